@@ -2,17 +2,106 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"sync"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/commands"
+	"github.com/10gen/realm-cli/internal/terminal"
 
 	"github.com/spf13/cobra"
 )
 
 // Run runs the CLI
 func Run() {
+	factory, err := cli.NewCommandFactory()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	os.Exit(factory.Run(newRootCommand(factory)))
+}
+
+// Prompt is a question asked by a command, see terminal.Prompt
+type Prompt = terminal.Prompt
+
+// PromptType is the type of a prompt, see terminal.PromptType
+type PromptType = terminal.PromptType
+
+// set of supported prompt types
+const (
+	PromptTypeInput       = terminal.PromptTypeInput
+	PromptTypePassword    = terminal.PromptTypePassword
+	PromptTypeConfirm     = terminal.PromptTypeConfirm
+	PromptTypeSelect      = terminal.PromptTypeSelect
+	PromptTypeMultiSelect = terminal.PromptTypeMultiSelect
+)
+
+// Answerer provides the answers to the prompts of a command, see terminal.Answerer
+type Answerer = terminal.Answerer
+
+// AnswererFunc is an Answerer implemented by a function
+type AnswererFunc = terminal.AnswererFunc
+
+// Options are the options to execute a command with
+type Options struct {
+	// In is the input prompts are read from when there is no Answerer, defaults to stdin
+	In io.Reader
+	// Out is the writer the command prints its output to, defaults to stdout
+	Out io.Writer
+	// Err is the writer the command prints its errors to, defaults to stderr
+	Err io.Writer
+	// Answerer answers the command's prompts, so it can run non-interactively
+	Answerer Answerer
+}
+
+// executeMu serializes the calls to Execute
+var executeMu sync.Mutex
+
+// Execute runs the command specified by the args, e.g. []string{"app", "list", "--project", "<id>"},
+// the same way the CLI would, but without exiting the process. It returns the exit code
+// the CLI would have exited with, which lets Go programs embed the CLI's commands.
+//
+// Execute is not safe to run concurrently: commands share process-wide state, such as the
+// loaded profile configuration, whether colors are disabled and how commands are sorted in
+// help text, so concurrent calls wait for the running command to return before they start
+func Execute(args []string, opts Options) int {
+	executeMu.Lock()
+	defer executeMu.Unlock()
+
+	factory, err := cli.NewCommandFactory()
+	if err != nil {
+		errWriter := opts.Err
+		if errWriter == nil {
+			errWriter = os.Stderr
+		}
+		fmt.Fprintln(errWriter, err)
+		return 1
+	}
+
+	factory.SetIO(opts.In, opts.Out, opts.Err)
+	if opts.Answerer != nil {
+		factory.SetAnswerer(opts.Answerer)
+	}
+
+	cmd := newRootCommand(factory)
+	cmd.SetArgs(args)
+	if opts.In != nil {
+		cmd.SetIn(opts.In)
+	}
+	if opts.Out != nil {
+		cmd.SetOut(opts.Out)
+	}
+	if opts.Err != nil {
+		cmd.SetErr(opts.Err)
+	}
+
+	return factory.Run(cmd)
+}
+
+func newRootCommand(factory *cli.CommandFactory) *cobra.Command {
 	// print commands in help/usage text in the order they are declared
 	cobra.EnableCommandSorting = false
 
@@ -25,13 +114,6 @@ func Run() {
 		SilenceUsage:  true,
 	}
 
-	factory, err := cli.NewCommandFactory()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	cobra.OnInitialize(factory.Setup)
-
 	cmd.Flags().SortFlags = false // ensures CLI help text displays global flags unsorted
 	factory.SetGlobalFlags(cmd.PersistentFlags())
 
@@ -40,12 +122,27 @@ func Run() {
 	cmd.AddCommand(factory.Build(commands.Logout))
 	cmd.AddCommand(factory.Build(commands.Push))
 	cmd.AddCommand(factory.Build(commands.Pull))
+	cmd.AddCommand(factory.Build(commands.Deploy))
 	cmd.AddCommand(factory.Build(commands.App))
 	cmd.AddCommand(factory.Build(commands.User))
 	cmd.AddCommand(factory.Build(commands.Secrets))
+	cmd.AddCommand(factory.Build(commands.Values))
+	cmd.AddCommand(factory.Build(commands.Rules))
+	cmd.AddCommand(factory.Build(commands.Sync))
+	cmd.AddCommand(factory.Build(commands.Data))
 	cmd.AddCommand(factory.Build(commands.Logs))
 	cmd.AddCommand(factory.Build(commands.Function))
+	cmd.AddCommand(factory.Build(commands.Eval))
+	cmd.AddCommand(factory.Build(commands.Dependencies))
+	cmd.AddCommand(factory.Build(commands.Triggers))
+	cmd.AddCommand(factory.Build(commands.Hosting))
 	cmd.AddCommand(factory.Build(commands.Schema))
+	cmd.AddCommand(factory.Build(commands.Search))
+	cmd.AddCommand(factory.Build(commands.Project))
+	cmd.AddCommand(factory.Build(commands.Config))
+	cmd.AddCommand(factory.Build(commands.Version))
+	cmd.AddCommand(factory.Build(commands.Testdata))
+	cmd.AddCommand(factory.Build(commands.BenchInternal))
 
-	os.Exit(factory.Run(cmd))
+	return cmd
 }
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"github.com/spf13/cobra"
+)
+
+func setupHome(t *testing.T) func() {
+	t.Helper()
+
+	home, teardown, err := u.NewTempDir("realm_cli_root_test")
+	assert.Nil(t, err)
+
+	originalHome := os.Getenv("HOME")
+	assert.Nil(t, os.Setenv("HOME", home))
+
+	return func() {
+		os.Setenv("HOME", originalHome) //nolint:errcheck
+		teardown()
+	}
+}
+
+func TestRootCommandHelp(t *testing.T) {
+	teardown := setupHome(t)
+	defer teardown()
+
+	factory, err := cli.NewCommandFactory()
+	assert.Nil(t, err)
+
+	var paths [][]string
+	var walk func(cmd *cobra.Command, path []string)
+	walk = func(cmd *cobra.Command, path []string) {
+		for _, sub := range cmd.Commands() {
+			subPath := append(append([]string{}, path...), sub.Name())
+			paths = append(paths, subPath)
+			walk(sub, subPath)
+		}
+	}
+	walk(newRootCommand(factory), nil)
+
+	assert.True(t, len(paths) > 0, "expected the root command to have subcommands")
+
+	for _, path := range paths {
+		t.Run("should print the help of "+strings.Join(path, " "), func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("failed to print the help: %v", r)
+				}
+			}()
+
+			out := new(bytes.Buffer)
+
+			exitCode := Execute(append(path, "--help"), Options{In: new(bytes.Buffer), Out: out, Err: out})
+			assert.Equal(t, 0, exitCode)
+			assert.True(t, strings.Contains(out.String(), "Usage:"), "expected the help text, but got: %s", out.String())
+		})
+	}
+}
+
+func TestExecuteWithAnswerer(t *testing.T) {
+	teardown := setupHome(t)
+	defer teardown()
+
+	apps := []realm.App{
+		{ID: "app1", ClientAppID: "app1-abcde", Name: "app1", GroupID: "groupID"},
+		{ID: "app2", ClientAppID: "app2-fghij", Name: "app2", GroupID: "groupID"},
+	}
+
+	var secretsPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/admin/v3.0/groups/groupID/apps":
+			if r.URL.Query().Get("product") != "" {
+				w.Write([]byte("[]")) //nolint:errcheck
+				return
+			}
+			json.NewEncoder(w).Encode(apps) //nolint:errcheck
+		case "/api/admin/v3.0/groups/groupID/apps/app1/secrets", "/api/admin/v3.0/groups/groupID/apps/app2/secrets":
+			secretsPath = r.URL.Path
+			json.NewEncoder(w).Encode([]realm.Secret{{ID: "secretID", Name: "secret"}}) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	profile, err := user.NewDefaultProfile()
+	assert.Nil(t, err)
+	profile.SetSession(user.Session{AccessToken: "accessToken", RefreshToken: "refreshToken"})
+	profile.SetRealmBaseURL(server.URL)
+	profile.SetLastVersionCheck(time.Now())
+	assert.Nil(t, profile.Save())
+
+	t.Run("should answer the app picker", func(t *testing.T) {
+		var prompts []Prompt
+		answerer := AnswererFunc(func(prompt Prompt) (string, error) {
+			if prompt.Type != terminal.PromptTypeSelect {
+				return "", nil
+			}
+			prompts = append(prompts, prompt)
+			return apps[1].Option(), nil
+		})
+
+		out := new(bytes.Buffer)
+
+		exitCode := Execute(
+			[]string{"secrets", "list", "--project", "groupID", "--telemetry", "off", "--realm-url", server.URL},
+			Options{Out: out, Err: out, Answerer: answerer},
+		)
+		assert.True(t, exitCode == 0, "unexpected output: %s", out.String())
+
+		assert.Equal(t, 1, len(prompts))
+		assert.Equal(t, terminal.PromptTypeSelect, prompts[0].Type)
+		assert.Equal(t, []string{apps[0].Option(), apps[1].Option()}, prompts[0].Options)
+		assert.Equal(t, "/api/admin/v3.0/groups/groupID/apps/app2/secrets", secretsPath)
+		assert.True(t, strings.Contains(out.String(), "secretID"), "expected the secrets, but got: %s", out.String())
+	})
+}
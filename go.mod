@@ -8,6 +8,7 @@ require (
 	github.com/blang/semver v3.5.1+incompatible
 	github.com/bmizerany/assert v0.0.0-20160611221934-b7ed37b82869 // indirect
 	github.com/briandowns/spinner v1.12.0
+	github.com/dop251/goja v0.0.0-20230427124612-428fc442ff5f
 	github.com/edaniels/digest v0.0.0-20170923160545-b81e9c4ee11c
 	github.com/edaniels/golinters v0.0.3
 	github.com/fatih/color v1.10.0
@@ -15,7 +16,8 @@ require (
 	github.com/google/go-cmp v0.5.2
 	github.com/hinshun/vt10x v0.0.0-20180616224451-1954e6464174
 	github.com/iancoleman/orderedmap v0.1.0
-	github.com/kr/pretty v0.2.1
+	github.com/jmespath/go-jmespath v0.4.0
+	github.com/kr/pretty v0.3.0
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/segmentio/backo-go v0.0.0-20200129164019-23eae7c10bd3 // indirect
 	github.com/spf13/afero v1.1.2
@@ -27,6 +29,7 @@ require (
 	github.com/xtgo/uuid v0.0.0-20140804021211-a0b114877d4c // indirect
 	go.mongodb.org/mongo-driver v1.5.1
 	gopkg.in/segmentio/analytics-go.v3 v3.1.0
+	gopkg.in/yaml.v2 v2.4.0
 )
 
 replace github.com/edaniels/golinters => github.com/mongodb-forks/golinters v0.0.4
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+// foundApps are the apps found in a project while apps are being resolved
+type foundApps struct {
+	apps    []realm.App
+	pending int
+}
+
+// appSelect is the app picker, which keeps adding the apps of the projects
+// still being searched to its options while it is shown
+type appSelect struct {
+	survey.Select
+	message string
+
+	found     <-chan foundApps
+	streamErr <-chan error
+	err       error
+
+	appsByOption map[string]realm.App
+}
+
+func newAppSelect(message string, found <-chan foundApps, streamErr <-chan error) *appSelect {
+	return &appSelect{
+		Select:       survey.Select{Message: message},
+		message:      message,
+		found:        found,
+		streamErr:    streamErr,
+		appsByOption: map[string]realm.App{},
+	}
+}
+
+func (s *appSelect) add(batch foundApps) {
+	for _, app := range batch.apps {
+		s.appsByOption[app.Option()] = app
+		s.Options = append(s.Options, app.Option())
+	}
+
+	s.Message = s.message
+	if batch.pending > 0 {
+		s.Message += fmt.Sprintf(" (searching %d more project(s)...)", batch.pending)
+	}
+}
+
+// wait adds every app found until the search completes
+func (s *appSelect) wait() {
+	for batch := range s.found {
+		s.add(batch)
+	}
+	s.done()
+}
+
+func (s *appSelect) done() {
+	s.found = nil
+	s.err = <-s.streamErr
+	s.Message = s.message
+}
+
+// Prompt shows the select prompt, re-rendering it each time more apps are found
+func (s *appSelect) Prompt(config *survey.PromptConfig) (interface{}, error) {
+	if s.found != nil {
+		stdio := s.Stdio()
+		stdio.In = &appSelectReader{FileReader: stdio.In, s: s, input: make(chan appSelectInput, 1)}
+		s.WithStdio(stdio)
+	}
+	return s.Select.Prompt(config)
+}
+
+// SelectPrompt returns the select prompt with every app found, so the picker can be answered non-interactively
+func (s *appSelect) SelectPrompt() *survey.Select {
+	if s.found != nil {
+		s.wait()
+	}
+	return &s.Select
+}
+
+type appSelectInput struct {
+	data []byte
+	err  error
+}
+
+// appSelectReader reads the user input for the app picker, and interrupts a pending
+// read with an ignored key whenever more apps are found, so that the prompt is
+// re-rendered with them; the apps are added by the prompt goroutine while it reads,
+// so the prompt options are never modified concurrently
+type appSelectReader struct {
+	terminal.FileReader
+	s *appSelect
+
+	input   chan appSelectInput
+	reading bool
+	buf     []byte
+}
+
+func (r *appSelectReader) Read(p []byte) (int, error) {
+	if len(r.buf) > 0 {
+		n := copy(p, r.buf)
+		r.buf = r.buf[n:]
+		return n, nil
+	}
+
+	if !r.reading {
+		r.reading = true
+		go func() {
+			data := make([]byte, len(p))
+			n, err := r.FileReader.Read(data)
+			r.input <- appSelectInput{data[:n], err}
+		}()
+	}
+
+	select {
+	case in := <-r.input:
+		r.reading = false
+		n := copy(p, in.data)
+		r.buf = in.data[n:]
+		return n, in.err
+	case batch, ok := <-r.s.found:
+		if ok {
+			r.s.add(batch)
+		} else {
+			r.s.done()
+		}
+		p[0] = byte(terminal.IgnoreKey)
+		return 1, nil
+	}
+}
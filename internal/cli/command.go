@@ -20,9 +20,10 @@ import (
 // Optionally, a Command may implement any of the other interfaces found below.
 // The order of operations is:
 //   1. CommandFlagger.Flags: use this hook to register flags to parse
-//   2. CommandInputs.Resolve: use this hook to prompt for any flags not provided
-//   3. CommandPreparer.Setup: use this hook to use setup the command (e.g. create clients/services)
-//   4. Command.Handler: this is the command hook
+//   2. CommandArgs.Args: use this hook to receive any positional arguments
+//   3. CommandInputs.Resolve: use this hook to prompt for any flags not provided
+//   4. CommandPreparer.Setup: use this hook to use setup the command (e.g. create clients/services)
+//   5. Command.Handler: this is the command hook
 // At any point should an error occur, command execution will terminate
 // and the ensuing steps will not be run
 type Command interface {
@@ -41,6 +42,11 @@ type CommandFlags interface {
 	Flags(fs *pflag.FlagSet)
 }
 
+// CommandArgs provides access for commands to receive their positional arguments
+type CommandArgs interface {
+	Args(args []string) error
+}
+
 // CommandInputs returns the command inputs
 type CommandInputs interface {
 	Inputs() InputResolver
@@ -85,6 +91,10 @@ type CommandMeta struct {
 	// HelpText is the text shown in the 'help' output of the actual command
 	// right below the command's description
 	HelpText string
+
+	// Hidden controls whether the command is left out of the 'help' output of the parent command
+	// This value maps 1:1 to Cobra's `Hidden` property
+	Hidden bool
 }
 
 // CommandDisplay returns the command display with the provided flags
@@ -3,15 +3,18 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/atlas"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/notify"
 	"github.com/10gen/realm-cli/internal/telemetry"
 	"github.com/10gen/realm-cli/internal/terminal"
 	"github.com/10gen/realm-cli/internal/utils/flags"
@@ -25,10 +28,18 @@ type CommandFactory struct {
 	profile          *user.Profile
 	ui               terminal.UI
 	uiConfig         terminal.UIConfig
-	inReader         *os.File
-	outWriter        *os.File
-	errWriter        *os.File
+	answerer         terminal.Answerer
+	inReader         io.Reader
+	outWriter        io.Writer
+	errWriter        io.Writer
 	telemetryService telemetry.Service
+	setup            bool
+
+	// summary flag and the details of the running command it reports
+	printSummary      bool
+	started           time.Time
+	display           string
+	resourcesAffected *int
 }
 
 // NewCommandFactory creates a new command factory
@@ -41,6 +52,20 @@ func NewCommandFactory() (*CommandFactory, error) {
 	return &CommandFactory{profile: profile}, nil
 }
 
+// SetIO sets the input the commands read from and the writers they print to,
+// in place of the standard input, output and error
+func (factory *CommandFactory) SetIO(in io.Reader, out, err io.Writer) {
+	factory.inReader = in
+	factory.outWriter = out
+	factory.errWriter = err
+}
+
+// SetAnswerer sets the answerer which answers the commands' prompts,
+// in place of reading the answers from the input
+func (factory *CommandFactory) SetAnswerer(answerer terminal.Answerer) {
+	factory.answerer = answerer
+}
+
 // Build builds a Cobra command from the specified CommandDefinition
 func (factory *CommandFactory) Build(command CommandDefinition) *cobra.Command {
 	display := command.Display
@@ -55,11 +80,17 @@ func (factory *CommandFactory) Build(command CommandDefinition) *cobra.Command {
 		aliasHelp = fmt.Sprintf(" (aliases: %s)", strings.Join(command.Aliases, ", "))
 	}
 
+	use := command.Use
+	if _, ok := appArgResolver(command.Command); ok {
+		use += " [app]"
+	}
+
 	cmd := cobra.Command{
-		Use:     command.Use,
+		Use:     use,
 		Short:   command.Description + aliasHelp,
 		Long:    command.Description + "\n\n" + command.HelpText,
 		Aliases: command.Aliases,
+		Hidden:  command.Hidden,
 	}
 
 	cmd.InheritedFlags().SortFlags = false // ensures command usage text displays global flags unsorted
@@ -76,15 +107,26 @@ func (factory *CommandFactory) Build(command CommandDefinition) *cobra.Command {
 			command.Flags(fs)
 		}
 
-		cmd.PersistentPreRun = func(c *cobra.Command, a []string) {
+		cmd.PersistentPreRunE = func(c *cobra.Command, a []string) error {
+			factory.profile.TraceID = newTraceID()
+			factory.started = time.Now()
+			factory.display = display
+
+			if err := factory.Setup(); err != nil {
+				return errDisableUsage{err}
+			}
+
 			factory.ensureUI()
 			cmd.SetIn(factory.inReader)
 			cmd.SetOut(factory.outWriter)
 			cmd.SetErr(factory.errWriter)
 
 			if err := factory.profile.ResolveFlags(); err != nil {
-				factory.ui.Print(terminal.NewErrorLog(err))
-				os.Exit(1)
+				return errDisableUsage{err}
+			}
+
+			if err := factory.applyDefaultFlags(c); err != nil {
+				return errDisableUsage{err}
 			}
 
 			factory.telemetryService = telemetry.NewService(
@@ -95,25 +137,48 @@ func (factory *CommandFactory) Build(command CommandDefinition) *cobra.Command {
 			)
 
 			factory.checkForNewVersion(http.DefaultClient)
+			return nil
 		}
 
-		if command, ok := command.Command.(CommandInputs); ok {
-			cmd.PreRunE = func(c *cobra.Command, a []string) error {
+		cmd.PreRunE = func(c *cobra.Command, a []string) error {
+			if command, ok := command.Command.(CommandFlagLookup); ok {
+				command.SetFlagLookup(newFlagLookup(c.Root()))
+			}
+			if command, ok := command.Command.(CommandArgs); ok {
+				if err := command.Args(a); err != nil {
+					return fmt.Errorf("%s setup failed: %w", display, err)
+				}
+			}
+			if resolver, ok := appArgResolver(command.Command); ok {
+				if err := resolver.ResolveAppArg(a); err != nil {
+					return fmt.Errorf("%s setup failed: %w", display, err)
+				}
+			}
+			if command, ok := command.Command.(CommandInputs); ok {
 				if err := command.Inputs().Resolve(factory.profile, factory.ui); err != nil {
 					return fmt.Errorf("%s setup failed: %w", display, err)
 				}
-				return nil
 			}
+			return nil
 		}
 
 		cmd.RunE = func(c *cobra.Command, a []string) error {
 			factory.telemetryService.TrackEvent(telemetry.EventTypeCommandStart)
 
+			start := time.Now()
 			err := command.Command.Handler(factory.profile, factory.ui, Clients{
 				Realm:        realm.NewAuthClient(factory.profile.RealmBaseURL(), factory.profile), // TODO(REALMC-8185): make this accept factory.profile.Session()
 				Atlas:        atlas.NewAuthClient(factory.profile.AtlasBaseURL(), factory.profile.Credentials()),
 				HostingAsset: http.DefaultClient,
 			})
+			factory.notify(display, time.Since(start), err)
+			if summarizer, ok := command.Command.(CommandSummarizer); ok {
+				resourcesAffected := summarizer.ResourcesAffected()
+				factory.resourcesAffected = &resourcesAffected
+			}
+			if warnings := factory.ui.Warnings(); len(warnings) > 0 {
+				factory.ui.Print(terminal.NewWarningSummaryLog(warnings))
+			}
 			if err != nil {
 				factory.telemetryService.TrackEvent(
 					telemetry.EventTypeCommandError,
@@ -136,13 +201,22 @@ func (factory *CommandFactory) Run(cmd *cobra.Command) int {
 
 	err := cmd.Execute()
 	if err == nil {
+		factory.summarize(0)
 		return 0
 	}
 
 	handleUsage(cmd, err)
 
 	if factory.ui == nil {
-		log.Print(err)
+		msg := err.Error()
+		if traceID := factory.profile.TraceID; traceID != "" {
+			msg += fmt.Sprintf("\n%s: %s", terminal.MsgTraceID, traceID)
+		}
+		if factory.errWriter != nil {
+			fmt.Fprintln(factory.errWriter, msg)
+		} else {
+			log.Print(msg)
+		}
 	} else {
 		logs := []terminal.Log{terminal.NewErrorLog(err)}
 		if e, ok := err.(Suggester); ok {
@@ -151,11 +225,22 @@ func (factory *CommandFactory) Run(cmd *cobra.Command) int {
 		if e, ok := err.(LinkReferrer); ok {
 			logs = append(logs, terminal.NewFollowupLog(terminal.MsgReferenceLinks, e.ReferenceLinks()))
 		}
+		if traceID := factory.profile.TraceID; traceID != "" {
+			logs = append(logs, terminal.NewFollowupLog(terminal.MsgTraceID, traceID))
+		}
 
 		factory.ui.Print(logs...)
 	}
 
-	return 1
+	exitCode := 1
+
+	var exitCoder ExitCoder
+	if errors.As(err, &exitCoder) {
+		exitCode = exitCoder.ExitCode()
+	}
+
+	factory.summarize(exitCode)
+	return exitCode
 }
 
 // SetGlobalFlags sets the global flags
@@ -165,12 +250,19 @@ func (factory *CommandFactory) SetGlobalFlags(fs *pflag.FlagSet) {
 	// profile flags
 	fs.StringVar(&factory.profile.Name, user.FlagProfile, user.DefaultProfile, user.FlagProfileUsage)
 	fs.Var(&factory.profile.Flags.TelemetryMode, telemetry.FlagMode, telemetry.FlagModeUsage)
+	fs.IntVar(&factory.profile.Flags.MaxParallel, user.FlagMaxParallel, 0, user.FlagMaxParallelUsage)
+	fs.StringArrayVar(&factory.profile.Flags.Headers, user.FlagHeader, nil, user.FlagHeaderUsage)
+	fs.Var(&factory.profile.Flags.Notify, notify.FlagTarget, notify.FlagTargetUsage)
+	fs.DurationVar(&factory.profile.Flags.NotifyAfter, notify.FlagAfter, notify.DefaultAfter, notify.FlagAfterUsage)
 
 	// ui flags
 	fs.StringVarP(&factory.uiConfig.OutputTarget, terminal.FlagOutputTarget, terminal.FlagOutputTargetShort, "", terminal.FlagOutputTargetUsage)
 	fs.VarP(&factory.uiConfig.OutputFormat, terminal.FlagOutputFormat, terminal.FlagOutputFormatShort, terminal.FlagOutputFormatUsage)
+	fs.StringVar(&factory.uiConfig.Query, terminal.FlagQuery, "", terminal.FlagQueryUsage)
+	fs.StringVar(&factory.uiConfig.FormatTemplate, terminal.FlagFormatTemplate, "", terminal.FlagFormatTemplateUsage)
 	fs.BoolVar(&factory.uiConfig.DisableColors, terminal.FlagDisableColors, false, terminal.FlagDisableColorsUsage)
 	fs.BoolVarP(&factory.uiConfig.AutoConfirm, terminal.FlagAutoConfirm, terminal.FlagAutoConfirmShort, false, terminal.FlagAutoConfirmUsage)
+	fs.BoolVar(&factory.printSummary, FlagSummary, false, FlagSummaryUsage)
 
 	// hidden flags
 	fs.StringVar(&factory.profile.Flags.AtlasBaseURL, user.FlagAtlasBaseURL, "", user.FlagAtlasBaseURLUsage)
@@ -178,21 +270,86 @@ func (factory *CommandFactory) SetGlobalFlags(fs *pflag.FlagSet) {
 
 	fs.StringVar(&factory.profile.Flags.RealmBaseURL, user.FlagRealmBaseURL, "", user.FlagRealmBaseURLUsage)
 	flags.MarkHidden(fs, user.FlagRealmBaseURL)
+
+	fs.StringVar(&factory.profile.Flags.RealmRegionalURL, user.FlagRealmRegionalURL, "", user.FlagRealmRegionalURLUsage)
+	flags.MarkHidden(fs, user.FlagRealmRegionalURL)
 }
 
-// Setup initializes the command factory
-func (factory *CommandFactory) Setup() {
+// Setup initializes the command factory, which happens once before its first command runs
+func (factory *CommandFactory) Setup() error {
+	if factory.setup {
+		return nil
+	}
+	factory.setup = true
+
 	if err := factory.profile.Load(); err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	if filepath := factory.uiConfig.OutputTarget; filepath != "" {
 		f, err := os.OpenFile(filepath, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0660)
 		if err != nil {
-			log.Fatal(fmt.Errorf("failed to open target file: %w", err))
+			return fmt.Errorf("failed to open target file: %w", err)
 		}
 		factory.outWriter = f
 	}
+
+	if filepath := factory.uiConfig.FormatTemplate; filepath != "" {
+		tmpl, err := terminal.ParseFormatTemplate(filepath)
+		if err != nil {
+			return fmt.Errorf("failed to parse format template: %w", err)
+		}
+		factory.uiConfig.Template = tmpl
+	}
+
+	if query := factory.uiConfig.Query; query != "" {
+		expr, err := terminal.CompileQuery(query)
+		if err != nil {
+			return fmt.Errorf("failed to compile query: %w", err)
+		}
+		factory.uiConfig.QueryExpr = expr
+		factory.uiConfig.OutputFormat = terminal.OutputFormatJSON
+	}
+	return nil
+}
+
+// notify sends a notification that the command finished when it took long enough,
+// only warning if the notification fails to send
+func (factory *CommandFactory) notify(display string, duration time.Duration, err error) {
+	if !notify.ShouldSend(factory.profile.Flags.Notify, factory.profile.Flags.NotifyAfter, duration) {
+		return
+	}
+
+	if err := notify.Send(factory.profile.Flags.Notify, notify.Notification{
+		Command:  Name + " " + display,
+		Duration: duration,
+		Err:      err,
+	}); err != nil {
+		factory.ui.Print(terminal.NewWarningLog("Failed to send notification: %s", err))
+	}
+}
+
+// summarize prints the summary of the command which finished with the exit code,
+// when requested and the command started running
+func (factory *CommandFactory) summarize(exitCode int) {
+	if !factory.printSummary || factory.started.IsZero() {
+		return
+	}
+
+	var warnings int
+	if factory.ui != nil {
+		warnings = len(factory.ui.Warnings())
+	}
+
+	w := factory.errWriter
+	if w == nil {
+		w = os.Stderr
+	}
+
+	summary := newSummary(factory.display, exitCode, time.Since(factory.started), factory.resourcesAffected, warnings)
+	if err := summary.print(w, factory.uiConfig.OutputFormat); err != nil {
+		log.Printf("failed to print command summary: %s", err)
+	}
 }
 
 func (factory *CommandFactory) close() {
@@ -201,7 +358,9 @@ func (factory *CommandFactory) close() {
 	}
 
 	if factory.uiConfig.OutputTarget != "" {
-		factory.outWriter.Close()
+		if closer, ok := factory.outWriter.(io.Closer); ok {
+			closer.Close()
+		}
 	}
 }
 
@@ -242,6 +401,40 @@ func (factory *CommandFactory) checkForNewVersion(client VersionManifestClient)
 	factory.telemetryService.TrackEvent(telemetry.EventTypeCommandVersionCheck)
 }
 
+// applyDefaultFlags sets each of the command's flags that was not provided
+// to its default value from the CLI profile, if one is configured
+func (factory *CommandFactory) applyDefaultFlags(c *cobra.Command) error {
+	path := strings.Fields(c.CommandPath())
+	if len(path) < 2 {
+		return nil
+	}
+	command := strings.Join(path[1:], ".")
+
+	defaults := factory.profile.DefaultFlags(command)
+
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fs := c.Flags()
+	for _, name := range names {
+		flag := fs.Lookup(name)
+		if flag == nil {
+			factory.ui.Print(terminal.NewWarningLog("Ignoring the default value for unknown flag '%s' of command '%s'", name, command))
+			continue
+		}
+		if flag.Changed {
+			continue
+		}
+		if err := fs.Set(name, defaults[name]); err != nil {
+			return fmt.Errorf("invalid default value '%s' for flag '%s' of command '%s': %w", defaults[name], name, command, err)
+		}
+	}
+	return nil
+}
+
 func (factory *CommandFactory) ensureUI() {
 	if factory.inReader == nil {
 		factory.inReader = os.Stdin
@@ -260,13 +453,37 @@ func (factory *CommandFactory) ensureUI() {
 	}
 
 	if factory.ui == nil {
-		factory.ui = terminal.NewUI(factory.uiConfig, factory.inReader, factory.outWriter, factory.errWriter)
+		if factory.answerer != nil {
+			factory.ui = terminal.NewAnswerUI(factory.uiConfig, factory.answerer, factory.outWriter, factory.errWriter)
+		} else {
+			factory.ui = terminal.NewUI(factory.uiConfig, factory.inReader, factory.outWriter, factory.errWriter)
+		}
 	}
 }
 
 func handleUsage(cmd *cobra.Command, err error) {
+	if _, ok := err.(DisableUsage); ok {
+		return
+	}
 	if _, ok := errors.Unwrap(err).(DisableUsage); ok {
 		return
 	}
-	fmt.Println(cmd.UsageString())
+	fmt.Fprintln(cmd.OutOrStdout(), cmd.UsageString())
+}
+
+// appArgResolver returns the command inputs which receive the app as a positional argument,
+// unless the command handles its positional arguments itself
+func appArgResolver(command Command) (AppArgResolver, bool) {
+	if command == nil {
+		return nil, false
+	}
+	if _, ok := command.(CommandArgs); ok {
+		return nil, false
+	}
+	inputs, ok := command.(CommandInputs)
+	if !ok {
+		return nil, false
+	}
+	resolver, ok := inputs.Inputs().(AppArgResolver)
+	return resolver, ok
 }
@@ -1,17 +1,25 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/telemetry"
+	"github.com/10gen/realm-cli/internal/terminal"
 	"github.com/10gen/realm-cli/internal/utils/api"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
 )
 
 type capturedEvent struct {
@@ -102,7 +110,7 @@ func TestCommandFactoryVersionCheck(t *testing.T) {
 		assert.Equal(t, 1, len(events))
 		assert.Equal(t, capturedEvent{EventType: telemetry.EventTypeCommandVersionCheck}, events[0])
 
-		assert.Equal(t, `New version (v0.1.0) of CLI available: http://somewhere.com
+		assert.Equal(t, `Warning: New version (v0.1.0) of CLI available: http://somewhere.com
 Note: This is the only time this alert will display today
 To install
   npm install -g mongodb-realm-cli@v0.1.0
@@ -139,6 +147,117 @@ To install
 	})
 }
 
+func TestCommandFactoryApplyDefaultFlags(t *testing.T) {
+	setup := func(t *testing.T, args ...string) (*cobra.Command, *bool, *[]string, *CommandFactory, *bytes.Buffer) {
+		t.Helper()
+
+		root := &cobra.Command{Use: Name}
+		apps := &cobra.Command{Use: "apps"}
+		list := &cobra.Command{Use: "list", Run: func(c *cobra.Command, a []string) {}}
+		root.AddCommand(apps)
+		apps.AddCommand(list)
+
+		var yes bool
+		var labels []string
+		list.Flags().BoolVar(&yes, "yes", false, "")
+		list.Flags().StringSliceVar(&labels, "label", nil, "")
+
+		root.SetArgs(append([]string{"apps", "list"}, args...))
+		assert.Nil(t, root.Execute())
+
+		out, ui := mock.NewUI()
+		return list, &yes, &labels, &CommandFactory{profile: mock.NewProfile(t), ui: ui}, out
+	}
+
+	t.Run("should set the flags which were not provided to their defaults", func(t *testing.T) {
+		list, yes, labels, factory, out := setup(t)
+		factory.profile.SetDefaultFlag("apps.list", "yes", "true")
+		factory.profile.SetDefaultFlag("apps.list", "label", "env=prod")
+
+		assert.Nil(t, factory.applyDefaultFlags(list))
+		assert.True(t, *yes, "expected yes to be set by its default")
+		assert.Equal(t, []string{"env=prod"}, *labels)
+		assert.Equal(t, "", out.String())
+	})
+
+	t.Run("should not override the flags which were provided", func(t *testing.T) {
+		list, _, labels, factory, _ := setup(t, "--label", "env=dev")
+		factory.profile.SetDefaultFlag("apps.list", "label", "env=prod")
+
+		assert.Nil(t, factory.applyDefaultFlags(list))
+		assert.Equal(t, []string{"env=dev"}, *labels)
+	})
+
+	t.Run("should warn about defaults for unknown flags", func(t *testing.T) {
+		list, _, _, factory, out := setup(t)
+		factory.profile.SetDefaultFlag("apps.list", "unknown", "value")
+
+		assert.Nil(t, factory.applyDefaultFlags(list))
+		assert.Equal(t, "Warning: Ignoring the default value for unknown flag 'unknown' of command 'apps.list'\n", out.String())
+	})
+
+	t.Run("should return an error for invalid default values", func(t *testing.T) {
+		list, _, _, factory, _ := setup(t)
+		factory.profile.SetDefaultFlag("apps.list", "yes", "maybe")
+
+		err := factory.applyDefaultFlags(list)
+		assert.NotNil(t, err)
+		assert.True(t,
+			strings.HasPrefix(err.Error(), "invalid default value 'maybe' for flag 'yes' of command 'apps.list'"),
+			"unexpected error: %s", err,
+		)
+	})
+}
+
+type appArgInputs struct {
+	ProjectInputs
+}
+
+func (i *appArgInputs) Resolve(profile *user.Profile, ui terminal.UI) error { return nil }
+
+type appArgCommand struct {
+	inputs appArgInputs
+}
+
+func (cmd *appArgCommand) Inputs() InputResolver { return &cmd.inputs }
+
+func (cmd *appArgCommand) Handler(profile *user.Profile, ui terminal.UI, clients Clients) error {
+	return nil
+}
+
+type ownArgsCommand struct {
+	appArgCommand
+}
+
+func (cmd *ownArgsCommand) Args(args []string) error { return nil }
+
+func TestCommandFactoryAppArg(t *testing.T) {
+	t.Run("should accept the app argument for commands with project inputs", func(t *testing.T) {
+		factory := &CommandFactory{profile: mock.NewProfile(t)}
+		command := &appArgCommand{}
+
+		cmd := factory.Build(CommandDefinition{Command: command, CommandMeta: CommandMeta{Use: "describe"}})
+		assert.Equal(t, "describe [app]", cmd.Use)
+
+		assert.Nil(t, cmd.PreRunE(cmd, []string{"my-app"}))
+		assert.Equal(t, "my-app", command.inputs.App)
+
+		err := cmd.PreRunE(cmd, []string{"app-1", "app-2"})
+		assert.Equal(t, "describe setup failed: accepts at most one app argument, but received 2: app-1, app-2", err.Error())
+	})
+
+	t.Run("should not accept the app argument for commands which handle their own args", func(t *testing.T) {
+		factory := &CommandFactory{profile: mock.NewProfile(t)}
+		command := &ownArgsCommand{}
+
+		cmd := factory.Build(CommandDefinition{Command: command, CommandMeta: CommandMeta{Use: "set"}})
+		assert.Equal(t, "set", cmd.Use)
+
+		assert.Nil(t, cmd.PreRunE(cmd, []string{"my-app"}))
+		assert.Equal(t, "", command.inputs.App)
+	})
+}
+
 type mockVersionClient struct {
 	status  int
 	version string
@@ -169,3 +288,144 @@ func (client mockVersionClient) Get(_ string) (*http.Response, error) {
 }`, version, osArch, url))),
 	}, nil
 }
+
+type greetCommand struct {
+	err      error
+	warnings []string
+}
+
+func (cmd *greetCommand) Handler(profile *user.Profile, ui terminal.UI, clients Clients) error {
+	for _, warning := range cmd.warnings {
+		ui.Print(terminal.NewWarningLog(warning))
+	}
+	if cmd.err != nil {
+		return cmd.err
+	}
+	var name string
+	if err := ui.AskOne(&name, &survey.Input{Message: "Name"}); err != nil {
+		return err
+	}
+	ui.Print(terminal.NewTextLog("Hello, %s", name))
+	return nil
+}
+
+type exitCodeErr struct{}
+
+func (err exitCodeErr) Error() string { return "something bad happened" }
+
+func (err exitCodeErr) ExitCode() int { return 3 }
+
+func TestCommandFactoryRunWithIO(t *testing.T) {
+	origNewTraceID := newTraceID
+	defer func() { newTraceID = origNewTraceID }()
+
+	newTraceID = func() string { return "0123456789abcdef0123456789abcdef" }
+
+	setup := func(t *testing.T, command Command) (*CommandFactory, *cobra.Command, *bytes.Buffer, *bytes.Buffer) {
+		profile := mock.NewProfile(t)
+		profile.Flags.TelemetryMode = telemetry.ModeOff
+		profile.SetLastVersionCheck(time.Now()) // avoid checking for a new version
+
+		factory := &CommandFactory{profile: profile}
+		factory.uiConfig.DisableColors = true
+
+		out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+		factory.SetIO(nil, out, errOut)
+
+		root := &cobra.Command{Use: Name, SilenceErrors: true, SilenceUsage: true}
+		root.AddCommand(factory.Build(CommandDefinition{Command: command, CommandMeta: CommandMeta{Use: "greet"}}))
+		root.SetArgs([]string{"greet"})
+
+		return factory, root, out, errOut
+	}
+
+	t.Run("should answer the prompts with the answerer and print to the writers", func(t *testing.T) {
+		factory, root, out, errOut := setup(t, &greetCommand{})
+
+		var prompts []terminal.Prompt
+		factory.SetAnswerer(terminal.AnswererFunc(func(prompt terminal.Prompt) (string, error) {
+			prompts = append(prompts, prompt)
+			return "eggcorn", nil
+		}))
+
+		assert.Equal(t, 0, factory.Run(root))
+		assert.Equal(t, "Hello, eggcorn\n", out.String())
+		assert.Equal(t, "", errOut.String())
+		assert.Equal(t, []terminal.Prompt{{Type: terminal.PromptTypeInput, Message: "Name"}}, prompts)
+	})
+
+	t.Run("should print the error to the error writer and return the exit code", func(t *testing.T) {
+		factory, root, out, errOut := setup(t, &greetCommand{err: exitCodeErr{}})
+
+		assert.Equal(t, 3, factory.Run(root))
+		assert.Equal(t, "Share this trace ID when reporting the failure: 0123456789abcdef0123456789abcdef\n", out.String())
+		assert.Equal(t, "greet failed: something bad happened\n", errOut.String())
+	})
+	t.Run("should print the warnings to the error writer and summarize them when the command finishes", func(t *testing.T) {
+		factory, root, out, errOut := setup(t, &greetCommand{
+			err:      exitCodeErr{},
+			warnings: []string{"field 'foo' is deprecated", "skipped file 'bar.txt'"},
+		})
+
+		assert.Equal(t, 3, factory.Run(root))
+		assert.Equal(t, "Share this trace ID when reporting the failure: 0123456789abcdef0123456789abcdef\n", out.String())
+		assert.Equal(t, `Warning: field 'foo' is deprecated
+Warning: skipped file 'bar.txt'
+Finished with 2 warning(s)
+  field 'foo' is deprecated
+  skipped file 'bar.txt'
+greet failed: something bad happened
+`, errOut.String())
+	})
+
+	t.Run("should print the summary as the last line of the error writer", func(t *testing.T) {
+		factory, root, _, errOut := setup(t, &greetCommand{err: exitCodeErr{}, warnings: []string{"skipped file 'bar.txt'"}})
+		factory.printSummary = true
+
+		assert.Equal(t, 3, factory.Run(root))
+
+		lines := strings.Split(strings.TrimSuffix(errOut.String(), "\n"), "\n")
+		assert.True(t, len(lines) > 1, "expected the summary to follow the error")
+
+		summary := regexp.MustCompile(`duration_ms=\d+`).ReplaceAllString(lines[len(lines)-1], "duration_ms=0")
+		assert.Equal(t, `summary: command="greet" status=failed exit_code=3 duration_ms=0 warnings=1`, summary)
+	})
+
+	t.Run("should print the summary as json with the resources affected", func(t *testing.T) {
+		factory, root, _, errOut := setup(t, &summarizedCommand{resourcesAffected: 2})
+		factory.printSummary = true
+		factory.uiConfig.OutputFormat = terminal.OutputFormatJSON
+
+		assert.Equal(t, 0, factory.Run(root))
+
+		var summary Summary
+		assert.Nil(t, json.Unmarshal(errOut.Bytes(), &summary))
+		summary.DurationMillis = 0
+
+		resourcesAffected := 2
+		assert.Equal(t, Summary{
+			Command:           "greet",
+			Status:            SummaryStatusSucceeded,
+			ResourcesAffected: &resourcesAffected,
+		}, summary)
+	})
+
+	t.Run("should not print the summary without the flag", func(t *testing.T) {
+		factory, root, _, errOut := setup(t, &summarizedCommand{resourcesAffected: 2})
+
+		assert.Equal(t, 0, factory.Run(root))
+		assert.Equal(t, "", errOut.String())
+	})
+}
+
+type summarizedCommand struct {
+	resourcesAffected int
+}
+
+func (cmd *summarizedCommand) Handler(profile *user.Profile, ui terminal.UI, clients Clients) error {
+	return nil
+}
+
+func (cmd *summarizedCommand) ResourcesAffected() int {
+	return cmd.resourcesAffected
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// DeploymentPollInterval is the time to wait between checks of a deployment's status
+var DeploymentPollInterval = time.Second
+
+// DeploymentTimeout is the time to wait for a deployment to complete before giving up on it
+var DeploymentTimeout = 10 * time.Minute
+
+// WaitForDeployment waits for the deployment to complete while showing a spinner with the message,
+// and returns the deployment with its final status. A deployment which does not complete within
+// the DeploymentTimeout keeps running, and an ErrDeploymentTimeout is returned
+func WaitForDeployment(ui terminal.UI, client realm.Client, groupID, appID string, deployment realm.AppDeployment, message string) (realm.AppDeployment, error) {
+	s := ui.Spinner(message)
+	s.Start()
+	defer s.Stop()
+
+	deadline := time.Now().Add(DeploymentTimeout)
+
+	status := deployment.Status
+	for deployment.Status == realm.DeploymentStatusCreated || deployment.Status == realm.DeploymentStatusPending {
+		if !time.Now().Before(deadline) {
+			return deployment, ErrDeploymentTimeout{deployment.ID, deployment.Status, DeploymentTimeout}
+		}
+
+		time.Sleep(DeploymentPollInterval)
+
+		polled, err := client.Deployment(groupID, appID, deployment.ID)
+		if err != nil {
+			return deployment, err
+		}
+		deployment = polled
+
+		if deployment.Status != status && deployment.Status == realm.DeploymentStatusPending {
+			s.Stop()
+			ui.Print(terminal.NewTextLog("Deployment status: %s", deployment.Status))
+			s.Start()
+		}
+		status = deployment.Status
+	}
+
+	return deployment, nil
+}
+
+// ErrDeploymentTimeout is a deployment that did not complete in time error
+type ErrDeploymentTimeout struct {
+	ID      string
+	Status  realm.DeploymentStatus
+	Timeout time.Duration
+}
+
+func (err ErrDeploymentTimeout) Error() string {
+	return fmt.Sprintf("deployment '%s' is still %s after waiting %s for it to complete", err.ID, err.Status, err.Timeout)
+}
+
+func (err ErrDeploymentTimeout) DisableUsage() struct{} { return struct{}{} }
+
+// Suggestions returns the command to check on the deployment's status later
+func (err ErrDeploymentTimeout) Suggestions() []interface{} {
+	return []interface{}{fmt.Sprintf("%s apps deployments describe %s", Name, err.ID)}
+}
@@ -0,0 +1,70 @@
+package cli_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestWaitForDeployment(t *testing.T) {
+	originalPollInterval := cli.DeploymentPollInterval
+	cli.DeploymentPollInterval = time.Millisecond
+	defer func() { cli.DeploymentPollInterval = originalPollInterval }()
+
+	created := realm.AppDeployment{ID: "deploymentID", Status: realm.DeploymentStatusCreated}
+
+	t.Run("should poll the deployment until it completes and print when it is pending", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var polls int
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			polls++
+			if polls < 3 {
+				return realm.AppDeployment{ID: deploymentID, Status: realm.DeploymentStatusPending}, nil
+			}
+			return realm.AppDeployment{ID: deploymentID, Status: realm.DeploymentStatusSuccessful}, nil
+		}
+
+		deployment, err := cli.WaitForDeployment(ui, realmClient, "groupID", "appID", created, "Deploying...")
+		assert.Nil(t, err)
+		assert.Equal(t, realm.AppDeployment{ID: "deploymentID", Status: realm.DeploymentStatusSuccessful}, deployment)
+		assert.Equal(t, 3, polls)
+		assert.Equal(t, "Deployment status: pending\n", out.String())
+	})
+
+	t.Run("should return the error of a failed poll", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{}, errors.New("something bad happened")
+		}
+
+		_, err := cli.WaitForDeployment(ui, realmClient, "groupID", "appID", created, "Deploying...")
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+
+	t.Run("should stop waiting for a deployment which does not complete in time", func(t *testing.T) {
+		originalTimeout := cli.DeploymentTimeout
+		cli.DeploymentTimeout = 10 * time.Millisecond
+		defer func() { cli.DeploymentTimeout = originalTimeout }()
+
+		_, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{ID: deploymentID, Status: realm.DeploymentStatusPending}, nil
+		}
+
+		deployment, err := cli.WaitForDeployment(ui, realmClient, "groupID", "appID", created, "Deploying...")
+		assert.Equal(t, cli.ErrDeploymentTimeout{ID: "deploymentID", Status: realm.DeploymentStatusPending, Timeout: 10 * time.Millisecond}, err)
+		assert.Equal(t, "deployment 'deploymentID' is still pending after waiting 10ms for it to complete", err.Error())
+		assert.Equal(t, realm.DeploymentStatusPending, deployment.Status)
+	})
+}
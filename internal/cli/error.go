@@ -11,6 +11,13 @@ type errDisableUsage struct {
 
 func (err errDisableUsage) DisableUsage() struct{} { return struct{}{} }
 
+func (err errDisableUsage) Unwrap() error { return err.error }
+
+// ExitCoder provides the exit code the CLI exits with when an error occurs, instead of 1
+type ExitCoder interface {
+	ExitCode() int
+}
+
 // Suggester provides a list of suggestions that will display to the user when an error occurs
 type Suggester interface {
 	Suggestions() []interface{}
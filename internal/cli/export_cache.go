@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+)
+
+// CacheAppExport caches the app found at the path as the latest deployed version of the app,
+// which can then be diffed against without making any requests
+func CacheAppExport(profile *user.Profile, path string) error {
+	app, err := local.LoadApp(path)
+	if err != nil {
+		return err
+	}
+	if app.AppData == nil || app.ID() == "" {
+		return nil
+	}
+	return local.WriteAppSnapshot(profile.ExportCachePath(app.ID()), app)
+}
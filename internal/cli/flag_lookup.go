@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// CommandFlagLookup provides access for commands to look up the flags of the other CLI commands
+type CommandFlagLookup interface {
+	SetFlagLookup(lookup FlagLookup)
+}
+
+// FlagLookup looks up the flag of the CLI command at the path, which may use the commands' aliases,
+// and returns the command's full path separated by "." along with the flag
+type FlagLookup func(path []string, name string) (string, *pflag.Flag, error)
+
+func newFlagLookup(root *cobra.Command) FlagLookup {
+	return func(path []string, name string) (string, *pflag.Flag, error) {
+		display := strings.Join(path, " ")
+
+		c, args, err := root.Find(path)
+		if err != nil || len(args) > 0 || c == root || !c.Runnable() {
+			return "", nil, fmt.Errorf("'%s' is not a command", display)
+		}
+
+		flag := c.Flags().Lookup(name)
+		if flag == nil {
+			flag = c.InheritedFlags().Lookup(name)
+		}
+		if flag == nil {
+			return "", nil, fmt.Errorf("'%s' has no flag '--%s'", display, name)
+		}
+
+		return strings.Join(strings.Fields(c.CommandPath())[1:], "."), flag, nil
+	}
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"github.com/spf13/cobra"
+)
+
+func TestFlagLookup(t *testing.T) {
+	root := &cobra.Command{Use: Name}
+	root.PersistentFlags().Bool("yes", false, "")
+
+	apps := &cobra.Command{Use: "apps", Aliases: []string{"app"}}
+	list := &cobra.Command{Use: "list", Run: func(c *cobra.Command, a []string) {}}
+	list.Flags().StringSlice("label", nil, "")
+	root.AddCommand(apps)
+	apps.AddCommand(list)
+
+	lookup := newFlagLookup(root)
+
+	t.Run("should find the flag of a command", func(t *testing.T) {
+		command, flag, err := lookup([]string{"apps", "list"}, "label")
+		assert.Nil(t, err)
+		assert.Equal(t, "apps.list", command)
+		assert.Equal(t, "label", flag.Name)
+	})
+
+	t.Run("should find the flag of a command with its full path when using an alias", func(t *testing.T) {
+		command, flag, err := lookup([]string{"app", "list"}, "label")
+		assert.Nil(t, err)
+		assert.Equal(t, "apps.list", command)
+		assert.Equal(t, "label", flag.Name)
+	})
+
+	t.Run("should find a global flag of a command", func(t *testing.T) {
+		command, flag, err := lookup([]string{"apps", "list"}, "yes")
+		assert.Nil(t, err)
+		assert.Equal(t, "apps.list", command)
+		assert.Equal(t, "yes", flag.Name)
+	})
+
+	for _, tc := range []struct {
+		description string
+		path        []string
+		name        string
+		err         error
+	}{
+		{
+			description: "should return an error for an unknown command",
+			path:        []string{"apps", "describe"},
+			name:        "label",
+			err:         errors.New("'apps describe' is not a command"),
+		},
+		{
+			description: "should return an error for a command which cannot be run",
+			path:        []string{"apps"},
+			name:        "yes",
+			err:         errors.New("'apps' is not a command"),
+		},
+		{
+			description: "should return an error for an unknown flag",
+			path:        []string{"apps", "list"},
+			name:        "unknown",
+			err:         errors.New("'apps list' has no flag '--unknown'"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, _, err := lookup(tc.path, tc.name)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
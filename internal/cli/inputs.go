@@ -3,6 +3,8 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"runtime"
+	"strings"
 
 	"github.com/10gen/realm-cli/internal/cloud/atlas"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
@@ -47,6 +49,11 @@ func (i *ProjectInputs) Flags(fs *pflag.FlagSet) {
 	flags.MarkHidden(fs, flagProduct)
 }
 
+// ResolveAppArg sets the app from the positional arguments, if any were provided
+func (i *ProjectInputs) ResolveAppArg(args []string) error {
+	return ResolveAppArg(&i.App, flagApp, args)
+}
+
 // Resolve resolves the necessary inputs that remain unset after flags have been parsed
 func (i *ProjectInputs) Resolve(ui terminal.UI, wd string, skipAppPrompt bool) error {
 	app, appErr := local.LoadAppConfig(wd)
@@ -72,6 +79,30 @@ func (i *ProjectInputs) Resolve(ui terminal.UI, wd string, skipAppPrompt bool) e
 	return nil
 }
 
+// AppArgResolver provides access for command inputs to receive the remote app
+// as a positional argument, e.g. `realm-cli app describe my-app`
+type AppArgResolver interface {
+	ResolveAppArg(args []string) error
+}
+
+// ResolveAppArg sets the app input from the positional arguments, ensuring at most one
+// app is specified and that it does not conflict with the app set by the named flag
+func ResolveAppArg(app *string, flag string, args []string) error {
+	switch len(args) {
+	case 0:
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("accepts at most one app argument, but received %d: %s", len(args), strings.Join(args, ", "))
+	}
+
+	if *app != "" && *app != args[0] {
+		return fmt.Errorf(`cannot use both the app argument '%s' and "--%s" '%s'`, args[0], flag, *app)
+	}
+	*app = args[0]
+	return nil
+}
+
 // ErrAppNotFound is an app not found error
 type ErrAppNotFound struct {
 	App string
@@ -91,35 +122,65 @@ var (
 	ErrGroupNotFound = errors.New("failed to find group")
 )
 
-// ResolveApp will use the provided Realm client to resolve the app specified by the filter
+// ResolveApp will use the provided Realm client to resolve the app specified by the filter;
+// when more than one app is found, the user is prompted to select one while the apps
+// of the remaining projects are still being found
 func ResolveApp(ui terminal.UI, client realm.Client, filter realm.AppFilter) (realm.App, error) {
-	apps, err := client.FindApps(filter)
-	if err != nil {
-		return realm.App{}, err
+	found := make(chan foundApps)
+	streamErr := make(chan error, 1)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(found)
+		streamErr <- client.FindAppsStream(filter, func(apps []realm.App, pending int) {
+			select {
+			case found <- foundApps{apps, pending}:
+			case <-done:
+			}
+		})
+	}()
+
+	prompt := newAppSelect("Select App", found, streamErr)
+
+	for len(prompt.Options) < 2 {
+		batch, ok := <-found
+		if !ok {
+			prompt.done()
+			break
+		}
+		prompt.add(batch)
 	}
 
-	switch len(apps) {
-	case 0:
-		return realm.App{}, ErrAppNotFound{filter.App}
-	case 1:
-		return apps[0], nil
+	// the rune reader on windows does not read through the prompt input,
+	// so the prompt cannot be re-rendered as more apps are found
+	if runtime.GOOS == "windows" && prompt.found != nil {
+		prompt.wait()
 	}
 
-	appsByOption := make(map[string]realm.App, len(apps))
-	appOptions := make([]string, len(apps))
-	for i, app := range apps {
-		appsByOption[app.Option()] = app
-		appOptions[i] = app.Option()
+	if prompt.found == nil {
+		if prompt.err != nil {
+			return realm.App{}, prompt.err
+		}
+
+		switch len(prompt.Options) {
+		case 0:
+			return realm.App{}, ErrAppNotFound{filter.App}
+		case 1:
+			return prompt.appsByOption[prompt.Options[0]], nil
+		}
 	}
 
 	var selection string
-	if err := ui.AskOne(&selection, &survey.Select{
-		Message: "Select App",
-		Options: appOptions,
-	}); err != nil {
+	if err := ui.AskOne(&selection, prompt); err != nil {
 		return realm.App{}, fmt.Errorf("failed to select app: %s", err)
 	}
-	return appsByOption[selection], nil
+
+	if prompt.err != nil {
+		ui.Print(terminal.NewWarningLog("Failed to find the apps of every project: %s", prompt.err))
+	}
+	return prompt.appsByOption[selection], nil
 }
 
 // ResolveGroupID will use the provided MongoDB Cloud Atlas client to resolve the user's group id
@@ -96,6 +96,56 @@ func TestProjectAppInputsResolve(t *testing.T) {
 	}
 }
 
+func TestProjectAppInputsResolveAppArg(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		app         string
+		args        []string
+		expectedApp string
+		expectedErr error
+	}{
+		{
+			description: "should leave the app unset with no args",
+		},
+		{
+			description: "should leave the app flag as is with no args",
+			app:         "flag-app",
+			expectedApp: "flag-app",
+		},
+		{
+			description: "should set the app from the arg",
+			args:        []string{"arg-app"},
+			expectedApp: "arg-app",
+		},
+		{
+			description: "should allow the same app set by both the arg and the flag",
+			app:         "same-app",
+			args:        []string{"same-app"},
+			expectedApp: "same-app",
+		},
+		{
+			description: "should return an error when the arg and the flag differ",
+			app:         "flag-app",
+			args:        []string{"arg-app"},
+			expectedApp: "flag-app",
+			expectedErr: errors.New(`cannot use both the app argument 'arg-app' and "--app" 'flag-app'`),
+		},
+		{
+			description: "should return an error with more than one arg",
+			args:        []string{"app-1", "app-2"},
+			expectedErr: errors.New("accepts at most one app argument, but received 2: app-1, app-2"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			inputs := cli.ProjectInputs{App: tc.app}
+
+			err := inputs.ResolveAppArg(tc.args)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedApp, inputs.App)
+		})
+	}
+}
+
 func TestResolveApp(t *testing.T) {
 	groupID := "groupID"
 	app := realm.App{
@@ -174,6 +224,53 @@ func TestResolveApp(t *testing.T) {
 		})
 	}
 
+	t.Run("Should add the apps found while the user is prompted to select an app", func(t *testing.T) {
+		otherApp := realm.App{
+			ID:          primitive.NewObjectID().Hex(),
+			GroupID:     primitive.NewObjectID().Hex(),
+			ClientAppID: "butternut-abcde",
+			Name:        "butternut",
+		}
+		laterApp := realm.App{
+			ID:          primitive.NewObjectID().Hex(),
+			GroupID:     primitive.NewObjectID().Hex(),
+			ClientAppID: "walnut-abcde",
+			Name:        "walnut",
+		}
+
+		prompted := make(chan struct{})
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsStreamFn = func(filter realm.AppFilter, onApps func(apps []realm.App, pending int)) error {
+			onApps([]realm.App{app, otherApp}, 1)
+			<-prompted
+			onApps([]realm.App{laterApp}, 0)
+			return nil
+		}
+
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		doneCh := make(chan (struct{}))
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Select App (searching 1 more project(s)...)")
+			close(prompted)
+			console.ExpectString("walnut")
+			console.SendLine("wal")
+			console.ExpectEOF()
+		}()
+
+		selected, err := cli.ResolveApp(ui, realmClient, realm.AppFilter{})
+
+		console.Tty().Close() // flush the writers
+		<-doneCh              // wait for procedure to complete
+
+		assert.Nil(t, err)
+		assert.Equal(t, laterApp, selected)
+	})
+
 	t.Run("Should return the client error if one occurs", func(t *testing.T) {
 		realmClient := mock.RealmClient{}
 		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
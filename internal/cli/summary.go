@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// set of supported summary flags
+const (
+	FlagSummary      = "summary"
+	FlagSummaryUsage = `print a final summary line of the command's status, duration, resources affected and warnings to stderr, as JSON with "--output-format json"`
+)
+
+// set of summary statuses
+const (
+	SummaryStatusSucceeded = "succeeded"
+	SummaryStatusFailed    = "failed"
+)
+
+// CommandSummarizer provides access for commands to report the number
+// of resources they created, updated or deleted in the command summary
+type CommandSummarizer interface {
+	ResourcesAffected() int
+}
+
+// Summary is the machine-parsable outcome of a finished command
+type Summary struct {
+	Command           string `json:"command"`
+	Status            string `json:"status"`
+	ExitCode          int    `json:"exitCode"`
+	DurationMillis    int64  `json:"durationMs"`
+	ResourcesAffected *int   `json:"resourcesAffected,omitempty"`
+	Warnings          int    `json:"warnings"`
+}
+
+// newSummary creates the summary of a command which finished with the exit code
+func newSummary(command string, exitCode int, duration time.Duration, resourcesAffected *int, warnings int) Summary {
+	status := SummaryStatusSucceeded
+	if exitCode != 0 {
+		status = SummaryStatusFailed
+	}
+	return Summary{
+		Command:           command,
+		Status:            status,
+		ExitCode:          exitCode,
+		DurationMillis:    duration.Milliseconds(),
+		ResourcesAffected: resourcesAffected,
+		Warnings:          warnings,
+	}
+}
+
+// String returns the summary as a line of key=value pairs,
+// leaving out the resources affected when the command does not report them
+func (s Summary) String() string {
+	pairs := []string{
+		"command=" + strconv.Quote(s.Command),
+		"status=" + s.Status,
+		"exit_code=" + strconv.Itoa(s.ExitCode),
+		"duration_ms=" + strconv.FormatInt(s.DurationMillis, 10),
+	}
+	if s.ResourcesAffected != nil {
+		pairs = append(pairs, "resources_affected="+strconv.Itoa(*s.ResourcesAffected))
+	}
+	pairs = append(pairs, "warnings="+strconv.Itoa(s.Warnings))
+	return "summary: " + strings.Join(pairs, " ")
+}
+
+// print writes the summary as a single line in the output format
+func (s Summary) print(w io.Writer, format terminal.OutputFormat) error {
+	if format != terminal.OutputFormatJSON {
+		_, err := fmt.Fprintln(w, s.String())
+		return err
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
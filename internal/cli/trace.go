@@ -0,0 +1,17 @@
+package cli
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// newTraceID generates the trace id which identifies the requests made by a command
+var newTraceID = func() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%032x", time.Now().UnixNano()) // still unique enough to find the requests
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,24 @@
+package user
+
+import (
+	"fmt"
+)
+
+// keychainService is the service the secrets of the CLI profiles are stored under in the keychain
+const keychainService = "mongodb-realm-cli"
+
+// keychain stores the secrets of the CLI profiles, such as the API keys and session tokens,
+// in the operating system's credential store rather than in the profile's file
+type keychain interface {
+	// get gets the secret stored for the target, and whether one was found
+	get(target string) (string, bool, error)
+	// set stores the secret for the target
+	set(target, secret string) error
+	// delete removes the secret stored for the target, if one was found
+	delete(target string) error
+}
+
+// keychainTarget returns the keychain target of the CLI profile's secret
+func keychainTarget(profile, key string) string {
+	return fmt.Sprintf("%s/%s/%s", keychainService, profile, key)
+}
@@ -0,0 +1,9 @@
+//go:build !windows
+// +build !windows
+
+package user
+
+// newKeychain returns no keychain, so the secrets are stored in the CLI profile's file
+func newKeychain() keychain {
+	return nil
+}
@@ -0,0 +1,118 @@
+//go:build windows
+// +build windows
+
+package user
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	advapi32 = syscall.NewLazyDLL("advapi32.dll")
+
+	procCredReadW   = advapi32.NewProc("CredReadW")
+	procCredWriteW  = advapi32.NewProc("CredWriteW")
+	procCredDeleteW = advapi32.NewProc("CredDeleteW")
+	procCredFree    = advapi32.NewProc("CredFree")
+)
+
+const (
+	credTypeGeneric         = 1
+	credPersistLocalMachine = 2
+
+	errNotFound syscall.Errno = 1168 // ERROR_NOT_FOUND
+)
+
+// credential is the CREDENTIALW structure of the Windows API
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// credentialManager is the keychain of the Windows Credential Manager,
+// which stores each secret as a generic credential of the signed in user
+type credentialManager struct{}
+
+// newKeychain returns the keychain of the Windows Credential Manager
+func newKeychain() keychain {
+	return credentialManager{}
+}
+
+func (credentialManager) get(target string) (string, bool, error) {
+	targetName, err := syscall.UTF16PtrFromString(target)
+	if err != nil {
+		return "", false, err
+	}
+
+	var cred *credential
+	if r, _, err := procCredReadW.Call(
+		uintptr(unsafe.Pointer(targetName)),
+		credTypeGeneric,
+		0,
+		uintptr(unsafe.Pointer(&cred)),
+	); r == 0 {
+		if err == errNotFound {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred))) //nolint:errcheck
+
+	if cred.CredentialBlobSize == 0 {
+		return "", true, nil
+	}
+	blob := (*[1 << 20]byte)(unsafe.Pointer(cred.CredentialBlob))[:cred.CredentialBlobSize:cred.CredentialBlobSize]
+	return string(blob), true, nil
+}
+
+func (credentialManager) set(target, secret string) error {
+	targetName, err := syscall.UTF16PtrFromString(target)
+	if err != nil {
+		return err
+	}
+	userName, err := syscall.UTF16PtrFromString(keychainService)
+	if err != nil {
+		return err
+	}
+
+	blob := []byte(secret)
+
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         targetName,
+		CredentialBlobSize: uint32(len(blob)),
+		Persist:            credPersistLocalMachine,
+		UserName:           userName,
+	}
+	if len(blob) > 0 {
+		cred.CredentialBlob = &blob[0]
+	}
+
+	if r, _, err := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0); r == 0 {
+		return err
+	}
+	return nil
+}
+
+func (credentialManager) delete(target string) error {
+	targetName, err := syscall.UTF16PtrFromString(target)
+	if err != nil {
+		return err
+	}
+
+	if r, _, err := procCredDeleteW.Call(uintptr(unsafe.Pointer(targetName)), credTypeGeneric, 0); r == 0 && err != errNotFound {
+		return err
+	}
+	return nil
+}
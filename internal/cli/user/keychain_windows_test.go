@@ -0,0 +1,52 @@
+//go:build windows
+// +build windows
+
+package user
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCredentialManager(t *testing.T) {
+	target := keychainTarget("keychain-test-"+primitive.NewObjectID().Hex(), keyPrivateAPIKey)
+
+	kc := newKeychain()
+	defer kc.delete(target) //nolint:errcheck
+
+	t.Run("should not find a secret which is not stored", func(t *testing.T) {
+		_, found, err := kc.get(target)
+		assert.Nil(t, err)
+		assert.False(t, found, "expected no secret to be found")
+	})
+
+	t.Run("should get the secret which is stored", func(t *testing.T) {
+		assert.Nil(t, kc.set(target, "private-key"))
+
+		secret, found, err := kc.get(target)
+		assert.Nil(t, err)
+		assert.True(t, found, "expected the secret to be found")
+		assert.Equal(t, "private-key", secret)
+	})
+
+	t.Run("should replace the secret which is stored", func(t *testing.T) {
+		assert.Nil(t, kc.set(target, "new-private-key"))
+
+		secret, _, err := kc.get(target)
+		assert.Nil(t, err)
+		assert.Equal(t, "new-private-key", secret)
+	})
+
+	t.Run("should delete the secret which is stored", func(t *testing.T) {
+		assert.Nil(t, kc.delete(target))
+
+		_, found, err := kc.get(target)
+		assert.Nil(t, err)
+		assert.False(t, found, "expected the secret to be deleted")
+
+		assert.Nil(t, kc.delete(target))
+	})
+}
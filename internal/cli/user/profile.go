@@ -2,10 +2,14 @@ package user
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/10gen/realm-cli/internal/notify"
 	"github.com/10gen/realm-cli/internal/telemetry"
 
 	"github.com/spf13/afero"
@@ -19,6 +23,9 @@ const (
 	// HostingAssetCacheDir is the hosting asset cache dir
 	HostingAssetCacheDir = ".asset-cache"
 
+	// ExportCacheDir is the app export cache dir
+	ExportCacheDir = ".export-cache"
+
 	envPrefix   = "realm"
 	profileType = "yaml"
 
@@ -36,6 +43,15 @@ const (
 	FlagRealmBaseURL      = "realm-url"
 	FlagRealmBaseURLUsage = "specify the base Realm server URL"
 
+	FlagRealmRegionalURL      = "realm-regional-url"
+	FlagRealmRegionalURLUsage = "specify the Realm server URL to make app requests with, instead of discovering the URL of the app's deployment region"
+
+	FlagMaxParallel      = "max-parallel"
+	FlagMaxParallelUsage = "specify the maximum number of requests a command makes concurrently (e.g. when uploading hosting assets or revoking user sessions), defaults to each command's own limit"
+
+	FlagHeader      = "header"
+	FlagHeaderUsage = `specify an additional HTTP header to send with every Realm request as "Name: Value" (e.g. for an authenticating gateway), which can be repeated and overrides the same header of your CLI profile; "Authorization", "Content-Type" and "X-BAAS-*" headers are set by the CLI and cannot be specified`
+
 	defaultAtlasBaseURL = "https://cloud.mongodb.com"
 	defaultRealmBaseURL = "https://realm.mongodb.com"
 )
@@ -46,15 +62,35 @@ type Profile struct {
 	Name             string
 	WorkingDirectory string
 
-	dir string
-	fs  afero.Fs
+	// TraceID identifies the requests made by the running command,
+	// so they can be correlated with the server logs
+	TraceID string
+
+	dir      string
+	fs       afero.Fs
+	headers  http.Header
+	keychain keychain
 }
 
 // Flags are the CLI profile flags
 type Flags struct {
-	AtlasBaseURL  string
-	RealmBaseURL  string
-	TelemetryMode telemetry.Mode
+	AtlasBaseURL     string
+	RealmBaseURL     string
+	RealmRegionalURL string
+	TelemetryMode    telemetry.Mode
+	MaxParallel      int
+	Headers          []string
+	Notify           notify.Target
+	NotifyAfter      time.Duration
+}
+
+// Parallelism returns the number of requests to make concurrently,
+// which is the max parallel flag when set or the provided default otherwise
+func (f Flags) Parallelism(defaultLimit int) int {
+	if f.MaxParallel > 0 {
+		return f.MaxParallel
+	}
+	return defaultLimit
 }
 
 // NewDefaultProfile creates a new default CLI profile
@@ -78,6 +114,7 @@ func NewProfile(name string) (*Profile, error) {
 		Name:             name,
 		dir:              dir,
 		fs:               afero.NewOsFs(),
+		keychain:         newKeychain(),
 		WorkingDirectory: wd,
 	}, nil
 }
@@ -101,6 +138,38 @@ func (p Profile) propertyKey(name string) string {
 	return fmt.Sprintf("%s.%s", p.Name, name)
 }
 
+// getSecret gets the specified CLI profile secret from the keychain, if there is one,
+// otherwise from the profile, where the secrets saved before the keychain was used remain
+func (p Profile) getSecret(name string) string {
+	if p.keychain != nil {
+		if secret, found, err := p.keychain.get(keychainTarget(p.Name, name)); err == nil && found {
+			return secret
+		}
+	}
+	return p.GetString(name)
+}
+
+// setSecret sets the specified CLI profile secret in the keychain, if there is one,
+// and clears it from the profile; the secret is set in the profile instead when
+// there is no keychain or it fails to store the secret
+func (p Profile) setSecret(name, value string) {
+	if p.keychain != nil {
+		target := keychainTarget(p.Name, name)
+
+		var err error
+		if value == "" {
+			err = p.keychain.delete(target)
+		} else {
+			err = p.keychain.set(target, value)
+		}
+		if err == nil {
+			p.Clear(name)
+			return
+		}
+	}
+	p.SetString(name, value)
+}
+
 // Load loads the CLI profile
 func (p Profile) Load() error {
 	viper.SetConfigName(p.Name)
@@ -141,6 +210,27 @@ func (p *Profile) Save() error {
 
 // ResolveFlags resolves the user profile flags
 func (p *Profile) ResolveFlags() error {
+	if p.Flags.MaxParallel < 0 {
+		return fmt.Errorf("--%s must be a positive number", FlagMaxParallel)
+	}
+
+	if p.Flags.NotifyAfter < 0 {
+		return fmt.Errorf("--%s must be a positive duration", notify.FlagAfter)
+	}
+
+	headers := http.Header{}
+	for name, value := range p.Headers() {
+		headers.Set(name, value)
+	}
+	for _, header := range p.Flags.Headers {
+		name, value, err := ParseHeader(header)
+		if err != nil {
+			return fmt.Errorf("--%s is invalid: %w", FlagHeader, err)
+		}
+		headers.Set(name, value)
+	}
+	p.headers = headers
+
 	if p.Flags.TelemetryMode == telemetry.ModeEmpty {
 		p.Flags.TelemetryMode = p.TelemetryMode()
 	}
@@ -188,8 +278,17 @@ const (
 	keyAtlasBaseURL     = "atlas_base_url"
 	keyTelemetryMode    = "telemetry_mode"
 	keyLastVersionCheck = "last_version_check"
+	keyDefaultFlags     = "defaults"
+	keyHeaders          = "headers"
 )
 
+// headerNamePattern matches the valid HTTP header names which can be stored in the
+// CLI profile, excluding "." as it separates the keys of the profile
+var headerNamePattern = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+\\-^_`|~]+$")
+
+// reservedHeaderPrefix is the prefix of the Realm headers, in their canonical form
+const reservedHeaderPrefix = "X-Baas-"
+
 // TelemetryMode gets the CLI profile telemetry mode
 func (p Profile) TelemetryMode() telemetry.Mode {
 	return telemetry.Mode(p.GetString(keyTelemetryMode))
@@ -199,34 +298,34 @@ func (p Profile) TelemetryMode() telemetry.Mode {
 func (p Profile) Credentials() Credentials {
 	return Credentials{
 		p.GetString(keyPublicAPIKey),
-		p.GetString(keyPrivateAPIKey),
+		p.getSecret(keyPrivateAPIKey),
 	}
 }
 
 // SetCredentials sets the CLI profile credentials
 func (p Profile) SetCredentials(creds Credentials) {
 	p.SetString(keyPublicAPIKey, creds.PublicAPIKey)
-	p.SetString(keyPrivateAPIKey, creds.PrivateAPIKey)
+	p.setSecret(keyPrivateAPIKey, creds.PrivateAPIKey)
 }
 
 // Session gets the CLI profile session
 func (p Profile) Session() Session {
 	return Session{
-		p.GetString(keyAccessToken),
-		p.GetString(keyRefreshToken),
+		p.getSecret(keyAccessToken),
+		p.getSecret(keyRefreshToken),
 	}
 }
 
 // SetSession sets the CLI profile session
 func (p Profile) SetSession(session Session) {
-	p.SetString(keyAccessToken, session.AccessToken)
-	p.SetString(keyRefreshToken, session.RefreshToken)
+	p.setSecret(keyAccessToken, session.AccessToken)
+	p.setSecret(keyRefreshToken, session.RefreshToken)
 }
 
 // ClearSession clears the CLI profile session
 func (p Profile) ClearSession() {
-	p.Clear(keyAccessToken)
-	p.Clear(keyRefreshToken)
+	p.setSecret(keyAccessToken, "")
+	p.setSecret(keyRefreshToken, "")
 }
 
 // RealmBaseURL gets the CLI profile Realm base url
@@ -265,7 +364,95 @@ func (p Profile) SetLastVersionCheck(t time.Time) {
 	p.SetString(keyLastVersionCheck, t.Format(time.RFC3339Nano))
 }
 
+// DefaultFlags gets the CLI profile default flag values for the command,
+// where the command is its path of names separated by "." (e.g. apps.list)
+func (p Profile) DefaultFlags(command string) map[string]string {
+	values := viper.GetStringMap(p.propertyKey(keyDefaultFlags + "." + command))
+
+	flags := make(map[string]string, len(values))
+	for name, value := range values {
+		switch v := value.(type) {
+		case nil, map[string]interface{}:
+			continue // skip cleared values and the defaults of subcommands
+		default:
+			if s := fmt.Sprint(v); s != "" {
+				flags[name] = s
+			}
+		}
+	}
+	return flags
+}
+
+// SetDefaultFlag sets the CLI profile default value for the command's flag,
+// an empty value clears the default
+func (p Profile) SetDefaultFlag(command, flag, value string) {
+	p.SetString(fmt.Sprintf("%s.%s.%s", keyDefaultFlags, command, flag), value)
+}
+
+// Headers gets the CLI profile headers to send with every Realm request
+func (p Profile) Headers() map[string]string {
+	values := viper.GetStringMapString(p.propertyKey(keyHeaders))
+
+	headers := make(map[string]string, len(values))
+	for name, value := range values {
+		if value == "" || ReservedHeaderName(name) {
+			continue // skip cleared headers, and reserved headers saved before they were rejected
+		}
+		headers[http.CanonicalHeaderKey(name)] = value
+	}
+	return headers
+}
+
+// SetHeader sets the CLI profile header to send with every Realm request,
+// an empty value clears the header
+func (p Profile) SetHeader(name, value string) {
+	p.SetString(keyHeaders+"."+strings.ToLower(name), value)
+}
+
+// RequestHeaders returns the additional headers to send with every Realm request,
+// which are the CLI profile headers overridden by the header flags once resolved
+func (p Profile) RequestHeaders() http.Header {
+	return p.headers
+}
+
+// ValidHeaderName returns true if the name is a valid header name
+func ValidHeaderName(name string) bool {
+	return headerNamePattern.MatchString(name)
+}
+
+// ReservedHeaderName returns true if the name is a header the CLI sets itself,
+// which are "Authorization", "Content-Type" and the "X-BAAS-" headers
+func ReservedHeaderName(name string) bool {
+	switch name = http.CanonicalHeaderKey(name); name {
+	case "Authorization", "Content-Type":
+		return true
+	}
+	return strings.HasPrefix(name, reservedHeaderPrefix)
+}
+
+// ParseHeader parses a header of the form "Name: Value" into its name and value
+func ParseHeader(header string) (string, string, error) {
+	idx := strings.Index(header, ":")
+	if idx == -1 {
+		return "", "", fmt.Errorf("header '%s' must be of the form 'Name: Value'", header)
+	}
+
+	name, value := strings.TrimSpace(header[:idx]), strings.TrimSpace(header[idx+1:])
+	if !ValidHeaderName(name) {
+		return "", "", fmt.Errorf("header '%s' has an invalid name", header)
+	}
+	if ReservedHeaderName(name) {
+		return "", "", fmt.Errorf("header '%s' is set by the CLI and cannot be specified", header)
+	}
+	return http.CanonicalHeaderKey(name), value, nil
+}
+
 // HostingAssetCachePath returns the CLI profile's hosting asset cache file path
 func (p Profile) HostingAssetCachePath() string {
 	return filepath.Join(p.dir, HostingAssetCacheDir, p.Name+extJSON)
 }
+
+// ExportCachePath returns the CLI profile's export cache directory path for the app
+func (p Profile) ExportCachePath(clientAppID string) string {
+	return filepath.Join(p.dir, ExportCacheDir, p.Name, clientAppID)
+}
@@ -1,7 +1,10 @@
 package user
 
 import (
+	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/telemetry"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
@@ -55,4 +58,203 @@ func TestProfileResolveFlags(t *testing.T) {
 		assert.Equal(t, "https://cloud-dev.mongodb.com", profile.Flags.AtlasBaseURL)
 		assert.Equal(t, "https://cloud-dev.mongodb.com", profile.AtlasBaseURL())
 	})
+
+	t.Run("should fail with a negative max parallel flag", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		profile.Flags.MaxParallel = -1
+
+		assert.Equal(t, errors.New("--max-parallel must be a positive number"), profile.ResolveFlags())
+	})
+
+	t.Run("should return an error with a negative notify after flag", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		profile.Flags.NotifyAfter = -time.Second
+
+		assert.Equal(t, errors.New("--notify-after must be a positive duration"), profile.ResolveFlags())
+	})
+}
+
+func TestProfileResolveHeaders(t *testing.T) {
+	t.Run("should resolve the profile headers overridden by the header flags", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		profile.SetHeader("X-Gateway-Token", "profileToken")
+		profile.SetHeader("X-Gateway-Region", "us-east-1")
+		profile.SetHeader("X-Cleared", "")
+
+		profile.Flags.Headers = []string{"x-gateway-token: flagToken", "X-Extra:  value: with colon "}
+
+		assert.Nil(t, profile.ResolveFlags())
+
+		assert.Equal(t, http.Header{
+			"X-Gateway-Token":  []string{"flagToken"},
+			"X-Gateway-Region": []string{"us-east-1"},
+			"X-Extra":          []string{"value: with colon"},
+		}, profile.RequestHeaders())
+	})
+
+	t.Run("should return an error with an invalid header flag", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		profile.Flags.Headers = []string{"X-Gateway-Token=token"}
+
+		err = profile.ResolveFlags()
+		assert.NotNil(t, err)
+		assert.Equal(t, "--header is invalid: header 'X-Gateway-Token=token' must be of the form 'Name: Value'", err.Error())
+	})
+}
+
+func TestParseHeader(t *testing.T) {
+	for _, tc := range []struct {
+		header        string
+		expectedName  string
+		expectedValue string
+		expectedErr   error
+	}{
+		{header: "X-Gateway-Token: abc123", expectedName: "X-Gateway-Token", expectedValue: "abc123"},
+		{header: "x-gateway-token:abc123", expectedName: "X-Gateway-Token", expectedValue: "abc123"},
+		{header: "X-Empty:", expectedName: "X-Empty"},
+		{header: "X-Gateway-Token", expectedErr: errors.New("header 'X-Gateway-Token' must be of the form 'Name: Value'")},
+		{header: ": abc123", expectedErr: errors.New("header ': abc123' has an invalid name")},
+		{header: "X Gateway: abc123", expectedErr: errors.New("header 'X Gateway: abc123' has an invalid name")},
+		{header: "X.Gateway: abc123", expectedErr: errors.New("header 'X.Gateway: abc123' has an invalid name")},
+		{header: "authorization: Bearer token", expectedErr: errors.New("header 'authorization: Bearer token' is set by the CLI and cannot be specified")},
+		{header: "Content-Type: text/plain", expectedErr: errors.New("header 'Content-Type: text/plain' is set by the CLI and cannot be specified")},
+		{header: "X-BAAS-Request-Origin: browser", expectedErr: errors.New("header 'X-BAAS-Request-Origin: browser' is set by the CLI and cannot be specified")},
+		{header: "X-Baasic: abc123", expectedName: "X-Baasic", expectedValue: "abc123"},
+	} {
+		t.Run("should parse "+tc.header, func(t *testing.T) {
+			name, value, err := ParseHeader(tc.header)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedName, name)
+			assert.Equal(t, tc.expectedValue, value)
+		})
+	}
+}
+
+func TestFlagsParallelism(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		maxParallel int
+		expected    int
+	}{
+		{"should use the default without a max parallel flag", 0, 4},
+		{"should use the max parallel flag when lower than the default", 2, 2},
+		{"should use the max parallel flag when higher than the default", 16, 16},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Flags{MaxParallel: tc.maxParallel}.Parallelism(4))
+		})
+	}
+}
+
+func TestProfileDefaultFlags(t *testing.T) {
+	profile, err := NewProfile(primitive.NewObjectID().Hex())
+	assert.Nil(t, err)
+
+	profile.SetDefaultFlag("push", "include-package-json", "true")
+	profile.SetDefaultFlag("apps.deployments.list", "label", "env=prod")
+	profile.SetDefaultFlag("apps", "yes", "true")
+	profile.SetDefaultFlag("apps", "yes", "")
+
+	t.Run("should get the default flags of a command", func(t *testing.T) {
+		assert.Equal(t, map[string]string{"include-package-json": "true"}, profile.DefaultFlags("push"))
+		assert.Equal(t, map[string]string{"label": "env=prod"}, profile.DefaultFlags("apps.deployments.list"))
+	})
+
+	t.Run("should not include the defaults of subcommands or cleared defaults", func(t *testing.T) {
+		assert.Equal(t, map[string]string{}, profile.DefaultFlags("apps"))
+	})
+
+	t.Run("should get no default flags for a command without any", func(t *testing.T) {
+		assert.Equal(t, map[string]string{}, profile.DefaultFlags("pull"))
+	})
+}
+
+type fakeKeychain struct {
+	secrets map[string]string
+	err     error
+}
+
+func (kc fakeKeychain) get(target string) (string, bool, error) {
+	secret, ok := kc.secrets[target]
+	return secret, ok, kc.err
+}
+
+func (kc fakeKeychain) set(target, secret string) error {
+	if kc.err != nil {
+		return kc.err
+	}
+	kc.secrets[target] = secret
+	return nil
+}
+
+func (kc fakeKeychain) delete(target string) error {
+	if kc.err != nil {
+		return kc.err
+	}
+	delete(kc.secrets, target)
+	return nil
+}
+
+func TestProfileKeychain(t *testing.T) {
+	t.Run("should store the secrets in the keychain rather than the profile", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		kc := fakeKeychain{secrets: map[string]string{}}
+		profile.keychain = kc
+
+		profile.SetCredentials(Credentials{"publicKey", "privateKey"})
+		profile.SetSession(Session{"accessToken", "refreshToken"})
+
+		assert.Equal(t, Credentials{"publicKey", "privateKey"}, profile.Credentials())
+		assert.Equal(t, Session{"accessToken", "refreshToken"}, profile.Session())
+		assert.Equal(t, map[string]string{
+			keychainTarget(profile.Name, keyPrivateAPIKey): "privateKey",
+			keychainTarget(profile.Name, keyAccessToken):   "accessToken",
+			keychainTarget(profile.Name, keyRefreshToken):  "refreshToken",
+		}, kc.secrets)
+
+		assert.Equal(t, "publicKey", profile.GetString(keyPublicAPIKey))
+		for _, key := range []string{keyPrivateAPIKey, keyAccessToken, keyRefreshToken} {
+			assert.Equal(t, "", profile.GetString(key))
+		}
+
+		profile.ClearSession()
+
+		assert.Equal(t, Session{}, profile.Session())
+		assert.Equal(t, map[string]string{keychainTarget(profile.Name, keyPrivateAPIKey): "privateKey"}, kc.secrets)
+	})
+
+	t.Run("should get the secrets from the profile which are not in the keychain", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		profile.SetString(keyAccessToken, "accessToken")
+		profile.SetString(keyRefreshToken, "refreshToken")
+
+		profile.keychain = fakeKeychain{secrets: map[string]string{}}
+
+		assert.Equal(t, Session{"accessToken", "refreshToken"}, profile.Session())
+	})
+
+	t.Run("should store the secrets in the profile when the keychain fails to store them", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		profile.keychain = fakeKeychain{secrets: map[string]string{}, err: errors.New("something bad happened")}
+
+		profile.SetSession(Session{"accessToken", "refreshToken"})
+
+		assert.Equal(t, Session{"accessToken", "refreshToken"}, profile.Session())
+		assert.Equal(t, "accessToken", profile.GetString(keyAccessToken))
+		assert.Equal(t, "refreshToken", profile.GetString(keyRefreshToken))
+	})
 }
@@ -16,6 +16,9 @@ var (
 	// Version represents the CLI version
 	Version = "0.0.0" // value will be injected at build-time
 
+	// Commit represents the commit the CLI was built from
+	Commit string // value will be injected at build-time
+
 	// osArch represents the CLI os architecture; used for locating the correct CLI URL
 	osArch string // value will be injected at build-time
 )
@@ -39,19 +42,43 @@ type VersionManifestClient interface {
 	Get(url string) (*http.Response, error)
 }
 
-// checkVersion looks for and returns a URL for a new CLI version, if one exists
-func checkVersion(client VersionManifestClient) (buildInfo, error) {
+// LatestVersion returns the latest released CLI version
+// along with the URL of its build for the current OS, if one exists
+func LatestVersion(client VersionManifestClient) (string, string, error) {
+	manifest, err := getVersionManifest(client)
+	if err != nil {
+		return "", "", err
+	}
+
+	version, err := parseSemver(manifest.Version)
+	if err != nil {
+		return "", "", err
+	}
+
+	return version.String(), manifest.Info[osArch].URL, nil
+}
+
+func getVersionManifest(client VersionManifestClient) (versionManifest, error) {
 	res, err := client.Get(manifestURL)
 	if err != nil {
-		return buildInfo{}, err
+		return versionManifest{}, err
 	}
 	if res.StatusCode != http.StatusOK {
-		return buildInfo{}, api.ErrUnexpectedStatusCode{"get cli version manifest", res.StatusCode}
+		return versionManifest{}, api.ErrUnexpectedStatusCode{"get cli version manifest", res.StatusCode}
 	}
 	defer res.Body.Close()
 
 	var manifest versionManifest
 	if err := json.NewDecoder(res.Body).Decode(&manifest); err != nil {
+		return versionManifest{}, err
+	}
+	return manifest, nil
+}
+
+// checkVersion looks for and returns a URL for a new CLI version, if one exists
+func checkVersion(client VersionManifestClient) (buildInfo, error) {
+	manifest, err := getVersionManifest(client)
+	if err != nil {
 		return buildInfo{}, err
 	}
 
@@ -84,6 +84,37 @@ func TestVersionCheck(t *testing.T) {
 	})
 }
 
+func TestLatestVersion(t *testing.T) {
+	origOSArch := osArch
+	osArch = "macos-amd64"
+	defer func() { osArch = origOSArch }()
+
+	t.Run("should return the latest version and its url for the current os", func(t *testing.T) {
+		client := testClient{http.StatusOK, "0.1.0", osArch, "http://whatever.com/test"}
+
+		version, url, err := LatestVersion(client)
+		assert.Nil(t, err)
+		assert.Equal(t, "0.1.0", version)
+		assert.Equal(t, "http://whatever.com/test", url)
+	})
+
+	t.Run("should return the latest version without a url for an unrecognized os", func(t *testing.T) {
+		client := testClient{http.StatusOK, "0.1.0", "some-other-arch", "http://whatever.com/test"}
+
+		version, url, err := LatestVersion(client)
+		assert.Nil(t, err)
+		assert.Equal(t, "0.1.0", version)
+		assert.Equal(t, "", url)
+	})
+
+	t.Run("should return an error if the client request fails", func(t *testing.T) {
+		var client failClient
+
+		_, _, err := LatestVersion(client)
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
+
 type testClient struct {
 	statusCode int
 	version    string
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/status"
 	"github.com/10gen/realm-cli/internal/utils/api"
 
 	"github.com/edaniels/digest"
@@ -27,12 +28,22 @@ type Client interface {
 	Clusters(groupID string) ([]Cluster, error)
 	DataLakes(groupID string) ([]DataLake, error)
 
+	SearchIndexes(groupID, clusterName, database, collection string) ([]SearchIndex, error)
+	CreateSearchIndex(groupID, clusterName string, index SearchIndex) (SearchIndex, error)
+	DeleteSearchIndex(groupID, clusterName, indexID string) error
+
+	Events(groupID string, opts EventsOptions) ([]Event, error)
+
+	ProjectUsers(groupID string) ([]ProjectUser, error)
+	ProjectTeams(groupID string) ([]ProjectTeam, error)
+	ProjectAPIKeys(groupID string) ([]ProjectAPIKey, error)
+
 	Status() error
 }
 
 // NewClient returns a new MongoDB Cloud Atlas client
 func NewClient(baseURL string) Client {
-	return &client{baseURL: baseURL}
+	return &client{baseURL: baseURL, status: status.NewClient(status.DefaultBaseURL)}
 }
 
 // NewAuthClient returns a new authenticated MongoDB Cloud Atlas client
@@ -40,12 +51,14 @@ func NewAuthClient(baseURL string, creds user.Credentials) Client {
 	return &client{
 		baseURL:   baseURL,
 		transport: digest.NewTransport(creds.PublicAPIKey, creds.PrivateAPIKey),
+		status:    status.NewClient(status.DefaultBaseURL),
 	}
 }
 
 type client struct {
 	baseURL   string
 	transport *digest.Transport
+	status    status.Client
 }
 
 func (c *client) do(method, path string, options api.RequestOptions) (*http.Response, error) {
@@ -95,5 +108,10 @@ func (c *client) do(method, path string, options api.RequestOptions) (*http.Resp
 		return nil, errForbidden{res.Status}
 	}
 
+	if res.StatusCode >= http.StatusInternalServerError {
+		defer res.Body.Close()
+		return nil, status.CheckIncidents(c.status, errServerError{res.Status})
+	}
+
 	return res, nil
 }
@@ -15,6 +15,16 @@ type Cluster struct {
 	State string `json:"stateName"`
 }
 
+// set of known Atlas cluster states
+const (
+	ClusterStateIdle      = "IDLE"
+	ClusterStateCreating  = "CREATING"
+	ClusterStateUpdating  = "UPDATING"
+	ClusterStateRepairing = "REPAIRING"
+	ClusterStateDeleting  = "DELETING"
+	ClusterStateDeleted   = "DELETED"
+)
+
 type clustersResponse struct {
 	Results []Cluster `json:"results"`
 }
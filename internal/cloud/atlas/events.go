@@ -0,0 +1,93 @@
+package atlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+// Event is an Atlas project activity event
+type Event struct {
+	ID             string    `json:"id"`
+	Created        time.Time `json:"created"`
+	Type           string    `json:"eventTypeName"`
+	Username       string    `json:"username,omitempty"`
+	PublicKey      string    `json:"publicKey,omitempty"`
+	RemoteAddress  string    `json:"remoteAddress,omitempty"`
+	TargetUsername string    `json:"targetUsername,omitempty"`
+}
+
+// Actor returns the user or API key responsible for the event
+func (e Event) Actor() string {
+	if e.Username != "" {
+		return e.Username
+	}
+	return e.PublicKey
+}
+
+// EventsOptions are options to filter the Atlas project events
+type EventsOptions struct {
+	Start time.Time
+	End   time.Time
+}
+
+type eventsResponse struct {
+	Results    []Event `json:"results"`
+	TotalCount int     `json:"totalCount"`
+}
+
+const (
+	eventsPattern = atlasAPI + "/groups/%s/events"
+
+	eventsQueryMinDate      = "minDate"
+	eventsQueryMaxDate      = "maxDate"
+	eventsQueryItemsPerPage = "itemsPerPage"
+	eventsQueryPageNum      = "pageNum"
+
+	eventsPageSize = 500
+)
+
+func (c *client) Events(groupID string, opts EventsOptions) ([]Event, error) {
+	query := map[string]string{eventsQueryItemsPerPage: strconv.Itoa(eventsPageSize)}
+	if !opts.Start.IsZero() {
+		query[eventsQueryMinDate] = opts.Start.UTC().Format(time.RFC3339)
+	}
+	if !opts.End.IsZero() {
+		query[eventsQueryMaxDate] = opts.End.UTC().Format(time.RFC3339)
+	}
+
+	var events []Event
+	for page := 1; ; page++ {
+		query[eventsQueryPageNum] = strconv.Itoa(page)
+
+		res, err := c.do(
+			http.MethodGet,
+			fmt.Sprintf(eventsPattern, groupID),
+			api.RequestOptions{Query: query},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusOK {
+			return nil, api.ErrUnexpectedStatusCode{"get events", res.StatusCode}
+		}
+
+		var eventsRes eventsResponse
+		err = json.NewDecoder(res.Body).Decode(&eventsRes)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, eventsRes.Results...)
+		if len(eventsRes.Results) < eventsPageSize || len(events) >= eventsRes.TotalCount {
+			break
+		}
+	}
+
+	return events, nil
+}
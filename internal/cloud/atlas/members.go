@@ -0,0 +1,147 @@
+package atlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+// Role is an Atlas role assignment
+type Role struct {
+	GroupID  string `json:"groupId,omitempty"`
+	OrgID    string `json:"orgId,omitempty"`
+	RoleName string `json:"roleName"`
+}
+
+// ProjectUser is an Atlas user with access to a project
+type ProjectUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Roles    []Role `json:"roles"`
+}
+
+// ProjectTeam is an Atlas team with access to a project
+type ProjectTeam struct {
+	ID        string   `json:"teamId"`
+	RoleNames []string `json:"roleNames"`
+}
+
+// ProjectAPIKey is an Atlas API key with access to a project
+type ProjectAPIKey struct {
+	ID          string `json:"id"`
+	Description string `json:"desc"`
+	PublicKey   string `json:"publicKey"`
+	Roles       []Role `json:"roles"`
+}
+
+// GroupRoleNames returns the names of the roles assigned for the specified project
+func GroupRoleNames(groupID string, roles []Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role.GroupID == groupID {
+			names = append(names, role.RoleName)
+		}
+	}
+	return names
+}
+
+type pagedResponse struct {
+	Results    []json.RawMessage `json:"results"`
+	TotalCount int               `json:"totalCount"`
+}
+
+const (
+	projectUsersPattern   = atlasAPI + "/groups/%s/users"
+	projectTeamsPattern   = atlasAPI + "/groups/%s/teams"
+	projectAPIKeysPattern = atlasAPI + "/groups/%s/apiKeys"
+
+	queryItemsPerPage = "itemsPerPage"
+	queryPageNum      = "pageNum"
+
+	membersPageSize = 500
+)
+
+func (c *client) ProjectUsers(groupID string) ([]ProjectUser, error) {
+	results, err := c.doPaged(fmt.Sprintf(projectUsersPattern, groupID), "get project users")
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]ProjectUser, 0, len(results))
+	for _, result := range results {
+		var user ProjectUser
+		if err := json.Unmarshal(result, &user); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (c *client) ProjectTeams(groupID string) ([]ProjectTeam, error) {
+	results, err := c.doPaged(fmt.Sprintf(projectTeamsPattern, groupID), "get project teams")
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]ProjectTeam, 0, len(results))
+	for _, result := range results {
+		var team ProjectTeam
+		if err := json.Unmarshal(result, &team); err != nil {
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+	return teams, nil
+}
+
+func (c *client) ProjectAPIKeys(groupID string) ([]ProjectAPIKey, error) {
+	results, err := c.doPaged(fmt.Sprintf(projectAPIKeysPattern, groupID), "get project api keys")
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]ProjectAPIKey, 0, len(results))
+	for _, result := range results {
+		var apiKey ProjectAPIKey
+		if err := json.Unmarshal(result, &apiKey); err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+	return apiKeys, nil
+}
+
+// doPaged fetches every page of results from the specified path
+func (c *client) doPaged(path, operation string) ([]json.RawMessage, error) {
+	query := map[string]string{queryItemsPerPage: strconv.Itoa(membersPageSize)}
+
+	var results []json.RawMessage
+	for page := 1; ; page++ {
+		query[queryPageNum] = strconv.Itoa(page)
+
+		res, err := c.do(http.MethodGet, path, api.RequestOptions{Query: query})
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusOK {
+			return nil, api.ErrUnexpectedStatusCode{operation, res.StatusCode}
+		}
+
+		var pagedRes pagedResponse
+		err = json.NewDecoder(res.Body).Decode(&pagedRes)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pagedRes.Results...)
+		if len(pagedRes.Results) < membersPageSize || len(results) >= pagedRes.TotalCount {
+			break
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,53 @@
+package atlas_test
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestProjectMembers(t *testing.T) {
+	u.SkipUnlessAtlasServerRunning(t)
+
+	for _, tc := range []struct {
+		description string
+		client      atlas.Client
+		expectedErr error
+	}{
+		{
+			description: "without an auth client",
+			client:      atlas.NewClient(u.AtlasServerURL()),
+			expectedErr: atlas.ErrMissingAuth,
+		},
+		{
+			description: "with a client with bad credentials",
+			client:      atlas.NewAuthClient(u.AtlasServerURL(), user.Credentials{"username", "password"}),
+			expectedErr: atlas.ErrUnauthorized{"You are not authorized for this resource."},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, usersErr := tc.client.ProjectUsers(u.CloudGroupID())
+			assert.Equal(t, tc.expectedErr, usersErr)
+
+			_, teamsErr := tc.client.ProjectTeams(u.CloudGroupID())
+			assert.Equal(t, tc.expectedErr, teamsErr)
+
+			_, apiKeysErr := tc.client.ProjectAPIKeys(u.CloudGroupID())
+			assert.Equal(t, tc.expectedErr, apiKeysErr)
+		})
+	}
+}
+
+func TestGroupRoleNames(t *testing.T) {
+	roles := []atlas.Role{
+		{OrgID: "orgID", RoleName: "ORG_MEMBER"},
+		{GroupID: "groupID", RoleName: "GROUP_OWNER"},
+		{GroupID: "otherGroupID", RoleName: "GROUP_READ_ONLY"},
+		{GroupID: "groupID", RoleName: "GROUP_DATA_ACCESS_ADMIN"},
+	}
+
+	assert.Equal(t, []string{"GROUP_OWNER", "GROUP_DATA_ACCESS_ADMIN"}, atlas.GroupRoleNames("groupID", roles))
+}
@@ -0,0 +1,92 @@
+package atlas
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+// SearchIndex is an Atlas Search index
+type SearchIndex struct {
+	ID             string                 `json:"indexID,omitempty"`
+	Name           string                 `json:"name"`
+	Database       string                 `json:"database"`
+	Collection     string                 `json:"collectionName"`
+	Analyzer       string                 `json:"analyzer,omitempty"`
+	SearchAnalyzer string                 `json:"searchAnalyzer,omitempty"`
+	Mappings       map[string]interface{} `json:"mappings"`
+	Status         string                 `json:"status,omitempty"`
+}
+
+const (
+	searchIndexesPattern           = clustersPattern + "/%s/fts/indexes"
+	searchIndexesCollectionPattern = searchIndexesPattern + "/%s/%s"
+	searchIndexPattern             = searchIndexesPattern + "/%s"
+)
+
+func (c *client) SearchIndexes(groupID, clusterName, database, collection string) ([]SearchIndex, error) {
+	res, err := c.do(
+		http.MethodGet,
+		fmt.Sprintf(searchIndexesCollectionPattern, groupID, clusterName, database, collection),
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"get search indexes", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var indexes []SearchIndex
+	if err := json.NewDecoder(res.Body).Decode(&indexes); err != nil {
+		return nil, err
+	}
+
+	return indexes, nil
+}
+
+func (c *client) CreateSearchIndex(groupID, clusterName string, index SearchIndex) (SearchIndex, error) {
+	body, err := json.Marshal(index)
+	if err != nil {
+		return SearchIndex{}, err
+	}
+
+	res, err := c.do(
+		http.MethodPost,
+		fmt.Sprintf(searchIndexesPattern, groupID, clusterName),
+		api.RequestOptions{Body: bytes.NewReader(body), ContentType: api.MediaTypeJSON},
+	)
+	if err != nil {
+		return SearchIndex{}, err
+	}
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return SearchIndex{}, api.ErrUnexpectedStatusCode{"create search index", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var created SearchIndex
+	if err := json.NewDecoder(res.Body).Decode(&created); err != nil {
+		return SearchIndex{}, err
+	}
+
+	return created, nil
+}
+
+func (c *client) DeleteSearchIndex(groupID, clusterName, indexID string) error {
+	res, err := c.do(
+		http.MethodDelete,
+		fmt.Sprintf(searchIndexPattern, groupID, clusterName, indexID),
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"delete search index", res.StatusCode}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package atlas_test
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestSearchIndexes(t *testing.T) {
+	u.SkipUnlessAtlasServerRunning(t)
+
+	for _, tc := range []struct {
+		description string
+		client      atlas.Client
+		expectedErr error
+	}{
+		{
+			description: "without an auth client",
+			client:      atlas.NewClient(u.AtlasServerURL()),
+			expectedErr: atlas.ErrMissingAuth,
+		},
+		{
+			description: "with a client with bad credentials",
+			client:      atlas.NewAuthClient(u.AtlasServerURL(), user.Credentials{"username", "password"}),
+			expectedErr: atlas.ErrUnauthorized{"You are not authorized for this resource."},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := tc.client.SearchIndexes(u.CloudGroupID(), "Cluster0", "db", "coll")
+			assert.Equal(t, tc.expectedErr, err)
+
+			_, err = tc.client.CreateSearchIndex(u.CloudGroupID(), "Cluster0", atlas.SearchIndex{Name: "default", Database: "db", Collection: "coll"})
+			assert.Equal(t, tc.expectedErr, err)
+
+			err = tc.client.DeleteSearchIndex(u.CloudGroupID(), "Cluster0", "indexID")
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
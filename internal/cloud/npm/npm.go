@@ -0,0 +1,85 @@
+package npm
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+// DefaultRegistryURL is the url of the public npm registry
+const DefaultRegistryURL = "https://registry.npmjs.org"
+
+const (
+	// headerAcceptAbbreviated requests the abbreviated package metadata,
+	// which only holds the fields needed to install a package
+	headerAcceptAbbreviated = "application/vnd.npm.install-v1+json"
+
+	distTagLatest = "latest"
+
+	requestTimeout = 30 * time.Second
+)
+
+// Package is the metadata of a package published to the npm registry
+type Package struct {
+	Name     string
+	Latest   string
+	Versions []string
+}
+
+type packageMetadata struct {
+	Name     string                     `json:"name"`
+	DistTags map[string]string          `json:"dist-tags"`
+	Versions map[string]json.RawMessage `json:"versions"`
+}
+
+// Client is a npm registry client
+type Client interface {
+	Package(name string) (Package, error)
+}
+
+// NewClient returns a new npm registry client
+func NewClient(registryURL string) Client {
+	return &client{registryURL}
+}
+
+type client struct {
+	registryURL string
+}
+
+// Package returns the metadata of the named package,
+// where scoped packages are named as "@scope/name"
+func (c *client) Package(name string) (Package, error) {
+	req, err := http.NewRequest(http.MethodGet, c.registryURL+"/"+strings.Replace(name, "/", "%2f", 1), nil)
+	if err != nil {
+		return Package{}, err
+	}
+	req.Header.Set(api.HeaderAccept, headerAcceptAbbreviated)
+
+	httpClient := &http.Client{Timeout: requestTimeout}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return Package{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return Package{}, api.ErrUnexpectedStatusCode{"get package " + name, res.StatusCode}
+	}
+
+	var metadata packageMetadata
+	if err := json.NewDecoder(res.Body).Decode(&metadata); err != nil {
+		return Package{}, err
+	}
+
+	pkg := Package{Name: metadata.Name, Latest: metadata.DistTags[distTagLatest]}
+	for version := range metadata.Versions {
+		pkg.Versions = append(pkg.Versions, version)
+	}
+	sort.Strings(pkg.Versions)
+	return pkg, nil
+}
@@ -0,0 +1,47 @@
+package npm_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/npm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestNPMPackage(t *testing.T) {
+	t.Run("should return the package versions", func(t *testing.T) {
+		var capturedPath, capturedAccept string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			capturedPath, capturedAccept = r.URL.EscapedPath(), r.Header.Get("Accept")
+			w.Write([]byte(`{
+  "name": "@scope/lodash",
+  "dist-tags": {"latest": "4.17.21", "next": "5.0.0-beta.1"},
+  "versions": {"4.17.21": {}, "4.17.20": {}, "5.0.0-beta.1": {}}
+}`))
+		}))
+		defer server.Close()
+
+		pkg, err := npm.NewClient(server.URL).Package("@scope/lodash")
+		assert.Nil(t, err)
+		assert.Equal(t, npm.Package{
+			Name:     "@scope/lodash",
+			Latest:   "4.17.21",
+			Versions: []string{"4.17.20", "4.17.21", "5.0.0-beta.1"},
+		}, pkg)
+
+		assert.Equal(t, "/@scope%2flodash", capturedPath)
+		assert.Equal(t, "application/vnd.npm.install-v1+json", capturedAccept)
+	})
+
+	t.Run("should return an error when the package does not exist", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		_, err := npm.NewClient(server.URL).Package("missing")
+		assert.Equal(t, errors.New("failed to get package missing: unexpected status code 404"), errors.New(err.Error()))
+	})
+}
@@ -7,8 +7,10 @@ import (
 	"math"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/10gen/realm-cli/internal/utils/api"
+	"github.com/10gen/realm-cli/internal/utils/parallel"
 )
 
 const (
@@ -99,6 +101,10 @@ var (
 	defaultProducts = []string{productStandard, productAtlas}
 )
 
+// maxConcurrentGroupRequests is the default maximum number of projects
+// whose apps are fetched at the same time
+const maxConcurrentGroupRequests = 8
+
 func (c *client) FindApps(filter AppFilter) ([]App, error) {
 	var apps []App
 	if filter.GroupID == "" {
@@ -115,17 +121,53 @@ func (c *client) FindApps(filter AppFilter) ([]App, error) {
 		apps = arr
 	}
 
-	if filter.App == "" {
-		return apps, nil
+	return filterApps(apps, filter.App), nil
+}
+
+func (c *client) FindAppsStream(filter AppFilter, onApps func(apps []App, pending int)) error {
+	if filter.GroupID != "" {
+		apps, err := c.getApps(filter.GroupID, filter.Products)
+		if err != nil {
+			return err
+		}
+		onApps(filterApps(apps, filter.App), 0)
+		return nil
+	}
+
+	profile, profileErr := c.AuthProfile()
+	if profileErr != nil {
+		return profileErr
+	}
+
+	groupIDs := profile.AllGroupIDs()
+	pending := len(groupIDs)
+
+	var firstErr error
+	c.findProjectApps(groupIDs, filter.Products, func(i int, apps []App, err error) {
+		pending--
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return
+		}
+		onApps(filterApps(apps, filter.App), pending)
+	})
+	return firstErr
+}
+
+func filterApps(apps []App, app string) []App {
+	if app == "" {
+		return apps
 	}
 
 	var filtered = make([]App, 0, len(apps))
-	for _, app := range apps {
-		if strings.HasPrefix(app.ClientAppID, strings.ToLower(filter.App)) {
-			filtered = append(filtered, app)
+	for _, a := range apps {
+		if strings.HasPrefix(a.ClientAppID, strings.ToLower(app)) {
+			filtered = append(filtered, a)
 		}
 	}
-	return filtered, nil
+	return filtered
 }
 
 func (c *client) getAppsForUser(products []string) ([]App, error) {
@@ -134,17 +176,40 @@ func (c *client) getAppsForUser(products []string) ([]App, error) {
 		return nil, profileErr
 	}
 
+	groupIDs := profile.AllGroupIDs()
+
+	// results are collected by index so apps are returned in project order
+	projectApps := make([][]App, len(groupIDs))
+	projectErrs := make([]error, len(groupIDs))
+
+	c.findProjectApps(groupIDs, products, func(i int, apps []App, err error) {
+		projectApps[i], projectErrs[i] = apps, err
+	})
+
 	var apps []App
-	for _, groupID := range profile.AllGroupIDs() {
-		projectApps, err := c.getApps(groupID, products)
-		if err != nil {
-			return nil, err
+	for i := range groupIDs {
+		if projectErrs[i] != nil {
+			return nil, projectErrs[i]
 		}
-		apps = append(apps, projectApps...)
+		apps = append(apps, projectApps[i]...)
 	}
 	return apps, nil
 }
 
+// findProjectApps fetches the apps of each project concurrently, calling found
+// with the index of each project as soon as its apps are fetched; found is never
+// called concurrently
+func (c *client) findProjectApps(groupIDs []string, products []string, found func(i int, apps []App, err error)) {
+	var mu sync.Mutex
+	parallel.Run(len(groupIDs), c.parallelism(maxConcurrentGroupRequests), func(i int) {
+		apps, err := c.getApps(groupIDs[i], products)
+
+		mu.Lock()
+		defer mu.Unlock()
+		found(i, apps, err)
+	})
+}
+
 func (c *client) getApps(groupID string, products []string) ([]App, error) {
 	allProducts := resolveProducts(products)
 
@@ -0,0 +1,71 @@
+package realm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+const (
+	appSettingsPathPattern = appPathPattern + "/settings"
+)
+
+// AppSettings are the mutable settings of a Realm app
+type AppSettings struct {
+	Name                  string      `json:"name"`
+	Environment           Environment `json:"environment"`
+	AllowedRequestOrigins []string    `json:"allowed_request_origins"`
+	DeletionProtection    bool        `json:"deletion_protection"`
+}
+
+// AppSettingsUpdate is a partial update of the settings of a Realm app,
+// where only the non-nil settings are changed
+type AppSettingsUpdate struct {
+	Name                  *string      `json:"name,omitempty"`
+	Environment           *Environment `json:"environment,omitempty"`
+	AllowedRequestOrigins *[]string    `json:"allowed_request_origins,omitempty"`
+	DeletionProtection    *bool        `json:"deletion_protection,omitempty"`
+}
+
+func (c *client) AppSettings(groupID, appID string) (AppSettings, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(appSettingsPathPattern, groupID, appID),
+		api.RequestOptions{AllowNotFound: true},
+	)
+	if resErr != nil {
+		return AppSettings{}, resErr
+	}
+	if res.StatusCode == http.StatusNotFound {
+		res.Body.Close()
+		return AppSettings{}, ErrAppSettingsNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return AppSettings{}, api.ErrUnexpectedStatusCode{"get app settings", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var settings AppSettings
+	if err := json.NewDecoder(res.Body).Decode(&settings); err != nil {
+		return AppSettings{}, err
+	}
+	return settings, nil
+}
+
+func (c *client) UpdateAppSettings(groupID, appID string, update AppSettingsUpdate) error {
+	res, err := c.doJSON(
+		http.MethodPatch,
+		fmt.Sprintf(appSettingsPathPattern, groupID, appID),
+		update,
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"update app settings", res.StatusCode}
+	}
+	return nil
+}
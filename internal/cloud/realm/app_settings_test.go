@@ -0,0 +1,68 @@
+package realm_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRealmAppSettings(t *testing.T) {
+	u.SkipUnlessRealmServerRunning(t)
+
+	t.Run("should fail without an auth client", func(t *testing.T) {
+		client := realm.NewClient(u.RealmServerURL())
+
+		_, err := client.AppSettings(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex())
+		assert.Equal(t, realm.ErrInvalidSession{}, err)
+
+		deletionProtection := true
+		err = client.UpdateAppSettings(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), realm.AppSettingsUpdate{DeletionProtection: &deletionProtection})
+		assert.Equal(t, realm.ErrInvalidSession{}, err)
+	})
+}
+
+func TestRealmAppSettingsStatus(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		status      int
+		expectedErr error
+	}{
+		{
+			description: "should return app settings not found for a server without app settings",
+			status:      http.StatusNotFound,
+			expectedErr: realm.ErrAppSettingsNotFound,
+		},
+		{
+			description: "should return the server error for any other failure",
+			status:      http.StatusForbidden,
+			expectedErr: realm.ServerError{Message: "forbidden"},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusNotFound {
+					w.Write([]byte(`{"error":"forbidden"}`)) //nolint:errcheck
+				}
+			}))
+			defer server.Close()
+
+			profile, err := user.NewProfile("app-settings-test")
+			assert.Nil(t, err)
+			profile.SetSession(user.Session{AccessToken: "accessToken", RefreshToken: "refreshToken"})
+			defer profile.ClearSession()
+
+			client := realm.NewAuthClient(server.URL, profile)
+
+			_, err = client.AppSettings("groupID", "appID")
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
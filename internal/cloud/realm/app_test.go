@@ -1,8 +1,16 @@
 package realm
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 )
 
@@ -29,3 +37,97 @@ func TestAppResolveProducts(t *testing.T) {
 		}
 	})
 }
+
+func TestAppFindAppsForUser(t *testing.T) {
+	groupIDs := make([]string, 20)
+	for i := range groupIDs {
+		groupIDs[i] = fmt.Sprintf("group%02d", i)
+	}
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == authProfilePath {
+			roles := make([]Role, 0, len(groupIDs))
+			for _, groupID := range groupIDs {
+				roles = append(roles, Role{groupID})
+			}
+			json.NewEncoder(w).Encode(AuthProfile{roles})
+			return
+		}
+
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		groupID := strings.Split(strings.TrimPrefix(r.URL.Path, adminAPI+"/groups/"), "/")[0]
+		if r.URL.Query().Get("product") == productAtlas {
+			json.NewEncoder(w).Encode([]App{})
+			return
+		}
+		json.NewEncoder(w).Encode([]App{{ID: groupID + "-app", GroupID: groupID}})
+	}))
+	defer server.Close()
+
+	profile, err := user.NewProfile("find-apps-test")
+	assert.Nil(t, err)
+	profile.SetSession(user.Session{AccessToken: "accessToken", RefreshToken: "refreshToken"})
+	defer profile.ClearSession()
+
+	client := NewAuthClient(server.URL, profile)
+
+	t.Run("should find the apps of every project in project order", func(t *testing.T) {
+		apps, err := client.FindApps(AppFilter{})
+		assert.Nil(t, err)
+
+		expected := make([]App, 0, len(groupIDs))
+		for _, groupID := range groupIDs {
+			expected = append(expected, App{ID: groupID + "-app", GroupID: groupID})
+		}
+		assert.Equal(t, expected, apps)
+	})
+
+	t.Run("should stream the apps of each project as they are found", func(t *testing.T) {
+		var found []App
+		var pendings []int
+
+		err := client.FindAppsStream(AppFilter{}, func(apps []App, pending int) {
+			found = append(found, apps...)
+			pendings = append(pendings, pending)
+		})
+		assert.Nil(t, err)
+
+		assert.Equal(t, len(groupIDs), len(found))
+		assert.Equal(t, len(groupIDs), len(pendings))
+		for i, pending := range pendings {
+			assert.Equal(t, len(groupIDs)-i-1, pending)
+		}
+	})
+
+	t.Run("should fetch the projects concurrently with a bounded number of requests", func(t *testing.T) {
+		assert.True(t, maxInFlight > 1, "expected projects to be fetched concurrently")
+		assert.True(t, maxInFlight <= maxConcurrentGroupRequests, "expected at most %d concurrent requests, but got %d", maxConcurrentGroupRequests, maxInFlight)
+	})
+
+	t.Run("should bound the concurrent requests by the max parallel flag", func(t *testing.T) {
+		profile.Flags.MaxParallel = 2
+		defer func() { profile.Flags.MaxParallel = 0 }()
+
+		maxInFlight = 0
+
+		_, err := client.FindApps(AppFilter{})
+		assert.Nil(t, err)
+
+		assert.True(t, maxInFlight <= 2, "expected at most 2 concurrent requests, but got %d", maxInFlight)
+	})
+}
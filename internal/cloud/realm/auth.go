@@ -11,6 +11,9 @@ const (
 	authenticatePath = adminAPI + "/auth/providers/mongodb-cloud/login"
 	authProfilePath  = adminAPI + "/auth/profile"
 	authSessionPath  = adminAPI + "/auth/session"
+
+	authorizeDevicePath    = adminAPI + "/auth/providers/mongodb-cloud/device/authorize"
+	authenticateDevicePath = adminAPI + "/auth/providers/mongodb-cloud/device/token"
 )
 
 // Session is the Realm session
@@ -46,6 +49,63 @@ func (c *client) Authenticate(publicAPIKey, privateAPIKey string) (Session, erro
 	return session, nil
 }
 
+// DeviceAuthorization is the authorization for the CLI to be logged in
+// once the user enters its user code in the browser
+type DeviceAuthorization struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+type authenticateDeviceRequest struct {
+	DeviceCode string `json:"device_code"`
+}
+
+func (c *client) AuthorizeDevice() (DeviceAuthorization, error) {
+	res, resErr := c.do(
+		http.MethodPost,
+		authorizeDevicePath,
+		api.RequestOptions{NoAuth: true, PreventRefresh: true},
+	)
+	if resErr != nil {
+		return DeviceAuthorization{}, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return DeviceAuthorization{}, api.ErrUnexpectedStatusCode{"authorize device", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var authorization DeviceAuthorization
+	if err := json.NewDecoder(res.Body).Decode(&authorization); err != nil {
+		return DeviceAuthorization{}, err
+	}
+	return authorization, nil
+}
+
+func (c *client) AuthenticateDevice(deviceCode string) (Session, error) {
+	res, resErr := c.doJSON(
+		http.MethodPost,
+		authenticateDevicePath,
+		authenticateDeviceRequest{deviceCode},
+		api.RequestOptions{NoAuth: true, PreventRefresh: true},
+	)
+	if resErr != nil {
+		return Session{}, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return Session{}, api.ErrUnexpectedStatusCode{"authenticate device", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var session Session
+	if err := json.NewDecoder(res.Body).Decode(&session); err != nil {
+		return Session{}, err
+	}
+	return session, nil
+}
+
 // AuthProfile is the user's profile
 type AuthProfile struct {
 	Roles []Role `json:"roles"`
@@ -82,7 +142,10 @@ func (c *client) getAuthToken(options api.RequestOptions) (string, error) {
 			return "", ErrInvalidSession{}
 		}
 
+		c.sessionMu.RLock()
 		session := c.profile.Session()
+		c.sessionMu.RUnlock()
+
 		if requiresRefreshToken {
 			if session.RefreshToken == "" {
 				return "", ErrInvalidSession{}
@@ -120,6 +183,9 @@ func (c *client) refreshAuth() error {
 		return err
 	}
 
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
 	session := c.profile.Session()
 	session.AccessToken = s.AccessToken
 	c.profile.SetSession(session)
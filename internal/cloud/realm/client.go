@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"sync"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/status"
 	"github.com/10gen/realm-cli/internal/utils/api"
 )
 
@@ -17,12 +19,17 @@ const (
 
 	requestOriginHeader = "X-BAAS-Request-Origin"
 	cliHeaderValue      = "mongodb-baas-cli"
+
+	// traceIDHeader carries the trace id of the command making the request
+	traceIDHeader = "X-BAAS-Trace-Id"
 )
 
 // Client is a Realm client
 type Client interface {
 	AuthProfile() (AuthProfile, error)
 	Authenticate(publicAPIKey, privateAPIKey string) (Session, error)
+	AuthorizeDevice() (DeviceAuthorization, error)
+	AuthenticateDevice(deviceCode string) (Session, error)
 
 	Export(groupID, appID string, req ExportRequest) (string, *zip.Reader, error)
 	ExportDependencies(groupID, appID string) (string, io.ReadCloser, error)
@@ -30,18 +37,28 @@ type Client interface {
 	ImportDependencies(groupID, appID, uploadPath string) error
 	Diff(groupID, appID string, appData interface{}) ([]string, error)
 	DiffDependencies(groupID, appID, uploadPath string) (DependenciesDiff, error)
+	DependenciesStatus(groupID, appID string) (DependenciesStatus, error)
 
 	CreateApp(groupID, name string, meta AppMeta) (App, error)
 	DeleteApp(groupID, appID string) error
 	FindApps(filter AppFilter) ([]App, error)
+	FindAppsStream(filter AppFilter, onApps func(apps []App, pending int)) error
 	AppDescription(groupID, appID string) (AppDescription, error)
+	AppSettings(groupID, appID string) (AppSettings, error)
+	UpdateAppSettings(groupID, appID string, update AppSettingsUpdate) error
+
+	DeployConfig(groupID, appID string) (DeployConfig, error)
+	UpdateDeployConfig(groupID, appID string, config DeployConfig) error
+	GitHubInstallations(groupID, appID string) ([]GitHubInstallation, error)
 
 	CreateDraft(groupID, appID string) (AppDraft, error)
-	DeployDraft(groupID, appID, draftID string) (AppDeployment, error)
+	DeployDraft(groupID, appID, draftID string, opts DeployDraftOptions) (AppDeployment, error)
 	DiffDraft(groupID, appID, draftID string) (AppDraftDiff, error)
 	DiscardDraft(groupID, appID, draftID string) error
 	Deployments(groupID, appID string) ([]AppDeployment, error)
 	Deployment(groupID, appID, deploymentID string) (AppDeployment, error)
+	DeploymentHistory(groupID, appID string, opts DeploymentHistoryOptions) ([]AppDeployment, error)
+	RedeployDeployment(groupID, appID, deploymentID string) (AppDeployment, error)
 	Draft(groupID, appID string) (AppDraft, error)
 
 	Secrets(groupID, appID string) ([]Secret, error)
@@ -49,13 +66,44 @@ type Client interface {
 	DeleteSecret(groupID, appID, secretID string) error
 	UpdateSecret(groupID, appID, secretID, name, value string) error
 
+	Values(groupID, appID string) ([]Value, error)
+	CreateValue(groupID, appID string, value Value) (Value, error)
+	DeleteValue(groupID, appID, valueID string) error
+	UpdateValue(groupID, appID string, value Value) error
+
+	Services(groupID, appID string) ([]Service, error)
+	Rules(groupID, appID, serviceID string) ([]Rule, error)
+	CreateRule(groupID, appID, serviceID string, rule Rule) (Rule, error)
+	DeleteRule(groupID, appID, serviceID, ruleID string) error
+	DefaultRule(groupID, appID, serviceID string) (DefaultRule, bool, error)
+	CreateDefaultRule(groupID, appID, serviceID string, defaultRule DefaultRule) (DefaultRule, error)
+	UpdateDefaultRule(groupID, appID, serviceID string, defaultRule DefaultRule) error
+	EvaluateRule(groupID, appID, serviceID string, evaluation RuleEvaluation) (RuleEvaluationResult, error)
+	SyncConfig(groupID, appID, serviceID string) (SyncConfig, error)
+	UpdateSyncConfig(groupID, appID, serviceID string, config SyncConfig) error
+	SyncProgress(groupID, appID string) (SyncProgress, error)
+	SyncClients(groupID, appID, userID string) ([]SyncClient, error)
+	SyncClientReset(groupID, appID, userID string) error
+
+	Triggers(groupID, appID string) ([]Trigger, error)
+	UpdateTrigger(groupID, appID string, trigger Trigger) error
+	ResumeTrigger(groupID, appID, triggerID string, disableToken bool) error
+
 	CreateAPIKey(groupID, appID, apiKeyName string) (APIKey, error)
+	ConfirmPendingUser(groupID, appID, email string) error
 	CreateUser(groupID, appID, email, password string) (User, error)
+	DeletePendingUser(groupID, appID, email string) error
 	DeleteUser(groupID, appID, userID string) error
 	DisableUser(groupID, appID, userID string) error
 	EnableUser(groupID, appID, userID string) error
 	FindUsers(groupID, appID string, filter UserFilter) ([]User, error)
+	RevokeAllUserSessions(groupID, appID string, filter UserFilter, onProgress func(processed, total int)) ([]UserSessionsRevocation, error)
+	RevokeUserDevice(groupID, appID, userID, deviceID string) error
 	RevokeUserSessions(groupID, appID, userID string) error
+	SendUserPasswordReset(groupID, appID, email string) error
+	UpdateUserEmail(groupID, appID, userID, email string) error
+	UpdateUserPassword(groupID, appID, userID, password string) error
+	UserDevices(groupID, appID, userID string) ([]UserDevice, error)
 
 	HostingAssets(groupID, appID string) ([]HostingAsset, error)
 	HostingAssetUpload(groupID, appID, rootDir string, asset HostingAsset) error
@@ -64,28 +112,55 @@ type Client interface {
 	HostingCacheInvalidate(groupID, appID, path string) error
 
 	Functions(groupID, appID string) ([]Function, error)
+	Function(groupID, appID, functionID string) (FunctionDetails, error)
 	AppDebugExecuteFunction(groupID, appID, userID, name string, args []interface{}) (ExecutionResults, error)
+	AppDebugExecuteFunctionSource(groupID, appID, userID, source, evalSource string) (ExecutionResults, error)
 
 	Logs(groupID, appID string, opts LogsOptions) (Logs, error)
 
 	SchemaModels(groupID, appID, language string) ([]SchemaModel, error)
+	Schemas(groupID, appID string) ([]Schema, error)
+	UpdateSchema(groupID, appID string, schema Schema) error
+	SchemaVersions(groupID, appID string) ([]SchemaVersion, error)
+	SchemaVersion(groupID, appID string, version int) ([]Schema, error)
+	ValidateSchemaData(groupID, appID string, schema Schema, opts SchemaValidationOptions) (SchemaValidationResult, error)
+	GenerateSchema(groupID, appID string, metadata SchemaMetadata, opts SchemaGenerationOptions) (map[string]interface{}, error)
 
 	Status() error
 }
 
 // NewClient creates a new Realm client
 func NewClient(baseURL string) Client {
-	return &client{baseURL, nil}
+	return &client{baseURL: baseURL, status: status.NewClient(status.DefaultBaseURL)}
 }
 
 // NewAuthClient creates a new Realm client capable of managing the user's session
 func NewAuthClient(baseURL string, profile *user.Profile) Client {
-	return &client{baseURL, profile}
+	return &client{baseURL: baseURL, profile: profile, status: status.NewClient(status.DefaultBaseURL)}
 }
 
 type client struct {
 	baseURL string
 	profile *user.Profile
+	status  status.Client
+
+	// sessionMu guards the profile session, which may be read
+	// and refreshed by requests made concurrently
+	sessionMu sync.RWMutex
+
+	// regionsMu guards the base urls discovered for each app,
+	// but is not held while they are discovered
+	regionsMu   sync.Mutex
+	appBaseURLs map[string]*appBaseURL
+}
+
+// parallelism returns the number of requests to make concurrently,
+// honoring the profile's max parallel flag when there is a profile
+func (c *client) parallelism(defaultLimit int) int {
+	if c.profile == nil {
+		return defaultLimit
+	}
+	return c.profile.Flags.Parallelism(defaultLimit)
 }
 
 func (c *client) doJSON(method, path string, payload interface{}, options api.RequestOptions) (*http.Response, error) {
@@ -101,15 +176,32 @@ func (c *client) doJSON(method, path string, payload interface{}, options api.Re
 }
 
 func (c *client) do(method, path string, options api.RequestOptions) (*http.Response, error) {
-	req, err := http.NewRequest(method, c.baseURL+path, options.Body)
+	return c.doWithBaseURL(c.requestBaseURL(path, options), method, path, options)
+}
+
+func (c *client) doWithBaseURL(baseURL, method, path string, options api.RequestOptions) (*http.Response, error) {
+	req, err := http.NewRequest(method, baseURL+path, options.Body)
 	if err != nil {
 		return nil, err
 	}
 
 	api.IncludeQuery(req, options.Query)
 
+	// the additional headers are set first, so they never override those required by the CLI
+	if c.profile != nil {
+		for name, values := range c.profile.RequestHeaders() {
+			for _, value := range values {
+				req.Header.Add(name, value)
+			}
+		}
+	}
+
 	req.Header.Set(requestOriginHeader, cliHeaderValue)
 
+	if c.profile != nil && c.profile.TraceID != "" {
+		req.Header.Set(traceIDHeader, c.profile.TraceID)
+	}
+
 	if options.ContentType != "" {
 		req.Header.Set(api.HeaderContentType, options.ContentType)
 	}
@@ -130,9 +222,15 @@ func (c *client) do(method, path string, options api.RequestOptions) (*http.Resp
 	if res.StatusCode >= 200 && res.StatusCode <= 299 {
 		return res, nil
 	}
+	if res.StatusCode == http.StatusNotFound && options.AllowNotFound {
+		return res, nil
+	}
 	defer res.Body.Close()
 
 	parsedErr := parseResponseError(res)
+	if res.StatusCode >= http.StatusInternalServerError {
+		return nil, status.CheckIncidents(c.status, parsedErr)
+	}
 	if err, ok := parsedErr.(ServerError); !ok {
 		return nil, parsedErr
 	} else if options.PreventRefresh || err.Code != errCodeInvalidSession {
@@ -140,6 +238,9 @@ func (c *client) do(method, path string, options api.RequestOptions) (*http.Resp
 	}
 
 	if refreshErr := c.refreshAuth(); refreshErr != nil {
+		c.sessionMu.Lock()
+		defer c.sessionMu.Unlock()
+
 		c.profile.ClearSession()
 		if err := c.profile.Save(); err != nil {
 			return nil, ErrInvalidSession{}
@@ -149,5 +250,5 @@ func (c *client) do(method, path string, options api.RequestOptions) (*http.Resp
 
 	options.PreventRefresh = true
 
-	return c.do(method, path, options)
+	return c.doWithBaseURL(baseURL, method, path, options)
 }
@@ -0,0 +1,99 @@
+package realm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/utils/api"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestClientTraceID(t *testing.T) {
+	var traceIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceIDs = append(traceIDs, r.Header.Get(traceIDHeader))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	profile, err := user.NewProfile("trace-test")
+	assert.Nil(t, err)
+
+	t.Run("should set the trace id header of the profile on every request", func(t *testing.T) {
+		traceIDs = nil
+		profile.TraceID = "0123456789abcdef0123456789abcdef"
+
+		c := &client{baseURL: server.URL, profile: profile}
+
+		for i := 0; i < 2; i++ {
+			_, err := c.do(http.MethodGet, statusPath, api.RequestOptions{NoAuth: true})
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, []string{"0123456789abcdef0123456789abcdef", "0123456789abcdef0123456789abcdef"}, traceIDs)
+	})
+
+	t.Run("should not set the trace id header without a trace id", func(t *testing.T) {
+		traceIDs = nil
+		profile.TraceID = ""
+
+		c := &client{baseURL: server.URL, profile: profile}
+
+		_, err := c.do(http.MethodGet, statusPath, api.RequestOptions{NoAuth: true})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{""}, traceIDs)
+	})
+}
+
+func TestClientRequestHeaders(t *testing.T) {
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	profile, err := user.NewProfile(primitive.NewObjectID().Hex())
+	assert.Nil(t, err)
+
+	profile.SetSession(user.Session{AccessToken: "accessToken"})
+	profile.Flags.Headers = []string{"X-Gateway-Token: abc123"}
+	assert.Nil(t, profile.ResolveFlags())
+
+	c := &client{baseURL: server.URL, profile: profile}
+
+	t.Run("should set the additional headers on every request", func(t *testing.T) {
+		headers = nil
+
+		for i := 0; i < 2; i++ {
+			_, err := c.do(http.MethodGet, statusPath, api.RequestOptions{})
+			assert.Nil(t, err)
+		}
+
+		assert.Equal(t, 2, len(headers))
+		for _, header := range headers {
+			assert.Equal(t, "abc123", header.Get("X-Gateway-Token"))
+		}
+	})
+
+	t.Run("should set the headers required by the cli alongside them", func(t *testing.T) {
+		headers = nil
+
+		_, err := c.do(http.MethodGet, statusPath, api.RequestOptions{})
+		assert.Nil(t, err)
+
+		assert.Equal(t, 1, len(headers))
+		assert.Equal(t, []string{"Bearer accessToken"}, headers[0].Values(api.HeaderAuthorization))
+		assert.Equal(t, []string{cliHeaderValue}, headers[0].Values(requestOriginHeader))
+	})
+
+	t.Run("should not allow the headers set by the cli to be specified", func(t *testing.T) {
+		for _, header := range []string{"Authorization: Bearer gatewayToken", requestOriginHeader + ": gateway"} {
+			profile.Flags.Headers = []string{header}
+			assert.NotNil(t, profile.ResolveFlags())
+		}
+	})
+}
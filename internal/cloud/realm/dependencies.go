@@ -18,10 +18,27 @@ const (
 	dependenciesPathPattern        = appPathPattern + "/dependencies"
 	dependenciesArchivePathPattern = dependenciesPathPattern + "/archive"
 	dependenciesDiffPathPattern    = dependenciesPathPattern + "/diff"
+	dependenciesStatusPathPattern  = dependenciesPathPattern + "/status"
 
 	paramFile = "file"
 )
 
+// DependenciesStatus is the installation status of the app's dependencies
+type DependenciesStatus struct {
+	State   DependenciesState `json:"status"`
+	Message string            `json:"status_message,omitempty"`
+}
+
+// DependenciesState is the state of a dependencies installation
+type DependenciesState string
+
+// set of known dependencies installation states
+const (
+	DependenciesStateCreated    DependenciesState = "created"
+	DependenciesStateSuccessful DependenciesState = "successful"
+	DependenciesStateFailed     DependenciesState = "failed"
+)
+
 func (c *client) ImportDependencies(groupID, appID, uploadPath string) error {
 	file, fileErr := os.Open(uploadPath)
 	if fileErr != nil {
@@ -138,3 +155,24 @@ func (c *client) DiffDependencies(groupID, appID, uploadPath string) (Dependenci
 
 	return diff, nil
 }
+
+func (c *client) DependenciesStatus(groupID, appID string) (DependenciesStatus, error) {
+	res, err := c.do(
+		http.MethodGet,
+		fmt.Sprintf(dependenciesStatusPathPattern, groupID, appID),
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return DependenciesStatus{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return DependenciesStatus{}, api.ErrUnexpectedStatusCode{"get dependencies status", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var status DependenciesStatus
+	if err := json.NewDecoder(res.Body).Decode(&status); err != nil {
+		return DependenciesStatus{}, err
+	}
+	return status, nil
+}
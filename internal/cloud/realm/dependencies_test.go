@@ -57,6 +57,12 @@ func TestRealmDependencies(t *testing.T) {
 		})
 	})
 
+	t.Run("should report the dependencies installation status", func(t *testing.T) {
+		status, err := client.DependenciesStatus(groupID, app.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, realm.DependenciesStateSuccessful, status.State)
+	})
+
 	t.Run("should successfully export a zip node_modules archive", func(t *testing.T) {
 		tmpDir, teardown, tmpDirErr := u.NewTempDir("dependencies")
 		assert.Nil(t, tmpDirErr)
@@ -0,0 +1,108 @@
+package realm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+const (
+	deployConfigPathPattern        = appPathPattern + "/deploy/config"
+	deployInstallationsPathPattern = appPathPattern + "/deploy/installations"
+
+	// GitHubAppInstallURL is the url to install the Realm GitHub app,
+	// which authorizes Realm to deploy apps from the repositories it has access to
+	GitHubAppInstallURL = "https://github.com/apps/mongodb-realm/installations/new"
+)
+
+// DeployProvider is a provider of automatic deployments
+type DeployProvider string
+
+// set of supported deploy providers
+const (
+	DeployProviderGitHub DeployProvider = "github"
+)
+
+// DeployConfig is the deployment configuration of a Realm app
+type DeployConfig struct {
+	UIDraftsDisabled    bool                      `json:"ui_drafts_disabled"`
+	AutomaticDeployment AutomaticDeploymentConfig `json:"automatic_deployment"`
+}
+
+// AutomaticDeploymentConfig is the configuration of a Realm app's automatic deployments,
+// which deploy the app whenever changes are made to it in a repository
+type AutomaticDeploymentConfig struct {
+	Enabled    bool           `json:"enabled"`
+	Provider   DeployProvider `json:"provider,omitempty"`
+	Repository string         `json:"repository,omitempty"`
+	Branch     string         `json:"branch,omitempty"`
+	Directory  string         `json:"directory,omitempty"`
+}
+
+// GitHubInstallation is an installation of the Realm GitHub app for a GitHub account
+type GitHubInstallation struct {
+	ID           string   `json:"installation_id"`
+	Owner        string   `json:"owner"`
+	AllRepos     bool     `json:"all_repositories"`
+	Repositories []string `json:"repositories"`
+}
+
+func (c *client) DeployConfig(groupID, appID string) (DeployConfig, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(deployConfigPathPattern, groupID, appID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return DeployConfig{}, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return DeployConfig{}, api.ErrUnexpectedStatusCode{"get deploy config", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var config DeployConfig
+	if err := json.NewDecoder(res.Body).Decode(&config); err != nil {
+		return DeployConfig{}, err
+	}
+	return config, nil
+}
+
+func (c *client) UpdateDeployConfig(groupID, appID string, config DeployConfig) error {
+	res, err := c.doJSON(
+		http.MethodPatch,
+		fmt.Sprintf(deployConfigPathPattern, groupID, appID),
+		config,
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"update deploy config", res.StatusCode}
+	}
+	return nil
+}
+
+func (c *client) GitHubInstallations(groupID, appID string) ([]GitHubInstallation, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(deployInstallationsPathPattern, groupID, appID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return nil, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"get github installations", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var installations []GitHubInstallation
+	if err := json.NewDecoder(res.Body).Decode(&installations); err != nil {
+		return nil, err
+	}
+	return installations, nil
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/10gen/realm-cli/internal/utils/api"
 )
@@ -11,12 +13,51 @@ import (
 const (
 	deploymentsPathPattern = appPathPattern + "/deployments"
 	deploymentPathPattern  = deploymentsPathPattern + "/%s"
+
+	deploymentRedeployPathPattern = deploymentPathPattern + "/redeploy"
+
+	deploymentsQueryBefore = "before"
 )
 
 // AppDeployment is a Realm app deployment
 type AppDeployment struct {
-	ID     string           `json:"_id"`
-	Status DeploymentStatus `json:"status"`
+	ID                 string            `json:"_id"`
+	DraftID            string            `json:"draft_id,omitempty"`
+	UserID             string            `json:"user_id,omitempty"`
+	DeployedAt         int64             `json:"deployed_at,omitempty"`
+	Origin             DeploymentOrigin  `json:"origin,omitempty"`
+	Commit             string            `json:"commit,omitempty"`
+	Status             DeploymentStatus  `json:"status"`
+	StatusErrorMessage string            `json:"status_error_message,omitempty"`
+	Comment            string            `json:"comment,omitempty"`
+	Labels             map[string]string `json:"labels,omitempty"`
+}
+
+// HasLabels returns true if the deployment has each of the provided labels
+func (d AppDeployment) HasLabels(labels map[string]string) bool {
+	for key, value := range labels {
+		if v, ok := d.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseDeploymentLabels parses the provided key=value pairs into deployment labels
+func ParseDeploymentLabels(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	labels := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		idx := strings.Index(pair, "=")
+		if idx < 1 {
+			return nil, fmt.Errorf("invalid label '%s', labels must be of the form key=value", pair)
+		}
+		labels[pair[:idx]] = pair[idx+1:]
+	}
+	return labels, nil
 }
 
 // DeploymentStatus is the Realm application deployment status
@@ -30,6 +71,21 @@ const (
 	DeploymentStatusPending    DeploymentStatus = "pending"
 )
 
+// DeploymentOrigin is the origin a Realm application deployment was created from
+type DeploymentOrigin string
+
+// set of known deployment origins
+const (
+	DeploymentOriginCLI    DeploymentOrigin = "CLI"
+	DeploymentOriginUI     DeploymentOrigin = "UI"
+	DeploymentOriginGitHub DeploymentOrigin = "GitHub"
+)
+
+// DeploymentHistoryOptions are options to page through the deployment history
+type DeploymentHistoryOptions struct {
+	Limit int
+}
+
 func (c *client) Deployments(groupID, appID string) ([]AppDeployment, error) {
 	res, resErr := c.do(
 		http.MethodGet,
@@ -71,3 +127,71 @@ func (c *client) Deployment(groupID, appID, deploymentID string) (AppDeployment,
 	}
 	return deployment, nil
 }
+
+func (c *client) DeploymentHistory(groupID, appID string, opts DeploymentHistoryOptions) ([]AppDeployment, error) {
+	options := api.RequestOptions{Query: make(map[string]string)}
+
+	// the server returns the most recent deployments a page at a time,
+	// each page continuing before the last deployment time of the previous one
+	var deployments []AppDeployment
+	for opts.Limit <= 0 || len(deployments) < opts.Limit {
+		page, err := c.getDeploymentsPage(groupID, appID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page) == 0 {
+			break
+		}
+		deployments = append(deployments, page...)
+
+		before := strconv.FormatInt(page[len(page)-1].DeployedAt, 10)
+		if before == options.Query[deploymentsQueryBefore] {
+			break
+		}
+		options.Query[deploymentsQueryBefore] = before
+	}
+
+	if opts.Limit > 0 && len(deployments) > opts.Limit {
+		deployments = deployments[:opts.Limit]
+	}
+	return deployments, nil
+}
+
+func (c *client) getDeploymentsPage(groupID, appID string, options api.RequestOptions) ([]AppDeployment, error) {
+	res, resErr := c.do(http.MethodGet, fmt.Sprintf(deploymentsPathPattern, groupID, appID), options)
+	if resErr != nil {
+		return nil, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"get deployments", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var deployments []AppDeployment
+	if err := json.NewDecoder(res.Body).Decode(&deployments); err != nil {
+		return nil, err
+	}
+	return deployments, nil
+}
+
+func (c *client) RedeployDeployment(groupID, appID, deploymentID string) (AppDeployment, error) {
+	res, resErr := c.do(
+		http.MethodPost,
+		fmt.Sprintf(deploymentRedeployPathPattern, groupID, appID, deploymentID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return AppDeployment{}, resErr
+	}
+	if res.StatusCode != http.StatusCreated {
+		return AppDeployment{}, api.ErrUnexpectedStatusCode{"redeploy deployment", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var deployment AppDeployment
+	if err := json.NewDecoder(res.Body).Decode(&deployment); err != nil {
+		return AppDeployment{}, err
+	}
+	return deployment, nil
+}
@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cloud/realm"
@@ -18,6 +19,12 @@ func TestRealmDeployments(t *testing.T) {
 
 		_, err := client.Deployment(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex())
 		assert.Equal(t, realm.ErrInvalidSession{}, err)
+
+		_, err = client.DeploymentHistory(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), realm.DeploymentHistoryOptions{})
+		assert.Equal(t, realm.ErrInvalidSession{}, err)
+
+		_, err = client.RedeployDeployment(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex())
+		assert.Equal(t, realm.ErrInvalidSession{}, err)
 	})
 
 	t.Run("with an active session", func(t *testing.T) {
@@ -37,7 +44,7 @@ func TestRealmDeployments(t *testing.T) {
 		})
 
 		t.Run("should be able to deploy an existing draft", func(t *testing.T) {
-			deployment, deploymentErr := client.DeployDraft(groupID, app.ID, draft.ID)
+			deployment, deploymentErr := client.DeployDraft(groupID, app.ID, draft.ID, realm.DeployDraftOptions{})
 			assert.Nil(t, deploymentErr)
 
 			assert.True(t, deployment.ID != "", "deployment id should not be empty")
@@ -51,7 +58,33 @@ func TestRealmDeployments(t *testing.T) {
 				all, err := client.Deployments(groupID, app.ID)
 				assert.Nil(t, err)
 				assert.Equal(t, []realm.AppDeployment{found}, all)
+
+				history, err := client.DeploymentHistory(groupID, app.ID, realm.DeploymentHistoryOptions{Limit: 10})
+				assert.Nil(t, err)
+				assert.Equal(t, []realm.AppDeployment{found}, history)
 			})
 		})
 	})
 }
+
+func TestDeploymentLabels(t *testing.T) {
+	t.Run("should parse key=value pairs into labels", func(t *testing.T) {
+		labels, err := realm.ParseDeploymentLabels([]string{"release=1.4.0", "query=a=b", "empty="})
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"release": "1.4.0", "query": "a=b", "empty": ""}, labels)
+	})
+
+	t.Run("should return an error for a pair without a key", func(t *testing.T) {
+		_, err := realm.ParseDeploymentLabels([]string{"release"})
+		assert.Equal(t, errors.New("invalid label 'release', labels must be of the form key=value"), err)
+	})
+
+	t.Run("should match a deployment with each of the labels", func(t *testing.T) {
+		deployment := realm.AppDeployment{Labels: map[string]string{"release": "1.4.0", "team": "mobile"}}
+
+		assert.True(t, deployment.HasLabels(nil), "expected deployment to match no labels")
+		assert.True(t, deployment.HasLabels(map[string]string{"release": "1.4.0"}), "expected deployment to match label")
+		assert.False(t, deployment.HasLabels(map[string]string{"release": "1.3.0"}), "expected deployment to not match label value")
+		assert.False(t, deployment.HasLabels(map[string]string{"release": "1.4.0", "env": "prod"}), "expected deployment to not match missing label")
+	})
+}
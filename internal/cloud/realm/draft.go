@@ -20,6 +20,12 @@ type AppDraft struct {
 	ID string `json:"_id"`
 }
 
+// DeployDraftOptions are the options used to annotate a draft deployment
+type DeployDraftOptions struct {
+	Comment string            `json:"comment,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+}
+
 func (c *client) CreateDraft(groupID, appID string) (AppDraft, error) {
 	res, resErr := c.do(
 		http.MethodPost,
@@ -41,10 +47,11 @@ func (c *client) CreateDraft(groupID, appID string) (AppDraft, error) {
 	return draft, nil
 }
 
-func (c *client) DeployDraft(groupID, appID, draftID string) (AppDeployment, error) {
-	res, resErr := c.do(
+func (c *client) DeployDraft(groupID, appID, draftID string, opts DeployDraftOptions) (AppDeployment, error) {
+	res, resErr := c.doJSON(
 		http.MethodPost,
 		fmt.Sprintf(draftDeployPathPattern, groupID, appID, draftID),
+		opts,
 		api.RequestOptions{},
 	)
 	if resErr != nil {
@@ -14,11 +14,20 @@ const (
 	errCodeInvalidSession = "InvalidSession"
 
 	ErrCodeDraftAlreadyExists = "DraftAlreadyExists"
+
+	// ErrCodeAuthorizationPending is returned while the user has yet to enter
+	// the user code of a device authorization in the browser
+	ErrCodeAuthorizationPending = "AuthorizationPending"
+	// ErrCodeSlowDown is returned when a device authorization is polled too often
+	ErrCodeSlowDown = "SlowDown"
 )
 
 // set of known Realm errors
 var (
 	ErrDraftNotFound = errors.New("failed to find draft")
+
+	// ErrAppSettingsNotFound is returned by servers which do not support app settings
+	ErrAppSettingsNotFound = errors.New("failed to find app settings")
 )
 
 // ErrInvalidSession is an invalid session error
@@ -3,9 +3,11 @@ package realm
 import (
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/10gen/realm-cli/internal/cloud/status"
 	"github.com/10gen/realm-cli/internal/utils/api"
 
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
@@ -45,3 +47,36 @@ func TestServerError(t *testing.T) {
 		assert.Equal(t, ServerError{Code: "AnErrorCode", Message: "something bad happened"}, err)
 	})
 }
+
+type incidentsClient []status.Incident
+
+func (c incidentsClient) Incidents() ([]status.Incident, error) {
+	return c, nil
+}
+
+func TestServerErrorIncidents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error":"service unavailable","error_code":"ServiceUnavailable"}`))
+	}))
+	defer server.Close()
+
+	incidents := incidentsClient{{Name: "Elevated API error rates", Status: "investigating"}}
+
+	t.Run("Should include the reported incidents in a server error", func(t *testing.T) {
+		c := &client{baseURL: server.URL, status: incidents}
+
+		_, err := c.do(http.MethodGet, statusPath, api.RequestOptions{NoAuth: true})
+		assert.Equal(t, status.ErrServiceDegraded{
+			Err:       ServerError{Code: "ServiceUnavailable", Message: "service unavailable"},
+			Incidents: []status.Incident(incidents),
+		}, err)
+	})
+
+	t.Run("Should return the server error as is when no incidents are reported", func(t *testing.T) {
+		c := &client{baseURL: server.URL, status: incidentsClient{}}
+
+		_, err := c.do(http.MethodGet, statusPath, api.RequestOptions{NoAuth: true})
+		assert.Equal(t, ServerError{Code: "ServiceUnavailable", Message: "service unavailable"}, err)
+	})
+}
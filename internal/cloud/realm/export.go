@@ -13,7 +13,8 @@ import (
 )
 
 const (
-	exportPathPattern = appPathPattern + "/export"
+	exportPathPattern           = appPathPattern + "/export"
+	exportDeploymentPathPattern = deploymentPathPattern + "/export"
 
 	exportQueryForSourceControl = "source_control"
 	exportQueryIsTemplated      = "template"
@@ -28,6 +29,9 @@ const (
 type ExportRequest struct {
 	ConfigVersion AppConfigVersion
 	IsTemplated   bool
+
+	// DeploymentID exports the app as it was deployed by the deployment, instead of its current version
+	DeploymentID string
 }
 
 func (c *client) Export(groupID, appID string, req ExportRequest) (string, *zip.Reader, error) {
@@ -44,7 +48,12 @@ func (c *client) Export(groupID, appID string, req ExportRequest) (string, *zip.
 		options.Query[exportQueryForSourceControl] = trueVal
 	}
 
-	res, resErr := c.do(http.MethodGet, fmt.Sprintf(exportPathPattern, groupID, appID), options)
+	path := fmt.Sprintf(exportPathPattern, groupID, appID)
+	if req.DeploymentID != "" {
+		path = fmt.Sprintf(exportDeploymentPathPattern, groupID, appID, req.DeploymentID)
+	}
+
+	res, resErr := c.do(http.MethodGet, path, options)
 	if resErr != nil {
 		return "", nil, resErr
 	}
@@ -11,7 +11,10 @@ import (
 // Routes for functions
 const (
 	FunctionsPattern               = appPathPattern + "/functions"
+	FunctionPattern                = FunctionsPattern + "/%s"
 	AppDebugExecuteFunctionPattern = appPathPattern + "/debug/execute_function"
+
+	AppDebugExecuteFunctionSourcePattern = appPathPattern + "/debug/execute_function_source"
 )
 
 type stats struct {
@@ -23,13 +26,44 @@ type ExecutionResults struct {
 	Result    interface{} `json:"result,omitempty"`
 	Logs      []string    `json:"logs,omitempty"`
 	ErrorLogs []string    `json:"error_logs,omitempty"`
+	Error     interface{} `json:"error,omitempty"`
 	Stats     stats       `json:"stats,omitempty"`
 }
 
+// ErrorMessage returns the message of the error the function execution failed with
+func (r ExecutionResults) ErrorMessage() string {
+	switch err := r.Error.(type) {
+	case nil:
+		return ""
+	case string:
+		return err
+	case map[string]interface{}:
+		if message, ok := err["message"].(string); ok {
+			return message
+		}
+	}
+	data, err := json.Marshal(r.Error)
+	if err != nil {
+		return fmt.Sprint(r.Error)
+	}
+	return string(data)
+}
+
 // Function is a realm Function
 type Function struct {
-	ID   string `json:"_id"`
-	Name string `json:"name"`
+	ID           string `json:"_id"`
+	Name         string `json:"name"`
+	LastModified int64  `json:"last_modified,omitempty"`
+}
+
+// FunctionDetails is a realm Function along with its runtime settings
+type FunctionDetails struct {
+	Function
+	Private                 bool   `json:"private"`
+	RunAsSystem             bool   `json:"run_as_system"`
+	RunAsUserID             string `json:"run_as_user_id,omitempty"`
+	RunAsUserIDScriptSource string `json:"run_as_user_id_script_source,omitempty"`
+	DisableArgLogs          bool   `json:"disable_arg_logs,omitempty"`
 }
 
 func (c *client) AppDebugExecuteFunction(groupID, appID, userID, name string, args []interface{}) (ExecutionResults, error) {
@@ -63,6 +97,37 @@ func (c *client) AppDebugExecuteFunction(groupID, appID, userID, name string, ar
 	return response, nil
 }
 
+func (c *client) AppDebugExecuteFunctionSource(groupID, appID, userID, source, evalSource string) (ExecutionResults, error) {
+	query := map[string]string{}
+	if userID == "" {
+		query["run_as_system"] = "true"
+	} else {
+		query["user_id"] = userID
+	}
+	res, err := c.doJSON(
+		http.MethodPost,
+		fmt.Sprintf(AppDebugExecuteFunctionSourcePattern, groupID, appID),
+		map[string]interface{}{
+			"source":      source,
+			"eval_source": evalSource,
+		},
+		api.RequestOptions{Query: query},
+	)
+	if err != nil {
+		return ExecutionResults{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return ExecutionResults{}, api.ErrUnexpectedStatusCode{"debug execute function source", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var response ExecutionResults
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return ExecutionResults{}, err
+	}
+	return response, nil
+}
+
 func (c *client) Functions(groupID, appID string) ([]Function, error) {
 	res, err := c.do(
 		http.MethodGet,
@@ -83,3 +148,24 @@ func (c *client) Functions(groupID, appID string) ([]Function, error) {
 	}
 	return result, nil
 }
+
+func (c *client) Function(groupID, appID, functionID string) (FunctionDetails, error) {
+	res, err := c.do(
+		http.MethodGet,
+		fmt.Sprintf(FunctionPattern, groupID, appID, functionID),
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return FunctionDetails{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return FunctionDetails{}, api.ErrUnexpectedStatusCode{"get function", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var result FunctionDetails
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return FunctionDetails{}, err
+	}
+	return result, nil
+}
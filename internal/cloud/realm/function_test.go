@@ -59,6 +59,13 @@ func TestFunctions(t *testing.T) {
 
 			assert.Equal(t, 1, len(functions))
 			assert.Equal(t, "test", functions[0].Name)
+
+			t.Log("and should get the function details")
+			function, err := client.Function(u.CloudGroupID(), app.ID, functions[0].ID)
+			assert.Nil(t, err)
+
+			assert.Equal(t, "test", function.Name)
+			assert.True(t, function.Private, "expected function to be private")
 		})
 	})
 }
@@ -138,3 +145,33 @@ func TestAppDebugExecuteFunction(t *testing.T) {
 		})
 	})
 }
+
+func TestAppDebugExecuteFunctionSource(t *testing.T) {
+	u.SkipUnlessRealmServerRunning(t)
+
+	t.Run("should fail without an auth client", func(t *testing.T) {
+		client := realm.NewClient(u.RealmServerURL())
+
+		_, err := client.AppDebugExecuteFunctionSource(u.CloudGroupID(), "test-app-1234", "", "exports = function(){};", "exports()")
+		assert.Equal(t, realm.ErrInvalidSession{}, err)
+	})
+
+	t.Run("should execute function source", func(t *testing.T) {
+		client := newAuthClient(t)
+
+		groupID := u.CloudGroupID()
+
+		app, teardown := setupTestApp(t, client, groupID, "app-debug-execute-function-source-test")
+		defer teardown()
+
+		response, err := client.AppDebugExecuteFunctionSource(
+			groupID,
+			app.ID,
+			"",
+			"exports = function(arg){\n  return \"successful \" + arg;\n};",
+			`exports("test")`,
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, "successful test", response.Result)
+	})
+}
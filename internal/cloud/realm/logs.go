@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,8 +16,10 @@ const (
 
 	logsQueryEndDate    = "end_date"
 	logsQueryErrorsOnly = "errors_only"
+	logsQuerySkip       = "skip"
 	logsQueryStartDate  = "start_date"
 	logsQueryType       = "type"
+	logsQueryUserID     = "user_id"
 
 	logsDateFormat = "2006-01-02T15:04:05.999Z07:00"
 )
@@ -54,6 +57,13 @@ type LogsOptions struct {
 	Types      []string
 	Start      time.Time
 	End        time.Time
+	UserID     string
+
+	// Limit is the maximum number of logs to find, following the pagination
+	// cursor across pages until it is reached; 0 finds a single page of logs
+	Limit int
+	// AllPages follows the pagination cursor until every log is found, ignoring the Limit
+	AllPages bool
 }
 
 // Logs is an array of Realm app logs
@@ -72,6 +82,7 @@ type Log struct {
 	MemTimeUsage          int64         `json:"mem_time_usage"`
 	Error                 string        `json:"error"`
 	ErrorCode             string        `json:"error_code"`
+	UserID                string        `json:"user_id,omitempty"`
 	AuthEvent             LogAuthEvent  `json:"auth_event"`
 	EventSubscriptionID   string        `json:"event_subscription_id"`
 	EventSubscriptionName string        `json:"event_subscription_name"`
@@ -89,7 +100,9 @@ type LogAuthEvent struct {
 }
 
 type logsResponse struct {
-	Logs []Log `json:"logs"`
+	Logs        []Log     `json:"logs"`
+	NextEndDate time.Time `json:"nextEndDate"`
+	NextSkip    int       `json:"nextSkip"`
 }
 
 func (c *client) Logs(groupID, appID string, opts LogsOptions) (Logs, error) {
@@ -106,23 +119,56 @@ func (c *client) Logs(groupID, appID string, opts LogsOptions) (Logs, error) {
 	if !opts.End.IsZero() {
 		query[logsQueryEndDate] = opts.End.Format(logsDateFormat)
 	}
+	if opts.UserID != "" {
+		query[logsQueryUserID] = opts.UserID
+	}
+
+	var logs Logs
+	for {
+		res, err := c.getLogs(groupID, appID, query)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, res.Logs...)
+
+		if !opts.AllPages {
+			if opts.Limit <= 0 {
+				return logs, nil
+			}
+			if len(logs) >= opts.Limit {
+				return logs[:opts.Limit], nil
+			}
+		}
+		if res.NextEndDate.IsZero() || len(res.Logs) == 0 {
+			return logs, nil
+		}
+
+		query[logsQueryEndDate] = res.NextEndDate.Format(logsDateFormat)
+		if res.NextSkip > 0 {
+			query[logsQuerySkip] = strconv.Itoa(res.NextSkip)
+		} else {
+			delete(query, logsQuerySkip)
+		}
+	}
+}
 
+func (c *client) getLogs(groupID, appID string, query map[string]string) (logsResponse, error) {
 	res, err := c.do(
 		http.MethodGet,
 		fmt.Sprintf(logsPathPattern, groupID, appID),
 		api.RequestOptions{Query: query},
 	)
 	if err != nil {
-		return nil, err
+		return logsResponse{}, err
 	}
 	if res.StatusCode != http.StatusOK {
-		return nil, api.ErrUnexpectedStatusCode{"get logs", res.StatusCode}
+		return logsResponse{}, api.ErrUnexpectedStatusCode{"get logs", res.StatusCode}
 	}
 	defer res.Body.Close()
 
 	var out logsResponse
 	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
-		return nil, err
+		return logsResponse{}, err
 	}
-	return out.Logs, nil
+	return out, nil
 }
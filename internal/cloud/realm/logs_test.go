@@ -23,5 +23,17 @@ func TestRealmLogs(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, 0, len(logs))
 		})
+
+		t.Run("getting logs with a limit and user filter should return an empty list if there are none", func(t *testing.T) {
+			logs, err := client.Logs(groupID, app.ID, realm.LogsOptions{UserID: "60344735b37e3733de2adf40", Limit: 250})
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(logs))
+		})
+
+		t.Run("getting every page of logs should return an empty list if there are none", func(t *testing.T) {
+			logs, err := client.Logs(groupID, app.ID, realm.LogsOptions{AllPages: true})
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(logs))
+		})
 	})
 }
@@ -47,6 +47,13 @@ const (
 	AppConfigVersion20180301 AppConfigVersion = 20180301
 )
 
+// SupportedAppConfigVersions are the app config versions the CLI can import and export, newest first
+var SupportedAppConfigVersions = []AppConfigVersion{
+	AppConfigVersion20210101,
+	AppConfigVersion20200603,
+	AppConfigVersion20180301,
+}
+
 func isValidConfigVersion(cv AppConfigVersion) bool {
 	switch cv {
 	case
@@ -0,0 +1,128 @@
+package realm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+const (
+	appLocationPathPattern = "/api/client/v2.0/app/%s/location"
+)
+
+// appPathRegexp matches the paths of requests made for a specific app,
+// capturing the app's group id and app id
+var appPathRegexp = regexp.MustCompile("^" + adminAPI + "/groups/([^/]+)/apps/([^/]+)/")
+
+type appLocation struct {
+	DeploymentModel DeploymentModel `json:"deployment_model"`
+	Location        Location        `json:"location"`
+	Hostname        string          `json:"hostname"`
+}
+
+// requestBaseURL returns the base url to make the request for the provided path with.
+// Requests for an app deployed locally to a region are made against the region's hostname,
+// which is discovered once per app, and only once at a time, unless the regional url is overridden by the profile
+func (c *client) requestBaseURL(path string, options api.RequestOptions) string {
+	if options.NoAuth || c.profile == nil {
+		return c.baseURL
+	}
+
+	match := appPathRegexp.FindStringSubmatch(path)
+	if match == nil {
+		return c.baseURL
+	}
+
+	if regionalURL := c.profile.Flags.RealmRegionalURL; regionalURL != "" {
+		return strings.TrimSuffix(regionalURL, "/")
+	}
+
+	groupID, appID := match[1], match[2]
+
+	c.regionsMu.Lock()
+	if c.appBaseURLs == nil {
+		c.appBaseURLs = map[string]*appBaseURL{}
+	}
+	if discovered, ok := c.appBaseURLs[appID]; ok {
+		c.regionsMu.Unlock()
+
+		// wait for the request which is discovering the app's base url
+		<-discovered.done
+		if discovered.ok {
+			return discovered.baseURL
+		}
+		return c.baseURL
+	}
+
+	discovered := &appBaseURL{done: make(chan struct{})}
+	c.appBaseURLs[appID] = discovered
+	c.regionsMu.Unlock()
+
+	hostname, err := c.discoverAppHostname(groupID, appID)
+
+	c.regionsMu.Lock()
+	if err != nil {
+		// discovery is best effort, so a failure falls back to the base url
+		// for now and the next request for the app tries to discover it again
+		delete(c.appBaseURLs, appID)
+	} else {
+		discovered.ok = true
+		discovered.baseURL = c.baseURL
+		if hostname != "" {
+			discovered.baseURL = strings.TrimSuffix(hostname, "/")
+		}
+	}
+	close(discovered.done)
+	c.regionsMu.Unlock()
+
+	if !discovered.ok {
+		return c.baseURL
+	}
+	return discovered.baseURL
+}
+
+// appBaseURL is the base url discovered for an app, which is done
+// once discovery completes and ok when discovery succeeded
+type appBaseURL struct {
+	done    chan struct{}
+	ok      bool
+	baseURL string
+}
+
+func (c *client) discoverAppHostname(groupID, appID string) (string, error) {
+	res, resErr := c.doWithBaseURL(c.baseURL, http.MethodGet, fmt.Sprintf(appPathPattern, groupID, appID), api.RequestOptions{})
+	if resErr != nil {
+		return "", resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", api.ErrUnexpectedStatusCode{"get app", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var app App
+	if err := json.NewDecoder(res.Body).Decode(&app); err != nil {
+		return "", err
+	}
+	if app.DeploymentModel != DeploymentModelLocal || app.ClientAppID == "" {
+		return "", nil
+	}
+
+	res, resErr = c.doWithBaseURL(c.baseURL, http.MethodGet, fmt.Sprintf(appLocationPathPattern, app.ClientAppID), api.RequestOptions{NoAuth: true})
+	if resErr != nil {
+		return "", resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", api.ErrUnexpectedStatusCode{"get app location", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var location appLocation
+	if err := json.NewDecoder(res.Body).Decode(&location); err != nil {
+		return "", err
+	}
+	return location.Hostname, nil
+}
@@ -0,0 +1,128 @@
+package realm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestRequestBaseURL(t *testing.T) {
+	var regionalPaths []string
+	regional := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		regionalPaths = append(regionalPaths, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer regional.Close()
+
+	var globalPaths []string
+	var flakyDiscoveries int
+	global := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		globalPaths = append(globalPaths, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/admin/v3.0/groups/groupID/apps/localApp":
+			json.NewEncoder(w).Encode(App{
+				ID:          "localApp",
+				ClientAppID: "local-abcde",
+				AppMeta:     AppMeta{DeploymentModel: DeploymentModelLocal, Location: LocationIreland},
+			})
+		case "/api/admin/v3.0/groups/groupID/apps/globalApp":
+			json.NewEncoder(w).Encode(App{
+				ID:          "globalApp",
+				ClientAppID: "global-abcde",
+				AppMeta:     AppMeta{DeploymentModel: DeploymentModelGlobal},
+			})
+		case "/api/admin/v3.0/groups/groupID/apps/flakyApp":
+			flakyDiscoveries++
+			if flakyDiscoveries == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			json.NewEncoder(w).Encode(App{
+				ID:          "flakyApp",
+				ClientAppID: "local-abcde",
+				AppMeta:     AppMeta{DeploymentModel: DeploymentModelLocal, Location: LocationIreland},
+			})
+		case "/api/client/v2.0/app/local-abcde/location":
+			json.NewEncoder(w).Encode(appLocation{DeploymentModelLocal, LocationIreland, regional.URL + "/"})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer global.Close()
+
+	profile, err := user.NewProfile("regional-test")
+	assert.Nil(t, err)
+	profile.SetSession(user.Session{AccessToken: "accessToken", RefreshToken: "refreshToken"})
+	defer profile.ClearSession()
+
+	t.Run("should discover and reuse the regional url of a local app", func(t *testing.T) {
+		globalPaths, regionalPaths = nil, nil
+
+		c := &client{baseURL: global.URL, profile: profile}
+
+		assert.Nil(t, c.DeleteUser("groupID", "localApp", "user1"))
+		assert.Nil(t, c.DeleteUser("groupID", "localApp", "user2"))
+
+		assert.Equal(t, []string{
+			"/api/admin/v3.0/groups/groupID/apps/localApp",
+			"/api/client/v2.0/app/local-abcde/location",
+		}, globalPaths)
+		assert.Equal(t, []string{
+			"/api/admin/v3.0/groups/groupID/apps/localApp/users/user1",
+			"/api/admin/v3.0/groups/groupID/apps/localApp/users/user2",
+		}, regionalPaths)
+	})
+
+	t.Run("should use the base url for a global app", func(t *testing.T) {
+		globalPaths, regionalPaths = nil, nil
+
+		c := &client{baseURL: global.URL, profile: profile}
+
+		assert.Nil(t, c.DeleteUser("groupID", "globalApp", "user1"))
+
+		assert.Equal(t, []string{
+			"/api/admin/v3.0/groups/groupID/apps/globalApp",
+			"/api/admin/v3.0/groups/groupID/apps/globalApp/users/user1",
+		}, globalPaths)
+		assert.Equal(t, 0, len(regionalPaths))
+	})
+
+	t.Run("should discover the regional url again after discovery fails", func(t *testing.T) {
+		globalPaths, regionalPaths = nil, nil
+
+		c := &client{baseURL: global.URL, profile: profile}
+
+		assert.Nil(t, c.DeleteUser("groupID", "flakyApp", "user1"))
+		assert.Nil(t, c.DeleteUser("groupID", "flakyApp", "user2"))
+		assert.Nil(t, c.DeleteUser("groupID", "flakyApp", "user3"))
+
+		assert.Equal(t, []string{
+			"/api/admin/v3.0/groups/groupID/apps/flakyApp",
+			"/api/admin/v3.0/groups/groupID/apps/flakyApp/users/user1",
+			"/api/admin/v3.0/groups/groupID/apps/flakyApp",
+			"/api/client/v2.0/app/local-abcde/location",
+		}, globalPaths)
+		assert.Equal(t, []string{
+			"/api/admin/v3.0/groups/groupID/apps/flakyApp/users/user2",
+			"/api/admin/v3.0/groups/groupID/apps/flakyApp/users/user3",
+		}, regionalPaths)
+	})
+
+	t.Run("should use the regional url set by the profile without discovery", func(t *testing.T) {
+		globalPaths, regionalPaths = nil, nil
+
+		profile.Flags.RealmRegionalURL = regional.URL
+		defer func() { profile.Flags.RealmRegionalURL = "" }()
+
+		c := &client{baseURL: global.URL, profile: profile}
+
+		assert.Nil(t, c.DeleteUser("groupID", "globalApp", "user1"))
+
+		assert.Equal(t, 0, len(globalPaths))
+		assert.Equal(t, []string{"/api/admin/v3.0/groups/groupID/apps/globalApp/users/user1"}, regionalPaths)
+	})
+}
@@ -0,0 +1,232 @@
+package realm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+const (
+	servicesPathPattern = appPathPattern + "/services"
+	servicePathPattern  = servicesPathPattern + "/%s"
+	rulesPathPattern    = servicePathPattern + "/rules"
+	rulePathPattern     = rulesPathPattern + "/%s"
+
+	ruleEvaluationPathPattern = rulesPathPattern + "/evaluate"
+
+	defaultRulePathPattern       = servicePathPattern + "/default_rule"
+	defaultRuleUpdatePathPattern = defaultRulePathPattern + "/%s"
+)
+
+// Service is a service (or data source) linked to a Realm app
+type Service struct {
+	ID   string `json:"_id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Rule is a collection rule of a Realm app data source
+type Rule struct {
+	ID         string                   `json:"_id,omitempty"`
+	Database   string                   `json:"database"`
+	Collection string                   `json:"collection"`
+	Roles      []map[string]interface{} `json:"roles,omitempty"`
+	Filters    []map[string]interface{} `json:"filters,omitempty"`
+	Schema     map[string]interface{}   `json:"schema,omitempty"`
+}
+
+// DefaultRule is the default rule of a Realm app data source,
+// which applies to any collection without a rule of its own
+type DefaultRule struct {
+	ID      string                   `json:"_id,omitempty"`
+	Roles   []map[string]interface{} `json:"roles,omitempty"`
+	Filters []map[string]interface{} `json:"filters,omitempty"`
+}
+
+// RuleEvaluation describes an operation on a document to evaluate the rules against,
+// which evaluates the rule when one is specified in place of the deployed rules
+type RuleEvaluation struct {
+	UserID     string                 `json:"user_id"`
+	Operation  string                 `json:"operation"`
+	Database   string                 `json:"database"`
+	Collection string                 `json:"collection"`
+	Document   map[string]interface{} `json:"document"`
+	Rule       map[string]interface{} `json:"rule,omitempty"`
+}
+
+// RuleEvaluationResult is the result of a rule evaluation
+type RuleEvaluationResult struct {
+	Allowed bool   `json:"allowed"`
+	Role    string `json:"role,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Namespace returns the rule's namespace as "database.collection"
+func (r Rule) Namespace() string {
+	return r.Database + "." + r.Collection
+}
+
+func (c *client) Services(groupID, appID string) ([]Service, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(servicesPathPattern, groupID, appID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return nil, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"services", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var services []Service
+	if err := json.NewDecoder(res.Body).Decode(&services); err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+func (c *client) Rules(groupID, appID, serviceID string) ([]Rule, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(rulesPathPattern, groupID, appID, serviceID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return nil, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"rules", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var rules []Rule
+	if err := json.NewDecoder(res.Body).Decode(&rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (c *client) CreateRule(groupID, appID, serviceID string, rule Rule) (Rule, error) {
+	res, resErr := c.doJSON(
+		http.MethodPost,
+		fmt.Sprintf(rulesPathPattern, groupID, appID, serviceID),
+		rule,
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return Rule{}, resErr
+	}
+	if res.StatusCode != http.StatusCreated {
+		return Rule{}, api.ErrUnexpectedStatusCode{"create rule", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var created Rule
+	if err := json.NewDecoder(res.Body).Decode(&created); err != nil {
+		return Rule{}, err
+	}
+	return created, nil
+}
+
+func (c *client) DeleteRule(groupID, appID, serviceID, ruleID string) error {
+	res, err := c.do(
+		http.MethodDelete,
+		fmt.Sprintf(rulePathPattern, groupID, appID, serviceID, ruleID),
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"delete rule", res.StatusCode}
+	}
+	return nil
+}
+
+func (c *client) DefaultRule(groupID, appID, serviceID string) (DefaultRule, bool, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(defaultRulePathPattern, groupID, appID, serviceID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return DefaultRule{}, false, resErr
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return DefaultRule{}, false, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return DefaultRule{}, false, api.ErrUnexpectedStatusCode{"default rule", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var defaultRule DefaultRule
+	if err := json.NewDecoder(res.Body).Decode(&defaultRule); err != nil {
+		return DefaultRule{}, false, err
+	}
+	return defaultRule, true, nil
+}
+
+func (c *client) CreateDefaultRule(groupID, appID, serviceID string, defaultRule DefaultRule) (DefaultRule, error) {
+	res, resErr := c.doJSON(
+		http.MethodPost,
+		fmt.Sprintf(defaultRulePathPattern, groupID, appID, serviceID),
+		defaultRule,
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return DefaultRule{}, resErr
+	}
+	if res.StatusCode != http.StatusCreated {
+		return DefaultRule{}, api.ErrUnexpectedStatusCode{"create default rule", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var created DefaultRule
+	if err := json.NewDecoder(res.Body).Decode(&created); err != nil {
+		return DefaultRule{}, err
+	}
+	return created, nil
+}
+
+func (c *client) UpdateDefaultRule(groupID, appID, serviceID string, defaultRule DefaultRule) error {
+	res, err := c.doJSON(
+		http.MethodPut,
+		fmt.Sprintf(defaultRuleUpdatePathPattern, groupID, appID, serviceID, defaultRule.ID),
+		defaultRule,
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"update default rule", res.StatusCode}
+	}
+	return nil
+}
+
+func (c *client) EvaluateRule(groupID, appID, serviceID string, evaluation RuleEvaluation) (RuleEvaluationResult, error) {
+	res, resErr := c.doJSON(
+		http.MethodPost,
+		fmt.Sprintf(ruleEvaluationPathPattern, groupID, appID, serviceID),
+		evaluation,
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return RuleEvaluationResult{}, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return RuleEvaluationResult{}, api.ErrUnexpectedStatusCode{"evaluate rule", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var result RuleEvaluationResult
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return RuleEvaluationResult{}, err
+	}
+	return result, nil
+}
@@ -61,3 +61,203 @@ func (c *client) SchemaModels(groupID, appID, language string) ([]SchemaModel, e
 	}
 	return models, nil
 }
+
+const (
+	schemasPathPattern        = appPathPattern + "/schemas"
+	schemaPathPattern         = schemasPathPattern + "/%s"
+	schemaVersionsPathPattern = schemasPathPattern + "/versions"
+	schemaVersionPathPattern  = schemaVersionsPathPattern + "/%d"
+	schemaValidatePathPattern = schemasPathPattern + "/validate"
+	schemaGeneratePathPattern = schemasPathPattern + "/generate"
+)
+
+// Schema is a Realm app collection schema
+type Schema struct {
+	ID       string                 `json:"_id,omitempty"`
+	Metadata SchemaMetadata         `json:"metadata"`
+	Schema   map[string]interface{} `json:"schema"`
+}
+
+// Namespace returns the schema's collection namespace
+func (s Schema) Namespace() string {
+	return s.Metadata.Database + "." + s.Metadata.Collection
+}
+
+// SchemaMetadata is the Realm app collection schema metadata
+type SchemaMetadata struct {
+	DataSource string `json:"data_source"`
+	Database   string `json:"database"`
+	Collection string `json:"collection"`
+}
+
+// SchemaVersion is a Realm app schema version
+type SchemaVersion struct {
+	ID        string `json:"version_id"`
+	Version   int    `json:"version_number"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// SchemaValidationOptions are options to validate existing documents against a schema
+type SchemaValidationOptions struct {
+	Limit int
+}
+
+// SchemaValidationResult is the result of validating existing documents against a schema
+type SchemaValidationResult struct {
+	DocumentsChecked int                     `json:"documents_checked"`
+	Errors           []SchemaValidationError `json:"errors"`
+}
+
+// SchemaValidationError is a document field which fails to match its schema
+type SchemaValidationError struct {
+	DocumentID string `json:"document_id"`
+	Field      string `json:"field"`
+	Message    string `json:"message"`
+}
+
+type schemaValidateRequest struct {
+	Metadata SchemaMetadata         `json:"metadata"`
+	Schema   map[string]interface{} `json:"schema"`
+	Limit    int                    `json:"limit,omitempty"`
+}
+
+// SchemaGenerationOptions are options to generate a schema from a collection's existing documents
+type SchemaGenerationOptions struct {
+	SampleSize int
+}
+
+type schemaGenerateRequest struct {
+	Metadata   SchemaMetadata `json:"metadata"`
+	SampleSize int            `json:"sample_size,omitempty"`
+}
+
+type schemaGenerateResponse struct {
+	Schema map[string]interface{} `json:"schema"`
+}
+
+type schemaVersionsResponse struct {
+	Versions []SchemaVersion `json:"versions"`
+}
+
+func (c *client) Schemas(groupID, appID string) ([]Schema, error) {
+	res, err := c.do(
+		http.MethodGet,
+		fmt.Sprintf(schemasPathPattern, groupID, appID),
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"get schemas", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var schemas []Schema
+	if err := json.NewDecoder(res.Body).Decode(&schemas); err != nil {
+		return nil, err
+	}
+	return schemas, nil
+}
+
+func (c *client) UpdateSchema(groupID, appID string, schema Schema) error {
+	res, err := c.doJSON(
+		http.MethodPut,
+		fmt.Sprintf(schemaPathPattern, groupID, appID, schema.ID),
+		schema,
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"update schema", res.StatusCode}
+	}
+	return nil
+}
+
+func (c *client) SchemaVersions(groupID, appID string) ([]SchemaVersion, error) {
+	res, err := c.do(
+		http.MethodGet,
+		fmt.Sprintf(schemaVersionsPathPattern, groupID, appID),
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"get schema versions", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var out schemaVersionsResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out.Versions, nil
+}
+
+func (c *client) SchemaVersion(groupID, appID string, version int) ([]Schema, error) {
+	res, err := c.do(
+		http.MethodGet,
+		fmt.Sprintf(schemaVersionPathPattern, groupID, appID, version),
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"get schema version", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var schemas []Schema
+	if err := json.NewDecoder(res.Body).Decode(&schemas); err != nil {
+		return nil, err
+	}
+	return schemas, nil
+}
+
+func (c *client) ValidateSchemaData(groupID, appID string, schema Schema, opts SchemaValidationOptions) (SchemaValidationResult, error) {
+	res, err := c.doJSON(
+		http.MethodPost,
+		fmt.Sprintf(schemaValidatePathPattern, groupID, appID),
+		schemaValidateRequest{schema.Metadata, schema.Schema, opts.Limit},
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return SchemaValidationResult{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return SchemaValidationResult{}, api.ErrUnexpectedStatusCode{"validate schema data", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var result SchemaValidationResult
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return SchemaValidationResult{}, err
+	}
+	return result, nil
+}
+
+func (c *client) GenerateSchema(groupID, appID string, metadata SchemaMetadata, opts SchemaGenerationOptions) (map[string]interface{}, error) {
+	res, err := c.doJSON(
+		http.MethodPost,
+		fmt.Sprintf(schemaGeneratePathPattern, groupID, appID),
+		schemaGenerateRequest{metadata, opts.SampleSize},
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"generate schema", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var out schemaGenerateResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out.Schema, nil
+}
@@ -35,3 +35,14 @@ func TestRealmSchemaModels(t *testing.T) {
 		// write mkore of this test to actually retrieve data models generated from a schema
 	})
 }
+
+func TestRealmGenerateSchema(t *testing.T) {
+	u.SkipUnlessRealmServerRunning(t)
+
+	t.Run("should respond with 401 when not authenticated", func(t *testing.T) {
+		client := realm.NewClient(u.RealmServerURL())
+
+		_, err := client.GenerateSchema("", "", realm.SchemaMetadata{}, realm.SchemaGenerationOptions{})
+		assert.Equal(t, realm.ErrInvalidSession{}, err)
+	})
+}
@@ -0,0 +1,182 @@
+package realm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+const (
+	serviceConfigPathPattern   = servicePathPattern + "/config"
+	syncProgressPathPattern    = appPathPattern + "/sync/progress"
+	syncClientsPathPattern     = appPathPattern + "/sync/clients"
+	syncClientResetPathPattern = appPathPattern + "/sync/client_reset"
+
+	syncClientsQueryUserID = "user_id"
+)
+
+// set of supported sync states
+const (
+	SyncStateEnabled    = "enabled"
+	SyncStateDisabled   = "disabled"
+	SyncStateTerminated = ""
+)
+
+// SyncConfig is the sync configuration of a data source,
+// which is set up for either flexible or partition-based sync
+type SyncConfig struct {
+	Flexible  *FlexibleSyncConfig  `json:"flexible_sync,omitempty"`
+	Partition *PartitionSyncConfig `json:"sync,omitempty"`
+}
+
+// FlexibleSyncConfig is the flexible sync configuration of a data source
+type FlexibleSyncConfig struct {
+	State                string   `json:"state"`
+	DatabaseName         string   `json:"database_name"`
+	QueryableFieldsNames []string `json:"queryable_fields_names"`
+}
+
+// PartitionSyncConfig is the partition-based sync configuration of a data source
+type PartitionSyncConfig struct {
+	State        string        `json:"state"`
+	DatabaseName string        `json:"database_name"`
+	Partition    SyncPartition `json:"partition"`
+}
+
+// SyncPartition is the partition key of a partition-based sync configuration
+type SyncPartition struct {
+	Key      string `json:"key"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+}
+
+// SyncProgress is the initial sync progress of an app, by namespace
+type SyncProgress struct {
+	Progress map[string]SyncNamespaceProgress `json:"progress"`
+}
+
+// SyncNamespaceProgress is the initial sync progress of a namespace,
+// where the total number of documents is only reported once it is known
+type SyncNamespaceProgress struct {
+	Complete        bool   `json:"complete"`
+	Error           string `json:"error,omitempty"`
+	DocumentsCopied int64  `json:"documentsCopied"`
+	TotalDocuments  int64  `json:"totalDocuments,omitempty"`
+}
+
+// SyncClient is a sync client with an active session
+type SyncClient struct {
+	ID           string `json:"_id"`
+	UserID       string `json:"user_id"`
+	DeviceID     string `json:"device_id"`
+	Platform     string `json:"platform"`
+	SDKVersion   string `json:"sdk_version"`
+	ConnectedAt  int64  `json:"connected_at"`
+	LastActivity int64  `json:"last_activity"`
+}
+
+type syncClientResetPayload struct {
+	UserID string `json:"user_id"`
+}
+
+func (c *client) SyncConfig(groupID, appID, serviceID string) (SyncConfig, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(serviceConfigPathPattern, groupID, appID, serviceID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return SyncConfig{}, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return SyncConfig{}, api.ErrUnexpectedStatusCode{"sync config", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var config SyncConfig
+	if err := json.NewDecoder(res.Body).Decode(&config); err != nil {
+		return SyncConfig{}, err
+	}
+	return config, nil
+}
+
+func (c *client) UpdateSyncConfig(groupID, appID, serviceID string, config SyncConfig) error {
+	res, err := c.doJSON(
+		http.MethodPatch,
+		fmt.Sprintf(serviceConfigPathPattern, groupID, appID, serviceID),
+		config,
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"update sync config", res.StatusCode}
+	}
+	return nil
+}
+
+func (c *client) SyncProgress(groupID, appID string) (SyncProgress, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(syncProgressPathPattern, groupID, appID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return SyncProgress{}, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return SyncProgress{}, api.ErrUnexpectedStatusCode{"sync progress", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var progress SyncProgress
+	if err := json.NewDecoder(res.Body).Decode(&progress); err != nil {
+		return SyncProgress{}, err
+	}
+	return progress, nil
+}
+
+func (c *client) SyncClients(groupID, appID, userID string) ([]SyncClient, error) {
+	options := api.RequestOptions{}
+	if userID != "" {
+		options.Query = map[string]string{syncClientsQueryUserID: userID}
+	}
+
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(syncClientsPathPattern, groupID, appID),
+		options,
+	)
+	if resErr != nil {
+		return nil, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"sync clients", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var clients []SyncClient
+	if err := json.NewDecoder(res.Body).Decode(&clients); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+func (c *client) SyncClientReset(groupID, appID, userID string) error {
+	res, err := c.doJSON(
+		http.MethodPost,
+		fmt.Sprintf(syncClientResetPathPattern, groupID, appID),
+		syncClientResetPayload{userID},
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"sync client reset", res.StatusCode}
+	}
+	return nil
+}
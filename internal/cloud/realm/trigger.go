@@ -0,0 +1,92 @@
+package realm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+const (
+	triggersPathPattern = appPathPattern + "/triggers"
+	triggerPathPattern  = triggersPathPattern + "/%s"
+
+	triggerResumePathPattern = triggerPathPattern + "/resume"
+)
+
+// set of supported trigger types
+const (
+	TriggerTypeDatabase       = "DATABASE"
+	TriggerTypeAuthentication = "AUTHENTICATION"
+	TriggerTypeScheduled      = "SCHEDULED"
+)
+
+// Trigger is a trigger of a Realm app
+type Trigger struct {
+	ID              string                 `json:"_id,omitempty"`
+	Name            string                 `json:"name"`
+	Type            string                 `json:"type"`
+	FunctionID      string                 `json:"function_id,omitempty"`
+	FunctionName    string                 `json:"function_name,omitempty"`
+	Disabled        bool                   `json:"disabled"`
+	Config          map[string]interface{} `json:"config"`
+	EventProcessors map[string]interface{} `json:"event_processors,omitempty"`
+}
+
+func (c *client) Triggers(groupID, appID string) ([]Trigger, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(triggersPathPattern, groupID, appID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return nil, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"triggers", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var triggers []Trigger
+	if err := json.NewDecoder(res.Body).Decode(&triggers); err != nil {
+		return nil, err
+	}
+	return triggers, nil
+}
+
+func (c *client) UpdateTrigger(groupID, appID string, trigger Trigger) error {
+	res, err := c.doJSON(
+		http.MethodPut,
+		fmt.Sprintf(triggerPathPattern, groupID, appID, trigger.ID),
+		trigger,
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"update trigger", res.StatusCode}
+	}
+	return nil
+}
+
+type resumeTriggerPayload struct {
+	DisableToken bool `json:"disable_token"`
+}
+
+func (c *client) ResumeTrigger(groupID, appID, triggerID string, disableToken bool) error {
+	res, err := c.doJSON(
+		http.MethodPut,
+		fmt.Sprintf(triggerResumePathPattern, groupID, appID, triggerID),
+		resumeTriggerPayload{disableToken},
+		api.RequestOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"resume trigger", res.StatusCode}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package realm_test
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRealmTriggers(t *testing.T) {
+	u.SkipUnlessRealmServerRunning(t)
+
+	t.Run("should fail without an auth client", func(t *testing.T) {
+		client := realm.NewClient(u.RealmServerURL())
+
+		_, err := client.Triggers(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex())
+		assert.Equal(t, realm.ErrInvalidSession{}, err)
+
+		err = client.UpdateTrigger(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), realm.Trigger{ID: primitive.NewObjectID().Hex()})
+		assert.Equal(t, realm.ErrInvalidSession{}, err)
+
+		err = client.ResumeTrigger(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), false)
+		assert.Equal(t, realm.ErrInvalidSession{}, err)
+	})
+}
@@ -4,10 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/utils/api"
 	"github.com/10gen/realm-cli/internal/utils/flags"
+	"github.com/10gen/realm-cli/internal/utils/parallel"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -20,7 +24,16 @@ const (
 	userDisablePathPattern  = userPathPattern + "/disable"
 	userEnablePathPattern   = userPathPattern + "/enable"
 	userLogoutPathPattern   = userPathPattern + "/logout"
+	userEmailPathPattern    = userPathPattern + "/email"
+	userPasswordPathPattern = userPathPattern + "/password"
+	userDevicesPathPattern  = userPathPattern + "/devices"
+	userDevicePathPattern   = userDevicesPathPattern + "/%s"
 
+	userRegistrationPathPattern  = appPathPattern + "/user_registrations/by_email/%s"
+	userConfirmPathPattern       = userRegistrationPathPattern + "/confirm"
+	userPasswordResetPathPattern = userRegistrationPathPattern + "/send_reset"
+
+	usersQueryAfter         = "after"
 	usersQueryStatus        = "status"
 	usersQueryProviderTypes = "provider_types"
 )
@@ -88,6 +101,38 @@ type User struct {
 	Data                   map[string]interface{} `json:"data,omitempty"`
 	CreationDate           int64                  `json:"creation_date"`
 	LastAuthenticationDate int64                  `json:"last_authentication_date"`
+
+	// LoginIDs are the login ids of a pending user
+	LoginIDs []UserLoginID `json:"login_ids,omitempty"`
+}
+
+// UserDevice is a device a Realm app user has logged in from
+type UserDevice struct {
+	ID              string `json:"_id"`
+	Platform        string `json:"platform"`
+	PlatformVersion string `json:"platform_version"`
+	SDKVersion      string `json:"sdk_version"`
+	AppVersion      string `json:"app_version"`
+	LastSeen        int64  `json:"last_seen"`
+}
+
+// UserLoginID is a Realm app pending user login id
+type UserLoginID struct {
+	IDType string `json:"id_type"`
+	ID     string `json:"id"`
+}
+
+// Email returns the user's email, found in either its data or its login ids
+func (user User) Email() string {
+	if email, ok := user.Data["email"].(string); ok && email != "" {
+		return email
+	}
+	for _, loginID := range user.LoginIDs {
+		if loginID.IDType == "email" {
+			return loginID.ID
+		}
+	}
+	return ""
 }
 
 // UserIdentity is a Realm app user identity
@@ -277,22 +322,200 @@ func (c *client) EnableUser(groupID, appID, userID string) error {
 	return nil
 }
 
+type updateUserEmailPayload struct {
+	Email string `json:"email"`
+}
+
+type updateUserPasswordPayload struct {
+	Password string `json:"password"`
+}
+
+func (c *client) UpdateUserEmail(groupID, appID, userID, email string) error {
+	res, resErr := c.doJSON(
+		http.MethodPut,
+		fmt.Sprintf(userEmailPathPattern, groupID, appID, userID),
+		updateUserEmailPayload{email},
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return resErr
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{Action: "update user email", Actual: res.StatusCode}
+	}
+	return nil
+}
+
+func (c *client) UpdateUserPassword(groupID, appID, userID, password string) error {
+	res, resErr := c.doJSON(
+		http.MethodPut,
+		fmt.Sprintf(userPasswordPathPattern, groupID, appID, userID),
+		updateUserPasswordPayload{password},
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return resErr
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{Action: "update user password", Actual: res.StatusCode}
+	}
+	return nil
+}
+
+// UserSessionsRevocation is the outcome of revoking a user's sessions
+type UserSessionsRevocation struct {
+	User User
+	Err  error
+}
+
+// maxConcurrentUserRequests is the default maximum number of users
+// whose sessions are revoked at the same time
+const maxConcurrentUserRequests = 4
+
+// RevokeAllUserSessions revokes the sessions of every user matching the filter, a bounded number of users at a time,
+// calling onProgress, when provided, after each user with the number of users processed so far
+func (c *client) RevokeAllUserSessions(groupID, appID string, filter UserFilter, onProgress func(processed, total int)) ([]UserSessionsRevocation, error) {
+	users, err := c.FindUsers(groupID, appID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// results are collected by index so revocations are returned in user order
+	revocations := make([]UserSessionsRevocation, len(users))
+
+	var mu sync.Mutex
+	var processed int
+
+	parallel.Run(len(users), c.parallelism(maxConcurrentUserRequests), func(i int) {
+		revocations[i] = UserSessionsRevocation{users[i], c.RevokeUserSessions(groupID, appID, users[i].ID)}
+
+		mu.Lock()
+		processed++
+		if onProgress != nil {
+			onProgress(processed, len(users))
+		}
+		mu.Unlock()
+	})
+
+	return revocations, nil
+}
+
+func (c *client) SendUserPasswordReset(groupID, appID, email string) error {
+	res, resErr := c.do(
+		http.MethodPost,
+		fmt.Sprintf(userPasswordResetPathPattern, groupID, appID, url.PathEscape(email)),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return resErr
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{Action: "send user password reset", Actual: res.StatusCode}
+	}
+	return nil
+}
+
+func (c *client) ConfirmPendingUser(groupID, appID, email string) error {
+	res, resErr := c.do(
+		http.MethodPost,
+		fmt.Sprintf(userConfirmPathPattern, groupID, appID, url.PathEscape(email)),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return resErr
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{Action: "confirm pending user", Actual: res.StatusCode}
+	}
+	return nil
+}
+
+func (c *client) DeletePendingUser(groupID, appID, email string) error {
+	res, resErr := c.do(
+		http.MethodDelete,
+		fmt.Sprintf(userRegistrationPathPattern, groupID, appID, url.PathEscape(email)),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return resErr
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{Action: "delete pending user", Actual: res.StatusCode}
+	}
+	return nil
+}
+
 // UserFilter represents the optional filter parameters available for lists of users
 type UserFilter struct {
 	IDs       []string
 	Pending   bool
 	Providers []AuthProviderType
 	State     UserState
+
+	// After is the cursor to list users from, which is
+	// the id of the last user found by a previous call
+	After string
+	// Limit is the maximum number of users to find, 0 finds every user
+	Limit int
+
+	// CreatedBefore finds only the users created before the time, when set
+	CreatedBefore time.Time
 }
 
 func (c *client) FindUsers(groupID, appID string, filter UserFilter) ([]User, error) {
-	if filter.Pending {
-		return c.getPendingUsers(groupID, appID, filter.IDs)
+	var users []User
+	var err error
+	switch {
+	case filter.Pending:
+		users, err = c.getPendingUsers(groupID, appID, filter.IDs)
+	case len(filter.IDs) == 0:
+		// the server cannot filter by creation date, so each page
+		// of users is filtered by it as the page is found
+		return c.getUsers(groupID, appID, filter.State, filter.Providers, filter.After, filter.Limit, func(user User) bool {
+			return userCreatedBefore(user, filter.CreatedBefore)
+		})
+	default:
+		users, err = c.getUsersByIDs(groupID, appID, filter.IDs, filter.State, filter.Providers)
 	}
-	if len(filter.IDs) == 0 {
-		return c.getUsers(groupID, appID, filter.State, filter.Providers)
+	if err != nil {
+		return nil, err
 	}
-	return c.getUsersByIDs(groupID, appID, filter.IDs, filter.State, filter.Providers)
+	return pageUsers(usersCreatedBefore(users, filter.CreatedBefore), filter.After, filter.Limit), nil
+}
+
+// usersCreatedBefore returns the users created before the time, or every user when it is zero
+func usersCreatedBefore(users []User, t time.Time) []User {
+	if t.IsZero() {
+		return users
+	}
+	filtered := make([]User, 0, len(users))
+	for _, user := range users {
+		if userCreatedBefore(user, t) {
+			filtered = append(filtered, user)
+		}
+	}
+	return filtered
+}
+
+// userCreatedBefore returns whether the user was created before the time, or true when it is zero
+func userCreatedBefore(user User, t time.Time) bool {
+	return t.IsZero() || time.Unix(user.CreationDate, 0).Before(t)
+}
+
+// pageUsers returns the users following the one with the after id, up to the limit
+func pageUsers(users []User, after string, limit int) []User {
+	if after != "" {
+		for i, user := range users {
+			if user.ID == after {
+				users = users[i+1:]
+				break
+			}
+		}
+	}
+	if limit > 0 && len(users) > limit {
+		users = users[:limit]
+	}
+	return users
 }
 
 func (c *client) RevokeUserSessions(groupID, appID, userID string) error {
@@ -310,6 +533,42 @@ func (c *client) RevokeUserSessions(groupID, appID, userID string) error {
 	return nil
 }
 
+func (c *client) UserDevices(groupID, appID, userID string) ([]UserDevice, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(userDevicesPathPattern, groupID, appID, userID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return nil, resErr
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"get user devices", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var devices []UserDevice
+	if err := json.NewDecoder(res.Body).Decode(&devices); err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+func (c *client) RevokeUserDevice(groupID, appID, userID, deviceID string) error {
+	res, resErr := c.do(
+		http.MethodDelete,
+		fmt.Sprintf(userDevicePathPattern, groupID, appID, userID, deviceID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return resErr
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{Action: "revoke user device", Actual: res.StatusCode}
+	}
+	return nil
+}
+
 func (c *client) getPendingUsers(groupID, appID string, userIDs []string) ([]User, error) {
 	res, resErr := c.do(
 		http.MethodGet,
@@ -343,7 +602,7 @@ func (c *client) getPendingUsers(groupID, appID string, userIDs []string) ([]Use
 		if _, ok := userIDSet[user.ID]; !ok {
 			continue
 		}
-		filtered = append(users, user)
+		filtered = append(filtered, user)
 	}
 	return filtered, nil
 }
@@ -369,7 +628,8 @@ func (c *client) getUser(groupID, appID, userID string) (User, error) {
 	return user, nil
 }
 
-func (c *client) getUsers(groupID, appID string, userState UserState, authProviderTypes AuthProviderTypes) ([]User, error) {
+// getUsers finds the users which match, a page at a time, until the limit of them are found
+func (c *client) getUsers(groupID, appID string, userState UserState, authProviderTypes AuthProviderTypes, after string, limit int, match func(user User) bool) ([]User, error) {
 	options := api.RequestOptions{Query: make(map[string]string)}
 	if userState != UserStateNil {
 		options.Query[usersQueryStatus] = string(userState)
@@ -378,6 +638,38 @@ func (c *client) getUsers(groupID, appID string, userState UserState, authProvid
 		options.Query[usersQueryProviderTypes] = authProviderTypes.join(",")
 	}
 
+	if after != "" {
+		options.Query[usersQueryAfter] = after
+	}
+
+	// the server returns users a page at a time, each page
+	// continuing after the last user id of the previous one
+	var users []User
+	for limit <= 0 || len(users) < limit {
+		page, err := c.getUsersPage(groupID, appID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page) == 0 || page[len(page)-1].ID == options.Query[usersQueryAfter] {
+			break
+		}
+		for _, user := range page {
+			if match(user) {
+				users = append(users, user)
+			}
+		}
+
+		options.Query[usersQueryAfter] = page[len(page)-1].ID
+	}
+
+	if limit > 0 && len(users) > limit {
+		users = users[:limit]
+	}
+	return users, nil
+}
+
+func (c *client) getUsersPage(groupID, appID string, options api.RequestOptions) ([]User, error) {
 	res, resErr := c.do(http.MethodGet, fmt.Sprintf(usersPathPattern, groupID, appID), options)
 	if resErr != nil {
 		return nil, resErr
@@ -1,9 +1,17 @@
 package realm
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 )
 
@@ -92,3 +100,209 @@ func TestAuthProviderTypesJoin(t *testing.T) {
 		})
 	}
 }
+
+func TestFindUsersPages(t *testing.T) {
+	pages := map[string][]User{
+		"":      {{ID: "user1"}, {ID: "user2"}},
+		"user2": {{ID: "user3"}},
+		"user3": {},
+	}
+
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/users") {
+			w.WriteHeader(http.StatusNotFound) // the app is not deployed to a region
+			return
+		}
+		after := r.URL.Query().Get(usersQueryAfter)
+		afters = append(afters, after)
+		json.NewEncoder(w).Encode(pages[after])
+	}))
+	defer server.Close()
+
+	profile, err := user.NewProfile("find-users-test")
+	assert.Nil(t, err)
+	profile.SetSession(user.Session{AccessToken: "accessToken", RefreshToken: "refreshToken"})
+	defer profile.ClearSession()
+
+	client := NewAuthClient(server.URL, profile)
+
+	t.Run("should find every user", func(t *testing.T) {
+		afters = nil
+
+		users, err := client.FindUsers("groupID", "appID", UserFilter{})
+		assert.Nil(t, err)
+		assert.Equal(t, []User{{ID: "user1"}, {ID: "user2"}, {ID: "user3"}}, users)
+		assert.Equal(t, []string{"", "user2", "user3"}, afters)
+	})
+
+	t.Run("should find users after the cursor", func(t *testing.T) {
+		afters = nil
+
+		users, err := client.FindUsers("groupID", "appID", UserFilter{After: "user2"})
+		assert.Nil(t, err)
+		assert.Equal(t, []User{{ID: "user3"}}, users)
+		assert.Equal(t, []string{"user2", "user3"}, afters)
+	})
+
+	t.Run("should stop finding users once the limit is reached", func(t *testing.T) {
+		afters = nil
+
+		users, err := client.FindUsers("groupID", "appID", UserFilter{Limit: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, []User{{ID: "user1"}}, users)
+		assert.Equal(t, []string{""}, afters)
+	})
+}
+
+func TestFindUsersCreatedBefore(t *testing.T) {
+	created2021 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	created2023 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	pages := map[string][]User{
+		"":      {{ID: "user1", CreationDate: created2023}, {ID: "user2", CreationDate: created2021}},
+		"user2": {{ID: "user3", CreationDate: created2021}},
+		"user3": {},
+	}
+
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/users") {
+			w.WriteHeader(http.StatusNotFound) // the app is not deployed to a region
+			return
+		}
+		after := r.URL.Query().Get(usersQueryAfter)
+		afters = append(afters, after)
+		json.NewEncoder(w).Encode(pages[after])
+	}))
+	defer server.Close()
+
+	profile, err := user.NewProfile("find-users-created-before-test")
+	assert.Nil(t, err)
+	profile.SetSession(user.Session{AccessToken: "accessToken", RefreshToken: "refreshToken"})
+	defer profile.ClearSession()
+
+	client := NewAuthClient(server.URL, profile)
+
+	createdBefore := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should find every user created before the time", func(t *testing.T) {
+		afters = nil
+
+		users, err := client.FindUsers("groupID", "appID", UserFilter{CreatedBefore: createdBefore})
+		assert.Nil(t, err)
+		assert.Equal(t, []User{{ID: "user2", CreationDate: created2021}, {ID: "user3", CreationDate: created2021}}, users)
+		assert.Equal(t, []string{"", "user2", "user3"}, afters)
+	})
+
+	t.Run("should stop finding users once the limit of users created before the time is reached", func(t *testing.T) {
+		afters = nil
+
+		users, err := client.FindUsers("groupID", "appID", UserFilter{CreatedBefore: createdBefore, Limit: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, []User{{ID: "user2", CreationDate: created2021}}, users)
+		assert.Equal(t, []string{""}, afters)
+	})
+}
+
+func TestPageUsers(t *testing.T) {
+	users := []User{{ID: "user1"}, {ID: "user2"}, {ID: "user3"}}
+
+	for _, tc := range []struct {
+		description string
+		after       string
+		limit       int
+		expected    []User
+	}{
+		{
+			description: "should return every user without a cursor or limit",
+			expected:    users,
+		},
+		{
+			description: "should return the users after the cursor",
+			after:       "user1",
+			expected:    users[1:],
+		},
+		{
+			description: "should return up to the limit of users after the cursor",
+			after:       "user1",
+			limit:       1,
+			expected:    users[1:2],
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, pageUsers(users, tc.after, tc.limit))
+		})
+	}
+}
+
+func TestUsersCreatedBefore(t *testing.T) {
+	users := []User{
+		{ID: "user1", CreationDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC).Unix()},
+		{ID: "user2", CreationDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC).Unix()},
+	}
+
+	t.Run("should return every user without a time", func(t *testing.T) {
+		assert.Equal(t, users, usersCreatedBefore(users, time.Time{}))
+	})
+
+	t.Run("should return the users created before the time", func(t *testing.T) {
+		assert.Equal(t, users[:1], usersCreatedBefore(users, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestUserEmail(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		user        User
+		expected    string
+	}{
+		{"should return the email from the user data", User{Data: map[string]interface{}{"email": "user@test.com"}}, "user@test.com"},
+		{"should return the email login id of a pending user", User{LoginIDs: []UserLoginID{{IDType: "email", ID: "pending@test.com"}}}, "pending@test.com"},
+		{"should return an empty email otherwise", User{}, ""},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.user.Email())
+		})
+	}
+}
+
+func TestRevokeAllUserSessions(t *testing.T) {
+	var mu sync.Mutex
+	var revoked []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users"):
+			if r.URL.Query().Get(usersQueryAfter) == "" {
+				json.NewEncoder(w).Encode([]User{{ID: "user1"}, {ID: "user2"}})
+				return
+			}
+			json.NewEncoder(w).Encode([]User{})
+		case strings.HasSuffix(r.URL.Path, "/logout"):
+			mu.Lock()
+			revoked = append(revoked, strings.Split(r.URL.Path, "/")[9])
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	profile, err := user.NewProfile("revoke-all-test")
+	assert.Nil(t, err)
+	profile.SetSession(user.Session{AccessToken: "accessToken", RefreshToken: "refreshToken"})
+	defer profile.ClearSession()
+
+	client := NewAuthClient(server.URL, profile)
+
+	var progress []string
+	revocations, err := client.RevokeAllUserSessions("groupID", "appID", UserFilter{}, func(processed, total int) {
+		progress = append(progress, fmt.Sprintf("%d/%d", processed, total))
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []UserSessionsRevocation{{User: User{ID: "user1"}}, {User: User{ID: "user2"}}}, revocations)
+	sort.Strings(revoked)
+	assert.Equal(t, []string{"user1", "user2"}, revoked)
+	assert.Equal(t, []string{"1/2", "2/2"}, progress)
+}
@@ -0,0 +1,105 @@
+package realm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+const (
+	valuesPathPattern = appPathPattern + "/values"
+	valuePathPattern  = valuesPathPattern + "/%s"
+)
+
+// Value is a value stored in a Realm app
+type Value struct {
+	ID         string      `json:"_id"`
+	Name       string      `json:"name"`
+	Private    bool        `json:"private"`
+	FromSecret bool        `json:"from_secret"`
+	Value      interface{} `json:"value,omitempty"`
+}
+
+func (c *client) Values(groupID, appID string) ([]Value, error) {
+	res, resErr := c.do(
+		http.MethodGet,
+		fmt.Sprintf(valuesPathPattern, groupID, appID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return nil, resErr
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"values", res.StatusCode}
+	}
+
+	defer res.Body.Close()
+
+	var values []Value
+	if err := json.NewDecoder(res.Body).Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func (c *client) CreateValue(groupID, appID string, value Value) (Value, error) {
+	res, resErr := c.doJSON(
+		http.MethodPost,
+		fmt.Sprintf(valuesPathPattern, groupID, appID),
+		value,
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return Value{}, resErr
+	}
+	if res.StatusCode != http.StatusCreated {
+		return Value{}, api.ErrUnexpectedStatusCode{"create value", res.StatusCode}
+	}
+	defer res.Body.Close()
+
+	var created Value
+	if err := json.NewDecoder(res.Body).Decode(&created); err != nil {
+		return Value{}, err
+	}
+	return created, nil
+}
+
+func (c *client) DeleteValue(groupID, appID, valueID string) error {
+	res, err := c.do(
+		http.MethodDelete,
+		fmt.Sprintf(valuePathPattern, groupID, appID, valueID),
+		api.RequestOptions{},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"delete value", res.StatusCode}
+	}
+
+	return nil
+}
+
+func (c *client) UpdateValue(groupID, appID string, value Value) error {
+	res, err := c.doJSON(
+		http.MethodPut,
+		fmt.Sprintf(valuePathPattern, groupID, appID, value.ID),
+		value,
+		api.RequestOptions{},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"update value", res.StatusCode}
+	}
+
+	return nil
+}
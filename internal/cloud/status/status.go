@@ -0,0 +1,125 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+// DefaultBaseURL is the base url of the MongoDB Cloud status page
+const DefaultBaseURL = "https://status.cloud.mongodb.com"
+
+const (
+	summaryPath = "/api/v2/summary.json"
+
+	// requestTimeout is kept short since the status page is only
+	// consulted while reporting another error
+	requestTimeout = 5 * time.Second
+)
+
+// set of scheduled maintenance statuses considered to be ongoing
+const (
+	maintenanceStatusInProgress = "in_progress"
+	maintenanceStatusVerifying  = "verifying"
+)
+
+// Incident is an ongoing MongoDB Cloud incident or maintenance window
+type Incident struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Impact    string `json:"impact"`
+	Shortlink string `json:"shortlink"`
+}
+
+type summary struct {
+	Incidents             []Incident `json:"incidents"`
+	ScheduledMaintenances []Incident `json:"scheduled_maintenances"`
+}
+
+// Client is a MongoDB Cloud status client
+type Client interface {
+	Incidents() ([]Incident, error)
+}
+
+// NewClient returns a new MongoDB Cloud status client
+func NewClient(baseURL string) Client {
+	return &client{baseURL}
+}
+
+type client struct {
+	baseURL string
+}
+
+// Incidents returns the unresolved incidents and ongoing maintenance windows
+// currently reported by the MongoDB Cloud status page
+func (c *client) Incidents() ([]Incident, error) {
+	httpClient := &http.Client{Timeout: requestTimeout}
+
+	res, err := httpClient.Get(c.baseURL + summaryPath)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, api.ErrUnexpectedStatusCode{"get status summary", res.StatusCode}
+	}
+
+	var s summary
+	if err := json.NewDecoder(res.Body).Decode(&s); err != nil {
+		return nil, err
+	}
+
+	incidents := s.Incidents
+	for _, maintenance := range s.ScheduledMaintenances {
+		switch maintenance.Status {
+		case maintenanceStatusInProgress, maintenanceStatusVerifying:
+			incidents = append(incidents, maintenance)
+		}
+	}
+	return incidents, nil
+}
+
+// ErrServiceDegraded is a server error that occurred
+// while MongoDB Cloud is reporting incidents
+type ErrServiceDegraded struct {
+	Err       error
+	Incidents []Incident
+}
+
+func (err ErrServiceDegraded) Error() string {
+	var sb strings.Builder
+	sb.WriteString(err.Err.Error())
+	sb.WriteString("\nMongoDB Cloud is currently reporting the following issue(s), which may be the cause of this error:")
+	for _, incident := range err.Incidents {
+		sb.WriteString(fmt.Sprintf("\n  - %s (%s)", incident.Name, incident.Status))
+		if incident.Shortlink != "" {
+			sb.WriteString(": " + incident.Shortlink)
+		}
+	}
+	return sb.String()
+}
+
+// Unwrap returns the underlying server error
+func (err ErrServiceDegraded) Unwrap() error {
+	return err.Err
+}
+
+// CheckIncidents annotates the provided server error with the incidents
+// currently reported by MongoDB Cloud, if any. Failing to reach the status
+// page is not reported, in which case the server error is returned as is
+func CheckIncidents(c Client, err error) error {
+	if c == nil {
+		return err
+	}
+
+	incidents, incidentsErr := c.Incidents()
+	if incidentsErr != nil || len(incidents) == 0 {
+		return err
+	}
+	return ErrServiceDegraded{err, incidents}
+}
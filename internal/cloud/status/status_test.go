@@ -0,0 +1,88 @@
+package status_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/status"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+const testSummary = `{
+  "status": {"indicator": "major", "description": "Partial System Outage"},
+  "incidents": [
+    {"name": "Elevated API error rates", "status": "investigating", "impact": "major", "shortlink": "https://stspg.io/abc"}
+  ],
+  "scheduled_maintenances": [
+    {"name": "Database upgrade", "status": "in_progress", "impact": "maintenance", "shortlink": "https://stspg.io/def"},
+    {"name": "Network upgrade", "status": "scheduled", "impact": "maintenance", "shortlink": "https://stspg.io/ghi"}
+  ]
+}`
+
+func TestStatusIncidents(t *testing.T) {
+	t.Run("should return the incidents and ongoing maintenance windows", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v2/summary.json", r.URL.Path)
+			w.Write([]byte(testSummary))
+		}))
+		defer server.Close()
+
+		incidents, err := status.NewClient(server.URL).Incidents()
+		assert.Nil(t, err)
+		assert.Equal(t, []status.Incident{
+			{Name: "Elevated API error rates", Status: "investigating", Impact: "major", Shortlink: "https://stspg.io/abc"},
+			{Name: "Database upgrade", Status: "in_progress", Impact: "maintenance", Shortlink: "https://stspg.io/def"},
+		}, incidents)
+	})
+
+	t.Run("should return an error when the status page is unavailable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		_, err := status.NewClient(server.URL).Incidents()
+		assert.Equal(t, errors.New("failed to get status summary: unexpected status code 502"), errors.New(err.Error()))
+	})
+}
+
+type testClient struct {
+	incidents []status.Incident
+	err       error
+}
+
+func (c testClient) Incidents() ([]status.Incident, error) {
+	return c.incidents, c.err
+}
+
+func TestCheckIncidents(t *testing.T) {
+	serverErr := errors.New("something bad happened")
+
+	t.Run("should annotate the error with the reported incidents", func(t *testing.T) {
+		err := status.CheckIncidents(testClient{incidents: []status.Incident{
+			{Name: "Elevated API error rates", Status: "investigating", Shortlink: "https://stspg.io/abc"},
+			{Name: "Database upgrade", Status: "in_progress"},
+		}}, serverErr)
+
+		assert.Equal(t, `something bad happened
+MongoDB Cloud is currently reporting the following issue(s), which may be the cause of this error:
+  - Elevated API error rates (investigating): https://stspg.io/abc
+  - Database upgrade (in_progress)`, err.Error())
+		assert.True(t, errors.Is(err, serverErr), "expected error to wrap the server error")
+	})
+
+	for _, tc := range []struct {
+		description string
+		client      status.Client
+	}{
+		{"without a status client", nil},
+		{"when no incidents are reported", testClient{}},
+		{"when the status page cannot be reached", testClient{err: errors.New("no such host")}},
+	} {
+		t.Run("should return the error as is "+tc.description, func(t *testing.T) {
+			assert.Equal(t, serverErr, status.CheckIncidents(tc.client, serverErr))
+		})
+	}
+}
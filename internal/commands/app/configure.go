@@ -0,0 +1,188 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagConfigureNameUsage = "change the app's display name"
+
+	flagAllowedRequestOrigins      = "allowed-request-origins"
+	flagAllowedRequestOriginsUsage = `set the origins allowed to make requests to the app, replacing the current ones, e.g. "https://example.com" (specify "" to allow any origin)`
+
+	flagDeletionProtection      = "deletion-protection"
+	flagDeletionProtectionUsage = `turn the app's deletion protection on or off, available options: ["on", "off"]`
+
+	headerSetting = "Setting"
+	headerValue   = "Value"
+
+	settingName                  = "Name"
+	settingEnvironment           = "Environment"
+	settingAllowedRequestOrigins = "Allowed Request Origins"
+	settingDeletionProtection    = "Deletion Protection"
+
+	// displayAnyOrigin is displayed when no allowed request origins are set, as any origin is then allowed
+	displayAnyOrigin = "any"
+)
+
+// CommandMetaConfigure is the command meta for the `app configure` command
+var CommandMetaConfigure = cli.CommandMeta{
+	Use:         "configure",
+	Display:     "app configure",
+	Description: "Configure the settings of your Realm app",
+	HelpText: `Changes the settings of your Realm app directly, without pushing its
+configuration. Only the settings specified with flags are changed.
+
+With this command, you can:
+  - Rename the app with "--name", which does not change its Client App ID
+  - Change the app's environment with "--environment"
+  - Set the origins allowed to make requests to the app with
+    "--allowed-request-origins", replacing the ones currently allowed
+  - Turn deletion protection on or off with "--deletion-protection", which
+    prevents the app from being deleted until it is turned off again
+
+Changes made with this command are not written to your local app directory,
+run "pull" to update it.`,
+}
+
+// CommandConfigure is the `app configure` command
+type CommandConfigure struct {
+	inputs configureInputs
+}
+
+type configureInputs struct {
+	cli.ProjectInputs
+	Name                  string
+	Environment           realm.Environment
+	AllowedRequestOrigins []string
+	DeletionProtection    toggle
+}
+
+// Flags is the command flags
+func (cmd *CommandConfigure) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.Name, flagName, "", flagConfigureNameUsage)
+	fs.VarP(&cmd.inputs.Environment, flagEnvironment, flagEnvironmentShort, flagEnvironmentUsage)
+	fs.StringSliceVar(&cmd.inputs.AllowedRequestOrigins, flagAllowedRequestOrigins, nil, flagAllowedRequestOriginsUsage)
+	fs.Var(&cmd.inputs.DeletionProtection, flagDeletionProtection, flagDeletionProtectionUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandConfigure) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandConfigure) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	var update realm.AppSettingsUpdate
+	var rows []map[string]interface{}
+
+	if cmd.inputs.Name != "" {
+		update.Name = &cmd.inputs.Name
+
+		rows = append(rows, map[string]interface{}{
+			headerSetting: settingName,
+			headerValue:   cmd.inputs.Name,
+		})
+	}
+
+	if cmd.inputs.Environment != realm.EnvironmentNone {
+		update.Environment = &cmd.inputs.Environment
+
+		rows = append(rows, map[string]interface{}{
+			headerSetting: settingEnvironment,
+			headerValue:   cmd.inputs.Environment,
+		})
+	}
+
+	if cmd.inputs.AllowedRequestOrigins != nil {
+		update.AllowedRequestOrigins = &cmd.inputs.AllowedRequestOrigins
+
+		rows = append(rows, map[string]interface{}{
+			headerSetting: settingAllowedRequestOrigins,
+			headerValue:   displayOrigins(cmd.inputs.AllowedRequestOrigins),
+		})
+	}
+
+	if cmd.inputs.DeletionProtection != toggleEmpty {
+		deletionProtection := cmd.inputs.DeletionProtection == toggleOn
+		update.DeletionProtection = &deletionProtection
+
+		rows = append(rows, map[string]interface{}{
+			headerSetting: settingDeletionProtection,
+			headerValue:   cmd.inputs.DeletionProtection,
+		})
+	}
+
+	if err := clients.Realm.UpdateAppSettings(app.GroupID, app.ID, update); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Successfully configured app %s", app.ClientAppID),
+		[]string{headerSetting, headerValue},
+		rows...,
+	))
+	return nil
+}
+
+func (i *configureInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Name == "" &&
+		i.Environment == realm.EnvironmentNone &&
+		i.AllowedRequestOrigins == nil &&
+		i.DeletionProtection == toggleEmpty {
+		return fmt.Errorf(`must specify a setting to configure, such as "--%s"`, flagDeletionProtection)
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+func displayOrigins(origins []string) string {
+	if len(origins) == 0 {
+		return displayAnyOrigin
+	}
+	return strings.Join(origins, ", ")
+}
+
+// toggle is a setting which is turned on or off
+type toggle string
+
+// set of supported toggle values
+const (
+	toggleEmpty toggle = "" // zero-valued to be flag's default
+	toggleOn    toggle = "on"
+	toggleOff   toggle = "off"
+)
+
+// String returns the string representation
+func (t toggle) String() string { return string(t) }
+
+// Type returns the toggle type
+func (t toggle) Type() string { return flags.TypeString }
+
+// Set validates and sets the toggle value
+func (t *toggle) Set(val string) error {
+	value := toggle(strings.ToLower(val))
+
+	if value != toggleOn && value != toggleOff {
+		return errors.New(`unsupported value, use one of [on, off] instead`)
+	}
+
+	*t = value
+	return nil
+}
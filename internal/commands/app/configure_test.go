@@ -0,0 +1,144 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestAppConfigureHandler(t *testing.T) {
+	on, off := true, false
+	name := "renamed"
+	environment := realm.EnvironmentProduction
+	origins := []string{"https://example.com", "https://www.example.com"}
+	anyOrigin := []string{}
+
+	for _, tc := range []struct {
+		description    string
+		inputs         configureInputs
+		expectedUpdate realm.AppSettingsUpdate
+		expectedOutput string
+	}{
+		{
+			description:    "should turn on the deletion protection",
+			inputs:         configureInputs{DeletionProtection: toggleOn},
+			expectedUpdate: realm.AppSettingsUpdate{DeletionProtection: &on},
+			expectedOutput: `Successfully configured app app1-abcde
+  Setting              Value
+  -------------------  -----
+  Deletion Protection  on   
+`,
+		},
+		{
+			description:    "should turn off the deletion protection",
+			inputs:         configureInputs{DeletionProtection: toggleOff},
+			expectedUpdate: realm.AppSettingsUpdate{DeletionProtection: &off},
+			expectedOutput: `Successfully configured app app1-abcde
+  Setting              Value
+  -------------------  -----
+  Deletion Protection  off  
+`,
+		},
+		{
+			description: "should change the name, environment and allowed request origins",
+			inputs: configureInputs{
+				Name:                  name,
+				Environment:           environment,
+				AllowedRequestOrigins: origins,
+			},
+			expectedUpdate: realm.AppSettingsUpdate{
+				Name:                  &name,
+				Environment:           &environment,
+				AllowedRequestOrigins: &origins,
+			},
+			expectedOutput: `Successfully configured app app1-abcde
+  Setting                  Value                                       
+  -----------------------  --------------------------------------------
+  Name                     renamed                                     
+  Environment              production                                  
+  Allowed Request Origins  https://example.com, https://www.example.com
+`,
+		},
+		{
+			description:    "should allow requests from any origin",
+			inputs:         configureInputs{AllowedRequestOrigins: anyOrigin},
+			expectedUpdate: realm.AppSettingsUpdate{AllowedRequestOrigins: &anyOrigin},
+			expectedOutput: `Successfully configured app app1-abcde
+  Setting                  Value
+  -----------------------  -----
+  Allowed Request Origins  any  
+`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app1-abcde"}}, nil
+			}
+
+			var capturedGroupID, capturedAppID string
+			var capturedUpdate realm.AppSettingsUpdate
+			realmClient.UpdateAppSettingsFn = func(groupID, appID string, update realm.AppSettingsUpdate) error {
+				capturedGroupID, capturedAppID, capturedUpdate = groupID, appID, update
+				return nil
+			}
+
+			cmd := &CommandConfigure{tc.inputs}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+
+			assert.Equal(t, "groupID", capturedGroupID)
+			assert.Equal(t, "appID", capturedAppID)
+			assert.Equal(t, tc.expectedUpdate, capturedUpdate)
+		})
+	}
+
+	t.Run("should return an error when the settings fail to update", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID"}}, nil
+		}
+		realmClient.UpdateAppSettingsFn = func(groupID, appID string, update realm.AppSettingsUpdate) error {
+			return errors.New("something bad happened")
+		}
+
+		cmd := &CommandConfigure{configureInputs{DeletionProtection: toggleOn}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
+
+func TestAppConfigureInputs(t *testing.T) {
+	t.Run("should return an error without a setting to configure", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := configureInputs{ProjectInputs: cli.ProjectInputs{Project: "groupID", App: "appID"}}
+		assert.Equal(t, errors.New(`must specify a setting to configure, such as "--deletion-protection"`), i.Resolve(profile, nil))
+	})
+}
+
+func TestToggle(t *testing.T) {
+	t.Run("should set the toggle to on or off", func(t *testing.T) {
+		var value toggle
+
+		assert.Nil(t, value.Set("ON"))
+		assert.Equal(t, toggleOn, value)
+
+		assert.Nil(t, value.Set("off"))
+		assert.Equal(t, toggleOff, value)
+	})
+
+	t.Run("should return an error for an unsupported value", func(t *testing.T) {
+		var value toggle
+		assert.Equal(t, errors.New("unsupported value, use one of [on, off] instead"), value.Set("true"))
+	})
+}
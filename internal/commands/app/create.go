@@ -2,9 +2,12 @@ package app
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/local"
 	"github.com/10gen/realm-cli/internal/terminal"
@@ -25,6 +28,16 @@ directory for your project.
 You can specify a "--remote" flag to create a Realm app from an existing app;
 if you do not specify a "--remote" flag, the CLI will create a default Realm app.
 
+You can specify a "--template-file" flag to create a Realm app from an app
+template packaged by "app template package", renaming its data sources with
+"--template-data-source" and setting the Secrets it references with
+"--template-secret". You will be prompted for any Secret value not set.
+
+You can specify the "--cluster" and "--data-lake" flags multiple times to link
+several Atlas clusters and data lakes to your new Realm app, naming each data
+source with the matching "--cluster-service-name" and "--data-lake-service-name"
+flags. The CLI waits for any linked cluster still being provisioned to be ready.
+
 NOTE: To create a Realm app without deploying it, use "app init".`,
 }
 
@@ -41,9 +54,14 @@ func (cmd *CommandCreate) Flags(fs *pflag.FlagSet) {
 	fs.VarP(&cmd.inputs.Location, flagLocation, flagLocationShort, flagLocationUsage)
 	fs.VarP(&cmd.inputs.DeploymentModel, flagDeploymentModel, flagDeploymentModelShort, flagDeploymentModelUsage)
 	fs.VarP(&cmd.inputs.Environment, flagEnvironment, flagEnvironmentShort, flagEnvironmentUsage)
-	fs.StringVar(&cmd.inputs.Cluster, flagCluster, "", flagClusterUsage)
-	fs.StringVar(&cmd.inputs.DataLake, flagDataLake, "", flagDataLakeUsage)
+	fs.StringSliceVar(&cmd.inputs.Clusters, flagCluster, []string{}, flagClusterUsage)
+	fs.StringSliceVar(&cmd.inputs.ClusterServiceNames, flagClusterServiceName, []string{}, flagClusterServiceNameUsage)
+	fs.StringSliceVar(&cmd.inputs.DataLakes, flagDataLake, []string{}, flagDataLakeUsage)
+	fs.StringSliceVar(&cmd.inputs.DataLakeServiceNames, flagDataLakeServiceName, []string{}, flagDataLakeServiceNameUsage)
 	fs.BoolVarP(&cmd.inputs.DryRun, flagDryRun, flagDryRunShort, false, flagDryRunUsage)
+	fs.StringVar(&cmd.inputs.TemplateFile, flagTemplateFile, "", flagTemplateFileUsage)
+	fs.StringToStringVar(&cmd.inputs.TemplateDataSources, flagTemplateDataSource, map[string]string{}, flagTemplateDataSourceUsage)
+	fs.StringToStringVar(&cmd.inputs.TemplateSecrets, flagTemplateSecret, map[string]string{}, flagTemplateSecretUsage)
 
 	fs.StringVar(&cmd.inputs.Project, flagProject, "", flagProjectUsage)
 	flags.MarkHidden(fs, flagProject)
@@ -87,40 +105,52 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	var dsCluster dataSourceCluster
-	if cmd.inputs.Cluster != "" {
-		dsCluster, err = cmd.inputs.resolveCluster(clients.Atlas, groupID)
+	var dsClusters []dataSourceCluster
+	if len(cmd.inputs.Clusters) > 0 {
+		dsClusters, err = cmd.inputs.resolveClusters(clients.Atlas, groupID)
 		if err != nil {
 			return err
 		}
 	}
 
-	var dsDataLake dataSourceDataLake
-	if cmd.inputs.DataLake != "" {
-		dsDataLake, err = cmd.inputs.resolveDataLake(clients.Atlas, groupID)
+	var dsDataLakes []dataSourceDataLake
+	if len(cmd.inputs.DataLakes) > 0 {
+		dsDataLakes, err = cmd.inputs.resolveDataLakes(clients.Atlas, groupID)
 		if err != nil {
 			return err
 		}
 	}
 
+	if err := checkDataSourceNames(dsClusters, dsDataLakes); err != nil {
+		return err
+	}
+
 	if cmd.inputs.DryRun {
-		logs := make([]terminal.Log, 0, 4)
-		if appRemote.IsZero() {
+		logs := make([]terminal.Log, 0, 2+len(dsClusters)+len(dsDataLakes))
+		if cmd.inputs.template != nil {
+			logs = append(logs, terminal.NewTextLog("A Realm app based on the app template '%s' would be created at %s", cmd.inputs.TemplateFile, dir))
+		} else if appRemote.IsZero() {
 			logs = append(logs, terminal.NewTextLog("A minimal Realm app would be created at %s", dir))
 		} else {
 			logs = append(logs, terminal.NewTextLog("A Realm app based on the Realm app '%s' would be created at %s", cmd.inputs.RemoteApp, dir))
 		}
-		if dsCluster.Name != "" {
-			logs = append(logs, terminal.NewTextLog("The cluster '%s' would be linked as data source '%s'", cmd.inputs.Cluster, dsCluster.Name))
+		for _, dsCluster := range dsClusters {
+			logs = append(logs, terminal.NewTextLog("The cluster '%s' would be linked as data source '%s'", dsCluster.Config.ClusterName, dsCluster.Name))
 		}
-		if dsDataLake.Name != "" {
-			logs = append(logs, terminal.NewTextLog("The data lake '%s' would be linked as data source '%s'", cmd.inputs.DataLake, dsDataLake.Name))
+		for _, dsDataLake := range dsDataLakes {
+			logs = append(logs, terminal.NewTextLog("The data lake '%s' would be linked as data source '%s'", dsDataLake.Config.DataLakeName, dsDataLake.Name))
 		}
 		logs = append(logs, terminal.NewFollowupLog("To create this app run", cmd.display(true)))
 		ui.Print(logs...)
 		return nil
 	}
 
+	if len(dsClusters) > 0 {
+		if err := waitForClusters(ui, clients.Atlas, groupID, dsClusters); err != nil {
+			return err
+		}
+	}
+
 	appRealm, err := clients.Realm.CreateApp(
 		groupID,
 		cmd.inputs.Name,
@@ -132,7 +162,16 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 
 	var appLocal local.App
 
-	if appRemote.IsZero() {
+	if cmd.inputs.template != nil {
+		appLocal, err = cmd.inputs.template.RenderApp(dir, local.AppTemplateParams{
+			ClientAppID: appRealm.ClientAppID,
+			Name:        cmd.inputs.Name,
+			DataSources: cmd.inputs.TemplateDataSources,
+		})
+		if err != nil {
+			return err
+		}
+	} else if appRemote.IsZero() {
 		appLocal = local.NewApp(
 			dir,
 			appRealm.ClientAppID,
@@ -151,7 +190,7 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 		_, zipPkg, err := clients.Realm.Export(
 			appRemote.GroupID,
 			appRemote.AppID,
-			realm.ExportRequest{},
+			realm.ExportRequest{ConfigVersion: cmd.inputs.ConfigVersion},
 		)
 		if err != nil {
 			return err
@@ -165,7 +204,7 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 		}
 	}
 
-	if dsCluster.Name != "" {
+	for _, dsCluster := range dsClusters {
 		local.AddDataSource(appLocal.AppData, map[string]interface{}{
 			"name": dsCluster.Name,
 			"type": dsCluster.Type,
@@ -176,7 +215,7 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 			},
 		})
 	}
-	if dsDataLake.Name != "" {
+	for _, dsDataLake := range dsDataLakes {
 		local.AddDataSource(appLocal.AppData, map[string]interface{}{
 			"name": dsDataLake.Name,
 			"type": dsDataLake.Type,
@@ -194,19 +233,27 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
+	if cmd.inputs.template != nil {
+		for _, name := range cmd.inputs.template.Manifest.Secrets {
+			if _, err := clients.Realm.CreateSecret(appRealm.GroupID, appRealm.ID, name, cmd.inputs.TemplateSecrets[name]); err != nil {
+				return fmt.Errorf("failed to create secret '%s': %w", name, err)
+			}
+		}
+	}
+
 	if err := clients.Realm.Import(appRealm.GroupID, appRealm.ID, appLocal.AppData); err != nil {
 		return err
 	}
 
 	headers := []string{"Info", "Details"}
-	rows := make([]map[string]interface{}, 0, 5)
+	rows := make([]map[string]interface{}, 0, 3+len(dsClusters)+len(dsDataLakes))
 	rows = append(rows, map[string]interface{}{"Info": "Client App ID", "Details": appRealm.ClientAppID})
 	rows = append(rows, map[string]interface{}{"Info": "Realm Directory", "Details": dir})
 	rows = append(rows, map[string]interface{}{"Info": "Realm UI", "Details": fmt.Sprintf("%s/groups/%s/apps/%s/dashboard", profile.RealmBaseURL(), appRealm.GroupID, appRealm.ID)})
-	if dsCluster.Name != "" {
+	for _, dsCluster := range dsClusters {
 		rows = append(rows, map[string]interface{}{"Info": "Data Source (Cluster)", "Details": dsCluster.Name})
 	}
-	if dsDataLake.Name != "" {
+	for _, dsDataLake := range dsDataLakes {
 		rows = append(rows, map[string]interface{}{"Info": "Data Source (Data Lake)", "Details": dsDataLake.Name})
 	}
 
@@ -215,6 +262,54 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 	return nil
 }
 
+var (
+	clusterReadyPollInterval = 5 * time.Second
+	clusterReadyTimeout      = 10 * time.Minute
+)
+
+func waitForClusters(ui terminal.UI, client atlas.Client, groupID string, dsClusters []dataSourceCluster) error {
+	s := ui.Spinner("")
+	defer s.Stop()
+
+	deadline := time.Now().Add(clusterReadyTimeout)
+	for {
+		clusters, err := client.Clusters(groupID)
+		if err != nil {
+			return err
+		}
+
+		states := make(map[string]string, len(clusters))
+		for _, cluster := range clusters {
+			states[cluster.Name] = cluster.State
+		}
+
+		var pending []string
+		for _, dsCluster := range dsClusters {
+			switch state := states[dsCluster.Config.ClusterName]; state {
+			case atlas.ClusterStateCreating, atlas.ClusterStateUpdating, atlas.ClusterStateRepairing:
+				pending = append(pending, dsCluster.Config.ClusterName)
+			case atlas.ClusterStateDeleting, atlas.ClusterStateDeleted:
+				return fmt.Errorf("cannot link Atlas cluster '%s' while it is in the %s state", dsCluster.Config.ClusterName, state)
+			}
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for Atlas clusters to be ready: %s", strings.Join(pending, ", "))
+		}
+
+		s.Lock()
+		s.Suffix = " Waiting for Atlas clusters to be ready: " + strings.Join(pending, ", ")
+		s.Unlock()
+		s.Start()
+
+		time.Sleep(clusterReadyPollInterval)
+	}
+}
+
 func (cmd *CommandCreate) display(omitDryRun bool) string {
 	return cli.CommandDisplay(CommandMetaCreate.Display, cmd.inputs.args(omitDryRun))
 }
@@ -2,8 +2,11 @@ package app
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path"
+	"sort"
+	"strings"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -21,22 +24,49 @@ var (
 	flagLocalPathCreateUsage = "the local path to create your new Realm app in, defaults to the Realm app name"
 
 	flagCluster      = "cluster"
-	flagClusterUsage = "include to link an Atlas cluster to your Realm app"
+	flagClusterUsage = "include to link an Atlas cluster to your Realm app, can be specified multiple times"
+
+	flagClusterServiceName      = "cluster-service-name"
+	flagClusterServiceNameUsage = "specify the data source name of each linked Atlas cluster in the order the clusters are specified, defaults to 'mongodb-atlas' for the first cluster and the cluster name for the rest"
 
 	flagDataLake      = "data-lake"
-	flagDataLakeUsage = "include to link an Atlas data lake to your Realm app"
+	flagDataLakeUsage = "include to link an Atlas data lake to your Realm app, can be specified multiple times"
+
+	flagDataLakeServiceName      = "data-lake-service-name"
+	flagDataLakeServiceNameUsage = "specify the data source name of each linked Atlas data lake in the order the data lakes are specified, defaults to 'mongodb-datalake' for the first data lake and the data lake name for the rest"
 
 	flagDryRun      = "dry-run"
 	flagDryRunShort = "x"
 	flagDryRunUsage = "include to run without writing any changes to the file system nor deploying any changes to the Realm server"
+
+	flagTemplateFile      = "template-file"
+	flagTemplateFileUsage = "the app template to create your new Realm app from, as packaged by 'app template package'"
+
+	flagTemplateDataSource      = "template-data-source"
+	flagTemplateDataSourceUsage = "rename a data source of the app template, in the form of 'name=new name', can be specified multiple times"
+
+	flagTemplateSecret      = "template-secret"
+	flagTemplateSecretUsage = "set the value of a Secret referenced by the app template, in the form of 'name=value', can be specified multiple times; you will be prompted for any value not set"
+)
+
+const (
+	defaultClusterServiceName  = "mongodb-atlas"
+	defaultDataLakeServiceName = "mongodb-datalake"
 )
 
 type createInputs struct {
 	newAppInputs
-	LocalPath string
-	Cluster   string
-	DataLake  string
-	DryRun    bool
+	LocalPath            string
+	Clusters             []string
+	ClusterServiceNames  []string
+	DataLakes            []string
+	DataLakeServiceNames []string
+	DryRun               bool
+	TemplateFile         string
+	TemplateDataSources  map[string]string
+	TemplateSecrets      map[string]string
+
+	template *local.AppTemplate
 }
 
 type dataSourceCluster struct {
@@ -62,9 +92,19 @@ type configDataLake struct {
 }
 
 func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.TemplateFile != "" {
+		if err := i.resolveTemplate(ui); err != nil {
+			return err
+		}
+	}
+
 	if i.RemoteApp == "" {
 		if i.Name == "" {
-			if err := ui.AskOne(&i.Name, &survey.Input{Message: "App Name"}); err != nil {
+			var defaultName string
+			if i.template != nil {
+				defaultName = i.template.Manifest.Name
+			}
+			if err := ui.AskOne(&i.Name, &survey.Input{Message: "App Name", Default: defaultName}); err != nil {
 				return err
 			}
 		}
@@ -76,9 +116,53 @@ func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 		}
 		if i.ConfigVersion == realm.AppConfigVersionZero {
 			i.ConfigVersion = realm.DefaultAppConfigVersion
+			if i.template != nil {
+				i.ConfigVersion = i.template.Manifest.ConfigVersion
+			}
+		}
+	}
+
+	return nil
+}
+
+func (i *createInputs) resolveTemplate(ui terminal.UI) error {
+	if i.RemoteApp != "" {
+		return fmt.Errorf(`cannot use "--%s" with "--%s"`, flagRemoteAppNew, flagTemplateFile)
+	}
+
+	tmpl, err := local.ReadAppTemplate(i.TemplateFile)
+	if err != nil {
+		return err
+	}
+
+	dataSources := make(map[string]struct{}, len(tmpl.Manifest.DataSources))
+	for _, name := range tmpl.Manifest.DataSources {
+		dataSources[name] = struct{}{}
+	}
+	for name, newName := range i.TemplateDataSources {
+		if _, ok := dataSources[name]; !ok {
+			return fmt.Errorf("app template has no data source named '%s'", name)
+		}
+		if newName == "" || strings.ContainsAny(newName, `/\`) || newName == "." || newName == ".." {
+			return fmt.Errorf("invalid data source name '%s'", newName)
+		}
+	}
+
+	if i.TemplateSecrets == nil {
+		i.TemplateSecrets = map[string]string{}
+	}
+	for _, name := range tmpl.Manifest.Secrets {
+		if _, ok := i.TemplateSecrets[name]; ok {
+			continue
+		}
+		var value string
+		if err := ui.AskOne(&value, &survey.Password{Message: fmt.Sprintf("Value for Secret '%s'", name)}); err != nil {
+			return err
 		}
+		i.TemplateSecrets[name] = value
 	}
 
+	i.template = &tmpl
 	return nil
 }
 
@@ -131,56 +215,106 @@ func (i *createInputs) resolveLocalPath(ui terminal.UI, wd string) (string, erro
 	return fullPath, nil
 }
 
-func (i *createInputs) resolveCluster(client atlas.Client, groupID string) (dataSourceCluster, error) {
+func (i *createInputs) resolveClusters(client atlas.Client, groupID string) ([]dataSourceCluster, error) {
+	if len(i.ClusterServiceNames) > len(i.Clusters) {
+		return nil, errors.New("cannot specify more cluster service names than clusters")
+	}
+
 	clusters, err := client.Clusters(groupID)
 	if err != nil {
-		return dataSourceCluster{}, err
+		return nil, err
 	}
-	var clusterName string
+
+	clustersByName := make(map[string]atlas.Cluster, len(clusters))
 	for _, cluster := range clusters {
-		if i.Cluster == cluster.Name {
-			clusterName = cluster.Name
-			break
-		}
+		clustersByName[cluster.Name] = cluster
 	}
-	if clusterName == "" {
-		return dataSourceCluster{}, errors.New("failed to find Atlas cluster")
-	}
-	dsCluster := dataSourceCluster{
-		Name: "mongodb-atlas",
-		Type: "mongodb-atlas",
-		Config: configCluster{
-			ClusterName:         clusterName,
-			ReadPreference:      "primary",
-			WireProtocolEnabled: false,
-		},
+
+	dsClusters := make([]dataSourceCluster, 0, len(i.Clusters))
+	for idx, clusterName := range i.Clusters {
+		if _, ok := clustersByName[clusterName]; !ok {
+			return nil, fmt.Errorf("failed to find Atlas cluster '%s'", clusterName)
+		}
+
+		serviceName := clusterName
+		if idx < len(i.ClusterServiceNames) {
+			serviceName = i.ClusterServiceNames[idx]
+		} else if idx == 0 {
+			serviceName = defaultClusterServiceName
+		}
+
+		dsClusters = append(dsClusters, dataSourceCluster{
+			Name: serviceName,
+			Type: "mongodb-atlas",
+			Config: configCluster{
+				ClusterName:         clusterName,
+				ReadPreference:      "primary",
+				WireProtocolEnabled: false,
+			},
+		})
 	}
-	return dsCluster, nil
+	return dsClusters, nil
 }
 
-func (i *createInputs) resolveDataLake(client atlas.Client, groupID string) (dataSourceDataLake, error) {
+func (i *createInputs) resolveDataLakes(client atlas.Client, groupID string) ([]dataSourceDataLake, error) {
+	if len(i.DataLakeServiceNames) > len(i.DataLakes) {
+		return nil, errors.New("cannot specify more data lake service names than data lakes")
+	}
+
 	dataLakes, err := client.DataLakes(groupID)
 	if err != nil {
-		return dataSourceDataLake{}, err
+		return nil, err
 	}
-	var dataLakeName string
+
+	dataLakesByName := make(map[string]atlas.DataLake, len(dataLakes))
 	for _, dataLake := range dataLakes {
-		if i.DataLake == dataLake.Name {
-			dataLakeName = dataLake.Name
-			break
+		dataLakesByName[dataLake.Name] = dataLake
+	}
+
+	dsDataLakes := make([]dataSourceDataLake, 0, len(i.DataLakes))
+	for idx, dataLakeName := range i.DataLakes {
+		if _, ok := dataLakesByName[dataLakeName]; !ok {
+			return nil, fmt.Errorf("failed to find Atlas data lake '%s'", dataLakeName)
 		}
+
+		serviceName := dataLakeName
+		if idx < len(i.DataLakeServiceNames) {
+			serviceName = i.DataLakeServiceNames[idx]
+		} else if idx == 0 {
+			serviceName = defaultDataLakeServiceName
+		}
+
+		dsDataLakes = append(dsDataLakes, dataSourceDataLake{
+			Name: serviceName,
+			Type: "datalake",
+			Config: configDataLake{
+				DataLakeName: dataLakeName,
+			},
+		})
+	}
+	return dsDataLakes, nil
+}
+
+func checkDataSourceNames(dsClusters []dataSourceCluster, dsDataLakes []dataSourceDataLake) error {
+	names := make(map[string]struct{}, len(dsClusters)+len(dsDataLakes))
+	check := func(name string) error {
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("data source name '%s' is used more than once", name)
+		}
+		names[name] = struct{}{}
+		return nil
 	}
-	if dataLakeName == "" {
-		return dataSourceDataLake{}, errors.New("failed to find Atlas data lake")
+	for _, ds := range dsClusters {
+		if err := check(ds.Name); err != nil {
+			return err
+		}
 	}
-	dsDataLake := dataSourceDataLake{
-		Name: "mongodb-datalake",
-		Type: "datalake",
-		Config: configDataLake{
-			DataLakeName: dataLakeName,
-		},
+	for _, ds := range dsDataLakes {
+		if err := check(ds.Name); err != nil {
+			return err
+		}
 	}
-	return dsDataLake, nil
+	return nil
 }
 
 func (i createInputs) args(omitDryRun bool) []flags.Arg {
@@ -206,11 +340,28 @@ func (i createInputs) args(omitDryRun bool) []flags.Arg {
 	if i.Environment != realm.EnvironmentNone {
 		args = append(args, flags.Arg{flagEnvironment, i.Environment.String()})
 	}
-	if i.Cluster != "" {
-		args = append(args, flags.Arg{flagCluster, i.Cluster})
+	for _, cluster := range i.Clusters {
+		args = append(args, flags.Arg{flagCluster, cluster})
+	}
+	for _, serviceName := range i.ClusterServiceNames {
+		args = append(args, flags.Arg{flagClusterServiceName, serviceName})
+	}
+	for _, dataLake := range i.DataLakes {
+		args = append(args, flags.Arg{flagDataLake, dataLake})
+	}
+	for _, serviceName := range i.DataLakeServiceNames {
+		args = append(args, flags.Arg{flagDataLakeServiceName, serviceName})
+	}
+	if i.TemplateFile != "" {
+		args = append(args, flags.Arg{flagTemplateFile, i.TemplateFile})
+	}
+	dataSourceNames := make([]string, 0, len(i.TemplateDataSources))
+	for name := range i.TemplateDataSources {
+		dataSourceNames = append(dataSourceNames, name)
 	}
-	if i.DataLake != "" {
-		args = append(args, flags.Arg{flagDataLake, i.DataLake})
+	sort.Strings(dataSourceNames)
+	for _, name := range dataSourceNames {
+		args = append(args, flags.Arg{flagTemplateDataSource, name + "=" + i.TemplateDataSources[name]})
 	}
 	if i.DryRun && !omitDryRun {
 		args = append(args, flags.Arg{Name: flagDryRun})
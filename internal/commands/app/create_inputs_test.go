@@ -76,6 +76,84 @@ func TestAppCreateInputsResolve(t *testing.T) {
 	})
 }
 
+func TestAppCreateInputsResolveTemplate(t *testing.T) {
+	profile, teardown := mock.NewProfileFromTmpDir(t, "app_create_inputs_test")
+	defer teardown()
+
+	appDir := path.Join(profile.WorkingDirectory, "eggcorn")
+	writeTemplateApp(t, appDir)
+
+	app, err := local.LoadApp(appDir)
+	assert.Nil(t, err)
+
+	tmpl, err := local.PackageAppTemplate(app)
+	assert.Nil(t, err)
+
+	templateFile := path.Join(profile.WorkingDirectory, "eggcorn-template.zip")
+	assert.Nil(t, local.WriteAppTemplate(templateFile, tmpl))
+
+	t.Run("should default the name and config version to the app template's", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		procedure := func(c *expect.Console) {
+			c.ExpectString("Value for Secret 'apiKeySecret'")
+			c.SendLine("abc123")
+			c.ExpectString("App Name")
+			c.SendLine("")
+			c.ExpectEOF()
+		}
+
+		doneCh := make(chan (struct{}))
+		go func() {
+			defer close(doneCh)
+			procedure(console)
+		}()
+
+		inputs := createInputs{TemplateFile: templateFile}
+		assert.Nil(t, inputs.Resolve(profile, ui))
+
+		console.Tty().Close() // flush the writers
+		<-doneCh              // wait for procedure to complete
+
+		assert.Equal(t, "eggcorn", inputs.Name)
+		assert.Equal(t, realm.DefaultAppConfigVersion, inputs.ConfigVersion)
+		assert.Equal(t, map[string]string{"apiKeySecret": "abc123"}, inputs.TemplateSecrets)
+	})
+
+	for _, tc := range []struct {
+		description string
+		inputs      createInputs
+		expectedErr error
+	}{
+		{
+			description: "should return an error when used with a remote app",
+			inputs:      createInputs{newAppInputs: newAppInputs{RemoteApp: "remote"}, TemplateFile: templateFile},
+			expectedErr: errors.New(`cannot use "--remote" with "--template-file"`),
+		},
+		{
+			description: "should return an error when renaming a data source the app template does not have",
+			inputs:      createInputs{TemplateFile: templateFile, TemplateDataSources: map[string]string{"missing": "cluster"}},
+			expectedErr: errors.New("app template has no data source named 'missing'"),
+		},
+		{
+			description: "should return an error when renaming a data source to an invalid name",
+			inputs:      createInputs{TemplateFile: templateFile, TemplateDataSources: map[string]string{"mongodb-atlas": "../cluster"}},
+			expectedErr: errors.New("invalid data source name '../cluster'"),
+		},
+		{
+			description: "should return an error when the app template cannot be found",
+			inputs:      createInputs{TemplateFile: path.Join(profile.WorkingDirectory, "missing.zip")},
+			expectedErr: errors.New("failed to find app template at " + path.Join(profile.WorkingDirectory, "missing.zip")),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, nil))
+		})
+	}
+}
+
 func TestAppCreateInputsResolveName(t *testing.T) {
 	testApp := realm.App{
 		ID:          primitive.NewObjectID().Hex(),
@@ -235,7 +313,7 @@ func TestAppCreateInputsResolveDirectory(t *testing.T) {
 	})
 }
 
-func TestAppCreateInputsResolveCluster(t *testing.T) {
+func TestAppCreateInputsResolveClusters(t *testing.T) {
 	t.Run("should return data source config of a provided cluster", func(t *testing.T) {
 		var expectedGroupID string
 		ac := mock.AtlasClient{}
@@ -244,12 +322,12 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 			return []atlas.Cluster{{ID: "789", Name: "test-cluster"}}, nil
 		}
 
-		inputs := createInputs{newAppInputs: newAppInputs{Name: "test-app"}, Cluster: "test-cluster"}
+		inputs := createInputs{newAppInputs: newAppInputs{Name: "test-app"}, Clusters: []string{"test-cluster"}}
 
-		ds, err := inputs.resolveCluster(ac, "123")
+		ds, err := inputs.resolveClusters(ac, "123")
 		assert.Nil(t, err)
 
-		assert.Equal(t, dataSourceCluster{
+		assert.Equal(t, []dataSourceCluster{{
 			Name: "mongodb-atlas",
 			Type: "mongodb-atlas",
 			Config: configCluster{
@@ -257,10 +335,57 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 				ReadPreference:      "primary",
 				WireProtocolEnabled: false,
 			},
-		}, ds)
+		}}, ds)
 		assert.Equal(t, "123", expectedGroupID)
 	})
 
+	t.Run("should return data source configs of multiple provided clusters", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.ClustersFn = func(groupID string) ([]atlas.Cluster, error) {
+			return []atlas.Cluster{{Name: "cluster0"}, {Name: "cluster1"}, {Name: "cluster2"}}, nil
+		}
+
+		for _, tc := range []struct {
+			description  string
+			serviceNames []string
+			expected     []string
+		}{
+			{
+				description: "with default service names",
+				expected:    []string{"mongodb-atlas", "cluster1", "cluster2"},
+			},
+			{
+				description:  "with some service names specified",
+				serviceNames: []string{"primary", "analytics"},
+				expected:     []string{"primary", "analytics", "cluster2"},
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				inputs := createInputs{
+					Clusters:            []string{"cluster0", "cluster1", "cluster2"},
+					ClusterServiceNames: tc.serviceNames,
+				}
+
+				ds, err := inputs.resolveClusters(ac, "123")
+				assert.Nil(t, err)
+
+				names := make([]string, 0, len(ds))
+				for i, d := range ds {
+					names = append(names, d.Name)
+					assert.Equal(t, inputs.Clusters[i], d.Config.ClusterName)
+				}
+				assert.Equal(t, tc.expected, names)
+			})
+		}
+	})
+
+	t.Run("should error when more service names are specified than clusters", func(t *testing.T) {
+		inputs := createInputs{Clusters: []string{"cluster0"}, ClusterServiceNames: []string{"one", "two"}}
+
+		_, err := inputs.resolveClusters(mock.AtlasClient{}, "123")
+		assert.Equal(t, errors.New("cannot specify more cluster service names than clusters"), err)
+	})
+
 	t.Run("should not be able to find specified cluster", func(t *testing.T) {
 		var expectedGroupID string
 		ac := mock.AtlasClient{}
@@ -269,10 +394,10 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 			return nil, nil
 		}
 
-		inputs := createInputs{Cluster: "test-cluster"}
+		inputs := createInputs{Clusters: []string{"test-cluster"}}
 
-		_, err := inputs.resolveCluster(ac, "123")
-		assert.Equal(t, errors.New("failed to find Atlas cluster"), err)
+		_, err := inputs.resolveClusters(ac, "123")
+		assert.Equal(t, errors.New("failed to find Atlas cluster 'test-cluster'"), err)
 		assert.Equal(t, "123", expectedGroupID)
 	})
 
@@ -284,15 +409,15 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 			return nil, errors.New("client error")
 		}
 
-		inputs := createInputs{Cluster: "test-cluster"}
+		inputs := createInputs{Clusters: []string{"test-cluster"}}
 
-		_, err := inputs.resolveCluster(ac, "123")
+		_, err := inputs.resolveClusters(ac, "123")
 		assert.Equal(t, errors.New("client error"), err)
 		assert.Equal(t, "123", expectedGroupID)
 	})
 }
 
-func TestAppCreateInputsResolveDataLake(t *testing.T) {
+func TestAppCreateInputsResolveDataLakes(t *testing.T) {
 	t.Run("should return data source config of a provided data lake", func(t *testing.T) {
 		var expectedGroupID string
 		ac := mock.AtlasClient{}
@@ -301,21 +426,37 @@ func TestAppCreateInputsResolveDataLake(t *testing.T) {
 			return []atlas.DataLake{{Name: "test-datalake"}}, nil
 		}
 
-		inputs := createInputs{newAppInputs: newAppInputs{Name: "test-app"}, DataLake: "test-datalake"}
+		inputs := createInputs{newAppInputs: newAppInputs{Name: "test-app"}, DataLakes: []string{"test-datalake"}}
 
-		ds, err := inputs.resolveDataLake(ac, "123")
+		ds, err := inputs.resolveDataLakes(ac, "123")
 		assert.Nil(t, err)
 
-		assert.Equal(t, dataSourceDataLake{
+		assert.Equal(t, []dataSourceDataLake{{
 			Name: "mongodb-datalake",
 			Type: "datalake",
 			Config: configDataLake{
 				DataLakeName: "test-datalake",
 			},
-		}, ds)
+		}}, ds)
 		assert.Equal(t, "123", expectedGroupID)
 	})
 
+	t.Run("should return data source configs of multiple provided data lakes", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.DataLakesFn = func(groupID string) ([]atlas.DataLake, error) {
+			return []atlas.DataLake{{Name: "lake0"}, {Name: "lake1"}}, nil
+		}
+
+		inputs := createInputs{DataLakes: []string{"lake0", "lake1"}, DataLakeServiceNames: []string{"archive"}}
+
+		ds, err := inputs.resolveDataLakes(ac, "123")
+		assert.Nil(t, err)
+		assert.Equal(t, []dataSourceDataLake{
+			{Name: "archive", Type: "datalake", Config: configDataLake{DataLakeName: "lake0"}},
+			{Name: "lake1", Type: "datalake", Config: configDataLake{DataLakeName: "lake1"}},
+		}, ds)
+	})
+
 	t.Run("should not be able to find specified data lake", func(t *testing.T) {
 		var expectedGroupID string
 		ac := mock.AtlasClient{}
@@ -324,10 +465,10 @@ func TestAppCreateInputsResolveDataLake(t *testing.T) {
 			return nil, nil
 		}
 
-		inputs := createInputs{DataLake: "test-datalake"}
+		inputs := createInputs{DataLakes: []string{"test-datalake"}}
 
-		_, err := inputs.resolveDataLake(ac, "123")
-		assert.Equal(t, errors.New("failed to find Atlas data lake"), err)
+		_, err := inputs.resolveDataLakes(ac, "123")
+		assert.Equal(t, errors.New("failed to find Atlas data lake 'test-datalake'"), err)
 		assert.Equal(t, "123", expectedGroupID)
 	})
 
@@ -339,10 +480,26 @@ func TestAppCreateInputsResolveDataLake(t *testing.T) {
 			return nil, errors.New("client error")
 		}
 
-		inputs := createInputs{DataLake: "test-datalake"}
+		inputs := createInputs{DataLakes: []string{"test-datalake"}}
 
-		_, err := inputs.resolveDataLake(ac, "123")
+		_, err := inputs.resolveDataLakes(ac, "123")
 		assert.Equal(t, errors.New("client error"), err)
 		assert.Equal(t, "123", expectedGroupID)
 	})
 }
+
+func TestAppCreateCheckDataSourceNames(t *testing.T) {
+	t.Run("should pass with unique data source names", func(t *testing.T) {
+		assert.Nil(t, checkDataSourceNames(
+			[]dataSourceCluster{{Name: "mongodb-atlas"}, {Name: "analytics"}},
+			[]dataSourceDataLake{{Name: "mongodb-datalake"}},
+		))
+	})
+
+	t.Run("should error with a duplicate data source name", func(t *testing.T) {
+		assert.Equal(t, errors.New("data source name 'analytics' is used more than once"), checkDataSourceNames(
+			[]dataSourceCluster{{Name: "mongodb-atlas"}, {Name: "analytics"}},
+			[]dataSourceDataLake{{Name: "analytics"}},
+		))
+	})
+}
@@ -9,8 +9,10 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/atlas"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/local"
@@ -395,13 +397,13 @@ func TestAppCreateHandler(t *testing.T) {
 
 	for _, tc := range []struct {
 		description string
-		cluster     string
-		dataLake    string
+		clusters    []string
+		dataLakes   []string
 		atlasClient atlas.Client
 	}{
 		{
 			description: "should create minimal project with a cluster data source when cluster is set",
-			cluster:     "test-cluster",
+			clusters:    []string{"test-cluster"},
 			atlasClient: mock.AtlasClient{
 				ClustersFn: func(groupID string) ([]atlas.Cluster, error) {
 					return []atlas.Cluster{{Name: "test-cluster"}}, nil
@@ -410,7 +412,7 @@ func TestAppCreateHandler(t *testing.T) {
 		},
 		{
 			description: "should create minimal project with a data lake data source when data lake is set",
-			dataLake:    "test-datalake",
+			dataLakes:   []string{"test-datalake"},
 			atlasClient: mock.AtlasClient{
 				DataLakesFn: func(groupID string) ([]atlas.DataLake, error) {
 					return []atlas.DataLake{{Name: "test-datalake"}}, nil
@@ -419,8 +421,8 @@ func TestAppCreateHandler(t *testing.T) {
 		},
 		{
 			description: "should create minimal project with a data lake and cluster data source when data lake and cluster is set",
-			cluster:     "test-cluster",
-			dataLake:    "test-datalake",
+			clusters:    []string{"test-cluster"},
+			dataLakes:   []string{"test-datalake"},
 			atlasClient: mock.AtlasClient{
 				ClustersFn: func(groupID string) ([]atlas.Cluster, error) {
 					return []atlas.Cluster{{Name: "test-cluster"}}, nil
@@ -465,8 +467,8 @@ func TestAppCreateHandler(t *testing.T) {
 						Location:        realm.LocationVirginia,
 						DeploymentModel: realm.DeploymentModelGlobal,
 					},
-					Cluster:  tc.cluster,
-					DataLake: tc.dataLake,
+					Clusters:  tc.clusters,
+					DataLakes: tc.dataLakes,
 				},
 			}
 
@@ -492,7 +494,7 @@ func TestAppCreateHandler(t *testing.T) {
 			fmtStr := fmt.Sprintf("%%-%ds", dirLength)
 
 			var spaceBuffer, dashBuffer string
-			if tc.dataLake != "" {
+			if len(tc.dataLakes) > 0 {
 				spaceBuffer = "  "
 				dashBuffer = "--"
 			}
@@ -505,10 +507,10 @@ func TestAppCreateHandler(t *testing.T) {
 				"  Realm Directory        "+spaceBuffer+appLocal.RootDir,
 				fmt.Sprintf("  Realm UI               "+spaceBuffer+fmtStr, "http://localhost:8080/groups/123/apps/456/dashboard"),
 			)
-			if tc.cluster != "" {
+			if len(tc.clusters) > 0 {
 				display = append(display, fmt.Sprintf("  Data Source (Cluster)  "+spaceBuffer+fmtStr, "mongodb-atlas"))
 			}
-			if tc.dataLake != "" {
+			if len(tc.dataLakes) > 0 {
 				display = append(display, fmt.Sprintf("  Data Source (Data Lake)  "+fmtStr, "mongodb-datalake"))
 			}
 			display = append(display, "Check out your app: cd ./test-app && realm-cli app describe", "")
@@ -526,9 +528,8 @@ func TestAppCreateHandler(t *testing.T) {
 	for _, tc := range []struct {
 		description     string
 		appRemote       string
-		cluster         string
-		dataLake        string
-		datalake        string
+		clusters        []string
+		dataLakes       []string
 		clients         cli.Clients
 		displayExpected func(dir string, cmd *CommandCreate) string
 	}{
@@ -562,7 +563,7 @@ func TestAppCreateHandler(t *testing.T) {
 		},
 		{
 			description: "should create a minimal project dry run with cluster set",
-			cluster:     "test-cluster",
+			clusters:    []string{"test-cluster"},
 			clients: cli.Clients{
 				Atlas: mock.AtlasClient{
 					ClustersFn: func(groupID string) ([]atlas.Cluster, error) {
@@ -581,7 +582,7 @@ func TestAppCreateHandler(t *testing.T) {
 		},
 		{
 			description: "should create a minimal project dry run with data lake set",
-			dataLake:    "test-datalake",
+			dataLakes:   []string{"test-datalake"},
 			clients: cli.Clients{
 				Atlas: mock.AtlasClient{
 					DataLakesFn: func(groupID string) ([]atlas.DataLake, error) {
@@ -615,9 +616,9 @@ func TestAppCreateHandler(t *testing.T) {
 						Location:        realm.LocationVirginia,
 						DeploymentModel: realm.DeploymentModelGlobal,
 					},
-					Cluster:  tc.cluster,
-					DataLake: tc.dataLake,
-					DryRun:   true,
+					Clusters:  tc.clusters,
+					DataLakes: tc.dataLakes,
+					DryRun:    true,
 				},
 			}
 
@@ -632,8 +633,8 @@ func TestAppCreateHandler(t *testing.T) {
 		description string
 		appRemote   string
 		groupID     string
-		cluster     string
-		dataLake    string
+		clusters    []string
+		dataLakes   []string
 		clients     cli.Clients
 		expectedErr error
 	}{
@@ -651,7 +652,7 @@ func TestAppCreateHandler(t *testing.T) {
 		{
 			description: "should error when resolving clusters when cluster is set",
 			groupID:     "123",
-			cluster:     "test-cluster",
+			clusters:    []string{"test-cluster"},
 			clients: cli.Clients{
 				Atlas: mock.AtlasClient{
 					ClustersFn: func(groupID string) ([]atlas.Cluster, error) {
@@ -664,7 +665,7 @@ func TestAppCreateHandler(t *testing.T) {
 		{
 			description: "should error when resolving data lakes when data lake is set",
 			groupID:     "123",
-			dataLake:    "test-datalake",
+			dataLakes:   []string{"test-datalake"},
 			clients: cli.Clients{
 				Atlas: mock.AtlasClient{
 					DataLakesFn: func(groupID string) ([]atlas.DataLake, error) {
@@ -698,8 +699,8 @@ func TestAppCreateHandler(t *testing.T) {
 					Location:        realm.LocationVirginia,
 					DeploymentModel: realm.DeploymentModelGlobal,
 				},
-				Cluster:  tc.cluster,
-				DataLake: tc.dataLake,
+				Clusters:  tc.clusters,
+				DataLakes: tc.dataLakes,
 			}}
 
 			assert.Equal(t, tc.expectedErr, cmd.Handler(profile, nil, tc.clients))
@@ -707,6 +708,92 @@ func TestAppCreateHandler(t *testing.T) {
 	}
 }
 
+func TestAppCreateFromTemplate(t *testing.T) {
+	setup := func(t *testing.T) (*user.Profile, string, func()) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_create_template_test")
+		profile.SetRealmBaseURL("http://localhost:8080")
+
+		appDir := filepath.Join(profile.WorkingDirectory, "eggcorn")
+		writeTemplateApp(t, appDir)
+
+		app, err := local.LoadApp(appDir)
+		assert.Nil(t, err)
+
+		tmpl, err := local.PackageAppTemplate(app)
+		assert.Nil(t, err)
+
+		templateFile := filepath.Join(profile.WorkingDirectory, "eggcorn-template.zip")
+		assert.Nil(t, local.WriteAppTemplate(templateFile, tmpl))
+
+		return profile, templateFile, teardown
+	}
+
+	t.Run("should create a new app from the app template", func(t *testing.T) {
+		profile, templateFile, teardown := setup(t)
+		defer teardown()
+
+		_, ui := mock.NewUI()
+
+		var secrets []string
+		var importedData interface{}
+
+		client := mock.RealmClient{}
+		client.CreateAppFn = func(groupID, name string, meta realm.AppMeta) (realm.App, error) {
+			return realm.App{GroupID: groupID, ID: "456", ClientAppID: name + "-abcde", Name: name, AppMeta: meta}, nil
+		}
+		client.CreateSecretFn = func(groupID, appID, name, value string) (realm.Secret, error) {
+			secrets = append(secrets, name+"="+value)
+			return realm.Secret{ID: "secretID", Name: name}, nil
+		}
+		client.ImportFn = func(groupID, appID string, appData interface{}) error {
+			importedData = appData
+			return nil
+		}
+
+		cmd := &CommandCreate{createInputs{
+			newAppInputs:        newAppInputs{Name: "chestnut", Project: "123"},
+			TemplateFile:        templateFile,
+			TemplateDataSources: map[string]string{"mongodb-atlas": "cluster"},
+			TemplateSecrets:     map[string]string{"apiKeySecret": "abc123"},
+		}}
+		assert.Nil(t, cmd.inputs.Resolve(profile, ui))
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: client}))
+
+		assert.Equal(t, []string{"apiKeySecret=abc123"}, secrets)
+
+		appLocal, err := local.LoadApp(filepath.Join(profile.WorkingDirectory, "chestnut"))
+		assert.Nil(t, err)
+		assert.Equal(t, "chestnut-abcde", appLocal.ID())
+		assert.Equal(t, "chestnut", appLocal.Name())
+		assert.Equal(t, appLocal.AppData, importedData)
+
+		appData, ok := appLocal.AppData.(*local.AppRealmConfigJSON)
+		assert.True(t, ok, "should create a realm_config.json app")
+		assert.Equal(t, 1, len(appData.DataSources))
+		assert.Equal(t, "cluster", appData.DataSources[0].Config["name"])
+	})
+
+	t.Run("should describe the app from the app template with a dry run", func(t *testing.T) {
+		profile, templateFile, teardown := setup(t)
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandCreate{createInputs{
+			newAppInputs:    newAppInputs{Name: "chestnut", Project: "123"},
+			TemplateFile:    templateFile,
+			TemplateSecrets: map[string]string{"apiKeySecret": "abc123"},
+			DryRun:          true,
+		}}
+		assert.Nil(t, cmd.inputs.Resolve(profile, ui))
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+
+		assert.Equal(t, fmt.Sprintf(`A Realm app based on the app template '%s' would be created at %s
+To create this app run: realm-cli app create --project 123 --name chestnut --local chestnut --template-file %s
+`, templateFile, filepath.Join(profile.WorkingDirectory, "chestnut"), templateFile), out.String())
+	})
+}
+
 func TestAppCreateCommandDisplay(t *testing.T) {
 	t.Run("should create a minimal command", func(t *testing.T) {
 		cmd := &CommandCreate{
@@ -732,15 +819,78 @@ func TestAppCreateCommandDisplay(t *testing.T) {
 					Location:        realm.LocationIreland,
 					DeploymentModel: realm.DeploymentModelLocal,
 				},
-				LocalPath: "realm-app",
-				Cluster:   "Cluster0",
-				DataLake:  "DataLake0",
-				DryRun:    true,
+				LocalPath:           "realm-app",
+				Clusters:            []string{"Cluster0", "Cluster1"},
+				ClusterServiceNames: []string{"mongodb-atlas", "analytics"},
+				DataLakes:           []string{"DataLake0"},
+				DryRun:              true,
 			},
 		}
 		assert.Equal(t,
-			cli.Name+" app create --project 123 --name test-app --remote remote-app --local realm-app --location IE --deployment-model LOCAL --cluster Cluster0 --data-lake DataLake0 --dry-run",
+			cli.Name+" app create --project 123 --name test-app --remote remote-app --local realm-app --location IE --deployment-model LOCAL --cluster Cluster0 --cluster Cluster1 --cluster-service-name mongodb-atlas --cluster-service-name analytics --data-lake DataLake0 --dry-run",
 			cmd.display(false),
 		)
 	})
 }
+
+func TestAppCreateWaitForClusters(t *testing.T) {
+	origInterval := clusterReadyPollInterval
+	clusterReadyPollInterval = time.Millisecond
+	defer func() { clusterReadyPollInterval = origInterval }()
+
+	dsClusters := []dataSourceCluster{
+		{Name: "mongodb-atlas", Config: configCluster{ClusterName: "cluster0"}},
+		{Name: "analytics", Config: configCluster{ClusterName: "cluster1"}},
+	}
+
+	_, ui := mock.NewUI()
+
+	t.Run("should poll until all linked clusters are ready", func(t *testing.T) {
+		var calls int
+		ac := mock.AtlasClient{}
+		ac.ClustersFn = func(groupID string) ([]atlas.Cluster, error) {
+			calls++
+			state := atlas.ClusterStateCreating
+			if calls == 3 {
+				state = atlas.ClusterStateIdle
+			}
+			return []atlas.Cluster{
+				{Name: "cluster0", State: atlas.ClusterStateIdle},
+				{Name: "cluster1", State: state},
+			}, nil
+		}
+
+		assert.Nil(t, waitForClusters(ui, ac, "123", dsClusters))
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("should error when a linked cluster is being deleted", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.ClustersFn = func(groupID string) ([]atlas.Cluster, error) {
+			return []atlas.Cluster{
+				{Name: "cluster0", State: atlas.ClusterStateIdle},
+				{Name: "cluster1", State: atlas.ClusterStateDeleting},
+			}, nil
+		}
+
+		err := waitForClusters(ui, ac, "123", dsClusters)
+		assert.Equal(t, errors.New("cannot link Atlas cluster 'cluster1' while it is in the DELETING state"), err)
+	})
+
+	t.Run("should error when the linked clusters are not ready in time", func(t *testing.T) {
+		origTimeout := clusterReadyTimeout
+		clusterReadyTimeout = 0
+		defer func() { clusterReadyTimeout = origTimeout }()
+
+		ac := mock.AtlasClient{}
+		ac.ClustersFn = func(groupID string) ([]atlas.Cluster, error) {
+			return []atlas.Cluster{
+				{Name: "cluster0", State: atlas.ClusterStateUpdating},
+				{Name: "cluster1", State: atlas.ClusterStateCreating},
+			}, nil
+		}
+
+		err := waitForClusters(ui, ac, "123", dsClusters)
+		assert.Equal(t, errors.New("timed out waiting for Atlas clusters to be ready: cluster0, cluster1"), err)
+	})
+}
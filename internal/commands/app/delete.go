@@ -2,9 +2,11 @@ package app
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/terminal"
 	"github.com/10gen/realm-cli/internal/utils/flags"
 
@@ -18,7 +20,11 @@ var CommandMetaDelete = cli.CommandMeta{
 	Description: "Delete a Realm app",
 	HelpText: `If you have more than one Realm app, you will be prompted to select one or
 multiple app(s) that you would like to delete from a list of all your Realm apps.
-The list includes Realm apps from all projects associated with your user profile.`,
+The list includes Realm apps from all projects associated with your user profile.
+
+Apps with deletion protection turned on are not deleted. To delete them anyway,
+specify "--disable-deletion-protection", which turns off their deletion
+protection once you confirm, and then deletes them.`,
 }
 
 // CommandDelete is the `app delete` command
@@ -29,6 +35,7 @@ type CommandDelete struct {
 // Flags is the command flags
 func (cmd *CommandDelete) Flags(fs *pflag.FlagSet) {
 	fs.StringSliceVarP(&cmd.inputs.Apps, flagApp, flagAppShort, []string{}, flagAppUsage)
+	fs.BoolVar(&cmd.inputs.DisableDeletionProtection, flagDisableDeletionProtection, false, flagDisableDeletionProtectionUsage)
 
 	fs.StringVar(&cmd.inputs.Project, flagProject, "", flagProjectUsage)
 	flags.MarkHidden(fs, flagProject)
@@ -48,23 +55,67 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 
 	outputs := make([]appOutput, 0, len(apps))
 	deletedCount := 0
+	var protected []string
 	for _, app := range apps {
-		err := clients.Realm.DeleteApp(app.GroupID, app.ID)
+		err := cmd.deleteApp(ui, clients.Realm, app)
 		if err == nil {
 			deletedCount++
+		} else if _, ok := err.(errDeletionProtected); ok {
+			protected = append(protected, app.ClientAppID)
 		}
 		outputs = append(outputs, appOutput{app, err})
 	}
 
-	ui.Print(terminal.NewTableLog(
+	logs := []terminal.Log{terminal.NewTableLog(
 		fmt.Sprintf("Successfully deleted %d/%d app(s)", deletedCount, len(apps)),
 		tableHeadersDelete,
 		tableRowsDelete(outputs)...,
-	))
+	)}
+	if len(protected) > 0 {
+		logs = append(logs, terminal.NewFollowupLog(
+			"To turn off deletion protection and delete the protected app(s), run",
+			fmt.Sprintf("%s app delete --app %s --%s", cli.Name, strings.Join(protected, ","), flagDisableDeletionProtection),
+		))
+	}
+	ui.Print(logs...)
 
 	return nil
 }
 
+// deleteApp deletes the app, unless its deletion protection is turned on
+// and it is not confirmed to turn the deletion protection off first.
+// When the app settings are not found, e.g. on servers without deletion protection,
+// the app is deleted as if its deletion protection is turned off
+func (cmd *CommandDelete) deleteApp(ui terminal.UI, client realm.Client, app realm.App) error {
+	settings, err := client.AppSettings(app.GroupID, app.ID)
+	if err == realm.ErrAppSettingsNotFound {
+		ui.Print(terminal.NewWarningLog("Unable to determine whether app %s has deletion protection turned on: %s", app.ClientAppID, err))
+	} else if err != nil {
+		return err
+	}
+
+	if settings.DeletionProtection {
+		if !cmd.inputs.DisableDeletionProtection {
+			return errDeletionProtected{}
+		}
+
+		proceed, err := ui.Confirm("App %s has deletion protection turned on, are you sure you want to turn it off and delete the app?", app.ClientAppID)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return errDeletionProtected{}
+		}
+
+		deletionProtection := false
+		if err := client.UpdateAppSettings(app.GroupID, app.ID, realm.AppSettingsUpdate{DeletionProtection: &deletionProtection}); err != nil {
+			return fmt.Errorf("failed to turn off deletion protection: %w", err)
+		}
+	}
+
+	return client.DeleteApp(app.GroupID, app.ID)
+}
+
 var (
 	tableHeadersDelete = []string{headerID, headerName, headerDeleted, headerDetails}
 )
@@ -14,11 +14,15 @@ var (
 	flagApp      = "app"
 	flagAppShort = "a"
 	flagAppUsage = "the remote Realm app name or id"
+
+	flagDisableDeletionProtection      = "disable-deletion-protection"
+	flagDisableDeletionProtectionUsage = "turn off the deletion protection of the apps to delete, once confirmed"
 )
 
 type deleteInputs struct {
-	Apps    []string
-	Project string
+	Apps                      []string
+	Project                   string
+	DisableDeletionProtection bool
 }
 
 func (inputs *deleteInputs) resolveApps(ui terminal.UI, client realm.Client) ([]realm.App, error) {
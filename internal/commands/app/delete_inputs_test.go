@@ -130,7 +130,7 @@ func TestResolveApps(t *testing.T) {
 			inputs := deleteInputs{Apps: []string{"nonexistent", "app1"}}
 			apps, err := inputs.resolveApps(ui, realmClient)
 			assert.Nil(t, err)
-			assert.Equal(t, "Unable to delete certain apps because they were not found: nonexistent\n", out.String())
+			assert.Equal(t, "Warning: Unable to delete certain apps because they were not found: nonexistent\n", out.String())
 			assert.Equal(t, []realm.App{app1}, apps)
 		})
 
@@ -145,7 +145,7 @@ func TestResolveApps(t *testing.T) {
 			inputs := deleteInputs{Apps: []string{"nonexistent", "missing"}}
 			apps, err := inputs.resolveApps(ui, realmClient)
 			assert.Nil(t, err)
-			assert.Equal(t, "Unable to delete certain apps because they were not found: nonexistent, missing\n", out.String())
+			assert.Equal(t, "Warning: Unable to delete certain apps because they were not found: nonexistent, missing\n", out.String())
 			assert.Equal(t, []realm.App{}, apps)
 		})
 	})
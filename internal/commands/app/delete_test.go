@@ -1,6 +1,7 @@
 package app
 
 import (
+	"bytes"
 	"errors"
 	"strings"
 	"testing"
@@ -111,6 +112,10 @@ func TestAppDeleteHandler(t *testing.T) {
 				return tc.apps, nil
 			}
 
+			realmClient.AppSettingsFn = func(groupID, appID string) (realm.AppSettings, error) {
+				return realm.AppSettings{}, nil
+			}
+
 			var capturedApps = make([]string, 0)
 			realmClient.DeleteAppFn = func(groupID, appID string) error {
 				capturedApps = append(capturedApps, appID)
@@ -124,4 +129,166 @@ func TestAppDeleteHandler(t *testing.T) {
 			assert.Equal(t, tc.inputs.Project, capturedFindGroupID)
 		})
 	}
+
+	t.Run("should delete the apps whose deletion protection is unknown with a warning", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app1}, nil
+		}
+		realmClient.AppSettingsFn = func(groupID, appID string) (realm.AppSettings, error) {
+			return realm.AppSettings{}, realm.ErrAppSettingsNotFound
+		}
+
+		var deleted []string
+		realmClient.DeleteAppFn = func(groupID, appID string) error {
+			deleted = append(deleted, appID)
+			return nil
+		}
+
+		cmd := &CommandDelete{inputs: deleteInputs{Apps: []string{"app1"}}}
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, strings.Join(
+			[]string{
+				"Warning: Unable to determine whether app app1-abcde has deletion protection turned on: failed to find app settings",
+				"Successfully deleted 1/1 app(s)",
+				"  ID                        Name  Deleted  Details",
+				"  ------------------------  ----  -------  -------",
+				"  60344735b37e3733de2adf40  app1  true            ",
+				"",
+			},
+			"\n",
+		), out.String())
+		assert.Equal(t, []string{appID1}, deleted)
+	})
+
+	t.Run("should not delete the apps whose app settings fail to be fetched", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app1}, nil
+		}
+		realmClient.AppSettingsFn = func(groupID, appID string) (realm.AppSettings, error) {
+			return realm.AppSettings{}, realm.ServerError{Message: "something bad happened"}
+		}
+
+		var deleted []string
+		realmClient.DeleteAppFn = func(groupID, appID string) error {
+			deleted = append(deleted, appID)
+			return nil
+		}
+
+		cmd := &CommandDelete{inputs: deleteInputs{Apps: []string{"app1"}}}
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, strings.Join(
+			[]string{
+				"Successfully deleted 0/1 app(s)",
+				"  ID                        Name  Deleted  Details               ",
+				"  ------------------------  ----  -------  ----------------------",
+				"  60344735b37e3733de2adf40  app1  false    something bad happened",
+				"",
+			},
+			"\n",
+		), out.String())
+		assert.Equal(t, 0, len(deleted))
+	})
+
+	t.Run("with deletion protection", func(t *testing.T) {
+		setup := func() (mock.RealmClient, *[]string, *[]realm.AppSettingsUpdate) {
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{app1, app2}, nil
+			}
+			realmClient.AppSettingsFn = func(groupID, appID string) (realm.AppSettings, error) {
+				return realm.AppSettings{DeletionProtection: appID == appID2}, nil
+			}
+
+			deleted := []string{}
+			realmClient.DeleteAppFn = func(groupID, appID string) error {
+				deleted = append(deleted, appID)
+				return nil
+			}
+
+			updates := []realm.AppSettingsUpdate{}
+			realmClient.UpdateAppSettingsFn = func(groupID, appID string, update realm.AppSettingsUpdate) error {
+				updates = append(updates, update)
+				return nil
+			}
+			return realmClient, &deleted, &updates
+		}
+
+		t.Run("should not delete the protected apps and suggest how to delete them", func(t *testing.T) {
+			out, ui := mock.NewUI()
+			realmClient, deleted, updates := setup()
+
+			cmd := &CommandDelete{inputs: deleteInputs{Apps: []string{"app1", "app2"}}}
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, strings.Join(
+				[]string{
+					"Successfully deleted 1/2 app(s)",
+					"  ID                        Name  Deleted  Details                              ",
+					"  ------------------------  ----  -------  -------------------------------------",
+					"  60344735b37e3733de2adf40  app1  true                                          ",
+					"  60344735b37e3733de2adf41  app2  false    app has deletion protection turned on",
+					"To turn off deletion protection and delete the protected app(s), run: realm-cli app delete --app app2-defgh --disable-deletion-protection",
+					"",
+				},
+				"\n",
+			), out.String())
+
+			assert.Equal(t, []string{appID1}, *deleted)
+			assert.Equal(t, []realm.AppSettingsUpdate{}, *updates)
+		})
+
+		t.Run("should turn off the deletion protection once confirmed and delete the protected apps", func(t *testing.T) {
+			out := new(bytes.Buffer)
+			ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+			realmClient, deleted, updates := setup()
+
+			cmd := &CommandDelete{inputs: deleteInputs{Apps: []string{"app2"}, DisableDeletionProtection: true}}
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, strings.Join(
+				[]string{
+					"Successfully deleted 1/1 app(s)",
+					"  ID                        Name  Deleted  Details",
+					"  ------------------------  ----  -------  -------",
+					"  60344735b37e3733de2adf41  app2  true            ",
+					"",
+				},
+				"\n",
+			), out.String())
+
+			deletionProtection := false
+			assert.Equal(t, []string{appID2}, *deleted)
+			assert.Equal(t, []realm.AppSettingsUpdate{{DeletionProtection: &deletionProtection}}, *updates)
+		})
+
+		t.Run("should not delete the protected apps when not confirmed", func(t *testing.T) {
+			_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+			assert.Nil(t, consoleErr)
+			defer console.Close()
+
+			realmClient, deleted, updates := setup()
+
+			doneCh := make(chan struct{})
+			go func() {
+				defer close(doneCh)
+				console.ExpectString("App app2-defgh has deletion protection turned on, are you sure you want to turn it off and delete the app?")
+				console.SendLine("n")
+				console.ExpectEOF()
+			}()
+
+			cmd := &CommandDelete{inputs: deleteInputs{Apps: []string{"app2"}, DisableDeletionProtection: true}}
+			err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+
+			console.Tty().Close()
+			<-doneCh
+
+			assert.Nil(t, err)
+			assert.Equal(t, []string{}, *deleted)
+			assert.Equal(t, []realm.AppSettingsUpdate{}, *updates)
+		})
+	})
 }
@@ -3,6 +3,7 @@ package app
 import (
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/terminal"
 
 	"github.com/spf13/pflag"
@@ -49,10 +50,55 @@ func (cmd *CommandDescribe) Handler(profile *user.Profile, ui terminal.UI, clien
 		return err
 	}
 
-	ui.Print(terminal.NewJSONLog("App description", appDesc))
+	ui.Print(terminal.NewJSONLog("App description", newAppDescriptionOutput(appDesc)))
 	return nil
 }
 
+// appDescriptionOutput is the app description with the arrays which
+// have no meaningful order marked so its output is stable
+type appDescriptionOutput struct {
+	ClientAppID       string                      `json:"client_app_id"`
+	Name              string                      `json:"name"`
+	RealmURL          string                      `json:"realm_url"`
+	DataSources       interface{}                 `json:"data_sources"`
+	HTTPEndpoints     interface{}                 `json:"http_endpoints"`
+	ServiceDescs      interface{}                 `json:"services"`
+	AuthProviders     interface{}                 `json:"auth_providers"`
+	CustomUserData    realm.CustomUserDataSummary `json:"custom_user_data"`
+	Values            interface{}                 `json:"values"`
+	Hosting           realm.HostingSummary        `json:"hosting"`
+	Functions         interface{}                 `json:"functions"`
+	Sync              realm.SyncSummary           `json:"sync"`
+	GraphQL           graphQLOutput               `json:"graphql"`
+	Environment       string                      `json:"environment"`
+	EventSubscription interface{}                 `json:"event_subscription"`
+}
+
+type graphQLOutput struct {
+	URL             string      `json:"url"`
+	CustomResolvers interface{} `json:"custom_resolvers"`
+}
+
+func newAppDescriptionOutput(desc realm.AppDescription) appDescriptionOutput {
+	return appDescriptionOutput{
+		ClientAppID:       desc.ClientAppID,
+		Name:              desc.Name,
+		RealmURL:          desc.RealmURL,
+		DataSources:       terminal.Unordered(desc.DataSources),
+		HTTPEndpoints:     terminal.Unordered(desc.HTTPEndpoints),
+		ServiceDescs:      terminal.Unordered(desc.ServiceDescs),
+		AuthProviders:     terminal.Unordered(desc.AuthProviders),
+		CustomUserData:    desc.CustomUserData,
+		Values:            terminal.Unordered(desc.Values),
+		Hosting:           desc.Hosting,
+		Functions:         terminal.Unordered(desc.Functions),
+		Sync:              desc.Sync,
+		GraphQL:           graphQLOutput{desc.GraphQL.URL, terminal.Unordered(desc.GraphQL.CustomResolvers)},
+		Environment:       desc.Environment,
+		EventSubscription: terminal.Unordered(desc.EventSubscription),
+	}
+}
+
 func (i *describeInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
 }
@@ -219,6 +219,11 @@ func TestAppDescribeHandler(t *testing.T) {
   "name": "todo",
   "realm_url": "https://admin-base.url/groups/123/apps/456/dashboard",
   "data_sources": [
+    {
+      "name": "mdb1",
+      "type": "mongodb",
+      "data_source": ""
+    },
     {
       "name": "mongodb-atlas",
       "type": "mongodb-atlas",
@@ -228,11 +233,6 @@ func TestAppDescribeHandler(t *testing.T) {
       "name": "mongodb-datalake",
       "type": "datalake",
       "data_source": "DataLake0"
-    },
-    {
-      "name": "mdb1",
-      "type": "mongodb",
-      "data_source": ""
     }
   ],
   "http_endpoints": [
@@ -248,13 +248,13 @@ func TestAppDescribeHandler(t *testing.T) {
   ],
   "auth_providers": [
     {
-      "name": "oauth2/google",
-      "type": "oauth2/google",
+      "name": "oauth2/facebook",
+      "type": "oauth2/facebook",
       "enabled": true
     },
     {
-      "name": "oauth2/facebook",
-      "type": "oauth2/facebook",
+      "name": "oauth2/google",
+      "type": "oauth2/google",
       "enabled": true
     }
   ],
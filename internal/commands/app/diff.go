@@ -1,6 +1,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -24,7 +25,13 @@ var CommandMetaDiff = cli.CommandMeta{
 	Description: "Show differences between your local directory and your Realm app",
 	HelpText: `Displays file-by-file differences between your local directory and the latest
 version of your Realm app. If you have more than one Realm app, you will be
-prompted to select a Realm app to view.`,
+prompted to select a Realm app to view.
+
+Files matched by a ".realmignore" file in your app's root directory, which
+follows the same syntax as a ".gitignore" file, are excluded from the diff.
+
+Include "--cached" to diff against the copy of your Realm app cached by the
+latest pull or push instead, which is fast and works offline.`,
 }
 
 // CommandDiff is the `app diff` command
@@ -38,6 +45,7 @@ type diffInputs struct {
 	Project             string
 	IncludeDependencies bool
 	IncludeHosting      bool
+	Cached              bool
 }
 
 const (
@@ -57,6 +65,9 @@ const (
 	flagIncludeHosting      = "include-hosting"
 	flagIncludeHostingShort = "s"
 	flagIncludeHostingUsage = "include to diff Realm app hosting changes as well"
+
+	flagCached      = "cached"
+	flagCachedUsage = "include to diff against the Realm app cached by the latest pull or push, without making any requests"
 )
 
 // Flags is the command flags
@@ -65,6 +76,7 @@ func (cmd *CommandDiff) Flags(fs *pflag.FlagSet) {
 	fs.StringVar(&cmd.inputs.RemoteApp, flagRemoteAppDiff, "", flagRemoteAppDiffUsage)
 	fs.BoolVarP(&cmd.inputs.IncludeDependencies, flagIncludeDependencies, flagIncludeDependenciesShort, false, flagIncludeDependenciesUsage)
 	fs.BoolVarP(&cmd.inputs.IncludeHosting, flagIncludeHosting, flagIncludeHostingShort, false, flagIncludeHostingUsage)
+	fs.BoolVar(&cmd.inputs.Cached, flagCached, false, flagCachedUsage)
 
 	fs.StringVar(&cmd.inputs.Project, flagProjectDiff, "", flagProjectDiffUsage)
 	flags.MarkHidden(fs, flagProjectDiff)
@@ -86,6 +98,10 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 		return fmt.Errorf("no app directory found at %s", cmd.inputs.LocalPath)
 	}
 
+	if cmd.inputs.Cached {
+		return diffCached(profile, ui, app)
+	}
+
 	appToDiff, err := cli.ResolveApp(ui, clients.Realm, realm.AppFilter{GroupID: cmd.inputs.Project, App: cmd.inputs.RemoteApp})
 	if err != nil {
 		return err
@@ -143,7 +159,46 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 	return nil
 }
 
+func diffCached(profile *user.Profile, ui terminal.UI, app local.App) error {
+	if app.ID() == "" {
+		return errors.New("cannot diff against a cached app without an app id in the app config")
+	}
+
+	diffs, err := local.DiffAppSnapshot(app, profile.ExportCachePath(app.ID()))
+	if err != nil {
+		return err
+	}
+
+	if diffs.Size() == 0 {
+		ui.Print(terminal.NewTextLog("Cached app is identical to proposed version"))
+		return nil
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"The following reflects the proposed changes to your cached Realm app\n%s",
+		strings.Join(diffs.Strings(), "\n"),
+	))
+	return nil
+}
+
+// checkTranspiler checks the transpiler used to diff dependencies is installed
+var checkTranspiler = local.CheckTranspiler
+
+func (i *diffInputs) ResolveAppArg(args []string) error {
+	return cli.ResolveAppArg(&i.RemoteApp, flagRemoteAppDiff, args)
+}
+
 func (i *diffInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Cached && (i.IncludeDependencies || i.IncludeHosting) {
+		return fmt.Errorf(`cannot use "--%s" with "--%s" or "--%s"`, flagCached, flagIncludeDependencies, flagIncludeHosting)
+	}
+
+	if i.IncludeDependencies {
+		if err := checkTranspiler(); err != nil {
+			return fmt.Errorf(`cannot use "--%s", %w`, flagIncludeDependencies, err)
+		}
+	}
+
 	searchPath := i.LocalPath
 	if searchPath == "" {
 		searchPath = profile.WorkingDirectory
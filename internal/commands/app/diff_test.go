@@ -2,12 +2,15 @@ package app
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
 	"github.com/10gen/realm-cli/internal/utils/api"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
@@ -201,6 +204,52 @@ Modified hosting files
 	})
 }
 
+func TestAppDiffHandlerCached(t *testing.T) {
+	profile, teardown := mock.NewProfileFromTmpDir(t, "app_diff_cached_test")
+	defer teardown()
+
+	dir := filepath.Join(profile.WorkingDirectory, "eggcorn")
+
+	app := local.NewApp(dir, "eggcorn-abcde", "eggcorn", flagLocationDefault, flagDeploymentModelDefault, realm.EnvironmentNone, realm.DefaultAppConfigVersion)
+	assert.Nil(t, app.Write())
+
+	t.Run("should return an error without a cached app", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandDiff{diffInputs{LocalPath: dir, Cached: true}}
+		assert.Equal(t, errors.New("no cached export found for app eggcorn-abcde, pull or push the app to cache its export"), cmd.Handler(profile, ui, cli.Clients{}))
+	})
+
+	assert.Nil(t, cli.CacheAppExport(profile, dir))
+
+	t.Run("should show no diffs against an identical cached app", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDiff{diffInputs{LocalPath: dir, Cached: true}}
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Equal(t, "Cached app is identical to proposed version\n", out.String())
+	})
+
+	t.Run("should diff the app files against the cached app without any requests", func(t *testing.T) {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, local.NameValues, "apiBaseUrl.json"), []byte(`{"name":"apiBaseUrl","value":"https://example.com"}`), 0666))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, local.NameAuth, local.FileCustomUserData.String()), []byte(`{"enabled":true}`), 0666))
+		assert.Nil(t, os.Remove(filepath.Join(dir, local.NameEnvironments, "qa.json")))
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDiff{diffInputs{LocalPath: dir, Cached: true}}
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Equal(t, `The following reflects the proposed changes to your cached Realm app
+New app files
+  + values/apiBaseUrl.json
+Removed app files
+  - environments/qa.json
+Modified app files
+  * auth/custom_user_data.json
+`, out.String())
+	})
+}
+
 func TestAppDiffInputs(t *testing.T) {
 	for _, tc := range []struct {
 		description    string
@@ -294,3 +343,31 @@ func TestAppDiffInputs(t *testing.T) {
 		})
 	}
 }
+
+func TestAppDiffInputsCached(t *testing.T) {
+	t.Run("should not allow cached diffs with dependencies or hosting", func(t *testing.T) {
+		for _, inputs := range []diffInputs{
+			{Cached: true, IncludeDependencies: true},
+			{Cached: true, IncludeHosting: true},
+		} {
+			assert.Equal(t,
+				errors.New(`cannot use "--cached" with "--include-dependencies" or "--include-hosting"`),
+				inputs.Resolve(mock.NewProfile(t), nil),
+			)
+		}
+	})
+}
+
+func TestAppDiffInputsDependencies(t *testing.T) {
+	t.Run("should return an error when including dependencies without the transpiler installed", func(t *testing.T) {
+		original := checkTranspiler
+		defer func() { checkTranspiler = original }()
+		checkTranspiler = func() error { return errors.New("transpiler not found") }
+
+		inputs := diffInputs{LocalPath: "testdata/dependencies", IncludeDependencies: true}
+		assert.Equal(t,
+			errors.New(`cannot use "--include-dependencies", transpiler not found`).Error(),
+			inputs.Resolve(mock.NewProfile(t), nil).Error(),
+		)
+	})
+}
@@ -1,5 +1,10 @@
 package app
 
+import (
+	"fmt"
+	"time"
+)
+
 type errProjectExists struct {
 	path string
 }
@@ -13,3 +18,35 @@ func (err errProjectExists) Error() string {
 }
 
 func (err errProjectExists) DisableUsage() struct{} { return struct{}{} }
+
+type errValidationFailed struct {
+	count int
+}
+
+func (err errValidationFailed) Error() string {
+	return fmt.Sprintf("app validation failed with %d issue(s)", err.count)
+}
+
+func (err errValidationFailed) DisableUsage() struct{} { return struct{}{} }
+
+type errDeletionProtected struct{}
+
+func (err errDeletionProtected) Error() string {
+	return "app has deletion protection turned on"
+}
+
+// exitCodeWaitTimeout is the exit code when a wait condition is not satisfied in time
+const exitCodeWaitTimeout = 2
+
+type errWaitTimeout struct {
+	condition string
+	timeout   time.Duration
+}
+
+func (err errWaitTimeout) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for %s", err.timeout, err.condition)
+}
+
+func (err errWaitTimeout) DisableUsage() struct{} { return struct{}{} }
+
+func (err errWaitTimeout) ExitCode() int { return exitCodeWaitTimeout }
@@ -14,4 +14,11 @@ func TestAppErrors(t *testing.T) {
 		_, ok := err.(cli.DisableUsage)
 		assert.True(t, ok, "expected project exists error to disable usage")
 	})
+
+	t.Run("err validation failed should disable usage", func(t *testing.T) {
+		var err error = errValidationFailed{}
+
+		_, ok := err.(cli.DisableUsage)
+		assert.True(t, ok, "expected validation failed error to disable usage")
+	})
 }
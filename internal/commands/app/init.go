@@ -1,6 +1,8 @@
 package app
 
 import (
+	"fmt"
+
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
@@ -24,6 +26,14 @@ You can specify a "--remote" flag to initialize a Realm app from an existing app
 if you do not specify a "--remote" flag, the CLI will initialize a default
 Realm app.
 
+You can specify a "--from-swagger" flag with the path to a Swagger 2.0 or OpenAPI 3
+document, in JSON or YAML, to scaffold an HTTP service with an incoming webhook
+and a Function stub for each of its operations. Each webhook is named after the
+operation's ID and keeps its method, while its authentication follows the
+operation's security requirements: an API key sent as a query parameter is
+validated as the webhook secret, other security schemes require an authenticated
+application user and operations without any run as the system user.
+
 NOTE: To create a new Realm app and have it deployed, use "app create".`,
 }
 
@@ -39,6 +49,7 @@ func (cmd *CommandInit) Flags(fs *pflag.FlagSet) {
 	fs.VarP(&cmd.inputs.Location, flagLocation, flagLocationShort, flagLocationUsage)
 	fs.VarP(&cmd.inputs.DeploymentModel, flagDeploymentModel, flagDeploymentModelShort, flagDeploymentModelUsage)
 	fs.VarP(&cmd.inputs.Environment, flagEnvironment, flagEnvironmentShort, flagEnvironmentUsage)
+	fs.StringVar(&cmd.inputs.FromSwagger, flagFromSwagger, "", flagFromSwaggerUsage)
 
 	fs.StringVar(&cmd.inputs.Project, flagProject, "", flagProjectUsage)
 	flags.MarkHidden(fs, flagProject)
@@ -54,6 +65,14 @@ func (cmd *CommandInit) Inputs() cli.InputResolver {
 
 // Handler is the command handler
 func (cmd *CommandInit) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	var spec swaggerSpec
+	if cmd.inputs.FromSwagger != "" {
+		var err error
+		if spec, err = readSwaggerSpec(cmd.inputs.FromSwagger); err != nil {
+			return err
+		}
+	}
+
 	appRemote, err := cmd.inputs.resolveRemoteApp(ui, clients.Realm)
 	if err != nil {
 		return err
@@ -69,6 +88,12 @@ func (cmd *CommandInit) Handler(profile *user.Profile, ui terminal.UI, clients c
 		}
 	}
 
+	if cmd.inputs.FromSwagger != "" {
+		if err := cmd.writeSwaggerEndpoints(profile.WorkingDirectory, ui, spec); err != nil {
+			return err
+		}
+	}
+
 	ui.Print(terminal.NewTextLog("Successfully initialized app"))
 	return nil
 }
@@ -91,10 +116,61 @@ func (cmd *CommandInit) writeAppFromScratch(wd string) error {
 }
 
 func (cmd *CommandInit) writeAppFromExisting(wd string, realmClient realm.Client, groupID, appID string) error {
-	_, zipPkg, err := realmClient.Export(groupID, appID, realm.ExportRequest{IsTemplated: true})
+	_, zipPkg, err := realmClient.Export(groupID, appID, realm.ExportRequest{ConfigVersion: cmd.inputs.ConfigVersion, IsTemplated: true})
 	if err != nil {
 		return err
 	}
 
 	return local.WriteZip(wd, zipPkg)
 }
+
+func (cmd *CommandInit) writeSwaggerEndpoints(wd string, ui terminal.UI, spec swaggerSpec) error {
+	endpoints, skipped, err := spec.endpoints()
+	if err != nil {
+		return err
+	}
+
+	appLocal, err := local.LoadApp(wd)
+	if err != nil {
+		return err
+	}
+
+	webhooks := make([]map[string]interface{}, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		webhooks = append(webhooks, endpoint.webhook())
+	}
+
+	service := map[string]interface{}{
+		"name":    spec.serviceName(),
+		"type":    "http",
+		"config":  map[string]interface{}{},
+		"version": 1,
+	}
+	if err := local.AddHTTPEndpoint(appLocal.AppData, service, webhooks); err != nil {
+		return err
+	}
+	if err := appLocal.Write(); err != nil {
+		return err
+	}
+
+	for _, operation := range skipped {
+		ui.Print(terminal.NewWarningLog("Skipped operation %s, as its method is not supported by incoming webhooks", operation))
+	}
+
+	rows := make([]map[string]interface{}, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		rows = append(rows, map[string]interface{}{
+			headerWebhook: endpoint.Name,
+			headerMethod:  endpoint.Method,
+			headerPath:    endpoint.Path,
+			headerAuth:    endpoint.Auth,
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Generated %d incoming webhook(s) for the HTTP service '%s'", len(endpoints), spec.serviceName()),
+		[]string{headerWebhook, headerMethod, headerPath, headerAuth},
+		rows...,
+	))
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 type initInputs struct {
 	newAppInputs
+	FromSwagger string
 }
 
 func (i *initInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
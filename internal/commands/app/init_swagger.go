@@ -0,0 +1,277 @@
+package app
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/10gen/realm-cli/internal/local"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	flagFromSwagger      = "from-swagger"
+	flagFromSwaggerUsage = "the path to a Swagger or OpenAPI document to generate incoming webhooks for"
+
+	headerWebhook = "Webhook"
+	headerMethod  = "Method"
+	headerAuth    = "Auth"
+
+	webhookValidationNone        = "NO_VALIDATION"
+	webhookValidationQuerySecret = "SECRET_AS_QUERY_PARAM"
+
+	defaultSwaggerServiceName = "api"
+
+	// webhookSourceStubFormat is the source written for the function of each generated webhook,
+	// which is formatted with the operation's method, path and summary
+	webhookSourceStubFormat = `// %s %s%s
+exports = async function({ query, headers, body }, response) {
+  // Write your endpoint logic here
+
+  return {};
+};
+`
+)
+
+// set of supported endpoint authentications
+const (
+	endpointAuthNone        = "none"
+	endpointAuthQuerySecret = "query secret"
+	endpointAuthUser        = "application user"
+)
+
+// swaggerMethods are the OpenAPI operation methods supported by incoming webhooks
+var swaggerMethods = []string{"get", "post", "put", "patch", "delete"}
+
+var nonIdentifierChars = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+type swaggerSpec struct {
+	Swagger  string                            `yaml:"swagger"`
+	OpenAPI  string                            `yaml:"openapi"`
+	Info     swaggerInfo                       `yaml:"info"`
+	Paths    map[string]map[string]interface{} `yaml:"paths"`
+	Security []map[string][]string             `yaml:"security"`
+
+	// the security schemes of Swagger 2.0 and OpenAPI 3 documents respectively
+	SecurityDefinitions map[string]swaggerSecurityScheme `yaml:"securityDefinitions"`
+	Components          struct {
+		SecuritySchemes map[string]swaggerSecurityScheme `yaml:"securitySchemes"`
+	} `yaml:"components"`
+}
+
+type swaggerInfo struct {
+	Title string `yaml:"title"`
+}
+
+type swaggerSecurityScheme struct {
+	Type string `yaml:"type"`
+	In   string `yaml:"in"`
+}
+
+type swaggerOperation struct {
+	OperationID string                 `yaml:"operationId"`
+	Summary     string                 `yaml:"summary"`
+	Security    *[]map[string][]string `yaml:"security"`
+}
+
+// swaggerEndpoint is an incoming webhook generated for an OpenAPI operation
+type swaggerEndpoint struct {
+	Name    string
+	Method  string
+	Path    string
+	Auth    string
+	Summary string
+}
+
+// readSwaggerSpec reads a Swagger 2.0 or OpenAPI 3 document, in either JSON or YAML
+func readSwaggerSpec(path string) (swaggerSpec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return swaggerSpec{}, err
+	}
+
+	var spec swaggerSpec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return swaggerSpec{}, fmt.Errorf("failed to parse OpenAPI document %s: %s", path, err)
+	}
+	if spec.Swagger == "" && spec.OpenAPI == "" {
+		return swaggerSpec{}, fmt.Errorf("failed to parse OpenAPI document %s: missing the swagger or openapi version", path)
+	}
+	return spec, nil
+}
+
+// serviceName returns the name of the HTTP service holding the generated webhooks
+func (spec swaggerSpec) serviceName() string {
+	if name := identifier(spec.Info.Title); name != "" {
+		return name
+	}
+	return defaultSwaggerServiceName
+}
+
+// endpoints returns the webhooks to generate for the document's operations
+// sorted by path and method, along with the operations which are not supported
+func (spec swaggerSpec) endpoints() ([]swaggerEndpoint, []string, error) {
+	paths := make([]string, 0, len(spec.Paths))
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var endpoints []swaggerEndpoint
+	var skipped []string
+	names := map[string]string{}
+	for _, path := range paths {
+		pathItem := spec.Paths[path]
+
+		methods := make([]string, 0, len(pathItem))
+		for method := range pathItem {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+
+		for _, method := range methods {
+			if method == "parameters" || strings.HasPrefix(method, "x-") {
+				continue // path-level fields rather than operations
+			}
+
+			display := strings.ToUpper(method) + " " + path
+			if !isSwaggerMethod(method) {
+				skipped = append(skipped, display)
+				continue
+			}
+
+			var operation swaggerOperation
+			data, err := yaml.Marshal(pathItem[method])
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := yaml.Unmarshal(data, &operation); err != nil {
+				return nil, nil, fmt.Errorf("failed to parse operation %s: %s", display, err)
+			}
+
+			name := identifier(operation.OperationID)
+			if name == "" {
+				name = camelCaseIdentifier(method + " " + path)
+			}
+			if other, ok := names[name]; ok {
+				return nil, nil, fmt.Errorf("operations %s and %s both generate the webhook '%s'", other, display, name)
+			}
+			names[name] = display
+
+			endpoints = append(endpoints, swaggerEndpoint{
+				Name:    name,
+				Method:  strings.ToUpper(method),
+				Path:    path,
+				Auth:    spec.auth(operation),
+				Summary: operation.Summary,
+			})
+		}
+	}
+	return endpoints, skipped, nil
+}
+
+// auth returns how requests to the operation are authenticated, where an operation's
+// security requirements override the ones of the document
+func (spec swaggerSpec) auth(operation swaggerOperation) string {
+	security := spec.Security
+	if operation.Security != nil {
+		security = *operation.Security
+	}
+	if len(security) == 0 {
+		return endpointAuthNone
+	}
+
+	schemes := spec.SecurityDefinitions
+	if len(schemes) == 0 {
+		schemes = spec.Components.SecuritySchemes
+	}
+
+	for _, requirement := range security {
+		if len(requirement) == 0 {
+			return endpointAuthNone // an empty requirement makes the security optional
+		}
+	}
+	for name := range security[0] {
+		if scheme := schemes[name]; scheme.Type == "apiKey" && scheme.In == "query" {
+			return endpointAuthQuerySecret
+		}
+	}
+	return endpointAuthUser
+}
+
+// webhook returns the incoming webhook config, along with its function source
+func (e swaggerEndpoint) webhook() map[string]interface{} {
+	validationMethod := webhookValidationNone
+	if e.Auth == endpointAuthQuerySecret {
+		validationMethod = webhookValidationQuerySecret
+	}
+
+	options := map[string]interface{}{
+		"httpMethod":       e.Method,
+		"validationMethod": validationMethod,
+	}
+	if e.Auth == endpointAuthQuerySecret {
+		options["secret"] = ""
+	}
+
+	var summary string
+	if e.Summary != "" {
+		summary = ": " + e.Summary
+	}
+
+	return map[string]interface{}{
+		"name":                         e.Name,
+		"run_as_authed_user":           e.Auth == endpointAuthUser,
+		"run_as_user_id":               "",
+		"run_as_user_id_script_source": "",
+		"can_evaluate":                 map[string]interface{}{},
+		"options":                      options,
+		"respond_result":               true,
+		"fetch_custom_user_data":       false,
+		"create_user_on_auth":          false,
+		local.NameSource:               fmt.Sprintf(webhookSourceStubFormat, e.Method, e.Path, summary),
+	}
+}
+
+func isSwaggerMethod(method string) bool {
+	for _, m := range swaggerMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// identifier converts the value into an identifier by joining its words with underscores,
+// e.g. "Swagger Petstore" becomes "Swagger_Petstore"
+func identifier(value string) string {
+	var words []string
+	for _, word := range nonIdentifierChars.Split(value, -1) {
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+	return strings.Join(words, "_")
+}
+
+// camelCaseIdentifier converts the value into a camelCase identifier,
+// e.g. "get /pets/{petId}" becomes "getPetsPetId"
+func camelCaseIdentifier(value string) string {
+	var sb strings.Builder
+	for _, word := range nonIdentifierChars.Split(value, -1) {
+		if word == "" {
+			continue
+		}
+		if sb.Len() > 0 {
+			runes := []rune(word)
+			runes[0] = unicode.ToUpper(runes[0])
+			word = string(runes)
+		}
+		sb.WriteString(word)
+	}
+	return sb.String()
+}
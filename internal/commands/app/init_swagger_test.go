@@ -0,0 +1,150 @@
+package app
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+const testSwaggerYAML = `openapi: 3.0.0
+info:
+  title: Swagger Petstore
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List all pets
+      security: []
+    post:
+      operationId: createPets
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+    get:
+      summary: Info for a specific pet
+      security:
+        - apiKeyQuery: []
+    head:
+      operationId: petExists
+components:
+  securitySchemes:
+    bearerAuth:
+      type: http
+      scheme: bearer
+    apiKeyQuery:
+      type: apiKey
+      in: query
+      name: secret
+security:
+  - bearerAuth: []
+`
+
+func writeSwaggerSpec(t *testing.T, name, contents string) (string, func()) {
+	t.Helper()
+
+	dir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+
+	path := filepath.Join(dir, name)
+	assert.Nil(t, local.WriteFile(path, 0666, strings.NewReader(contents)))
+	return path, teardown
+}
+
+func TestSwaggerSpecEndpoints(t *testing.T) {
+	t.Run("should generate an endpoint for each supported operation", func(t *testing.T) {
+		path, teardown := writeSwaggerSpec(t, "petstore.yaml", testSwaggerYAML)
+		defer teardown()
+
+		spec, err := readSwaggerSpec(path)
+		assert.Nil(t, err)
+		assert.Equal(t, "Swagger_Petstore", spec.serviceName())
+
+		endpoints, skipped, err := spec.endpoints()
+		assert.Nil(t, err)
+		assert.Equal(t, []swaggerEndpoint{
+			{Name: "listPets", Method: "GET", Path: "/pets", Auth: endpointAuthNone, Summary: "List all pets"},
+			{Name: "createPets", Method: "POST", Path: "/pets", Auth: endpointAuthUser},
+			{Name: "getPetsPetId", Method: "GET", Path: "/pets/{petId}", Auth: endpointAuthQuerySecret, Summary: "Info for a specific pet"},
+		}, endpoints)
+		assert.Equal(t, []string{"HEAD /pets/{petId}"}, skipped)
+	})
+
+	t.Run("should read a swagger 2.0 document in json", func(t *testing.T) {
+		path, teardown := writeSwaggerSpec(t, "orders.json", `{
+  "swagger": "2.0",
+  "paths": {"/orders": {"delete": {"operationId": "delete-orders", "security": [{"key": []}]}}},
+  "securityDefinitions": {"key": {"type": "apiKey", "in": "header", "name": "X-Api-Key"}}
+}`)
+		defer teardown()
+
+		spec, err := readSwaggerSpec(path)
+		assert.Nil(t, err)
+		assert.Equal(t, defaultSwaggerServiceName, spec.serviceName())
+
+		endpoints, skipped, err := spec.endpoints()
+		assert.Nil(t, err)
+		assert.Equal(t, []swaggerEndpoint{{Name: "delete_orders", Method: "DELETE", Path: "/orders", Auth: endpointAuthUser}}, endpoints)
+		assert.Equal(t, 0, len(skipped))
+	})
+
+	t.Run("should return an error when operations generate the same webhook", func(t *testing.T) {
+		path, teardown := writeSwaggerSpec(t, "dupes.yaml", `swagger: "2.0"
+paths:
+  /a:
+    get:
+      operationId: find
+  /b:
+    get:
+      operationId: find
+`)
+		defer teardown()
+
+		spec, err := readSwaggerSpec(path)
+		assert.Nil(t, err)
+
+		_, _, err = spec.endpoints()
+		assert.Equal(t, errors.New("operations GET /a and GET /b both generate the webhook 'find'"), err)
+	})
+
+	t.Run("should return an error when the document is not an openapi document", func(t *testing.T) {
+		path, teardown := writeSwaggerSpec(t, "other.json", `{"name": "not a spec"}`)
+		defer teardown()
+
+		_, err := readSwaggerSpec(path)
+		assert.Equal(t, errors.New("failed to parse OpenAPI document "+path+": missing the swagger or openapi version"), err)
+	})
+}
+
+func TestSwaggerEndpointWebhook(t *testing.T) {
+	endpoint := swaggerEndpoint{Name: "getPetsPetId", Method: "GET", Path: "/pets/{petId}", Auth: endpointAuthQuerySecret, Summary: "Info for a specific pet"}
+
+	assert.Equal(t, map[string]interface{}{
+		"name":                         "getPetsPetId",
+		"run_as_authed_user":           false,
+		"run_as_user_id":               "",
+		"run_as_user_id_script_source": "",
+		"can_evaluate":                 map[string]interface{}{},
+		"options": map[string]interface{}{
+			"httpMethod":       "GET",
+			"validationMethod": "SECRET_AS_QUERY_PARAM",
+			"secret":           "",
+		},
+		"respond_result":         true,
+		"fetch_custom_user_data": false,
+		"create_user_on_auth":    false,
+		"source": `// GET /pets/{petId}: Info for a specific pet
+exports = async function({ query, headers, body }, response) {
+  // Write your endpoint logic here
+
+  return {};
+};
+`,
+	}, endpoint.webhook())
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"archive/zip"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -208,4 +209,80 @@ func TestAppInitHandler(t *testing.T) {
 `, string(config))
 		})
 	})
+
+	t.Run("should initialize a project with incoming webhooks generated from an openapi document", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_init_test")
+		defer teardown()
+
+		specPath, specTeardown := writeSwaggerSpec(t, "petstore.yaml", testSwaggerYAML)
+		defer specTeardown()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandInit{initInputs{
+			newAppInputs: newAppInputs{
+				Name:            "test-app",
+				DeploymentModel: realm.DeploymentModelGlobal,
+				Location:        realm.LocationVirginia,
+				ConfigVersion:   realm.DefaultAppConfigVersion,
+			},
+			FromSwagger: specPath,
+		}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Equal(t, `Warning: Skipped operation HEAD /pets/{petId}, as its method is not supported by incoming webhooks
+Generated 3 incoming webhook(s) for the HTTP service 'Swagger_Petstore'
+  Webhook       Method  Path           Auth            
+  ------------  ------  -------------  ----------------
+  listPets      GET     /pets          none            
+  createPets    POST    /pets          application user
+  getPetsPetId  GET     /pets/{petId}  query secret    
+Successfully initialized app
+`, out.String())
+
+		app, err := local.LoadApp(profile.WorkingDirectory)
+		assert.Nil(t, err)
+
+		appData, ok := app.AppData.(*local.AppRealmConfigJSON)
+		assert.True(t, ok, "expected a v2 app")
+		assert.Equal(t, 1, len(appData.HTTPEndpoints))
+
+		httpEndpoint := appData.HTTPEndpoints[0]
+		assert.Equal(t, "Swagger_Petstore", httpEndpoint.Config["name"])
+		assert.Equal(t, "http", httpEndpoint.Config["type"])
+		assert.Equal(t, 3, len(httpEndpoint.IncomingWebhooks))
+
+		source, err := ioutil.ReadFile(filepath.Join(profile.WorkingDirectory, local.NameHTTPEndpoints, "Swagger_Petstore", local.NameIncomingWebhooks, "listPets", local.FileSource.String()))
+		assert.Nil(t, err)
+		assert.Equal(t, `// GET /pets: List all pets
+exports = async function({ query, headers, body }, response) {
+  // Write your endpoint logic here
+
+  return {};
+};
+`, string(source))
+	})
+
+	t.Run("should not initialize a project when the openapi document is invalid", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_init_test")
+		defer teardown()
+
+		specPath, specTeardown := writeSwaggerSpec(t, "petstore.json", `{"paths": {}}`)
+		defer specTeardown()
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandInit{initInputs{
+			newAppInputs: newAppInputs{Name: "test-app", ConfigVersion: realm.DefaultAppConfigVersion},
+			FromSwagger:  specPath,
+		}}
+
+		assert.Equal(t,
+			errors.New("failed to parse OpenAPI document "+specPath+": missing the swagger or openapi version"),
+			cmd.Handler(profile, ui, cli.Clients{}),
+		)
+
+		_, err := os.Stat(filepath.Join(profile.WorkingDirectory, local.FileRealmConfig.String()))
+		assert.True(t, os.IsNotExist(err), "expected no app to be written")
+	})
 }
@@ -42,9 +42,11 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 	}
 
 	rows := make([]interface{}, 0, len(apps))
+	templateRows := make([]interface{}, 0, len(apps))
 	for _, app := range apps {
 		rows = append(rows, app.Option())
+		templateRows = append(templateRows, app)
 	}
-	ui.Print(terminal.NewListLog(fmt.Sprintf("Found %d apps", len(rows)), rows...))
+	ui.Print(terminal.NewListLog(fmt.Sprintf("Found %d apps", len(rows)), rows...).WithRows(templateRows...))
 	return nil
 }
@@ -12,4 +12,6 @@ const (
 	headerName    = "Name"
 	headerDeleted = "Deleted"
 	headerDetails = "Details"
+	headerPath    = "Path"
+	headerIssue   = "Issue"
 )
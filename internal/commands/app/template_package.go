@@ -0,0 +1,130 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaTemplatePackage is the command meta for the `app template package` command
+var CommandMetaTemplatePackage = cli.CommandMeta{
+	Use:         "package",
+	Display:     "app template package",
+	Description: "Package your local Realm app directory as a reusable app template",
+	HelpText: `Converts your local Realm app directory into a template bundle which can be
+shared and used to create new Realm apps with "app create --template-file".
+
+The app name, client app id and the names of linked data sources are replaced
+with placeholders which are filled in when the template is used. Secret values
+are never included: the Secrets your app references are listed in the template
+instead, and their values are provided when the template is used.
+
+Hosting files and dependencies are not included in the template.`,
+}
+
+// CommandTemplatePackage is the `app template package` command
+type CommandTemplatePackage struct {
+	inputs templatePackageInputs
+}
+
+type templatePackageInputs struct {
+	LocalPath string
+	Output    string
+}
+
+const (
+	flagLocalPathTemplate      = "local"
+	flagLocalPathTemplateUsage = "the local path to the Realm app to package as a template"
+
+	flagOutputTemplate      = "output"
+	flagOutputTemplateUsage = "the path to write the app template to, defaults to '<app name>-template.zip'"
+)
+
+// Flags is the command flags
+func (cmd *CommandTemplatePackage) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathTemplate, "", flagLocalPathTemplateUsage)
+	fs.StringVar(&cmd.inputs.Output, flagOutputTemplate, "", flagOutputTemplateUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandTemplatePackage) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandTemplatePackage) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := local.PackageAppTemplate(app)
+	if err != nil {
+		return err
+	}
+
+	output := cmd.inputs.Output
+	if output == "" {
+		output = filepath.Join(profile.WorkingDirectory, tmpl.Manifest.Name+"-template.zip")
+	}
+
+	if err := local.WriteAppTemplate(output, tmpl); err != nil {
+		return err
+	}
+
+	logs := []terminal.Log{terminal.NewTextLog("Successfully packaged app template to %s", output)}
+	if len(tmpl.Manifest.DataSources) > 0 {
+		logs = append(logs, terminal.NewListLog("Data sources which can be renamed", stringsToInterfaces(tmpl.Manifest.DataSources)...))
+	}
+	if len(tmpl.Manifest.Secrets) > 0 {
+		logs = append(logs, terminal.NewListLog("Secrets which must be provided", stringsToInterfaces(tmpl.Manifest.Secrets)...))
+	}
+	logs = append(logs, terminal.NewFollowupLog("To create an app from this template run", fmt.Sprintf("%s %s --%s %s", cli.Name, CommandMetaCreate.Display, flagTemplateFile, output)))
+
+	ui.Print(logs...)
+	return nil
+}
+
+func (i *templatePackageInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	searchPath := i.LocalPath
+	if searchPath == "" {
+		searchPath = profile.WorkingDirectory
+	}
+
+	app, err := local.LoadAppConfig(searchPath)
+	if err != nil {
+		return err
+	}
+
+	if i.LocalPath == "" && app.RootDir == "" {
+		if err := ui.AskOne(&i.LocalPath, &survey.Input{Message: "App filepath (local)"}); err != nil {
+			return err
+		}
+
+		app, err = local.LoadAppConfig(i.LocalPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if app.RootDir != "" {
+		i.LocalPath = app.RootDir
+	}
+
+	return nil
+}
+
+func stringsToInterfaces(values []string) []interface{} {
+	out := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		out = append(out, value)
+	}
+	return out
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+// writeTemplateApp writes an app with a linked data source and a value referencing a secret
+func writeTemplateApp(t *testing.T, dir string) {
+	t.Helper()
+
+	app := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.DefaultAppConfigVersion)
+	local.AddDataSource(app.AppData, map[string]interface{}{
+		"name":   "mongodb-atlas",
+		"type":   "mongodb-atlas",
+		"config": map[string]interface{}{"clusterName": "Cluster0"},
+	})
+	assert.Nil(t, app.Write())
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, local.NameValues, "apiKey.json"), []byte(`{"name":"apiKey","value":"apiKeySecret","from_secret":true}`), 0666))
+}
+
+func TestAppTemplatePackageHandler(t *testing.T) {
+	t.Run("should package the local app as a template", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_template_package_test")
+		defer teardown()
+
+		appDir := filepath.Join(profile.WorkingDirectory, "eggcorn")
+		writeTemplateApp(t, appDir)
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandTemplatePackage{templatePackageInputs{LocalPath: appDir}}
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+
+		output := filepath.Join(profile.WorkingDirectory, "eggcorn-template.zip")
+		assert.Equal(t, `Successfully packaged app template to `+output+`
+Data sources which can be renamed
+  mongodb-atlas
+Secrets which must be provided
+  apiKeySecret
+To create an app from this template run: realm-cli app create --template-file `+output+`
+`, out.String())
+
+		tmpl, err := local.ReadAppTemplate(output)
+		assert.Nil(t, err)
+		assert.Equal(t, local.AppTemplateManifest{
+			Name:          "eggcorn",
+			ConfigVersion: realm.DefaultAppConfigVersion,
+			DataSources:   []string{"mongodb-atlas"},
+			Secrets:       []string{"apiKeySecret"},
+		}, tmpl.Manifest)
+	})
+
+	t.Run("should write the template to the output path", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_template_package_test")
+		defer teardown()
+
+		appDir := filepath.Join(profile.WorkingDirectory, "eggcorn")
+		writeTemplateApp(t, appDir)
+
+		_, ui := mock.NewUI()
+
+		output := filepath.Join(profile.WorkingDirectory, "templates", "starter.zip")
+
+		cmd := &CommandTemplatePackage{templatePackageInputs{LocalPath: appDir, Output: output}}
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+
+		_, err := local.ReadAppTemplate(output)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should return an error when the local app cannot be found", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_template_package_test")
+		defer teardown()
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandTemplatePackage{templatePackageInputs{LocalPath: profile.WorkingDirectory}}
+		assert.NotNil(t, cmd.Handler(profile, ui, cli.Clients{}))
+	})
+}
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaValidate is the command meta
+var CommandMetaValidate = cli.CommandMeta{
+	Use:         "validate",
+	Aliases:     []string{},
+	Display:     "app validate",
+	Description: "Validate your local Realm app directory without contacting Realm",
+	HelpText: `Checks your local Realm app directory for structural issues before you push
+it. This includes invalid JSON, missing required fields, triggers and GraphQL
+custom resolvers that reference functions which do not exist, and unknown
+operators or expansions in rule expressions.
+
+Validation happens entirely on your machine. If any issues are found, they are
+listed and the command exits with a non-zero status.`,
+}
+
+// CommandValidate is the `app validate` command
+type CommandValidate struct {
+	inputs validateInputs
+}
+
+type validateInputs struct {
+	LocalPath string
+}
+
+const (
+	flagLocalPathValidate      = "local"
+	flagLocalPathValidateUsage = "the local path to a Realm app to validate"
+)
+
+// Flags is the command flags
+func (cmd *CommandValidate) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathValidate, "", flagLocalPathValidateUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandValidate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandValidate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	findings, err := local.ValidateApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	if len(findings) == 0 {
+		ui.Print(terminal.NewTextLog("Successfully validated app with no issues found"))
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(findings))
+	for _, finding := range findings {
+		rows = append(rows, map[string]interface{}{
+			headerPath:  finding.Path,
+			headerIssue: finding.Message,
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d issue(s) with your app", len(findings)),
+		[]string{headerPath, headerIssue},
+		rows...,
+	))
+
+	return errValidationFailed{len(findings)}
+}
+
+func (i *validateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	searchPath := i.LocalPath
+	if searchPath == "" {
+		searchPath = profile.WorkingDirectory
+	}
+
+	app, err := local.LoadAppConfig(searchPath)
+	if err != nil {
+		return err
+	}
+
+	if i.LocalPath == "" && app.RootDir == "" {
+		if err := ui.AskOne(&i.LocalPath, &survey.Input{Message: "App filepath (local)"}); err != nil {
+			return err
+		}
+
+		app, err = local.LoadAppConfig(i.LocalPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if app.RootDir != "" {
+		i.LocalPath = app.RootDir
+	}
+
+	return nil
+}
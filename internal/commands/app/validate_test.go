@@ -0,0 +1,78 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestAppValidateHandler(t *testing.T) {
+	t.Run("should print a success message when no issues are found", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandValidate{validateInputs{LocalPath: "testdata/diff"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, "Successfully validated app with no issues found\n", out.String())
+	})
+
+	t.Run("should print the issues found and return an error", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		assert.Nil(t, ioutil.WriteFile(
+			filepath.Join(tmpDir, local.FileRealmConfig.String()),
+			[]byte(`{"config_version":20210101,"name":"eggcorn"}`),
+			0666,
+		))
+		assert.Nil(t, os.MkdirAll(filepath.Join(tmpDir, local.NameTriggers), os.ModePerm))
+		assert.Nil(t, ioutil.WriteFile(
+			filepath.Join(tmpDir, local.NameTriggers, "trigger.json"),
+			[]byte(`{"name":"trigger","type":"SCHEDULED","function_name":"missing"}`),
+			0666,
+		))
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandValidate{validateInputs{LocalPath: tmpDir}}
+
+		err = cmd.Handler(nil, ui, cli.Clients{})
+		assert.Equal(t, errValidationFailed{1}, err)
+		assert.Equal(t, `Found 1 issue(s) with your app
+  Path                   Issue                                             
+  ---------------------  --------------------------------------------------
+  triggers/trigger.json  references function 'missing' which does not exist
+`, out.String())
+	})
+}
+
+func TestAppValidateInputs(t *testing.T) {
+	t.Run("should resolve the local path to the app root when inside an app directory", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		profile.WorkingDirectory = filepath.Join(profile.WorkingDirectory, "testdata/diff/hosting")
+
+		_, ui := mock.NewUI()
+
+		inputs := validateInputs{}
+		assert.Nil(t, inputs.Resolve(profile, ui))
+		assert.Equal(t, filepath.Join(profile.WorkingDirectory, ".."), inputs.LocalPath)
+	})
+
+	t.Run("should not override the local path when provided", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		_, ui := mock.NewUI()
+
+		inputs := validateInputs{LocalPath: "./testdata/diff"}
+		assert.Nil(t, inputs.Resolve(profile, ui))
+		assert.Equal(t, filepath.Join(profile.WorkingDirectory, "testdata/diff"), inputs.LocalPath)
+	})
+}
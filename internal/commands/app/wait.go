@@ -0,0 +1,243 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaWait is the command meta for the `app wait` command
+var CommandMetaWait = cli.CommandMeta{
+	Use:         "wait",
+	Display:     "app wait",
+	Description: "Wait for a condition of your Realm app to be satisfied",
+	HelpText: `Polls your Realm app until the specified condition is satisfied, which is useful
+to sequence the steps of a CI/CD pipeline. The supported conditions are:
+  - deployment-successful: the latest deployment, or the one specified with
+    "--deployment", has succeeded
+  - sync-enabled: Sync is enabled for the app
+  - dependencies-installed: the app's dependencies have finished installing
+  - cluster-linked: a MongoDB Atlas cluster, or the one specified with
+    "--data-source", is linked to the app
+
+The command exits with code 0 once the condition is satisfied, 1 if the
+condition can no longer be satisfied (e.g. the deployment failed) and 2 if it
+times out.`,
+}
+
+// CommandWait is the `app wait` command
+type CommandWait struct {
+	inputs waitInputs
+}
+
+// set of supported wait conditions
+const (
+	waitConditionDeploymentSuccessful  = "deployment-successful"
+	waitConditionSyncEnabled           = "sync-enabled"
+	waitConditionDependenciesInstalled = "dependencies-installed"
+	waitConditionClusterLinked         = "cluster-linked"
+)
+
+var waitConditions = []string{
+	waitConditionDeploymentSuccessful,
+	waitConditionSyncEnabled,
+	waitConditionDependenciesInstalled,
+	waitConditionClusterLinked,
+}
+
+const (
+	flagWaitFor      = "for"
+	flagWaitForUsage = "the condition to wait for, one of: deployment-successful, sync-enabled, dependencies-installed or cluster-linked"
+
+	flagWaitTimeout      = "timeout"
+	flagWaitTimeoutUsage = "the maximum amount of time to wait for, e.g. 30s or 10m"
+
+	flagWaitInterval      = "interval"
+	flagWaitIntervalUsage = "the amount of time to wait between checking the condition"
+
+	flagWaitDeployment      = "deployment"
+	flagWaitDeploymentUsage = "the id of the deployment to wait for, defaults to the latest deployment"
+
+	flagWaitDataSource      = "data-source"
+	flagWaitDataSourceUsage = "the name of the data source to wait for, defaults to any linked cluster"
+
+	defaultWaitTimeout  = 10 * time.Minute
+	defaultWaitInterval = 5 * time.Second
+
+	syncStateEnabled      = "enabled"
+	dataSourceTypeCluster = "mongodb-atlas"
+)
+
+type waitInputs struct {
+	cli.ProjectInputs
+	For        string
+	Timeout    time.Duration
+	Interval   time.Duration
+	Deployment string
+	DataSource string
+}
+
+// Flags is the command flags
+func (cmd *CommandWait) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.For, flagWaitFor, "", flagWaitForUsage)
+	fs.DurationVar(&cmd.inputs.Timeout, flagWaitTimeout, defaultWaitTimeout, flagWaitTimeoutUsage)
+	fs.DurationVar(&cmd.inputs.Interval, flagWaitInterval, defaultWaitInterval, flagWaitIntervalUsage)
+	fs.StringVar(&cmd.inputs.Deployment, flagWaitDeployment, "", flagWaitDeploymentUsage)
+	fs.StringVar(&cmd.inputs.DataSource, flagWaitDataSource, "", flagWaitDataSourceUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandWait) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandWait) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	s := ui.Spinner(fmt.Sprintf("Waiting for %s...", cmd.inputs.For))
+
+	wait := func() error {
+		s.Start()
+		defer s.Stop()
+
+		deadline := time.Now().Add(cmd.inputs.Timeout)
+		for {
+			satisfied, err := cmd.inputs.check(clients.Realm, app)
+			if err != nil {
+				return err
+			}
+			if satisfied {
+				return nil
+			}
+
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return errWaitTimeout{cmd.inputs.For, cmd.inputs.Timeout}
+			}
+			if remaining > cmd.inputs.Interval {
+				remaining = cmd.inputs.Interval
+			}
+			time.Sleep(remaining)
+		}
+	}
+
+	if err := wait(); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Condition '%s' is satisfied", cmd.inputs.For))
+	return nil
+}
+
+// check returns whether the condition is satisfied, or an error if it can no longer be satisfied
+func (i waitInputs) check(client realm.Client, app realm.App) (bool, error) {
+	switch i.For {
+	case waitConditionDeploymentSuccessful:
+		var deployment realm.AppDeployment
+		if i.Deployment != "" {
+			d, err := client.Deployment(app.GroupID, app.ID, i.Deployment)
+			if err != nil {
+				return false, err
+			}
+			deployment = d
+		} else {
+			deployments, err := client.Deployments(app.GroupID, app.ID)
+			if err != nil {
+				return false, err
+			}
+			if len(deployments) == 0 {
+				return false, nil
+			}
+			deployment = deployments[0]
+		}
+
+		switch deployment.Status {
+		case realm.DeploymentStatusSuccessful:
+			return true, nil
+		case realm.DeploymentStatusFailed:
+			return false, fmt.Errorf("deployment '%s' failed: %s", deployment.ID, deployment.StatusErrorMessage)
+		}
+		return false, nil
+
+	case waitConditionSyncEnabled:
+		desc, err := client.AppDescription(app.GroupID, app.ID)
+		if err != nil {
+			return false, err
+		}
+		return desc.Sync.State == syncStateEnabled, nil
+
+	case waitConditionDependenciesInstalled:
+		status, err := client.DependenciesStatus(app.GroupID, app.ID)
+		if err != nil {
+			return false, err
+		}
+
+		switch status.State {
+		case realm.DependenciesStateSuccessful:
+			return true, nil
+		case realm.DependenciesStateFailed:
+			return false, fmt.Errorf("dependencies installation failed: %s", status.Message)
+		}
+		return false, nil
+
+	case waitConditionClusterLinked:
+		desc, err := client.AppDescription(app.GroupID, app.ID)
+		if err != nil {
+			return false, err
+		}
+		for _, dataSource := range desc.DataSources {
+			if dataSource.Type != dataSourceTypeCluster {
+				continue
+			}
+			if i.DataSource == "" || dataSource.Name == i.DataSource {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	return false, fmt.Errorf("unsupported condition '%s'", i.For)
+}
+
+func (i *waitInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Timeout <= 0 {
+		return fmt.Errorf(`"--%s" must be a positive duration`, flagWaitTimeout)
+	}
+	if i.Interval <= 0 {
+		return fmt.Errorf(`"--%s" must be a positive duration`, flagWaitInterval)
+	}
+
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	if i.For == "" {
+		if err := ui.AskOne(&i.For, &survey.Select{
+			Message: "Which condition would you like to wait for?",
+			Options: waitConditions,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, condition := range waitConditions {
+		if i.For == condition {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported condition '%s', use one of: %s", i.For, strings.Join(waitConditions, ", "))
+}
@@ -0,0 +1,228 @@
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestAppWaitHandler(t *testing.T) {
+	app := realm.App{
+		ID:          "60344735b37e3733de2adf40",
+		GroupID:     "groupID",
+		ClientAppID: "app1-abcde",
+		Name:        "app1",
+	}
+
+	newClient := func() mock.RealmClient {
+		return mock.RealmClient{
+			FindAppsFn: func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{app}, nil
+			},
+		}
+	}
+
+	t.Run("should wait until the condition is satisfied", func(t *testing.T) {
+		for _, tc := range []struct {
+			description string
+			inputs      waitInputs
+			setup       func(client *mock.RealmClient, calls *int)
+		}{
+			{
+				description: "with the latest deployment succeeding",
+				inputs:      waitInputs{For: waitConditionDeploymentSuccessful},
+				setup: func(client *mock.RealmClient, calls *int) {
+					client.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+						*calls++
+						status := realm.DeploymentStatusPending
+						if *calls > 1 {
+							status = realm.DeploymentStatusSuccessful
+						}
+						return []realm.AppDeployment{{ID: "new", Status: status}, {ID: "old", Status: realm.DeploymentStatusFailed}}, nil
+					}
+				},
+			},
+			{
+				description: "with the specified deployment succeeding",
+				inputs:      waitInputs{For: waitConditionDeploymentSuccessful, Deployment: "deploymentID"},
+				setup: func(client *mock.RealmClient, calls *int) {
+					client.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+						*calls++
+						status := realm.DeploymentStatusCreated
+						if *calls > 1 {
+							status = realm.DeploymentStatusSuccessful
+						}
+						return realm.AppDeployment{ID: deploymentID, Status: status}, nil
+					}
+				},
+			},
+			{
+				description: "with sync being enabled",
+				inputs:      waitInputs{For: waitConditionSyncEnabled},
+				setup: func(client *mock.RealmClient, calls *int) {
+					client.AppDescriptionFn = func(groupID, appID string) (realm.AppDescription, error) {
+						*calls++
+						var desc realm.AppDescription
+						if *calls > 1 {
+							desc.Sync.State = "enabled"
+						}
+						return desc, nil
+					}
+				},
+			},
+			{
+				description: "with the dependencies being installed",
+				inputs:      waitInputs{For: waitConditionDependenciesInstalled},
+				setup: func(client *mock.RealmClient, calls *int) {
+					client.DependenciesStatusFn = func(groupID, appID string) (realm.DependenciesStatus, error) {
+						*calls++
+						state := realm.DependenciesStateCreated
+						if *calls > 1 {
+							state = realm.DependenciesStateSuccessful
+						}
+						return realm.DependenciesStatus{State: state}, nil
+					}
+				},
+			},
+			{
+				description: "with the specified cluster being linked",
+				inputs:      waitInputs{For: waitConditionClusterLinked, DataSource: "mongodb-atlas"},
+				setup: func(client *mock.RealmClient, calls *int) {
+					client.AppDescriptionFn = func(groupID, appID string) (realm.AppDescription, error) {
+						*calls++
+						desc := realm.AppDescription{DataSources: []realm.DataSourceSummary{{Name: "other", Type: "mongodb-atlas"}}}
+						if *calls > 1 {
+							desc.DataSources = append(desc.DataSources, realm.DataSourceSummary{Name: "mongodb-atlas", Type: "mongodb-atlas"})
+						}
+						return desc, nil
+					}
+				},
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				out, ui := mock.NewUI()
+
+				var calls int
+				realmClient := newClient()
+				tc.setup(&realmClient, &calls)
+
+				tc.inputs.Timeout = time.Minute
+				tc.inputs.Interval = time.Millisecond
+
+				cmd := &CommandWait{tc.inputs}
+				assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+
+				assert.Equal(t, 2, calls)
+				assert.Equal(t, "Condition '"+tc.inputs.For+"' is satisfied\n", out.String())
+			})
+		}
+	})
+
+	t.Run("should return an error when the deployment fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newClient()
+		realmClient.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+			return []realm.AppDeployment{{ID: "deploymentID", Status: realm.DeploymentStatusFailed, StatusErrorMessage: "something bad happened"}}, nil
+		}
+
+		cmd := &CommandWait{waitInputs{For: waitConditionDeploymentSuccessful, Timeout: time.Minute, Interval: time.Millisecond}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("deployment 'deploymentID' failed: something bad happened"), err)
+	})
+
+	t.Run("should return an error when the dependencies fail to install", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newClient()
+		realmClient.DependenciesStatusFn = func(groupID, appID string) (realm.DependenciesStatus, error) {
+			return realm.DependenciesStatus{State: realm.DependenciesStateFailed, Message: "npm install failed"}, nil
+		}
+
+		cmd := &CommandWait{waitInputs{For: waitConditionDependenciesInstalled, Timeout: time.Minute, Interval: time.Millisecond}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("dependencies installation failed: npm install failed"), err)
+	})
+
+	t.Run("should return a timeout error with its exit code when the condition is not satisfied in time", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		var calls int
+		realmClient := newClient()
+		realmClient.AppDescriptionFn = func(groupID, appID string) (realm.AppDescription, error) {
+			calls++
+			return realm.AppDescription{}, nil
+		}
+
+		cmd := &CommandWait{waitInputs{For: waitConditionSyncEnabled, Timeout: 10 * time.Millisecond, Interval: time.Millisecond}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errWaitTimeout{waitConditionSyncEnabled, 10 * time.Millisecond}, err)
+		assert.Equal(t, "timed out after 10ms waiting for sync-enabled", err.Error())
+		assert.True(t, calls > 1, "expected the condition to be checked more than once, but was checked %d time(s)", calls)
+
+		exitCoder, ok := err.(cli.ExitCoder)
+		assert.True(t, ok, "expected the error to provide an exit code")
+		assert.Equal(t, 2, exitCoder.ExitCode())
+	})
+
+	t.Run("should return an error when the client fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newClient()
+		realmClient.DependenciesStatusFn = func(groupID, appID string) (realm.DependenciesStatus, error) {
+			return realm.DependenciesStatus{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandWait{waitInputs{For: waitConditionDependenciesInstalled, Timeout: time.Minute, Interval: time.Millisecond}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
+
+func TestAppWaitInputs(t *testing.T) {
+	t.Run("should resolve a supported condition", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		inputs := waitInputs{ProjectInputs: cli.ProjectInputs{App: "app1"}, For: waitConditionClusterLinked, Timeout: time.Minute, Interval: time.Second}
+		assert.Nil(t, inputs.Resolve(profile, ui))
+	})
+
+	for _, tc := range []struct {
+		description string
+		inputs      waitInputs
+		expectedErr error
+	}{
+		{
+			description: "should return an error with a non-positive timeout",
+			inputs:      waitInputs{For: waitConditionSyncEnabled, Interval: time.Second},
+			expectedErr: errors.New(`"--timeout" must be a positive duration`),
+		},
+		{
+			description: "should return an error with a non-positive interval",
+			inputs:      waitInputs{For: waitConditionSyncEnabled, Timeout: time.Minute},
+			expectedErr: errors.New(`"--interval" must be a positive duration`),
+		},
+		{
+			description: "should return an error with an unsupported condition",
+			inputs:      waitInputs{ProjectInputs: cli.ProjectInputs{App: "app1"}, For: "eventually", Timeout: time.Minute, Interval: time.Second},
+			expectedErr: errors.New("unsupported condition 'eventually', use one of: deployment-successful, sync-enabled, dependencies-installed, cluster-linked"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, ui))
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package bench
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagLocalPath      = "local"
+	flagLocalPathUsage = "specify the local path of the Realm app to measure (default: the working directory)"
+
+	flagIterations      = "iterations"
+	flagIterationsShort = "n"
+	flagIterationsUsage = "specify the number of times to run each step"
+
+	flagOutput      = "output"
+	flagOutputUsage = `specify the file to write the timings to as JSON, for use as a "--baseline" later`
+
+	flagBaseline      = "baseline"
+	flagBaselineUsage = `specify a file of timings previously written with "--output" to compare against`
+
+	flagThreshold      = "threshold"
+	flagThresholdUsage = `specify the percentage by which the mean timing of a step may exceed its "--baseline" before failing`
+
+	defaultIterations = 5
+	defaultThreshold  = 20
+
+	headerStep       = "Step"
+	headerIterations = "Iterations"
+	headerMin        = "Min"
+	headerMean       = "Mean"
+	headerMax        = "Max"
+	headerBaseline   = "Baseline"
+	headerChange     = "Change"
+
+	benchAppID = "bench"
+)
+
+// set of benchmarked steps
+const (
+	stepAppLoad          = "app load"
+	stepHostingHash      = "hosting hash"
+	stepDependenciesPack = "dependencies pack"
+)
+
+// CommandMeta is the command meta for the `bench-internal` command
+var CommandMeta = cli.CommandMeta{
+	Use:         "bench-internal",
+	Description: "Measure how long the CLI takes to process a local Realm app",
+	HelpText: `Measures how long loading the app configuration, hashing the hosting files and
+packing the function dependencies of a local Realm app take, which are the
+costliest steps of commands such as "push". Each step is run the number of
+times specified with "--iterations" and its timings are printed. Steps which
+do not apply to the app, such as when it has no hosting files, are skipped.
+
+Use "--output" to save the timings, then "--baseline" with the saved file to
+fail when any step has become slower than "--threshold" percent.`,
+	Hidden: true,
+}
+
+// Command is the `bench-internal` command
+type Command struct {
+	inputs inputs
+}
+
+type inputs struct {
+	LocalPath  string
+	Iterations int
+	Output     string
+	Baseline   string
+	Threshold  float64
+}
+
+// results are the timings of each benchmarked step
+type results struct {
+	Steps []result `json:"steps"`
+}
+
+type result struct {
+	Name       string        `json:"name"`
+	Iterations int           `json:"iterations"`
+	Min        time.Duration `json:"min_ns"`
+	Mean       time.Duration `json:"mean_ns"`
+	Max        time.Duration `json:"max_ns"`
+}
+
+// Flags is the command flags
+func (cmd *Command) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPath, "", flagLocalPathUsage)
+	fs.IntVarP(&cmd.inputs.Iterations, flagIterations, flagIterationsShort, defaultIterations, flagIterationsUsage)
+	fs.StringVar(&cmd.inputs.Output, flagOutput, "", flagOutputUsage)
+	fs.StringVar(&cmd.inputs.Baseline, flagBaseline, "", flagBaselineUsage)
+	fs.Float64Var(&cmd.inputs.Threshold, flagThreshold, defaultThreshold, flagThresholdUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *Command) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	var baseline map[string]result
+	if cmd.inputs.Baseline != "" {
+		b, err := readResults(cmd.inputs.Baseline)
+		if err != nil {
+			return err
+		}
+		baseline = b
+	}
+
+	tmpDir, err := ioutil.TempDir("", "realm-cli-bench")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var out results
+	var skipped []string
+	for _, step := range cmd.steps(tmpDir) {
+		res, ok, err := step.measure(cmd.inputs.Iterations)
+		if err != nil {
+			return fmt.Errorf("failed to measure %s: %w", step.name, err)
+		}
+		if !ok {
+			skipped = append(skipped, step.name)
+			continue
+		}
+		out.Steps = append(out.Steps, res)
+	}
+
+	headers := []string{headerStep, headerIterations, headerMin, headerMean, headerMax}
+	if baseline != nil {
+		headers = append(headers, headerBaseline, headerChange)
+	}
+
+	var regressed []string
+
+	rows := make([]map[string]interface{}, 0, len(out.Steps))
+	for _, res := range out.Steps {
+		row := map[string]interface{}{
+			headerStep:       res.Name,
+			headerIterations: res.Iterations,
+			headerMin:        durationDisplay(res.Min),
+			headerMean:       durationDisplay(res.Mean),
+			headerMax:        durationDisplay(res.Max),
+		}
+		if baseline != nil {
+			row[headerBaseline] = ""
+			row[headerChange] = ""
+			if base, ok := baseline[res.Name]; ok && base.Mean > 0 {
+				change := 100 * float64(res.Mean-base.Mean) / float64(base.Mean)
+
+				row[headerBaseline] = durationDisplay(base.Mean)
+				row[headerChange] = fmt.Sprintf("%+.1f%%", change)
+
+				if change > cmd.inputs.Threshold {
+					regressed = append(regressed, res.Name)
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	logs := []terminal.Log{terminal.NewTableLog(
+		fmt.Sprintf("Measured %d step(s) of the app at %s", len(out.Steps), cmd.inputs.LocalPath),
+		headers,
+		rows...,
+	)}
+	for _, name := range skipped {
+		logs = append(logs, terminal.NewTextLog("Skipped %s as it does not apply to the app", name))
+	}
+
+	if cmd.inputs.Output != "" {
+		if err := writeResults(cmd.inputs.Output, out); err != nil {
+			return err
+		}
+		logs = append(logs, terminal.NewTextLog("Wrote the timings to %s", cmd.inputs.Output))
+	}
+
+	ui.Print(logs...)
+
+	if len(regressed) > 0 {
+		return fmt.Errorf(
+			"step(s) %s regressed by more than %g%% compared to the baseline",
+			strings.Join(regressed, ", "),
+			cmd.inputs.Threshold,
+		)
+	}
+	return nil
+}
+
+func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Iterations < 1 {
+		return fmt.Errorf(`"--%s" must be at least 1`, flagIterations)
+	}
+	if i.Threshold < 0 {
+		return fmt.Errorf(`"--%s" must not be negative`, flagThreshold)
+	}
+
+	localPath := profile.WorkingDirectory
+	if i.LocalPath != "" {
+		path, err := homedir.Expand(i.LocalPath)
+		if err != nil {
+			return err
+		}
+		localPath = path
+	}
+
+	app, ok, err := local.FindApp(localPath)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("no local app found at %s", localPath)
+	}
+	i.LocalPath = app.RootDir
+	return nil
+}
+
+// step is a benchmarked step, which reports whether it applies to the app
+// with prepare before it is run
+type step struct {
+	name    string
+	prepare func() (bool, error)
+	run     func(i int) error
+}
+
+func (cmd *Command) steps(tmpDir string) []step {
+	rootDir := cmd.inputs.LocalPath
+
+	hosting := local.Hosting{RootDir: filepath.Join(rootDir, local.NameHosting)}
+
+	var dependencies local.Dependencies
+
+	return []step{
+		{
+			name:    stepAppLoad,
+			prepare: func() (bool, error) { return true, nil },
+			run: func(i int) error {
+				_, err := local.LoadApp(rootDir)
+				return err
+			},
+		},
+		{
+			name: stepHostingHash,
+			prepare: func() (bool, error) {
+				return exists(filepath.Join(rootDir, local.NameHosting, local.NameFiles))
+			},
+			run: func(i int) error {
+				// every run uses a new cache, so that each file is hashed
+				cachePath := filepath.Join(tmpDir, fmt.Sprintf("hosting-cache-%d.json", i))
+				_, err := hosting.Diffs(cachePath, benchAppID, nil)
+				return err
+			},
+		},
+		{
+			name: stepDependenciesPack,
+			prepare: func() (bool, error) {
+				archives, err := filepath.Glob(filepath.Join(rootDir, local.NameFunctions, "node_modules*"))
+				if err != nil || len(archives) == 0 {
+					return false, err
+				}
+				d, err := local.FindAppDependencies(rootDir)
+				if err != nil {
+					return false, err
+				}
+				dependencies = d
+				return true, nil
+			},
+			run: func(i int) error {
+				path, err := dependencies.PrepareUpload()
+				if err != nil {
+					return err
+				}
+				return os.RemoveAll(filepath.Dir(path))
+			},
+		},
+	}
+}
+
+func (s step) measure(iterations int) (result, bool, error) {
+	ok, err := s.prepare()
+	if err != nil || !ok {
+		return result{}, false, err
+	}
+
+	res := result{Name: s.name, Iterations: iterations}
+
+	var total time.Duration
+	for i := 0; i < iterations; i++ {
+		start := time.Now()
+		if err := s.run(i); err != nil {
+			return result{}, false, err
+		}
+		elapsed := time.Since(start)
+
+		if i == 0 || elapsed < res.Min {
+			res.Min = elapsed
+		}
+		if elapsed > res.Max {
+			res.Max = elapsed
+		}
+		total += elapsed
+	}
+	res.Mean = total / time.Duration(iterations)
+
+	return res, true, nil
+}
+
+func exists(path string) (bool, error) {
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func durationDisplay(d time.Duration) string {
+	return d.Round(time.Microsecond).String()
+}
+
+func readResults(path string) (map[string]result, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	var res results
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+	if len(res.Steps) == 0 {
+		return nil, errors.New("failed to read baseline: no steps found")
+	}
+
+	resultsByName := make(map[string]result, len(res.Steps))
+	for _, step := range res.Steps {
+		resultsByName[step.Name] = step
+	}
+	return resultsByName, nil
+}
+
+func writeResults(path string, res results) error {
+	data, err := json.MarshalIndent(res, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0666)
+}
@@ -0,0 +1,143 @@
+package bench
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestBenchHandler(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	rootDir := filepath.Join(tmpDir, "app")
+
+	_, err = local.GenerateAppFixture(rootDir, local.AppFixtureOptions{Functions: 2, Rules: 2, HostingFiles: 3})
+	assert.Nil(t, err)
+
+	t.Run("should print the timings of the steps which apply to the app", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		output := filepath.Join(tmpDir, "timings.json")
+
+		cmd := &Command{inputs{LocalPath: rootDir, Iterations: 2, Output: output}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+
+		lines := strings.Split(out.String(), "\n")
+		assert.Equal(t, fmt.Sprintf("Measured 2 step(s) of the app at %s", rootDir), lines[0])
+		assert.True(t, strings.HasPrefix(lines[3], "  app load      2         "), "expected app load row but got: %s", lines[3])
+		assert.True(t, strings.HasPrefix(lines[4], "  hosting hash  2         "), "expected hosting hash row but got: %s", lines[4])
+		assert.Equal(t, "Skipped dependencies pack as it does not apply to the app", lines[5])
+		assert.Equal(t, fmt.Sprintf("Wrote the timings to %s", output), lines[6])
+
+		data, err := ioutil.ReadFile(output)
+		assert.Nil(t, err)
+
+		var res results
+		assert.Nil(t, json.Unmarshal(data, &res))
+		assert.Equal(t, 2, len(res.Steps))
+		for _, step := range res.Steps {
+			assert.Equal(t, 2, step.Iterations)
+			assert.True(t, step.Min <= step.Mean && step.Mean <= step.Max, "expected min <= mean <= max but got: %v", step)
+		}
+	})
+
+	t.Run("should compare the timings with a baseline", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		baseline := filepath.Join(tmpDir, "baseline.json")
+		assert.Nil(t, writeResults(baseline, results{[]result{
+			{Name: stepAppLoad, Iterations: 1, Min: time.Hour, Mean: time.Hour, Max: time.Hour},
+		}}))
+
+		cmd := &Command{inputs{LocalPath: rootDir, Iterations: 1, Baseline: baseline, Threshold: 20}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+
+		lines := strings.Split(out.String(), "\n")
+		assert.True(t, strings.HasSuffix(strings.TrimSpace(lines[1]), "Baseline  Change"), "expected baseline headers but got: %s", lines[1])
+		assert.True(t, strings.Contains(lines[3], "1h0m0s    -"), "expected app load improvement but got: %s", lines[3])
+	})
+
+	t.Run("should return an error when a step regressed compared to the baseline", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		baseline := filepath.Join(tmpDir, "baseline.json")
+		assert.Nil(t, writeResults(baseline, results{[]result{
+			{Name: stepAppLoad, Iterations: 1, Min: time.Nanosecond, Mean: time.Nanosecond, Max: time.Nanosecond},
+			{Name: stepHostingHash, Iterations: 1, Min: time.Hour, Mean: time.Hour, Max: time.Hour},
+		}}))
+
+		cmd := &Command{inputs{LocalPath: rootDir, Iterations: 1, Baseline: baseline, Threshold: 20}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{})
+		assert.Equal(t, errors.New("step(s) app load regressed by more than 20% compared to the baseline"), err)
+	})
+
+	t.Run("should return an error when the baseline has no steps", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		baseline := filepath.Join(tmpDir, "empty.json")
+		assert.Nil(t, ioutil.WriteFile(baseline, []byte(`{"steps":[]}`), 0666))
+
+		cmd := &Command{inputs{LocalPath: rootDir, Iterations: 1, Baseline: baseline}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{})
+		assert.Equal(t, errors.New("failed to read baseline: no steps found"), err)
+	})
+}
+
+func TestBenchInputs(t *testing.T) {
+	t.Run("should resolve the local app from the working directory", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "bench_inputs")
+		defer teardown()
+
+		_, err := local.GenerateAppFixture(profile.WorkingDirectory, local.AppFixtureOptions{})
+		assert.Nil(t, err)
+
+		i := inputs{Iterations: 1}
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, profile.WorkingDirectory, i.LocalPath)
+	})
+
+	t.Run("should return an error when no local app is found", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "bench_inputs")
+		defer teardown()
+
+		i := inputs{Iterations: 1}
+		assert.Equal(t, fmt.Errorf("no local app found at %s", profile.WorkingDirectory), i.Resolve(profile, nil))
+	})
+
+	for _, tc := range []struct {
+		description string
+		inputs      inputs
+		expectedErr error
+	}{
+		{
+			description: "should return an error when iterations is less than 1",
+			expectedErr: errors.New(`"--iterations" must be at least 1`),
+		},
+		{
+			description: "should return an error when the threshold is negative",
+			inputs:      inputs{Iterations: 1, Threshold: -1},
+			expectedErr: errors.New(`"--threshold" must not be negative`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(mock.NewProfile(t), nil))
+		})
+	}
+}
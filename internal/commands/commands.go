@@ -3,15 +3,33 @@ package commands
 import (
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/commands/app"
+	"github.com/10gen/realm-cli/internal/commands/bench"
+	"github.com/10gen/realm-cli/internal/commands/config"
+	"github.com/10gen/realm-cli/internal/commands/data"
+	"github.com/10gen/realm-cli/internal/commands/dependencies"
+	"github.com/10gen/realm-cli/internal/commands/deploy"
+	"github.com/10gen/realm-cli/internal/commands/deployments"
+	"github.com/10gen/realm-cli/internal/commands/drafts"
+	"github.com/10gen/realm-cli/internal/commands/eval"
+	"github.com/10gen/realm-cli/internal/commands/fixture"
 	"github.com/10gen/realm-cli/internal/commands/function"
+	"github.com/10gen/realm-cli/internal/commands/hosting"
 	"github.com/10gen/realm-cli/internal/commands/login"
 	"github.com/10gen/realm-cli/internal/commands/logout"
 	"github.com/10gen/realm-cli/internal/commands/logs"
+	"github.com/10gen/realm-cli/internal/commands/origins"
+	"github.com/10gen/realm-cli/internal/commands/project"
 	"github.com/10gen/realm-cli/internal/commands/pull"
 	"github.com/10gen/realm-cli/internal/commands/push"
+	"github.com/10gen/realm-cli/internal/commands/rules"
 	"github.com/10gen/realm-cli/internal/commands/schema"
+	"github.com/10gen/realm-cli/internal/commands/search"
 	"github.com/10gen/realm-cli/internal/commands/secrets"
+	"github.com/10gen/realm-cli/internal/commands/sync"
+	"github.com/10gen/realm-cli/internal/commands/trigger"
 	"github.com/10gen/realm-cli/internal/commands/user"
+	"github.com/10gen/realm-cli/internal/commands/values"
+	"github.com/10gen/realm-cli/internal/commands/version"
 	"github.com/10gen/realm-cli/internal/commands/whoami"
 )
 
@@ -32,6 +50,21 @@ var (
 		CommandMeta: whoami.CommandMeta,
 	}
 
+	Version = cli.CommandDefinition{
+		Command:     &version.Command{},
+		CommandMeta: version.CommandMeta,
+	}
+
+	BenchInternal = cli.CommandDefinition{
+		Command:     &bench.Command{},
+		CommandMeta: bench.CommandMeta,
+	}
+
+	Eval = cli.CommandDefinition{
+		Command:     &eval.Command{},
+		CommandMeta: eval.CommandMeta,
+	}
+
 	Push = cli.CommandDefinition{
 		Command:     &push.Command{},
 		CommandMeta: push.CommandMeta,
@@ -42,6 +75,35 @@ var (
 		CommandMeta: pull.CommandMeta,
 	}
 
+	Deploy = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "deploy",
+			Description: "Manage how your Realm app is deployed",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				CommandMeta: cli.CommandMeta{
+					Use:         "github",
+					Description: "Manage the automatic deployment of your Realm app from GitHub",
+				},
+				SubCommands: []cli.CommandDefinition{
+					{
+						Command:     &deploy.CommandGitHubEnable{},
+						CommandMeta: deploy.CommandMetaGitHubEnable,
+					},
+					{
+						Command:     &deploy.CommandGitHubDisable{},
+						CommandMeta: deploy.CommandMetaGitHubDisable,
+					},
+					{
+						Command:     &deploy.CommandGitHubStatus{},
+						CommandMeta: deploy.CommandMetaGitHubStatus,
+					},
+				},
+			},
+		},
+	}
+
 	App = cli.CommandDefinition{
 		CommandMeta: cli.CommandMeta{
 			Use:         "apps",
@@ -65,6 +127,10 @@ var (
 				Command:     &app.CommandDelete{},
 				CommandMeta: app.CommandMetaDelete,
 			},
+			{
+				Command:     &app.CommandConfigure{},
+				CommandMeta: app.CommandMetaConfigure,
+			},
 			{
 				Command:     &app.CommandDiff{},
 				CommandMeta: app.CommandMetaDiff,
@@ -73,6 +139,98 @@ var (
 				Command:     &app.CommandDescribe{},
 				CommandMeta: app.CommandMetaDescribe,
 			},
+			{
+				Command:     &app.CommandValidate{},
+				CommandMeta: app.CommandMetaValidate,
+			},
+			{
+				Command:     &app.CommandWait{},
+				CommandMeta: app.CommandMetaWait,
+			},
+			{
+				CommandMeta: cli.CommandMeta{
+					Use:         "template",
+					Aliases:     []string{"templates"},
+					Description: "Manage reusable templates of your Realm apps",
+				},
+				SubCommands: []cli.CommandDefinition{
+					{
+						Command:     &app.CommandTemplatePackage{},
+						CommandMeta: app.CommandMetaTemplatePackage,
+					},
+				},
+			},
+			{
+				CommandMeta: cli.CommandMeta{
+					Use:         "deployments",
+					Aliases:     []string{"deployment"},
+					Description: "Manage the deployments of your Realm app",
+				},
+				SubCommands: []cli.CommandDefinition{
+					{
+						Command:     &deployments.CommandList{},
+						CommandMeta: deployments.CommandMetaList,
+					},
+					{
+						Command:     &deployments.CommandDescribe{},
+						CommandMeta: deployments.CommandMetaDescribe,
+					},
+					{
+						Command:     &deployments.CommandDiff{},
+						CommandMeta: deployments.CommandMetaDiff,
+					},
+					{
+						Command:     &deployments.CommandRollback{},
+						CommandMeta: deployments.CommandMetaRollback,
+					},
+				},
+			},
+			{
+				CommandMeta: cli.CommandMeta{
+					Use:         "origins",
+					Aliases:     []string{"origin"},
+					Description: "Manage the allowed request origins of your Realm app",
+				},
+				SubCommands: []cli.CommandDefinition{
+					{
+						Command:     &origins.CommandList{},
+						CommandMeta: origins.CommandMetaList,
+					},
+					{
+						Command:     &origins.CommandAdd{},
+						CommandMeta: origins.CommandMetaAdd,
+					},
+					{
+						Command:     &origins.CommandRemove{},
+						CommandMeta: origins.CommandMetaRemove,
+					},
+				},
+			},
+			{
+				CommandMeta: cli.CommandMeta{
+					Use:         "drafts",
+					Aliases:     []string{"draft"},
+					Description: "Manage the drafts of your Realm app",
+				},
+				SubCommands: []cli.CommandDefinition{
+					{
+						Command:     &drafts.CommandCreate{},
+						CommandMeta: drafts.CommandMetaCreate,
+					},
+					{
+						Command:     &drafts.CommandDiff{},
+						CommandMeta: drafts.CommandMetaDiff,
+					},
+					{
+						Command:     &drafts.CommandDiscard{},
+						CommandMeta: drafts.CommandMetaDiscard,
+					},
+					{
+						Command:     &drafts.CommandDeploy{},
+						CommandMeta: drafts.CommandMetaDeploy,
+					},
+				},
+			},
 		},
 	}
 
@@ -87,10 +245,22 @@ var (
 				Command:     &user.CommandCreate{},
 				CommandMeta: user.CommandMetaCreate,
 			},
+			{
+				Command:     &user.CommandImport{},
+				CommandMeta: user.CommandMetaImport,
+			},
+			{
+				Command:     &user.CommandExport{},
+				CommandMeta: user.CommandMetaExport,
+			},
 			{
 				Command:     &user.CommandList{},
 				CommandMeta: user.CommandMetaList,
 			},
+			{
+				Command:     &user.CommandStats{},
+				CommandMeta: user.CommandMetaStats,
+			},
 			{
 				Command:     &user.CommandDisable{},
 				CommandMeta: user.CommandMetaDisable,
@@ -103,10 +273,30 @@ var (
 				Command:     &user.CommandRevoke{},
 				CommandMeta: user.CommandMetaRevoke,
 			},
+			{
+				Command:     &user.CommandDevices{},
+				CommandMeta: user.CommandMetaDevices,
+			},
+			{
+				Command:     &user.CommandUpdate{},
+				CommandMeta: user.CommandMetaUpdate,
+			},
+			{
+				Command:     &user.CommandConfirm{},
+				CommandMeta: user.CommandMetaConfirm,
+			},
+			{
+				Command:     &user.CommandReject{},
+				CommandMeta: user.CommandMetaReject,
+			},
 			{
 				Command:     &user.CommandDelete{},
 				CommandMeta: user.CommandMetaDelete,
 			},
+			{
+				Command:     &user.CommandPurge{},
+				CommandMeta: user.CommandMetaPurge,
+			},
 		},
 	}
 
@@ -121,6 +311,14 @@ var (
 				Command:     &secrets.CommandCreate{},
 				CommandMeta: secrets.CommandMetaCreate,
 			},
+			{
+				Command:     &secrets.CommandImport{},
+				CommandMeta: secrets.CommandMetaImport,
+			},
+			{
+				Command:     &secrets.CommandSync{},
+				CommandMeta: secrets.CommandMetaSync,
+			},
 			{
 				Command:     &secrets.CommandList{},
 				CommandMeta: secrets.CommandMetaList,
@@ -129,6 +327,10 @@ var (
 				Command:     &secrets.CommandUpdate{},
 				CommandMeta: secrets.CommandMetaUpdate,
 			},
+			{
+				Command:     &secrets.CommandRotate{},
+				CommandMeta: secrets.CommandMetaRotate,
+			},
 			{
 				Command:     &secrets.CommandDelete{},
 				CommandMeta: secrets.CommandMetaDelete,
@@ -136,6 +338,180 @@ var (
 		},
 	}
 
+	Values = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "values",
+			Aliases:     []string{"value"},
+			Description: "Manage the Values of your Realm app",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &values.CommandCreate{},
+				CommandMeta: values.CommandMetaCreate,
+			},
+			{
+				Command:     &values.CommandList{},
+				CommandMeta: values.CommandMetaList,
+			},
+			{
+				Command:     &values.CommandUpdate{},
+				CommandMeta: values.CommandMetaUpdate,
+			},
+			{
+				Command:     &values.CommandDelete{},
+				CommandMeta: values.CommandMetaDelete,
+			},
+		},
+	}
+
+	Rules = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "rules",
+			Aliases:     []string{"rule"},
+			Description: "Manage the collection Rules of your Realm app's data sources",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &rules.CommandCreate{},
+				CommandMeta: rules.CommandMetaCreate,
+			},
+			{
+				Command:     &rules.CommandList{},
+				CommandMeta: rules.CommandMetaList,
+			},
+			{
+				Command:     &rules.CommandDelete{},
+				CommandMeta: rules.CommandMetaDelete,
+			},
+			{
+				Command:     &rules.CommandTest{},
+				CommandMeta: rules.CommandMetaTest,
+			},
+			{
+				CommandMeta: cli.CommandMeta{
+					Use:         "default",
+					Description: "Manage the default Rule of your Realm app's data sources",
+				},
+				SubCommands: []cli.CommandDefinition{
+					{
+						Command:     &rules.CommandDefaultGet{},
+						CommandMeta: rules.CommandMetaDefaultGet,
+					},
+					{
+						Command:     &rules.CommandDefaultSet{},
+						CommandMeta: rules.CommandMetaDefaultSet,
+					},
+				},
+			},
+		},
+	}
+
+	Sync = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "sync",
+			Description: "Manage Device Sync of your Realm app's data sources",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &sync.CommandEnable{},
+				CommandMeta: sync.CommandMetaEnable,
+			},
+			{
+				Command:     &sync.CommandPause{},
+				CommandMeta: sync.CommandMetaPause,
+			},
+			{
+				Command:     &sync.CommandTerminate{},
+				CommandMeta: sync.CommandMetaTerminate,
+			},
+			{
+				Command:     &sync.CommandReenable{},
+				CommandMeta: sync.CommandMetaReenable,
+			},
+			{
+				Command:     &sync.CommandProgress{},
+				CommandMeta: sync.CommandMetaProgress,
+			},
+			{
+				Command:     &sync.CommandClients{},
+				CommandMeta: sync.CommandMetaClients,
+			},
+			{
+				Command:     &sync.CommandClientReset{},
+				CommandMeta: sync.CommandMetaClientReset,
+			},
+		},
+	}
+
+	Data = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "data",
+			Description: "Access the data of your Realm app's linked data sources",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &data.CommandFind{},
+				CommandMeta: data.CommandMetaFind,
+			},
+			{
+				Command:     &data.CommandAggregate{},
+				CommandMeta: data.CommandMetaAggregate,
+			},
+			{
+				Command:     &data.CommandSeed{},
+				CommandMeta: data.CommandMetaSeed,
+			},
+		},
+	}
+
+	Dependencies = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "dependencies",
+			Aliases:     []string{"dependency"},
+			Description: "Manage the npm dependencies of your Realm app's functions",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &dependencies.CommandOutdated{},
+				CommandMeta: dependencies.CommandMetaOutdated,
+			},
+			{
+				Command:     &dependencies.CommandUpgrade{},
+				CommandMeta: dependencies.CommandMetaUpgrade,
+			},
+		},
+	}
+
+	Triggers = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "triggers",
+			Aliases:     []string{"trigger"},
+			Description: "Manage the Triggers of your Realm app",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &trigger.CommandList{},
+				CommandMeta: trigger.CommandMetaList,
+			},
+			{
+				Command:     &trigger.CommandEnable{},
+				CommandMeta: trigger.CommandMetaEnable,
+			},
+			{
+				Command:     &trigger.CommandDisable{},
+				CommandMeta: trigger.CommandMetaDisable,
+			},
+			{
+				Command:     &trigger.CommandResume{},
+				CommandMeta: trigger.CommandMetaResume,
+			},
+			{
+				Command:     &trigger.CommandRun{},
+				CommandMeta: trigger.CommandMetaRun,
+			},
+		},
+	}
+
 	Function = cli.CommandDefinition{
 		CommandMeta: cli.CommandMeta{
 			Use:         "function",
@@ -143,10 +519,30 @@ var (
 			Description: "Interact with the Functions of your Realm app",
 		},
 		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &function.CommandCreate{},
+				CommandMeta: function.CommandMetaCreate,
+			},
+			{
+				Command:     &function.CommandCoverage{},
+				CommandMeta: function.CommandMetaCoverage,
+			},
+			{
+				Command:     &function.CommandList{},
+				CommandMeta: function.CommandMetaList,
+			},
 			{
 				Command:     &function.CommandRun{},
 				CommandMeta: function.CommandMetaRun,
 			},
+			{
+				Command:     &function.CommandStats{},
+				CommandMeta: function.CommandMetaStats,
+			},
+			{
+				Command:     &function.CommandTest{},
+				CommandMeta: function.CommandMetaTest,
+			},
 		},
 	}
 
@@ -161,6 +557,18 @@ var (
 				Command:     &logs.CommandList{},
 				CommandMeta: logs.CommandMetaList,
 			},
+			{
+				Command:     &logs.CommandTail{},
+				CommandMeta: logs.CommandMetaTail,
+			},
+			{
+				Command:     &logs.CommandDownload{},
+				CommandMeta: logs.CommandMetaDownload,
+			},
+			{
+				Command:     &logs.CommandExport{},
+				CommandMeta: logs.CommandMetaExport,
+			},
 		},
 	}
 
@@ -175,6 +583,160 @@ var (
 				Command:     &schema.CommandDatamodels{},
 				CommandMeta: schema.CommandMetaDatamodels,
 			},
+			{
+				Command:     &schema.CommandGenerate{},
+				CommandMeta: schema.CommandMetaGenerate,
+			},
+			{
+				Command:     &schema.CommandHistory{},
+				CommandMeta: schema.CommandMetaHistory,
+			},
+			{
+				Command:     &schema.CommandRollback{},
+				CommandMeta: schema.CommandMetaRollback,
+			},
+			{
+				Command:     &schema.CommandValidate{},
+				CommandMeta: schema.CommandMetaValidate,
+			},
+			{
+				Command:     &schema.CommandValidateData{},
+				CommandMeta: schema.CommandMetaValidateData,
+			},
+		},
+	}
+
+	Search = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "search",
+			Description: "Manage the Atlas Search indexes used by your Realm app",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				CommandMeta: cli.CommandMeta{
+					Use:         "indexes",
+					Aliases:     []string{"index"},
+					Description: "Manage the Atlas Search indexes on a collection",
+				},
+				SubCommands: []cli.CommandDefinition{
+					{
+						Command:     &search.CommandIndexesList{},
+						CommandMeta: search.CommandMetaIndexesList,
+					},
+					{
+						Command:     &search.CommandIndexesCreate{},
+						CommandMeta: search.CommandMetaIndexesCreate,
+					},
+					{
+						Command:     &search.CommandIndexesDelete{},
+						CommandMeta: search.CommandMetaIndexesDelete,
+					},
+				},
+			},
+		},
+	}
+
+	Project = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "project",
+			Aliases:     []string{"projects"},
+			Description: "Interact with the Atlas project of your Realm apps",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &project.CommandAuditLog{},
+				CommandMeta: project.CommandMetaAuditLog,
+			},
+			{
+				CommandMeta: cli.CommandMeta{
+					Use:         "members",
+					Aliases:     []string{"member"},
+					Description: "Review access to the Atlas project of your Realm apps",
+				},
+				SubCommands: []cli.CommandDefinition{
+					{
+						Command:     &project.CommandMembersList{},
+						CommandMeta: project.CommandMetaMembersList,
+					},
+				},
+			},
+		},
+	}
+
+	Hosting = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "hosting",
+			Description: "Manage the Hosting files of your Realm app",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &hosting.CommandList{},
+				CommandMeta: hosting.CommandMetaList,
+			},
+			{
+				Command:     &hosting.CommandDownload{},
+				CommandMeta: hosting.CommandMetaDownload,
+			},
+			{
+				Command:     &hosting.CommandUpload{},
+				CommandMeta: hosting.CommandMetaUpload,
+			},
+			{
+				CommandMeta: cli.CommandMeta{
+					Use:         "attributes",
+					Aliases:     []string{"attrs"},
+					Description: "Manage the attributes of the hosting files of your Realm app",
+				},
+				SubCommands: []cli.CommandDefinition{
+					{
+						Command:     &hosting.CommandAttributesList{},
+						CommandMeta: hosting.CommandMetaAttributesList,
+					},
+					{
+						Command:     &hosting.CommandAttributesSet{},
+						CommandMeta: hosting.CommandMetaAttributesSet,
+					},
+					{
+						Command:     &hosting.CommandAttributesClear{},
+						CommandMeta: hosting.CommandMetaAttributesClear,
+					},
+				},
+			},
+		},
+	}
+
+	Testdata = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "testdata",
+			Description: "Generate test fixtures for developing the CLI",
+			Hidden:      true,
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &fixture.CommandGenerate{},
+				CommandMeta: fixture.CommandMetaGenerate,
+			},
+		},
+	}
+
+	Config = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "config",
+			Description: "Manage the default flag values and request headers of your CLI profile",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &config.CommandSet{},
+				CommandMeta: config.CommandMetaSet,
+			},
+			{
+				Command:     &config.CommandUnset{},
+				CommandMeta: config.CommandMetaUnset,
+			},
+			{
+				Command:     &config.CommandSetHeader{},
+				CommandMeta: config.CommandMetaSetHeader,
+			},
 		},
 	}
 )
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// CommandMetaSet is the command meta for the `config set` command
+var CommandMetaSet = cli.CommandMeta{
+	Use:         "set <key> <value>",
+	Display:     "config set",
+	Description: "Set the default value of a command's flag in your CLI profile",
+	HelpText: `Persists a default value for a command's flag in your CLI profile, which is
+used whenever the flag is not provided. Specify the key as the command's path
+and the flag name separated by "." followed by the value, for example:
+  config set push.include-package-json true
+  config set apps.deployments.list.label env=prod
+
+The key must name a command and one of its flags, and the value must be valid
+for the flag. Flags provided when running a command always override their
+defaults. Use "config unset" to remove a default.`,
+}
+
+// CommandSet is the `config set` command
+type CommandSet struct {
+	inputs setInputs
+	lookup cli.FlagLookup
+}
+
+type setInputs struct {
+	Key   string
+	Value string
+}
+
+// Args is the command args
+func (cmd *CommandSet) Args(args []string) error {
+	if len(args) != 2 {
+		return errors.New(`must specify a key and value, e.g. "config set push.include-package-json true"`)
+	}
+	cmd.inputs.Key, cmd.inputs.Value = args[0], args[1]
+	return nil
+}
+
+// SetFlagLookup sets the lookup of the CLI commands' flags
+func (cmd *CommandSet) SetFlagLookup(lookup cli.FlagLookup) {
+	cmd.lookup = lookup
+}
+
+// Handler is the command handler
+func (cmd *CommandSet) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	command, name, err := parseKey(cmd.inputs.Key)
+	if err != nil {
+		return err
+	}
+
+	if cmd.inputs.Value == "" {
+		return errors.New(`must specify a value, use "config unset" to remove a default`)
+	}
+
+	command, flag, err := cmd.lookup(strings.Split(command, "."), name)
+	if err != nil {
+		return err
+	}
+
+	// the command is not run, so setting its flag only checks the value is valid for it
+	if err := flag.Value.Set(cmd.inputs.Value); err != nil {
+		return fmt.Errorf("invalid value '%s' for '--%s': %w", cmd.inputs.Value, flag.Name, err)
+	}
+
+	profile.SetDefaultFlag(command, flag.Name, cmd.inputs.Value)
+	if err := profile.Save(); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Set the default value of '--%s' for '%s' to '%s'", flag.Name, displayCommand(command), cmd.inputs.Value))
+	return nil
+}
+
+// parseKey splits the key into the command path and flag name
+func parseKey(key string) (string, string, error) {
+	idx := strings.LastIndex(key, ".")
+	if idx <= 0 || idx == len(key)-1 {
+		return "", "", fmt.Errorf("invalid key '%s', keys must be of the form command.flag", key)
+	}
+	return key[:idx], strings.TrimPrefix(key[idx+1:], "--"), nil
+}
+
+func displayCommand(command string) string {
+	return strings.ReplaceAll(command, ".", " ")
+}
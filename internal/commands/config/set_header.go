@@ -0,0 +1,69 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// CommandMetaSetHeader is the command meta for the `config set-header` command
+var CommandMetaSetHeader = cli.CommandMeta{
+	Use:         "set-header <name> <value>",
+	Display:     "config set-header",
+	Description: "Set an HTTP header to send with every Realm request in your CLI profile",
+	HelpText: `Persists an HTTP header in your CLI profile which is sent with every request to
+the Realm admin API, such as the token required by an authenticating gateway
+your network traffic passes through, for example:
+  config set-header X-Gateway-Token abc123
+
+Headers provided with "--header" override those of your CLI profile. The
+headers the CLI sets itself, which are "Authorization", "Content-Type" and the
+"X-BAAS-" headers, cannot be set. Set an empty value (e.g. "") to remove a
+header.`,
+}
+
+// CommandSetHeader is the `config set-header` command
+type CommandSetHeader struct {
+	inputs setHeaderInputs
+}
+
+type setHeaderInputs struct {
+	Name  string
+	Value string
+}
+
+// Args is the command args
+func (cmd *CommandSetHeader) Args(args []string) error {
+	if len(args) != 2 {
+		return errors.New(`must specify a header name and value, e.g. "config set-header X-Gateway-Token abc123"`)
+	}
+	cmd.inputs.Name, cmd.inputs.Value = args[0], args[1]
+	return nil
+}
+
+// Handler is the command handler
+func (cmd *CommandSetHeader) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	if !user.ValidHeaderName(cmd.inputs.Name) {
+		return fmt.Errorf("invalid header name '%s'", cmd.inputs.Name)
+	}
+	name := http.CanonicalHeaderKey(cmd.inputs.Name)
+	if cmd.inputs.Value != "" && user.ReservedHeaderName(name) {
+		return fmt.Errorf("header '%s' is set by the CLI and cannot be set", name)
+	}
+
+	profile.SetHeader(name, cmd.inputs.Value)
+	if err := profile.Save(); err != nil {
+		return err
+	}
+
+	if cmd.inputs.Value == "" {
+		ui.Print(terminal.NewTextLog("Removed the header '%s'", name))
+		return nil
+	}
+	ui.Print(terminal.NewTextLog("Set the header '%s'", name))
+	return nil
+}
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestConfigSetHeaderArgs(t *testing.T) {
+	t.Run("should set the header name and value", func(t *testing.T) {
+		cmd := &CommandSetHeader{}
+
+		assert.Nil(t, cmd.Args([]string{"X-Gateway-Token", "abc123"}))
+		assert.Equal(t, setHeaderInputs{"X-Gateway-Token", "abc123"}, cmd.inputs)
+	})
+
+	t.Run("should error without a header name and value", func(t *testing.T) {
+		cmd := &CommandSetHeader{}
+
+		assert.Equal(t,
+			errors.New(`must specify a header name and value, e.g. "config set-header X-Gateway-Token abc123"`),
+			cmd.Args([]string{"X-Gateway-Token"}),
+		)
+	})
+}
+
+func TestConfigSetHeaderHandler(t *testing.T) {
+	t.Run("should set the header in the profile", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_header_test")
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandSetHeader{setHeaderInputs{"x-gateway-token", "abc123"}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Equal(t, "Set the header 'X-Gateway-Token'\n", out.String())
+		assert.Equal(t, map[string]string{"X-Gateway-Token": "abc123"}, profile.Headers())
+	})
+
+	t.Run("should remove the header from the profile with an empty value", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_header_test")
+		defer teardown()
+
+		profile.SetHeader("X-Gateway-Token", "abc123")
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandSetHeader{setHeaderInputs{"X-Gateway-Token", ""}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Equal(t, "Removed the header 'X-Gateway-Token'\n", out.String())
+		assert.Equal(t, map[string]string{}, profile.Headers())
+	})
+
+	t.Run("should return an error with an invalid header name", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_header_test")
+		defer teardown()
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandSetHeader{setHeaderInputs{"X Gateway", "abc123"}}
+
+		assert.Equal(t, errors.New("invalid header name 'X Gateway'"), cmd.Handler(profile, ui, cli.Clients{}))
+	})
+
+	t.Run("should return an error with a header set by the cli", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_header_test")
+		defer teardown()
+
+		_, ui := mock.NewUI()
+
+		for _, name := range []string{"authorization", "Content-Type", "X-BAAS-Trace-Id"} {
+			cmd := &CommandSetHeader{setHeaderInputs{name, "abc123"}}
+
+			assert.Equal(t, fmt.Errorf("header '%s' is set by the CLI and cannot be set", http.CanonicalHeaderKey(name)), cmd.Handler(profile, ui, cli.Clients{}))
+		}
+		assert.Equal(t, map[string]string{}, profile.Headers())
+	})
+
+	t.Run("should ignore the headers set by the cli which are saved in the profile", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_header_test")
+		defer teardown()
+
+		profile.SetHeader("Authorization", "Bearer token")
+		profile.SetHeader("X-Gateway-Token", "abc123")
+
+		assert.Equal(t, map[string]string{"X-Gateway-Token": "abc123"}, profile.Headers())
+	})
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+
+	"github.com/spf13/pflag"
+)
+
+func TestConfigSetArgs(t *testing.T) {
+	t.Run("should set the key and value", func(t *testing.T) {
+		cmd := &CommandSet{}
+
+		assert.Nil(t, cmd.Args([]string{"push.include-package-json", "true"}))
+		assert.Equal(t, setInputs{"push.include-package-json", "true"}, cmd.inputs)
+	})
+
+	t.Run("should error without a key and value", func(t *testing.T) {
+		cmd := &CommandSet{}
+
+		assert.Equal(t,
+			errors.New(`must specify a key and value, e.g. "config set push.include-package-json true"`),
+			cmd.Args([]string{"push.include-package-json"}),
+		)
+	})
+}
+
+func TestConfigSetHandler(t *testing.T) {
+	t.Run("should set the default flag in the profile", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_test")
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandSet{setInputs{"apps.deployments.list.label", "env=prod"}, newFlagLookup()}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Equal(t, "Set the default value of '--label' for 'apps deployments list' to 'env=prod'\n", out.String())
+		assert.Equal(t, map[string]string{"label": "env=prod"}, profile.DefaultFlags("apps.deployments.list"))
+	})
+
+	t.Run("should set the default flag for the full path of the command", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_test")
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandSet{setInputs{"app.deployments.list.label", "env=prod"}, newFlagLookup()}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Equal(t, "Set the default value of '--label' for 'apps deployments list' to 'env=prod'\n", out.String())
+		assert.Equal(t, map[string]string{"label": "env=prod"}, profile.DefaultFlags("apps.deployments.list"))
+	})
+
+	t.Run("should return an error with an empty value", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_test")
+		defer teardown()
+
+		cmd := &CommandSet{setInputs{"push.include-package-json", ""}, newFlagLookup()}
+
+		assert.Equal(t,
+			errors.New(`must specify a value, use "config unset" to remove a default`),
+			cmd.Handler(profile, nil, cli.Clients{}),
+		)
+	})
+
+	t.Run("should return an error with an unknown command or flag", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_test")
+		defer teardown()
+
+		for key, expectedErr := range map[string]error{
+			"pull.include-package-json": errors.New("'pull' is not a command"),
+			"push.unknown":              errors.New("'push' has no flag '--unknown'"),
+		} {
+			cmd := &CommandSet{setInputs{key, "true"}, newFlagLookup()}
+
+			assert.Equal(t, expectedErr, cmd.Handler(profile, nil, cli.Clients{}))
+		}
+		assert.Equal(t, map[string]string{}, profile.DefaultFlags("push"))
+	})
+
+	t.Run("should return an error with a value which is invalid for the flag", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_test")
+		defer teardown()
+
+		cmd := &CommandSet{setInputs{"push.include-package-json", "maybe"}, newFlagLookup()}
+
+		err := cmd.Handler(profile, nil, cli.Clients{})
+		assert.NotNil(t, err)
+		assert.True(t,
+			strings.HasPrefix(err.Error(), "invalid value 'maybe' for '--include-package-json'"),
+			"unexpected error: %s", err,
+		)
+		assert.Equal(t, map[string]string{}, profile.DefaultFlags("push"))
+	})
+
+	for _, key := range []string{"push", ".flag", "push."} {
+		t.Run("should error with an invalid key "+key, func(t *testing.T) {
+			profile, teardown := mock.NewProfileFromTmpDir(t, "config_set_test")
+			defer teardown()
+
+			cmd := &CommandSet{setInputs{key, "true"}, newFlagLookup()}
+
+			assert.Equal(t,
+				errors.New("invalid key '"+key+"', keys must be of the form command.flag"),
+				cmd.Handler(profile, nil, cli.Clients{}),
+			)
+		})
+	}
+}
+
+// newFlagLookup looks up the flags of the "push" and "apps deployments list" commands,
+// where "app" is an alias of "apps"
+func newFlagLookup() cli.FlagLookup {
+	push := pflag.NewFlagSet("push", pflag.ContinueOnError)
+	push.Bool("include-package-json", false, "")
+
+	list := pflag.NewFlagSet("list", pflag.ContinueOnError)
+	list.StringSlice("label", nil, "")
+
+	commands := map[string]*pflag.FlagSet{"push": push, "apps.deployments.list": list}
+
+	return func(path []string, name string) (string, *pflag.Flag, error) {
+		if len(path) > 0 && path[0] == "app" {
+			path = append([]string{"apps"}, path[1:]...)
+		}
+		command := strings.Join(path, ".")
+
+		fs, ok := commands[command]
+		if !ok {
+			return "", nil, fmt.Errorf("'%s' is not a command", strings.Join(path, " "))
+		}
+		flag := fs.Lookup(name)
+		if flag == nil {
+			return "", nil, fmt.Errorf("'%s' has no flag '--%s'", strings.Join(path, " "), name)
+		}
+		return command, flag, nil
+	}
+}
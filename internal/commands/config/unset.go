@@ -0,0 +1,72 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// CommandMetaUnset is the command meta for the `config unset` command
+var CommandMetaUnset = cli.CommandMeta{
+	Use:         "unset <key>",
+	Display:     "config unset",
+	Description: "Remove the default value of a command's flag from your CLI profile",
+	HelpText: `Removes a default value set with "config set" from your CLI profile. Specify
+the key as the command's path and the flag name separated by ".", for example:
+  config unset push.include-package-json
+
+Defaults of commands or flags which no longer exist can be removed as well.`,
+}
+
+// CommandUnset is the `config unset` command
+type CommandUnset struct {
+	inputs unsetInputs
+	lookup cli.FlagLookup
+}
+
+type unsetInputs struct {
+	Key string
+}
+
+// Args is the command args
+func (cmd *CommandUnset) Args(args []string) error {
+	if len(args) != 1 {
+		return errors.New(`must specify a key, e.g. "config unset push.include-package-json"`)
+	}
+	cmd.inputs.Key = args[0]
+	return nil
+}
+
+// SetFlagLookup sets the lookup of the CLI commands' flags
+func (cmd *CommandUnset) SetFlagLookup(lookup cli.FlagLookup) {
+	cmd.lookup = lookup
+}
+
+// Handler is the command handler
+func (cmd *CommandUnset) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	command, name, err := parseKey(cmd.inputs.Key)
+	if err != nil {
+		return err
+	}
+
+	// resolve any aliases used, while still allowing the removal of stale defaults
+	if path, flag, err := cmd.lookup(strings.Split(command, "."), name); err == nil {
+		command, name = path, flag.Name
+	}
+
+	if _, ok := profile.DefaultFlags(command)[name]; !ok {
+		return fmt.Errorf("no default value of '--%s' is set for '%s'", name, displayCommand(command))
+	}
+
+	profile.SetDefaultFlag(command, name, "")
+	if err := profile.Save(); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Removed the default value of '--%s' for '%s'", name, displayCommand(command)))
+	return nil
+}
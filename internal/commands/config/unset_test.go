@@ -0,0 +1,84 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestConfigUnsetArgs(t *testing.T) {
+	t.Run("should set the key", func(t *testing.T) {
+		cmd := &CommandUnset{}
+
+		assert.Nil(t, cmd.Args([]string{"push.include-package-json"}))
+		assert.Equal(t, unsetInputs{"push.include-package-json"}, cmd.inputs)
+	})
+
+	t.Run("should error without a key", func(t *testing.T) {
+		cmd := &CommandUnset{}
+
+		assert.Equal(t,
+			errors.New(`must specify a key, e.g. "config unset push.include-package-json"`),
+			cmd.Args(nil),
+		)
+	})
+}
+
+func TestConfigUnsetHandler(t *testing.T) {
+	t.Run("should remove the default flag from the profile", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_unset_test")
+		defer teardown()
+
+		profile.SetDefaultFlag("apps.deployments.list", "label", "env=prod")
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandUnset{unsetInputs{"app.deployments.list.label"}, newFlagLookup()}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Equal(t, "Removed the default value of '--label' for 'apps deployments list'\n", out.String())
+		assert.Equal(t, map[string]string{}, profile.DefaultFlags("apps.deployments.list"))
+	})
+
+	t.Run("should remove the default of a flag which no longer exists", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_unset_test")
+		defer teardown()
+
+		profile.SetDefaultFlag("push", "unknown", "true")
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandUnset{unsetInputs{"push.unknown"}, newFlagLookup()}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Equal(t, "Removed the default value of '--unknown' for 'push'\n", out.String())
+		assert.Equal(t, map[string]string{}, profile.DefaultFlags("push"))
+	})
+
+	t.Run("should return an error when no default is set", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_unset_test")
+		defer teardown()
+
+		cmd := &CommandUnset{unsetInputs{"push.include-package-json"}, newFlagLookup()}
+
+		assert.Equal(t,
+			errors.New("no default value of '--include-package-json' is set for 'push'"),
+			cmd.Handler(profile, nil, cli.Clients{}),
+		)
+	})
+
+	t.Run("should return an error with an invalid key", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "config_unset_test")
+		defer teardown()
+
+		cmd := &CommandUnset{unsetInputs{"push"}, newFlagLookup()}
+
+		assert.Equal(t,
+			errors.New("invalid key 'push', keys must be of the form command.flag"),
+			cmd.Handler(profile, nil, cli.Clients{}),
+		)
+	})
+}
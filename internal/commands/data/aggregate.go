@@ -0,0 +1,150 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	flagPipeline      = "pipeline"
+	flagPipelineUsage = "specify the aggregation pipeline to run, as an array of stages in Extended JSON"
+
+	// aggregateDocumentsSource is the function source which runs an aggregation pipeline on a collection
+	aggregateDocumentsSource = `exports = function(dataSource, database, collection, pipeline) {
+  return context.services.get(dataSource).db(database).collection(collection).aggregate(EJSON.parse(pipeline)).toArray();
+};`
+)
+
+// writeStages are the aggregation stages which write to a collection
+var writeStages = []string{"$out", "$merge"}
+
+// CommandMetaAggregate is the command meta for the `data aggregate` command
+var CommandMetaAggregate = cli.CommandMeta{
+	Use:         "aggregate",
+	Display:     "data aggregate",
+	Description: "Run an aggregation pipeline on a collection of a linked data source",
+	HelpText: `Runs the aggregation pipeline specified with "--pipeline" on the collection
+through your Realm app's linked data source, and prints the resulting documents
+as Extended JSON. At most "--limit" documents are returned. The command is
+read-only, so pipelines with an "$out" or "$merge" stage are rejected.
+
+Specify "--user" to run the pipeline as one of your app's users, which applies
+the data source's Rules and Schema the same way they apply to your client apps.`,
+}
+
+// CommandAggregate is the `data aggregate` command
+type CommandAggregate struct {
+	inputs aggregateInputs
+}
+
+type aggregateInputs struct {
+	cli.ProjectInputs
+	queryInputs
+	Pipeline string
+}
+
+// Flags is the command flags
+func (cmd *CommandAggregate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+	cmd.inputs.queryInputs.flags(fs)
+
+	fs.StringVar(&cmd.inputs.Pipeline, flagPipeline, "", flagPipelineUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandAggregate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandAggregate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	pipeline, err := parsePipeline(cmd.inputs.Pipeline)
+	if err != nil {
+		return err
+	}
+
+	limit, err := bson.MarshalExtJSON(bson.D{{"$limit", cmd.inputs.Limit}}, true, false)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(append(pipeline, limit))
+	if err != nil {
+		return err
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	result, err := execute(
+		ui,
+		clients.Realm,
+		app,
+		cmd.inputs.User,
+		aggregateDocumentsSource,
+		[]interface{}{cmd.inputs.DataSource, cmd.inputs.Database, cmd.inputs.Collection, string(data)},
+		fmt.Sprintf("Running the aggregation pipeline on collection '%s'...", cmd.inputs.namespace()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to run the aggregation pipeline on collection '%s': %s", cmd.inputs.namespace(), err)
+	}
+
+	cmd.inputs.printDocuments(ui, result)
+	return nil
+}
+
+func (i *aggregateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.queryInputs.validate(); err != nil {
+		return err
+	}
+	if i.Pipeline == "" {
+		return fmt.Errorf(`must specify an aggregation pipeline with "--%s"`, flagPipeline)
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// parsePipeline parses the Extended JSON pipeline into its stages as canonical Extended JSON,
+// rejecting any stage which writes to a collection
+func parsePipeline(value string) ([]json.RawMessage, error) {
+	if trimmed := strings.TrimSpace(value); !strings.HasPrefix(trimmed, "[") {
+		return nil, fmt.Errorf(`"--%s" must be an array of stages`, flagPipeline)
+	}
+
+	var stages []json.RawMessage
+	if err := json.Unmarshal([]byte(value), &stages); err != nil {
+		return nil, fmt.Errorf(`failed to parse "--%s": %s`, flagPipeline, err)
+	}
+
+	pipeline := make([]json.RawMessage, 0, len(stages))
+	for i, stage := range stages {
+		var document bson.D
+		if err := bson.UnmarshalExtJSON(stage, false, &document); err != nil {
+			return nil, fmt.Errorf(`failed to parse stage %d of "--%s": %s`, i+1, flagPipeline, err)
+		}
+
+		for _, elem := range document {
+			for _, writeStage := range writeStages {
+				if elem.Key == writeStage {
+					return nil, fmt.Errorf("cannot use a %s stage, as aggregate is read-only", writeStage)
+				}
+			}
+		}
+
+		data, err := bson.MarshalExtJSON(document, true, false)
+		if err != nil {
+			return nil, err
+		}
+		pipeline = append(pipeline, data)
+	}
+	return pipeline, nil
+}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDataAggregateInputs(t *testing.T) {
+	t.Run("should error without a pipeline", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		inputs := aggregateInputs{queryInputs: queryInputs{
+			collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"},
+			Limit:            defaultLimit,
+		}}
+
+		assert.Equal(t, errors.New(`must specify an aggregation pipeline with "--pipeline"`), inputs.Resolve(profile, ui))
+	})
+}
+
+func TestDataAggregateHandler(t *testing.T) {
+	t.Run("should run the pipeline with the limit and print the documents", func(t *testing.T) {
+		var capturedUserID, capturedSource, capturedEvalSource string
+		realmClient := newQueryRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			capturedUserID, capturedSource, capturedEvalSource = userID, source, evalSource
+			return realm.ExecutionResults{Result: []interface{}{
+				map[string]interface{}{"_id": "eggcorn", "count": map[string]interface{}{"$numberInt": "2"}},
+			}}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandAggregate{aggregateInputs{
+			queryInputs: queryInputs{
+				collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"},
+				Limit:            10,
+			},
+			Pipeline: `[{"$group": {"_id": "$name", "count": {"$sum": 1}}}]`,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Found 1 document(s) in collection 'app.items'
+[
+  {
+    "_id": "eggcorn",
+    "count": {
+      "$numberInt": "2"
+    }
+  }
+]
+`, out.String())
+
+		assert.Equal(t, "", capturedUserID)
+		assert.Equal(t, aggregateDocumentsSource, capturedSource)
+		assert.Equal(t,
+			`exports("mongodb-atlas","app","items","[{\"$group\":{\"_id\":\"$name\",\"count\":{\"$sum\":{\"$numberInt\":\"1\"}}}},{\"$limit\":{\"$numberInt\":\"10\"}}]")`,
+			capturedEvalSource,
+		)
+	})
+
+	for _, tc := range []struct {
+		description string
+		pipeline    string
+		err         error
+	}{
+		{
+			description: "should return an error when the pipeline writes to a collection",
+			pipeline:    `[{"$match": {}}, {"$out": "copy"}]`,
+			err:         errors.New("cannot use a $out stage, as aggregate is read-only"),
+		},
+		{
+			description: "should return an error when the pipeline is not an array",
+			pipeline:    `{"$match": {}}`,
+			err:         errors.New(`"--pipeline" must be an array of stages`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			cmd := &CommandAggregate{aggregateInputs{
+				queryInputs: queryInputs{
+					collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"},
+					Limit:            defaultLimit,
+				},
+				Pipeline: tc.pipeline,
+			}}
+
+			assert.Equal(t, tc.err, cmd.Handler(nil, ui, cli.Clients{}))
+		})
+	}
+}
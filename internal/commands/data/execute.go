@@ -0,0 +1,55 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// evalSource returns the source which calls the exported function with the args
+func evalSource(args ...interface{}) (string, error) {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("exports(%s)", bytes.Trim(data, "[]")), nil
+}
+
+// execute executes the function source as the user, or as the system user if none is specified,
+// while showing a spinner with the message, and returns the function's result
+func execute(ui terminal.UI, client realm.Client, app realm.App, userID, source string, args []interface{}, message string) (interface{}, error) {
+	evalSource, err := evalSource(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	s := ui.Spinner(message)
+
+	s.Start()
+	res, err := client.AppDebugExecuteFunctionSource(app.GroupID, app.ID, userID, source, evalSource)
+	s.Stop()
+
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		return nil, errors.New(res.ErrorMessage())
+	}
+	return res.Result, nil
+}
+
+// canonicalDocument parses the Extended JSON document and returns it as canonical Extended JSON,
+// which the function source parses back with its BSON types intact
+func canonicalDocument(data []byte) (json.RawMessage, error) {
+	var document bson.D
+	if err := bson.UnmarshalExtJSON(data, false, &document); err != nil {
+		return nil, err
+	}
+	return bson.MarshalExtJSON(document, true, false)
+}
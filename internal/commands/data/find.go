@@ -0,0 +1,133 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagFilter      = "filter"
+	flagFilterUsage = "specify the query filter of the documents to find, in Extended JSON"
+
+	flagProjection      = "projection"
+	flagProjectionUsage = "specify the projection of the documents to find, in Extended JSON"
+
+	flagSort      = "sort"
+	flagSortUsage = "specify the sort order of the documents to find, in Extended JSON"
+
+	// findDocumentsSource is the function source which finds the documents of a collection
+	findDocumentsSource = `exports = function(dataSource, database, collection, filter, projection, sort, limit) {
+  return context.services.get(dataSource).db(database).collection(collection)
+    .find(EJSON.parse(filter), EJSON.parse(projection))
+    .sort(EJSON.parse(sort))
+    .limit(limit)
+    .toArray();
+};`
+)
+
+// CommandMetaFind is the command meta for the `data find` command
+var CommandMetaFind = cli.CommandMeta{
+	Use:         "find",
+	Display:     "data find",
+	Description: "Find documents in a collection of a linked data source",
+	HelpText: `Finds the documents matching "--filter" in the collection through your Realm
+app's linked data source, and prints them as Extended JSON. At most "--limit"
+documents are returned.
+
+Specify "--user" to run the query as one of your app's users, which applies the
+data source's Rules and Schema the same way they apply to your client apps.`,
+}
+
+// CommandFind is the `data find` command
+type CommandFind struct {
+	inputs findInputs
+}
+
+type findInputs struct {
+	cli.ProjectInputs
+	queryInputs
+	QueryFilter string
+	Projection  string
+	Sort        string
+}
+
+// Flags is the command flags
+func (cmd *CommandFind) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+	cmd.inputs.queryInputs.flags(fs)
+
+	fs.StringVar(&cmd.inputs.QueryFilter, flagFilter, "", flagFilterUsage)
+	fs.StringVar(&cmd.inputs.Projection, flagProjection, "", flagProjectionUsage)
+	fs.StringVar(&cmd.inputs.Sort, flagSort, "", flagSortUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandFind) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandFind) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	args := []interface{}{cmd.inputs.DataSource, cmd.inputs.Database, cmd.inputs.Collection}
+	for _, flag := range []struct {
+		name  string
+		value string
+	}{
+		{flagFilter, cmd.inputs.QueryFilter},
+		{flagProjection, cmd.inputs.Projection},
+		{flagSort, cmd.inputs.Sort},
+	} {
+		document, err := parseDocumentFlag(flag.name, flag.value)
+		if err != nil {
+			return err
+		}
+		args = append(args, document)
+	}
+	args = append(args, cmd.inputs.Limit)
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	result, err := execute(
+		ui,
+		clients.Realm,
+		app,
+		cmd.inputs.User,
+		findDocumentsSource,
+		args,
+		fmt.Sprintf("Finding documents in collection '%s'...", cmd.inputs.namespace()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to find documents in collection '%s': %s", cmd.inputs.namespace(), err)
+	}
+
+	cmd.inputs.printDocuments(ui, result)
+	return nil
+}
+
+func (i *findInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.queryInputs.validate(); err != nil {
+		return err
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// parseDocumentFlag parses the Extended JSON document of the flag, which defaults to an empty document
+func parseDocumentFlag(flag, value string) (string, error) {
+	if value == "" {
+		return "{}", nil
+	}
+
+	document, err := canonicalDocument([]byte(value))
+	if err != nil {
+		return "", fmt.Errorf(`failed to parse "--%s": %s`, flag, err)
+	}
+	return string(document), nil
+}
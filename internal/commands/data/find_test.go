@@ -0,0 +1,141 @@
+package data
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func newQueryRealmClient(executeFn func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error)) mock.RealmClient {
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+	}
+	realmClient.AppDebugExecuteFunctionSourceFn = executeFn
+	return realmClient
+}
+
+func TestDataFindInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      findInputs
+		err         error
+	}{
+		{
+			description: "should error without a collection",
+			inputs:      findInputs{queryInputs: queryInputs{collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app"}, Limit: 1}},
+			err:         errors.New(`must specify a collection with "--collection"`),
+		},
+		{
+			description: "should error without a positive limit",
+			inputs:      findInputs{queryInputs: queryInputs{collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"}}},
+			err:         errors.New("limit must be a positive number"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.err, tc.inputs.Resolve(profile, ui))
+		})
+	}
+}
+
+func TestDataFindHandler(t *testing.T) {
+	t.Run("should find the documents as the user and print them", func(t *testing.T) {
+		var capturedUserID, capturedSource, capturedEvalSource string
+		realmClient := newQueryRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			capturedUserID, capturedSource, capturedEvalSource = userID, source, evalSource
+			return realm.ExecutionResults{Result: []interface{}{
+				map[string]interface{}{"_id": map[string]interface{}{"$oid": "5fd45718cface356de9d104d"}, "name": "eggcorn"},
+			}}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandFind{findInputs{
+			queryInputs: queryInputs{
+				collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"},
+				User:             "user1",
+				Limit:            5,
+			},
+			QueryFilter: `{"owner_id": {"$oid": "5fd45718cface356de9d1040"}}`,
+			Sort:        `{"name": 1}`,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Found 1 document(s) in collection 'app.items'
+[
+  {
+    "_id": {
+      "$oid": "5fd45718cface356de9d104d"
+    },
+    "name": "eggcorn"
+  }
+]
+`, out.String())
+
+		assert.Equal(t, "user1", capturedUserID)
+		assert.Equal(t, findDocumentsSource, capturedSource)
+		assert.Equal(t,
+			`exports("mongodb-atlas","app","items","{\"owner_id\":{\"$oid\":\"5fd45718cface356de9d1040\"}}","{}","{\"name\":{\"$numberInt\":\"1\"}}",5)`,
+			capturedEvalSource,
+		)
+	})
+
+	t.Run("should print a message when no documents are found", func(t *testing.T) {
+		realmClient := newQueryRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			return realm.ExecutionResults{Result: []interface{}{}}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandFind{findInputs{queryInputs: queryInputs{
+			collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"},
+			Limit:            defaultLimit,
+		}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No documents found in collection 'app.items'\n", out.String())
+	})
+
+	t.Run("should return an error when the filter is invalid", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandFind{findInputs{
+			queryInputs: queryInputs{
+				collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"},
+				Limit:            defaultLimit,
+			},
+			QueryFilter: `{"name": `,
+		}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{})
+		assert.NotNil(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), `failed to parse "--filter": `), "unexpected error: %s", err)
+	})
+
+	t.Run("should return an error when the query fails", func(t *testing.T) {
+		realmClient := newQueryRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			return realm.ExecutionResults{Error: map[string]interface{}{"message": "no rule exists for namespace 'app.items'"}}, nil
+		})
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandFind{findInputs{queryInputs: queryInputs{
+			collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"},
+			User:             "user1",
+			Limit:            defaultLimit,
+		}}}
+
+		assert.Equal(t,
+			errors.New("failed to find documents in collection 'app.items': no rule exists for namespace 'app.items'"),
+			cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}),
+		)
+	})
+}
@@ -0,0 +1,93 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// Flag names and usages across the data commands
+const (
+	flagDataSource      = "cluster"
+	flagDataSourceUsage = "specify the name of the linked data source to access"
+
+	flagDatabase      = "db"
+	flagDatabaseUsage = "specify the name of the database to access"
+
+	flagCollection      = "collection"
+	flagCollectionUsage = "specify the name of the collection to access"
+
+	flagUser      = "user"
+	flagUserUsage = "specify the id of a user to run the query as so the data source rules apply, otherwise the query runs as the system user"
+
+	flagLimit      = "limit"
+	flagLimitUsage = "specify the maximum number of documents to return"
+
+	defaultDataSource = "mongodb-atlas"
+	defaultLimit      = 20
+)
+
+type collectionInputs struct {
+	DataSource string
+	Database   string
+	Collection string
+}
+
+func (i collectionInputs) validate() error {
+	if i.DataSource == "" {
+		return fmt.Errorf(`must specify a data source with "--%s"`, flagDataSource)
+	}
+	if i.Database == "" {
+		return fmt.Errorf(`must specify a database with "--%s"`, flagDatabase)
+	}
+	if i.Collection == "" {
+		return fmt.Errorf(`must specify a collection with "--%s"`, flagCollection)
+	}
+	return nil
+}
+
+// namespace returns the namespace of the collection as "database.collection"
+func (i collectionInputs) namespace() string {
+	return i.Database + "." + i.Collection
+}
+
+type queryInputs struct {
+	collectionInputs
+	User  string
+	Limit int
+}
+
+func (i *queryInputs) flags(fs *pflag.FlagSet) {
+	fs.StringVar(&i.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+	fs.StringVar(&i.Database, flagDatabase, "", flagDatabaseUsage)
+	fs.StringVar(&i.Collection, flagCollection, "", flagCollectionUsage)
+	fs.StringVar(&i.User, flagUser, "", flagUserUsage)
+	fs.IntVar(&i.Limit, flagLimit, defaultLimit, flagLimitUsage)
+}
+
+func (i queryInputs) validate() error {
+	if err := i.collectionInputs.validate(); err != nil {
+		return err
+	}
+	if i.Limit <= 0 {
+		return errors.New("limit must be a positive number")
+	}
+	return nil
+}
+
+// printDocuments prints the documents a query found
+func (i queryInputs) printDocuments(ui terminal.UI, result interface{}) {
+	documents, ok := result.([]interface{})
+	if !ok && result != nil {
+		documents = []interface{}{result}
+	}
+
+	if len(documents) == 0 {
+		ui.Print(terminal.NewTextLog("No documents found in collection '%s'", i.namespace()))
+		return
+	}
+	ui.Print(terminal.NewJSONLog(fmt.Sprintf("Found %d document(s) in collection '%s'", len(documents), i.namespace()), documents))
+}
@@ -0,0 +1,174 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagFile      = "file"
+	flagFileUsage = "specify the path to a file of the documents to insert, in Extended JSON"
+
+	// seedBatchSize is the number of documents inserted per function execution
+	seedBatchSize = 500
+
+	// insertDocumentsSource is the function source which inserts documents into a collection
+	insertDocumentsSource = `exports = function(dataSource, database, collection, documents) {
+  return context.services.get(dataSource).db(database).collection(collection).insertMany(EJSON.parse(documents));
+};`
+)
+
+// CommandMetaSeed is the command meta for the `data seed` command
+var CommandMetaSeed = cli.CommandMeta{
+	Use:         "seed",
+	Display:     "data seed",
+	Description: "Insert documents into a collection of a linked data source",
+	HelpText: `Inserts the documents of the file specified with "--file" into the collection
+through your Realm app's linked data source. The file contains Extended JSON,
+either an array of documents or one document per line, so the same seed data
+can be used to set up each of your integration environments.
+
+The documents are inserted in batches of ` + fmt.Sprint(seedBatchSize) + `, so if a batch fails, the
+documents of the batches before it remain inserted.`,
+}
+
+// CommandSeed is the `data seed` command
+type CommandSeed struct {
+	inputs seedInputs
+}
+
+type seedInputs struct {
+	cli.ProjectInputs
+	collectionInputs
+	File string
+}
+
+// Flags is the command flags
+func (cmd *CommandSeed) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+	fs.StringVar(&cmd.inputs.Database, flagDatabase, "", flagDatabaseUsage)
+	fs.StringVar(&cmd.inputs.Collection, flagCollection, "", flagCollectionUsage)
+	fs.StringVar(&cmd.inputs.File, flagFile, "", flagFileUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandSeed) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandSeed) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	documents, err := readDocuments(cmd.inputs.File)
+	if err != nil {
+		return err
+	}
+
+	if len(documents) == 0 {
+		ui.Print(terminal.NewTextLog("No documents found to insert in %s", cmd.inputs.File))
+		return nil
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	var inserted int
+	for inserted < len(documents) {
+		end := inserted + seedBatchSize
+		if end > len(documents) {
+			end = len(documents)
+		}
+
+		batch, err := json.Marshal(documents[inserted:end])
+		if err != nil {
+			return err
+		}
+
+		if _, err := execute(
+			ui,
+			clients.Realm,
+			app,
+			"",
+			insertDocumentsSource,
+			[]interface{}{cmd.inputs.DataSource, cmd.inputs.Database, cmd.inputs.Collection, string(batch)},
+			fmt.Sprintf("Inserting documents into collection '%s' (%d/%d)...", cmd.inputs.namespace(), inserted, len(documents)),
+		); err != nil {
+			return fmt.Errorf("failed to insert documents into collection '%s' after inserting %d document(s): %s", cmd.inputs.namespace(), inserted, err)
+		}
+
+		inserted = end
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"Successfully inserted %d document(s) into collection '%s' of data source '%s'",
+		inserted,
+		cmd.inputs.namespace(),
+		cmd.inputs.DataSource,
+	))
+	return nil
+}
+
+func (i *seedInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.collectionInputs.validate(); err != nil {
+		return err
+	}
+	if i.File == "" {
+		return fmt.Errorf(`must specify a file of documents with "--%s"`, flagFile)
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// readDocuments reads the documents of a file of Extended JSON, which contains
+// either an array of documents or one document per line
+func readDocuments(path string) ([]json.RawMessage, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	dec := json.NewDecoder(file)
+
+	var raws []json.RawMessage
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse %s: %s", path, err)
+		}
+
+		if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+			var elems []json.RawMessage
+			if err := json.Unmarshal(trimmed, &elems); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %s", path, err)
+			}
+			raws = append(raws, elems...)
+			continue
+		}
+		raws = append(raws, raw)
+	}
+
+	documents := make([]json.RawMessage, 0, len(raws))
+	for i, raw := range raws {
+		document, err := canonicalDocument(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse document %d of %s: %s", i+1, path, err)
+		}
+		documents = append(documents, document)
+	}
+	return documents, nil
+}
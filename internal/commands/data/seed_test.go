@@ -0,0 +1,164 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDataSeedInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      seedInputs
+		err         error
+	}{
+		{
+			description: "should error without a data source",
+			inputs:      seedInputs{collectionInputs: collectionInputs{Database: "app", Collection: "items"}, File: "seed.ndjson"},
+			err:         errors.New(`must specify a data source with "--cluster"`),
+		},
+		{
+			description: "should error without a database",
+			inputs:      seedInputs{collectionInputs: collectionInputs{DataSource: defaultDataSource, Collection: "items"}, File: "seed.ndjson"},
+			err:         errors.New(`must specify a database with "--db"`),
+		},
+		{
+			description: "should error without a collection",
+			inputs:      seedInputs{collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app"}, File: "seed.ndjson"},
+			err:         errors.New(`must specify a collection with "--collection"`),
+		},
+		{
+			description: "should error without a file",
+			inputs:      seedInputs{collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"}},
+			err:         errors.New(`must specify a file of documents with "--file"`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.err, tc.inputs.Resolve(profile, ui))
+		})
+	}
+}
+
+func TestDataSeedHandler(t *testing.T) {
+	dir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	writeSeedFile := func(t *testing.T, name, contents string) string {
+		t.Helper()
+
+		path := filepath.Join(dir, name)
+		assert.Nil(t, local.WriteFile(path, 0666, strings.NewReader(contents)))
+		return path
+	}
+
+	newRealmClient := func(executeFn func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error)) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.AppDebugExecuteFunctionSourceFn = executeFn
+		return realmClient
+	}
+
+	newCommand := func(file string) *CommandSeed {
+		return &CommandSeed{seedInputs{
+			collectionInputs: collectionInputs{DataSource: defaultDataSource, Database: "app", Collection: "items"},
+			File:             file,
+		}}
+	}
+
+	t.Run("should insert the documents of the file through the data source", func(t *testing.T) {
+		file := writeSeedFile(t, "seed.ndjson", `{"_id": {"$oid": "5fd45718cface356de9d104d"}, "name": "eggcorn", "count": 1}
+{"_id": {"$oid": "5fd45718cface356de9d104e"}, "name": "acorn", "price": 2.5}
+`)
+
+		var capturedGroupID, capturedAppID, capturedSource string
+		var capturedEvalSources []string
+		realmClient := newRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			capturedGroupID, capturedAppID, capturedSource = groupID, appID, source
+			capturedEvalSources = append(capturedEvalSources, evalSource)
+			return realm.ExecutionResults{}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		assert.Nil(t, newCommand(file).Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully inserted 2 document(s) into collection 'app.items' of data source 'mongodb-atlas'\n", out.String())
+
+		assert.Equal(t, "groupID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+		assert.Equal(t, insertDocumentsSource, capturedSource)
+		assert.Equal(t, []string{
+			`exports("mongodb-atlas","app","items","[{\"_id\":{\"$oid\":\"5fd45718cface356de9d104d\"},\"name\":\"eggcorn\",\"count\":{\"$numberInt\":\"1\"}},{\"_id\":{\"$oid\":\"5fd45718cface356de9d104e\"},\"name\":\"acorn\",\"price\":{\"$numberDouble\":\"2.5\"}}]")`,
+		}, capturedEvalSources)
+	})
+
+	t.Run("should insert the documents in batches", func(t *testing.T) {
+		documents := make([]string, seedBatchSize+1)
+		for i := range documents {
+			documents[i] = fmt.Sprintf(`{"n": %d}`, i)
+		}
+		file := writeSeedFile(t, "seed.json", "["+strings.Join(documents, ",")+"]")
+
+		var batches int
+		realmClient := newRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			batches++
+			return realm.ExecutionResults{}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		assert.Nil(t, newCommand(file).Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, fmt.Sprintf("Successfully inserted %d document(s) into collection 'app.items' of data source 'mongodb-atlas'\n", seedBatchSize+1), out.String())
+		assert.Equal(t, 2, batches)
+	})
+
+	t.Run("should print a message when the file has no documents", func(t *testing.T) {
+		file := writeSeedFile(t, "empty.json", "[]")
+
+		out, ui := mock.NewUI()
+
+		assert.Nil(t, newCommand(file).Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, "No documents found to insert in "+file+"\n", out.String())
+	})
+
+	t.Run("should return an error when a document is invalid", func(t *testing.T) {
+		file := writeSeedFile(t, "invalid.ndjson", `{"name": "eggcorn"}
+{"_id": {"$oid": "eggcorn"}}
+`)
+
+		_, ui := mock.NewUI()
+
+		err := newCommand(file).Handler(nil, ui, cli.Clients{})
+		assert.NotNil(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), "failed to parse document 2 of "+file+": "), "unexpected error: %s", err)
+	})
+
+	t.Run("should return an error when the documents fail to be inserted", func(t *testing.T) {
+		file := writeSeedFile(t, "duplicate.ndjson", `{"_id": 1}`)
+
+		realmClient := newRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			return realm.ExecutionResults{Error: map[string]interface{}{"message": "E11000 duplicate key error"}}, nil
+		})
+
+		_, ui := mock.NewUI()
+
+		assert.Equal(t,
+			errors.New("failed to insert documents into collection 'app.items' after inserting 0 document(s): E11000 duplicate key error"),
+			newCommand(file).Handler(nil, ui, cli.Clients{Realm: realmClient}),
+		)
+	})
+}
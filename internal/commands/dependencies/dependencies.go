@@ -0,0 +1,156 @@
+package dependencies
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/npm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/blang/semver"
+)
+
+// registryClient is the client used to look up the published versions of dependencies
+var registryClient npm.Client = npm.NewClient(npm.DefaultRegistryURL)
+
+// set of semver impacts of an update
+const (
+	impactMajor = "major"
+	impactMinor = "minor"
+	impactPatch = "patch"
+)
+
+// rangePrefixes are the supported prefixes of a dependency's version range,
+// where ranges such as ">=1.0.0 <2.0.0" or "1.x" are not supported
+var rangePrefixes = []string{"^", "~", "="}
+
+type dependencyUpdate struct {
+	name        string
+	versionSpec string
+	prefix      string
+	current     semver.Version
+	latestMinor *semver.Version
+	latest      semver.Version
+}
+
+// target returns the version to update to, which is the latest version
+// of the current major version when restricted to it, if there is one
+func (d dependencyUpdate) target(latestMinor bool) (semver.Version, bool) {
+	if latestMinor {
+		if d.latestMinor == nil {
+			return semver.Version{}, false
+		}
+		return *d.latestMinor, true
+	}
+	return d.latest, d.latest.GT(d.current)
+}
+
+func (d dependencyUpdate) versionRange(version semver.Version) string {
+	return d.prefix + version.String()
+}
+
+// impact returns the semver impact of updating from the current version to the specified one
+func (d dependencyUpdate) impact(version semver.Version) string {
+	switch {
+	case version.Major != d.current.Major:
+		return impactMajor
+	case version.Minor != d.current.Minor:
+		return impactMinor
+	default:
+		return impactPatch
+	}
+}
+
+// checkDependencies looks up the package.json's dependencies in the npm registry
+// and returns the ones with a newer version, along with the names of the ones
+// whose version range is not supported
+func checkDependencies(ui terminal.UI, packageJSON local.PackageJSON) ([]dependencyUpdate, []string, error) {
+	names := make([]string, 0, len(packageJSON.Dependencies))
+	for name := range packageJSON.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	s := ui.Spinner("Checking dependencies against the npm registry...")
+
+	check := func() ([]dependencyUpdate, []string, error) {
+		s.Start()
+		defer s.Stop()
+
+		var updates []dependencyUpdate
+		var skipped []string
+		for _, name := range names {
+			prefix, current, ok := parseVersionRange(packageJSON.Dependencies[name])
+			if !ok {
+				skipped = append(skipped, name)
+				continue
+			}
+
+			pkg, err := registryClient.Package(name)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to check dependency '%s': %s", name, err)
+			}
+
+			update, ok := newDependencyUpdate(name, packageJSON.Dependencies[name], prefix, current, pkg)
+			if !ok {
+				continue
+			}
+			updates = append(updates, update)
+		}
+		return updates, skipped, nil
+	}
+
+	return check()
+}
+
+// newDependencyUpdate returns the update of the dependency, if there is a newer version
+func newDependencyUpdate(name, versionSpec, prefix string, current semver.Version, pkg npm.Package) (dependencyUpdate, bool) {
+	latest, err := semver.Parse(pkg.Latest)
+	if err != nil {
+		return dependencyUpdate{}, false
+	}
+
+	var latestMinor *semver.Version
+	for _, v := range pkg.Versions {
+		version, err := semver.Parse(v)
+		if err != nil || len(version.Pre) > 0 {
+			continue
+		}
+		if version.Major != current.Major || version.LTE(current) {
+			continue
+		}
+		if version.Major == latest.Major && version.GT(latest) {
+			continue // only consider versions which are published as the latest
+		}
+		if latestMinor == nil || version.GT(*latestMinor) {
+			latestMinor = &version
+		}
+	}
+
+	if latestMinor == nil && latest.LTE(current) {
+		return dependencyUpdate{}, false
+	}
+	return dependencyUpdate{name, versionSpec, prefix, current, latestMinor, latest}, true
+}
+
+// parseVersionRange parses the version range of a dependency into its prefix
+// and version, which is only supported for ranges of a single version
+func parseVersionRange(versionSpec string) (string, semver.Version, bool) {
+	spec := strings.TrimSpace(versionSpec)
+
+	var prefix string
+	for _, p := range rangePrefixes {
+		if strings.HasPrefix(spec, p) {
+			prefix = p
+			break
+		}
+	}
+
+	version, err := semver.Parse(strings.TrimPrefix(strings.TrimPrefix(spec, prefix), "v"))
+	if err != nil {
+		return "", semver.Version{}, false
+	}
+	return prefix, version, true
+}
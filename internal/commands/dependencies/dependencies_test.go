@@ -0,0 +1,118 @@
+package dependencies
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/npm"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"github.com/blang/semver"
+)
+
+const testPackageJSON = `{
+  "name": "functions",
+  "dependencies": {
+    "axios": "0.21.1",
+    "lodash": "^4.17.15",
+    "moment": "~2.29.1",
+    "realm-utils": "github:mongodb/realm-utils",
+    "uuid": "^8.3.2"
+  }
+}
+`
+
+type testRegistry map[string]npm.Package
+
+func (r testRegistry) Package(name string) (npm.Package, error) {
+	pkg, ok := r[name]
+	if !ok {
+		return npm.Package{}, errors.New("failed to get package " + name + ": unexpected status code 404")
+	}
+	return pkg, nil
+}
+
+var defaultTestRegistry = testRegistry{
+	"axios":  {Name: "axios", Latest: "0.21.4", Versions: []string{"0.21.1", "0.21.4"}},
+	"lodash": {Name: "lodash", Latest: "4.17.21", Versions: []string{"4.17.15", "4.17.20", "4.17.21"}},
+	"moment": {Name: "moment", Latest: "3.0.0", Versions: []string{"2.29.1", "2.29.4", "2.30.0-beta.1", "3.0.0"}},
+	"uuid":   {Name: "uuid", Latest: "8.3.2", Versions: []string{"8.3.1", "8.3.2"}},
+}
+
+// setupRegistry replaces the npm registry client with the test registry
+func setupRegistry(registry testRegistry) func() {
+	original := registryClient
+	registryClient = registry
+	return func() { registryClient = original }
+}
+
+// setupApp writes a local app whose functions have the test package.json
+func setupApp(t *testing.T) (string, func()) {
+	t.Helper()
+
+	dir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+
+	app := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+	assert.Nil(t, app.Write())
+	assert.Nil(t, local.WriteFile(filepath.Join(dir, local.NameFunctions, local.FilePackageJSON.String()), 0666, strings.NewReader(testPackageJSON)))
+	return dir, teardown
+}
+
+func TestParseVersionRange(t *testing.T) {
+	for _, tc := range []struct {
+		versionSpec string
+		prefix      string
+		version     string
+		ok          bool
+	}{
+		{versionSpec: "1.2.3", version: "1.2.3", ok: true},
+		{versionSpec: "^1.2.3", prefix: "^", version: "1.2.3", ok: true},
+		{versionSpec: "~1.2.3", prefix: "~", version: "1.2.3", ok: true},
+		{versionSpec: "v1.2.3", version: "1.2.3", ok: true},
+		{versionSpec: "1.x"},
+		{versionSpec: ">=1.0.0 <2.0.0"},
+		{versionSpec: "latest"},
+		{versionSpec: "github:mongodb/realm-utils"},
+	} {
+		t.Run("should parse "+tc.versionSpec, func(t *testing.T) {
+			prefix, version, ok := parseVersionRange(tc.versionSpec)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.prefix, prefix)
+			if tc.ok {
+				assert.Equal(t, tc.version, version.String())
+			}
+		})
+	}
+}
+
+func TestNewDependencyUpdate(t *testing.T) {
+	current := semver.MustParse("2.29.1")
+
+	t.Run("should find the latest minor version without prereleases and the latest version", func(t *testing.T) {
+		update, ok := newDependencyUpdate("moment", "~2.29.1", "~", current, defaultTestRegistry["moment"])
+		assert.True(t, ok, "expected an update to be found")
+		assert.Equal(t, "2.29.4", update.latestMinor.String())
+		assert.Equal(t, "3.0.0", update.latest.String())
+		assert.Equal(t, impactMajor, update.impact(update.latest))
+		assert.Equal(t, impactPatch, update.impact(*update.latestMinor))
+		assert.Equal(t, "~2.29.4", update.versionRange(*update.latestMinor))
+	})
+
+	t.Run("should not find an update for an up to date dependency", func(t *testing.T) {
+		_, ok := newDependencyUpdate("moment", "~2.29.1", "~", current, npm.Package{Latest: "2.29.1", Versions: []string{"2.29.1"}})
+		assert.False(t, ok, "expected no update to be found")
+	})
+
+	t.Run("should not find a latest minor version beyond the latest version", func(t *testing.T) {
+		update, ok := newDependencyUpdate("moment", "~2.29.1", "~", current, npm.Package{Latest: "2.29.2", Versions: []string{"2.29.1", "2.29.2", "2.29.3"}})
+		assert.True(t, ok, "expected an update to be found")
+		assert.Equal(t, "2.29.2", update.latestMinor.String())
+		assert.Equal(t, impactPatch, update.impact(update.latest))
+	})
+}
@@ -0,0 +1,61 @@
+package dependencies
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+const (
+	flagLocalPath      = "local"
+	flagLocalPathUsage = "the local path to the Realm app whose function dependencies to check"
+)
+
+type localInputs struct {
+	LocalPath string
+}
+
+func (i *localInputs) resolve(profile *user.Profile, ui terminal.UI) error {
+	searchPath := i.LocalPath
+	if searchPath == "" {
+		searchPath = profile.WorkingDirectory
+	}
+
+	app, err := local.LoadAppConfig(searchPath)
+	if err != nil {
+		return err
+	}
+
+	if i.LocalPath == "" && app.RootDir == "" {
+		if err := ui.AskOne(&i.LocalPath, &survey.Input{Message: "App filepath (local)"}); err != nil {
+			return err
+		}
+
+		app, err = local.LoadAppConfig(i.LocalPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if app.RootDir == "" {
+		return fmt.Errorf("no local app found at %s", i.LocalPath)
+	}
+	i.LocalPath = app.RootDir
+	return nil
+}
+
+// loadPackageJSON loads the package.json of the local app's functions
+func loadPackageJSON(rootDir string) (local.PackageJSON, error) {
+	packageJSON, ok, err := local.LoadPackageJSON(rootDir)
+	if err != nil {
+		return local.PackageJSON{}, err
+	}
+	if !ok {
+		return local.PackageJSON{}, fmt.Errorf("no %s found for the functions of the app at %s", local.FilePackageJSON, rootDir)
+	}
+	return packageJSON, nil
+}
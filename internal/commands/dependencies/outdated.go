@@ -0,0 +1,104 @@
+package dependencies
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	headerPackage     = "Package"
+	headerCurrent     = "Current"
+	headerLatestMinor = "Latest Minor"
+	headerLatest      = "Latest"
+	headerImpact      = "Impact"
+)
+
+// CommandMetaOutdated is the command meta for the `dependencies outdated` command
+var CommandMetaOutdated = cli.CommandMeta{
+	Use:         "outdated",
+	Display:     "dependencies outdated",
+	Description: "List the function dependencies with available updates",
+	HelpText: `Checks the dependencies of your local Realm app's functions/package.json against
+the npm registry, and lists the ones with a newer version available: the latest
+version within the current major version, the latest version overall and the
+semver impact of updating to it.
+
+Only dependencies whose version range is a single version, optionally prefixed
+with "^" or "~", are checked.`,
+}
+
+// CommandOutdated is the `dependencies outdated` command
+type CommandOutdated struct {
+	inputs outdatedInputs
+}
+
+type outdatedInputs struct {
+	localInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandOutdated) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPath, "", flagLocalPathUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandOutdated) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandOutdated) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	packageJSON, err := loadPackageJSON(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	updates, skipped, err := checkDependencies(ui, packageJSON)
+	if err != nil {
+		return err
+	}
+
+	printSkipped(ui, skipped)
+
+	if len(updates) == 0 {
+		ui.Print(terminal.NewTextLog("All dependencies in %s are up to date", packageJSON.Path))
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(updates))
+	for _, update := range updates {
+		latestMinor := "-"
+		if update.latestMinor != nil {
+			latestMinor = update.latestMinor.String()
+		}
+		rows = append(rows, map[string]interface{}{
+			headerPackage:     update.name,
+			headerCurrent:     update.versionSpec,
+			headerLatestMinor: latestMinor,
+			headerLatest:      update.latest.String(),
+			headerImpact:      update.impact(update.latest),
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d outdated dependency(ies) in %s", len(updates), packageJSON.Path),
+		[]string{headerPackage, headerCurrent, headerLatestMinor, headerLatest, headerImpact},
+		rows...,
+	))
+	return nil
+}
+
+func (i *outdatedInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.resolve(profile, ui)
+}
+
+func printSkipped(ui terminal.UI, skipped []string) {
+	for _, name := range skipped {
+		ui.Print(terminal.NewWarningLog("Skipped dependency '%s', as its version range is not supported", name))
+	}
+}
@@ -0,0 +1,102 @@
+package dependencies
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDependenciesOutdatedInputs(t *testing.T) {
+	t.Run("should resolve the local app from the working directory", func(t *testing.T) {
+		dir, teardown := setupApp(t)
+		defer teardown()
+
+		profile := mock.NewProfile(t)
+		profile.WorkingDirectory = dir
+		_, ui := mock.NewUI()
+
+		var inputs outdatedInputs
+		assert.Nil(t, inputs.Resolve(profile, ui))
+		assert.Equal(t, dir, inputs.LocalPath)
+	})
+}
+
+func TestDependenciesOutdatedHandler(t *testing.T) {
+	t.Run("should list the outdated dependencies", func(t *testing.T) {
+		dir, teardown := setupApp(t)
+		defer teardown()
+		defer setupRegistry(defaultTestRegistry)()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandOutdated{outdatedInputs{localInputs{dir}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+
+		path := filepath.Join(dir, local.NameFunctions, local.FilePackageJSON.String())
+		assert.Equal(t, `Warning: Skipped dependency 'realm-utils', as its version range is not supported
+Found 3 outdated dependency(ies) in `+path+`
+  Package  Current   Latest Minor  Latest   Impact
+  -------  --------  ------------  -------  ------
+  axios    0.21.1    0.21.4        0.21.4   patch 
+  lodash   ^4.17.15  4.17.21       4.17.21  patch 
+  moment   ~2.29.1   2.29.4        3.0.0    major 
+`, out.String())
+	})
+
+	t.Run("should print a message when all dependencies are up to date", func(t *testing.T) {
+		dir, teardown := setupApp(t)
+		defer teardown()
+		defer setupRegistry(testRegistry{
+			"axios":  {Latest: "0.21.1", Versions: []string{"0.21.1"}},
+			"lodash": {Latest: "4.17.15", Versions: []string{"4.17.15"}},
+			"moment": {Latest: "2.29.1", Versions: []string{"2.29.1"}},
+			"uuid":   {Latest: "8.3.2", Versions: []string{"8.3.2"}},
+		})()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandOutdated{outdatedInputs{localInputs{dir}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+
+		path := filepath.Join(dir, local.NameFunctions, local.FilePackageJSON.String())
+		assert.Equal(t, "Warning: Skipped dependency 'realm-utils', as its version range is not supported\nAll dependencies in "+path+" are up to date\n", out.String())
+	})
+
+	t.Run("should return an error when a dependency cannot be checked", func(t *testing.T) {
+		dir, teardown := setupApp(t)
+		defer teardown()
+		defer setupRegistry(testRegistry{})()
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandOutdated{outdatedInputs{localInputs{dir}}}
+
+		assert.Equal(t,
+			errors.New("failed to check dependency 'axios': failed to get package axios: unexpected status code 404"),
+			cmd.Handler(nil, ui, cli.Clients{}),
+		)
+	})
+
+	t.Run("should return an error when the functions have no package.json", func(t *testing.T) {
+		dir, teardown := setupApp(t)
+		defer teardown()
+
+		assert.Nil(t, os.Remove(filepath.Join(dir, local.NameFunctions, local.FilePackageJSON.String())))
+		_, ui := mock.NewUI()
+
+		cmd := &CommandOutdated{outdatedInputs{localInputs{dir}}}
+
+		assert.Equal(t,
+			errors.New("no package.json found for the functions of the app at "+dir),
+			cmd.Handler(nil, ui, cli.Clients{}),
+		)
+	})
+}
@@ -0,0 +1,121 @@
+package dependencies
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagLatestMinor      = "latest-minor"
+	flagLatestMinorUsage = "include to only upgrade to the latest version within each dependency's current major version"
+
+	headerFrom = "From"
+	headerTo   = "To"
+)
+
+// CommandMetaUpgrade is the command meta for the `dependencies upgrade` command
+var CommandMetaUpgrade = cli.CommandMeta{
+	Use:         "upgrade",
+	Display:     "dependencies upgrade",
+	Description: "Upgrade the function dependencies to their latest versions",
+	HelpText: `Checks the dependencies of your local Realm app's functions/package.json against
+the npm registry, and rewrites their version ranges to the latest versions. Use
+"--latest-minor" to avoid breaking changes, by only upgrading to the latest
+version within each dependency's current major version.
+
+Only the version ranges are rewritten, which keep their "^" or "~" prefix. Once
+upgraded, reinstall the dependencies and push them with your Realm app.`,
+}
+
+// CommandUpgrade is the `dependencies upgrade` command
+type CommandUpgrade struct {
+	inputs   upgradeInputs
+	upgraded int
+}
+
+type upgradeInputs struct {
+	localInputs
+	LatestMinor bool
+}
+
+// Flags is the command flags
+func (cmd *CommandUpgrade) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPath, "", flagLocalPathUsage)
+	fs.BoolVar(&cmd.inputs.LatestMinor, flagLatestMinor, false, flagLatestMinorUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandUpgrade) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandUpgrade) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	packageJSON, err := loadPackageJSON(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	updates, skipped, err := checkDependencies(ui, packageJSON)
+	if err != nil {
+		return err
+	}
+
+	printSkipped(ui, skipped)
+
+	var rows []map[string]interface{}
+	for _, update := range updates {
+		target, ok := update.target(cmd.inputs.LatestMinor)
+		if !ok {
+			continue
+		}
+
+		if err := packageJSON.SetDependency(update.name, update.versionRange(target)); err != nil {
+			return err
+		}
+
+		rows = append(rows, map[string]interface{}{
+			headerPackage: update.name,
+			headerFrom:    update.versionSpec,
+			headerTo:      update.versionRange(target),
+			headerImpact:  update.impact(target),
+		})
+	}
+
+	if len(rows) == 0 {
+		ui.Print(terminal.NewTextLog("No dependencies in %s to upgrade", packageJSON.Path))
+		return nil
+	}
+
+	if err := packageJSON.Write(); err != nil {
+		return err
+	}
+	cmd.upgraded = len(rows)
+
+	ui.Print(
+		terminal.NewTableLog(
+			fmt.Sprintf("Upgraded %d dependency(ies) in %s", len(rows), packageJSON.Path),
+			[]string{headerPackage, headerFrom, headerTo, headerImpact},
+			rows...,
+		),
+		terminal.NewFollowupLog(
+			"To deploy the upgraded dependencies, reinstall them and run",
+			fmt.Sprintf("%s push --local %s --include-dependencies", cli.Name, cmd.inputs.LocalPath),
+		),
+	)
+	return nil
+}
+
+// ResourcesAffected is the number of dependencies upgraded
+func (cmd *CommandUpgrade) ResourcesAffected() int {
+	return cmd.upgraded
+}
+
+func (i *upgradeInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.resolve(profile, ui)
+}
@@ -0,0 +1,96 @@
+package dependencies
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDependenciesUpgradeHandler(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		latestMinor bool
+		table       string
+		moment      string
+	}{
+		{
+			description: "should upgrade the dependencies to their latest versions",
+			table: `  Package  From      To        Impact
+  -------  --------  --------  ------
+  axios    0.21.1    0.21.4    patch 
+  lodash   ^4.17.15  ^4.17.21  patch 
+  moment   ~2.29.1   ~3.0.0    major 
+`,
+			moment: "~3.0.0",
+		},
+		{
+			description: "should upgrade the dependencies to their latest minor versions",
+			latestMinor: true,
+			table: `  Package  From      To        Impact
+  -------  --------  --------  ------
+  axios    0.21.1    0.21.4    patch 
+  lodash   ^4.17.15  ^4.17.21  patch 
+  moment   ~2.29.1   ~2.29.4   patch 
+`,
+			moment: "~2.29.4",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			dir, teardown := setupApp(t)
+			defer teardown()
+			defer setupRegistry(defaultTestRegistry)()
+
+			out, ui := mock.NewUI()
+
+			cmd := &CommandUpgrade{inputs: upgradeInputs{localInputs{dir}, tc.latestMinor}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+
+			path := filepath.Join(dir, local.NameFunctions, local.FilePackageJSON.String())
+			assert.Equal(t, `Warning: Skipped dependency 'realm-utils', as its version range is not supported
+Upgraded 3 dependency(ies) in `+path+`
+`+tc.table+`To deploy the upgraded dependencies, reinstall them and run: realm-cli push --local `+dir+` --include-dependencies
+`, out.String())
+
+			data, err := ioutil.ReadFile(path)
+			assert.Nil(t, err)
+			assert.Equal(t, strings.NewReplacer(
+				`"axios": "0.21.1"`, `"axios": "0.21.4"`,
+				`"lodash": "^4.17.15"`, `"lodash": "^4.17.21"`,
+				`"moment": "~2.29.1"`, `"moment": "`+tc.moment+`"`,
+			).Replace(testPackageJSON), string(data))
+			assert.Equal(t, 3, cmd.ResourcesAffected())
+		})
+	}
+
+	t.Run("should not rewrite the package.json when there is nothing to upgrade", func(t *testing.T) {
+		dir, teardown := setupApp(t)
+		defer teardown()
+		defer setupRegistry(testRegistry{
+			"axios":  {Latest: "0.21.1", Versions: []string{"0.21.1"}},
+			"lodash": {Latest: "4.17.15", Versions: []string{"4.17.15"}},
+			"moment": {Latest: "3.0.0", Versions: []string{"2.29.1", "3.0.0"}},
+			"uuid":   {Latest: "8.3.2", Versions: []string{"8.3.2"}},
+		})()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandUpgrade{inputs: upgradeInputs{localInputs{dir}, true}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+
+		path := filepath.Join(dir, local.NameFunctions, local.FilePackageJSON.String())
+		assert.Equal(t, "Warning: Skipped dependency 'realm-utils', as its version range is not supported\nNo dependencies in "+path+" to upgrade\n", out.String())
+
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		assert.Equal(t, testPackageJSON, string(data))
+		assert.Equal(t, 0, cmd.ResourcesAffected())
+	})
+}
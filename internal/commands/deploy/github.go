@@ -0,0 +1,91 @@
+package deploy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+const (
+	headerRepository = "Repository"
+	headerBranch     = "Branch"
+	headerDirectory  = "Directory"
+)
+
+// parseRepository splits the repository into its owner and name
+func parseRepository(repo string) (string, string, error) {
+	parts := strings.Split(repo, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errInvalidRepository{repo}
+	}
+	return parts[0], parts[1], nil
+}
+
+// isAuthorized reports whether any of the Realm GitHub app installations has access to the repository
+func isAuthorized(installations []realm.GitHubInstallation, repo string) bool {
+	owner, name, err := parseRepository(repo)
+	if err != nil {
+		return false
+	}
+
+	for _, installation := range installations {
+		if !strings.EqualFold(installation.Owner, owner) {
+			continue
+		}
+		if installation.AllRepos {
+			return true
+		}
+		for _, repository := range installation.Repositories {
+			if strings.EqualFold(repository, name) || strings.EqualFold(repository, repo) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newDeployConfigTable(message string, config realm.AutomaticDeploymentConfig) terminal.Log {
+	return terminal.NewTableLog(
+		message,
+		[]string{headerRepository, headerBranch, headerDirectory},
+		map[string]interface{}{
+			headerRepository: config.Repository,
+			headerBranch:     config.Branch,
+			headerDirectory:  displayDirectory(config.Directory),
+		},
+	)
+}
+
+func displayDirectory(dir string) string {
+	if dir == "" {
+		return "/"
+	}
+	return dir
+}
+
+type errInvalidRepository struct {
+	repo string
+}
+
+func (err errInvalidRepository) Error() string {
+	return fmt.Sprintf("invalid repository '%s', must be in the form owner/name", err.repo)
+}
+
+func (err errInvalidRepository) DisableUsage() struct{} { return struct{}{} }
+
+type errGitHubNotAuthorized struct {
+	repo string
+}
+
+func (err errGitHubNotAuthorized) Error() string {
+	return fmt.Sprintf("the Realm GitHub app is not authorized to access the repository %s, install it for the repository and try again", err.repo)
+}
+
+func (err errGitHubNotAuthorized) DisableUsage() struct{} { return struct{}{} }
+
+// ReferenceLinks returns the link to install the Realm GitHub app
+func (err errGitHubNotAuthorized) ReferenceLinks() []interface{} {
+	return []interface{}{realm.GitHubAppInstallURL}
+}
@@ -0,0 +1,59 @@
+package deploy
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaGitHubDisable is the command meta for the `deploy github disable` command
+var CommandMetaGitHubDisable = cli.CommandMeta{
+	Use:         "disable",
+	Display:     "deploy github disable",
+	Description: "Disable automatic deployment of your Realm app from GitHub",
+	HelpText: `Stops deploying your Realm app automatically when changes are pushed to its
+GitHub repository. The Realm GitHub app remains installed for the repository.`,
+}
+
+// CommandGitHubDisable is the `deploy github disable` command
+type CommandGitHubDisable struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandGitHubDisable) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandGitHubDisable) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandGitHubDisable) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	config, err := clients.Realm.DeployConfig(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	if !config.AutomaticDeployment.Enabled {
+		ui.Print(terminal.NewTextLog("Automatic deployment from GitHub is already disabled for app %s", app.ClientAppID))
+		return nil
+	}
+
+	config.AutomaticDeployment.Enabled = false
+	if err := clients.Realm.UpdateDeployConfig(app.GroupID, app.ID, config); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully disabled automatic deployment from GitHub for app %s", app.ClientAppID))
+	return nil
+}
@@ -0,0 +1,59 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeployGitHubDisableHandler(t *testing.T) {
+	for _, tc := range []struct {
+		description    string
+		config         realm.AutomaticDeploymentConfig
+		expectedOutput string
+		expectedUpdate []realm.DeployConfig
+	}{
+		{
+			description:    "should disable automatic deployment",
+			config:         realm.AutomaticDeploymentConfig{Enabled: true, Provider: realm.DeployProviderGitHub, Repository: "eggcorn/realm-app", Branch: "main"},
+			expectedOutput: "Successfully disabled automatic deployment from GitHub for app app-abcde\n",
+			expectedUpdate: []realm.DeployConfig{{AutomaticDeployment: realm.AutomaticDeploymentConfig{
+				Provider:   realm.DeployProviderGitHub,
+				Repository: "eggcorn/realm-app",
+				Branch:     "main",
+			}}},
+		},
+		{
+			description:    "should do nothing when automatic deployment is already disabled",
+			expectedOutput: "Automatic deployment from GitHub is already disabled for app app-abcde\n",
+			expectedUpdate: []realm.DeployConfig{},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+			}
+			realmClient.DeployConfigFn = func(groupID, appID string) (realm.DeployConfig, error) {
+				return realm.DeployConfig{AutomaticDeployment: tc.config}, nil
+			}
+
+			updates := []realm.DeployConfig{}
+			realmClient.UpdateDeployConfigFn = func(groupID, appID string, config realm.DeployConfig) error {
+				updates = append(updates, config)
+				return nil
+			}
+
+			cmd := &CommandGitHubDisable{}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+			assert.Equal(t, tc.expectedUpdate, updates)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package deploy
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagRepo      = "repo"
+	flagRepoUsage = "the GitHub repository to deploy the Realm app from, in the form owner/name"
+
+	flagBranch      = "branch"
+	flagBranchUsage = "the branch of the GitHub repository to deploy the Realm app from"
+
+	flagDirectory      = "directory"
+	flagDirectoryUsage = "the directory of the GitHub repository containing the Realm app, defaults to the repository root"
+
+	defaultBranch = "main"
+)
+
+// CommandMetaGitHubEnable is the command meta for the `deploy github enable` command
+var CommandMetaGitHubEnable = cli.CommandMeta{
+	Use:         "enable",
+	Display:     "deploy github enable",
+	Description: "Enable automatic deployment of your Realm app from GitHub",
+	HelpText: `Configures your Realm app to be deployed automatically whenever changes are
+pushed to a branch of a GitHub repository. Use "--directory" when the app is not
+found at the root of the repository.
+
+Realm must be authorized to access the repository by installing the Realm GitHub
+app for it. If it is not yet authorized, the command fails and prints the link
+to install it.`,
+}
+
+// CommandGitHubEnable is the `deploy github enable` command
+type CommandGitHubEnable struct {
+	inputs enableInputs
+}
+
+type enableInputs struct {
+	cli.ProjectInputs
+	Repo      string
+	Branch    string
+	Directory string
+}
+
+// Flags is the command flags
+func (cmd *CommandGitHubEnable) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.Repo, flagRepo, "", flagRepoUsage)
+	fs.StringVar(&cmd.inputs.Branch, flagBranch, defaultBranch, flagBranchUsage)
+	fs.StringVar(&cmd.inputs.Directory, flagDirectory, "", flagDirectoryUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandGitHubEnable) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandGitHubEnable) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	installations, err := clients.Realm.GitHubInstallations(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized(installations, cmd.inputs.Repo) {
+		return errGitHubNotAuthorized{cmd.inputs.Repo}
+	}
+
+	config, err := clients.Realm.DeployConfig(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	config.AutomaticDeployment = realm.AutomaticDeploymentConfig{
+		Enabled:    true,
+		Provider:   realm.DeployProviderGitHub,
+		Repository: cmd.inputs.Repo,
+		Branch:     cmd.inputs.Branch,
+		Directory:  cmd.inputs.Directory,
+	}
+
+	if err := clients.Realm.UpdateDeployConfig(app.GroupID, app.ID, config); err != nil {
+		return err
+	}
+
+	ui.Print(newDeployConfigTable(
+		"Successfully enabled automatic deployment from GitHub for app "+app.ClientAppID,
+		config.AutomaticDeployment,
+	))
+	return nil
+}
+
+func (i *enableInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	if i.Repo == "" {
+		if err := ui.AskOne(&i.Repo, &survey.Input{Message: "GitHub Repository (owner/name)"}); err != nil {
+			return err
+		}
+	}
+
+	if _, _, err := parseRepository(i.Repo); err != nil {
+		return err
+	}
+	return nil
+}
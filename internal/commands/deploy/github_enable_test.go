@@ -0,0 +1,81 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeployGitHubEnableHandler(t *testing.T) {
+	newRealmClient := func(installations []realm.GitHubInstallation) (mock.RealmClient, *[]realm.DeployConfig) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+		}
+		realmClient.GitHubInstallationsFn = func(groupID, appID string) ([]realm.GitHubInstallation, error) {
+			return installations, nil
+		}
+		realmClient.DeployConfigFn = func(groupID, appID string) (realm.DeployConfig, error) {
+			return realm.DeployConfig{UIDraftsDisabled: true}, nil
+		}
+
+		updates := []realm.DeployConfig{}
+		realmClient.UpdateDeployConfigFn = func(groupID, appID string, config realm.DeployConfig) error {
+			updates = append(updates, config)
+			return nil
+		}
+		return realmClient, &updates
+	}
+
+	t.Run("should enable automatic deployment from the repository", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, updates := newRealmClient([]realm.GitHubInstallation{{ID: "1", Owner: "eggcorn", AllRepos: true}})
+
+		cmd := &CommandGitHubEnable{enableInputs{Repo: "eggcorn/realm-app", Branch: "main", Directory: "app/"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Successfully enabled automatic deployment from GitHub for app app-abcde
+  Repository         Branch  Directory
+  -----------------  ------  ---------
+  eggcorn/realm-app  main    app/     
+`, out.String())
+		assert.Equal(t, []realm.DeployConfig{{
+			UIDraftsDisabled: true,
+			AutomaticDeployment: realm.AutomaticDeploymentConfig{
+				Enabled:    true,
+				Provider:   realm.DeployProviderGitHub,
+				Repository: "eggcorn/realm-app",
+				Branch:     "main",
+				Directory:  "app/",
+			},
+		}}, *updates)
+	})
+
+	t.Run("should return an error with the installation link when the github app is not authorized", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient, updates := newRealmClient([]realm.GitHubInstallation{{ID: "1", Owner: "eggcorn", Repositories: []string{"website"}}})
+
+		cmd := &CommandGitHubEnable{enableInputs{Repo: "eggcorn/realm-app", Branch: "main"}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errGitHubNotAuthorized{"eggcorn/realm-app"}, err)
+		assert.Equal(t, []interface{}{"https://github.com/apps/mongodb-realm/installations/new"}, errGitHubNotAuthorized{}.ReferenceLinks())
+		assert.Equal(t, []realm.DeployConfig{}, *updates)
+	})
+}
+
+func TestDeployGitHubEnableInputs(t *testing.T) {
+	t.Run("should return an error for an invalid repository", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		i := enableInputs{ProjectInputs: cli.ProjectInputs{App: "app-abcde"}, Repo: "realm-app"}
+
+		assert.Equal(t, errInvalidRepository{"realm-app"}, i.Resolve(profile, ui))
+	})
+}
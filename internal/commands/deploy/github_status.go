@@ -0,0 +1,80 @@
+package deploy
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaGitHubStatus is the command meta for the `deploy github status` command
+var CommandMetaGitHubStatus = cli.CommandMeta{
+	Use:         "status",
+	Display:     "deploy github status",
+	Description: "Show the automatic deployment of your Realm app from GitHub",
+	HelpText: `Displays whether your Realm app is deployed automatically from GitHub, and if so
+from which repository, branch and directory. A warning is printed when the Realm
+GitHub app is no longer authorized to access the repository.`,
+}
+
+// CommandGitHubStatus is the `deploy github status` command
+type CommandGitHubStatus struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandGitHubStatus) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandGitHubStatus) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandGitHubStatus) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	config, err := clients.Realm.DeployConfig(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	if !config.AutomaticDeployment.Enabled {
+		ui.Print(
+			terminal.NewTextLog("Automatic deployment from GitHub is disabled for app %s", app.ClientAppID),
+			terminal.NewFollowupLog(
+				"To enable automatic deployment from GitHub, run",
+				fmt.Sprintf("%s deploy github enable --app %s --%s owner/name", cli.Name, app.ClientAppID, flagRepo),
+			),
+		)
+		return nil
+	}
+
+	installations, err := clients.Realm.GitHubInstallations(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	logs := []terminal.Log{newDeployConfigTable(
+		"Automatic deployment from GitHub is enabled for app "+app.ClientAppID,
+		config.AutomaticDeployment,
+	)}
+	if !isAuthorized(installations, config.AutomaticDeployment.Repository) {
+		logs = append(logs,
+			terminal.NewWarningLog("The Realm GitHub app is not authorized to access the repository %s", config.AutomaticDeployment.Repository),
+			terminal.NewFollowupLog("To authorize it, install the Realm GitHub app for the repository at", realm.GitHubAppInstallURL),
+		)
+	}
+
+	ui.Print(logs...)
+	return nil
+}
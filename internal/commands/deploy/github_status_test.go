@@ -0,0 +1,69 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeployGitHubStatusHandler(t *testing.T) {
+	enabled := realm.AutomaticDeploymentConfig{Enabled: true, Provider: realm.DeployProviderGitHub, Repository: "eggcorn/realm-app", Branch: "main"}
+
+	for _, tc := range []struct {
+		description    string
+		config         realm.AutomaticDeploymentConfig
+		installations  []realm.GitHubInstallation
+		expectedOutput string
+	}{
+		{
+			description: "should print that automatic deployment is disabled",
+			expectedOutput: `Automatic deployment from GitHub is disabled for app app-abcde
+To enable automatic deployment from GitHub, run: realm-cli deploy github enable --app app-abcde --repo owner/name
+`,
+		},
+		{
+			description:   "should print the automatic deployment configuration",
+			config:        enabled,
+			installations: []realm.GitHubInstallation{{ID: "1", Owner: "eggcorn", AllRepos: true}},
+			expectedOutput: `Automatic deployment from GitHub is enabled for app app-abcde
+  Repository         Branch  Directory
+  -----------------  ------  ---------
+  eggcorn/realm-app  main    /        
+`,
+		},
+		{
+			description: "should warn when the github app is no longer authorized",
+			config:      enabled,
+			expectedOutput: `Automatic deployment from GitHub is enabled for app app-abcde
+  Repository         Branch  Directory
+  -----------------  ------  ---------
+  eggcorn/realm-app  main    /        
+Warning: The Realm GitHub app is not authorized to access the repository eggcorn/realm-app
+To authorize it, install the Realm GitHub app for the repository at: https://github.com/apps/mongodb-realm/installations/new
+`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+			}
+			realmClient.DeployConfigFn = func(groupID, appID string) (realm.DeployConfig, error) {
+				return realm.DeployConfig{AutomaticDeployment: tc.config}, nil
+			}
+			realmClient.GitHubInstallationsFn = func(groupID, appID string) ([]realm.GitHubInstallation, error) {
+				return tc.installations, nil
+			}
+
+			cmd := &CommandGitHubStatus{}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestParseRepository(t *testing.T) {
+	t.Run("should split the repository into its owner and name", func(t *testing.T) {
+		owner, name, err := parseRepository("eggcorn/realm-app")
+		assert.Nil(t, err)
+		assert.Equal(t, "eggcorn", owner)
+		assert.Equal(t, "realm-app", name)
+	})
+
+	for _, repo := range []string{"", "realm-app", "eggcorn/", "/realm-app", "eggcorn/realm/app"} {
+		t.Run("should return an error for repository '"+repo+"'", func(t *testing.T) {
+			_, _, err := parseRepository(repo)
+			assert.Equal(t, errInvalidRepository{repo}, err)
+		})
+	}
+}
+
+func TestIsAuthorized(t *testing.T) {
+	installations := []realm.GitHubInstallation{
+		{ID: "1", Owner: "eggcorn", Repositories: []string{"realm-app"}},
+		{ID: "2", Owner: "Acorn", AllRepos: true},
+	}
+
+	for _, tc := range []struct {
+		repo       string
+		authorized bool
+	}{
+		{repo: "eggcorn/realm-app", authorized: true},
+		{repo: "eggcorn/website"},
+		{repo: "acorn/website", authorized: true},
+		{repo: "chestnut/realm-app"},
+		{repo: "invalid"},
+	} {
+		t.Run("should report whether "+tc.repo+" is authorized", func(t *testing.T) {
+			assert.Equal(t, tc.authorized, isAuthorized(installations, tc.repo))
+		})
+	}
+}
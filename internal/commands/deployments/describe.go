@@ -0,0 +1,145 @@
+package deployments
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaDescribe is the command meta for the `apps deployments describe` command
+var CommandMetaDescribe = cli.CommandMeta{
+	Use:         "describe [id]",
+	Display:     "apps deployments describe",
+	Description: "Display the details of a deployment of your Realm app",
+	HelpText: `Displays the details of the deployment with the specified ID, including its
+status and any error it failed with, where it was deployed from, the user who
+deployed it and the draft it deployed. If no ID is specified, you will be
+prompted to select one of your Realm app's recent deployments.`,
+}
+
+// CommandDescribe is the `apps deployments describe` command
+type CommandDescribe struct {
+	inputs describeInputs
+}
+
+type describeInputs struct {
+	cli.ProjectInputs
+	ID string
+}
+
+// deploymentDescription is the displayed description of a deployment
+type deploymentDescription struct {
+	ID                 string                 `json:"id"`
+	Status             realm.DeploymentStatus `json:"status"`
+	StatusErrorMessage string                 `json:"statusErrorMessage,omitempty"`
+	Origin             realm.DeploymentOrigin `json:"origin,omitempty"`
+	UserID             string                 `json:"userId,omitempty"`
+	DeployedAt         string                 `json:"deployedAt,omitempty"`
+	DraftID            string                 `json:"draftId,omitempty"`
+	Commit             string                 `json:"commit,omitempty"`
+	Comment            string                 `json:"comment,omitempty"`
+	Labels             map[string]string      `json:"labels,omitempty"`
+}
+
+// Args is the command args
+func (cmd *CommandDescribe) Args(args []string) error {
+	if len(args) > 1 {
+		return errors.New("must specify at most one deployment id")
+	}
+	if len(args) == 1 {
+		cmd.inputs.ID = args[0]
+	}
+	return nil
+}
+
+// Flags is the command flags
+func (cmd *CommandDescribe) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDescribe) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDescribe) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	id, err := cmd.inputs.resolveDeployment(ui, clients.Realm, app)
+	if err != nil {
+		return err
+	}
+
+	deployment, err := clients.Realm.Deployment(app.GroupID, app.ID, id)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewJSONLog("Deployment", deploymentDescription{
+		ID:                 deployment.ID,
+		Status:             deployment.Status,
+		StatusErrorMessage: deployment.StatusErrorMessage,
+		Origin:             deployment.Origin,
+		UserID:             deployment.UserID,
+		DeployedAt:         displayDeployedAt(deployment.DeployedAt),
+		DraftID:            deployment.DraftID,
+		Commit:             deployment.Commit,
+		Comment:            deployment.Comment,
+		Labels:             deployment.Labels,
+	}))
+	return nil
+}
+
+func (i *describeInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// resolveDeployment returns the specified deployment id,
+// otherwise prompts to select one of the app's recent deployments
+func (i describeInputs) resolveDeployment(ui terminal.UI, client realm.Client, app realm.App) (string, error) {
+	if i.ID != "" {
+		return i.ID, nil
+	}
+
+	deployments, err := client.DeploymentHistory(app.GroupID, app.ID, realm.DeploymentHistoryOptions{Limit: defaultLimit})
+	if err != nil {
+		return "", err
+	}
+	if len(deployments) == 0 {
+		return "", errors.New("no deployments found to describe")
+	}
+
+	options := make([]string, 0, len(deployments))
+	idsByOption := map[string]string{}
+	for _, deployment := range deployments {
+		option := displayDeploymentOption(deployment)
+
+		options = append(options, option)
+		idsByOption[option] = deployment.ID
+	}
+
+	var selection string
+	if err := ui.AskOne(&selection, &survey.Select{Message: "Which deployment would you like to describe?", Options: options}); err != nil {
+		return "", err
+	}
+	return idsByOption[selection], nil
+}
+
+func displayDeploymentOption(deployment realm.AppDeployment) string {
+	option := fmt.Sprintf("%s (%s)", deployment.ID, deployment.Status)
+	if deployment.DeployedAt != 0 {
+		option += " deployed at " + displayDeployedAt(deployment.DeployedAt)
+	}
+	return option
+}
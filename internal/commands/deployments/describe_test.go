@@ -0,0 +1,137 @@
+package deployments
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeploymentsDescribeArgs(t *testing.T) {
+	t.Run("should set the deployment id", func(t *testing.T) {
+		cmd := &CommandDescribe{}
+
+		assert.Nil(t, cmd.Args([]string{"deployment1"}))
+		assert.Equal(t, "deployment1", cmd.inputs.ID)
+	})
+
+	t.Run("should return an error with more than one deployment id", func(t *testing.T) {
+		cmd := &CommandDescribe{}
+
+		assert.Equal(t, errors.New("must specify at most one deployment id"), cmd.Args([]string{"deployment1", "deployment2"}))
+	})
+}
+
+func TestDeploymentsDescribeHandler(t *testing.T) {
+	newRealmClient := func() mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID"}}, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should print the details of the deployment", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedGroupID, capturedAppID, capturedDeploymentID string
+		realmClient := newRealmClient()
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			capturedGroupID, capturedAppID, capturedDeploymentID = groupID, appID, deploymentID
+			return realm.AppDeployment{
+				ID:                 "deployment1",
+				DraftID:            "draft1",
+				UserID:             "user1",
+				DeployedAt:         1609459200,
+				Origin:             realm.DeploymentOriginGitHub,
+				Commit:             "4f2a1c9",
+				Status:             realm.DeploymentStatusFailed,
+				StatusErrorMessage: "function 'onLogin' failed to transpile",
+				Labels:             map[string]string{"release": "1.4.0"},
+			}, nil
+		}
+
+		cmd := &CommandDescribe{describeInputs{ID: "deployment1"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Deployment
+{
+  "id": "deployment1",
+  "status": "failed",
+  "statusErrorMessage": "function 'onLogin' failed to transpile",
+  "origin": "GitHub",
+  "userId": "user1",
+  "deployedAt": "2021-01-01T00:00:00Z",
+  "draftId": "draft1",
+  "commit": "4f2a1c9",
+  "labels": {
+    "release": "1.4.0"
+  }
+}
+`, out.String())
+
+		assert.Equal(t, "groupID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+		assert.Equal(t, "deployment1", capturedDeploymentID)
+	})
+
+	t.Run("should return an error when the deployment fails to be found", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newRealmClient()
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandDescribe{describeInputs{ID: "deployment1"}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
+
+func TestDeploymentsDescribeInputsResolveDeployment(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID"}
+
+	t.Run("should prompt for one of the recent deployments", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentHistoryFn = func(groupID, appID string, opts realm.DeploymentHistoryOptions) ([]realm.AppDeployment, error) {
+			return []realm.AppDeployment{
+				{ID: "deployment2", Status: realm.DeploymentStatusSuccessful, DeployedAt: 1609545600},
+				{ID: "deployment1", Status: realm.DeploymentStatusFailed},
+			}, nil
+		}
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Which deployment would you like to describe?")
+			console.SendLine("deployment1")
+			console.ExpectEOF()
+		}()
+
+		id, err := describeInputs{}.resolveDeployment(ui, realmClient, app)
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, "deployment1", id)
+	})
+
+	t.Run("should return an error when the app has no deployments", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentHistoryFn = func(groupID, appID string, opts realm.DeploymentHistoryOptions) ([]realm.AppDeployment, error) {
+			return nil, nil
+		}
+
+		_, err := describeInputs{}.resolveDeployment(nil, realmClient, app)
+		assert.Equal(t, errors.New("no deployments found to describe"), err)
+	})
+}
@@ -0,0 +1,116 @@
+package deployments
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaDiff is the command meta for the `apps deployments diff` command
+var CommandMetaDiff = cli.CommandMeta{
+	Use:         "diff <from-id> <to-id>",
+	Display:     "apps deployments diff",
+	Description: "Show the changes between two deployments of your Realm app",
+	HelpText: `Exports your Realm app as it was deployed by each of the specified deployments,
+then displays the app files added, removed and modified from the first to the
+second deployment. No local copy of the app is needed.`,
+}
+
+// CommandDiff is the `apps deployments diff` command
+type CommandDiff struct {
+	inputs diffInputs
+}
+
+type diffInputs struct {
+	cli.ProjectInputs
+	FromID string
+	ToID   string
+}
+
+// Args is the command args
+func (cmd *CommandDiff) Args(args []string) error {
+	if len(args) != 2 {
+		return errors.New("must specify the two deployment ids to compare")
+	}
+	cmd.inputs.FromID = args[0]
+	cmd.inputs.ToID = args[1]
+	return nil
+}
+
+// Flags is the command flags
+func (cmd *CommandDiff) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDiff) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	from, err := exportDeployment(clients.Realm, app, cmd.inputs.FromID)
+	if err != nil {
+		return err
+	}
+
+	to, err := exportDeployment(clients.Realm, app, cmd.inputs.ToID)
+	if err != nil {
+		return err
+	}
+
+	diffs, err := local.DiffApps(to, from)
+	if err != nil {
+		return err
+	}
+
+	if diffs.Size() == 0 {
+		ui.Print(terminal.NewTextLog("Deployments %s and %s have no differences", cmd.inputs.FromID, cmd.inputs.ToID))
+		return nil
+	}
+
+	changes := diffs.Strings()
+	items := make([]interface{}, 0, len(changes))
+	for _, change := range changes {
+		items = append(items, change)
+	}
+
+	ui.Print(terminal.NewListLog("Changes from deployment "+cmd.inputs.FromID+" to deployment "+cmd.inputs.ToID, items...))
+	return nil
+}
+
+func (i *diffInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// exportDeployment exports and loads the app as it was deployed by the deployment
+func exportDeployment(client realm.Client, app realm.App, deploymentID string) (local.App, error) {
+	dir, err := ioutil.TempDir("", "realm-cli-deployment-")
+	if err != nil {
+		return local.App{}, err
+	}
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	_, zipPkg, err := client.Export(app.GroupID, app.ID, realm.ExportRequest{DeploymentID: deploymentID})
+	if err != nil {
+		return local.App{}, err
+	}
+	if err := local.WriteZip(dir, zipPkg); err != nil {
+		return local.App{}, err
+	}
+
+	return local.LoadApp(dir)
+}
@@ -0,0 +1,134 @@
+package deployments
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeploymentsDiffHandler(t *testing.T) {
+	profile, teardown := mock.NewProfileFromTmpDir(t, "deployments_diff_test")
+	defer teardown()
+
+	writeApp := func(name string) string {
+		dir := filepath.Join(profile.WorkingDirectory, name)
+		assert.Nil(t, local.NewApp(dir, "app-abcde", "app", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.DefaultAppConfigVersion).Write())
+		return dir
+	}
+
+	fromDir := writeApp("from")
+	toDir := writeApp("to")
+	assert.Nil(t, ioutil.WriteFile(
+		filepath.Join(toDir, local.NameAuth, local.FileCustomUserData.String()),
+		[]byte(`{"enabled":true,"mongo_service_name":"mongodb-atlas"}`),
+		0666,
+	))
+	assert.Nil(t, os.Remove(filepath.Join(toDir, local.NameEnvironments, "qa.json")))
+
+	exportDirs := map[string]string{"deployment1": fromDir, "deployment2": toDir}
+
+	newRealmClient := func() (mock.RealmClient, *[]realm.ExportRequest) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+		}
+
+		exports := []realm.ExportRequest{}
+		realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+			exports = append(exports, req)
+			dir, ok := exportDirs[req.DeploymentID]
+			if !ok {
+				return "", nil, errors.New("deployment not found")
+			}
+			return "app_20210101", zipDir(t, dir), nil
+		}
+		return realmClient, &exports
+	}
+
+	t.Run("should print the changes between the deployments", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, exports := newRealmClient()
+
+		cmd := &CommandDiff{diffInputs{FromID: "deployment1", ToID: "deployment2"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Changes from deployment deployment1 to deployment deployment2
+  Removed app files
+    - environments/qa.json
+  Modified app files
+    * auth/custom_user_data.json
+`, out.String())
+		assert.Equal(t, []realm.ExportRequest{{DeploymentID: "deployment1"}, {DeploymentID: "deployment2"}}, *exports)
+	})
+
+	t.Run("should print that identical deployments have no differences", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, _ := newRealmClient()
+
+		cmd := &CommandDiff{diffInputs{FromID: "deployment1", ToID: "deployment1"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Deployments deployment1 and deployment1 have no differences\n", out.String())
+	})
+
+	t.Run("should return an error when a deployment fails to export", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient, _ := newRealmClient()
+
+		cmd := &CommandDiff{diffInputs{FromID: "deployment1", ToID: "deployment3"}}
+
+		assert.Equal(t, errors.New("deployment not found"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
+
+func TestDeploymentsDiffArgs(t *testing.T) {
+	t.Run("should return an error unless two deployment ids are specified", func(t *testing.T) {
+		cmd := &CommandDiff{}
+		assert.Equal(t, errors.New("must specify the two deployment ids to compare"), cmd.Args([]string{"deployment1"}))
+	})
+}
+
+func zipDir(t *testing.T, dir string) *zip.Reader {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	assert.Nil(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		f, err := w.Create(filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	}))
+	assert.Nil(t, w.Close())
+
+	zipPkg, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Nil(t, err)
+	return zipPkg
+}
@@ -0,0 +1,115 @@
+package deployments
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagLabel      = "label"
+	flagLabelUsage = "filter the deployments by label, in the form key=value"
+
+	flagLimit      = "limit"
+	flagLimitUsage = "specify the number of most recent deployments to show, or 0 to show every deployment"
+
+	defaultLimit = 20
+)
+
+// CommandMetaList is the command meta for the `apps deployments list` command
+var CommandMetaList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "apps deployments list",
+	Description: "List the deployments of your Realm app",
+	HelpText: `Displays the most recent deployments of your Realm app, starting with the latest.
+For each deployment, its ID, status, origin (CLI, UI or GitHub), the ID of the
+user who deployed it, the time it was deployed, and its comment and labels are
+shown. Use "--limit" to show more deployments, or "--limit 0" to show them all.
+
+Comments and labels are attached to a deployment with the "--comment" and
+"--label" flags of the push command. Use "--label key=value" to only show the
+recent deployments that have the label; when specified more than once,
+deployments must have each of the labels.`,
+}
+
+// CommandList is the `apps deployments list` command
+type CommandList struct {
+	inputs listInputs
+}
+
+type listInputs struct {
+	cli.ProjectInputs
+	Labels []string
+	Limit  int
+
+	labels map[string]string
+}
+
+// Flags is the command flags
+func (cmd *CommandList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+	fs.StringSliceVar(&cmd.inputs.Labels, flagLabel, []string{}, flagLabelUsage)
+	fs.IntVar(&cmd.inputs.Limit, flagLimit, defaultLimit, flagLimitUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	deployments, err := clients.Realm.DeploymentHistory(app.GroupID, app.ID, realm.DeploymentHistoryOptions{Limit: cmd.inputs.Limit})
+	if err != nil {
+		return err
+	}
+
+	filtered := make([]realm.AppDeployment, 0, len(deployments))
+	for _, deployment := range deployments {
+		if deployment.HasLabels(cmd.inputs.labels) {
+			filtered = append(filtered, deployment)
+		}
+	}
+
+	if len(filtered) == 0 {
+		ui.Print(terminal.NewTextLog("No available deployments to show"))
+		return nil
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d deployment(s)", len(filtered)),
+		tableHeaders(),
+		tableRows(filtered)...,
+	))
+	return nil
+}
+
+func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	if i.Limit < 0 {
+		return errors.New("limit cannot be negative")
+	}
+
+	labels, err := realm.ParseDeploymentLabels(i.Labels)
+	if err != nil {
+		return err
+	}
+	i.labels = labels
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package deployments
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeploymentsListHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	deployments := []realm.AppDeployment{
+		{
+			ID:         "deployment1",
+			Status:     realm.DeploymentStatusSuccessful,
+			Origin:     realm.DeploymentOriginCLI,
+			UserID:     "user1",
+			DeployedAt: 1609459200,
+			Comment:    "first release",
+			Labels:     map[string]string{"release": "1.3.0"},
+		},
+		{
+			ID:         "deployment2",
+			Status:     realm.DeploymentStatusFailed,
+			Origin:     realm.DeploymentOriginGitHub,
+			DeployedAt: 1609545600,
+			Comment:    "fix login",
+			Labels:     map[string]string{"release": "1.4.0", "team": "mobile"},
+		},
+		{
+			ID:     "deployment3",
+			Status: realm.DeploymentStatusSuccessful,
+		},
+	}
+
+	for _, tc := range []struct {
+		description    string
+		deployments    []realm.AppDeployment
+		labels         map[string]string
+		expectedOutput string
+	}{
+		{
+			description:    "should print a message when no deployments are found",
+			expectedOutput: "No available deployments to show\n",
+		},
+		{
+			description: "should list every deployment without a label filter",
+			deployments: deployments,
+			expectedOutput: strings.Join(
+				[]string{
+					"Found 3 deployment(s)",
+					"  ID           Status      Origin  User   Deployed              Comment        Labels                    ",
+					"  -----------  ----------  ------  -----  --------------------  -------------  --------------------------",
+					"  deployment1  successful  CLI     user1  2021-01-01T00:00:00Z  first release  release=1.3.0             ",
+					"  deployment2  failed      GitHub         2021-01-02T00:00:00Z  fix login      release=1.4.0, team=mobile",
+					"  deployment3  successful                                                                                ",
+					"",
+				},
+				"\n",
+			),
+		},
+		{
+			description: "should only list the deployments with each of the labels",
+			deployments: deployments,
+			labels:      map[string]string{"release": "1.4.0", "team": "mobile"},
+			expectedOutput: strings.Join(
+				[]string{
+					"Found 1 deployment(s)",
+					"  ID           Status  Origin  User  Deployed              Comment    Labels                    ",
+					"  -----------  ------  ------  ----  --------------------  ---------  --------------------------",
+					"  deployment2  failed  GitHub        2021-01-02T00:00:00Z  fix login  release=1.4.0, team=mobile",
+					"",
+				},
+				"\n",
+			),
+		},
+		{
+			description:    "should print a message when no deployments have the labels",
+			deployments:    deployments,
+			labels:         map[string]string{"release": "2.0.0"},
+			expectedOutput: "No available deployments to show\n",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			var capturedGroupID, capturedAppID string
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{app}, nil
+			}
+			var capturedOpts realm.DeploymentHistoryOptions
+			realmClient.DeploymentHistoryFn = func(groupID, appID string, opts realm.DeploymentHistoryOptions) ([]realm.AppDeployment, error) {
+				capturedGroupID = groupID
+				capturedAppID = appID
+				capturedOpts = opts
+				return tc.deployments, nil
+			}
+
+			cmd := &CommandList{listInputs{
+				ProjectInputs: cli.ProjectInputs{Project: "groupID", App: "appID"},
+				Limit:         defaultLimit,
+				labels:        tc.labels,
+			}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+
+			assert.Equal(t, "groupID", capturedGroupID)
+			assert.Equal(t, "appID", capturedAppID)
+			assert.Equal(t, realm.DeploymentHistoryOptions{Limit: defaultLimit}, capturedOpts)
+		})
+	}
+
+	t.Run("should return an error when the deployments fail to be found", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.DeploymentHistoryFn = func(groupID, appID string, opts realm.DeploymentHistoryOptions) ([]realm.AppDeployment, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandList{}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
+
+func TestDeploymentsListInputs(t *testing.T) {
+	t.Run("should parse the label filters", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := listInputs{ProjectInputs: cli.ProjectInputs{Project: "groupID", App: "appID"}, Labels: []string{"release=1.4.0"}}
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, map[string]string{"release": "1.4.0"}, i.labels)
+	})
+
+	t.Run("should return an error when a label filter is not a key=value pair", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := listInputs{ProjectInputs: cli.ProjectInputs{Project: "groupID", App: "appID"}, Labels: []string{"=1.4.0"}}
+		assert.Equal(t, errors.New("invalid label '=1.4.0', labels must be of the form key=value"), i.Resolve(profile, nil))
+	})
+	t.Run("should return an error when the limit is negative", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := listInputs{ProjectInputs: cli.ProjectInputs{Project: "groupID", App: "appID"}, Limit: -1}
+		assert.Equal(t, errors.New("limit cannot be negative"), i.Resolve(profile, nil))
+	})
+}
@@ -0,0 +1,55 @@
+package deployments
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+const (
+	headerID       = "ID"
+	headerStatus   = "Status"
+	headerOrigin   = "Origin"
+	headerUser     = "User"
+	headerDeployed = "Deployed"
+	headerComment  = "Comment"
+	headerLabels   = "Labels"
+)
+
+func tableHeaders() []string {
+	return []string{headerID, headerStatus, headerOrigin, headerUser, headerDeployed, headerComment, headerLabels}
+}
+
+func tableRows(deployments []realm.AppDeployment) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(deployments))
+	for _, deployment := range deployments {
+		rows = append(rows, map[string]interface{}{
+			headerID:       deployment.ID,
+			headerStatus:   deployment.Status,
+			headerOrigin:   deployment.Origin,
+			headerUser:     deployment.UserID,
+			headerDeployed: displayDeployedAt(deployment.DeployedAt),
+			headerComment:  deployment.Comment,
+			headerLabels:   displayLabels(deployment.Labels),
+		})
+	}
+	return rows
+}
+
+func displayDeployedAt(seconds int64) string {
+	if seconds == 0 {
+		return ""
+	}
+	return time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+}
+
+func displayLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
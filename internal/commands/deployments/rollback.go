@@ -0,0 +1,126 @@
+package deployments
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagTo      = "to"
+	flagToUsage = "specify the id of the successful deployment to roll back to, otherwise the previous successful deployment is used"
+)
+
+// CommandMetaRollback is the command meta for the `apps deployments rollback` command
+var CommandMetaRollback = cli.CommandMeta{
+	Use:         "rollback",
+	Display:     "apps deployments rollback",
+	Description: "Roll back your Realm app to a previous deployment",
+	HelpText: `Redeploys the configuration of a previous successful deployment of your Realm
+app, then waits for the new deployment to complete and reports its result. By
+default, the app is rolled back to the successful deployment before the latest
+successful one. Specify "--to" to roll back to a specific deployment instead.
+
+The rollback creates a new deployment, so it can itself be rolled back.`,
+}
+
+// CommandRollback is the `apps deployments rollback` command
+type CommandRollback struct {
+	inputs rollbackInputs
+}
+
+type rollbackInputs struct {
+	cli.ProjectInputs
+	To string
+}
+
+// Flags is the command flags
+func (cmd *CommandRollback) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.To, flagTo, "", flagToUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandRollback) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandRollback) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	target, err := cmd.inputs.resolveTarget(clients.Realm, app)
+	if err != nil {
+		return err
+	}
+
+	proceed, err := ui.Confirm("Are you sure you want to roll back app %s to deployment %s?", app.ClientAppID, displayDeploymentOption(target))
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	deployment, err := clients.Realm.RedeployDeployment(app.GroupID, app.ID, target.ID)
+	if err != nil {
+		return err
+	}
+
+	deployment, err = cli.WaitForDeployment(ui, clients.Realm, app.GroupID, app.ID, deployment, fmt.Sprintf("Rolling back to deployment %s...", target.ID))
+	if err != nil {
+		return err
+	}
+
+	if deployment.Status == realm.DeploymentStatusFailed {
+		return fmt.Errorf("failed to roll back to deployment '%s', as deployment '%s' failed: %s", target.ID, deployment.ID, deployment.StatusErrorMessage)
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully rolled back app %s to deployment %s with deployment %s", app.ClientAppID, target.ID, deployment.ID))
+	return nil
+}
+
+func (i *rollbackInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// resolveTarget finds the deployment to roll back to, which is either the specified one
+// or the successful deployment before the latest successful one
+func (i rollbackInputs) resolveTarget(client realm.Client, app realm.App) (realm.AppDeployment, error) {
+	if i.To != "" {
+		deployment, err := client.Deployment(app.GroupID, app.ID, i.To)
+		if err != nil {
+			return realm.AppDeployment{}, err
+		}
+		if deployment.Status != realm.DeploymentStatusSuccessful {
+			return realm.AppDeployment{}, fmt.Errorf("can only roll back to a successful deployment, but deployment '%s' is %s", deployment.ID, deployment.Status)
+		}
+		return deployment, nil
+	}
+
+	deployments, err := client.DeploymentHistory(app.GroupID, app.ID, realm.DeploymentHistoryOptions{Limit: defaultLimit})
+	if err != nil {
+		return realm.AppDeployment{}, err
+	}
+
+	var successful int
+	for _, deployment := range deployments {
+		if deployment.Status != realm.DeploymentStatusSuccessful {
+			continue
+		}
+		successful++
+		if successful == 2 {
+			return deployment, nil
+		}
+	}
+	return realm.AppDeployment{}, fmt.Errorf(`no previous successful deployment found in the %d most recent deployments, specify one to roll back to with "--%s"`, defaultLimit, flagTo)
+}
@@ -0,0 +1,147 @@
+package deployments
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeploymentsRollbackHandler(t *testing.T) {
+	originalPollInterval := cli.DeploymentPollInterval
+	cli.DeploymentPollInterval = time.Millisecond
+	defer func() { cli.DeploymentPollInterval = originalPollInterval }()
+
+	history := []realm.AppDeployment{
+		{ID: "deployment4", Status: realm.DeploymentStatusFailed},
+		{ID: "deployment3", Status: realm.DeploymentStatusSuccessful},
+		{ID: "deployment2", Status: realm.DeploymentStatusFailed},
+		{ID: "deployment1", Status: realm.DeploymentStatusSuccessful, DeployedAt: 1609459200},
+	}
+
+	newRealmClient := func(finalStatus realm.DeploymentStatus) (mock.RealmClient, *[]string) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+		}
+		realmClient.DeploymentHistoryFn = func(groupID, appID string, opts realm.DeploymentHistoryOptions) ([]realm.AppDeployment, error) {
+			return history, nil
+		}
+
+		redeployed := []string{}
+		realmClient.RedeployDeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			redeployed = append(redeployed, deploymentID)
+			return realm.AppDeployment{ID: "deployment5", Status: realm.DeploymentStatusCreated}, nil
+		}
+
+		var polls int
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			for _, deployment := range history {
+				if deployment.ID == deploymentID {
+					return deployment, nil
+				}
+			}
+			polls++
+			if polls < 2 {
+				return realm.AppDeployment{ID: deploymentID, Status: realm.DeploymentStatusPending}, nil
+			}
+			return realm.AppDeployment{ID: deploymentID, Status: finalStatus, StatusErrorMessage: "bad config"}, nil
+		}
+		return realmClient, &redeployed
+	}
+
+	t.Run("should roll back to the previous successful deployment and wait for it to complete", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, redeployed := newRealmClient(realm.DeploymentStatusSuccessful)
+
+		cmd := &CommandRollback{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Deployment status: pending\nSuccessfully rolled back app app-abcde to deployment deployment1 with deployment deployment5\n", out.String())
+		assert.Equal(t, []string{"deployment1"}, *redeployed)
+	})
+
+	t.Run("should roll back to the specified deployment", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, redeployed := newRealmClient(realm.DeploymentStatusSuccessful)
+
+		cmd := &CommandRollback{rollbackInputs{To: "deployment3"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"deployment3"}, *redeployed)
+	})
+
+	t.Run("should return an error when the rollback deployment fails", func(t *testing.T) {
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		realmClient, _ := newRealmClient(realm.DeploymentStatusFailed)
+
+		cmd := &CommandRollback{}
+
+		assert.Equal(t,
+			errors.New("failed to roll back to deployment 'deployment1', as deployment 'deployment5' failed: bad config"),
+			cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}),
+		)
+	})
+
+	t.Run("should not roll back when not confirmed", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		realmClient, redeployed := newRealmClient(realm.DeploymentStatusSuccessful)
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Are you sure you want to roll back app app-abcde to deployment deployment1 (successful) deployed at 2021-01-01T00:00:00Z?")
+			console.SendLine("n")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandRollback{}
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{}, *redeployed)
+	})
+}
+
+func TestDeploymentsRollbackInputsResolveTarget(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID"}
+
+	t.Run("should return an error when the specified deployment is not successful", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{ID: deploymentID, Status: realm.DeploymentStatusFailed}, nil
+		}
+
+		_, err := rollbackInputs{To: "deployment2"}.resolveTarget(realmClient, app)
+		assert.Equal(t, errors.New("can only roll back to a successful deployment, but deployment 'deployment2' is failed"), err)
+	})
+
+	t.Run("should return an error when there is no previous successful deployment", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentHistoryFn = func(groupID, appID string, opts realm.DeploymentHistoryOptions) ([]realm.AppDeployment, error) {
+			return []realm.AppDeployment{
+				{ID: "deployment2", Status: realm.DeploymentStatusSuccessful},
+				{ID: "deployment1", Status: realm.DeploymentStatusFailed},
+			}, nil
+		}
+
+		_, err := rollbackInputs{}.resolveTarget(realmClient, app)
+		assert.Equal(t, errors.New(`no previous successful deployment found in the 20 most recent deployments, specify one to roll back to with "--to"`), err)
+	})
+}
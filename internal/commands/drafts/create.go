@@ -0,0 +1,70 @@
+package drafts
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaCreate is the command meta for the `apps drafts create` command
+var CommandMetaCreate = cli.CommandMeta{
+	Use:         "create",
+	Display:     "apps drafts create",
+	Description: "Create a draft of your Realm app",
+	HelpText: `Creates a draft of your Realm app, which collects changes made to the app until
+the draft is deployed, rather than deploying each change on its own. Each user
+can have one draft per app, which is shared with the Realm UI.
+
+Add changes to the draft with "push --draft" or in the Realm UI, review them
+with "apps drafts diff", then deploy them together with "apps drafts deploy".`,
+}
+
+// CommandCreate is the `apps drafts create` command
+type CommandCreate struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandCreate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandCreate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	draft, err := clients.Realm.CreateDraft(app.GroupID, app.ID)
+	if err != nil {
+		if e, ok := err.(realm.ServerError); !ok || e.Code != realm.ErrCodeDraftAlreadyExists {
+			return err
+		}
+
+		existing, existingErr := findDraft(clients.Realm, app)
+		if existingErr != nil {
+			return err
+		}
+		return errDraftExists{app.ClientAppID, existing.ID}
+	}
+
+	ui.Print(
+		terminal.NewTextLog("Successfully created draft %s for app %s", draft.ID, app.ClientAppID),
+		terminal.NewFollowupLog(
+			"To add your local changes to the draft, run",
+			fmt.Sprintf("%s push --remote %s --draft", cli.Name, app.ClientAppID),
+		),
+	)
+	return nil
+}
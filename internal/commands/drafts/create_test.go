@@ -0,0 +1,72 @@
+package drafts
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDraftsCreateHandler(t *testing.T) {
+	newRealmClient := func(createErr error) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+		}
+		realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{ID: "draftID"}, createErr
+		}
+		realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{ID: "existingDraftID"}, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should create a draft", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandCreate{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient(nil)}))
+		assert.Equal(t, `Successfully created draft draftID for app app-abcde
+To add your local changes to the draft, run: realm-cli push --remote app-abcde --draft
+`, out.String())
+	})
+
+	t.Run("should return an error with the existing draft when one already exists", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandCreate{}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient(realm.ServerError{Code: realm.ErrCodeDraftAlreadyExists})})
+		assert.Equal(t, errDraftExists{"app-abcde", "existingDraftID"}, err)
+		assert.Equal(t, "draft existingDraftID already exists for app app-abcde", err.Error())
+	})
+
+	t.Run("should return an error when the draft fails to be created", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandCreate{}
+
+		assert.Equal(t,
+			errors.New("something bad happened"),
+			cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient(errors.New("something bad happened"))}),
+		)
+	})
+}
+
+func TestDraftsFindDraft(t *testing.T) {
+	t.Run("should suggest creating a draft when the app has none", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{}, realm.ErrDraftNotFound
+		}
+
+		_, err := findDraft(realmClient, realm.App{ClientAppID: "app-abcde"})
+		assert.Equal(t, errDraftNotFound{"app-abcde"}, err)
+		assert.Equal(t, []interface{}{"realm-cli apps drafts create", "realm-cli push --draft"}, errDraftNotFound{}.Suggestions())
+	})
+}
@@ -0,0 +1,131 @@
+package drafts
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagComment      = "comment"
+	flagCommentUsage = "attach a comment to the deployment of the draft"
+
+	flagLabel      = "label"
+	flagLabelUsage = "attach a label to the deployment of the draft, in the form key=value"
+
+	flagNoWait      = "no-wait"
+	flagNoWaitUsage = "include to deploy the draft without waiting for the deployment to complete"
+)
+
+// CommandMetaDeploy is the command meta for the `apps drafts deploy` command
+var CommandMetaDeploy = cli.CommandMeta{
+	Use:         "deploy",
+	Display:     "apps drafts deploy",
+	Description: "Deploy the draft of your Realm app",
+	HelpText: `Deploys the changes collected by the draft of your Realm app together, once you
+confirm them, and waits until the deployment succeeds or fails. Use "--no-wait"
+to return as soon as the deployment has started.
+
+Use "--comment" and "--label key=value" to annotate the deployment, so it can be
+found later with "apps deployments list --label".`,
+}
+
+// CommandDeploy is the `apps drafts deploy` command
+type CommandDeploy struct {
+	inputs deployInputs
+}
+
+type deployInputs struct {
+	inputs
+	Comment string
+	Labels  []string
+	NoWait  bool
+
+	labels map[string]string
+}
+
+// Flags is the command flags
+func (cmd *CommandDeploy) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.Comment, flagComment, "", flagCommentUsage)
+	fs.StringSliceVar(&cmd.inputs.Labels, flagLabel, []string{}, flagLabelUsage)
+	fs.BoolVar(&cmd.inputs.NoWait, flagNoWait, false, flagNoWaitUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDeploy) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDeploy) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	draft, err := findDraft(clients.Realm, app)
+	if err != nil {
+		return err
+	}
+
+	diff, err := clients.Realm.DiffDraft(app.GroupID, app.ID, draft.ID)
+	if err != nil {
+		return err
+	}
+
+	if !ui.AutoConfirm() {
+		ui.Print(diffLogs(draft.ID, diff)...)
+	}
+
+	proceed, err := ui.Confirm("Are you sure you want to deploy draft %s?", draft.ID)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	deployment, err := clients.Realm.DeployDraft(app.GroupID, app.ID, draft.ID, realm.DeployDraftOptions{
+		Comment: cmd.inputs.Comment,
+		Labels:  cmd.inputs.labels,
+	})
+	if err != nil {
+		return err
+	}
+
+	if cmd.inputs.NoWait {
+		ui.Print(terminal.NewTextLog("Deployment started: %s", deployment.ID))
+		return nil
+	}
+
+	deployment, err = cli.WaitForDeployment(ui, clients.Realm, app.GroupID, app.ID, deployment, "Deploying draft...")
+	if err != nil {
+		return err
+	}
+
+	if deployment.Status == realm.DeploymentStatusFailed {
+		return errDeploymentFailed{deployment.ID, deployment.StatusErrorMessage}
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully deployed draft %s with deployment %s", draft.ID, deployment.ID))
+	return nil
+}
+
+func (i *deployInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.inputs.Resolve(profile, ui); err != nil {
+		return err
+	}
+
+	labels, err := realm.ParseDeploymentLabels(i.Labels)
+	if err != nil {
+		return err
+	}
+	i.labels = labels
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package drafts
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDraftsDeployHandler(t *testing.T) {
+	originalPollInterval := cli.DeploymentPollInterval
+	cli.DeploymentPollInterval = time.Millisecond
+	defer func() { cli.DeploymentPollInterval = originalPollInterval }()
+
+	newRealmClient := func(finalStatus realm.DeploymentStatus) (mock.RealmClient, *realm.DeployDraftOptions) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+		}
+		realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{ID: "draftID"}, nil
+		}
+		realmClient.DiffDraftFn = func(groupID, appID, draftID string) (realm.AppDraftDiff, error) {
+			return realm.AppDraftDiff{Diffs: []string{"diff1"}}, nil
+		}
+
+		var capturedOptions realm.DeployDraftOptions
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
+			capturedOptions = opts
+			return realm.AppDeployment{ID: "deploymentID", Status: realm.DeploymentStatusCreated}, nil
+		}
+
+		var polls int
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			polls++
+			if polls < 2 {
+				return realm.AppDeployment{ID: deploymentID, Status: realm.DeploymentStatusPending}, nil
+			}
+			return realm.AppDeployment{ID: deploymentID, Status: finalStatus, StatusErrorMessage: "bad config"}, nil
+		}
+		return realmClient, &capturedOptions
+	}
+
+	t.Run("should deploy the draft and wait for the deployment to complete", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, capturedOptions := newRealmClient(realm.DeploymentStatusSuccessful)
+
+		cmd := &CommandDeploy{deployInputs{
+			Comment: "fix the login flow",
+			labels:  map[string]string{"release": "1.4.0"},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Deployment status: pending\nSuccessfully deployed draft draftID with deployment deploymentID\n", out.String())
+		assert.Equal(t, realm.DeployDraftOptions{
+			Comment: "fix the login flow",
+			Labels:  map[string]string{"release": "1.4.0"},
+		}, *capturedOptions)
+	})
+
+	t.Run("should deploy the draft without waiting", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, _ := newRealmClient(realm.DeploymentStatusSuccessful)
+
+		cmd := &CommandDeploy{deployInputs{NoWait: true}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Deployment started: deploymentID\n", out.String())
+	})
+
+	t.Run("should return an error when the deployment fails", func(t *testing.T) {
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		realmClient, _ := newRealmClient(realm.DeploymentStatusFailed)
+
+		cmd := &CommandDeploy{}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errDeploymentFailed{"deploymentID", "bad config"}, err)
+		assert.Equal(t, "deployment 'deploymentID' failed: bad config", err.Error())
+	})
+
+	t.Run("should show the changes and not deploy the draft when not confirmed", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		realmClient, _ := newRealmClient(realm.DeploymentStatusSuccessful)
+		var deployed bool
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
+			deployed = true
+			return realm.AppDeployment{}, nil
+		}
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Draft draftID has the following changes...")
+			console.ExpectString("diff1")
+			console.ExpectString("Are you sure you want to deploy draft draftID?")
+			console.SendLine("n")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandDeploy{}
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.False(t, deployed, "expected the draft to not be deployed")
+	})
+}
@@ -0,0 +1,54 @@
+package drafts
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaDiff is the command meta for the `apps drafts diff` command
+var CommandMetaDiff = cli.CommandMeta{
+	Use:         "diff",
+	Display:     "apps drafts diff",
+	Description: "Show the changes of the draft of your Realm app",
+	HelpText: `Displays the changes collected by the draft of your Realm app, compared to the
+app's latest deployment. These are the changes "apps drafts deploy" deploys.`,
+}
+
+// CommandDiff is the `apps drafts diff` command
+type CommandDiff struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandDiff) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDiff) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	draft, err := findDraft(clients.Realm, app)
+	if err != nil {
+		return err
+	}
+
+	diff, err := clients.Realm.DiffDraft(app.GroupID, app.ID, draft.ID)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(diffLogs(draft.ID, diff)...)
+	return nil
+}
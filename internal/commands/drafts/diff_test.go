@@ -0,0 +1,56 @@
+package drafts
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDraftsDiffHandler(t *testing.T) {
+	for _, tc := range []struct {
+		description    string
+		diff           realm.AppDraftDiff
+		expectedOutput string
+	}{
+		{
+			description:    "should print that the draft has no changes",
+			expectedOutput: "Draft draftID has no changes\n",
+		},
+		{
+			description: "should print the changes of the draft",
+			diff: realm.AppDraftDiff{
+				Diffs:            []string{"diff1", "diff2"},
+				HostingFilesDiff: realm.HostingFilesDiff{Added: []string{"index.html"}},
+			},
+			expectedOutput: `Draft draftID has the following changes...
+  diff1
+  diff2
+With changes to your static hosting files...
+  added: index.html
+`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+			}
+			realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+				return realm.AppDraft{ID: "draftID"}, nil
+			}
+			realmClient.DiffDraftFn = func(groupID, appID, draftID string) (realm.AppDraftDiff, error) {
+				return tc.diff, nil
+			}
+
+			cmd := &CommandDiff{}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package drafts
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaDiscard is the command meta for the `apps drafts discard` command
+var CommandMetaDiscard = cli.CommandMeta{
+	Use:         "discard",
+	Display:     "apps drafts discard",
+	Description: "Discard the draft of your Realm app",
+	HelpText: `Discards the draft of your Realm app along with the changes it collected, once
+you confirm. The app itself is left unchanged.`,
+}
+
+// CommandDiscard is the `apps drafts discard` command
+type CommandDiscard struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandDiscard) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDiscard) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDiscard) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	draft, err := findDraft(clients.Realm, app)
+	if err != nil {
+		return err
+	}
+
+	proceed, err := ui.Confirm("Are you sure you want to discard draft %s and its changes?", draft.ID)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	if err := clients.Realm.DiscardDraft(app.GroupID, app.ID, draft.ID); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully discarded draft %s", draft.ID))
+	return nil
+}
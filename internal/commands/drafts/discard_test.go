@@ -0,0 +1,68 @@
+package drafts
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDraftsDiscardHandler(t *testing.T) {
+	newRealmClient := func() (mock.RealmClient, *[]string) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+		}
+		realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{ID: "draftID"}, nil
+		}
+
+		discarded := []string{}
+		realmClient.DiscardDraftFn = func(groupID, appID, draftID string) error {
+			discarded = append(discarded, draftID)
+			return nil
+		}
+		return realmClient, &discarded
+	}
+
+	t.Run("should discard the draft", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, discarded := newRealmClient()
+
+		cmd := &CommandDiscard{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully discarded draft draftID\n", out.String())
+		assert.Equal(t, []string{"draftID"}, *discarded)
+	})
+
+	t.Run("should not discard the draft when not confirmed", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		realmClient, discarded := newRealmClient()
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Are you sure you want to discard draft draftID and its changes?")
+			console.SendLine("n")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandDiscard{}
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{}, *discarded)
+	})
+}
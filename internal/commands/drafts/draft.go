@@ -0,0 +1,37 @@
+package drafts
+
+import (
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// findDraft returns the draft of the app, as each user has at most one draft per app
+func findDraft(client realm.Client, app realm.App) (realm.AppDraft, error) {
+	draft, err := client.Draft(app.GroupID, app.ID)
+	if err == realm.ErrDraftNotFound {
+		return realm.AppDraft{}, errDraftNotFound{app.ClientAppID}
+	}
+	return draft, err
+}
+
+// diffLogs returns the logs which display the changes of the draft
+func diffLogs(draftID string, diff realm.AppDraftDiff) []terminal.Log {
+	if !diff.HasChanges() {
+		return []terminal.Log{terminal.NewTextLog("Draft %s has no changes", draftID)}
+	}
+
+	logs := []terminal.Log{terminal.NewListLog("Draft "+draftID+" has the following changes...", diff.DiffList()...)}
+	if diff.HostingFilesDiff.HasChanges() {
+		logs = append(logs, terminal.NewListLog("With changes to your static hosting files...", diff.HostingFilesDiff.DiffList()...))
+	}
+	if diff.DependenciesDiff.HasChanges() {
+		logs = append(logs, terminal.NewListLog("With changes to your app dependencies...", diff.DependenciesDiff.DiffList()...))
+	}
+	if diff.GraphQLConfigDiff.HasChanges() {
+		logs = append(logs, terminal.NewListLog("With changes to your GraphQL configuration...", diff.GraphQLConfigDiff.DiffList()...))
+	}
+	if diff.SchemaOptionsDiff.HasChanges() {
+		logs = append(logs, terminal.NewListLog("With changes to your app schema...", diff.SchemaOptionsDiff.DiffList()...))
+	}
+	return logs
+}
@@ -0,0 +1,47 @@
+package drafts
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+)
+
+type errDraftNotFound struct {
+	app string
+}
+
+func (err errDraftNotFound) Error() string {
+	return fmt.Sprintf("no draft found for app %s", err.app)
+}
+
+func (err errDraftNotFound) DisableUsage() struct{} { return struct{}{} }
+
+// Suggestions returns the commands which create a draft
+func (err errDraftNotFound) Suggestions() []interface{} {
+	return []interface{}{
+		fmt.Sprintf("%s apps drafts create", cli.Name),
+		fmt.Sprintf("%s push --draft", cli.Name),
+	}
+}
+
+type errDraftExists struct {
+	app     string
+	draftID string
+}
+
+func (err errDraftExists) Error() string {
+	return fmt.Sprintf("draft %s already exists for app %s", err.draftID, err.app)
+}
+
+func (err errDraftExists) DisableUsage() struct{} { return struct{}{} }
+
+type errDeploymentFailed struct {
+	deploymentID string
+	message      string
+}
+
+func (err errDeploymentFailed) Error() string {
+	return fmt.Sprintf("deployment '%s' failed: %s", err.deploymentID, err.message)
+}
+
+func (err errDeploymentFailed) DisableUsage() struct{} { return struct{}{} }
@@ -0,0 +1,129 @@
+package eval
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagUser      = "user"
+	flagUserUsage = "specify the id of a user to evaluate the snippet as, otherwise it is evaluated as the system user"
+
+	// snippetSource is the function source which returns the evaluated snippet,
+	// awaiting it in case the snippet results in a promise
+	snippetSource = `exports = async function() {
+  return await (
+%s
+  );
+};`
+)
+
+// CommandMeta is the command meta for the `eval` command
+var CommandMeta = cli.CommandMeta{
+	Use:         "eval <snippet>",
+	Description: "Evaluate a JavaScript snippet in the server context of your Realm app",
+	HelpText: `Evaluates the JavaScript expression as a temporary Function of your Realm app
+and prints its result as a document. The snippet has access to the same global
+variables as your app's Functions, such as "context" and "EJSON", and promises
+are awaited, so you can run ad hoc checks against your app's data, for example:
+  eval 'context.services.get("mongodb-atlas").db("store").collection("orders").count()'
+
+The snippet runs as the system user, unless "--user" is specified. Nothing is
+deployed to your app. Once run, any logs and error logs are displayed as well.`,
+}
+
+// Command is the `eval` command
+type Command struct {
+	inputs inputs
+}
+
+type inputs struct {
+	cli.ProjectInputs
+	Snippet string
+	User    string
+}
+
+// Args is the command args
+func (cmd *Command) Args(args []string) error {
+	if len(args) != 1 {
+		return errors.New(`must specify a single JavaScript snippet to evaluate, e.g. "eval 'context.values.get(\"env\")'"`)
+	}
+	cmd.inputs.Snippet = args[0]
+	return nil
+}
+
+// Flags is the command flags
+func (cmd *Command) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.User, flagUser, "", flagUserUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *Command) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	source, err := functionSource(cmd.inputs.Snippet)
+	if err != nil {
+		return err
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	s := ui.Spinner("Evaluating snippet...")
+
+	evaluate := func() (realm.ExecutionResults, error) {
+		s.Start()
+		defer s.Stop()
+
+		return clients.Realm.AppDebugExecuteFunctionSource(app.GroupID, app.ID, cmd.inputs.User, source, "exports()")
+	}
+
+	response, err := evaluate()
+	if err != nil {
+		return err
+	}
+	if response.Logs != nil {
+		logs := make([]interface{}, 0, len(response.Logs))
+		for _, log := range response.Logs {
+			logs = append(logs, log)
+		}
+		ui.Print(terminal.NewListLog("Logs", logs...))
+	}
+	if response.ErrorLogs != nil {
+		ui.Print(terminal.NewJSONLog("Error Logs", response.ErrorLogs))
+	}
+	if response.Error != nil {
+		return fmt.Errorf("failed to evaluate snippet: %s", response.ErrorMessage())
+	}
+	ui.Print(terminal.NewJSONLog("Result", response.Result))
+
+	return nil
+}
+
+func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// functionSource wraps the snippet in the source of a function which returns its result
+func functionSource(snippet string) (string, error) {
+	snippet = strings.TrimRight(strings.TrimSpace(snippet), "; \t\n")
+	if snippet == "" {
+		return "", errors.New("snippet cannot be empty")
+	}
+	return fmt.Sprintf(snippetSource, snippet), nil
+}
@@ -0,0 +1,98 @@
+package eval
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestEvalArgs(t *testing.T) {
+	t.Run("should set the snippet", func(t *testing.T) {
+		cmd := &Command{}
+
+		assert.Nil(t, cmd.Args([]string{`context.values.get("env")`}))
+		assert.Equal(t, `context.values.get("env")`, cmd.inputs.Snippet)
+	})
+
+	t.Run("should return an error without exactly one snippet", func(t *testing.T) {
+		for _, args := range [][]string{nil, {"1", "2"}} {
+			cmd := &Command{}
+			assert.Equal(t,
+				errors.New(`must specify a single JavaScript snippet to evaluate, e.g. "eval 'context.values.get(\"env\")'"`),
+				cmd.Args(args),
+			)
+		}
+	})
+}
+
+func TestEvalHandler(t *testing.T) {
+	newRealmClient := func() mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "projectID"}}, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should evaluate the snippet and print the logs and result", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedGroupID, capturedAppID, capturedUserID, capturedSource, capturedEvalSource string
+		realmClient := newRealmClient()
+		realmClient.AppDebugExecuteFunctionSourceFn = func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			capturedGroupID, capturedAppID, capturedUserID = groupID, appID, userID
+			capturedSource, capturedEvalSource = source, evalSource
+			return realm.ExecutionResults{Result: map[string]interface{}{"$numberLong": "3"}, Logs: []string{"counting"}}, nil
+		}
+
+		cmd := &Command{inputs{Snippet: `context.services.get("mongodb-atlas").db("store").collection("orders").count();`, User: "user1"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Logs
+  counting
+Result
+{
+  "$numberLong": "3"
+}
+`, out.String())
+
+		assert.Equal(t, "projectID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+		assert.Equal(t, "user1", capturedUserID)
+		assert.Equal(t, `exports = async function() {
+  return await (
+context.services.get("mongodb-atlas").db("store").collection("orders").count()
+  );
+};`, capturedSource)
+		assert.Equal(t, "exports()", capturedEvalSource)
+	})
+
+	t.Run("should return an error when the snippet fails", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newRealmClient()
+		realmClient.AppDebugExecuteFunctionSourceFn = func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			return realm.ExecutionResults{
+				ErrorLogs: []string{"oops"},
+				Error:     map[string]interface{}{"message": "'foo' is not defined", "name": "ReferenceError"},
+			}, nil
+		}
+
+		cmd := &Command{inputs{Snippet: "foo.bar"}}
+
+		assert.Equal(t, errors.New("failed to evaluate snippet: 'foo' is not defined"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Error Logs\n[\n  \"oops\"\n]\n", out.String())
+	})
+
+	t.Run("should return an error when the snippet is empty", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &Command{inputs{Snippet: " ; "}}
+
+		assert.Equal(t, errors.New("snippet cannot be empty"), cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient()}))
+	})
+}
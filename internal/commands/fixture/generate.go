@@ -0,0 +1,133 @@
+package fixture
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagOutput      = "output"
+	flagOutputUsage = "specify the directory to generate the app in, which must be empty or not yet exist"
+
+	flagFunctions      = "functions"
+	flagFunctionsUsage = "specify the number of functions to generate"
+
+	flagRules      = "rules"
+	flagRulesUsage = "specify the number of collection rules to generate"
+
+	flagHostingFiles      = "hosting-files"
+	flagHostingFilesUsage = "specify the number of hosting files to generate"
+
+	flagHostingFileSize      = "hosting-file-size"
+	flagHostingFileSizeUsage = "specify the size in bytes of each generated hosting file"
+
+	flagHostingFilesPerDir      = "hosting-files-per-dir"
+	flagHostingFilesPerDirUsage = "specify the number of generated hosting files in each hosting directory"
+
+	flagSeed      = "seed"
+	flagSeedUsage = "specify the seed to generate the contents of the hosting files with"
+)
+
+// CommandMetaGenerate is the command meta for the `testdata generate` command
+var CommandMetaGenerate = cli.CommandMeta{
+	Use:         "generate",
+	Display:     "testdata generate",
+	Description: "Generate a synthetic local Realm app for development",
+	HelpText: `Generates a local Realm app of the specified size, with any number of
+functions, collection rules and hosting files, for benchmarking commands such
+as "push" and reproducing issues which only occur with large apps. The same
+flags always generate the same app.
+
+The generated app is not linked to any remote Realm app.`,
+}
+
+// CommandGenerate is the `testdata generate` command
+type CommandGenerate struct {
+	inputs generateInputs
+}
+
+type generateInputs struct {
+	Output string
+	local.AppFixtureOptions
+}
+
+// Flags is the command flags
+func (cmd *CommandGenerate) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.Output, flagOutput, "", flagOutputUsage)
+	fs.IntVar(&cmd.inputs.Functions, flagFunctions, 0, flagFunctionsUsage)
+	fs.IntVar(&cmd.inputs.Rules, flagRules, 0, flagRulesUsage)
+	fs.IntVar(&cmd.inputs.HostingFiles, flagHostingFiles, 0, flagHostingFilesUsage)
+	fs.IntVar(&cmd.inputs.HostingFileSize, flagHostingFileSize, local.DefaultFixtureHostingFileSize, flagHostingFileSizeUsage)
+	fs.IntVar(&cmd.inputs.HostingFilesPerDir, flagHostingFilesPerDir, local.DefaultFixtureHostingFilesPerDir, flagHostingFilesPerDirUsage)
+	fs.Int64Var(&cmd.inputs.Seed, flagSeed, 0, flagSeedUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandGenerate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandGenerate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	if _, err := local.GenerateAppFixture(cmd.inputs.Output, cmd.inputs.AppFixtureOptions); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"Successfully generated an app with %d function(s), %d rule(s) and %d hosting file(s) at %s",
+		cmd.inputs.Functions,
+		cmd.inputs.Rules,
+		cmd.inputs.HostingFiles,
+		cmd.inputs.Output,
+	))
+	return nil
+}
+
+func (i *generateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Output == "" {
+		return fmt.Errorf(`must specify the directory to generate the app in with "--%s"`, flagOutput)
+	}
+
+	for flag, n := range map[string]int{
+		flagFunctions:          i.Functions,
+		flagRules:              i.Rules,
+		flagHostingFiles:       i.HostingFiles,
+		flagHostingFileSize:    i.HostingFileSize,
+		flagHostingFilesPerDir: i.HostingFilesPerDir,
+	} {
+		if n < 0 {
+			return fmt.Errorf(`"--%s" must not be negative`, flag)
+		}
+	}
+
+	output, err := homedir.Expand(i.Output)
+	if err != nil {
+		return err
+	}
+	if !filepath.IsAbs(output) {
+		output = filepath.Join(profile.WorkingDirectory, output)
+	}
+	i.Output = output
+
+	files, err := ioutil.ReadDir(i.Output)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if len(files) > 0 {
+		return fmt.Errorf("directory %s must be empty to generate an app in", i.Output)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package fixture
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestFixtureGenerateHandler(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	out, ui := mock.NewUI()
+
+	output := filepath.Join(tmpDir, "app")
+
+	cmd := &CommandGenerate{generateInputs{
+		Output: output,
+		AppFixtureOptions: local.AppFixtureOptions{
+			Functions:          2,
+			Rules:              3,
+			HostingFiles:       4,
+			HostingFileSize:    8,
+			HostingFilesPerDir: 10,
+		},
+	}}
+
+	assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+	assert.Equal(t, fmt.Sprintf("Successfully generated an app with 2 function(s), 3 rule(s) and 4 hosting file(s) at %s\n", output), out.String())
+
+	app, err := local.LoadApp(output)
+	assert.Nil(t, err)
+	assert.Equal(t, "fixture", app.Name())
+
+	files, err := ioutil.ReadDir(filepath.Join(output, local.NameHosting, local.NameFiles, "dir0"))
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(files))
+}
+
+func TestFixtureGenerateInputs(t *testing.T) {
+	t.Run("should resolve a relative output against the working directory", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "fixture_generate")
+		defer teardown()
+
+		inputs := generateInputs{Output: "app"}
+		assert.Nil(t, inputs.Resolve(profile, nil))
+		assert.Equal(t, filepath.Join(profile.WorkingDirectory, "app"), inputs.Output)
+	})
+
+	t.Run("should allow an existing empty output directory", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "fixture_generate")
+		defer teardown()
+
+		assert.Nil(t, os.MkdirAll(filepath.Join(profile.WorkingDirectory, "app"), os.ModePerm))
+
+		inputs := generateInputs{Output: "app"}
+		assert.Nil(t, inputs.Resolve(profile, nil))
+	})
+
+	t.Run("should return an error when the output directory is not empty", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "fixture_generate")
+		defer teardown()
+
+		output := filepath.Join(profile.WorkingDirectory, "app")
+		assert.Nil(t, os.MkdirAll(output, os.ModePerm))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(output, "README.md"), []byte("readme"), 0666))
+
+		inputs := generateInputs{Output: output}
+		assert.Equal(t, fmt.Errorf("directory %s must be empty to generate an app in", output), inputs.Resolve(profile, nil))
+	})
+
+	for _, tc := range []struct {
+		description string
+		inputs      generateInputs
+		expectedErr error
+	}{
+		{
+			description: "should return an error when no output is specified",
+			expectedErr: fmt.Errorf(`must specify the directory to generate the app in with "--output"`),
+		},
+		{
+			description: "should return an error when a size is negative",
+			inputs:      generateInputs{Output: "app", AppFixtureOptions: local.AppFixtureOptions{HostingFiles: -1}},
+			expectedErr: fmt.Errorf(`"--hosting-files" must not be negative`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, nil))
+		})
+	}
+}
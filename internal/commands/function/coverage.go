@@ -0,0 +1,187 @@
+package function
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagCoverageWindowUsage = "specify how far back to look for function calls, e.g. 24h or 720h"
+
+	flagUncalledOnly      = "uncalled-only"
+	flagUncalledOnlyUsage = "include to only list the functions which were never called"
+
+	defaultCoverageWindow = 7 * 24 * time.Hour
+
+	headerCalls       = "Calls"
+	headerCallsPerDay = "Calls/Day"
+	headerLastCalled  = "Last Called"
+
+	lastCalledNever = "never"
+)
+
+// CommandMetaCoverage is the command meta for the `function coverage` command
+var CommandMetaCoverage = cli.CommandMeta{
+	Use:         "coverage",
+	Display:     "function coverage",
+	Description: "Report which Functions of your Realm app have been called",
+	HelpText: `Compares the Functions of your Realm app against the Function calls recorded in
+its logs over the specified window, including calls by Triggers and Webhooks.
+The report lists how often and how recently each Function was called, followed
+by the Functions which were never called, which may be candidates for cleanup.
+
+Function calls are counted from every log recorded over the window, so reports
+over long windows of busy apps may take a while to compute. Logs are only kept
+for a limited time, so Functions may be reported as never called when they were
+last called before then.`,
+}
+
+// CommandCoverage is the `function coverage` command
+type CommandCoverage struct {
+	inputs coverageInputs
+}
+
+type coverageInputs struct {
+	cli.ProjectInputs
+	Window       time.Duration
+	UncalledOnly bool
+}
+
+// Flags is the command flags
+func (cmd *CommandCoverage) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.DurationVar(&cmd.inputs.Window, flagWindow, defaultCoverageWindow, flagCoverageWindowUsage)
+	fs.BoolVar(&cmd.inputs.UncalledOnly, flagUncalledOnly, false, flagUncalledOnlyUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandCoverage) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandCoverage) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	functions, err := clients.Realm.Functions(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+	if len(functions) == 0 {
+		ui.Print(terminal.NewTextLog("No functions found for app %s", app.ClientAppID))
+		return nil
+	}
+
+	logs, err := clients.Realm.Logs(app.GroupID, app.ID, realm.LogsOptions{
+		Types:    statsLogTypes,
+		Start:    time.Now().Add(-cmd.inputs.Window),
+		AllPages: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	coverage := newFunctionCoverage(functions, logs)
+
+	var uncalled []interface{}
+	for _, c := range coverage {
+		if c.calls == 0 {
+			uncalled = append(uncalled, c.name)
+		}
+	}
+
+	called := len(coverage) - len(uncalled)
+	summary := fmt.Sprintf(
+		"%d of %d function(s) called in the last %s (%.1f%%)",
+		called,
+		len(coverage),
+		cmd.inputs.Window,
+		100*float64(called)/float64(len(coverage)),
+	)
+
+	if cmd.inputs.UncalledOnly {
+		ui.Print(terminal.NewListLog(fmt.Sprintf("%s, %d function(s) never called", summary, len(uncalled)), uncalled...))
+		return nil
+	}
+
+	days := cmd.inputs.Window.Hours() / 24
+
+	rows := make([]map[string]interface{}, 0, len(coverage))
+	for _, c := range coverage {
+		lastCalled := lastCalledNever
+		if !c.lastCalled.IsZero() {
+			lastCalled = c.lastCalled.UTC().Format(time.RFC3339)
+		}
+		rows = append(rows, map[string]interface{}{
+			headerFunction:    c.name,
+			headerCalls:       c.calls,
+			headerCallsPerDay: fmt.Sprintf("%.1f", float64(c.calls)/days),
+			headerLastCalled:  lastCalled,
+		})
+	}
+
+	output := []terminal.Log{terminal.NewTableLog(
+		summary,
+		[]string{headerFunction, headerCalls, headerCallsPerDay, headerLastCalled},
+		rows...,
+	)}
+	if len(uncalled) > 0 {
+		output = append(output, terminal.NewListLog(fmt.Sprintf("%d function(s) never called", len(uncalled)), uncalled...))
+	}
+
+	ui.Print(output...)
+	return nil
+}
+
+func (i *coverageInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Window <= 0 {
+		return errors.New("window must be a positive duration")
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+type functionCoverage struct {
+	name       string
+	calls      int
+	lastCalled time.Time
+}
+
+// newFunctionCoverage counts the calls of each function recorded in the logs,
+// sorted from the most to the least called function
+func newFunctionCoverage(functions []realm.Function, logs realm.Logs) []functionCoverage {
+	coverage := make([]functionCoverage, 0, len(functions))
+	for _, function := range functions {
+		c := functionCoverage{name: function.Name}
+		for _, log := range logs {
+			if !isFunctionLog(log, function) {
+				continue
+			}
+			c.calls++
+			if log.Started.After(c.lastCalled) {
+				c.lastCalled = log.Started
+			}
+		}
+		coverage = append(coverage, c)
+	}
+
+	sort.SliceStable(coverage, func(i, j int) bool {
+		if coverage[i].calls != coverage[j].calls {
+			return coverage[i].calls > coverage[j].calls
+		}
+		return coverage[i].name < coverage[j].name
+	})
+	return coverage
+}
@@ -0,0 +1,108 @@
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestFunctionCoverageHandler(t *testing.T) {
+	started := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	newLog := func(functionName string, daysAgo int) realm.Log {
+		return realm.Log{
+			Type:         realm.LogTypeFunction,
+			FunctionName: functionName,
+			Started:      started.AddDate(0, 0, -daysAgo),
+		}
+	}
+
+	triggerLog := newLog("", 0)
+	triggerLog.Type = realm.LogTypeScheduledTrigger
+	triggerLog.FunctionID = "cleanupID"
+
+	newClient := func() (mock.RealmClient, *realm.LogsOptions) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+		}
+		realmClient.FunctionsFn = func(groupID, appID string) ([]realm.Function, error) {
+			return []realm.Function{
+				{ID: "sumID", Name: "sum"},
+				{ID: "cleanupID", Name: "cleanup"},
+				{ID: "legacyID", Name: "legacy"},
+				{ID: "unusedID", Name: "unused"},
+			}, nil
+		}
+
+		var capturedOpts realm.LogsOptions
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			capturedOpts = opts
+			return realm.Logs{
+				newLog("sum", 3),
+				newLog("sum", 1),
+				newLog("sum", 2),
+				newLog("deleted", 1),
+				triggerLog,
+			}, nil
+		}
+		return realmClient, &capturedOpts
+	}
+
+	t.Run("should report the calls of each function and the functions never called", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, capturedOpts := newClient()
+
+		cmd := &CommandCoverage{coverageInputs{Window: 2 * 24 * time.Hour}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `2 of 4 function(s) called in the last 48h0m0s (50.0%)
+  Function  Calls  Calls/Day  Last Called         
+  --------  -----  ---------  --------------------
+  sum       3      1.5        2021-02-28T12:00:00Z
+  cleanup   1      0.5        2021-03-01T12:00:00Z
+  legacy    0      0.0        never               
+  unused    0      0.0        never               
+2 function(s) never called
+  legacy
+  unused
+`, out.String())
+
+		assert.Equal(t, statsLogTypes, capturedOpts.Types)
+		assert.True(t, capturedOpts.AllPages, "expected every page of logs to be found")
+		assert.True(t, time.Since(capturedOpts.Start) >= 2*24*time.Hour, "expected logs to be found from the start of the window")
+	})
+
+	t.Run("should only report the functions never called", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, _ := newClient()
+
+		cmd := &CommandCoverage{coverageInputs{Window: 2 * 24 * time.Hour, UncalledOnly: true}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `2 of 4 function(s) called in the last 48h0m0s (50.0%), 2 function(s) never called
+  legacy
+  unused
+`, out.String())
+	})
+
+	t.Run("should report when the app has no functions", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, _ := newClient()
+		realmClient.FunctionsFn = func(groupID, appID string) ([]realm.Function, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandCoverage{coverageInputs{Window: time.Hour}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No functions found for app app-abcde\n", out.String())
+	})
+}
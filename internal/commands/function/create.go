@@ -0,0 +1,234 @@
+package function
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaCreate is the command meta for the `function create` command
+var CommandMetaCreate = cli.CommandMeta{
+	Use:         "create",
+	Display:     "function create",
+	Description: "Create a Function in your local Realm app directory",
+	HelpText: `Writes the source stub and configuration of a new Function to your local Realm
+app directory, following the layout of your app's config version. Nest the
+Function in a directory by including a "/" in its name, e.g. "orders/onNewOrder"
+(requires config version 20210101).
+
+Include "--trigger" to also create a Trigger which runs the new Function, either
+on a schedule with "--trigger scheduled" or on changes to a collection with
+"--trigger database".
+
+The Function is deployed to your remote Realm app with "push".`,
+}
+
+// CommandCreate is the `function create` command
+type CommandCreate struct {
+	inputs createInputs
+}
+
+const (
+	flagLocalPathCreate      = "local"
+	flagLocalPathCreateUsage = "the local path to the Realm app to create the function in"
+
+	flagFunctionNameCreateUsage = "the name of the function to create"
+
+	flagPrivate      = "private"
+	flagPrivateUsage = "include to prevent client applications from calling the function"
+
+	flagTrigger      = "trigger"
+	flagTriggerUsage = `specify the type of trigger to create for the function, either "scheduled" or "database"`
+
+	flagSchedule      = "schedule"
+	flagScheduleUsage = "specify the CRON expression the scheduled trigger runs on"
+
+	flagDataSource      = "data-source"
+	flagDataSourceUsage = "specify the data source the database trigger watches"
+
+	flagDatabase      = "database"
+	flagDatabaseUsage = "specify the database the database trigger watches"
+
+	flagCollection      = "collection"
+	flagCollectionUsage = "specify the collection the database trigger watches"
+
+	flagOperationType      = "operation-type"
+	flagOperationTypeUsage = "specify the operation type(s) the database trigger runs on, e.g. INSERT or UPDATE"
+
+	triggerScheduled = "scheduled"
+	triggerDatabase  = "database"
+
+	defaultSchedule = "0 0 * * *"
+)
+
+var (
+	functionNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+(/[A-Za-z0-9_-]+)*$`)
+
+	defaultOperationTypes = []string{"INSERT"}
+)
+
+type createInputs struct {
+	LocalPath      string
+	Name           string
+	Private        bool
+	Trigger        string
+	Schedule       string
+	DataSource     string
+	Database       string
+	Collection     string
+	OperationTypes []string
+}
+
+// Flags is the command flags
+func (cmd *CommandCreate) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathCreate, "", flagLocalPathCreateUsage)
+	fs.StringVar(&cmd.inputs.Name, flagFunctionName, "", flagFunctionNameCreateUsage)
+	fs.BoolVar(&cmd.inputs.Private, flagPrivate, false, flagPrivateUsage)
+	fs.StringVar(&cmd.inputs.Trigger, flagTrigger, "", flagTriggerUsage)
+	fs.StringVar(&cmd.inputs.Schedule, flagSchedule, defaultSchedule, flagScheduleUsage)
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, "", flagDataSourceUsage)
+	fs.StringVar(&cmd.inputs.Database, flagDatabase, "", flagDatabaseUsage)
+	fs.StringVar(&cmd.inputs.Collection, flagCollection, "", flagCollectionUsage)
+	fs.StringSliceVar(&cmd.inputs.OperationTypes, flagOperationType, defaultOperationTypes, flagOperationTypeUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandCreate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	if err := local.AddFunction(app.AppData, cmd.inputs.Name, cmd.inputs.Private); err != nil {
+		return err
+	}
+
+	trigger := cmd.inputs.trigger()
+	if trigger != nil {
+		if err := local.AddTrigger(app.AppData, trigger); err != nil {
+			return err
+		}
+	}
+
+	if err := app.Write(); err != nil {
+		return err
+	}
+
+	logs := []terminal.Log{terminal.NewTextLog("Successfully created function '%s' in %s", cmd.inputs.Name, app.RootDir)}
+	if trigger != nil {
+		logs = append(logs, terminal.NewTextLog("Successfully created %s trigger '%s'", cmd.inputs.Trigger, trigger["name"]))
+	}
+	logs = append(logs, terminal.NewFollowupLog("To deploy your new function run", fmt.Sprintf("%s push --local %s", cli.Name, app.RootDir)))
+
+	ui.Print(logs...)
+	return nil
+}
+
+func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	searchPath := i.LocalPath
+	if searchPath == "" {
+		searchPath = profile.WorkingDirectory
+	}
+
+	app, err := local.LoadAppConfig(searchPath)
+	if err != nil {
+		return err
+	}
+
+	if i.LocalPath == "" && app.RootDir == "" {
+		if err := ui.AskOne(&i.LocalPath, &survey.Input{Message: "App filepath (local)"}); err != nil {
+			return err
+		}
+
+		app, err = local.LoadAppConfig(i.LocalPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if app.RootDir != "" {
+		i.LocalPath = app.RootDir
+	}
+
+	if i.Name == "" {
+		if err := ui.AskOne(&i.Name, &survey.Input{Message: "Function Name"}); err != nil {
+			return err
+		}
+	}
+	if !functionNamePattern.MatchString(i.Name) {
+		return fmt.Errorf("invalid function name '%s', use only letters, numbers, underscores and hyphens, separating directories with '/'", i.Name)
+	}
+
+	i.Trigger = strings.ToLower(i.Trigger)
+	switch i.Trigger {
+	case "", triggerScheduled:
+	case triggerDatabase:
+		var questions []*survey.Question
+		if i.DataSource == "" {
+			questions = append(questions, &survey.Question{Name: "DataSource", Prompt: &survey.Input{Message: "Data Source", Default: "mongodb-atlas"}})
+		}
+		if i.Database == "" {
+			questions = append(questions, &survey.Question{Name: "Database", Prompt: &survey.Input{Message: "Database"}})
+		}
+		if i.Collection == "" {
+			questions = append(questions, &survey.Question{Name: "Collection", Prompt: &survey.Input{Message: "Collection"}})
+		}
+		if len(questions) > 0 {
+			if err := ui.Ask(i, questions...); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf(`unsupported trigger type '%s', use either "%s" or "%s" instead`, i.Trigger, triggerScheduled, triggerDatabase)
+	}
+
+	return nil
+}
+
+// trigger returns the config of the trigger to create for the function, if any
+func (i createInputs) trigger() map[string]interface{} {
+	var triggerType string
+	var config map[string]interface{}
+
+	switch i.Trigger {
+	case triggerScheduled:
+		triggerType = "SCHEDULED"
+		config = map[string]interface{}{"schedule": i.Schedule}
+	case triggerDatabase:
+		operationTypes := make([]interface{}, 0, len(i.OperationTypes))
+		for _, operationType := range i.OperationTypes {
+			operationTypes = append(operationTypes, strings.ToUpper(operationType))
+		}
+		triggerType = "DATABASE"
+		config = map[string]interface{}{
+			"service_name":    i.DataSource,
+			"database":        i.Database,
+			"collection":      i.Collection,
+			"operation_types": operationTypes,
+			"full_document":   true,
+		}
+	default:
+		return nil
+	}
+
+	return map[string]interface{}{
+		"name":          strings.ReplaceAll(i.Name, "/", "_"),
+		"type":          triggerType,
+		"config":        config,
+		"function_name": i.Name,
+		"disabled":      false,
+	}
+}
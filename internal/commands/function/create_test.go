@@ -0,0 +1,150 @@
+package function
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestFunctionCreateHandler(t *testing.T) {
+	setup := func(t *testing.T, configVersion realm.AppConfigVersion) (string, func()) {
+		t.Helper()
+
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+
+		app := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, configVersion)
+		if configVersion == realm.AppConfigVersion20200603 {
+			app.Config = local.FileConfig
+		}
+		assert.Nil(t, app.Write())
+		return dir, teardown
+	}
+
+	readJSON := func(t *testing.T, path string, out interface{}) {
+		t.Helper()
+
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		assert.Nil(t, json.Unmarshal(data, out))
+	}
+
+	t.Run("should write the function to the functions directory for a v2 app", func(t *testing.T) {
+		dir, teardown := setup(t, realm.AppConfigVersion20210101)
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandCreate{createInputs{LocalPath: dir, Name: "orders/onNewOrder", Private: true}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, "Successfully created function 'orders/onNewOrder' in "+dir+"\n"+
+			"To deploy your new function run: "+
+			"realm-cli push --local "+dir+"\n", out.String())
+
+		source, err := ioutil.ReadFile(filepath.Join(dir, local.NameFunctions, "orders", "onNewOrder.js"))
+		assert.Nil(t, err)
+		assert.Equal(t, local.FunctionSourceStub, string(source))
+
+		var configs []map[string]interface{}
+		readJSON(t, filepath.Join(dir, local.NameFunctions, local.FileConfig.String()), &configs)
+		assert.Equal(t, []map[string]interface{}{{"name": "orders/onNewOrder", "private": true}}, configs)
+	})
+
+	t.Run("should write the function and a database trigger for a v1 app", func(t *testing.T) {
+		dir, teardown := setup(t, realm.AppConfigVersion20200603)
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandCreate{createInputs{
+			LocalPath:      dir,
+			Name:           "onNewOrder",
+			Trigger:        triggerDatabase,
+			DataSource:     "mongodb-atlas",
+			Database:       "store",
+			Collection:     "orders",
+			OperationTypes: []string{"insert", "update"},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, "Successfully created function 'onNewOrder' in "+dir+"\n"+
+			"Successfully created database trigger 'onNewOrder'\n"+
+			"To deploy your new function run: "+
+			"realm-cli push --local "+dir+"\n", out.String())
+
+		source, err := ioutil.ReadFile(filepath.Join(dir, local.NameFunctions, "onNewOrder", local.FileSource.String()))
+		assert.Nil(t, err)
+		assert.Equal(t, local.FunctionSourceStub, string(source))
+
+		var config map[string]interface{}
+		readJSON(t, filepath.Join(dir, local.NameFunctions, "onNewOrder", local.FileConfig.String()), &config)
+		assert.Equal(t, map[string]interface{}{"name": "onNewOrder", "private": false}, config)
+
+		var trigger map[string]interface{}
+		readJSON(t, filepath.Join(dir, local.NameTriggers, "onNewOrder.json"), &trigger)
+		assert.Equal(t, map[string]interface{}{
+			"name": "onNewOrder",
+			"type": "DATABASE",
+			"config": map[string]interface{}{
+				"service_name":    "mongodb-atlas",
+				"database":        "store",
+				"collection":      "orders",
+				"operation_types": []interface{}{"INSERT", "UPDATE"},
+				"full_document":   true,
+			},
+			"function_name": "onNewOrder",
+			"disabled":      false,
+		}, trigger)
+	})
+
+	t.Run("should return an error when the function already exists", func(t *testing.T) {
+		dir, teardown := setup(t, realm.AppConfigVersion20210101)
+		defer teardown()
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandCreate{createInputs{LocalPath: dir, Name: "fn"}}
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+
+		err := cmd.Handler(nil, ui, cli.Clients{})
+		assert.Equal(t, errors.New("function 'fn' already exists"), err)
+	})
+}
+
+func TestFunctionCreateInputsResolve(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      createInputs
+		expectedErr error
+	}{
+		{
+			description: "should return an error with an invalid function name",
+			inputs:      createInputs{Name: "../fn"},
+			expectedErr: errors.New("invalid function name '../fn', use only letters, numbers, underscores and hyphens, separating directories with '/'"),
+		},
+		{
+			description: "should return an error with an unsupported trigger type",
+			inputs:      createInputs{Name: "fn", Trigger: "auth"},
+			expectedErr: errors.New(`unsupported trigger type 'auth', use either "scheduled" or "database" instead`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile, teardown := mock.NewProfileFromTmpDir(t, "function_create_test")
+			defer teardown()
+
+			assert.Nil(t, local.NewApp(profile.WorkingDirectory, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.DefaultAppConfigVersion).WriteConfig())
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, nil))
+		})
+	}
+}
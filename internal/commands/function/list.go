@@ -0,0 +1,147 @@
+package function
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/parallel"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaList is the command meta for the `function list` command
+var CommandMetaList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "function list",
+	Description: "List the Functions deployed to your Realm app",
+	HelpText: `Displays the Functions deployed to your Realm app along with whether each is
+private, which user it runs as and when it was last modified, without pulling
+your app.`,
+}
+
+// CommandList is the `function list` command
+type CommandList struct {
+	inputs listInputs
+}
+
+type listInputs struct {
+	cli.ProjectInputs
+}
+
+const (
+	headerName         = "Name"
+	headerPrivate      = "Private"
+	headerRunAs        = "Run As"
+	headerLastModified = "Last Modified"
+
+	dateFormat = "2006-01-02T15:04:05.000-0700"
+
+	// maxConcurrentFunctionLookups is the default maximum number of function details fetched at the same time
+	maxConcurrentFunctionLookups = 4
+)
+
+// Flags is the command flags
+func (cmd *CommandList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	functions, err := clients.Realm.Functions(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(functions) == 0 {
+		ui.Print(terminal.NewTextLog("No available functions to show"))
+		return nil
+	}
+
+	workers := maxConcurrentFunctionLookups
+	if profile != nil {
+		workers = profile.Parallelism(workers)
+	}
+
+	details, err := functionDetails(clients.Realm, app, functions, workers)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(details, func(i, j int) bool {
+		return details[i].Name < details[j].Name
+	})
+
+	rows := make([]map[string]interface{}, 0, len(details))
+	for _, function := range details {
+		var lastModified string
+		if function.LastModified > 0 {
+			lastModified = time.Unix(function.LastModified, 0).Local().Format(dateFormat)
+		}
+		rows = append(rows, map[string]interface{}{
+			headerName:         function.Name,
+			headerPrivate:      function.Private,
+			headerRunAs:        runAs(function),
+			headerLastModified: lastModified,
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d functions", len(details)),
+		[]string{headerName, headerPrivate, headerRunAs, headerLastModified},
+		rows...,
+	))
+	return nil
+}
+
+// functionDetails fetches the details of each function with a bounded number of workers,
+// keeping the last modified time from the functions list when the details omit it
+func functionDetails(realmClient realm.Client, app realm.App, functions []realm.Function, workers int) ([]realm.FunctionDetails, error) {
+	details := make([]realm.FunctionDetails, len(functions))
+	errs := make([]error, len(functions))
+
+	parallel.Run(len(functions), workers, func(i int) {
+		details[i], errs[i] = realmClient.Function(app.GroupID, app.ID, functions[i].ID)
+		if details[i].LastModified == 0 {
+			details[i].LastModified = functions[i].LastModified
+		}
+	})
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("failed to get function '%s': %w", functions[i].Name, err)
+		}
+	}
+	return details, nil
+}
+
+func runAs(function realm.FunctionDetails) string {
+	switch {
+	case function.RunAsSystem:
+		return "system"
+	case function.RunAsUserID != "":
+		return "user " + function.RunAsUserID
+	case function.RunAsUserIDScriptSource != "":
+		return "user from script"
+	}
+	return "calling user"
+}
+
+func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
@@ -0,0 +1,96 @@
+package function
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestFunctionListHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", Name: "eggcorn"}
+
+	modified := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should list the functions with their runtime details", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FunctionsFn = func(groupID, appID string) ([]realm.Function, error) {
+			return []realm.Function{
+				{ID: "fn3", Name: "scripted"},
+				{ID: "fn1", Name: "admin", LastModified: modified.Unix()},
+				{ID: "fn4", Name: "public"},
+				{ID: "fn2", Name: "impersonate"},
+			}, nil
+		}
+		realmClient.FunctionFn = func(groupID, appID, functionID string) (realm.FunctionDetails, error) {
+			switch functionID {
+			case "fn1":
+				return realm.FunctionDetails{Function: realm.Function{ID: "fn1", Name: "admin"}, Private: true, RunAsSystem: true}, nil
+			case "fn2":
+				return realm.FunctionDetails{Function: realm.Function{ID: "fn2", Name: "impersonate"}, RunAsUserID: "userID"}, nil
+			case "fn3":
+				return realm.FunctionDetails{Function: realm.Function{ID: "fn3", Name: "scripted"}, RunAsUserIDScriptSource: "exports = () => 'userID'"}, nil
+			}
+			return realm.FunctionDetails{Function: realm.Function{ID: functionID, Name: "public"}}, nil
+		}
+
+		cmd := &CommandList{listInputs{cli.ProjectInputs{App: "eggcorn"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, strings.Join([]string{
+			"Found 4 functions",
+			"  Name         Private  Run As            Last Modified               ",
+			"  -----------  -------  ----------------  ----------------------------",
+			"  admin        true     system            " + modified.Local().Format(dateFormat),
+			"  impersonate  false    user userID                                   ",
+			"  public       false    calling user                                  ",
+			"  scripted     false    user from script                              ",
+			"",
+		}, "\n"), out.String())
+	})
+
+	t.Run("should print a message when the app has no functions", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FunctionsFn = func(groupID, appID string) ([]realm.Function, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandList{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No available functions to show\n", out.String())
+	})
+
+	t.Run("should return an error when getting a function fails", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FunctionsFn = func(groupID, appID string) ([]realm.Function, error) {
+			return []realm.Function{{ID: "fn1", Name: "admin"}}, nil
+		}
+		realmClient.FunctionFn = func(groupID, appID, functionID string) (realm.FunctionDetails, error) {
+			return realm.FunctionDetails{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandList{}
+
+		err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, "failed to get function 'admin': something bad happened", err.Error())
+	})
+}
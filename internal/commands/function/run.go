@@ -4,14 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
 
-	"github.com/briandowns/spinner"
 	"github.com/spf13/pflag"
 )
 
@@ -23,8 +22,12 @@ var CommandMetaRun = cli.CommandMeta{
 app. Once you select and run a Function for your Realm app, the following will
 be displayed:
   - A list of logs, if present
-  - The function result as a document
-  - A list of error logs, if present`,
+  - A list of error logs, if present
+  - The function result as a document, or the error the function failed with
+
+Pass each arg to your Function with its own "--args" flag, or pass all of them
+at once as a JSON array with "--args-json". Include "--as-user" with a user id
+to run the Function in the context of that user instead of as system.`,
 }
 
 // CommandRun is the `function run` command
@@ -38,7 +41,15 @@ func (cmd *CommandRun) Flags(fs *pflag.FlagSet) {
 
 	fs.StringVar(&cmd.inputs.Name, flagFunctionName, "", flagFunctionNameUsage)
 	fs.StringArrayVar(&cmd.inputs.Args, flagFunctionArgs, nil, flagFunctionArgsUsage)
+	fs.StringVar(&cmd.inputs.ArgsJSON, flagFunctionArgsJSON, "", flagFunctionArgsJSONUsage)
 	fs.StringVar(&cmd.inputs.User, flagAsUser, "", flagAsUserUsage)
+
+	// kept for backwards compatibility
+	fs.StringVar(&cmd.inputs.Name, flagFunctionNameLegacy, "", flagFunctionNameUsage)
+	flags.MarkHidden(fs, flagFunctionNameLegacy)
+
+	fs.StringVar(&cmd.inputs.User, flagAsUserLegacy, "", flagAsUserUsage)
+	flags.MarkHidden(fs, flagAsUserLegacy)
 }
 
 // Inputs is the command inputs
@@ -84,39 +95,12 @@ func (cmd *CommandRun) Handler(profile *user.Profile, ui terminal.UI, clients cl
 		return err
 	}
 
-	args := make([]interface{}, 0, len(cmd.inputs.Args))
-	if cmd.inputs.Args != nil {
-		for _, arg := range cmd.inputs.Args {
-			if isJSON(arg) {
-				var argNew interface{}
-				if err := json.Unmarshal([]byte(arg), &argNew); err != nil {
-					return err
-				}
-				args = append(args, argNew)
-				continue
-			}
-			if isInt(arg) {
-				num, err := strconv.Atoi(arg)
-				if err != nil {
-					return err
-				}
-				args = append(args, num)
-				continue
-			}
-			if isFloat(arg) {
-				num, err := strconv.ParseFloat(arg, 64)
-				if err != nil {
-					return err
-				}
-				args = append(args, num)
-				continue
-			}
-			args = append(args, arg)
-		}
+	args, err := cmd.inputs.functionArgs()
+	if err != nil {
+		return err
 	}
 
-	s := spinner.New(terminal.SpinnerCircles, 250*time.Millisecond)
-	s.Suffix = fmt.Sprintf(" Running function %s with args %s...", cmd.inputs.Name, cmd.inputs.Args)
+	s := ui.Spinner(fmt.Sprintf("Running function %s...", function.Name))
 
 	runFunction := func() (realm.ExecutionResults, error) {
 		s.Start()
@@ -130,11 +114,18 @@ func (cmd *CommandRun) Handler(profile *user.Profile, ui terminal.UI, clients cl
 		return err
 	}
 	if response.Logs != nil {
-		ui.Print(terminal.NewListLog("Logs", response.Logs))
+		logs := make([]interface{}, 0, len(response.Logs))
+		for _, log := range response.Logs {
+			logs = append(logs, log)
+		}
+		ui.Print(terminal.NewListLog("Logs", logs...))
 	}
 	if response.ErrorLogs != nil {
 		ui.Print(terminal.NewJSONLog("Error Logs", response.ErrorLogs))
 	}
+	if response.Error != nil {
+		return fmt.Errorf("function '%s' failed: %s", function.Name, response.ErrorMessage())
+	}
 	ui.Print(terminal.NewJSONLog("Result", response.Result))
 
 	return nil
@@ -1,8 +1,9 @@
 package function
 
 import (
-	"errors"
+	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -13,44 +14,112 @@ import (
 )
 
 const (
-	flagFunctionName      = "function"
+	flagFunctionName      = "name"
 	flagFunctionNameUsage = "specify the function to run"
 
+	flagFunctionNameLegacy = "function"
+
 	flagFunctionArgs      = "args"
-	flagFunctionArgsUsage = "specify the args to pass to your function"
+	flagFunctionArgsUsage = "specify an arg to pass to your function (can be specified multiple times)"
+
+	flagFunctionArgsJSON      = "args-json"
+	flagFunctionArgsJSONUsage = `specify all the args to pass to your function as a JSON array, e.g. '[1,"a"]'`
+
+	flagAsUser      = "as-user"
+	flagAsUserUsage = "specify the id of the user to run the function as; defaults to system"
 
-	flagAsUser      = "user"
-	flagAsUserUsage = "specify the user to run the function as; defaults to system"
+	flagAsUserLegacy = "user"
 )
 
 type runInputs struct {
 	cli.ProjectInputs
-	Name string
-	Args []string
-	User string
+	Name     string
+	Args     []string
+	ArgsJSON string
+	User     string
 }
 
 func (i *runInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.ArgsJSON != "" {
+		if len(i.Args) > 0 {
+			return fmt.Errorf(`cannot use "--%s" with "--%s"`, flagFunctionArgs, flagFunctionArgsJSON)
+		}
+		if _, err := parseArgsJSON(i.ArgsJSON); err != nil {
+			return err
+		}
+	}
 	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
 }
 
+// functionArgs returns the args to run the function with, either parsed from the
+// JSON array or parsed individually from each provided arg
+func (i runInputs) functionArgs() ([]interface{}, error) {
+	if i.ArgsJSON != "" {
+		return parseArgsJSON(i.ArgsJSON)
+	}
+
+	args := make([]interface{}, 0, len(i.Args))
+	for _, arg := range i.Args {
+		if isJSON(arg) {
+			var argNew interface{}
+			if err := json.Unmarshal([]byte(arg), &argNew); err != nil {
+				return nil, err
+			}
+			args = append(args, argNew)
+			continue
+		}
+		if isInt(arg) {
+			num, err := strconv.Atoi(arg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, num)
+			continue
+		}
+		if isFloat(arg) {
+			num, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, num)
+			continue
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+func parseArgsJSON(data string) ([]interface{}, error) {
+	var args []interface{}
+	if err := json.Unmarshal([]byte(data), &args); err != nil {
+		return nil, fmt.Errorf(`"--%s" must be a JSON array of args: %w`, flagFunctionArgsJSON, err)
+	}
+	return args, nil
+}
+
 func (i *runInputs) resolveFunction(ui terminal.UI, client realm.Client, groupID, appID string) (realm.Function, error) {
+	return resolveFunction(ui, client, groupID, appID, i.Name, "run")
+}
+
+// resolveFunction finds the named function of the app, or prompts
+// for the function to perform the provided action on
+func resolveFunction(ui terminal.UI, client realm.Client, groupID, appID, name, action string) (realm.Function, error) {
 	functions, err := client.Functions(groupID, appID)
 	if err != nil {
 		return realm.Function{}, err
 	}
 
 	if len(functions) == 0 {
-		return realm.Function{}, errors.New("no functions available to run")
+		return realm.Function{}, fmt.Errorf("no functions available to %s", action)
 	}
 
-	if i.Name != "" {
+	if name != "" {
 		for _, function := range functions {
-			if function.Name == i.Name {
+			if function.Name == name {
 				return function, nil
 			}
 		}
-		return realm.Function{}, fmt.Errorf("failed to find function '%s'", i.Name)
+		return realm.Function{}, fmt.Errorf("failed to find function '%s'", name)
 	}
 
 	if len(functions) == 1 {
@@ -118,3 +118,30 @@ func TestFunctionRunInputsResolveFunction(t *testing.T) {
 		assert.Equal(t, realm.Function{Name: "func2"}, fn)
 	})
 }
+
+func TestFunctionRunInputsResolve(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      runInputs
+		expectedErr string
+	}{
+		{
+			description: "should return an error when both args and args json are set",
+			inputs:      runInputs{Args: []string{"1"}, ArgsJSON: "[1]"},
+			expectedErr: `cannot use "--args" with "--args-json"`,
+		},
+		{
+			description: "should return an error when args json is not an array",
+			inputs:      runInputs{ArgsJSON: `{"a":1}`},
+			expectedErr: `"--args-json" must be a JSON array of args: json: cannot unmarshal object into Go value of type []interface {}`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+
+			err := tc.inputs.Resolve(profile, nil)
+			assert.NotNil(t, err)
+			assert.Equal(t, tc.expectedErr, err.Error())
+		})
+	}
+}
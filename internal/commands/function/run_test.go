@@ -140,6 +140,77 @@ func TestFunctionHandler(t *testing.T) {
 		assert.Equal(t, display, out.String())
 	})
 
+	t.Run("should run the function with the args json as the user", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		rc := mock.RealmClient{}
+		rc.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", Name: "test-app"}}, nil
+		}
+		rc.FunctionsFn = func(groupID, appID string) ([]realm.Function, error) {
+			return []realm.Function{{Name: "test"}}, nil
+		}
+
+		var capturedUserID string
+		var capturedArgs []interface{}
+		rc.AppDebugExecuteFunctionFn = func(groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error) {
+			capturedUserID = userID
+			capturedArgs = args
+			return realm.ExecutionResults{Result: "ok", Logs: []string{"running"}}, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &CommandRun{runInputs{
+			ProjectInputs: cli.ProjectInputs{
+				Project: "test-project",
+				App:     "test-app",
+			},
+			Name:     "test",
+			ArgsJSON: `[1,"a"]`,
+			User:     "userID",
+		}}
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc}))
+
+		assert.Equal(t, "Logs\n  running\nResult\n\"ok\"\n", out.String())
+		assert.Equal(t, "userID", capturedUserID)
+		assert.Equal(t, []interface{}{1.0, "a"}, capturedArgs)
+	})
+
+	t.Run("should print the logs and return the error the function failed with", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		rc := mock.RealmClient{}
+		rc.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", Name: "test-app"}}, nil
+		}
+		rc.FunctionsFn = func(groupID, appID string) ([]realm.Function, error) {
+			return []realm.Function{{Name: "test"}}, nil
+		}
+		rc.AppDebugExecuteFunctionFn = func(groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error) {
+			return realm.ExecutionResults{
+				Logs:  []string{"running"},
+				Error: map[string]interface{}{"message": "'foo' is not defined", "name": "ReferenceError"},
+			}, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &CommandRun{runInputs{
+			ProjectInputs: cli.ProjectInputs{
+				Project: "test-project",
+				App:     "test-app",
+			},
+			Name: "test",
+		}}
+
+		err := cmd.Handler(profile, ui, cli.Clients{Realm: rc})
+		assert.Equal(t, errors.New("function 'test' failed: 'foo' is not defined"), err)
+		assert.Equal(t, "Logs\n  running\n", out.String())
+	})
+
 	for _, tc := range []struct {
 		description   string
 		realmClient   realm.Client
@@ -184,6 +255,7 @@ func TestFunctionHandler(t *testing.T) {
 	} {
 		t.Run(tc.description, func(t *testing.T) {
 			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
 
 			clients := cli.Clients{Realm: tc.realmClient}
 
@@ -195,7 +267,7 @@ func TestFunctionHandler(t *testing.T) {
 				Name: "test",
 				Args: []string{"Hello world"},
 			}}
-			assert.Equal(t, tc.errorExpected, cmd.Handler(profile, nil, clients))
+			assert.Equal(t, tc.errorExpected, cmd.Handler(profile, ui, clients))
 		})
 	}
 }
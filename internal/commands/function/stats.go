@@ -0,0 +1,207 @@
+package function
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagStatsFunctionNameUsage = "specify the function to summarize"
+
+	flagWindow      = "window"
+	flagWindowUsage = "specify how far back to summarize executions from, e.g. 30m, 6h or 168h"
+
+	defaultStatsWindow = 24 * time.Hour
+
+	// maxTopErrors is the number of distinct error messages reported
+	maxTopErrors = 5
+)
+
+// statsLogTypes are the log types which record a function execution
+var statsLogTypes = []string{
+	realm.LogTypeFunction,
+	realm.LogTypeAuthTrigger,
+	realm.LogTypeDBTrigger,
+	realm.LogTypeScheduledTrigger,
+	realm.LogTypeWebhook,
+}
+
+// CommandMetaStats is the command meta for the `function stats` command
+var CommandMetaStats = cli.CommandMeta{
+	Use:         "stats",
+	Display:     "function stats",
+	Description: "Summarize the recent executions of a Function from your Realm app",
+	HelpText: `Summarizes the executions of a Function recorded in your Realm app's logs over
+the specified window, including executions by Triggers and Webhooks. The report
+displays the number of executions, the error rate, the average and maximum
+execution duration and the most common error messages.
+
+The summary is computed from a sample of the most recent logs returned by the
+Realm server, so busy Functions may report fewer executions than have run.`,
+}
+
+// CommandStats is the `function stats` command
+type CommandStats struct {
+	inputs statsInputs
+}
+
+type statsInputs struct {
+	cli.ProjectInputs
+	Name   string
+	Window time.Duration
+}
+
+// Flags is the command flags
+func (cmd *CommandStats) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.Name, flagFunctionName, "", flagStatsFunctionNameUsage)
+	fs.DurationVar(&cmd.inputs.Window, flagWindow, defaultStatsWindow, flagWindowUsage)
+
+	// kept for backwards compatibility
+	fs.StringVar(&cmd.inputs.Name, flagFunctionNameLegacy, "", flagStatsFunctionNameUsage)
+	flags.MarkHidden(fs, flagFunctionNameLegacy)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandStats) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandStats) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	function, err := resolveFunction(ui, clients.Realm, app.GroupID, app.ID, cmd.inputs.Name, "summarize")
+	if err != nil {
+		return err
+	}
+
+	logs, err := clients.Realm.Logs(app.GroupID, app.ID, realm.LogsOptions{
+		Types: statsLogTypes,
+		Start: time.Now().Add(-cmd.inputs.Window),
+	})
+	if err != nil {
+		return err
+	}
+
+	stats := newFunctionStats(function, logs)
+	if stats.executions == 0 {
+		ui.Print(terminal.NewTextLog("No executions of function '%s' found in the last %s", function.Name, cmd.inputs.Window))
+		return nil
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Executions of function '%s' in the last %s", function.Name, cmd.inputs.Window),
+		[]string{headerExecutions, headerErrors, headerErrorRate, headerAvgDuration, headerMaxDuration},
+		map[string]interface{}{
+			headerExecutions:  stats.executions,
+			headerErrors:      stats.errors,
+			headerErrorRate:   fmt.Sprintf("%.1f%%", 100*float64(stats.errors)/float64(stats.executions)),
+			headerAvgDuration: displayDuration(stats.totalDuration / time.Duration(stats.executions)),
+			headerMaxDuration: displayDuration(stats.maxDuration),
+		},
+	))
+
+	if len(stats.topErrors) > 0 {
+		rows := make([]map[string]interface{}, 0, len(stats.topErrors))
+		for _, e := range stats.topErrors {
+			rows = append(rows, map[string]interface{}{headerCount: e.count, headerError: e.message})
+		}
+		ui.Print(terminal.NewTableLog("Top errors", []string{headerCount, headerError}, rows...))
+	}
+	return nil
+}
+
+func (i *statsInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Window <= 0 {
+		return errors.New("window must be a positive duration")
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
+}
+
+const (
+	headerExecutions  = "Executions"
+	headerErrors      = "Errors"
+	headerErrorRate   = "Error Rate"
+	headerAvgDuration = "Avg Duration"
+	headerMaxDuration = "Max Duration"
+	headerCount       = "Count"
+	headerError       = "Error"
+)
+
+type functionStats struct {
+	executions    int
+	errors        int
+	totalDuration time.Duration
+	maxDuration   time.Duration
+	topErrors     []errorCount
+}
+
+type errorCount struct {
+	message string
+	count   int
+}
+
+func newFunctionStats(function realm.Function, logs realm.Logs) functionStats {
+	var stats functionStats
+
+	errorCounts := map[string]int{}
+	for _, log := range logs {
+		if !isFunctionLog(log, function) {
+			continue
+		}
+
+		stats.executions++
+
+		if !log.Completed.IsZero() {
+			duration := log.Completed.Sub(log.Started)
+			stats.totalDuration += duration
+			if duration > stats.maxDuration {
+				stats.maxDuration = duration
+			}
+		}
+
+		if log.Error != "" {
+			stats.errors++
+			errorCounts[log.Error]++
+		}
+	}
+
+	for message, count := range errorCounts {
+		stats.topErrors = append(stats.topErrors, errorCount{message, count})
+	}
+	sort.Slice(stats.topErrors, func(i, j int) bool {
+		if stats.topErrors[i].count != stats.topErrors[j].count {
+			return stats.topErrors[i].count > stats.topErrors[j].count
+		}
+		return stats.topErrors[i].message < stats.topErrors[j].message
+	})
+	if len(stats.topErrors) > maxTopErrors {
+		stats.topErrors = stats.topErrors[:maxTopErrors]
+	}
+
+	return stats
+}
+
+// isFunctionLog reports whether the log records an execution of the function
+func isFunctionLog(log realm.Log, function realm.Function) bool {
+	return log.FunctionName == function.Name || (log.FunctionID != "" && log.FunctionID == function.ID)
+}
+
+func displayDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
@@ -0,0 +1,119 @@
+package function
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestFunctionStatsHandler(t *testing.T) {
+	started := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	newLog := func(functionName string, duration time.Duration, err string) realm.Log {
+		return realm.Log{
+			Type:         realm.LogTypeFunction,
+			FunctionName: functionName,
+			Started:      started,
+			Completed:    started.Add(duration),
+			Error:        err,
+		}
+	}
+
+	newClient := func(logs realm.Logs) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", Name: "test-app"}}, nil
+		}
+		realmClient.FunctionsFn = func(groupID, appID string) ([]realm.Function, error) {
+			return []realm.Function{{ID: "fnID", Name: "sum"}, {ID: "otherID", Name: "other"}}, nil
+		}
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			return logs, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should summarize the executions of the function", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		triggerLog := newLog("", 400*time.Millisecond, "")
+		triggerLog.Type = realm.LogTypeDBTrigger
+		triggerLog.FunctionID = "fnID"
+
+		realmClient := newClient(realm.Logs{
+			newLog("sum", 100*time.Millisecond, ""),
+			newLog("sum", 200*time.Millisecond, "TypeError: x is undefined"),
+			newLog("sum", 300*time.Millisecond, "TypeError: x is undefined"),
+			newLog("sum", 1200*time.Millisecond, "execution time limit exceeded"),
+			newLog("other", 5*time.Second, "ignored"),
+			triggerLog,
+		})
+
+		var capturedOpts realm.LogsOptions
+		logsFn := realmClient.LogsFn
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			capturedOpts = opts
+			return logsFn(groupID, appID, opts)
+		}
+
+		cmd := &CommandStats{statsInputs{
+			ProjectInputs: cli.ProjectInputs{Project: "groupID", App: "test-app"},
+			Name:          "sum",
+			Window:        6 * time.Hour,
+		}}
+
+		before := time.Now()
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.Equal(t, statsLogTypes, capturedOpts.Types)
+		assert.True(t, !capturedOpts.Start.Before(before.Add(-6*time.Hour)), "expected logs to start within the window")
+
+		assert.Equal(t, strings.Join([]string{
+			"Executions of function 'sum' in the last 6h0m0s",
+			"  Executions  Errors  Error Rate  Avg Duration  Max Duration",
+			"  ----------  ------  ----------  ------------  ------------",
+			"  5           3       60.0%       440ms         1.2s        ",
+			"Top errors",
+			"  Count  Error                        ",
+			"  -----  -----------------------------",
+			"  2      TypeError: x is undefined    ",
+			"  1      execution time limit exceeded",
+			"",
+		}, "\n"), out.String())
+	})
+
+	t.Run("should print a message when the function has no executions", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandStats{statsInputs{Name: "sum", Window: time.Hour}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: newClient(realm.Logs{newLog("other", time.Second, "")})}))
+		assert.Equal(t, "No executions of function 'sum' found in the last 1h0m0s\n", out.String())
+	})
+
+	t.Run("should return an error when the logs fail to be found", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newClient(nil)
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandStats{statsInputs{Name: "sum", Window: time.Hour}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
+
+func TestFunctionStatsInputs(t *testing.T) {
+	t.Run("should return an error when the window is not positive", func(t *testing.T) {
+		i := statsInputs{Window: -time.Hour}
+		assert.Equal(t, errors.New("window must be a positive duration"), i.Resolve(nil, nil))
+	})
+}
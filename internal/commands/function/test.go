@@ -0,0 +1,189 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaTest is the command meta for the `function test` command
+var CommandMetaTest = cli.CommandMeta{
+	Use:         "test",
+	Display:     "function test",
+	Description: "Test the Functions in your local Realm app directory",
+	HelpText: `Runs the test cases in each JSON file of the "tests" directory of your local
+Realm app against your local Function sources, without pushing your app. Each
+file specifies the "function" it tests and its "cases", where each case has
+the "args" to run the Function with and either the "result" or the "error" it
+is expected to produce.
+
+The Function's context is mocked: "values" are returned by context.values.get,
+"services" map the dotted path of a service call, e.g. "db.collection.findOne",
+to its result, and "functions" are returned by context.functions.execute, which
+otherwise runs your local Function. Mocks specified for the file apply to each
+of its cases.
+
+The tests are run with the JavaScript runtime bundled with the CLI, so Node.js
+is not required. Functions can require the modules installed in the
+"node_modules" directory of your "functions" directory, but not the modules
+built into Node.js, such as "fs" or "crypto".`,
+}
+
+// CommandTest is the `function test` command
+type CommandTest struct {
+	inputs testInputs
+}
+
+const (
+	flagLocalPathTest      = "local"
+	flagLocalPathTestUsage = "the local path to the Realm app to test the functions of"
+
+	flagFunctionNameTestUsage = "specify the function to run the tests of"
+
+	headerFunction = "Function"
+	headerTest     = "Test"
+	headerFile     = "File"
+	headerResult   = "Result"
+
+	testResultPassed = "passed"
+	testResultFailed = "failed"
+)
+
+// newFunctionTestRunner creates the runner which runs the function tests
+var newFunctionTestRunner = local.NewFunctionTestRunner
+
+type testInputs struct {
+	LocalPath string
+	Name      string
+}
+
+// Flags is the command flags
+func (cmd *CommandTest) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathTest, "", flagLocalPathTestUsage)
+	fs.StringVar(&cmd.inputs.Name, flagFunctionName, "", flagFunctionNameTestUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandTest) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandTest) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	allTests, err := local.LoadFunctionTests(app)
+	if err != nil {
+		return err
+	}
+
+	tests := make([]local.FunctionTest, 0, len(allTests))
+	for _, test := range allTests {
+		if cmd.inputs.Name == "" || test.Function == cmd.inputs.Name {
+			tests = append(tests, test)
+		}
+	}
+
+	if len(tests) == 0 {
+		ui.Print(terminal.NewTextLog("No function tests found in %s", filepath.Join(app.RootDir, local.NameTests)))
+		return nil
+	}
+
+	sources, err := local.FunctionSources(app)
+	if err != nil {
+		return err
+	}
+
+	runner := newFunctionTestRunner()
+
+	s := ui.Spinner("Running function tests...")
+
+	runTests := func() ([]local.FunctionTestResult, error) {
+		s.Start()
+		defer s.Stop()
+
+		return runner.Run(context.Background(), filepath.Join(app.RootDir, local.NameFunctions), sources, tests)
+	}
+
+	results, err := runTests()
+	if err != nil {
+		return err
+	}
+
+	rows := make([]map[string]interface{}, 0, len(results))
+	var failures []terminal.Log
+	for _, result := range results {
+		status := testResultPassed
+		if failure := result.Failure(); failure != "" {
+			status = testResultFailed
+
+			details := []interface{}{failure}
+			for _, log := range result.Logs {
+				details = append(details, "console: "+log)
+			}
+			failures = append(failures, terminal.NewListLog(
+				fmt.Sprintf("%s: %s (%s)", result.Test.Function, result.Test.Name, result.Test.File),
+				details...,
+			))
+		}
+
+		rows = append(rows, map[string]interface{}{
+			headerFunction: result.Test.Function,
+			headerTest:     result.Test.Name,
+			headerFile:     result.Test.File,
+			headerResult:   status,
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Ran %d function test(s)", len(results)),
+		[]string{headerFunction, headerTest, headerFile, headerResult},
+		rows...,
+	))
+	ui.Print(failures...)
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%d of %d function test(s) failed", len(failures), len(results))
+	}
+	return nil
+}
+
+func (i *testInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	searchPath := i.LocalPath
+	if searchPath == "" {
+		searchPath = profile.WorkingDirectory
+	}
+
+	app, err := local.LoadAppConfig(searchPath)
+	if err != nil {
+		return err
+	}
+
+	if i.LocalPath == "" && app.RootDir == "" {
+		if err := ui.AskOne(&i.LocalPath, &survey.Input{Message: "App filepath (local)"}); err != nil {
+			return err
+		}
+
+		app, err = local.LoadAppConfig(i.LocalPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if app.RootDir != "" {
+		i.LocalPath = app.RootDir
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package function
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+type mockFunctionTestRunner struct {
+	run func(dir string, sources map[string]string, tests []local.FunctionTest) ([]local.FunctionTestResult, error)
+}
+
+func (r mockFunctionTestRunner) Run(ctx context.Context, dir string, sources map[string]string, tests []local.FunctionTest) ([]local.FunctionTestResult, error) {
+	return r.run(dir, sources, tests)
+}
+
+func TestFunctionTestHandler(t *testing.T) {
+	setup := func(t *testing.T, fixtures map[string]string) (string, func()) {
+		t.Helper()
+
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+
+		app := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+		assert.Nil(t, local.AddFunction(app.AppData, "sum", false))
+		assert.Nil(t, app.Write())
+
+		for name, fixture := range fixtures {
+			assert.Nil(t, local.WriteFile(filepath.Join(dir, local.NameTests, name), 0666, strings.NewReader(fixture)))
+		}
+		return dir, teardown
+	}
+
+	mockRunner := func(t *testing.T, run func(dir string, sources map[string]string, tests []local.FunctionTest) ([]local.FunctionTestResult, error)) func() {
+		t.Helper()
+
+		original := newFunctionTestRunner
+		newFunctionTestRunner = func() local.FunctionTestRunner {
+			return mockFunctionTestRunner{run}
+		}
+		return func() { newFunctionTestRunner = original }
+	}
+
+	sumFixture := `{
+  "function": "sum",
+  "cases": [
+    { "name": "adds", "args": [1, 2], "result": 3 },
+    { "name": "adds negatives", "args": [-1, -2], "result": -3 }
+  ]
+}`
+
+	t.Run("should run the function tests and print their results", func(t *testing.T) {
+		dir, teardown := setup(t, map[string]string{"sum.json": sumFixture})
+		defer teardown()
+
+		var runDir string
+		var runSources map[string]string
+		defer mockRunner(t, func(dir string, sources map[string]string, tests []local.FunctionTest) ([]local.FunctionTestResult, error) {
+			runDir, runSources = dir, sources
+			return []local.FunctionTestResult{
+				{Test: tests[0], Result: 3.0},
+				{Test: tests[1], Result: -3.0},
+			}, nil
+		})()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandTest{testInputs{LocalPath: dir}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, `Ran 2 function test(s)
+  Function  Test            File            Result
+  --------  --------------  --------------  ------
+  sum       adds            tests/sum.json  passed
+  sum       adds negatives  tests/sum.json  passed
+`, out.String())
+
+		assert.Equal(t, filepath.Join(dir, local.NameFunctions), runDir)
+		assert.Equal(t, map[string]string{"sum": local.FunctionSourceStub}, runSources)
+	})
+
+	t.Run("should print the failures and return an error when a function test fails", func(t *testing.T) {
+		dir, teardown := setup(t, map[string]string{"sum.json": sumFixture})
+		defer teardown()
+
+		defer mockRunner(t, func(dir string, sources map[string]string, tests []local.FunctionTest) ([]local.FunctionTestResult, error) {
+			return []local.FunctionTestResult{
+				{Test: tests[0], Result: 3.0},
+				{Test: tests[1], Result: 3.0, Logs: []string{"adding -1 and -2"}},
+			}, nil
+		})()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandTest{testInputs{LocalPath: dir}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{})
+		assert.Equal(t, errors.New("1 of 2 function test(s) failed"), err)
+		assert.Equal(t, `Ran 2 function test(s)
+  Function  Test            File            Result
+  --------  --------------  --------------  ------
+  sum       adds            tests/sum.json  passed
+  sum       adds negatives  tests/sum.json  failed
+sum: adds negatives (tests/sum.json)
+  expected the result -3, but got 3
+  console: adding -1 and -2
+`, out.String())
+	})
+
+	t.Run("should only run the tests of the specified function", func(t *testing.T) {
+		dir, teardown := setup(t, map[string]string{
+			"sum.json":   sumFixture,
+			"other.json": `{"function": "other", "cases": [{"name": "other"}]}`,
+		})
+		defer teardown()
+
+		var runTests []local.FunctionTest
+		defer mockRunner(t, func(dir string, sources map[string]string, tests []local.FunctionTest) ([]local.FunctionTestResult, error) {
+			runTests = tests
+			return []local.FunctionTestResult{{Test: tests[0], Result: 3.0}, {Test: tests[1], Result: -3.0}}, nil
+		})()
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandTest{testInputs{LocalPath: dir, Name: "sum"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, 2, len(runTests))
+		for _, test := range runTests {
+			assert.Equal(t, "sum", test.Function)
+		}
+	})
+
+	t.Run("should print a message when there are no function tests", func(t *testing.T) {
+		dir, teardown := setup(t, nil)
+		defer teardown()
+
+		defer mockRunner(t, func(dir string, sources map[string]string, tests []local.FunctionTest) ([]local.FunctionTestResult, error) {
+			t.Fatal("the runner should not run without tests")
+			return nil, nil
+		})()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandTest{testInputs{LocalPath: dir}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, "No function tests found in "+filepath.Join(dir, local.NameTests)+"\n", out.String())
+	})
+
+	t.Run("should return an error when the function tests fail to run", func(t *testing.T) {
+		dir, teardown := setup(t, map[string]string{"sum.json": sumFixture})
+		defer teardown()
+
+		defer mockRunner(t, func(dir string, sources map[string]string, tests []local.FunctionTest) ([]local.FunctionTestResult, error) {
+			return nil, errors.New("something bad happened")
+		})()
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandTest{testInputs{LocalPath: dir}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{}))
+	})
+}
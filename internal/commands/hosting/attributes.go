@@ -0,0 +1,152 @@
+package hosting
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagAttributesPath      = "path"
+	flagAttributesPathUsage = `specify the path of the hosting files, which may be a gitignore-style pattern such as "/downloads/*"`
+
+	flagAttr = "attr"
+
+	headerPath  = "Path"
+	headerName  = "Name"
+	headerValue = "Value"
+)
+
+// attributesInputs are the inputs shared by the `hosting attributes` commands
+type attributesInputs struct {
+	cli.ProjectInputs
+	Path  string
+	Attrs []string
+}
+
+func (i *attributesInputs) Flags(fs *pflag.FlagSet, attrUsage string) {
+	i.ProjectInputs.Flags(fs)
+
+	fs.StringVar(&i.Path, flagAttributesPath, "", flagAttributesPathUsage)
+	fs.StringSliceVar(&i.Attrs, flagAttr, nil, attrUsage)
+}
+
+func (i *attributesInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// matchingFiles returns the hosting files which match the path, sorted by their paths
+func (i attributesInputs) matchingFiles(appAssets []realm.HostingAsset) []realm.HostingAsset {
+	files := hostingFiles(appAssets)
+
+	matches := make([]realm.HostingAsset, 0, len(files))
+	for _, file := range files {
+		if i.Path == "" || local.MatchHostingPath(i.Path, file.FilePath) {
+			matches = append(matches, file)
+		}
+	}
+
+	sort.Slice(matches, func(a, b int) bool { return matches[a].FilePath < matches[b].FilePath })
+	return matches
+}
+
+// parseAttribute parses an attribute in the form of "Name: Value"
+func parseAttribute(attr string) (realm.HostingAssetAttribute, error) {
+	parts := strings.SplitN(attr, ":", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+		return realm.HostingAssetAttribute{}, fmt.Errorf(`invalid attribute '%s', must be in the form of "Name: Value"`, attr)
+	}
+
+	name, err := parseAttributeName(parts[0])
+	if err != nil {
+		return realm.HostingAssetAttribute{}, err
+	}
+	return realm.HostingAssetAttribute{Name: name, Value: strings.TrimSpace(parts[1])}, nil
+}
+
+func parseAttributeName(name string) (string, error) {
+	canonical, ok := local.ValidHostingAttributeName(name)
+	if !ok {
+		return "", fmt.Errorf("unsupported attribute '%s', must be one of: %s", strings.TrimSpace(name), strings.Join(local.HostingAttributeNames(), ", "))
+	}
+	return canonical, nil
+}
+
+// updateAttributes updates the attributes of each hosting file whose attributes have changed,
+// where update receives the sorted attributes of each file and returns them sorted,
+// and then updates the metadata file of the local Realm app found in the working directory to match
+func updateAttributes(
+	profile *user.Profile,
+	ui terminal.UI,
+	realmClient realm.Client,
+	app realm.App,
+	appAssets []realm.HostingAsset,
+	files []realm.HostingAsset,
+	update func(attrs realm.HostingAssetAttributes) realm.HostingAssetAttributes,
+) error {
+	updatedAttrs := make(map[string]realm.HostingAssetAttributes, len(files))
+	for _, file := range files {
+		current := append(realm.HostingAssetAttributes{}, file.Attrs...)
+		sort.Sort(current)
+
+		attrs := update(current)
+		if attributesEqual(current, attrs) {
+			continue
+		}
+
+		if err := realmClient.HostingAssetAttributesUpdate(app.GroupID, app.ID, file.FilePath, attrs...); err != nil {
+			return fmt.Errorf("failed to update attributes for %s: %w", file.FilePath, err)
+		}
+		updatedAttrs[file.FilePath] = attrs
+	}
+
+	if len(updatedAttrs) == 0 {
+		ui.Print(terminal.NewTextLog("The attributes of the matching hosting file(s) of app %s are already up to date", app.ClientAppID))
+		return nil
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully updated the attributes of %d hosting file(s) of app %s", len(updatedAttrs), app.ClientAppID))
+
+	localApp, ok, err := local.FindApp(profile.WorkingDirectory)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	assets := make([]realm.HostingAsset, 0, len(appAssets))
+	for _, appAsset := range appAssets {
+		if attrs, ok := updatedAttrs[appAsset.FilePath]; ok {
+			appAsset.Attrs = attrs
+		}
+		assets = append(assets, appAsset)
+	}
+
+	if err := local.WriteHostingMetadata(localApp.RootDir, assets); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Updated the hosting metadata of the local app at %s to match", localApp.RootDir))
+	return nil
+}
+
+func attributesEqual(a, b realm.HostingAssetAttributes) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
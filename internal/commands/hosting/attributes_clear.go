@@ -0,0 +1,109 @@
+package hosting
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/api"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagAttrClearUsage = `specify the name(s) of the attribute(s) to clear (default: every attribute other than "Content-Type")`
+)
+
+// CommandMetaAttributesClear is the command meta for the `hosting attributes clear` command
+var CommandMetaAttributesClear = cli.CommandMeta{
+	Use:         "clear",
+	Display:     "hosting attributes clear",
+	Description: "Clear attributes from the hosting files of your Realm app",
+	HelpText: `Removes the attributes named with "--attr" from the hosting files of your Realm
+app matching "--path". Without "--attr", every attribute other than
+"Content-Type" is removed.
+
+When run from within a local Realm app directory, "hosting/metadata.json" is
+updated to match, so the attributes stay cleared the next time you push.`,
+}
+
+// CommandAttributesClear is the `hosting attributes clear` command
+type CommandAttributesClear struct {
+	inputs attributesClearInputs
+}
+
+type attributesClearInputs struct {
+	attributesInputs
+	names []string
+}
+
+// Flags is the command flags
+func (cmd *CommandAttributesClear) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs, flagAttrClearUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandAttributesClear) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandAttributesClear) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	appAssets, err := clients.Realm.HostingAssets(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	files := cmd.inputs.matchingFiles(appAssets)
+	if len(files) == 0 {
+		return fmt.Errorf("no hosting files of app %s match the path '%s'", app.ClientAppID, cmd.inputs.Path)
+	}
+
+	return updateAttributes(profile, ui, clients.Realm, app, appAssets, files, func(attrs realm.HostingAssetAttributes) realm.HostingAssetAttributes {
+		updated := make(realm.HostingAssetAttributes, 0, len(attrs))
+		for _, attr := range attrs {
+			if !cmd.inputs.clears(attr.Name) {
+				updated = append(updated, attr)
+			}
+		}
+		sort.Sort(updated)
+		return updated
+	})
+}
+
+func (i *attributesClearInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Path == "" {
+		return fmt.Errorf(`must specify the path of the hosting files with "--%s"`, flagAttributesPath)
+	}
+
+	i.names = make([]string, 0, len(i.Attrs))
+	for _, attr := range i.Attrs {
+		name, err := parseAttributeName(attr)
+		if err != nil {
+			return err
+		}
+		i.names = append(i.names, name)
+	}
+
+	return i.attributesInputs.Resolve(profile, ui)
+}
+
+func (i attributesClearInputs) clears(name string) bool {
+	if len(i.names) == 0 {
+		return name != api.HeaderContentType
+	}
+	for _, n := range i.names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package hosting
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaAttributesList is the command meta for the `hosting attributes list` command
+var CommandMetaAttributesList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "hosting attributes list",
+	Description: "List the attributes of the hosting files of your Realm app",
+	HelpText: `Lists the attributes, such as "Cache-Control" or "Content-Disposition", which
+are sent as headers with the hosting files of your Realm app. Use "--path" to
+only list the attributes of the matching hosting files.`,
+}
+
+// CommandAttributesList is the `hosting attributes list` command
+type CommandAttributesList struct {
+	inputs attributesInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandAttributesList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.ProjectInputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.Path, flagAttributesPath, "", flagAttributesPathUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandAttributesList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandAttributesList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	appAssets, err := clients.Realm.HostingAssets(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	var rows []map[string]interface{}
+	for _, file := range cmd.inputs.matchingFiles(appAssets) {
+		for _, attr := range file.Attrs {
+			rows = append(rows, map[string]interface{}{
+				headerPath:  file.FilePath,
+				headerName:  attr.Name,
+				headerValue: attr.Value,
+			})
+		}
+	}
+
+	if len(rows) == 0 {
+		ui.Print(terminal.NewTextLog("No hosting file attributes found for app %s", app.ClientAppID))
+		return nil
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d hosting file attribute(s) for app %s", len(rows), app.ClientAppID),
+		[]string{headerPath, headerName, headerValue},
+		rows...,
+	))
+	return nil
+}
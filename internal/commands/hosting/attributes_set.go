@@ -0,0 +1,113 @@
+package hosting
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagAttrSetUsage = `specify the attribute(s) to set in the form of "Name: Value", e.g. "Content-Disposition: attachment"`
+)
+
+// CommandMetaAttributesSet is the command meta for the `hosting attributes set` command
+var CommandMetaAttributesSet = cli.CommandMeta{
+	Use:         "set",
+	Display:     "hosting attributes set",
+	Description: "Set attributes on the hosting files of your Realm app",
+	HelpText: `Sets the attributes, which are sent as headers, of the hosting files of your
+Realm app matching "--path". Any existing attribute with the same name is
+replaced, and the other attributes of the files are left as they are.
+
+When run from within a local Realm app directory, "hosting/metadata.json" is
+updated to match, so the attributes are kept the next time you push.`,
+}
+
+// CommandAttributesSet is the `hosting attributes set` command
+type CommandAttributesSet struct {
+	inputs attributesSetInputs
+}
+
+type attributesSetInputs struct {
+	attributesInputs
+	attrs realm.HostingAssetAttributes
+}
+
+// Flags is the command flags
+func (cmd *CommandAttributesSet) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs, flagAttrSetUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandAttributesSet) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandAttributesSet) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	appAssets, err := clients.Realm.HostingAssets(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	files := cmd.inputs.matchingFiles(appAssets)
+	if len(files) == 0 {
+		return fmt.Errorf("no hosting files of app %s match the path '%s'", app.ClientAppID, cmd.inputs.Path)
+	}
+
+	return updateAttributes(profile, ui, clients.Realm, app, appAssets, files, func(attrs realm.HostingAssetAttributes) realm.HostingAssetAttributes {
+		updated := make(realm.HostingAssetAttributes, 0, len(attrs)+len(cmd.inputs.attrs))
+		for _, attr := range attrs {
+			if !hasAttribute(cmd.inputs.attrs, attr.Name) {
+				updated = append(updated, attr)
+			}
+		}
+		updated = append(updated, cmd.inputs.attrs...)
+		sort.Sort(updated)
+		return updated
+	})
+}
+
+func (i *attributesSetInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Path == "" {
+		return fmt.Errorf(`must specify the path of the hosting files with "--%s"`, flagAttributesPath)
+	}
+	if len(i.Attrs) == 0 {
+		return errors.New(`must specify at least one attribute to set with "--` + flagAttr + `"`)
+	}
+
+	i.attrs = make(realm.HostingAssetAttributes, 0, len(i.Attrs))
+	for _, a := range i.Attrs {
+		attr, err := parseAttribute(a)
+		if err != nil {
+			return err
+		}
+		if hasAttribute(i.attrs, attr.Name) {
+			return fmt.Errorf("attribute '%s' is specified more than once", attr.Name)
+		}
+		i.attrs = append(i.attrs, attr)
+	}
+
+	return i.attributesInputs.Resolve(profile, ui)
+}
+
+func hasAttribute(attrs realm.HostingAssetAttributes, name string) bool {
+	for _, attr := range attrs {
+		if attr.Name == name {
+			return true
+		}
+	}
+	return false
+}
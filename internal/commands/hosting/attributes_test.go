@@ -0,0 +1,259 @@
+package hosting
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestHostingAttributesHandlers(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	appAssets := []realm.HostingAsset{
+		{
+			HostingAssetData: realm.HostingAssetData{FilePath: "/index.html"},
+			Attrs:            realm.HostingAssetAttributes{{Name: "Content-Type", Value: "text/html"}},
+		},
+		{HostingAssetData: realm.HostingAssetData{FilePath: "/downloads/"}},
+		{
+			HostingAssetData: realm.HostingAssetData{FilePath: "/downloads/report.pdf"},
+			Attrs: realm.HostingAssetAttributes{
+				{Name: "Content-Type", Value: "application/pdf"},
+				{Name: "Cache-Control", Value: "no-cache"},
+			},
+		},
+		{
+			HostingAssetData: realm.HostingAssetData{FilePath: "/downloads/notes.txt"},
+			Attrs:            realm.HostingAssetAttributes{{Name: "Content-Type", Value: "text/plain"}},
+		},
+	}
+
+	newRealmClient := func() (mock.RealmClient, map[string]realm.HostingAssetAttributes) {
+		updates := map[string]realm.HostingAssetAttributes{}
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.HostingAssetsFn = func(groupID, appID string) ([]realm.HostingAsset, error) {
+			return appAssets, nil
+		}
+		realmClient.HostingAssetAttributesUpdateFn = func(groupID, appID, path string, attrs ...realm.HostingAssetAttribute) error {
+			updates[path] = attrs
+			return nil
+		}
+		return realmClient, updates
+	}
+
+	t.Run("list should print the attributes of the matching hosting files", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, _ := newRealmClient()
+
+		cmd := &CommandAttributesList{attributesInputs{Path: "/downloads/*"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Found 3 hosting file attribute(s) for app eggcorn-abcde
+  Path                   Name           Value          
+  ---------------------  -------------  ---------------
+  /downloads/notes.txt   Content-Type   text/plain     
+  /downloads/report.pdf  Content-Type   application/pdf
+  /downloads/report.pdf  Cache-Control  no-cache       
+`, out.String())
+	})
+
+	t.Run("set should update the attributes of the matching hosting files", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "hosting_attributes_test")
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		realmClient, updates := newRealmClient()
+
+		cmd := &CommandAttributesSet{attributesSetInputs{
+			attributesInputs: attributesInputs{Path: "/downloads/*"},
+			attrs: realm.HostingAssetAttributes{
+				{Name: "Cache-Control", Value: "max-age=60"},
+				{Name: "Content-Disposition", Value: "attachment"},
+			},
+		}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully updated the attributes of 2 hosting file(s) of app eggcorn-abcde\n", out.String())
+		assert.Equal(t, map[string]realm.HostingAssetAttributes{
+			"/downloads/notes.txt": {
+				{Name: "Cache-Control", Value: "max-age=60"},
+				{Name: "Content-Disposition", Value: "attachment"},
+				{Name: "Content-Type", Value: "text/plain"},
+			},
+			"/downloads/report.pdf": {
+				{Name: "Cache-Control", Value: "max-age=60"},
+				{Name: "Content-Disposition", Value: "attachment"},
+				{Name: "Content-Type", Value: "application/pdf"},
+			},
+		}, updates)
+	})
+
+	t.Run("set should update the hosting metadata of the local app", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "hosting_attributes_test")
+		defer teardown()
+
+		assert.Nil(t, local.NewApp(
+			profile.WorkingDirectory,
+			"eggcorn-abcde",
+			"eggcorn",
+			realm.LocationVirginia,
+			realm.DeploymentModelGlobal,
+			realm.EnvironmentNone,
+			realm.DefaultAppConfigVersion,
+		).Write())
+
+		out, ui := mock.NewUI()
+
+		realmClient, _ := newRealmClient()
+
+		cmd := &CommandAttributesSet{attributesSetInputs{
+			attributesInputs: attributesInputs{Path: "/index.html"},
+			attrs:            realm.HostingAssetAttributes{{Name: "Cache-Control", Value: "no-store"}},
+		}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, fmt.Sprintf(`Successfully updated the attributes of 1 hosting file(s) of app eggcorn-abcde
+Updated the hosting metadata of the local app at %s to match
+`, profile.WorkingDirectory), out.String())
+
+		metadata, err := ioutil.ReadFile(filepath.Join(profile.WorkingDirectory, local.NameHosting, local.NameMetadata+".json"))
+		assert.Nil(t, err)
+		assert.Equal(t, `[{"path":"/index.html","attrs":[{"name":"Cache-Control","value":"no-store"},{"name":"Content-Type","value":"text/html"}]},`+
+			`{"path":"/downloads/report.pdf","attrs":[{"name":"Content-Type","value":"application/pdf"},{"name":"Cache-Control","value":"no-cache"}]}]`,
+			string(metadata),
+		)
+	})
+
+	t.Run("set should return an error when no hosting files match the path", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient, _ := newRealmClient()
+
+		cmd := &CommandAttributesSet{attributesSetInputs{
+			attributesInputs: attributesInputs{Path: "/missing/*"},
+			attrs:            realm.HostingAssetAttributes{{Name: "Cache-Control", Value: "no-store"}},
+		}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("no hosting files of app eggcorn-abcde match the path '/missing/*'"), err)
+	})
+
+	t.Run("clear should remove every attribute other than the content type", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "hosting_attributes_test")
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		realmClient, updates := newRealmClient()
+
+		cmd := &CommandAttributesClear{attributesClearInputs{attributesInputs: attributesInputs{Path: "*"}}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully updated the attributes of 1 hosting file(s) of app eggcorn-abcde\n", out.String())
+		assert.Equal(t, map[string]realm.HostingAssetAttributes{
+			"/downloads/report.pdf": {{Name: "Content-Type", Value: "application/pdf"}},
+		}, updates)
+	})
+
+	t.Run("clear should print when the attributes are already cleared", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "hosting_attributes_test")
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		realmClient, updates := newRealmClient()
+
+		cmd := &CommandAttributesClear{attributesClearInputs{
+			attributesInputs: attributesInputs{Path: "/index.html"},
+			names:            []string{"Cache-Control"},
+		}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "The attributes of the matching hosting file(s) of app eggcorn-abcde are already up to date\n", out.String())
+		assert.Equal(t, map[string]realm.HostingAssetAttributes{}, updates)
+	})
+}
+
+func TestHostingAttributesInputs(t *testing.T) {
+	t.Run("set should parse the attributes", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := attributesSetInputs{attributesInputs: attributesInputs{
+			ProjectInputs: cli.ProjectInputs{App: "eggcorn-abcde"},
+			Path:          "/downloads/*",
+			Attrs:         []string{"content-disposition: attachment", "Cache-Control:no-cache"},
+		}}
+
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, realm.HostingAssetAttributes{
+			{Name: "Content-Disposition", Value: "attachment"},
+			{Name: "Cache-Control", Value: "no-cache"},
+		}, i.attrs)
+	})
+
+	for _, tc := range []struct {
+		description string
+		inputs      attributesInputs
+		expectedErr error
+	}{
+		{
+			description: "should return an error without a path",
+			inputs:      attributesInputs{Attrs: []string{"Cache-Control: no-cache"}},
+			expectedErr: errors.New(`must specify the path of the hosting files with "--path"`),
+		},
+		{
+			description: "should return an error without an attribute",
+			inputs:      attributesInputs{Path: "/index.html"},
+			expectedErr: errors.New(`must specify at least one attribute to set with "--attr"`),
+		},
+		{
+			description: "should return an error for an attribute without a value",
+			inputs:      attributesInputs{Path: "/index.html", Attrs: []string{"Cache-Control"}},
+			expectedErr: errors.New(`invalid attribute 'Cache-Control', must be in the form of "Name: Value"`),
+		},
+		{
+			description: "should return an error for an unsupported attribute",
+			inputs:      attributesInputs{Path: "/index.html", Attrs: []string{"X-Frame-Options: DENY"}},
+			expectedErr: errors.New("unsupported attribute 'X-Frame-Options', must be one of: Cache-Control, Content-Disposition, Content-Encoding, Content-Language, Content-Type, Website-Redirect-Location"),
+		},
+		{
+			description: "should return an error for a repeated attribute",
+			inputs:      attributesInputs{Path: "/index.html", Attrs: []string{"Cache-Control: no-cache", "cache-control: no-store"}},
+			expectedErr: errors.New("attribute 'Cache-Control' is specified more than once"),
+		},
+	} {
+		t.Run("set "+tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+
+			i := attributesSetInputs{attributesInputs: tc.inputs}
+			assert.Equal(t, tc.expectedErr, i.Resolve(profile, nil))
+		})
+	}
+
+	t.Run("clear should parse the attribute names", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := attributesClearInputs{attributesInputs: attributesInputs{
+			ProjectInputs: cli.ProjectInputs{App: "eggcorn-abcde"},
+			Path:          "/downloads/*",
+			Attrs:         []string{"cache-control"},
+		}}
+
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, []string{"Cache-Control"}, i.names)
+	})
+}
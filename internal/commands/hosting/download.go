@@ -0,0 +1,146 @@
+package hosting
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagLocalPath      = "local"
+	flagLocalPathUsage = "specify the local path of the Realm app to download the hosting files to"
+
+	flagNewer      = "newer"
+	flagNewerUsage = "include to only download the hosting files modified after their local copy"
+)
+
+// CommandMetaDownload is the command meta for the `hosting download` command
+var CommandMetaDownload = cli.CommandMeta{
+	Use:         "download",
+	Display:     "hosting download",
+	Description: "Download the hosting files of your Realm app",
+	HelpText: `Downloads the hosting files deployed with your Realm app into the "hosting"
+directory of your local Realm app, or of the path specified with "--local". The
+attributes of the files are written to "hosting/metadata.json", so the files
+can be pushed back with the same attributes.
+
+Use "--newer" to only download the files which were modified after their local
+copy, along with any files which do not exist locally.`,
+}
+
+// CommandDownload is the `hosting download` command
+type CommandDownload struct {
+	inputs downloadInputs
+}
+
+type downloadInputs struct {
+	cli.ProjectInputs
+	LocalPath string
+	Newer     bool
+}
+
+// Flags is the command flags
+func (cmd *CommandDownload) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPath, "", flagLocalPathUsage)
+	fs.BoolVar(&cmd.inputs.Newer, flagNewer, false, flagNewerUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDownload) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDownload) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	appAssets, err := clients.Realm.HostingAssets(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	files := hostingFiles(appAssets)
+	if len(files) == 0 {
+		ui.Print(terminal.NewTextLog("No hosting files to download"))
+		return nil
+	}
+
+	downloads := files
+	if cmd.inputs.Newer {
+		downloads, err = local.HostingAssetsNewerThanLocal(cmd.inputs.LocalPath, files)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := local.WriteHostingMetadata(cmd.inputs.LocalPath, appAssets); err != nil {
+		return err
+	}
+
+	s := ui.Spinner("Downloading hosting files...")
+
+	download := func() error {
+		s.Start()
+		defer s.Stop()
+
+		return local.DownloadHostingAssets(clients.HostingAsset, cmd.inputs.LocalPath, downloads, profile.MaxParallel)
+	}
+
+	if err := download(); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"Downloaded %d of %d hosting file(s) to %s",
+		len(downloads),
+		len(files),
+		filepath.Join(cmd.inputs.LocalPath, local.NameHosting),
+	))
+	return nil
+}
+
+func (i *downloadInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.LocalPath == "" {
+		app, ok, err := local.FindApp(profile.WorkingDirectory)
+		if err != nil {
+			return err
+		}
+		i.LocalPath = profile.WorkingDirectory
+		if ok {
+			i.LocalPath = app.RootDir
+		}
+	} else {
+		localPath, err := homedir.Expand(i.LocalPath)
+		if err != nil {
+			return err
+		}
+		i.LocalPath = localPath
+	}
+
+	return i.ProjectInputs.Resolve(ui, i.LocalPath, false)
+}
+
+// hostingFiles returns the hosting assets which are files rather than directories
+func hostingFiles(appAssets []realm.HostingAsset) []realm.HostingAsset {
+	files := make([]realm.HostingAsset, 0, len(appAssets))
+	for _, appAsset := range appAssets {
+		if strings.HasSuffix(appAsset.FilePath, "/") {
+			continue
+		}
+		files = append(files, appAsset)
+	}
+	return files
+}
@@ -0,0 +1,122 @@
+package hosting
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestHostingDownloadHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	lastModified := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	appAssets := []realm.HostingAsset{
+		{
+			HostingAssetData: realm.HostingAssetData{FilePath: "/index.html", LastModified: lastModified.Unix()},
+			URL:              "http://url.com/index.html",
+		},
+		{
+			HostingAssetData: realm.HostingAssetData{FilePath: "/docs/"},
+		},
+		{
+			HostingAssetData: realm.HostingAssetData{FilePath: "/docs/readme.txt", LastModified: lastModified.Unix()},
+			Attrs:            realm.HostingAssetAttributes{{Name: "Cache-Control", Value: "no-cache"}},
+			URL:              "http://url.com/docs/readme.txt",
+		},
+	}
+
+	newRealmClient := func() mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.HostingAssetsFn = func(groupID, appID string) ([]realm.HostingAsset, error) {
+			return appAssets, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should download every hosting file and its attributes", func(t *testing.T) {
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		profile := mock.NewProfile(t)
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDownload{downloadInputs{
+			ProjectInputs: cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			LocalPath:     dir,
+		}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: newRealmClient(), HostingAsset: mockHostingAssetClient{}}))
+		assert.Equal(t, "Downloaded 2 of 2 hosting file(s) to "+filepath.Join(dir, local.NameHosting)+"\n", out.String())
+
+		index, err := ioutil.ReadFile(filepath.Join(dir, local.NameHosting, local.NameFiles, "index.html"))
+		assert.Nil(t, err)
+		assert.Equal(t, "http://url.com/index.html", string(index))
+
+		metadata, err := ioutil.ReadFile(filepath.Join(dir, local.NameHosting, local.NameMetadata+".json"))
+		assert.Nil(t, err)
+		assert.True(t, strings.Contains(string(metadata), `"path":"/docs/readme.txt"`), "expected metadata to include the file attributes")
+	})
+
+	t.Run("should only download the hosting files newer than their local copy", func(t *testing.T) {
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		filesDir := filepath.Join(dir, local.NameHosting, local.NameFiles)
+		assert.Nil(t, os.MkdirAll(filepath.Join(filesDir, "docs"), os.ModePerm))
+
+		for path, modTime := range map[string]time.Time{
+			"index.html":      lastModified.Add(time.Hour),
+			"docs/readme.txt": lastModified.Add(-time.Hour),
+		} {
+			assert.Nil(t, ioutil.WriteFile(filepath.Join(filesDir, path), []byte("local"), 0666))
+			assert.Nil(t, os.Chtimes(filepath.Join(filesDir, path), modTime, modTime))
+		}
+
+		profile := mock.NewProfile(t)
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDownload{downloadInputs{
+			ProjectInputs: cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			LocalPath:     dir,
+			Newer:         true,
+		}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: newRealmClient(), HostingAsset: mockHostingAssetClient{}}))
+		assert.Equal(t, "Downloaded 1 of 2 hosting file(s) to "+filepath.Join(dir, local.NameHosting)+"\n", out.String())
+
+		index, err := ioutil.ReadFile(filepath.Join(filesDir, "index.html"))
+		assert.Nil(t, err)
+		assert.Equal(t, "local", string(index))
+
+		readme, err := ioutil.ReadFile(filepath.Join(filesDir, "docs", "readme.txt"))
+		assert.Nil(t, err)
+		assert.Equal(t, "http://url.com/docs/readme.txt", string(readme))
+	})
+}
+
+// mockHostingAssetClient responds with the url of each requested asset as its contents
+type mockHostingAssetClient struct{}
+
+func (client mockHostingAssetClient) Get(url string) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(url)),
+	}, nil
+}
@@ -0,0 +1,188 @@
+package hosting
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagDiffLocal      = "diff-local"
+	flagDiffLocalUsage = "include to compare the hosting files with those of your local Realm app"
+
+	flagLocalPathListUsage = `specify the local path of the Realm app to compare the hosting files with when using "--diff-local"`
+
+	headerSize         = "Size"
+	headerHash         = "Hash"
+	headerLastModified = "Last Modified"
+	headerStatus       = "Status"
+
+	dateFormat = "2006-01-02T15:04:05.000-0700"
+)
+
+// set of hosting file statuses compared with the local Realm app
+const (
+	statusUnchanged  = "unchanged"
+	statusModified   = "modified"
+	statusRemoteOnly = "remote only"
+	statusLocalOnly  = "local only"
+)
+
+var (
+	errNoLocalApp = errors.New(`must run this command from within a local Realm app directory or specify "--local" to use "--diff-local"`)
+)
+
+// CommandMetaList is the command meta for the `hosting list` command
+var CommandMetaList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "hosting list",
+	Description: "List the hosting files of your Realm app",
+	HelpText: `Lists the hosting files deployed with your Realm app, along with each file's
+size, hash and when it was last modified.
+
+Use "--diff-local" to compare the hosting files with the "hosting/files"
+directory of your local Realm app, which shows whether each file is unchanged,
+modified, only exists remotely, or only exists locally. Files which only exist
+remotely are removed the next time the hosting files are pushed.`,
+}
+
+// CommandList is the `hosting list` command
+type CommandList struct {
+	inputs listInputs
+}
+
+type listInputs struct {
+	cli.ProjectInputs
+	DiffLocal bool
+	LocalPath string
+}
+
+// Flags is the command flags
+func (cmd *CommandList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.BoolVar(&cmd.inputs.DiffLocal, flagDiffLocal, false, flagDiffLocalUsage)
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPath, "", flagLocalPathListUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	appAssets, err := clients.Realm.HostingAssets(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	files := hostingFiles(appAssets)
+
+	headers := []string{headerPath, headerSize, headerHash, headerLastModified}
+
+	statuses := map[string]string{}
+	if cmd.inputs.DiffLocal {
+		headers = append(headers, headerStatus)
+
+		hosting := local.Hosting{RootDir: filepath.Join(cmd.inputs.LocalPath, local.NameHosting)}
+
+		hostingDiffs, err := hosting.Diffs(profile.HostingAssetCachePath(), app.ID, files)
+		if err != nil {
+			return err
+		}
+
+		for _, added := range hostingDiffs.Added {
+			files = append(files, added)
+			statuses[added.FilePath] = statusLocalOnly
+		}
+		for _, deleted := range hostingDiffs.Deleted {
+			statuses[deleted.FilePath] = statusRemoteOnly
+		}
+		for _, modified := range hostingDiffs.Modified {
+			statuses[modified.FilePath] = statusModified
+		}
+	}
+
+	if len(files) == 0 {
+		ui.Print(terminal.NewTextLog("No hosting files found for app %s", app.ClientAppID))
+		return nil
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].FilePath < files[j].FilePath })
+
+	rows := make([]map[string]interface{}, 0, len(files))
+	for _, file := range files {
+		row := map[string]interface{}{
+			headerPath:         file.FilePath,
+			headerSize:         file.FileSize,
+			headerHash:         file.FileHash,
+			headerLastModified: lastModifiedDisplay(file),
+		}
+		if cmd.inputs.DiffLocal {
+			status, ok := statuses[file.FilePath]
+			if !ok {
+				status = statusUnchanged
+			}
+			row[headerStatus] = status
+		}
+		rows = append(rows, row)
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d hosting file(s) for app %s", len(files), app.ClientAppID),
+		headers,
+		rows...,
+	))
+	return nil
+}
+
+func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	wd := profile.WorkingDirectory
+	if i.DiffLocal {
+		localPath := profile.WorkingDirectory
+		if i.LocalPath != "" {
+			path, err := homedir.Expand(i.LocalPath)
+			if err != nil {
+				return err
+			}
+			localPath = path
+		}
+
+		app, ok, err := local.FindApp(localPath)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errNoLocalApp
+		}
+		i.LocalPath = app.RootDir
+		wd = app.RootDir
+	}
+
+	return i.ProjectInputs.Resolve(ui, wd, false)
+}
+
+func lastModifiedDisplay(asset realm.HostingAsset) string {
+	if asset.LastModified == 0 {
+		return ""
+	}
+	return time.Unix(asset.LastModified, 0).Local().Format(dateFormat)
+}
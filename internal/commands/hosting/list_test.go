@@ -0,0 +1,152 @@
+package hosting
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestHostingListHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	lastModified := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	lastModifiedDisplay := lastModified.Local().Format(dateFormat)
+
+	index := "<html></html>"
+	indexHash := fmt.Sprintf("%x", md5.Sum([]byte(index)))
+
+	appAssets := []realm.HostingAsset{
+		{
+			HostingAssetData: realm.HostingAssetData{FilePath: "/index.html", FileHash: indexHash, FileSize: 13, LastModified: lastModified.Unix()},
+			Attrs:            realm.HostingAssetAttributes{{Name: "Content-Type", Value: "text/html"}},
+		},
+		{HostingAssetData: realm.HostingAssetData{FilePath: "/docs/"}},
+		{HostingAssetData: realm.HostingAssetData{FilePath: "/docs/readme.txt", FileHash: "abcdef", FileSize: 5, LastModified: lastModified.Unix()}},
+		{HostingAssetData: realm.HostingAssetData{FilePath: "/old.html", FileHash: "123456", FileSize: 7, LastModified: lastModified.Unix()}},
+	}
+
+	newRealmClient := func(appAssets []realm.HostingAsset) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.HostingAssetsFn = func(groupID, appID string) ([]realm.HostingAsset, error) {
+			return appAssets, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should list the hosting files with their sizes and hashes", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandList{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient(appAssets)}))
+		assert.Equal(t, fmt.Sprintf(`Found 3 hosting file(s) for app eggcorn-abcde
+  Path              Size  Hash                              Last Modified               
+  ----------------  ----  --------------------------------  ----------------------------
+  /docs/readme.txt  5     abcdef                            %[1]s
+  /index.html       13    %[2]s  %[1]s
+  /old.html         7     123456                            %[1]s
+`, lastModifiedDisplay, indexHash), out.String())
+	})
+
+	t.Run("should print when there are no hosting files", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandList{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient(nil)}))
+		assert.Equal(t, "No hosting files found for app eggcorn-abcde\n", out.String())
+	})
+
+	t.Run("should compare the hosting files with the local app", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "hosting_list_test")
+		defer teardown()
+
+		filesDir := filepath.Join(profile.WorkingDirectory, local.NameHosting, local.NameFiles)
+		assert.Nil(t, os.MkdirAll(filepath.Join(filesDir, "docs"), os.ModePerm))
+
+		for path, contents := range map[string]string{
+			"index.html":      index,
+			"docs/readme.txt": "changed",
+			"new.html":        "<html>new</html>",
+		} {
+			assert.Nil(t, ioutil.WriteFile(filepath.Join(filesDir, path), []byte(contents), 0666))
+		}
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandList{listInputs{DiffLocal: true, LocalPath: profile.WorkingDirectory}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: newRealmClient(appAssets)}))
+
+		output := out.String()
+		for path, expected := range map[string]struct {
+			prefix string
+			status string
+		}{
+			"/docs/readme.txt": {"/docs/readme.txt  5     abcdef", statusModified},
+			"/index.html":      {"/index.html       13    " + indexHash, statusUnchanged},
+			"/new.html":        {"/new.html         16", statusLocalOnly},
+			"/old.html":        {"/old.html         7     123456", statusRemoteOnly},
+		} {
+			line := tableLine(output, path)
+			assert.True(t, strings.HasPrefix(line, expected.prefix), "expected %q to start with %q", line, expected.prefix)
+			assert.True(t, strings.HasSuffix(line, expected.status), "expected %q to end with %q", line, expected.status)
+		}
+	})
+}
+
+func TestHostingListInputs(t *testing.T) {
+	t.Run("should return an error comparing with a local app outside of one", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "hosting_list_inputs_test")
+		defer teardown()
+
+		i := listInputs{DiffLocal: true}
+		assert.Equal(t, errNoLocalApp, i.Resolve(profile, nil))
+	})
+
+	t.Run("should resolve the local app to compare with", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "hosting_list_inputs_test")
+		defer teardown()
+
+		assert.Nil(t, local.NewApp(
+			profile.WorkingDirectory,
+			"eggcorn-abcde",
+			"eggcorn",
+			realm.LocationVirginia,
+			realm.DeploymentModelGlobal,
+			realm.EnvironmentNone,
+			realm.DefaultAppConfigVersion,
+		).Write())
+
+		i := listInputs{DiffLocal: true, LocalPath: filepath.Join(profile.WorkingDirectory, local.NameHosting)}
+
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, profile.WorkingDirectory, i.LocalPath)
+		assert.Equal(t, "eggcorn-abcde", i.App)
+	})
+}
+
+// tableLine returns the trimmed line of the table output for the hosting file path
+func tableLine(output, path string) string {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, path+" ") {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,234 @@
+package hosting
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagPath      = "path"
+	flagPathUsage = `specify the path of the hosting files to upload (default "hosting/files" of your local Realm app)`
+
+	flagRetryFailedHosting      = "retry-failed-hosting"
+	flagRetryFailedHostingUsage = "upload only the hosting files recorded in the specified hosting failures file"
+)
+
+var (
+	errNoHostingFiles = errors.New(`must specify --path or run this command from within a local Realm app directory`)
+)
+
+// CommandMetaUpload is the command meta for the `hosting upload` command
+var CommandMetaUpload = cli.CommandMeta{
+	Use:         "upload",
+	Display:     "hosting upload",
+	Description: "Upload the hosting files of your local Realm app",
+	HelpText: `Uploads the hosting files from the "hosting/files" directory of your local
+Realm app, or from the path specified with "--path", without pushing any of
+your app's configuration. The attributes of the files are read from the
+"metadata.json" file next to the files directory.
+
+Only the files which were added, modified, or removed since they were last
+deployed are uploaded, and the uploads run in parallel. Files which were
+removed locally are also removed from your Realm app.
+
+The files are checked for possible secrets, such as API keys or connection
+strings, before they are uploaded. Remove them from the files or include
+"--allow-secrets-in-hosting" to upload them anyway.
+
+When some files fail to upload, they are recorded in "hosting-failures.json"
+in the current directory. Specify that file with "--retry-failed-hosting" to
+upload only those files again.`,
+}
+
+// CommandUpload is the `hosting upload` command
+type CommandUpload struct {
+	inputs uploadInputs
+}
+
+type uploadInputs struct {
+	cli.ProjectInputs
+	Path               string
+	AllowSecrets       bool
+	RetryFailedHosting string
+}
+
+// Flags is the command flags
+func (cmd *CommandUpload) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.Path, flagPath, "", flagPathUsage)
+	fs.BoolVar(&cmd.inputs.AllowSecrets, local.FlagAllowSecretsInHosting, false, local.FlagAllowSecretsInHostingUsage)
+	fs.StringVar(&cmd.inputs.RetryFailedHosting, flagRetryFailedHosting, "", flagRetryFailedHostingUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandUpload) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandUpload) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	appAssets, err := clients.Realm.HostingAssets(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	hosting := local.Hosting{RootDir: filepath.Dir(cmd.inputs.Path), FilesDir: cmd.inputs.Path}
+
+	hostingDiffs, err := hosting.Diffs(profile.HostingAssetCachePath(), app.ID, appAssets)
+	if err != nil {
+		return err
+	}
+
+	if cmd.inputs.RetryFailedHosting != "" {
+		manifest, err := local.ReadHostingFailures(cmd.inputs.RetryFailedHosting)
+		if err != nil {
+			return err
+		}
+		if manifest.GroupID != app.GroupID || manifest.AppID != app.ID {
+			return fmt.Errorf("hosting failures at %s were not recorded for app %s", cmd.inputs.RetryFailedHosting, app.ClientAppID)
+		}
+		hostingDiffs = hostingDiffs.FilterPaths(manifest.Paths())
+	}
+
+	if hostingDiffs.Size() == 0 {
+		if cmd.inputs.RetryFailedHosting != "" {
+			if err := os.Remove(cmd.inputs.RetryFailedHosting); err != nil {
+				return err
+			}
+		}
+		ui.Print(terminal.NewTextLog("App %s hosting files are already up to date", app.ClientAppID))
+		return nil
+	}
+
+	diffs := hostingDiffs.Strings()
+
+	items := make([]interface{}, 0, len(diffs))
+	for _, diff := range diffs {
+		items = append(items, diff)
+	}
+	ui.Print(terminal.NewListLog(fmt.Sprintf("The following hosting file changes will be uploaded to app %s", app.ClientAppID), items...))
+
+	if err := hosting.CheckSecrets(ui, hostingDiffs, cmd.inputs.AllowSecrets); err != nil {
+		return err
+	}
+
+	proceed, err := ui.Confirm("Please confirm the changes shown above")
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	s := ui.Spinner("Uploading hosting files...")
+
+	upload := func() error {
+		s.Start()
+		defer s.Stop()
+
+		return hosting.UploadHostingAssets(
+			clients.Realm,
+			app.GroupID,
+			app.ID,
+			hostingDiffs,
+			profile.MaxParallel,
+			func(err error) {
+				ui.Print(terminal.NewWarningLog("An error occurred while uploading hosting assets: %s", err.Error()))
+			},
+			func(completed, total int) {
+				s.Lock()
+				s.Suffix = fmt.Sprintf(" Uploading hosting files... (%d/%d)", completed, total)
+				s.Unlock()
+			},
+		)
+	}
+
+	if err := upload(); err != nil {
+		return cmd.reportFailures(ui, filepath.Join(profile.WorkingDirectory, local.NameHostingFailures), app, err)
+	}
+
+	if cmd.inputs.RetryFailedHosting != "" {
+		if err := os.Remove(cmd.inputs.RetryFailedHosting); err != nil {
+			return err
+		}
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully uploaded %d hosting file change(s) to app %s", hostingDiffs.Size(), app.ClientAppID))
+	return nil
+}
+
+// reportFailures writes the manifest of hosting files which failed to upload,
+// so they can be retried without uploading every hosting file again
+func (cmd *CommandUpload) reportFailures(ui terminal.UI, path string, app realm.App, err error) error {
+	var uploadErr local.HostingUploadError
+	if !errors.As(err, &uploadErr) {
+		return err
+	}
+
+	if err := local.NewHostingFailures(app.GroupID, app.ID, uploadErr.Failed).Write(path); err != nil {
+		return fmt.Errorf("%s: failed to write hosting failures to %s: %w", uploadErr, path, err)
+	}
+
+	ui.Print(
+		terminal.NewTextLog("Wrote %d failed hosting file(s) to %s", uploadErr.Failed.Size(), path),
+		terminal.NewFollowupLog("To retry uploading these hosting files, run", cli.CommandDisplay(
+			CommandMetaUpload.Display,
+			[]flags.Arg{
+				{"app", app.ClientAppID},
+				{flagPath, cmd.inputs.Path},
+				{flagRetryFailedHosting, path},
+			},
+		)),
+	)
+	return err
+}
+
+func (i *uploadInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Path == "" {
+		app, ok, err := local.FindApp(profile.WorkingDirectory)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errNoHostingFiles
+		}
+		i.Path = filepath.Join(app.RootDir, local.NameHosting, local.NameFiles)
+	} else {
+		path, err := homedir.Expand(i.Path)
+		if err != nil {
+			return err
+		}
+		i.Path = path
+	}
+
+	fileInfo, err := os.Stat(i.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("hosting files directory %s does not exist", i.Path)
+		}
+		return err
+	}
+	if !fileInfo.IsDir() {
+		return fmt.Errorf("hosting files path %s must be a directory", i.Path)
+	}
+
+	return i.ProjectInputs.Resolve(ui, i.Path, false)
+}
@@ -1,21 +1,56 @@
 package login
 
 import (
+	"errors"
+	"time"
+
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/browser"
 
 	"github.com/spf13/pflag"
 )
 
+const (
+	// defaultPollInterval is how often to check whether the user has logged in through the browser,
+	// unless the device authorization specifies otherwise
+	defaultPollInterval = 5 * time.Second
+	slowDownInterval    = 5 * time.Second
+
+	// defaultExpiresIn is how long to wait for the user to log in through the browser,
+	// unless the device authorization specifies otherwise
+	defaultExpiresIn = 15 * time.Minute
+)
+
+var (
+	errBrowserLoginExpired = errors.New(`the login code expired before logging in through the browser, run "realm-cli login --browser" to try again`)
+)
+
+// set of functions used to log in through the browser, which are replaced in tests
+var (
+	openBrowser = browser.Open
+	sleep       = time.Sleep
+)
+
 // CommandMeta is the command meta for the `login` command
 var CommandMeta = cli.CommandMeta{
 	Use:         "login",
-	Description: "Log the CLI into Realm using a MongoDB Cloud API key",
+	Description: "Log the CLI into Realm using a MongoDB Cloud API key or your browser",
 	HelpText: `Begins an authenticated session with Realm. To get a MongoDB Cloud API Key, open
 your Realm app in the Realm UI. Navigate to "Deployment" in the left navigation
 menu, and select the "Export App" tab. From there, create a programmatic API key
-to authenticate your realm-cli session.`,
+to authenticate your realm-cli session.
+
+Alternatively, use "--browser" to log in with your MongoDB Cloud account without
+an API key. The MongoDB Cloud login page is opened in your browser, where you
+enter the code printed by the CLI, and the session is saved once you have
+logged in. Commands which manage Atlas resources, such as clusters, still
+require an API key.
+
+On Windows, the private API key and session tokens are stored in the Windows
+Credential Manager rather than in your CLI profile.`,
 }
 
 // Command is the `login` command
@@ -27,6 +62,7 @@ type Command struct {
 func (cmd *Command) Flags(fs *pflag.FlagSet) {
 	fs.StringVar(&cmd.inputs.PublicAPIKey, flagPublicAPIKey, "", flagPublicAPIKeyUsage)
 	fs.StringVar(&cmd.inputs.PrivateAPIKey, flagPrivateAPIKey, "", flagPrivateAPIKeyUsage)
+	fs.BoolVar(&cmd.inputs.Browser, flagBrowser, false, flagBrowserUsage)
 }
 
 // Inputs is the command inputs
@@ -52,11 +88,23 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		}
 	}
 
-	profile.SetCredentials(user.Credentials{cmd.inputs.PublicAPIKey, cmd.inputs.PrivateAPIKey})
+	var session realm.Session
+	if cmd.inputs.Browser {
+		s, err := browserLogin(ui, clients.Realm)
+		if err != nil {
+			return err
+		}
+		session = s
 
-	session, err := clients.Realm.Authenticate(cmd.inputs.PublicAPIKey, cmd.inputs.PrivateAPIKey)
-	if err != nil {
-		return err
+		profile.SetCredentials(user.Credentials{})
+	} else {
+		profile.SetCredentials(user.Credentials{cmd.inputs.PublicAPIKey, cmd.inputs.PrivateAPIKey})
+
+		s, err := clients.Realm.Authenticate(cmd.inputs.PublicAPIKey, cmd.inputs.PrivateAPIKey)
+		if err != nil {
+			return err
+		}
+		session = s
 	}
 
 	profile.SetSession(user.Session{session.AccessToken, session.RefreshToken})
@@ -67,3 +115,60 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 	ui.Print(terminal.NewTextLog("Successfully logged in"))
 	return nil
 }
+
+// browserLogin authorizes the CLI to log in, then waits for the user
+// to enter the user code in the browser before the authorization expires
+func browserLogin(ui terminal.UI, realmClient realm.Client) (realm.Session, error) {
+	authorization, err := realmClient.AuthorizeDevice()
+	if err != nil {
+		return realm.Session{}, err
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"To log in, enter the code %s at %s",
+		authorization.UserCode,
+		authorization.VerificationURI,
+	))
+
+	if err := openBrowser(authorization.VerificationURI); err != nil {
+		ui.Print(terminal.NewWarningLog("Failed to open your browser, please open the link above manually: %s", err))
+	}
+
+	interval := defaultPollInterval
+	if authorization.Interval > 0 {
+		interval = time.Duration(authorization.Interval) * time.Second
+	}
+	expiresIn := defaultExpiresIn
+	if authorization.ExpiresIn > 0 {
+		expiresIn = time.Duration(authorization.ExpiresIn) * time.Second
+	}
+
+	s := ui.Spinner("Waiting for you to log in through the browser...")
+
+	s.Start()
+	defer s.Stop()
+
+	for waited := time.Duration(0); waited < expiresIn; waited += interval {
+		sleep(interval)
+
+		session, err := realmClient.AuthenticateDevice(authorization.DeviceCode)
+		if err == nil {
+			return session, nil
+		}
+
+		var serverErr realm.ServerError
+		if !errors.As(err, &serverErr) {
+			return realm.Session{}, err
+		}
+
+		switch serverErr.Code {
+		case realm.ErrCodeAuthorizationPending:
+		case realm.ErrCodeSlowDown:
+			interval += slowDownInterval
+		default:
+			return realm.Session{}, err
+		}
+	}
+
+	return realm.Session{}, errBrowserLoginExpired
+}
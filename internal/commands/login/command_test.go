@@ -2,11 +2,13 @@ package login
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -163,6 +165,174 @@ func TestLoginHandler(t *testing.T) {
 	})
 }
 
+func TestLoginBrowserHandler(t *testing.T) {
+	authorization := realm.DeviceAuthorization{
+		DeviceCode:      "deviceCode",
+		UserCode:        "ABCD-EFGH",
+		VerificationURI: "https://cloud.mongodb.com/device",
+		ExpiresIn:       10,
+		Interval:        2,
+	}
+
+	setupBrowser := func(t *testing.T, openErr error) (*[]string, *[]time.Duration, func()) {
+		var opened []string
+		var slept []time.Duration
+
+		origOpenBrowser, origSleep := openBrowser, sleep
+		openBrowser = func(url string) error {
+			opened = append(opened, url)
+			return openErr
+		}
+		sleep = func(d time.Duration) { slept = append(slept, d) }
+
+		return &opened, &slept, func() {
+			openBrowser, sleep = origOpenBrowser, origSleep
+		}
+	}
+
+	t.Run("should save the session once the user logs in through the browser", func(t *testing.T) {
+		tc := setup(t)
+		defer tc.teardown()
+
+		opened, slept, teardownBrowser := setupBrowser(t, nil)
+		defer teardownBrowser()
+
+		var deviceCodes []string
+		errs := []error{
+			realm.ServerError{Code: realm.ErrCodeAuthorizationPending},
+			realm.ServerError{Code: realm.ErrCodeSlowDown},
+		}
+
+		realmClient := mock.RealmClient{}
+		realmClient.AuthorizeDeviceFn = func() (realm.DeviceAuthorization, error) {
+			return authorization, nil
+		}
+		realmClient.AuthenticateDeviceFn = func(deviceCode string) (realm.Session, error) {
+			deviceCodes = append(deviceCodes, deviceCode)
+			if len(errs) > 0 {
+				err := errs[0]
+				errs = errs[1:]
+				return realm.Session{}, err
+			}
+			return realm.Session{AccessToken: "browserAccessToken", RefreshToken: "browserRefreshToken"}, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs{Browser: true}}
+
+		assert.Nil(t, cmd.Handler(tc.profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `To log in, enter the code ABCD-EFGH at https://cloud.mongodb.com/device
+Successfully logged in
+`, out.String())
+
+		assert.Equal(t, []string{"https://cloud.mongodb.com/device"}, *opened)
+		assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second, 7 * time.Second}, *slept)
+		assert.Equal(t, []string{"deviceCode", "deviceCode", "deviceCode"}, deviceCodes)
+
+		assert.Equal(t, user.Credentials{}, tc.profile.Credentials())
+		assert.Equal(t, user.Session{"browserAccessToken", "browserRefreshToken"}, tc.profile.Session())
+	})
+
+	t.Run("should print a warning when the browser fails to open", func(t *testing.T) {
+		tc := setup(t)
+		defer tc.teardown()
+
+		_, _, teardownBrowser := setupBrowser(t, errors.New("something bad happened"))
+		defer teardownBrowser()
+
+		realmClient := mock.RealmClient{}
+		realmClient.AuthorizeDeviceFn = func() (realm.DeviceAuthorization, error) {
+			return authorization, nil
+		}
+		realmClient.AuthenticateDeviceFn = func(deviceCode string) (realm.Session, error) {
+			return realm.Session{AccessToken: "browserAccessToken", RefreshToken: "browserRefreshToken"}, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs{Browser: true}}
+
+		assert.Nil(t, cmd.Handler(tc.profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `To log in, enter the code ABCD-EFGH at https://cloud.mongodb.com/device
+Warning: Failed to open your browser, please open the link above manually: something bad happened
+Successfully logged in
+`, out.String())
+	})
+
+	t.Run("should wait for the default time when the device authorization does not expire", func(t *testing.T) {
+		tc := setup(t)
+		defer tc.teardown()
+
+		_, slept, teardownBrowser := setupBrowser(t, nil)
+		defer teardownBrowser()
+
+		realmClient := mock.RealmClient{}
+		realmClient.AuthorizeDeviceFn = func() (realm.DeviceAuthorization, error) {
+			return realm.DeviceAuthorization{DeviceCode: "deviceCode", UserCode: "ABCD-EFGH", VerificationURI: "https://cloud.mongodb.com/device"}, nil
+		}
+		realmClient.AuthenticateDeviceFn = func(deviceCode string) (realm.Session, error) {
+			return realm.Session{}, realm.ServerError{Code: realm.ErrCodeAuthorizationPending}
+		}
+
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		cmd := &Command{inputs{Browser: true}}
+
+		assert.Equal(t, errBrowserLoginExpired, cmd.Handler(tc.profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, int(defaultExpiresIn/defaultPollInterval), len(*slept))
+	})
+
+	for _, tc := range []struct {
+		description   string
+		authenticate  func(deviceCode string) (realm.Session, error)
+		expectedErr   error
+		expectedSlept int
+	}{
+		{
+			description: "should return an error when the login code expires",
+			authenticate: func(deviceCode string) (realm.Session, error) {
+				return realm.Session{}, realm.ServerError{Code: realm.ErrCodeAuthorizationPending}
+			},
+			expectedErr:   errBrowserLoginExpired,
+			expectedSlept: 5,
+		},
+		{
+			description: "should return an error when the user denies the login",
+			authenticate: func(deviceCode string) (realm.Session, error) {
+				return realm.Session{}, realm.ServerError{Code: "AccessDenied", Message: "access denied"}
+			},
+			expectedErr:   realm.ServerError{Code: "AccessDenied", Message: "access denied"},
+			expectedSlept: 1,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			ctx := setup(t)
+			defer ctx.teardown()
+
+			_, slept, teardownBrowser := setupBrowser(t, nil)
+			defer teardownBrowser()
+
+			realmClient := mock.RealmClient{}
+			realmClient.AuthorizeDeviceFn = func() (realm.DeviceAuthorization, error) {
+				return authorization, nil
+			}
+			realmClient.AuthenticateDeviceFn = tc.authenticate
+
+			ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+			cmd := &Command{inputs{Browser: true}}
+
+			assert.Equal(t, tc.expectedErr, cmd.Handler(ctx.profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedSlept, len(*slept))
+
+			assert.Equal(t, user.Session{"existingAccessToken", "existingRefreshToken"}, ctx.profile.Session())
+		})
+	}
+}
+
 func TestLoginFeedback(t *testing.T) {
 	t.Run("should print a message that login was successful", func(t *testing.T) {
 		tc := setup(t)
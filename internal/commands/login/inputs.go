@@ -1,6 +1,8 @@
 package login
 
 import (
+	"fmt"
+
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/terminal"
 	"github.com/AlecAivazis/survey/v2"
@@ -13,6 +15,9 @@ const (
 	flagPrivateAPIKey      = "private-api-key"
 	flagPrivateAPIKeyUsage = "the private key of your programmatic API Key"
 
+	flagBrowser      = "browser"
+	flagBrowserUsage = "include to log in through your browser instead of with a programmatic API Key"
+
 	inputFieldPublicAPIKey  = "publicAPIKey"
 	inputFieldPrivateAPIKey = "privateAPIKey"
 )
@@ -20,9 +25,17 @@ const (
 type inputs struct {
 	PublicAPIKey  string
 	PrivateAPIKey string
+	Browser       bool
 }
 
 func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Browser {
+		if i.PublicAPIKey != "" || i.PrivateAPIKey != "" {
+			return fmt.Errorf(`cannot use "--%s" with "--%s" or "--%s"`, flagBrowser, flagPublicAPIKey, flagPrivateAPIKey)
+		}
+		return nil
+	}
+
 	user := profile.Credentials()
 	var questions []*survey.Question
 
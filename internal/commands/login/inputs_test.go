@@ -1,6 +1,7 @@
 package login
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -76,6 +77,20 @@ func TestLoginInputs(t *testing.T) {
 				assert.Equal(t, "password", i.PrivateAPIKey)
 			},
 		},
+		{
+			description: "Should not prompt for inputs when logging in through the browser",
+			inputs:      inputs{Browser: true},
+			prepareProfile: func(p *user.Profile) {
+				p.SetCredentials(user.Credentials{"username", "password"})
+			},
+			procedure: func(c *expect.Console) {
+				c.ExpectEOF()
+			},
+			test: func(t *testing.T, i inputs) {
+				assert.Equal(t, "", i.PublicAPIKey)
+				assert.Equal(t, "", i.PrivateAPIKey)
+			},
+		},
 		{
 			description: "Should not prompt for inputs when profile provides the data",
 			prepareProfile: func(p *user.Profile) {
@@ -113,3 +128,12 @@ func TestLoginInputs(t *testing.T) {
 		})
 	}
 }
+
+func TestLoginInputsBrowser(t *testing.T) {
+	t.Run("Should return an error when logging in through the browser with api keys", func(t *testing.T) {
+		i := inputs{PublicAPIKey: "username", Browser: true}
+
+		err := i.Resolve(mock.NewProfile(t), nil)
+		assert.Equal(t, errors.New(`cannot use "--browser" with "--api-key" or "--private-api-key"`), err)
+	})
+}
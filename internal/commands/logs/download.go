@@ -0,0 +1,248 @@
+package logs
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaDownload is the command meta for the `logs download` command
+var CommandMetaDownload = cli.CommandMeta{
+	Use:         "download",
+	Display:     "logs download",
+	Description: "Download the Logs of your Realm app to a file",
+	HelpText: `Writes every Log of your Realm app created within the specified time range to a
+file, suitable for ingesting into tools such as Splunk or BigQuery. A file with
+a ".csv" extension is written as CSV, otherwise each Log is written as a line of
+JSON.
+
+The time range is downloaded in chunks, oldest first, and each chunk is paged
+through until all of its Logs are found.`,
+}
+
+// CommandDownload is the `logs download` command
+type CommandDownload struct {
+	inputs downloadInputs
+}
+
+const (
+	flagOutput      = "output"
+	flagOutputUsage = `specify the file to write the logs to, a ".csv" extension writes CSV and otherwise JSON lines are written`
+
+	flagChunk      = "chunk"
+	flagChunkUsage = "specify the length of time to download logs for at once"
+
+	defaultDownloadChunk = time.Hour
+
+	extCSV = ".csv"
+)
+
+type downloadInputs struct {
+	cli.ProjectInputs
+	Types  []string
+	Status string
+	UserID string
+	Start  flags.Date
+	End    flags.Date
+	Chunk  time.Duration
+	Output string
+}
+
+// Flags is the command flags
+func (cmd *CommandDownload) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.Var(&cmd.inputs.Start, flagStartDate, flagStartDateUsage)
+	fs.Var(&cmd.inputs.End, flagEndDate, flagEndDateUsage)
+	fs.StringVar(&cmd.inputs.Output, flagOutput, "", flagOutputUsage)
+	fs.Var(flags.NewEnumSet(&cmd.inputs.Types, allLogTypes), flagType, flagTypeUsage)
+	fs.StringVar(&cmd.inputs.Status, flagStatus, "", flagStatusUsage)
+	fs.StringVar(&cmd.inputs.UserID, flagUser, "", flagUserUsage)
+	fs.DurationVar(&cmd.inputs.Chunk, flagChunk, defaultDownloadChunk, flagChunkUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDownload) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDownload) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cmd.inputs.Output), os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(cmd.inputs.Output, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", cmd.inputs.Output, err)
+	}
+	defer f.Close()
+
+	var w logsWriter
+	if strings.EqualFold(filepath.Ext(cmd.inputs.Output), extCSV) {
+		w = newCSVLogsWriter(f)
+	} else {
+		w = jsonLogsWriter{json.NewEncoder(f)}
+	}
+
+	opts := realm.LogsOptions{
+		Types:      logTypes(cmd.inputs.Types),
+		ErrorsOnly: cmd.inputs.Status == logStatusError,
+		UserID:     cmd.inputs.UserID,
+		AllPages:   true,
+	}
+
+	var count int
+	for start := cmd.inputs.Start.Time; start.Before(cmd.inputs.End.Time); start = start.Add(cmd.inputs.Chunk) {
+		end := start.Add(cmd.inputs.Chunk)
+		if end.After(cmd.inputs.End.Time) {
+			end = cmd.inputs.End.Time
+		}
+
+		// the range of each chunk is inclusive, so it ends just before the next chunk starts
+		opts.Start, opts.End = start, end.Add(-time.Millisecond)
+
+		logs, err := clients.Realm.Logs(app.GroupID, app.ID, opts)
+		if err != nil {
+			return fmt.Errorf("failed to get logs from %s to %s: %w", start.Format(dateFormat), end.Format(dateFormat), err)
+		}
+		if cmd.inputs.Status == logStatusSuccess {
+			logs = successfulLogs(logs)
+		}
+		sort.Stable(logs)
+
+		for _, log := range logs {
+			if err := w.Write(log); err != nil {
+				return err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		count += len(logs)
+	}
+
+	ui.Print(terminal.NewTextLog("Downloaded %d log(s) to %s", count, cmd.inputs.Output))
+	return nil
+}
+
+func (i *downloadInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := validateStatus(i.Status); err != nil {
+		return err
+	}
+	if i.Output == "" {
+		return fmt.Errorf(`must specify the file to download logs to with "--%s"`, flagOutput)
+	}
+	if i.Start.Time.IsZero() {
+		return fmt.Errorf(`must specify the date to download logs from with "--%s"`, flagStartDate)
+	}
+	if i.End.Time.IsZero() {
+		i.End.Time = time.Now()
+	}
+	if !i.Start.Time.Before(i.End.Time) {
+		return fmt.Errorf(`"--%s" must be before "--%s"`, flagStartDate, flagEndDate)
+	}
+	if i.Chunk <= 0 {
+		return fmt.Errorf(`"--%s" must be a positive duration`, flagChunk)
+	}
+
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
+}
+
+type logsWriter interface {
+	Write(log realm.Log) error
+	Flush() error
+}
+
+// jsonLogsWriter writes each log as a line of JSON
+type jsonLogsWriter struct {
+	enc *json.Encoder
+}
+
+func (w jsonLogsWriter) Write(log realm.Log) error { return w.enc.Encode(log) }
+
+func (w jsonLogsWriter) Flush() error { return nil }
+
+var csvLogsHeader = []string{
+	"started",
+	"completed",
+	"type",
+	"name",
+	"status",
+	"error_code",
+	"error",
+	"user_id",
+	"messages",
+}
+
+// csvLogsWriter writes each log as a CSV record, preceded by a header record
+type csvLogsWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func newCSVLogsWriter(w io.Writer) *csvLogsWriter {
+	return &csvLogsWriter{w: csv.NewWriter(w)}
+}
+
+func (w *csvLogsWriter) Write(log realm.Log) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	status := logStatusSuccess
+	if log.Error != "" {
+		status = logStatusError
+	}
+
+	messages, err := json.Marshal(log.Messages)
+	if err != nil {
+		return err
+	}
+
+	return w.w.Write([]string{
+		log.Started.Format(time.RFC3339Nano),
+		log.Completed.Format(time.RFC3339Nano),
+		log.Type,
+		strings.TrimPrefix(logNameDisplay(log), " "),
+		status,
+		log.ErrorCode,
+		log.Error,
+		log.UserID,
+		string(messages),
+	})
+}
+
+func (w *csvLogsWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvLogsWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.w.Write(csvLogsHeader)
+}
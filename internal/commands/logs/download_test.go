@@ -0,0 +1,244 @@
+package logs
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestLogsDownload(t *testing.T) {
+	start := time.Date(2021, time.June, 22, 0, 0, 0, 0, time.UTC)
+
+	testLogs := map[time.Time]realm.Logs{
+		start: {
+			{
+				Type:         realm.LogTypeFunction,
+				Messages:     []interface{}{"second", 2},
+				Started:      start.Add(40 * time.Minute),
+				Completed:    start.Add(40*time.Minute + time.Second),
+				FunctionName: "func0",
+				UserID:       "user0",
+			},
+			{
+				Type:                  realm.LogTypeDBTrigger,
+				Error:                 "something, bad happened",
+				ErrorCode:             "FunctionExecutionError",
+				Started:               start.Add(10 * time.Minute),
+				Completed:             start.Add(10*time.Minute + time.Second),
+				EventSubscriptionName: "trigger0",
+			},
+		},
+		start.Add(time.Hour): {
+			{
+				Type:      realm.LogTypeAuth,
+				Started:   start.Add(70 * time.Minute),
+				Completed: start.Add(70*time.Minute + time.Second),
+			},
+		},
+	}
+
+	setup := func(t *testing.T) (*[]realm.LogsOptions, mock.RealmClient) {
+		var optsList []realm.LogsOptions
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			optsList = append(optsList, opts)
+			logs := make(realm.Logs, len(testLogs[opts.Start]))
+			copy(logs, testLogs[opts.Start])
+			return logs, nil
+		}
+		return &optsList, realmClient
+	}
+
+	t.Run("should download the logs in chunks as json lines", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		optsList, realmClient := setup(t)
+		out, ui := mock.NewUI()
+
+		output := filepath.Join(tmpDir, "logs", "logs.jsonl")
+
+		cmd := &CommandDownload{downloadInputs{
+			Types:  []string{logTypeFunction},
+			UserID: "user0",
+			Start:  flags.Date{Time: start},
+			End:    flags.Date{Time: start.Add(90 * time.Minute)},
+			Chunk:  time.Hour,
+			Output: output,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Downloaded 3 log(s) to "+output+"\n", out.String())
+
+		assert.Equal(t, []realm.LogsOptions{
+			{
+				Types:    []string{realm.LogTypeFunction},
+				UserID:   "user0",
+				Start:    start,
+				End:      start.Add(time.Hour - time.Millisecond),
+				AllPages: true,
+			},
+			{
+				Types:    []string{realm.LogTypeFunction},
+				UserID:   "user0",
+				Start:    start.Add(time.Hour),
+				End:      start.Add(90*time.Minute - time.Millisecond),
+				AllPages: true,
+			},
+		}, *optsList)
+
+		data, err := ioutil.ReadFile(output)
+		assert.Nil(t, err)
+
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		assert.Equal(t, 3, len(lines))
+		assert.True(t, strings.Contains(lines[0], `"event_subscription_name":"trigger0"`), "expected the first line to be the oldest log, but got: %s", lines[0])
+		assert.True(t, strings.Contains(lines[1], `"function_name":"func0"`), "expected the second line to be the function log, but got: %s", lines[1])
+		assert.True(t, strings.Contains(lines[2], `"type":"AUTH"`), "expected the last line to be the auth log, but got: %s", lines[2])
+	})
+
+	t.Run("should download the successful logs as csv", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		_, realmClient := setup(t)
+		_, ui := mock.NewUI()
+
+		output := filepath.Join(tmpDir, "logs.csv")
+
+		cmd := &CommandDownload{downloadInputs{
+			Status: logStatusSuccess,
+			Start:  flags.Date{Time: start},
+			End:    flags.Date{Time: start.Add(time.Hour)},
+			Chunk:  time.Hour,
+			Output: output,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+
+		data, err := ioutil.ReadFile(output)
+		assert.Nil(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"started,completed,type,name,status,error_code,error,user_id,messages",
+			`2021-06-22T00:40:00Z,2021-06-22T00:40:01Z,FUNCTION,func0,success,,,user0,"[""second"",2]"`,
+			"",
+		}, "\n"), string(data))
+	})
+
+	t.Run("should write only the csv header when there are no logs", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		_, realmClient := setup(t)
+		_, ui := mock.NewUI()
+
+		output := filepath.Join(tmpDir, "logs.csv")
+
+		cmd := &CommandDownload{downloadInputs{
+			Start:  flags.Date{Time: start.Add(-time.Hour)},
+			End:    flags.Date{Time: start},
+			Chunk:  time.Hour,
+			Output: output,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+
+		data, err := ioutil.ReadFile(output)
+		assert.Nil(t, err)
+		assert.Equal(t, "started,completed,type,name,status,error_code,error,user_id,messages\n", string(data))
+	})
+
+	t.Run("should return an error when getting the logs fails", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandDownload{downloadInputs{
+			Start:  flags.Date{Time: start},
+			End:    flags.Date{Time: start.Add(time.Hour)},
+			Chunk:  time.Hour,
+			Output: filepath.Join(tmpDir, "logs.jsonl"),
+		}}
+
+		err = cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, "failed to get logs from 2021-06-22T00:00:00.000+0000 to 2021-06-22T01:00:00.000+0000: something bad happened", err.Error())
+	})
+}
+
+func TestLogsDownloadInputs(t *testing.T) {
+	start := time.Date(2021, time.June, 22, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should default the end date to now", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		inputs := downloadInputs{
+			ProjectInputs: cli.ProjectInputs{App: "test-app"},
+			Start:         flags.Date{Time: start},
+			Chunk:         time.Hour,
+			Output:        "logs.jsonl",
+		}
+
+		before := time.Now()
+		assert.Nil(t, inputs.Resolve(profile, ui))
+		assert.False(t, inputs.End.Time.Before(before), "expected the end date to default to now")
+	})
+
+	for _, tc := range []struct {
+		description string
+		inputs      downloadInputs
+		expectedErr error
+	}{
+		{
+			description: "with no output",
+			inputs:      downloadInputs{Start: flags.Date{Time: start}, Chunk: time.Hour},
+			expectedErr: errors.New(`must specify the file to download logs to with "--output"`),
+		},
+		{
+			description: "with no start date",
+			inputs:      downloadInputs{Output: "logs.jsonl", Chunk: time.Hour},
+			expectedErr: errors.New(`must specify the date to download logs from with "--start"`),
+		},
+		{
+			description: "with an end date before the start date",
+			inputs:      downloadInputs{Output: "logs.jsonl", Start: flags.Date{Time: start}, End: flags.Date{Time: start.Add(-time.Hour)}, Chunk: time.Hour},
+			expectedErr: errors.New(`"--start" must be before "--end"`),
+		},
+		{
+			description: "with no chunk",
+			inputs:      downloadInputs{Output: "logs.jsonl", Start: flags.Date{Time: start}},
+			expectedErr: errors.New(`"--chunk" must be a positive duration`),
+		},
+	} {
+		t.Run("should return an error "+tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, ui))
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package logs
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaExport is the command meta for the `logs export` command
+var CommandMetaExport = cli.CommandMeta{
+	Use:         "export",
+	Display:     "logs export",
+	Description: "Export the Logs of a user of your Realm app to an archive",
+	HelpText: `Collects every Log of your Realm app pertaining to the specified user within
+the specified time range, and writes them to a ".zip" archive suitable for
+responding to data subject access requests. The archive contains the Logs as
+both JSON lines and CSV, along with a manifest describing the export.
+
+Use "--redact-pii" to replace the identifiers of any other users, along with any
+email addresses, found in the messages and errors of the Logs with "[REDACTED]".`,
+}
+
+// CommandExport is the `logs export` command
+type CommandExport struct {
+	inputs exportInputs
+}
+
+const (
+	flagUserID      = "user-id"
+	flagUserIDUsage = "specify the id of the user to export logs for"
+
+	flagRedactPII      = "redact-pii"
+	flagRedactPIIUsage = "include to redact the identifiers of other users and any email addresses found in the logs"
+
+	flagExportOutput      = "output"
+	flagExportOutputUsage = `specify the ".zip" file to write the archive to (default: "logs-<user-id>.zip" in the working directory)`
+
+	exportLogsJSON     = "logs.jsonl"
+	exportLogsCSV      = "logs.csv"
+	exportManifestJSON = "manifest.json"
+
+	redacted = "[REDACTED]"
+)
+
+var (
+	objectIDPattern = regexp.MustCompile(`\b[0-9a-fA-F]{24}\b`)
+	emailPattern    = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+)
+
+type exportInputs struct {
+	cli.ProjectInputs
+	UserID    string
+	Start     flags.Date
+	End       flags.Date
+	RedactPII bool
+	Output    string
+}
+
+// exportManifest describes the contents of a logs export archive
+type exportManifest struct {
+	AppID      string    `json:"app_id"`
+	UserID     string    `json:"user_id"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	ExportedAt time.Time `json:"exported_at"`
+	Logs       int       `json:"logs"`
+	Redacted   bool      `json:"redacted"`
+}
+
+// Flags is the command flags
+func (cmd *CommandExport) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.UserID, flagUserID, "", flagUserIDUsage)
+	fs.Var(&cmd.inputs.Start, flagStartDate, flagStartDateUsage)
+	fs.Var(&cmd.inputs.End, flagEndDate, flagEndDateUsage)
+	fs.BoolVar(&cmd.inputs.RedactPII, flagRedactPII, false, flagRedactPIIUsage)
+	fs.StringVar(&cmd.inputs.Output, flagExportOutput, "", flagExportOutputUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandExport) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandExport) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	logs, err := clients.Realm.Logs(app.GroupID, app.ID, realm.LogsOptions{
+		Start:    cmd.inputs.Start.Time,
+		End:      cmd.inputs.End.Time,
+		UserID:   cmd.inputs.UserID,
+		AllPages: true,
+	})
+	if err != nil {
+		return err
+	}
+	sort.Stable(logs)
+
+	if cmd.inputs.RedactPII {
+		redactor := piiRedactor{cmd.inputs.UserID}
+		for i := range logs {
+			logs[i] = redactor.redactLog(logs[i])
+		}
+	}
+
+	manifest := exportManifest{
+		AppID:      app.ClientAppID,
+		UserID:     cmd.inputs.UserID,
+		Start:      cmd.inputs.Start.Time,
+		End:        cmd.inputs.End.Time,
+		ExportedAt: time.Now(),
+		Logs:       len(logs),
+		Redacted:   cmd.inputs.RedactPII,
+	}
+
+	if err := writeExportArchive(cmd.inputs.Output, manifest, logs); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Exported %d log(s) of user %s to %s", len(logs), cmd.inputs.UserID, cmd.inputs.Output))
+	return nil
+}
+
+func (i *exportInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.UserID == "" {
+		return fmt.Errorf(`must specify the user to export logs for with "--%s"`, flagUserID)
+	}
+	if i.Start.Time.IsZero() {
+		return fmt.Errorf(`must specify the date to export logs from with "--%s"`, flagStartDate)
+	}
+	if i.End.Time.IsZero() {
+		i.End.Time = time.Now()
+	}
+	if !i.Start.Time.Before(i.End.Time) {
+		return fmt.Errorf(`"--%s" must be before "--%s"`, flagStartDate, flagEndDate)
+	}
+	if i.Output == "" {
+		i.Output = filepath.Join(profile.WorkingDirectory, fmt.Sprintf("logs-%s.zip", i.UserID))
+	}
+
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
+}
+
+func writeExportArchive(path string, manifest exportManifest, logs realm.Logs) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+
+	manifestWriter, err := zw.Create(exportManifestJSON)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(manifestWriter)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(manifest); err != nil {
+		return err
+	}
+
+	jsonWriter, err := zw.Create(exportLogsJSON)
+	if err != nil {
+		return err
+	}
+	if err := writeLogs(jsonLogsWriter{json.NewEncoder(jsonWriter)}, logs); err != nil {
+		return err
+	}
+
+	csvWriter, err := zw.Create(exportLogsCSV)
+	if err != nil {
+		return err
+	}
+	if err := writeLogs(newCSVLogsWriter(csvWriter), logs); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func writeLogs(w logsWriter, logs realm.Logs) error {
+	for _, log := range logs {
+		if err := w.Write(log); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// piiRedactor redacts the identifiers of every user other than userID,
+// along with any email addresses, from the messages and errors of logs
+type piiRedactor struct {
+	userID string
+}
+
+func (r piiRedactor) redactLog(log realm.Log) realm.Log {
+	messages := make([]interface{}, 0, len(log.Messages))
+	for _, message := range log.Messages {
+		messages = append(messages, r.redactValue(message))
+	}
+	log.Messages = messages
+	log.Error = r.redactString(log.Error)
+	return log
+}
+
+func (r piiRedactor) redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return r.redactString(v)
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			values = append(values, r.redactValue(item))
+		}
+		return values
+	case map[string]interface{}:
+		values := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			values[key] = r.redactValue(item)
+		}
+		return values
+	}
+	return value
+}
+
+func (r piiRedactor) redactString(s string) string {
+	s = emailPattern.ReplaceAllString(s, redacted)
+	return objectIDPattern.ReplaceAllStringFunc(s, func(id string) string {
+		if id == r.userID {
+			return id
+		}
+		return redacted
+	})
+}
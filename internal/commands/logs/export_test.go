@@ -0,0 +1,197 @@
+package logs
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestLogsExport(t *testing.T) {
+	start := time.Date(2021, time.June, 22, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	userID := "60d0c5a3e4b0a1b2c3d4e5f6"
+	otherUserID := "60d0c5a3e4b0a1b2c3d4e5f7"
+
+	testLogs := realm.Logs{
+		{
+			Type:         realm.LogTypeFunction,
+			Messages:     []interface{}{"shared a document with " + otherUserID, map[string]interface{}{"email": "other@example.com"}},
+			Started:      start.Add(2 * time.Hour),
+			FunctionName: "share",
+			UserID:       userID,
+		},
+		{
+			Type:    realm.LogTypeAuth,
+			Error:   "user " + userID + " failed to log in",
+			Started: start.Add(time.Hour),
+			UserID:  userID,
+		},
+	}
+
+	setup := func(t *testing.T) (*realm.LogsOptions, mock.RealmClient) {
+		var logsOpts realm.LogsOptions
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ClientAppID: "app-abcde"}}, nil
+		}
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			logsOpts = opts
+			logs := make(realm.Logs, len(testLogs))
+			copy(logs, testLogs)
+			return logs, nil
+		}
+		return &logsOpts, realmClient
+	}
+
+	readArchive := func(t *testing.T, path string) map[string]string {
+		t.Helper()
+
+		r, err := zip.OpenReader(path)
+		assert.Nil(t, err)
+		defer r.Close()
+
+		files := map[string]string{}
+		for _, file := range r.File {
+			f, err := file.Open()
+			assert.Nil(t, err)
+
+			data, err := ioutil.ReadAll(f)
+			assert.Nil(t, err)
+			assert.Nil(t, f.Close())
+
+			files[file.Name] = string(data)
+		}
+		return files
+	}
+
+	t.Run("should export the logs of the user to an archive", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		logsOpts, realmClient := setup(t)
+		out, ui := mock.NewUI()
+
+		output := filepath.Join(tmpDir, "export", "logs.zip")
+
+		cmd := &CommandExport{exportInputs{
+			UserID: userID,
+			Start:  flags.Date{Time: start},
+			End:    flags.Date{Time: end},
+			Output: output,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, fmt.Sprintf("Exported 2 log(s) of user %s to %s\n", userID, output), out.String())
+		assert.Equal(t, realm.LogsOptions{Start: start, End: end, UserID: userID, AllPages: true}, *logsOpts)
+
+		files := readArchive(t, output)
+		assert.Equal(t, 3, len(files))
+
+		var manifest exportManifest
+		assert.Nil(t, json.Unmarshal([]byte(files[exportManifestJSON]), &manifest))
+		assert.Equal(t, "app-abcde", manifest.AppID)
+		assert.Equal(t, userID, manifest.UserID)
+		assert.Equal(t, 2, manifest.Logs)
+		assert.False(t, manifest.Redacted, "expected the manifest to not be redacted")
+
+		lines := strings.Split(strings.TrimSpace(files[exportLogsJSON]), "\n")
+		assert.Equal(t, 2, len(lines))
+		assert.True(t, strings.Contains(lines[0], `"type":"AUTH"`), "expected the logs to be sorted oldest first")
+		assert.True(t, strings.Contains(lines[1], otherUserID), "expected the logs to not be redacted")
+
+		records := strings.Split(strings.TrimSpace(files[exportLogsCSV]), "\n")
+		assert.Equal(t, 3, len(records))
+		assert.Equal(t, strings.Join(csvLogsHeader, ","), records[0])
+	})
+
+	t.Run("should redact the identifiers of other users", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		_, realmClient := setup(t)
+		_, ui := mock.NewUI()
+
+		output := filepath.Join(tmpDir, "logs.zip")
+
+		cmd := &CommandExport{exportInputs{
+			UserID:    userID,
+			Start:     flags.Date{Time: start},
+			End:       flags.Date{Time: end},
+			RedactPII: true,
+			Output:    output,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+
+		files := readArchive(t, output)
+
+		var manifest exportManifest
+		assert.Nil(t, json.Unmarshal([]byte(files[exportManifestJSON]), &manifest))
+		assert.True(t, manifest.Redacted, "expected the manifest to be redacted")
+
+		lines := strings.Split(strings.TrimSpace(files[exportLogsJSON]), "\n")
+		assert.Equal(t, 2, len(lines))
+		assert.True(t, strings.Contains(lines[0], `"error":"user `+userID+` failed to log in"`), "expected the user id to be kept")
+		assert.True(t, strings.Contains(lines[1], `"messages":["shared a document with [REDACTED]",{"email":"[REDACTED]"}]`), "expected other users to be redacted")
+
+		assert.Equal(t, []interface{}{"shared a document with " + otherUserID, map[string]interface{}{"email": "other@example.com"}}, testLogs[0].Messages)
+	})
+}
+
+func TestLogsExportInputs(t *testing.T) {
+	start := time.Date(2021, time.June, 22, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		description string
+		inputs      exportInputs
+		expectedErr error
+	}{
+		{
+			description: "should return an error without a user",
+			inputs:      exportInputs{ProjectInputs: cli.ProjectInputs{App: "app"}, Start: flags.Date{Time: start}},
+			expectedErr: fmt.Errorf(`must specify the user to export logs for with "--user-id"`),
+		},
+		{
+			description: "should return an error without a start date",
+			inputs:      exportInputs{ProjectInputs: cli.ProjectInputs{App: "app"}, UserID: "user"},
+			expectedErr: fmt.Errorf(`must specify the date to export logs from with "--start"`),
+		},
+		{
+			description: "should return an error when the start date is not before the end date",
+			inputs:      exportInputs{ProjectInputs: cli.ProjectInputs{App: "app"}, UserID: "user", Start: flags.Date{Time: start}, End: flags.Date{Time: start}},
+			expectedErr: fmt.Errorf(`"--start" must be before "--end"`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, nil))
+		})
+	}
+
+	t.Run("should default the output to an archive named after the user", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		profile.WorkingDirectory = "/path/to/wd"
+
+		i := exportInputs{ProjectInputs: cli.ProjectInputs{App: "app"}, UserID: "user", Start: flags.Date{Time: start}}
+
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, filepath.Join("/path/to/wd", "logs-user.zip"), i.Output)
+		assert.False(t, i.End.Time.IsZero(), "expected the end date to default to now")
+	})
+}
@@ -1,8 +1,10 @@
 package logs
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -30,7 +32,17 @@ var CommandMetaList = cli.CommandMeta{
 	Description: "Lists the Logs in your Realm app",
 	HelpText: `Displays a list of your Realm app’s Logs sorted by recentness, with most recent
 Logs appearing towards the bottom. You can specify a "--tail" flag to monitor
-your Logs and follow any newly created Logs in real-time.`,
+your Logs and follow any newly created Logs in real-time.
+
+You can filter the Logs by their type, status, date and the ID of the User they
+were created for. The "--start" and "--end" flags accept either a date or how
+long ago it was, e.g. "2h". Specify a "--limit" to page through more Logs than
+are returned at once, and a "--display" of "table" or "json" to render each Log
+as a table row or as a line of JSON.
+
+Specify a "--group-by" of "type", "function" or "status" to instead display the
+number of Logs in each group along with a sample of its most recent Logs, which
+is useful to triage which parts of your app are failing.`,
 }
 
 // CommandList is the `logs list` command
@@ -44,9 +56,15 @@ func (cmd *CommandList) Flags(fs *pflag.FlagSet) {
 
 	fs.Var(flags.NewEnumSet(&cmd.inputs.Types, allLogTypes), flagType, flagTypeUsage)
 	fs.BoolVar(&cmd.inputs.Errors, flagErrors, false, flagErrorsUsage)
+	fs.StringVar(&cmd.inputs.Status, flagStatus, "", flagStatusUsage)
 	fs.Var(&cmd.inputs.Start, flagStartDate, flagStartDateUsage)
 	fs.Var(&cmd.inputs.End, flagEndDate, flagEndDateUsage)
+	fs.StringVar(&cmd.inputs.UserID, flagUser, "", flagUserUsage)
+	fs.IntVar(&cmd.inputs.Limit, flagLimit, 0, flagLimitUsage)
+	fs.StringVar(&cmd.inputs.Display, flagDisplay, displayList, flagDisplayUsage)
 	fs.BoolVar(&cmd.inputs.Tail, flagTail, false, flagTailUsage)
+	fs.StringVar(&cmd.inputs.GroupBy, flagGroupBy, "", flagGroupByUsage)
+	fs.IntVar(&cmd.inputs.GroupSamples, flagGroupSamples, defaultGroupSamples, flagGroupSamplesUsage)
 }
 
 // Inputs is the command inputs
@@ -64,12 +82,14 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 	}
 
 	opts := realm.LogsOptions{
-		Types:      cmd.inputs.Types,
-		ErrorsOnly: cmd.inputs.Errors,
+		Types:      cmd.inputs.logTypes(),
+		ErrorsOnly: cmd.inputs.Status == logStatusError,
+		UserID:     cmd.inputs.UserID,
 	}
 	if !cmd.inputs.Tail {
 		opts.Start = cmd.inputs.Start.Time
 		opts.End = cmd.inputs.End.Time
+		opts.Limit = cmd.inputs.Limit
 	}
 
 	logs, err := clients.Realm.Logs(app.GroupID, app.ID, opts)
@@ -81,7 +101,9 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 		logs = logs[0:tailLookBehind]
 	}
 
-	printLogs(ui, logs)
+	if err := cmd.printLogs(ui, logs); err != nil {
+		return err
+	}
 	if !cmd.inputs.Tail {
 		return nil // if not tailing, command stops here
 	}
@@ -95,7 +117,9 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 	for {
 		select {
 		case logs := <-logsCh:
-			printLogs(ui, logs)
+			if err := cmd.printLogs(ui, logs); err != nil {
+				return err
+			}
 		case err := <-errCh:
 			return err
 		case <-cmd.inputs.sigShutdown:
@@ -134,23 +158,221 @@ func pollForLogs(realmClient realm.Client, groupID, appID string, opts realm.Log
 	}
 }
 
-func printLogs(ui terminal.UI, logs realm.Logs) {
+func (cmd *CommandList) printLogs(ui terminal.UI, logs realm.Logs) error {
+	if cmd.inputs.Status == logStatusSuccess {
+		logs = successfulLogs(logs)
+	}
 	sort.Sort(logs)
+
+	if cmd.inputs.GroupBy != "" {
+		printLogsGroups(ui, logs, cmd.inputs.GroupBy, cmd.inputs.GroupSamples)
+		return nil
+	}
+
+	switch cmd.inputs.Display {
+	case displayTable:
+		printLogsTable(ui, logs)
+	case displayJSON:
+		return printLogsJSON(ui, logs)
+	default:
+		printLogsList(ui, logs)
+	}
+	return nil
+}
+
+// successfulLogs returns the logs without an error, since the server can only filter for errors
+func successfulLogs(logs realm.Logs) realm.Logs {
+	filtered := make(realm.Logs, 0, len(logs))
+	for _, log := range logs {
+		if log.Error == "" {
+			filtered = append(filtered, log)
+		}
+	}
+	return filtered
+}
+
+const (
+	headerStarted  = "Started"
+	headerRuntime  = "Runtime"
+	headerType     = "Type"
+	headerName     = "Name"
+	headerStatus   = "Status"
+	headerMessages = "Messages"
+)
+
+func printLogsTable(ui terminal.UI, logs realm.Logs) {
+	if len(logs) == 0 {
+		return
+	}
+
+	rows := make([]map[string]interface{}, 0, len(logs))
+	for _, log := range logs {
+		messages := make([]string, 0, len(log.Messages))
+		for _, message := range log.Messages {
+			messages = append(messages, fmt.Sprint(message))
+		}
+		rows = append(rows, map[string]interface{}{
+			headerStarted:  log.Started.Format(dateFormat),
+			headerRuntime:  log.Completed.Sub(log.Started).String(),
+			headerType:     logTypeDisplay(log),
+			headerName:     strings.TrimPrefix(logNameDisplay(log), " "),
+			headerStatus:   logStatusDisplay(log),
+			headerMessages: strings.Join(messages, "; "),
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d logs", len(logs)),
+		[]string{headerStarted, headerRuntime, headerType, headerName, headerStatus, headerMessages},
+		rows...,
+	))
+}
+
+// printLogsJSON prints each log as a single line of JSON
+func printLogsJSON(ui terminal.UI, logs realm.Logs) error {
 	for _, log := range logs {
+		data, err := json.Marshal(log)
+		if err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("%s", data))
+	}
+	return nil
+}
+
+func printLogsList(ui terminal.UI, logs realm.Logs) {
+	for _, log := range logs {
+		ui.Print(logListLog(log, logStatusDisplay(log)))
+	}
+}
+
+func logListLog(log realm.Log, status string) terminal.Log {
+	return terminal.NewListLog(logSummary(log, status), log.Messages...).WithRows(log)
+}
+
+func logSummary(log realm.Log, status string) string {
+	return fmt.Sprintf(
+		"%s %9s %26s%s: %s",
+		log.Started.Format(dateFormat),
+		fmt.Sprintf("[%s]", log.Completed.Sub(log.Started)), // 9 provides spacing for runtime
+		logTypeDisplay(log),                                 // 26 provides spacing for type (see test)
+		logNameDisplay(log),
+		status,
+	)
+}
+
+const (
+	headerFunction = "Function"
+	headerCount    = "Count"
+	headerLastSeen = "Last Seen"
+
+	groupNoFunction = "(no function)"
+)
+
+type logsGroup struct {
+	key  string
+	logs realm.Logs
+}
+
+// printLogsGroups prints the number of logs in each group, with the largest groups first,
+// followed by a sample of the most recent logs in each group
+func printLogsGroups(ui terminal.UI, logs realm.Logs, groupBy string, samples int) {
+	if len(logs) == 0 {
+		return
+	}
+
+	groups := groupLogs(logs, groupBy)
+
+	header := headerType
+	switch groupBy {
+	case groupByFunction:
+		header = headerFunction
+	case groupByStatus:
+		header = headerStatus
+	}
+
+	rows := make([]map[string]interface{}, 0, len(groups))
+	for _, group := range groups {
+		rows = append(rows, map[string]interface{}{
+			header:         group.key,
+			headerCount:    len(group.logs),
+			headerLastSeen: group.logs[len(group.logs)-1].Started.Format(dateFormat),
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d logs in %d group(s)", len(logs), len(groups)),
+		[]string{header, headerCount, headerLastSeen},
+		rows...,
+	))
+
+	if samples == 0 {
+		return
+	}
+
+	for _, group := range groups {
+		sample := group.logs
+		if len(sample) > samples {
+			sample = sample[len(sample)-samples:]
+		}
+
+		summaries := make([]interface{}, 0, len(sample))
+		for _, log := range sample {
+			summaries = append(summaries, logSummary(log, logStatusDisplay(log)))
+		}
+
 		ui.Print(terminal.NewListLog(
-			fmt.Sprintf(
-				"%s %9s %26s%s: %s",
-				log.Started.Format(dateFormat),
-				fmt.Sprintf("[%s]", log.Completed.Sub(log.Started)), // 9 provides spacing for runtime
-				logTypeDisplay(log),                                 // 26 provides spacing for type (see test)
-				logNameDisplay(log),
-				logStatusDisplay(log),
-			),
-			log.Messages...,
+			fmt.Sprintf("%s (%d of %d logs)", group.key, len(sample), len(group.logs)),
+			summaries...,
 		))
 	}
 }
 
+// groupLogs buckets the sorted logs by the specified group, keeping the logs of each group sorted
+func groupLogs(logs realm.Logs, groupBy string) []logsGroup {
+	var groups []logsGroup
+
+	indexes := map[string]int{}
+	for _, log := range logs {
+		var key string
+		switch groupBy {
+		case groupByFunction:
+			key = logFunctionDisplay(log)
+		case groupByStatus:
+			key = logStatusDisplay(log)
+		default:
+			key = logTypeDisplay(log)
+		}
+
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(groups)
+			indexes[key] = idx
+			groups = append(groups, logsGroup{key: key})
+		}
+		groups[idx].logs = append(groups[idx].logs, log)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if len(groups[i].logs) != len(groups[j].logs) {
+			return len(groups[i].logs) > len(groups[j].logs)
+		}
+		return groups[i].key < groups[j].key
+	})
+
+	return groups
+}
+
+func logFunctionDisplay(log realm.Log) string {
+	if log.FunctionName != "" {
+		return log.FunctionName
+	}
+	if log.FunctionID != "" {
+		return log.FunctionID
+	}
+	return groupNoFunction
+}
+
 func logNameDisplay(log realm.Log) string {
 	var name, prefix string
 
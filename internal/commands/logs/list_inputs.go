@@ -1,6 +1,7 @@
 package logs
 
 import (
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -19,36 +20,132 @@ const (
 	flagErrors      = "errors"
 	flagErrorsUsage = "specify to view only error logs"
 
+	flagStatus      = "status"
+	flagStatusUsage = "specify the status of logs to list, available options: [error, success]"
+
 	flagStartDate      = "start"
-	flagStartDateUsage = "specify the start date to begin listing logs from"
+	flagStartDateUsage = `specify the start date to begin listing logs from, or how long ago to begin from, e.g. "2h"`
 
 	flagEndDate      = "end"
-	flagEndDateUsage = "specify the end date to finish listing logs from"
+	flagEndDateUsage = `specify the end date to finish listing logs from, or how long ago to finish at, e.g. "30m"`
+
+	flagUser      = "user"
+	flagUserUsage = "specify the id of the user to list logs for"
+
+	flagLimit      = "limit"
+	flagLimitUsage = "specify the maximum number of logs to list, paging through the logs until it is reached (default: a single page of logs)"
+
+	flagDisplay      = "display"
+	flagDisplayUsage = "specify how to display the logs, available options: [list, table, json]"
 
 	flagTail      = "tail"
 	flagTailUsage = "specify to view logs in real-time (note: start and end dates are ignored here)"
+
+	flagGroupBy      = "group-by"
+	flagGroupByUsage = "specify to group the logs and display the number of logs in each group, available options: [type, function, status]"
+
+	flagGroupSamples      = "group-samples"
+	flagGroupSamplesUsage = "specify the number of the most recent logs to display for each group"
+
+	defaultGroupSamples = 3
+)
+
+// set of supported log statuses
+const (
+	logStatusError   = "error"
+	logStatusSuccess = "success"
+)
+
+// set of supported ways to group logs
+const (
+	groupByType     = "type"
+	groupByFunction = "function"
+	groupByStatus   = "status"
+)
+
+// set of supported ways to display logs
+const (
+	displayList  = "list"
+	displayTable = "table"
+	displayJSON  = "json"
 )
 
 type listInputs struct {
 	cli.ProjectInputs
-	Types       []string
-	Errors      bool
-	Start       flags.Date
-	End         flags.Date
-	Tail        bool
-	sigShutdown chan os.Signal
+	Types        []string
+	Errors       bool
+	Status       string
+	Start        flags.Date
+	End          flags.Date
+	UserID       string
+	Limit        int
+	Display      string
+	Tail         bool
+	GroupBy      string
+	GroupSamples int
+	sigShutdown  chan os.Signal
 }
 
 func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := validateStatus(i.Status); err != nil {
+		return err
+	}
+	if i.Errors {
+		if i.Status == logStatusSuccess {
+			return fmt.Errorf(`cannot use "--%s" with "--%s %s"`, flagErrors, flagStatus, logStatusSuccess)
+		}
+		i.Status = logStatusError
+	}
+
+	switch i.Display {
+	case "", displayList, displayTable, displayJSON:
+	default:
+		return fmt.Errorf("unsupported value for %q: '%s', use one of [%s, %s, %s] instead", "--"+flagDisplay, i.Display, displayList, displayTable, displayJSON)
+	}
+
+	if i.Limit < 0 {
+		return fmt.Errorf(`"--%s" must not be negative`, flagLimit)
+	}
+
+	switch i.GroupBy {
+	case "":
+	case groupByType, groupByFunction, groupByStatus:
+		if i.Tail {
+			return fmt.Errorf(`cannot use "--%s" with "--%s"`, flagGroupBy, flagTail)
+		}
+		if i.Display == displayJSON {
+			return fmt.Errorf(`cannot use "--%s" with "--%s %s"`, flagGroupBy, flagDisplay, displayJSON)
+		}
+	default:
+		return fmt.Errorf("unsupported value for %q: '%s', use one of [%s, %s, %s] instead", "--"+flagGroupBy, i.GroupBy, groupByType, groupByFunction, groupByStatus)
+	}
+
+	if i.GroupSamples < 0 {
+		return fmt.Errorf(`"--%s" must not be negative`, flagGroupSamples)
+	}
+
 	i.sigShutdown = make(chan os.Signal, 1)
 	signal.Notify(i.sigShutdown, syscall.SIGTERM, syscall.SIGINT)
 
 	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
 }
 
+func validateStatus(status string) error {
+	switch status {
+	case "", logStatusError, logStatusSuccess:
+		return nil
+	}
+	return fmt.Errorf("unsupported value for %q: '%s', use one of [%s, %s] instead", "--"+flagStatus, status, logStatusError, logStatusSuccess)
+}
+
 func (i *listInputs) logTypes() []string {
+	return logTypes(i.Types)
+}
+
+// logTypes returns the Realm app log types for the specified types of logs
+func logTypes(names []string) []string {
 	var types []string
-	for _, lt := range i.Types {
+	for _, lt := range names {
 		switch lt {
 		case logTypeAuth:
 			types = append(types, realm.LogTypeAuth, realm.LogTypeAPIKey)
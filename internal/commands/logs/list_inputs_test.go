@@ -1,10 +1,13 @@
 package logs
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
 )
 
 func TestLogTypes(t *testing.T) {
@@ -29,3 +32,69 @@ func TestLogTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestLogsListInputsResolve(t *testing.T) {
+	t.Run("should set the error status with the errors flag", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		i := listInputs{ProjectInputs: cli.ProjectInputs{App: "test-app"}, Errors: true}
+
+		assert.Nil(t, i.Resolve(profile, ui))
+		assert.Equal(t, logStatusError, i.Status)
+	})
+
+	for _, tc := range []struct {
+		description string
+		inputs      listInputs
+		expectedErr error
+	}{
+		{
+			description: "with an unsupported status",
+			inputs:      listInputs{Status: "pending"},
+			expectedErr: errors.New(`unsupported value for "--status": 'pending', use one of [error, success] instead`),
+		},
+		{
+			description: "with the errors flag and a success status",
+			inputs:      listInputs{Errors: true, Status: logStatusSuccess},
+			expectedErr: errors.New(`cannot use "--errors" with "--status success"`),
+		},
+		{
+			description: "with an unsupported display",
+			inputs:      listInputs{Display: "csv"},
+			expectedErr: errors.New(`unsupported value for "--display": 'csv', use one of [list, table, json] instead`),
+		},
+		{
+			description: "with a negative limit",
+			inputs:      listInputs{Limit: -1},
+			expectedErr: errors.New(`"--limit" must not be negative`),
+		},
+		{
+			description: "with an unsupported group by",
+			inputs:      listInputs{GroupBy: "user"},
+			expectedErr: errors.New(`unsupported value for "--group-by": 'user', use one of [type, function, status] instead`),
+		},
+		{
+			description: "with a group by and tail",
+			inputs:      listInputs{GroupBy: groupByType, Tail: true},
+			expectedErr: errors.New(`cannot use "--group-by" with "--tail"`),
+		},
+		{
+			description: "with a group by and a json display",
+			inputs:      listInputs{GroupBy: groupByType, Display: displayJSON},
+			expectedErr: errors.New(`cannot use "--group-by" with "--display json"`),
+		},
+		{
+			description: "with a negative number of group samples",
+			inputs:      listInputs{GroupBy: groupByType, GroupSamples: -1},
+			expectedErr: errors.New(`"--group-samples" must not be negative`),
+		},
+	} {
+		t.Run("should return an error "+tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, ui))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/flags"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
 )
@@ -97,6 +98,115 @@ func TestLogsList(t *testing.T) {
 	})
 }
 
+func TestLogsListFilters(t *testing.T) {
+	testLogs := realm.Logs{
+		{
+			Type:         realm.LogTypeFunction,
+			Messages:     []interface{}{"a test log message", 42},
+			Started:      time.Date(2021, time.June, 22, 7, 54, 42, 0, time.UTC),
+			Completed:    time.Date(2021, time.June, 22, 7, 54, 43, 234_000_000, time.UTC),
+			FunctionName: "func0",
+			UserID:       "user0",
+		},
+		{
+			Type:                  realm.LogTypeDBTrigger,
+			Error:                 "something bad happened",
+			ErrorCode:             "Function",
+			Started:               time.Date(2020, time.June, 22, 7, 54, 42, 0, time.UTC),
+			Completed:             time.Date(2020, time.June, 22, 7, 54, 42, 123_000_000, time.UTC),
+			EventSubscriptionName: "suessTrigger",
+		},
+	}
+
+	t.Run("should query the logs with the filters", func(t *testing.T) {
+		start := time.Date(2021, time.June, 22, 5, 54, 42, 0, time.UTC)
+
+		var optsList []realm.LogsOptions
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			optsList = append(optsList, opts)
+			return nil, nil
+		}
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandList{listInputs{
+			Types:  []string{logTypeTrigger, logTypeFunction},
+			Status: logStatusError,
+			Start:  flags.Date{Time: start},
+			UserID: "user0",
+			Limit:  250,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []realm.LogsOptions{{
+			Types:      []string{realm.LogTypeAuthTrigger, realm.LogTypeDBTrigger, realm.LogTypeScheduledTrigger, realm.LogTypeFunction},
+			ErrorsOnly: true,
+			Start:      start,
+			UserID:     "user0",
+			Limit:      250,
+		}}, optsList)
+	})
+
+	for _, tc := range []struct {
+		description    string
+		inputs         listInputs
+		expectedOutput string
+	}{
+		{
+			description: "should print only the successful logs with a success status",
+			inputs:      listInputs{Status: logStatusSuccess},
+			expectedOutput: `2021-06-22T07:54:42.000+0000  [1.234s]                   Function func0: OK
+  a test log message
+  42
+`,
+		},
+		{
+			description: "should print the logs as a table",
+			inputs:      listInputs{Display: displayTable},
+			expectedOutput: strings.Join([]string{
+				"Found 2 logs",
+				"  Started                       Runtime  Type                 Name          Status                                  Messages              ",
+				"  ----------------------------  -------  -------------------  ------------  --------------------------------------  ----------------------",
+				"  2020-06-22T07:54:42.000+0000  123ms    Trigger -> Database  suessTrigger  FunctionError - something bad happened                        ",
+				"  2021-06-22T07:54:42.000+0000  1.234s   Function             func0         OK                                      a test log message; 42",
+				"",
+			}, "\n"),
+		},
+		{
+			description: "should print the logs as json lines",
+			inputs:      listInputs{Display: displayJSON},
+			expectedOutput: strings.Join([]string{
+				`{"messages":null,"type":"DB_TRIGGER","started":"2020-06-22T07:54:42Z","completed":"2020-06-22T07:54:42.123Z","mem_time_usage":0,"error":"something bad happened","error_code":"Function","auth_event":{"failed":false,"type":"","provider":""},"event_subscription_id":"","event_subscription_name":"suessTrigger","function_id":"","function_name":"","incoming_webhook_id":"","incoming_webhook_name":""}`,
+				`{"messages":["a test log message",42],"type":"FUNCTION","started":"2021-06-22T07:54:42Z","completed":"2021-06-22T07:54:43.234Z","mem_time_usage":0,"error":"","error_code":"","user_id":"user0","auth_event":{"failed":false,"type":"","provider":""},"event_subscription_id":"","event_subscription_name":"","function_id":"","function_name":"func0","incoming_webhook_id":"","incoming_webhook_name":""}`,
+				"",
+			}, "\n"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{}}, nil
+			}
+			realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+				logs := make(realm.Logs, len(testLogs))
+				copy(logs, testLogs)
+				return logs, nil
+			}
+
+			out, ui := mock.NewUI()
+
+			cmd := &CommandList{tc.inputs}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+		})
+	}
+}
+
 func TestLogsListTail(t *testing.T) {
 	t.Run("should poll for logs until a shutdown signal is received", func(t *testing.T) {
 		var logIdx int
@@ -386,3 +496,99 @@ func TestLogTypeDisplay(t *testing.T) {
 	}
 	t.Logf("the max width for log type display is: %d", maxWidth)
 }
+
+func TestLogsListGroupBy(t *testing.T) {
+	newLog := func(logType, functionName, errorCode, err string, day int) realm.Log {
+		return realm.Log{
+			Type:         logType,
+			FunctionName: functionName,
+			ErrorCode:    errorCode,
+			Error:        err,
+			Started:      time.Date(2021, time.June, day, 7, 54, 42, 0, time.UTC),
+			Completed:    time.Date(2021, time.June, day, 7, 54, 42, 5_000_000, time.UTC),
+		}
+	}
+
+	testLogs := realm.Logs{
+		newLog(realm.LogTypeFunction, "func0", "", "", 1),
+		newLog(realm.LogTypeFunction, "func1", "Function", "something bad happened", 2),
+		newLog(realm.LogTypeFunction, "func0", "", "", 3),
+		newLog(realm.LogTypeAuth, "", "", "", 4),
+		newLog(realm.LogTypeFunction, "func1", "Function", "something bad happened", 5),
+		newLog(realm.LogTypeFunction, "func0", "", "", 6),
+	}
+
+	for _, tc := range []struct {
+		description    string
+		inputs         listInputs
+		expectedOutput string
+	}{
+		{
+			description: "should print the number of logs of each type",
+			inputs:      listInputs{GroupBy: groupByType},
+			expectedOutput: strings.Join([]string{
+				"Found 6 logs in 2 group(s)",
+				"  Type            Count  Last Seen                   ",
+				"  --------------  -----  ----------------------------",
+				"  Function        5      2021-06-06T07:54:42.000+0000",
+				"  Authentication  1      2021-06-04T07:54:42.000+0000",
+				"",
+			}, "\n"),
+		},
+		{
+			description: "should print the number of logs of each function with samples of the most recent logs",
+			inputs:      listInputs{GroupBy: groupByFunction, GroupSamples: 2},
+			expectedOutput: strings.Join([]string{
+				"Found 6 logs in 3 group(s)",
+				"  Function       Count  Last Seen                   ",
+				"  -------------  -----  ----------------------------",
+				"  func0          3      2021-06-06T07:54:42.000+0000",
+				"  func1          2      2021-06-05T07:54:42.000+0000",
+				"  (no function)  1      2021-06-04T07:54:42.000+0000",
+				"func0 (2 of 3 logs)",
+				"  2021-06-03T07:54:42.000+0000     [5ms]                   Function func0: OK",
+				"  2021-06-06T07:54:42.000+0000     [5ms]                   Function func0: OK",
+				"func1 (2 of 2 logs)",
+				"  2021-06-02T07:54:42.000+0000     [5ms]                   Function func1: FunctionError - something bad happened",
+				"  2021-06-05T07:54:42.000+0000     [5ms]                   Function func1: FunctionError - something bad happened",
+				"(no function) (1 of 1 logs)",
+				"  2021-06-04T07:54:42.000+0000     [5ms]             Authentication: OK",
+				"",
+			}, "\n"),
+		},
+		{
+			description: "should print the number of logs of each status",
+			inputs:      listInputs{GroupBy: groupByStatus, Status: logStatusError},
+			expectedOutput: strings.Join([]string{
+				"Found 2 logs in 1 group(s)",
+				"  Status                                  Count  Last Seen                   ",
+				"  --------------------------------------  -----  ----------------------------",
+				"  FunctionError - something bad happened  2      2021-06-05T07:54:42.000+0000",
+				"",
+			}, "\n"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{}}, nil
+			}
+			realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+				var logs realm.Logs
+				for _, log := range testLogs {
+					if !opts.ErrorsOnly || log.Error != "" {
+						logs = append(logs, log)
+					}
+				}
+				return logs, nil
+			}
+
+			out, ui := mock.NewUI()
+
+			cmd := &CommandList{tc.inputs}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+		})
+	}
+}
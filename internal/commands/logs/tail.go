@@ -0,0 +1,196 @@
+package logs
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/fatih/color"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaTail is the command meta for the `logs tail` command
+var CommandMetaTail = cli.CommandMeta{
+	Use:         "tail",
+	Display:     "logs tail",
+	Description: "Print the most recent Logs of your Realm app",
+	HelpText: `Displays the most recent Logs of your Realm app, with the most recent Logs
+appearing towards the bottom. Specify "--follow" to keep printing any newly
+created Logs as they arrive until the command is interrupted.
+
+While following, failing to get the Logs is retried with an increasing delay,
+so brief network or server errors do not stop the command.`,
+}
+
+// CommandTail is the `logs tail` command
+type CommandTail struct {
+	inputs tailInputs
+}
+
+const (
+	flagFollow      = "follow"
+	flagFollowUsage = "specify to keep printing newly created logs until interrupted"
+
+	flagLines      = "lines"
+	flagLinesShort = "n"
+	flagLinesUsage = "specify the number of the most recent logs to print"
+
+	flagInterval      = "interval"
+	flagIntervalUsage = "specify the amount of time to wait between checking for new logs"
+
+	flagRetries      = "retries"
+	flagRetriesUsage = "specify the number of consecutive times to retry getting logs before failing"
+
+	defaultTailLines    = 10
+	defaultTailInterval = 5 * time.Second
+	defaultTailRetries  = 5
+
+	maxTailRetryDelay = time.Minute
+)
+
+type tailInputs struct {
+	cli.ProjectInputs
+	Types       []string
+	Status      string
+	UserID      string
+	Follow      bool
+	Lines       int
+	Interval    time.Duration
+	Retries     int
+	sigShutdown chan os.Signal
+}
+
+// Flags is the command flags
+func (cmd *CommandTail) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.BoolVar(&cmd.inputs.Follow, flagFollow, false, flagFollowUsage)
+	fs.IntVarP(&cmd.inputs.Lines, flagLines, flagLinesShort, defaultTailLines, flagLinesUsage)
+	fs.Var(flags.NewEnumSet(&cmd.inputs.Types, allLogTypes), flagType, flagTypeUsage)
+	fs.StringVar(&cmd.inputs.Status, flagStatus, "", flagStatusUsage)
+	fs.StringVar(&cmd.inputs.UserID, flagUser, "", flagUserUsage)
+	fs.DurationVar(&cmd.inputs.Interval, flagInterval, defaultTailInterval, flagIntervalUsage)
+	fs.IntVar(&cmd.inputs.Retries, flagRetries, defaultTailRetries, flagRetriesUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandTail) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandTail) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	opts := realm.LogsOptions{
+		Types:      logTypes(cmd.inputs.Types),
+		ErrorsOnly: cmd.inputs.Status == logStatusError,
+		UserID:     cmd.inputs.UserID,
+		Limit:      cmd.inputs.Lines,
+	}
+
+	since := time.Now()
+	logs, err := clients.Realm.Logs(app.GroupID, app.ID, opts)
+	if err != nil {
+		return err
+	}
+	cmd.printLogs(ui, logs)
+
+	if !cmd.inputs.Follow {
+		return nil
+	}
+
+	opts.Limit = 0
+
+	var failures int
+	wait := cmd.inputs.Interval
+	for {
+		select {
+		case <-cmd.inputs.sigShutdown:
+			return nil
+		case <-time.After(wait):
+		}
+
+		next := time.Now()
+
+		opts.Start = since
+		logs, err := clients.Realm.Logs(app.GroupID, app.ID, opts)
+		if err != nil {
+			failures++
+			if failures > cmd.inputs.Retries {
+				return fmt.Errorf("failed to get logs after %d attempt(s): %w", failures, err)
+			}
+			wait = retryDelay(cmd.inputs.Interval, failures)
+			ui.Print(terminal.NewWarningLog("Failed to get logs, retrying in %s: %s", wait, err))
+			continue
+		}
+
+		failures = 0
+		wait = cmd.inputs.Interval
+		since = next
+
+		cmd.printLogs(ui, logs)
+	}
+}
+
+func (cmd *CommandTail) printLogs(ui terminal.UI, logs realm.Logs) {
+	if cmd.inputs.Status == logStatusSuccess {
+		logs = successfulLogs(logs)
+	}
+	sort.Sort(logs)
+
+	for _, log := range logs {
+		status := logStatusDisplay(log)
+		if log.Error == "" {
+			status = color.GreenString(status)
+		} else {
+			status = color.RedString(status)
+		}
+		ui.Print(logListLog(log, status))
+	}
+}
+
+// retryDelay returns the delay before retrying to get logs, which doubles
+// with each consecutive failure up to a maximum
+func retryDelay(interval time.Duration, failures int) time.Duration {
+	delay := interval
+	for i := 0; i < failures && delay < maxTailRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxTailRetryDelay {
+		return maxTailRetryDelay
+	}
+	return delay
+}
+
+func (i *tailInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := validateStatus(i.Status); err != nil {
+		return err
+	}
+	if i.Lines <= 0 {
+		return fmt.Errorf(`"--%s" must be a positive number`, flagLines)
+	}
+	if i.Interval <= 0 {
+		return fmt.Errorf(`"--%s" must be a positive duration`, flagInterval)
+	}
+	if i.Retries < 0 {
+		return fmt.Errorf(`"--%s" must not be negative`, flagRetries)
+	}
+
+	i.sigShutdown = make(chan os.Signal, 1)
+	signal.Notify(i.sigShutdown, syscall.SIGTERM, syscall.SIGINT)
+
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
+}
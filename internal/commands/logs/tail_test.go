@@ -0,0 +1,175 @@
+package logs
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestLogsTail(t *testing.T) {
+	newLog := func(year int, message string, err string) realm.Log {
+		return realm.Log{
+			Type:      realm.LogTypeAuth,
+			Started:   time.Date(year, time.June, 22, 7, 54, 42, 0, time.UTC),
+			Completed: time.Date(year, time.June, 22, 7, 54, 42, 5_000_000, time.UTC),
+			Messages:  []interface{}{message},
+			Error:     err,
+		}
+	}
+
+	newClient := func(logsFn func(opts realm.LogsOptions) (realm.Logs, error)) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			return logsFn(opts)
+		}
+		return realmClient
+	}
+
+	t.Run("should print the most recent logs without following", func(t *testing.T) {
+		var optsList []realm.LogsOptions
+		realmClient := newClient(func(opts realm.LogsOptions) (realm.Logs, error) {
+			optsList = append(optsList, opts)
+			return realm.Logs{newLog(2021, "lower log", ""), newLog(2020, "upper log", "something bad happened")}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandTail{tailInputs{Types: []string{logTypeFunction}, Status: logStatusError, UserID: "user0", Lines: 2}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `2020-06-22T07:54:42.000+0000     [5ms]             Authentication: Error - something bad happened
+  upper log
+2021-06-22T07:54:42.000+0000     [5ms]             Authentication: OK
+  lower log
+`, out.String())
+
+		assert.Equal(t, []realm.LogsOptions{{
+			Types:      []string{realm.LogTypeFunction},
+			ErrorsOnly: true,
+			UserID:     "user0",
+			Limit:      2,
+		}}, optsList)
+	})
+
+	t.Run("should follow new logs and retry after failing to get them", func(t *testing.T) {
+		sigShutdown := make(chan os.Signal, 1)
+
+		var optsList []realm.LogsOptions
+		realmClient := newClient(func(opts realm.LogsOptions) (realm.Logs, error) {
+			optsList = append(optsList, opts)
+			switch len(optsList) {
+			case 1:
+				return realm.Logs{newLog(2019, "initial log", "")}, nil
+			case 2:
+				return nil, errors.New("connection reset")
+			case 3:
+				return realm.Logs{newLog(2020, "followed log", "")}, nil
+			}
+			sigShutdown <- os.Interrupt
+			return nil, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandTail{tailInputs{Follow: true, Lines: 10, Interval: time.Millisecond, Retries: 1, sigShutdown: sigShutdown}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `2019-06-22T07:54:42.000+0000     [5ms]             Authentication: OK
+  initial log
+Warning: Failed to get logs, retrying in 2ms: connection reset
+2020-06-22T07:54:42.000+0000     [5ms]             Authentication: OK
+  followed log
+`, out.String())
+
+		assert.Equal(t, 4, len(optsList))
+		assert.Equal(t, 10, optsList[0].Limit)
+		assert.True(t, optsList[0].Start.IsZero(), "expected the initial logs to have no start date")
+		for _, opts := range optsList[1:] {
+			assert.Equal(t, 0, opts.Limit)
+			assert.False(t, opts.Start.IsZero(), "expected the followed logs to have a start date")
+		}
+		assert.Equal(t, optsList[1].Start, optsList[2].Start)
+		assert.True(t, optsList[3].Start.After(optsList[2].Start), "expected the start date to advance after getting logs")
+	})
+
+	t.Run("should return an error after failing to get logs more than the retries", func(t *testing.T) {
+		var calls int
+		realmClient := newClient(func(opts realm.LogsOptions) (realm.Logs, error) {
+			calls++
+			if calls == 1 {
+				return nil, nil
+			}
+			return nil, errors.New("something bad happened")
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandTail{tailInputs{Follow: true, Lines: 10, Interval: time.Millisecond, Retries: 2, sigShutdown: make(chan os.Signal, 1)}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, "failed to get logs after 3 attempt(s): something bad happened", err.Error())
+		assert.Equal(t, `Warning: Failed to get logs, retrying in 2ms: something bad happened
+Warning: Failed to get logs, retrying in 4ms: something bad happened
+`, out.String())
+	})
+}
+
+func TestLogsTailRetryDelay(t *testing.T) {
+	for _, tc := range []struct {
+		failures int
+		delay    time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{4, time.Minute},
+		{10, time.Minute},
+	} {
+		assert.Equal(t, tc.delay, retryDelay(5*time.Second, tc.failures))
+	}
+}
+
+func TestLogsTailInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      tailInputs
+		expectedErr error
+	}{
+		{
+			description: "with an unsupported status",
+			inputs:      tailInputs{Status: "pending", Lines: 10, Interval: time.Second},
+			expectedErr: errors.New(`unsupported value for "--status": 'pending', use one of [error, success] instead`),
+		},
+		{
+			description: "with no lines",
+			inputs:      tailInputs{Interval: time.Second},
+			expectedErr: errors.New(`"--lines" must be a positive number`),
+		},
+		{
+			description: "with no interval",
+			inputs:      tailInputs{Lines: 10},
+			expectedErr: errors.New(`"--interval" must be a positive duration`),
+		},
+		{
+			description: "with negative retries",
+			inputs:      tailInputs{Lines: 10, Interval: time.Second, Retries: -1},
+			expectedErr: errors.New(`"--retries" must not be negative`),
+		},
+	} {
+		t.Run("should return an error "+tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, ui))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package origins
+
+import (
+	"errors"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaAdd is the command meta for the `apps origins add` command
+var CommandMetaAdd = cli.CommandMeta{
+	Use:         "add <origin>...",
+	Display:     "apps origins add",
+	Description: "Allow requests to your Realm app from origins",
+	HelpText: `Adds the specified origins to those allowed to make requests to your Realm app
+from a browser. Each origin is a scheme and host, with an optional port, such
+as "https://example.com" or "http://localhost:3000".
+
+Note that once an origin is allowed, requests are only allowed from the listed
+origins rather than from any origin.`,
+}
+
+// CommandAdd is the `apps origins add` command
+type CommandAdd struct {
+	inputs inputs
+}
+
+// Args is the command args
+func (cmd *CommandAdd) Args(args []string) error {
+	if len(args) == 0 {
+		return errors.New("must specify at least one origin to add")
+	}
+	cmd.inputs.Origins = args
+	return nil
+}
+
+// Flags is the command flags
+func (cmd *CommandAdd) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandAdd) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandAdd) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	settings, err := clients.Realm.AppSettings(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	origins := settings.AllowedRequestOrigins
+	var added []string
+	for _, origin := range cmd.inputs.Origins {
+		if contains(origins, origin) {
+			continue
+		}
+		origins = append(origins, origin)
+		added = append(added, origin)
+	}
+
+	if len(added) == 0 {
+		ui.Print(terminal.NewTextLog("App %s already allows requests from the specified origin(s)", app.ClientAppID))
+		return nil
+	}
+
+	if err := clients.Realm.UpdateAppSettings(app.GroupID, app.ID, realm.AppSettingsUpdate{AllowedRequestOrigins: &origins}); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewListLog(
+		"Successfully added allowed request origin(s) to app "+app.ClientAppID,
+		toInterfaces(added)...,
+	))
+	return nil
+}
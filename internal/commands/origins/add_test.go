@@ -0,0 +1,82 @@
+package origins
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func newRealmClient(origins []string) (mock.RealmClient, *[]realm.AppSettingsUpdate) {
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+	}
+	realmClient.AppSettingsFn = func(groupID, appID string) (realm.AppSettings, error) {
+		return realm.AppSettings{AllowedRequestOrigins: origins}, nil
+	}
+
+	updates := []realm.AppSettingsUpdate{}
+	realmClient.UpdateAppSettingsFn = func(groupID, appID string, update realm.AppSettingsUpdate) error {
+		updates = append(updates, update)
+		return nil
+	}
+	return realmClient, &updates
+}
+
+func TestOriginsAddHandler(t *testing.T) {
+	t.Run("should add the origins which are not yet allowed", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, updates := newRealmClient([]string{"https://example.com"})
+
+		cmd := &CommandAdd{inputs{Origins: []string{"https://example.com", "http://localhost:3000"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully added allowed request origin(s) to app app-abcde\n  http://localhost:3000\n", out.String())
+
+		expectedOrigins := []string{"https://example.com", "http://localhost:3000"}
+		assert.Equal(t, []realm.AppSettingsUpdate{{AllowedRequestOrigins: &expectedOrigins}}, *updates)
+	})
+
+	t.Run("should not update the settings when the origins are already allowed", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, updates := newRealmClient([]string{"https://example.com"})
+
+		cmd := &CommandAdd{inputs{Origins: []string{"https://example.com"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "App app-abcde already allows requests from the specified origin(s)\n", out.String())
+		assert.Equal(t, []realm.AppSettingsUpdate{}, *updates)
+	})
+}
+
+func TestOriginsAddArgs(t *testing.T) {
+	t.Run("should return an error without an origin", func(t *testing.T) {
+		cmd := &CommandAdd{}
+		assert.Equal(t, errors.New("must specify at least one origin to add"), cmd.Args(nil))
+	})
+}
+
+func TestOriginsInputs(t *testing.T) {
+	t.Run("should normalize the origins", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := inputs{ProjectInputs: cli.ProjectInputs{App: "app-abcde"}, Origins: []string{"https://Example.com/"}}
+
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, []string{"https://example.com"}, i.Origins)
+	})
+
+	t.Run("should return an error for an invalid origin", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := inputs{ProjectInputs: cli.ProjectInputs{App: "app-abcde"}, Origins: []string{"example.com"}}
+
+		assert.Equal(t, errInvalidOrigin{"example.com"}, i.Resolve(profile, nil))
+	})
+}
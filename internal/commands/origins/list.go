@@ -0,0 +1,58 @@
+package origins
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaList is the command meta for the `apps origins list` command
+var CommandMetaList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "apps origins list",
+	Description: "List the allowed request origins of your Realm app",
+	HelpText: `Lists the origins allowed to make requests to your Realm app from a browser.
+When no origins are listed, requests from any origin are allowed.`,
+}
+
+// CommandList is the `apps origins list` command
+type CommandList struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	settings, err := clients.Realm.AppSettings(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(settings.AllowedRequestOrigins) == 0 {
+		ui.Print(terminal.NewTextLog("App %s allows requests from any origin", app.ClientAppID))
+		return nil
+	}
+
+	ui.Print(terminal.NewUnorderedListLog(
+		"Allowed request origins of app "+app.ClientAppID,
+		toInterfaces(settings.AllowedRequestOrigins)...,
+	))
+	return nil
+}
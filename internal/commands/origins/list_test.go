@@ -0,0 +1,48 @@
+package origins
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestOriginsListHandler(t *testing.T) {
+	for _, tc := range []struct {
+		description    string
+		origins        []string
+		expectedOutput string
+	}{
+		{
+			description:    "should print that any origin is allowed",
+			expectedOutput: "App app-abcde allows requests from any origin\n",
+		},
+		{
+			description: "should list the allowed request origins",
+			origins:     []string{"https://example.com", "http://localhost:3000"},
+			expectedOutput: `Allowed request origins of app app-abcde
+  https://example.com
+  http://localhost:3000
+`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}}, nil
+			}
+			realmClient.AppSettingsFn = func(groupID, appID string) (realm.AppSettings, error) {
+				return realm.AppSettings{AllowedRequestOrigins: tc.origins}, nil
+			}
+
+			cmd := &CommandList{}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package origins
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+type inputs struct {
+	cli.ProjectInputs
+	Origins []string
+}
+
+func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	for idx, origin := range i.Origins {
+		parsed, err := parseOrigin(origin)
+		if err != nil {
+			return err
+		}
+		i.Origins[idx] = parsed
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// parseOrigin validates the origin is a url made of only a scheme and host,
+// and returns it without a trailing slash as browsers send it
+func parseOrigin(origin string) (string, error) {
+	u, err := url.Parse(origin)
+	if err != nil ||
+		(u.Scheme != "http" && u.Scheme != "https") ||
+		u.Host == "" ||
+		u.User != nil ||
+		(u.Path != "" && u.Path != "/") ||
+		u.RawQuery != "" ||
+		u.Fragment != "" {
+		return "", errInvalidOrigin{origin}
+	}
+	return u.Scheme + "://" + strings.ToLower(u.Host), nil
+}
+
+func contains(origins []string, origin string) bool {
+	for _, o := range origins {
+		if o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+func toInterfaces(origins []string) []interface{} {
+	items := make([]interface{}, 0, len(origins))
+	for _, origin := range origins {
+		items = append(items, origin)
+	}
+	return items
+}
+
+type errInvalidOrigin struct {
+	origin string
+}
+
+func (err errInvalidOrigin) Error() string {
+	return fmt.Sprintf("invalid origin '%s', must be a scheme and host such as https://example.com", err.origin)
+}
+
+func (err errInvalidOrigin) DisableUsage() struct{} { return struct{}{} }
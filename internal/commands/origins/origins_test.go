@@ -0,0 +1,39 @@
+package origins
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestParseOrigin(t *testing.T) {
+	for _, tc := range []struct {
+		origin   string
+		expected string
+	}{
+		{origin: "https://example.com", expected: "https://example.com"},
+		{origin: "https://Example.com/", expected: "https://example.com"},
+		{origin: "http://localhost:3000", expected: "http://localhost:3000"},
+	} {
+		t.Run("should parse origin "+tc.origin, func(t *testing.T) {
+			origin, err := parseOrigin(tc.origin)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, origin)
+		})
+	}
+
+	for _, origin := range []string{
+		"example.com",
+		"ftp://example.com",
+		"https://",
+		"https://user@example.com",
+		"https://example.com/path",
+		"https://example.com?query=1",
+		"https://example.com#fragment",
+	} {
+		t.Run("should return an error for origin "+origin, func(t *testing.T) {
+			_, err := parseOrigin(origin)
+			assert.Equal(t, errInvalidOrigin{origin}, err)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package origins
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaRemove is the command meta for the `apps origins remove` command
+var CommandMetaRemove = cli.CommandMeta{
+	Use:         "remove <origin>...",
+	Aliases:     []string{"rm", "delete", "del"},
+	Display:     "apps origins remove",
+	Description: "Stop allowing requests to your Realm app from origins",
+	HelpText: `Removes the specified origins from those allowed to make requests to your Realm
+app from a browser.
+
+Removing every allowed origin allows requests from any origin, so you will be
+asked to confirm before doing so.`,
+}
+
+// CommandRemove is the `apps origins remove` command
+type CommandRemove struct {
+	inputs inputs
+}
+
+// Args is the command args
+func (cmd *CommandRemove) Args(args []string) error {
+	if len(args) == 0 {
+		return errors.New("must specify at least one origin to remove")
+	}
+	cmd.inputs.Origins = args
+	return nil
+}
+
+// Flags is the command flags
+func (cmd *CommandRemove) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandRemove) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandRemove) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	settings, err := clients.Realm.AppSettings(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, origin := range cmd.inputs.Origins {
+		if !contains(settings.AllowedRequestOrigins, origin) {
+			return fmt.Errorf("origin '%s' is not an allowed request origin of app %s", origin, app.ClientAppID)
+		}
+	}
+
+	origins := []string{}
+	for _, origin := range settings.AllowedRequestOrigins {
+		if !contains(cmd.inputs.Origins, origin) {
+			origins = append(origins, origin)
+		}
+	}
+
+	if len(origins) == 0 {
+		proceed, err := ui.Confirm("Removing every allowed request origin allows requests to app %s from any origin, are you sure?", app.ClientAppID)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return nil
+		}
+	}
+
+	if err := clients.Realm.UpdateAppSettings(app.GroupID, app.ID, realm.AppSettingsUpdate{AllowedRequestOrigins: &origins}); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewListLog(
+		"Successfully removed allowed request origin(s) from app "+app.ClientAppID,
+		toInterfaces(cmd.inputs.Origins)...,
+	))
+	return nil
+}
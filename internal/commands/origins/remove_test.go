@@ -0,0 +1,80 @@
+package origins
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestOriginsRemoveHandler(t *testing.T) {
+	t.Run("should remove the origins", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, updates := newRealmClient([]string{"https://example.com", "http://localhost:3000"})
+
+		cmd := &CommandRemove{inputs{Origins: []string{"http://localhost:3000"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully removed allowed request origin(s) from app app-abcde\n  http://localhost:3000\n", out.String())
+
+		expectedOrigins := []string{"https://example.com"}
+		assert.Equal(t, []realm.AppSettingsUpdate{{AllowedRequestOrigins: &expectedOrigins}}, *updates)
+	})
+
+	t.Run("should return an error when an origin is not allowed", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient, updates := newRealmClient([]string{"https://example.com"})
+
+		cmd := &CommandRemove{inputs{Origins: []string{"http://localhost:3000"}}}
+
+		assert.Equal(t,
+			errors.New("origin 'http://localhost:3000' is not an allowed request origin of app app-abcde"),
+			cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}),
+		)
+		assert.Equal(t, []realm.AppSettingsUpdate{}, *updates)
+	})
+
+	t.Run("should remove every origin once confirmed", func(t *testing.T) {
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		realmClient, updates := newRealmClient([]string{"https://example.com"})
+
+		cmd := &CommandRemove{inputs{Origins: []string{"https://example.com"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+
+		expectedOrigins := []string{}
+		assert.Equal(t, []realm.AppSettingsUpdate{{AllowedRequestOrigins: &expectedOrigins}}, *updates)
+	})
+
+	t.Run("should not remove every origin when not confirmed", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		realmClient, updates := newRealmClient([]string{"https://example.com"})
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Removing every allowed request origin allows requests to app app-abcde from any origin, are you sure?")
+			console.SendLine("n")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandRemove{inputs{Origins: []string{"https://example.com"}}}
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, []realm.AppSettingsUpdate{}, *updates)
+	})
+}
@@ -0,0 +1,219 @@
+package project
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	dateFormat = "2006-01-02T15:04:05.000-0700"
+)
+
+// CommandMetaAuditLog is the command meta for the `project audit-log` command
+var CommandMetaAuditLog = cli.CommandMeta{
+	Use:         "audit-log",
+	Aliases:     []string{"events"},
+	Display:     "project audit-log",
+	Description: "List the activity events of your Atlas project",
+	HelpText: `Displays the activity events recorded for your Atlas project, such as apps being
+created or deployed and changes to project users, sorted by when they occurred.
+
+With this command, you can:
+  - Filter events by the user or API key responsible with "--actor" flags
+  - Filter events by their type with "--type" flags
+  - Limit events to a time range with the "--start" and "--end" flags
+  - Print events as CSV with the "--csv" flag, or as JSON with "--output-format json"`,
+}
+
+// CommandAuditLog is the `project audit-log` command
+type CommandAuditLog struct {
+	inputs auditLogInputs
+}
+
+type auditLogInputs struct {
+	Project string
+	Actors  []string
+	Types   []string
+	Start   flags.Date
+	End     flags.Date
+	CSV     bool
+}
+
+const (
+	flagProject      = "project"
+	flagProjectUsage = "the MongoDB cloud project id"
+
+	flagActor      = "actor"
+	flagActorUsage = "specify the user(s) or API key(s) to list events for"
+
+	flagType      = "type"
+	flagTypeUsage = "specify the event type(s) to list (e.g. GROUP_CREATED)"
+
+	flagStartDate      = "start"
+	flagStartDateUsage = "specify the start date to begin listing events from"
+
+	flagEndDate      = "end"
+	flagEndDateUsage = "specify the end date to finish listing events from"
+
+	flagCSV      = "csv"
+	flagCSVUsage = "include to print events as CSV"
+)
+
+const (
+	headerCreated       = "Created"
+	headerType          = "Type"
+	headerActor         = "Actor"
+	headerTarget        = "Target"
+	headerRemoteAddress = "Remote Address"
+)
+
+// Flags is the command flags
+func (cmd *CommandAuditLog) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.Project, flagProject, "", flagProjectUsage)
+	fs.StringSliceVar(&cmd.inputs.Actors, flagActor, []string{}, flagActorUsage)
+	fs.StringSliceVar(&cmd.inputs.Types, flagType, []string{}, flagTypeUsage)
+	fs.Var(&cmd.inputs.Start, flagStartDate, flagStartDateUsage)
+	fs.Var(&cmd.inputs.End, flagEndDate, flagEndDateUsage)
+	fs.BoolVar(&cmd.inputs.CSV, flagCSV, false, flagCSVUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandAuditLog) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandAuditLog) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	groupID := cmd.inputs.Project
+	if groupID == "" {
+		id, err := cli.ResolveGroupID(ui, clients.Atlas)
+		if err != nil {
+			return err
+		}
+		groupID = id
+	}
+
+	events, err := clients.Atlas.Events(groupID, atlas.EventsOptions{
+		Start: cmd.inputs.Start.Time,
+		End:   cmd.inputs.End.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	events = cmd.inputs.filter(events)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Created.Before(events[j].Created)
+	})
+
+	if cmd.inputs.CSV {
+		out, err := eventsCSV(events)
+		if err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("%s", out))
+		return nil
+	}
+
+	if len(events) == 0 {
+		ui.Print(terminal.NewTextLog("No events found"))
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(events))
+	for _, event := range events {
+		rows = append(rows, map[string]interface{}{
+			headerCreated:       event.Created.Local().Format(dateFormat),
+			headerType:          event.Type,
+			headerActor:         event.Actor(),
+			headerTarget:        event.TargetUsername,
+			headerRemoteAddress: event.RemoteAddress,
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d event(s)", len(events)),
+		eventHeaders,
+		rows...,
+	))
+	return nil
+}
+
+var eventHeaders = []string{headerCreated, headerType, headerActor, headerTarget, headerRemoteAddress}
+
+func eventsCSV(events []atlas.Event) (string, error) {
+	buf := new(bytes.Buffer)
+
+	w := csv.NewWriter(buf)
+	if err := w.Write(eventHeaders); err != nil {
+		return "", err
+	}
+	for _, event := range events {
+		if err := w.Write([]string{
+			event.Created.UTC().Format(time.RFC3339),
+			event.Type,
+			event.Actor(),
+			event.TargetUsername,
+			event.RemoteAddress,
+		}); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func (i *auditLogInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if !i.Start.Time.IsZero() && !i.End.Time.IsZero() && i.End.Time.Before(i.Start.Time) {
+		return fmt.Errorf("--%s must be after --%s", flagEndDate, flagStartDate)
+	}
+	return nil
+}
+
+func (i auditLogInputs) filter(events []atlas.Event) []atlas.Event {
+	if len(i.Actors) == 0 && len(i.Types) == 0 {
+		return events
+	}
+
+	actors := make(map[string]struct{}, len(i.Actors))
+	for _, actor := range i.Actors {
+		actors[actor] = struct{}{}
+	}
+
+	types := make(map[string]struct{}, len(i.Types))
+	for _, t := range i.Types {
+		types[strings.ToUpper(t)] = struct{}{}
+	}
+
+	filtered := make([]atlas.Event, 0, len(events))
+	for _, event := range events {
+		if len(actors) > 0 {
+			if _, ok := actors[event.Actor()]; !ok {
+				continue
+			}
+		}
+		if len(types) > 0 {
+			if _, ok := types[event.Type]; !ok {
+				continue
+			}
+		}
+		filtered = append(filtered, event)
+	}
+	return filtered
+}
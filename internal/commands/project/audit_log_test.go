@@ -0,0 +1,135 @@
+package project
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestProjectAuditLogHandler(t *testing.T) {
+	t1 := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	t3 := t2.Add(time.Hour)
+
+	events := []atlas.Event{
+		{ID: "event3", Created: t3, Type: "GROUP_USER_ADDED", Username: "admin@example.com", TargetUsername: "dev@example.com", RemoteAddress: "10.0.0.1"},
+		{ID: "event1", Created: t1, Type: "REALM_APP_CREATED", Username: "dev@example.com", RemoteAddress: "10.0.0.2"},
+		{ID: "event2", Created: t2, Type: "REALM_APP_DEPLOYED", PublicKey: "abcdefgh"},
+	}
+
+	for _, tc := range []struct {
+		description    string
+		inputs         auditLogInputs
+		expectedOutput string
+	}{
+		{
+			description: "should list all events sorted by when they occurred",
+			inputs:      auditLogInputs{Project: "groupID"},
+			expectedOutput: strings.Join([]string{
+				"Found 3 event(s)",
+				"  Created                       Type                Actor              Target           Remote Address",
+				"  ----------------------------  ------------------  -----------------  ---------------  --------------",
+				"  " + t1.Local().Format(dateFormat) + "  REALM_APP_CREATED   dev@example.com                     10.0.0.2      ",
+				"  " + t2.Local().Format(dateFormat) + "  REALM_APP_DEPLOYED  abcdefgh                                          ",
+				"  " + t3.Local().Format(dateFormat) + "  GROUP_USER_ADDED    admin@example.com  dev@example.com  10.0.0.1      ",
+				"",
+			}, "\n"),
+		},
+		{
+			description: "should filter events by actor and type",
+			inputs:      auditLogInputs{Project: "groupID", Actors: []string{"dev@example.com", "abcdefgh"}, Types: []string{"realm_app_deployed"}},
+			expectedOutput: strings.Join([]string{
+				"Found 1 event(s)",
+				"  Created                       Type                Actor     Target  Remote Address",
+				"  ----------------------------  ------------------  --------  ------  --------------",
+				"  " + t2.Local().Format(dateFormat) + "  REALM_APP_DEPLOYED  abcdefgh                        ",
+				"",
+			}, "\n"),
+		},
+		{
+			description:    "should print a message when no events are found",
+			inputs:         auditLogInputs{Project: "groupID", Actors: []string{"someone@example.com"}},
+			expectedOutput: "No events found\n",
+		},
+		{
+			description: "should print the events as csv",
+			inputs:      auditLogInputs{Project: "groupID", CSV: true},
+			expectedOutput: strings.Join([]string{
+				"Created,Type,Actor,Target,Remote Address",
+				"2021-03-01T12:00:00Z,REALM_APP_CREATED,dev@example.com,,10.0.0.2",
+				"2021-03-01T13:00:00Z,REALM_APP_DEPLOYED,abcdefgh,,",
+				"2021-03-01T14:00:00Z,GROUP_USER_ADDED,admin@example.com,dev@example.com,10.0.0.1",
+				"",
+			}, "\n"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			var capturedGroupID string
+			atlasClient := mock.AtlasClient{}
+			atlasClient.EventsFn = func(groupID string, opts atlas.EventsOptions) ([]atlas.Event, error) {
+				capturedGroupID = groupID
+				return append([]atlas.Event{}, events...), nil
+			}
+
+			cmd := &CommandAuditLog{tc.inputs}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Atlas: atlasClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+			assert.Equal(t, "groupID", capturedGroupID)
+		})
+	}
+
+	t.Run("should resolve the project and pass the time range to the atlas client", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		var capturedGroupID string
+		var capturedOpts atlas.EventsOptions
+		atlasClient := mock.AtlasClient{}
+		atlasClient.GroupsFn = func() ([]atlas.Group, error) {
+			return []atlas.Group{{ID: "groupID", Name: "project"}}, nil
+		}
+		atlasClient.EventsFn = func(groupID string, opts atlas.EventsOptions) ([]atlas.Event, error) {
+			capturedGroupID = groupID
+			capturedOpts = opts
+			return nil, nil
+		}
+
+		cmd := &CommandAuditLog{auditLogInputs{Start: flags.Date{t1}, End: flags.Date{t3}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Atlas: atlasClient}))
+		assert.Equal(t, "groupID", capturedGroupID)
+		assert.Equal(t, atlas.EventsOptions{Start: t1, End: t3}, capturedOpts)
+	})
+
+	t.Run("should return an error when listing events fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		atlasClient := mock.AtlasClient{}
+		atlasClient.EventsFn = func(groupID string, opts atlas.EventsOptions) ([]atlas.Event, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandAuditLog{auditLogInputs{Project: "groupID"}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Atlas: atlasClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
+
+func TestProjectAuditLogInputs(t *testing.T) {
+	t.Run("should return an error when the end date is before the start date", func(t *testing.T) {
+		now := time.Now()
+
+		i := auditLogInputs{Start: flags.Date{now}, End: flags.Date{now.Add(-time.Hour)}}
+		assert.Equal(t, errors.New("--end must be after --start"), i.Resolve(nil, nil))
+	})
+}
@@ -0,0 +1,149 @@
+package project
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaMembersList is the command meta for the `project members list` command
+var CommandMetaMembersList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "project members list",
+	Description: "List the users, teams and API keys with access to your Atlas project",
+	HelpText: `Displays every user, team and API key with access to the Atlas project hosting
+your Realm app, along with the project roles each of them is assigned. This is
+useful when reviewing who can manage your Realm apps.
+
+Specify the app with "--app", otherwise you will be prompted to select the
+project. Print the members as JSON with "--output-format json".`,
+}
+
+// CommandMembersList is the `project members list` command
+type CommandMembersList struct {
+	inputs membersListInputs
+}
+
+type membersListInputs struct {
+	cli.ProjectInputs
+}
+
+const (
+	headerMemberType = "Type"
+	headerMemberName = "Name"
+	headerMemberID   = "ID"
+	headerRoles      = "Roles"
+
+	memberTypeUser   = "User"
+	memberTypeTeam   = "Team"
+	memberTypeAPIKey = "API Key"
+)
+
+// Flags is the command flags
+func (cmd *CommandMembersList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandMembersList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandMembersList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	groupID, err := cmd.inputs.resolveGroupID(ui, clients)
+	if err != nil {
+		return err
+	}
+
+	users, err := clients.Atlas.ProjectUsers(groupID)
+	if err != nil {
+		return err
+	}
+
+	teams, err := clients.Atlas.ProjectTeams(groupID)
+	if err != nil {
+		return err
+	}
+
+	apiKeys, err := clients.Atlas.ProjectAPIKeys(groupID)
+	if err != nil {
+		return err
+	}
+
+	rows := make([]map[string]interface{}, 0, len(users)+len(teams)+len(apiKeys))
+
+	sort.SliceStable(users, func(i, j int) bool { return users[i].Username < users[j].Username })
+	for _, u := range users {
+		rows = append(rows, memberRow(memberTypeUser, u.Username, u.ID, atlas.GroupRoleNames(groupID, u.Roles)))
+	}
+
+	sort.SliceStable(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
+	for _, team := range teams {
+		rows = append(rows, memberRow(memberTypeTeam, "", team.ID, team.RoleNames))
+	}
+
+	sort.SliceStable(apiKeys, func(i, j int) bool { return apiKeys[i].PublicKey < apiKeys[j].PublicKey })
+	for _, apiKey := range apiKeys {
+		name := apiKey.PublicKey
+		if apiKey.Description != "" {
+			name += " (" + apiKey.Description + ")"
+		}
+		rows = append(rows, memberRow(memberTypeAPIKey, name, apiKey.ID, atlas.GroupRoleNames(groupID, apiKey.Roles)))
+	}
+
+	if len(rows) == 0 {
+		ui.Print(terminal.NewTextLog("No members found for project %s", groupID))
+		return nil
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d member(s) with access to project %s", len(rows), groupID),
+		[]string{headerMemberType, headerMemberName, headerMemberID, headerRoles},
+		rows...,
+	))
+	return nil
+}
+
+func memberRow(memberType, name, id string, roles []string) map[string]interface{} {
+	sorted := make([]string, len(roles))
+	copy(sorted, roles)
+	sort.Strings(sorted)
+
+	return map[string]interface{}{
+		headerMemberType: memberType,
+		headerMemberName: name,
+		headerMemberID:   id,
+		headerRoles:      strings.Join(sorted, ", "),
+	}
+}
+
+func (i *membersListInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
+}
+
+// resolveGroupID finds the project hosting the specified app, falling back to
+// the specified project or a prompt to select one when no app is specified
+func (i membersListInputs) resolveGroupID(ui terminal.UI, clients cli.Clients) (string, error) {
+	if i.App != "" {
+		app, err := cli.ResolveApp(ui, clients.Realm, i.Filter())
+		if err != nil {
+			return "", err
+		}
+		return app.GroupID, nil
+	}
+
+	if i.Project != "" {
+		return i.Project, nil
+	}
+
+	return cli.ResolveGroupID(ui, clients.Atlas)
+}
@@ -0,0 +1,111 @@
+package project
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestProjectMembersListHandler(t *testing.T) {
+	setupAtlasClient := func(capturedGroupIDs *[]string) mock.AtlasClient {
+		atlasClient := mock.AtlasClient{}
+		atlasClient.ProjectUsersFn = func(groupID string) ([]atlas.ProjectUser, error) {
+			*capturedGroupIDs = append(*capturedGroupIDs, groupID)
+			return []atlas.ProjectUser{
+				{ID: "user2", Username: "dev@example.com", Roles: []atlas.Role{
+					{GroupID: "groupID", RoleName: "GROUP_READ_ONLY"},
+					{OrgID: "orgID", RoleName: "ORG_MEMBER"},
+				}},
+				{ID: "user1", Username: "admin@example.com", Roles: []atlas.Role{
+					{GroupID: "groupID", RoleName: "GROUP_OWNER"},
+					{GroupID: "groupID", RoleName: "GROUP_DATA_ACCESS_ADMIN"},
+				}},
+			}, nil
+		}
+		atlasClient.ProjectTeamsFn = func(groupID string) ([]atlas.ProjectTeam, error) {
+			*capturedGroupIDs = append(*capturedGroupIDs, groupID)
+			return []atlas.ProjectTeam{{ID: "team1", RoleNames: []string{"GROUP_CLUSTER_MANAGER"}}}, nil
+		}
+		atlasClient.ProjectAPIKeysFn = func(groupID string) ([]atlas.ProjectAPIKey, error) {
+			*capturedGroupIDs = append(*capturedGroupIDs, groupID)
+			return []atlas.ProjectAPIKey{{ID: "key1", PublicKey: "abcdefgh", Description: "ci", Roles: []atlas.Role{
+				{GroupID: "groupID", RoleName: "GROUP_OWNER"},
+			}}}, nil
+		}
+		return atlasClient
+	}
+
+	expectedOutput := strings.Join([]string{
+		"Found 4 member(s) with access to project groupID",
+		"  Type     Name               ID     Roles                               ",
+		"  -------  -----------------  -----  ------------------------------------",
+		"  User     admin@example.com  user1  GROUP_DATA_ACCESS_ADMIN, GROUP_OWNER",
+		"  User     dev@example.com    user2  GROUP_READ_ONLY                     ",
+		"  Team                        team1  GROUP_CLUSTER_MANAGER               ",
+		"  API Key  abcdefgh (ci)      key1   GROUP_OWNER                         ",
+		"",
+	}, "\n")
+
+	t.Run("should list the members of the project hosting the app", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", Name: "eggcorn"}}, nil
+		}
+
+		var capturedGroupIDs []string
+		atlasClient := setupAtlasClient(&capturedGroupIDs)
+
+		cmd := &CommandMembersList{membersListInputs{cli.ProjectInputs{App: "eggcorn"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient}))
+		assert.Equal(t, expectedOutput, out.String())
+		assert.Equal(t, []string{"groupID", "groupID", "groupID"}, capturedGroupIDs)
+	})
+
+	t.Run("should list the members of the specified project", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedGroupIDs []string
+		atlasClient := setupAtlasClient(&capturedGroupIDs)
+
+		cmd := &CommandMembersList{membersListInputs{cli.ProjectInputs{Project: "groupID"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Atlas: atlasClient}))
+		assert.Equal(t, expectedOutput, out.String())
+	})
+
+	t.Run("should print a message when no members are found", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		atlasClient := mock.AtlasClient{}
+		atlasClient.ProjectUsersFn = func(groupID string) ([]atlas.ProjectUser, error) { return nil, nil }
+		atlasClient.ProjectTeamsFn = func(groupID string) ([]atlas.ProjectTeam, error) { return nil, nil }
+		atlasClient.ProjectAPIKeysFn = func(groupID string) ([]atlas.ProjectAPIKey, error) { return nil, nil }
+
+		cmd := &CommandMembersList{membersListInputs{cli.ProjectInputs{Project: "groupID"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Atlas: atlasClient}))
+		assert.Equal(t, "No members found for project groupID\n", out.String())
+	})
+
+	t.Run("should return an error when listing the members fails", func(t *testing.T) {
+		atlasClient := mock.AtlasClient{}
+		atlasClient.ProjectUsersFn = func(groupID string) ([]atlas.ProjectUser, error) { return nil, nil }
+		atlasClient.ProjectTeamsFn = func(groupID string) ([]atlas.ProjectTeam, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandMembersList{membersListInputs{cli.ProjectInputs{Project: "groupID"}}}
+
+		err := cmd.Handler(nil, nil, cli.Clients{Atlas: atlasClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
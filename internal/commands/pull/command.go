@@ -2,10 +2,10 @@ package pull
 
 import (
 	"archive/zip"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
-	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -14,7 +14,6 @@ import (
 	"github.com/10gen/realm-cli/internal/terminal"
 	"github.com/10gen/realm-cli/internal/utils/flags"
 
-	"github.com/briandowns/spinner"
 	"github.com/spf13/pflag"
 )
 
@@ -25,7 +24,15 @@ var CommandMeta = cli.CommandMeta{
 	Description: "Exports the latest version of your Realm app into your local directory",
 	HelpText: `Pulls changes from your remote Realm app into your local directory. If
 applicable, Hosting Files and/or Dependencies associated with your Realm app will be
-exported as well.`,
+exported as well.
+
+Specify "--config-version" to export your Realm app with the directory structure of
+an older app config version. If the exported app has another config version, it is
+converted to the requested one. If run from inside an existing project directory, the
+config version must match the one found in that directory.
+
+The default Rule of each data source is written to its
+"data_sources/<name>/default_rule.json" file.`,
 }
 
 // Command is the `pull` command
@@ -45,7 +52,6 @@ func (cmd *Command) Flags(fs *pflag.FlagSet) {
 	flags.MarkHidden(fs, flagProject)
 
 	fs.Var(&cmd.inputs.AppVersion, flagConfigVersion, flagConfigVersionUsage)
-	flags.MarkHidden(fs, flagConfigVersion)
 }
 
 // Inputs is the command inputs
@@ -88,11 +94,32 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 	if err := local.WriteZip(pathTarget, zipPkg); err != nil {
 		return err
 	}
+
+	if cmd.inputs.AppVersion != realm.AppConfigVersionZero {
+		exportedVersion, err := convertApp(pathTarget, zipPkg, cmd.inputs.AppVersion)
+		if err != nil {
+			return err
+		}
+		if exportedVersion != cmd.inputs.AppVersion {
+			ui.Print(terminal.NewDebugLog(
+				"Converted exported app from config version %s to %s",
+				exportedVersion,
+				cmd.inputs.AppVersion,
+			))
+		}
+	}
 	ui.Print(terminal.NewTextLog("Saved app to disk"))
 
+	if err := writeDefaultRules(clients.Realm, appRemote, pathTarget); err != nil {
+		return err
+	}
+
+	if err := cli.CacheAppExport(profile, pathTarget); err != nil {
+		ui.Print(terminal.NewWarningLog("Failed to cache the app export: %s", err))
+	}
+
 	if cmd.inputs.IncludeDependencies {
-		s := spinner.New(terminal.SpinnerCircles, 250*time.Millisecond)
-		s.Suffix = " Fetching dependencies archive..."
+		s := ui.Spinner("Fetching dependencies archive...")
 
 		exportDependencies := func() error {
 			s.Start()
@@ -117,8 +144,7 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 	}
 
 	if cmd.inputs.IncludeHosting {
-		s := spinner.New(terminal.SpinnerCircles, 250*time.Millisecond)
-		s.Suffix = " Fetching hosting assets..."
+		s := ui.Spinner("Fetching hosting assets...")
 
 		exportHostingAssets := func() error {
 			s.Start()
@@ -129,7 +155,7 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 				return err
 			}
 
-			return local.WriteHostingAssets(clients.HostingAsset, pathTarget, appRemote.GroupID, appRemote.AppID, appAssets)
+			return local.WriteHostingAssets(clients.HostingAsset, pathTarget, appRemote.GroupID, appRemote.AppID, appAssets, profile.MaxParallel)
 		}
 
 		if err := exportHostingAssets(); err != nil {
@@ -191,3 +217,96 @@ func checkAppDestination(ui terminal.UI, path string) (bool, error) {
 
 	return ui.Confirm("Directory '%s' already exists, do you still wish to proceed?", path)
 }
+
+// convertApp rewrites the exported app with the directory structure of the config version
+// when it was exported with another one, and returns the config version it was exported with
+func convertApp(rootDir string, zipPkg *zip.Reader, configVersion realm.AppConfigVersion) (realm.AppConfigVersion, error) {
+	app, err := local.LoadApp(rootDir)
+	if err != nil {
+		return realm.AppConfigVersionZero, err
+	}
+	if app.RootDir != rootDir || app.ConfigVersion() == configVersion {
+		return configVersion, nil
+	}
+	exportedVersion := app.ConfigVersion()
+
+	converted, err := local.ConvertApp(app, configVersion)
+	if err != nil {
+		return realm.AppConfigVersionZero, err
+	}
+
+	// remove the exported structure, the hosting files are kept as they are the same in every config version
+	removed := map[string]struct{}{}
+	for _, file := range zipPkg.File {
+		name := strings.SplitN(filepath.ToSlash(file.Name), "/", 2)[0]
+		if _, ok := removed[name]; ok || name == "" || name == local.NameHosting {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(rootDir, name)); err != nil {
+			return realm.AppConfigVersionZero, err
+		}
+		removed[name] = struct{}{}
+	}
+
+	if err := converted.Write(); err != nil {
+		return realm.AppConfigVersionZero, err
+	}
+	return exportedVersion, nil
+}
+
+// writeDefaultRules writes the default rule of each of the exported app's data sources
+// into its "default_rule.json" file, unless the export already includes one
+func writeDefaultRules(realmClient realm.Client, remote appRemote, rootDir string) error {
+	files, err := ioutil.ReadDir(filepath.Join(rootDir, local.NameDataSources))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // the app has no data sources, or a config version without them
+		}
+		return err
+	}
+
+	dataSources := map[string]struct{}{}
+	for _, file := range files {
+		if file.IsDir() {
+			dataSources[file.Name()] = struct{}{}
+		}
+	}
+	if len(dataSources) == 0 {
+		return nil
+	}
+
+	services, err := realmClient.Services(remote.GroupID, remote.AppID)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		if _, ok := dataSources[service.Name]; !ok {
+			continue
+		}
+
+		if _, err := os.Stat(filepath.Join(rootDir, local.NameDataSources, service.Name, local.FileDefaultRule.String())); err == nil {
+			continue
+		}
+
+		defaultRule, ok, err := realmClient.DefaultRule(remote.GroupID, remote.AppID, service.ID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		data := map[string]interface{}{}
+		if len(defaultRule.Roles) > 0 {
+			data["roles"] = defaultRule.Roles
+		}
+		if len(defaultRule.Filters) > 0 {
+			data["filters"] = defaultRule.Filters
+		}
+		if err := local.WriteDefaultRule(rootDir, service.Name, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
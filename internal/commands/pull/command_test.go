@@ -317,6 +317,177 @@ Successfully pulled app down: app
 		assert.Nil(t, err)
 		assert.Equal(t, "<html><body>hello world!</body></html>", string(modifiedData))
 	})
+
+	t.Run("with a pinned config version", func(t *testing.T) {
+		newZipPkg := func(configFile local.File, configVersion realm.AppConfigVersion) *zip.Reader {
+			buf := new(bytes.Buffer)
+			w := zip.NewWriter(buf)
+			f, err := w.Create(configFile.String())
+			assert.Nil(t, err)
+			_, err = f.Write([]byte(fmt.Sprintf(`{"config_version":%d,"name":"app"}`, configVersion)))
+			assert.Nil(t, err)
+			f, err = w.Create("functions/test/config.json")
+			assert.Nil(t, err)
+			_, err = f.Write([]byte(`{"name":"test"}`))
+			assert.Nil(t, err)
+			f, err = w.Create("functions/test/source.js")
+			assert.Nil(t, err)
+			_, err = f.Write([]byte(`exports = () => "test"`))
+			assert.Nil(t, err)
+			assert.Nil(t, w.Close())
+
+			zipPkg, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			assert.Nil(t, err)
+			return zipPkg
+		}
+
+		for _, tc := range []struct {
+			description        string
+			exportConfigFile   local.File
+			exportVersion      realm.AppConfigVersion
+			configVersion      realm.AppConfigVersion
+			expectedConfigFile local.File
+			expectedSource     string
+			expectedRemoved    []string
+		}{
+			{
+				description:        "should write the app when the exported config version matches",
+				exportConfigFile:   local.FileConfig,
+				exportVersion:      realm.AppConfigVersion20200603,
+				configVersion:      realm.AppConfigVersion20200603,
+				expectedConfigFile: local.FileConfig,
+				expectedSource:     "functions/test/source.js",
+			},
+			{
+				description:        "should convert the app to an older config version than exported",
+				exportConfigFile:   local.FileConfig,
+				exportVersion:      realm.AppConfigVersion20200603,
+				configVersion:      realm.AppConfigVersion20180301,
+				expectedConfigFile: local.FileStitch,
+				expectedSource:     "functions/test/source.js",
+				expectedRemoved:    []string{local.FileConfig.String()},
+			},
+			{
+				description:        "should convert the app to a newer config version than exported",
+				exportConfigFile:   local.FileStitch,
+				exportVersion:      realm.AppConfigVersion20180301,
+				configVersion:      realm.AppConfigVersion20210101,
+				expectedConfigFile: local.FileRealmConfig,
+				expectedSource:     "functions/test.js",
+				expectedRemoved:    []string{local.FileStitch.String(), "functions/test"},
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				profile, teardown := mock.NewProfileFromTmpDir(t, "pull_handler_test")
+				defer teardown()
+
+				_, ui := mock.NewUI()
+
+				var capturedExportReq realm.ExportRequest
+				realmClient := mock.RealmClient{}
+				realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+					capturedExportReq = req
+					return "app_20210101", newZipPkg(tc.exportConfigFile, tc.exportVersion), nil
+				}
+
+				cmd := &Command{inputs{LocalPath: "app", AppVersion: tc.configVersion}}
+
+				assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+				assert.Equal(t, realm.ExportRequest{ConfigVersion: tc.configVersion}, capturedExportReq)
+
+				appDir := filepath.Join(profile.WorkingDirectory, "app")
+				for _, path := range tc.expectedRemoved {
+					_, err := os.Stat(filepath.Join(appDir, path))
+					assert.True(t, os.IsNotExist(err), "expected %s to be removed", path)
+				}
+
+				app, err := local.LoadApp(appDir)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.expectedConfigFile, app.Config)
+				assert.Equal(t, tc.configVersion, app.ConfigVersion())
+				assert.Equal(t, "app", app.Name())
+
+				source, err := ioutil.ReadFile(filepath.Join(appDir, tc.expectedSource))
+				assert.Nil(t, err)
+				assert.Equal(t, `exports = () => "test"`, string(source))
+			})
+		}
+	})
+
+	t.Run("should write the default rules of the exported data sources", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "pull_handler_test")
+		defer teardown()
+
+		buf := new(bytes.Buffer)
+		w := zip.NewWriter(buf)
+		for name, contents := range map[string]string{
+			"data_sources/mongodb-atlas/config.json":     `{"name":"mongodb-atlas","type":"mongodb-atlas"}`,
+			"data_sources/other-atlas/config.json":       `{"name":"other-atlas","type":"mongodb-atlas"}`,
+			"data_sources/other-atlas/default_rule.json": `{"roles":[{"name":"exported"}]}`,
+			"data_sources/missing-atlas/config.json":     `{"name":"missing-atlas","type":"mongodb-atlas"}`,
+		} {
+			f, err := w.Create(name)
+			assert.Nil(t, err)
+			_, err = f.Write([]byte(contents))
+			assert.Nil(t, err)
+		}
+		assert.Nil(t, w.Close())
+
+		zipPkg, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.Nil(t, err)
+
+		realmClient := mock.RealmClient{}
+		realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+			return "app_20210101", zipPkg, nil
+		}
+		realmClient.ServicesFn = func(groupID, appID string) ([]realm.Service, error) {
+			return []realm.Service{
+				{ID: "service1", Name: "mongodb-atlas", Type: "mongodb-atlas"},
+				{ID: "service2", Name: "other-atlas", Type: "mongodb-atlas"},
+				{ID: "service3", Name: "missing-atlas", Type: "mongodb-atlas"},
+				{ID: "service4", Name: "http", Type: "http"},
+			}, nil
+		}
+		var capturedServiceIDs []string
+		realmClient.DefaultRuleFn = func(groupID, appID, serviceID string) (realm.DefaultRule, bool, error) {
+			capturedServiceIDs = append(capturedServiceIDs, serviceID)
+			if serviceID == "service3" {
+				return realm.DefaultRule{}, false, nil
+			}
+			return realm.DefaultRule{
+				ID:    "rule1",
+				Roles: []map[string]interface{}{{"name": "readAll", "read": true}},
+			}, true, nil
+		}
+
+		_, ui := mock.NewUI()
+
+		cmd := &Command{inputs{LocalPath: "app"}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"service1", "service3"}, capturedServiceIDs)
+
+		dataSourcesDir := filepath.Join(profile.WorkingDirectory, "app", local.NameDataSources)
+
+		defaultRule, err := ioutil.ReadFile(filepath.Join(dataSourcesDir, "mongodb-atlas", local.FileDefaultRule.String()))
+		assert.Nil(t, err)
+		assert.Equal(t, `{
+    "roles": [
+        {
+            "name": "readAll",
+            "read": true
+        }
+    ]
+}
+`, string(defaultRule))
+
+		exportedRule, err := ioutil.ReadFile(filepath.Join(dataSourcesDir, "other-atlas", local.FileDefaultRule.String()))
+		assert.Nil(t, err)
+		assert.Equal(t, `{"roles":[{"name":"exported"}]}`, string(exportedRule))
+
+		_, err = os.Stat(filepath.Join(dataSourcesDir, "missing-atlas", local.FileDefaultRule.String()))
+		assert.True(t, os.IsNotExist(err), "expected no default rule to be written, but instead: %s", err)
+	})
 }
 
 func TestPullCommandDoExport(t *testing.T) {
@@ -2,6 +2,8 @@ package pull
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -34,11 +36,12 @@ const (
 	flagProject      = "project"
 	flagProjectUsage = "the MongoDB cloud project id"
 
-	flagConfigVersion      = "config-version"
-	flagConfigVersionUsage = "specify the app config version to export as"
+	flagConfigVersion = "config-version"
 )
 
 var (
+	flagConfigVersionUsage = fmt.Sprintf("specify the app config version to export as, available options: [%s]", strings.Join(realm.ConfigVersionValues, ", "))
+
 	errConfigVersionMismatch = errors.New("must export an app with the same config version as found in the current project directory")
 )
 
@@ -52,6 +55,10 @@ type inputs struct {
 	DryRun              bool
 }
 
+func (i *inputs) ResolveAppArg(args []string) error {
+	return cli.ResolveAppArg(&i.RemoteApp, flagRemote, args)
+}
+
 func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 	wd := i.LocalPath
 	if wd == "" {
@@ -1,19 +1,19 @@
 package push
 
 import (
+	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/secrets"
 	"github.com/10gen/realm-cli/internal/terminal"
 	"github.com/10gen/realm-cli/internal/utils/flags"
 
 	"github.com/AlecAivazis/survey/v2"
-	"github.com/briandowns/spinner"
 	"github.com/spf13/pflag"
 )
 
@@ -26,12 +26,61 @@ var CommandMeta = cli.CommandMeta{
 that you would like changes pushed to. This input can be either the application
 Client App ID of an existing Realm app you would like to update, or the Name of
 a new Realm app you would like to create. Changes pushed are automatically
-deployed.`,
+deployed, and the command waits until the deployment succeeds or fails. Use
+"--no-wait" to return as soon as the deployment has started.
+
+To review changes before they are pushed, use "--plan-out" to write the
+computed changes to a plan file without pushing them, then "--plan" to push
+exactly those changes. Pushing a plan fails if the remote app has changed since
+the plan was created. Secret values which reference an external secret source
+are written to the plan as references, and are resolved when the plan is pushed.
+
+Hosting assets which fail to upload are retried automatically. Any which still
+fail are recorded in a "hosting-failures.json" file in the working directory;
+use "--retry-failed-hosting" with that file to upload only those assets.
+
+Hosting assets to upload are first scanned for secrets which may have been
+embedded by accident, such as API keys, tokens and connection strings with
+credentials. The upload is blocked when any are found; review the reported
+files and include "--allow-secrets-in-hosting" to upload them anyway.
+
+Files matched by a ".realmignore" file in your app's root directory, which
+follows the same syntax as a ".gitignore" file, are not pushed.
+
+Use "--comment" and "--label key=value" to annotate the deployment created by
+the push, so it can be found later with "apps deployments list --label".
+
+Secret values in your local directory may reference an external secret source
+instead of holding the value itself (e.g. "vault:kv/realm/prod#apiKey" or
+"aws-sm:realm/prod#apiKey"); referenced values are resolved when pushing.
+
+Helper code common to several Functions can be kept in a "shared" directory in
+your app's root directory. Each require of a shared module in a Function, e.g.
+require("shared/utils"), is replaced with the module's source when pushing.
+
+Use "--merge" when the remote app may have changed since you last pulled or
+pushed it: changes made only to the remote app are kept, and for each file
+changed both locally and remotely you are prompted to keep the local version,
+take the remote version or view the differences first.
+
+Use "--draft" to push your changes to the draft of the app without deploying
+them, creating the draft if there is none. Push to the draft as many times as
+needed, then review its changes with "apps drafts diff" and deploy them all at
+once with "apps drafts deploy".
+
+Teams sharing an app can list the app files they own in a ".realmowners" file
+in the app's root directory. Each line holds a pattern, which follows the same
+syntax as a ".gitignore" file, followed by the teams owning the matching files
+(e.g. "functions/billing/ @payments"), and the last line matching a file
+determines its owners. Pushing changes to files owned by teams other than the
+one set with "--team" prints a warning; when auto confirming, such as in CI,
+the push is blocked unless "--ack-owners" is included.`,
 }
 
 // Command is the `push` command
 type Command struct {
 	inputs inputs
+	pushed int
 }
 
 // Flags is the command flags
@@ -40,8 +89,19 @@ func (cmd *Command) Flags(fs *pflag.FlagSet) {
 	fs.StringVar(&cmd.inputs.RemoteApp, flagRemote, "", flagRemoteUsage)
 	fs.BoolVarP(&cmd.inputs.IncludeDependencies, flagIncludeDependencies, flagIncludeDependenciesShort, false, flagIncludeDependenciesUsage)
 	fs.BoolVarP(&cmd.inputs.IncludeHosting, flagIncludeHosting, flagIncludeHostingShort, false, flagIncludeHostingUsage)
+	fs.BoolVar(&cmd.inputs.AllowSecrets, local.FlagAllowSecretsInHosting, false, local.FlagAllowSecretsInHostingUsage)
 	fs.BoolVarP(&cmd.inputs.ResetCDNCache, flagResetCDNCache, flagResetCDNCacheShort, false, flagResetCDNCacheUsage)
 	fs.BoolVarP(&cmd.inputs.DryRun, flagDryRun, flagDryRunShort, false, flagDryRunUsage)
+	fs.BoolVar(&cmd.inputs.NoWait, flagNoWait, false, flagNoWaitUsage)
+	fs.StringVar(&cmd.inputs.PlanOut, flagPlanOut, "", flagPlanOutUsage)
+	fs.StringVar(&cmd.inputs.Plan, flagPlan, "", flagPlanUsage)
+	fs.StringVar(&cmd.inputs.RetryFailedHosting, flagRetryFailedHosting, "", flagRetryFailedHostingUsage)
+	fs.StringVar(&cmd.inputs.Comment, flagComment, "", flagCommentUsage)
+	fs.StringSliceVar(&cmd.inputs.Labels, flagLabel, []string{}, flagLabelUsage)
+	fs.BoolVar(&cmd.inputs.Merge, flagMerge, false, flagMergeUsage)
+	fs.BoolVar(&cmd.inputs.Draft, flagDraft, false, flagDraftUsage)
+	fs.StringVar(&cmd.inputs.Team, flagTeam, "", flagTeamUsage)
+	fs.BoolVar(&cmd.inputs.AckOwners, flagAckOwners, false, flagAckOwnersUsage)
 
 	fs.StringVar(&cmd.inputs.Project, flagProject, "", flagProjectUsage)
 	flags.MarkHidden(fs, flagProject)
@@ -54,11 +114,28 @@ func (cmd *Command) Inputs() cli.InputResolver {
 
 // Handler is the command handler
 func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	if cmd.inputs.Plan != "" {
+		return cmd.applyPlan(ui, clients.Realm)
+	}
+
+	if cmd.inputs.RetryFailedHosting != "" {
+		return cmd.retryFailedHosting(profile, ui, clients.Realm)
+	}
+
 	app, err := local.LoadApp(cmd.inputs.LocalPath)
 	if err != nil {
 		return err
 	}
 
+	unresolvedSecrets := local.AppSecrets(app.AppData)
+	if err := local.ResolveSecrets(app.AppData, secrets.NewDefaultResolver().Resolve); err != nil {
+		return err
+	}
+
+	if err := local.InlineSharedSources(app); err != nil {
+		return err
+	}
+
 	appRemote, err := cmd.inputs.resolveRemoteApp(ui, clients.Realm)
 	if err != nil {
 		return err
@@ -74,6 +151,13 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 
 	var isNewApp bool
 	if appRemote.AppID == "" {
+		if cmd.inputs.PlanOut != "" {
+			return errPlanOutNewApp
+		}
+		if cmd.inputs.Draft {
+			return errDraftNewApp
+		}
+
 		if cmd.inputs.DryRun {
 			ui.Print(
 				terminal.NewTextLog("This is a new app. To create a new app, you must omit the 'dry-run' flag to proceed"),
@@ -94,12 +178,61 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		isNewApp = true
 	}
 
+	appPath := app.RootDir
+	if cmd.inputs.Merge && !isNewApp {
+		mergedPath, err := mergeRemoteChanges(profile, ui, clients.Realm, appRemote, app.RootDir)
+		if err != nil {
+			return err
+		}
+		if mergedPath != "" {
+			defer os.RemoveAll(mergedPath) //nolint:errcheck
+
+			mergedApp, err := local.LoadApp(mergedPath)
+			if err != nil {
+				return err
+			}
+			unresolvedSecrets = local.AppSecrets(mergedApp.AppData)
+			if err := local.ResolveSecrets(mergedApp.AppData, secrets.NewDefaultResolver().Resolve); err != nil {
+				return err
+			}
+
+			// shared sources are still found in the local app
+			app.AppData = mergedApp.AppData
+			if err := local.InlineSharedSources(app); err != nil {
+				return err
+			}
+			appPath = mergedPath
+		}
+	}
+
 	ui.Print(terminal.NewTextLog("Determining changes"))
 	appDiffs, err := clients.Realm.Diff(appRemote.GroupID, appRemote.AppID, app.AppData)
 	if err != nil {
 		return err
 	}
 
+	if cmd.inputs.PlanOut != "" {
+		plan, err := newPushPlan(appRemote, appDiffs, app.AppData, unresolvedSecrets)
+		if err != nil {
+			return err
+		}
+		if err := plan.write(cmd.inputs.PlanOut); err != nil {
+			return err
+		}
+
+		ui.Print(
+			terminal.NewTextLog("Wrote plan with %d change(s) to %s", len(appDiffs), cmd.inputs.PlanOut),
+			terminal.NewFollowupLog("To push these changes, run", cli.CommandDisplay(CommandMeta.Use, []flags.Arg{{flagPlan, cmd.inputs.PlanOut}})),
+		)
+		return nil
+	}
+
+	if len(appDiffs) > 0 && !isNewApp {
+		if err := cmd.checkOwners(ui, clients.Realm, appRemote, appPath); err != nil {
+			return err
+		}
+	}
+
 	var uploadPathDependencies string
 	var dependenciesDiffs realm.DependenciesDiff
 	if cmd.inputs.IncludeDependencies {
@@ -131,6 +264,10 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		if err != nil {
 			return err
 		}
+
+		if err := hosting.CheckSecrets(ui, hostingDiffs, cmd.inputs.AllowSecrets); err != nil {
+			return err
+		}
 	}
 
 	if len(appDiffs) == 0 && dependenciesDiffs.Len() == 0 && hostingDiffs.Size() == 0 {
@@ -173,6 +310,10 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		return nil
 	}
 
+	if cmd.inputs.Draft {
+		return cmd.pushToDraft(ui, clients.Realm, appRemote, app)
+	}
+
 	if len(appDiffs) > 0 {
 		ui.Print(terminal.NewTextLog("Creating draft"))
 		draft, proceed, err := createNewDraft(ui, clients.Realm, appRemote)
@@ -189,9 +330,14 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		}
 
 		ui.Print(terminal.NewTextLog("Deploying draft"))
-		if err := deployDraftAndWait(ui, clients.Realm, appRemote, draft.ID); err != nil {
+		if err := deployDraftAndWait(ui, clients.Realm, appRemote, draft.ID, cmd.inputs.deployOptions(), !cmd.inputs.NoWait); err != nil {
 			return err
 		}
+
+		// the app is reloaded to cache it without its resolved secrets and inlined shared sources
+		if err := cli.CacheAppExport(profile, appPath); err != nil {
+			ui.Print(terminal.NewWarningLog("Failed to cache the app export: %s", err))
+		}
 	}
 
 	if cmd.inputs.IncludeDependencies {
@@ -202,32 +348,13 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 	}
 
 	if cmd.inputs.IncludeHosting {
-		s := spinner.New(terminal.SpinnerCircles, 250*time.Millisecond)
-		s.Suffix = " Importing hosting assets..."
-
-		importHosting := func() error {
-			s.Start()
-			defer s.Stop()
-
-			return hosting.UploadHostingAssets(
-				clients.Realm,
-				appRemote.GroupID,
-				appRemote.AppID,
-				hostingDiffs,
-				func(err error) {
-					ui.Print(terminal.NewWarningLog("An error occurred while uploading hosting assets: %s", err.Error()))
-				},
-			)
-		}
-
-		if err := importHosting(); err != nil {
-			return err
+		if err := cmd.uploadHosting(ui, clients.Realm, appRemote, hosting, hostingDiffs, profile.MaxParallel); err != nil {
+			return cmd.reportHostingFailures(ui, hostingFailuresPath(profile.WorkingDirectory), appRemote, err)
 		}
 		ui.Print(terminal.NewTextLog("Import hosting assets"))
 
 		if cmd.inputs.ResetCDNCache {
-			s := spinner.New(terminal.SpinnerCircles, 250*time.Millisecond)
-			s.Suffix = " Resetting CDN cache..."
+			s := ui.Spinner("Resetting CDN cache...")
 
 			invalidateCache := func() error {
 				s.Start()
@@ -243,10 +370,65 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		}
 	}
 
+	cmd.pushed = len(appDiffs) + dependenciesDiffs.Len() + hostingDiffs.Size()
+
 	ui.Print(terminal.NewTextLog("Successfully pushed app up: %s", app.ID()))
 	return nil
 }
 
+// ResourcesAffected is the number of changes pushed
+func (cmd *Command) ResourcesAffected() int {
+	return cmd.pushed
+}
+
+func (cmd *Command) pushToDraft(ui terminal.UI, realmClient realm.Client, remote appRemote, app local.App) error {
+	draft, err := realmClient.Draft(remote.GroupID, remote.AppID)
+	if err != nil {
+		if err != realm.ErrDraftNotFound {
+			return err
+		}
+
+		ui.Print(terminal.NewTextLog("Creating draft"))
+		if draft, err = realmClient.CreateDraft(remote.GroupID, remote.AppID); err != nil {
+			return err
+		}
+	}
+
+	ui.Print(terminal.NewTextLog("Pushing changes"))
+	if err := realmClient.Import(remote.GroupID, remote.AppID, app.AppData); err != nil {
+		return err
+	}
+
+	ui.Print(
+		terminal.NewTextLog("Successfully pushed changes to draft %s of app %s", draft.ID, app.ID()),
+		terminal.NewFollowupLog(
+			"To review and deploy the changes of the draft, run",
+			fmt.Sprintf("%s apps drafts diff --app %s", cli.Name, app.ID()),
+			fmt.Sprintf("%s apps drafts deploy --app %s", cli.Name, app.ID()),
+		),
+	)
+	return nil
+}
+
+func (cmd *Command) uploadHosting(ui terminal.UI, realmClient realm.Client, remote appRemote, hosting local.Hosting, hostingDiffs local.HostingDiffs, maxParallel int) error {
+	s := ui.Spinner("Importing hosting assets...")
+
+	s.Start()
+	defer s.Stop()
+
+	return hosting.UploadHostingAssets(
+		realmClient,
+		remote.GroupID,
+		remote.AppID,
+		hostingDiffs,
+		maxParallel,
+		func(err error) {
+			ui.Print(terminal.NewWarningLog("An error occurred while uploading hosting assets: %s", err.Error()))
+		},
+		nil,
+	)
+}
+
 func (cmd *Command) display(omitDryRun bool) string {
 	return cli.CommandDisplay(CommandMeta.Use, cmd.inputs.args(omitDryRun))
 }
@@ -409,38 +591,32 @@ func diffDraft(ui terminal.UI, realmClient realm.Client, remote appRemote, draft
 	return nil
 }
 
-func deployDraftAndWait(ui terminal.UI, realmClient realm.Client, remote appRemote, draftID string) error {
-	deployment, err := realmClient.DeployDraft(remote.GroupID, remote.AppID, draftID)
+func deployDraftAndWait(ui terminal.UI, realmClient realm.Client, remote appRemote, draftID string, opts realm.DeployDraftOptions, wait bool) error {
+	deployment, err := realmClient.DeployDraft(remote.GroupID, remote.AppID, draftID, opts)
 	if err != nil {
 		return err
 	}
 
-	s := spinner.New(terminal.SpinnerCircles, 250*time.Millisecond)
-	s.Suffix = " Deploying app changes..."
-
-	waitForDeployment := func() error {
-		s.Start()
-		defer s.Stop()
-
-		for deployment.Status == realm.DeploymentStatusCreated || deployment.Status == realm.DeploymentStatusPending {
-			time.Sleep(time.Second)
-
-			deployment, err = realmClient.Deployment(remote.GroupID, remote.AppID, deployment.ID)
-			if err != nil {
-				if e := realmClient.DiscardDraft(remote.GroupID, remote.AppID, draftID); e != nil {
-					ui.Print(terminal.NewWarningLog("Failed to discard the draft created for your deployment"))
-				}
-				return err
-			}
-		}
-
+	if !wait {
+		ui.Print(terminal.NewTextLog("Deployment started: %s", deployment.ID))
 		return nil
 	}
 
-	if err := waitForDeployment(); err != nil {
+	deployment, err = cli.WaitForDeployment(ui, realmClient, remote.GroupID, remote.AppID, deployment, "Deploying app changes...")
+	if err != nil {
+		if _, ok := err.(cli.ErrDeploymentTimeout); ok {
+			return err
+		}
+		if e := realmClient.DiscardDraft(remote.GroupID, remote.AppID, draftID); e != nil {
+			ui.Print(terminal.NewWarningLog("Failed to discard the draft created for your deployment"))
+		}
 		return err
 	}
 
+	if deployment.Status == realm.DeploymentStatusFailed {
+		return errDeploymentFailed{deployment.ID, deployment.StatusErrorMessage}
+	}
+
 	ui.Print(terminal.NewTextLog("Deployment complete"))
 	return nil
 }
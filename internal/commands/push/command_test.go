@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cloud/atlas"
@@ -51,7 +52,7 @@ func TestPushHandler(t *testing.T) {
 			return nil, errors.New("something bad happened")
 		}
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", Project: "groupID", RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", Project: "groupID", RemoteApp: "appID"}}
 
 		err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
@@ -71,7 +72,7 @@ func TestPushHandler(t *testing.T) {
 			return nil, nil
 		}
 
-		cmd := &Command{inputs{LocalPath: "testdata/project"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project"}}
 
 		err := cmd.Handler(nil, nil, cli.Clients{
 			Realm: realmClient,
@@ -100,7 +101,7 @@ func TestPushHandler(t *testing.T) {
 			return realm.App{}, errors.New("something bad happened")
 		}
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
@@ -125,7 +126,7 @@ func TestPushHandler(t *testing.T) {
 			return nil, errors.New("something bad happened")
 		}
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
@@ -156,7 +157,7 @@ func TestPushHandler(t *testing.T) {
 			return realm.AppDraft{}, errors.New("something bad happened")
 		}
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
@@ -193,7 +194,7 @@ func TestPushHandler(t *testing.T) {
 			return errors.New("something bad happened")
 		}
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
@@ -226,14 +227,21 @@ func TestPushHandler(t *testing.T) {
 		}
 
 		var capturedGroupID, capturedAppID, capturedDraftID string
-		realmClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+		var capturedOptions realm.DeployDraftOptions
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
 			capturedGroupID = groupID
 			capturedAppID = appID
 			capturedDraftID = draftID
+			capturedOptions = opts
 			return realm.AppDeployment{}, errors.New("something bad happened")
 		}
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{
+			LocalPath: "testdata/project",
+			RemoteApp: "appID",
+			Comment:   "fix the login flow",
+			labels:    map[string]string{"release": "1.4.0"},
+		}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
@@ -242,6 +250,10 @@ func TestPushHandler(t *testing.T) {
 		assert.Equal(t, "groupID", capturedGroupID)
 		assert.Equal(t, "appID", capturedAppID)
 		assert.Equal(t, "draftID", capturedDraftID)
+		assert.Equal(t, realm.DeployDraftOptions{
+			Comment: "fix the login flow",
+			Labels:  map[string]string{"release": "1.4.0"},
+		}, capturedOptions)
 	})
 
 	t.Run("with a realm client that successfully imports and deploys drafts", func(t *testing.T) {
@@ -262,7 +274,7 @@ func TestPushHandler(t *testing.T) {
 			return nil
 		}
 
-		realmClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
 			return realm.AppDeployment{Status: realm.DeploymentStatusSuccessful}, nil
 		}
 
@@ -290,17 +302,20 @@ func TestPushHandler(t *testing.T) {
 					return nil, nil
 				}
 
-				cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
+				cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
 				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
-				assert.Equal(t, errors.New("2 error(s) occurred while importing hosting assets"), err)
+				assert.Equal(t, "2 error(s) occurred while importing hosting assets", err.Error())
+				failuresPath := filepath.Join(profile.WorkingDirectory, local.NameHostingFailures)
 				assert.Equal(t, `Determining changes
 Creating draft
 Pushing changes
 Deploying draft
 Deployment complete
-An error occurred while uploading hosting assets: failed to add /404.html: something bad happened
-An error occurred while uploading hosting assets: failed to add /index.html: something bad happened
+Warning: An error occurred while uploading hosting assets: failed to add /404.html: something bad happened
+Warning: An error occurred while uploading hosting assets: failed to add /index.html: something bad happened
+Wrote 2 failed hosting asset(s) to `+failuresPath+`
+To retry uploading these hosting assets, run: realm-cli push --local testdata/hosting --retry-failed-hosting `+failuresPath+`
 `, out.String())
 			})
 
@@ -315,17 +330,20 @@ An error occurred while uploading hosting assets: failed to add /index.html: som
 					}, nil
 				}
 
-				cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
+				cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
 				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
-				assert.Equal(t, errors.New("2 error(s) occurred while importing hosting assets"), err)
+				assert.Equal(t, "2 error(s) occurred while importing hosting assets", err.Error())
+				failuresPath := filepath.Join(profile.WorkingDirectory, local.NameHostingFailures)
 				assert.Equal(t, `Determining changes
 Creating draft
 Pushing changes
 Deploying draft
 Deployment complete
-An error occurred while uploading hosting assets: failed to update /404.html: something bad happened
-An error occurred while uploading hosting assets: failed to update /index.html: something bad happened
+Warning: An error occurred while uploading hosting assets: failed to update /404.html: something bad happened
+Warning: An error occurred while uploading hosting assets: failed to update /index.html: something bad happened
+Wrote 2 failed hosting asset(s) to `+failuresPath+`
+To retry uploading these hosting assets, run: realm-cli push --local testdata/hosting --retry-failed-hosting `+failuresPath+`
 `, out.String())
 			})
 		})
@@ -356,16 +374,19 @@ An error occurred while uploading hosting assets: failed to update /index.html:
 					}, nil
 				}
 
-				cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
+				cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
 				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
-				assert.Equal(t, errors.New("1 error(s) occurred while importing hosting assets"), err)
+				assert.Equal(t, "1 error(s) occurred while importing hosting assets", err.Error())
+				failuresPath := filepath.Join(profile.WorkingDirectory, local.NameHostingFailures)
 				assert.Equal(t, `Determining changes
 Creating draft
 Pushing changes
 Deploying draft
 Deployment complete
-An error occurred while uploading hosting assets: failed to remove /deleteme.html: something bad happened
+Warning: An error occurred while uploading hosting assets: failed to remove /deleteme.html: something bad happened
+Wrote 1 failed hosting asset(s) to `+failuresPath+`
+To retry uploading these hosting assets, run: realm-cli push --local testdata/hosting --retry-failed-hosting `+failuresPath+`
 `, out.String())
 			})
 		})
@@ -399,17 +420,20 @@ An error occurred while uploading hosting assets: failed to remove /deleteme.htm
 					}, nil
 				}
 
-				cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
+				cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
 				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
-				assert.Equal(t, errors.New("2 error(s) occurred while importing hosting assets"), err)
+				assert.Equal(t, "2 error(s) occurred while importing hosting assets", err.Error())
+				failuresPath := filepath.Join(profile.WorkingDirectory, local.NameHostingFailures)
 				assert.Equal(t, `Determining changes
 Creating draft
 Pushing changes
 Deploying draft
 Deployment complete
-An error occurred while uploading hosting assets: failed to update attributes for /404.html: something bad happened
-An error occurred while uploading hosting assets: failed to update attributes for /index.html: something bad happened
+Warning: An error occurred while uploading hosting assets: failed to update attributes for /404.html: something bad happened
+Warning: An error occurred while uploading hosting assets: failed to update attributes for /index.html: something bad happened
+Wrote 2 failed hosting asset(s) to `+failuresPath+`
+To retry uploading these hosting assets, run: realm-cli push --local testdata/hosting --retry-failed-hosting `+failuresPath+`
 `, out.String())
 			})
 		})
@@ -445,7 +469,7 @@ An error occurred while uploading hosting assets: failed to update attributes fo
 				}, nil
 			}
 
-			cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
+			cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
 			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
 			assert.Nil(t, err)
@@ -461,6 +485,7 @@ Successfully pushed app up: eggcorn-abcde
 			assert.Equal(t, []string{"/index.html"}, added)
 			assert.Equal(t, []string{"/deleteme.html"}, removed)
 			assert.Equal(t, []string{"/404.html"}, updated)
+			assert.Equal(t, 4, cmd.ResourcesAffected())
 		})
 
 		t.Run("and can import hosting files but fails to invalidate cdn cache should return an error", func(t *testing.T) {
@@ -486,7 +511,7 @@ Successfully pushed app up: eggcorn-abcde
 				return errors.New("something bad happened")
 			}
 
-			cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true, ResetCDNCache: true}}
+			cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true, ResetCDNCache: true}}
 
 			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
 			assert.Equal(t, errors.New("something bad happened"), err)
@@ -515,7 +540,7 @@ Successfully pushed app up: eggcorn-abcde
 				return nil
 			}
 
-			cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true, ResetCDNCache: true}}
+			cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true, ResetCDNCache: true}}
 
 			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
 			assert.Nil(t, err)
@@ -542,9 +567,12 @@ Successfully pushed app up: eggcorn-abcde
 				out := new(bytes.Buffer)
 				ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
 
-				cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+				profile, teardown := mock.NewProfileFromTmpDir(t, "push_import_test")
+				defer teardown()
 
-				assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+				cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+
+				assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
 				assert.Equal(t, `Determining changes
 Creating draft
 Pushing changes
@@ -558,7 +586,7 @@ Successfully pushed app up: eggcorn-abcde
 				out := new(bytes.Buffer)
 				ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
 
-				cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID", IncludeDependencies: true}}
+				cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID", IncludeDependencies: true}}
 
 				err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("something bad happened"), err)
@@ -573,7 +601,7 @@ Successfully pushed app up: eggcorn-abcde
 			out := new(bytes.Buffer)
 			ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
 
-			cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID", IncludeDependencies: true}}
+			cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID", IncludeDependencies: true}}
 
 			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, `Determining changes
@@ -616,7 +644,7 @@ Successfully pushed app up: eggcorn-abcde
 					return []realm.App{{GroupID: tc.groupID}}, nil
 				}
 
-				cmd := &Command{inputs{LocalPath: "testdata/project", DryRun: true, RemoteApp: "appID"}}
+				cmd := &Command{inputs: inputs{LocalPath: "testdata/project", DryRun: true, RemoteApp: "appID"}}
 
 				out, ui := mock.NewUI()
 
@@ -653,7 +681,7 @@ Try instead: realm-cli push --local testdata/project --remote appID
 			console.ExpectEOF()
 		}()
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 
@@ -674,7 +702,7 @@ Try instead: realm-cli push --local testdata/project --remote appID
 			return []string{}, nil
 		}
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", DryRun: true, RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", DryRun: true, RemoteApp: "appID"}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 		assert.Nil(t, err)
@@ -694,7 +722,7 @@ Deployed app is identical to proposed version, nothing to do
 
 		out, ui := mock.NewUI()
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", DryRun: true, RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", DryRun: true, RemoteApp: "appID"}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 
@@ -726,7 +754,7 @@ Try instead: realm-cli push --local testdata/project --remote appID
 
 		out, ui := mock.NewUI()
 
-		cmd := &Command{inputs{LocalPath: "testdata/dependencies", DryRun: true, RemoteApp: "appID", IncludeDependencies: true}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/dependencies", DryRun: true, RemoteApp: "appID", IncludeDependencies: true}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 
@@ -761,11 +789,94 @@ Try instead: realm-cli push --local testdata/dependencies --remote appID --inclu
 
 		_, ui := mock.NewUI()
 
-		cmd := &Command{inputs{LocalPath: "testdata/dependencies", RemoteApp: "appID", IncludeDependencies: true}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/dependencies", RemoteApp: "appID", IncludeDependencies: true}}
 
 		assert.Equal(t, errors.New("realm client error"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
 	})
 
+	t.Run("with the draft flag set", func(t *testing.T) {
+		newRealmClient := func(draftErr error) (mock.RealmClient, *[]string) {
+			var realmClient mock.RealmClient
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{ID: "appID", GroupID: "groupID"}}, nil
+			}
+			realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+				return []string{"diff1"}, nil
+			}
+			realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+				return realm.AppDraft{ID: "existingDraftID"}, draftErr
+			}
+			realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+				return realm.AppDraft{ID: "draftID"}, nil
+			}
+
+			calls := []string{}
+			realmClient.ImportFn = func(groupID, appID string, appData interface{}) error {
+				calls = append(calls, "import")
+				return nil
+			}
+			realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
+				calls = append(calls, "deploy")
+				return realm.AppDeployment{}, nil
+			}
+			return realmClient, &calls
+		}
+
+		for _, tc := range []struct {
+			description string
+			draftErr    error
+			draftID     string
+		}{
+			{
+				description: "should push changes to the existing draft without deploying it",
+				draftID:     "existingDraftID",
+			},
+			{
+				description: "should push changes to a new draft without deploying it when there is no draft",
+				draftErr:    realm.ErrDraftNotFound,
+				draftID:     "draftID",
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				out := new(bytes.Buffer)
+				ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+				realmClient, calls := newRealmClient(tc.draftErr)
+
+				cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID", Draft: true}}
+
+				assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+				assert.Equal(t, []string{"import"}, *calls)
+				assert.True(t, strings.Contains(out.String(), "Successfully pushed changes to draft "+tc.draftID+" of app eggcorn-abcde"), "expected output to mention the draft, but got: %s", out.String())
+				assert.True(t, strings.Contains(out.String(), "realm-cli apps drafts deploy --app eggcorn-abcde"), "expected output to suggest deploying the draft, but got: %s", out.String())
+			})
+		}
+
+		t.Run("should return an error when the draft cannot be found", func(t *testing.T) {
+			ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+			realmClient, calls := newRealmClient(errors.New("something bad happened"))
+
+			cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID", Draft: true}}
+
+			assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, []string{}, *calls)
+		})
+
+		t.Run("should return an error for a new app", func(t *testing.T) {
+			ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+			var realmClient mock.RealmClient
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return nil, nil
+			}
+
+			cmd := &Command{inputs: inputs{LocalPath: "testdata/project", Project: "groupID", RemoteApp: "new-app", Draft: true}}
+
+			assert.Equal(t, errDraftNewApp, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		})
+	})
+
 	t.Run("with diffs generated from the app but the user rejects them", func(t *testing.T) {
 		var realmClient mock.RealmClient
 		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
@@ -788,7 +899,7 @@ Try instead: realm-cli push --local testdata/dependencies --remote appID --inclu
 			console.ExpectEOF()
 		}()
 
-		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
 		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
 
@@ -1336,18 +1447,23 @@ func TestPushCommandDiffDraft(t *testing.T) {
 
 func TestPushCommandDeployDraftAndWait(t *testing.T) {
 	groupID, appID, draftID := "groupID", "appID", "draftID"
+
+	originalPollInterval := cli.DeploymentPollInterval
+	cli.DeploymentPollInterval = time.Millisecond
+	defer func() { cli.DeploymentPollInterval = originalPollInterval }()
+
 	t.Run("should return an error with a client that fails to deploy the draft", func(t *testing.T) {
 		realmClient := mock.RealmClient{}
 
 		var capturedGroupID, capturedAppID, capturedDraftID string
-		realmClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
 			capturedGroupID = groupID
 			capturedAppID = appID
 			capturedDraftID = draftID
 			return realm.AppDeployment{}, errors.New("something bad happened")
 		}
 
-		err := deployDraftAndWait(nil, realmClient, appRemote{groupID, appID}, draftID)
+		err := deployDraftAndWait(nil, realmClient, appRemote{groupID, appID}, draftID, realm.DeployDraftOptions{}, true)
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and should properly pass through the expected inputs")
@@ -1358,7 +1474,7 @@ func TestPushCommandDeployDraftAndWait(t *testing.T) {
 
 	t.Run("with a client that successfully deploys a draft", func(t *testing.T) {
 		realmClient := mock.RealmClient{}
-		realmClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
 			return realm.AppDeployment{ID: "id", Status: realm.DeploymentStatusCreated}, nil
 		}
 
@@ -1378,7 +1494,7 @@ func TestPushCommandDeployDraftAndWait(t *testing.T) {
 				{
 					description:      "and fails to discard the draft should return the deployment error and print a warning message",
 					discardDraftErr:  errors.New("failed to discard draft"),
-					expectedContents: "Failed to discard the draft created for your deployment\n",
+					expectedContents: "Warning: Failed to discard the draft created for your deployment\n",
 				},
 			} {
 				t.Run(tc.description, func(t *testing.T) {
@@ -1388,7 +1504,7 @@ func TestPushCommandDeployDraftAndWait(t *testing.T) {
 
 					out, ui := mock.NewUI()
 
-					err := deployDraftAndWait(ui, realmClient, appRemote{groupID, appID}, draftID)
+					err := deployDraftAndWait(ui, realmClient, appRemote{groupID, appID}, draftID, realm.DeployDraftOptions{}, true)
 					assert.Equal(t, errors.New("something bad happened"), err)
 					assert.Equal(t, tc.expectedContents, out.String())
 				})
@@ -1409,10 +1525,44 @@ func TestPushCommandDeployDraftAndWait(t *testing.T) {
 
 			out, ui := mock.NewUI()
 
-			err := deployDraftAndWait(ui, realmClient, appRemote{groupID, appID}, draftID)
+			err := deployDraftAndWait(ui, realmClient, appRemote{groupID, appID}, draftID, realm.DeployDraftOptions{}, true)
 			assert.Nil(t, err)
 
-			assert.Equal(t, "Deployment complete\n", out.String())
+			assert.Equal(t, "Deployment status: pending\nDeployment complete\n", out.String())
+		})
+
+		t.Run("and the deployment fails should return an error with the failure reason", func(t *testing.T) {
+			var polls int
+
+			realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+				deployment := realm.AppDeployment{ID: deploymentID, Status: realm.DeploymentStatusPending}
+				if polls > 0 {
+					deployment.Status = realm.DeploymentStatusFailed
+					deployment.StatusErrorMessage = "function 'onInsert' failed to compile"
+				}
+				polls++
+				return deployment, nil
+			}
+
+			out, ui := mock.NewUI()
+
+			err := deployDraftAndWait(ui, realmClient, appRemote{groupID, appID}, draftID, realm.DeployDraftOptions{}, true)
+			assert.Equal(t, errDeploymentFailed{"id", "function 'onInsert' failed to compile"}, err)
+			assert.Equal(t, "deployment id failed: function 'onInsert' failed to compile", err.Error())
+
+			assert.Equal(t, "Deployment status: pending\n", out.String())
+		})
+
+		t.Run("and not waiting should return once the deployment has started", func(t *testing.T) {
+			realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+				t.Fatal("deployment should not be polled")
+				return realm.AppDeployment{}, nil
+			}
+
+			out, ui := mock.NewUI()
+
+			assert.Nil(t, deployDraftAndWait(ui, realmClient, appRemote{groupID, appID}, draftID, realm.DeployDraftOptions{}, false))
+			assert.Equal(t, "Deployment started: id\n", out.String())
 		})
 	})
 }
@@ -1448,13 +1598,14 @@ func TestPushCommandDisplay(t *testing.T) {
 				IncludeDependencies: true,
 				IncludeHosting:      true,
 				ResetCDNCache:       true,
+				NoWait:              true,
 				DryRun:              true,
 			},
-			display: "realm-cli push --project project --local directory --remote remote --include-dependencies --include-hosting --reset-cdn-cache --dry-run",
+			display: "realm-cli push --project project --local directory --remote remote --include-dependencies --include-hosting --reset-cdn-cache --no-wait --dry-run",
 		},
 	} {
 		t.Run(tc.description, func(t *testing.T) {
-			cmd := &Command{tc.inputs}
+			cmd := &Command{inputs: tc.inputs}
 			assert.Equal(t, tc.display, cmd.display(tc.omitDryRun))
 		})
 	}
@@ -1464,9 +1615,12 @@ func runImport(t *testing.T, realmClient realm.Client, appDirectory string) {
 	out := new(bytes.Buffer)
 	ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
 
-	cmd := &Command{inputs{LocalPath: appDirectory, RemoteApp: "appID"}}
+	profile, teardown := mock.NewProfileFromTmpDir(t, "push_import_test")
+	defer teardown()
+
+	cmd := &Command{inputs: inputs{LocalPath: appDirectory, RemoteApp: "appID"}}
 
-	assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
 	assert.Equal(t, `Determining changes
 Creating draft
 Pushing changes
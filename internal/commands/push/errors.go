@@ -1,5 +1,23 @@
 package push
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	errPlanConflict    = errors.New("cannot use --plan and --plan-out together")
+	errPlanUnsupported = errors.New("plans only include app configuration changes and cannot be used with --include-dependencies, --include-hosting or --dry-run")
+	errPlanOutNewApp   = errors.New("cannot write a plan for a new app, push the app first to create it")
+
+	errRetryFailedHostingConflict = errors.New("cannot use --retry-failed-hosting with --plan, --plan-out or --dry-run")
+	errMergeConflict              = errors.New("cannot use --merge with --plan or --retry-failed-hosting")
+
+	errDraftUnsupported = errors.New("drafts only include app configuration changes and cannot be used with --include-dependencies, --include-hosting, --plan, --plan-out or --retry-failed-hosting")
+	errDraftNewApp      = errors.New("cannot push a new app to a draft, push the app first to create it")
+)
+
 type errProjectNotFound struct {
 }
 
@@ -8,3 +26,68 @@ func (err errProjectNotFound) Error() string {
 }
 
 func (err errProjectNotFound) DisableUsage() struct{} { return struct{}{} }
+
+type errDeploymentFailed struct {
+	id      string
+	message string
+}
+
+func (err errDeploymentFailed) Error() string {
+	msg := fmt.Sprintf("deployment %s failed", err.id)
+	if err.message != "" {
+		msg += ": " + err.message
+	}
+	return msg
+}
+
+func (err errDeploymentFailed) DisableUsage() struct{} { return struct{}{} }
+
+type errPlanDrifted struct {
+	path string
+}
+
+func (err errPlanDrifted) Error() string {
+	return fmt.Sprintf("the remote app has changed since the plan at %s was created, create a new plan with --plan-out", err.path)
+}
+
+func (err errPlanDrifted) DisableUsage() struct{} { return struct{}{} }
+
+type errMergeConflicts struct {
+	paths []string
+}
+
+func (err errMergeConflicts) Error() string {
+	return fmt.Sprintf(
+		"both the local and remote app changed %d file(s) (%s), run without --yes to resolve these conflicts",
+		len(err.paths),
+		strings.Join(err.paths, ", "),
+	)
+}
+
+func (err errMergeConflicts) DisableUsage() struct{} { return struct{}{} }
+
+type errMissingTranspiler struct {
+	error
+}
+
+func (err errMissingTranspiler) Error() string {
+	return fmt.Sprintf("cannot use --include-dependencies, %s, or omit --include-dependencies to push without your app's dependencies", err.error)
+}
+
+func (err errMissingTranspiler) DisableUsage() struct{} { return struct{}{} }
+
+func (err errMissingTranspiler) Unwrap() error { return err.error }
+
+type errOwnersNotAcknowledged struct {
+	count int
+}
+
+func (err errOwnersNotAcknowledged) Error() string {
+	return fmt.Sprintf(
+		"found %d change(s) to app files owned by other teams, use --%s to acknowledge and push them",
+		err.count,
+		flagAckOwners,
+	)
+}
+
+func (err errOwnersNotAcknowledged) DisableUsage() struct{} { return struct{}{} }
@@ -14,4 +14,11 @@ func TestPushErrors(t *testing.T) {
 		_, ok := err.(cli.DisableUsage)
 		assert.True(t, ok, "expected project not found error to disable usage")
 	})
+
+	t.Run("err deployment failed should disable usage", func(t *testing.T) {
+		var err error = errDeploymentFailed{}
+
+		_, ok := err.(cli.DisableUsage)
+		assert.True(t, ok, "expected deployment failed error to disable usage")
+	})
 }
@@ -0,0 +1,98 @@
+package push
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// reportHostingFailures writes the manifest of hosting assets which failed to upload,
+// so they can be retried without pushing every hosting asset again
+func (cmd *Command) reportHostingFailures(ui terminal.UI, path string, remote appRemote, err error) error {
+	var uploadErr local.HostingUploadError
+	if !errors.As(err, &uploadErr) {
+		return err
+	}
+
+	if err := local.NewHostingFailures(remote.GroupID, remote.AppID, uploadErr.Failed).Write(path); err != nil {
+		return fmt.Errorf("%s: failed to write hosting failures to %s: %w", uploadErr, path, err)
+	}
+
+	args := []flags.Arg{{flagRetryFailedHosting, path}}
+	if cmd.inputs.LocalPath != "" {
+		args = append([]flags.Arg{{flagLocalPath, cmd.inputs.LocalPath}}, args...)
+	}
+
+	ui.Print(
+		terminal.NewTextLog("Wrote %d failed hosting asset(s) to %s", uploadErr.Failed.Size(), path),
+		terminal.NewFollowupLog("To retry uploading these hosting assets, run", cli.CommandDisplay(CommandMeta.Use, args)),
+	)
+	return err
+}
+
+// retryFailedHosting uploads only the hosting assets recorded in the hosting failures manifest,
+// rewriting the manifest with any assets which fail again
+func (cmd *Command) retryFailedHosting(profile *user.Profile, ui terminal.UI, realmClient realm.Client) error {
+	path := cmd.inputs.RetryFailedHosting
+
+	manifest, err := local.ReadHostingFailures(path)
+	if err != nil {
+		return err
+	}
+	remote := appRemote{manifest.GroupID, manifest.AppID}
+
+	app, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	hosting, err := local.FindAppHosting(app.RootDir)
+	if err != nil {
+		return err
+	}
+
+	appAssets, err := realmClient.HostingAssets(remote.GroupID, remote.AppID)
+	if err != nil {
+		return err
+	}
+
+	hostingDiffs, err := hosting.Diffs(profile.HostingAssetCachePath(), remote.AppID, appAssets)
+	if err != nil {
+		return err
+	}
+	hostingDiffs = hostingDiffs.FilterPaths(manifest.Paths())
+
+	if hostingDiffs.Size() == 0 {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("Deployed hosting assets are up to date, nothing to retry"))
+		return nil
+	}
+
+	if err := hosting.CheckSecrets(ui, hostingDiffs, cmd.inputs.AllowSecrets); err != nil {
+		return err
+	}
+
+	if err := cmd.uploadHosting(ui, realmClient, remote, hosting, hostingDiffs, profile.MaxParallel); err != nil {
+		return cmd.reportHostingFailures(ui, path, remote, err)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+	ui.Print(terminal.NewTextLog("Successfully retried %d hosting asset(s)", hostingDiffs.Size()))
+	return nil
+}
+
+func hostingFailuresPath(wd string) string {
+	return filepath.Join(wd, local.NameHostingFailures)
+}
@@ -0,0 +1,98 @@
+package push
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestPushRetryFailedHosting(t *testing.T) {
+	profile, teardown := mock.NewProfileFromTmpDir(t, "push-retry-failed-hosting")
+	defer teardown()
+
+	failuresPath := filepath.Join(profile.WorkingDirectory, local.NameHostingFailures)
+
+	newRealmClient := func(uploadErr error) (mock.RealmClient, *[]string) {
+		var mu sync.Mutex
+		var uploaded []string
+
+		var realmClient mock.RealmClient
+		realmClient.HostingAssetsFn = func(groupID, appID string) ([]realm.HostingAsset, error) {
+			return nil, nil
+		}
+		realmClient.HostingAssetUploadFn = func(groupID, appID, rootDir string, asset realm.HostingAsset) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			uploaded = append(uploaded, groupID+"/"+appID+": "+asset.FilePath)
+			return uploadErr
+		}
+		return realmClient, &uploaded
+	}
+
+	writeFailures := func(t *testing.T) {
+		t.Helper()
+		manifest := local.HostingFailures{Version: 1, GroupID: "groupID", AppID: "appID", Added: []string{"/404.html"}}
+		assert.Nil(t, manifest.Write(failuresPath))
+	}
+
+	t.Run("should upload only the failed hosting assets and remove the manifest", func(t *testing.T) {
+		writeFailures(t)
+
+		realmClient, uploaded := newRealmClient(nil)
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RetryFailedHosting: failuresPath}}
+
+		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"groupID/appID: /404.html"}, *uploaded)
+		assert.Equal(t, "Successfully retried 1 hosting asset(s)\n", out.String())
+
+		_, err := os.Stat(failuresPath)
+		assert.True(t, os.IsNotExist(err), "expected hosting failures to be removed")
+	})
+
+	t.Run("should rewrite the manifest when hosting assets fail again", func(t *testing.T) {
+		writeFailures(t)
+
+		realmClient, _ := newRealmClient(errors.New("something bad happened"))
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RetryFailedHosting: failuresPath}}
+
+		err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, "1 error(s) occurred while importing hosting assets", err.Error())
+		assert.Equal(t, `Warning: An error occurred while uploading hosting assets: failed to add /404.html: something bad happened
+Wrote 1 failed hosting asset(s) to `+failuresPath+`
+To retry uploading these hosting assets, run: realm-cli push --local testdata/hosting --retry-failed-hosting `+failuresPath+`
+`, out.String())
+
+		manifest, err := local.ReadHostingFailures(failuresPath)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"/404.html"}, manifest.Paths())
+	})
+
+	t.Run("should fail with a manifest of an unsupported version", func(t *testing.T) {
+		assert.Nil(t, local.HostingFailures{Version: 0, GroupID: "groupID", AppID: "appID"}.Write(failuresPath))
+
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/hosting", RetryFailedHosting: failuresPath}}
+
+		_, ui := mock.NewUI()
+
+		err := cmd.Handler(profile, ui, cli.Clients{})
+		assert.Equal(t, "hosting failures at "+failuresPath+" have unsupported version 0", err.Error())
+	})
+}
@@ -34,6 +34,36 @@ const (
 	flagDryRun      = "dry-run"
 	flagDryRunShort = "x"
 	flagDryRunUsage = "include to run without pushing any changes to the Realm server"
+
+	flagNoWait      = "no-wait"
+	flagNoWaitUsage = "include to push changes without waiting for the deployment to complete"
+
+	flagPlanOut      = "plan-out"
+	flagPlanOutUsage = "write the changes to push to the specified plan file instead of pushing them"
+
+	flagPlan      = "plan"
+	flagPlanUsage = "push exactly the changes recorded in the specified plan file"
+
+	flagRetryFailedHosting      = "retry-failed-hosting"
+	flagRetryFailedHostingUsage = "upload only the hosting assets recorded in the specified hosting failures file"
+
+	flagComment      = "comment"
+	flagCommentUsage = "attach a comment to the deployment created by the push"
+
+	flagLabel      = "label"
+	flagLabelUsage = "attach a label to the deployment created by the push, in the form key=value"
+
+	flagDraft      = "draft"
+	flagDraftUsage = "include to push changes to the draft of the Realm app without deploying them"
+
+	flagTeam      = "team"
+	flagTeamUsage = "the team pushing the changes, whose files listed in the .realmowners file can be changed without a warning"
+
+	flagAckOwners      = "ack-owners"
+	flagAckOwnersUsage = "include to acknowledge changes to app files owned by other teams, which are otherwise blocked when auto confirming"
+
+	flagMerge      = "merge"
+	flagMergeUsage = "include to merge the changes made to the remote app since it was last pulled or pushed, resolving conflicting changes interactively"
 )
 
 type appRemote struct {
@@ -47,11 +77,69 @@ type inputs struct {
 	Project             string
 	IncludeDependencies bool
 	IncludeHosting      bool
+	AllowSecrets        bool
 	ResetCDNCache       bool
 	DryRun              bool
+	NoWait              bool
+	PlanOut             string
+	Plan                string
+	RetryFailedHosting  string
+	Comment             string
+	Labels              []string
+	Merge               bool
+	Draft               bool
+	Team                string
+	AckOwners           bool
+
+	labels map[string]string
+}
+
+// checkTranspiler checks the transpiler used to upload dependencies is installed
+var checkTranspiler = local.CheckTranspiler
+
+func (i *inputs) ResolveAppArg(args []string) error {
+	return cli.ResolveAppArg(&i.RemoteApp, flagRemote, args)
 }
 
 func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	labels, err := realm.ParseDeploymentLabels(i.Labels)
+	if err != nil {
+		return err
+	}
+	i.labels = labels
+
+	if i.Plan != "" || i.PlanOut != "" {
+		if i.Plan != "" && i.PlanOut != "" {
+			return errPlanConflict
+		}
+		if i.IncludeDependencies || i.IncludeHosting || i.DryRun {
+			return errPlanUnsupported
+		}
+	}
+
+	if i.RetryFailedHosting != "" && (i.Plan != "" || i.PlanOut != "" || i.DryRun) {
+		return errRetryFailedHostingConflict
+	}
+
+	if i.Merge && (i.Plan != "" || i.RetryFailedHosting != "") {
+		return errMergeConflict
+	}
+
+	if i.Draft && (i.Plan != "" || i.PlanOut != "" || i.RetryFailedHosting != "" || i.IncludeDependencies || i.IncludeHosting) {
+		return errDraftUnsupported
+	}
+
+	if i.Plan != "" {
+		// the plan records the app to push to
+		return nil
+	}
+
+	if i.IncludeDependencies {
+		if err := checkTranspiler(); err != nil {
+			return errMissingTranspiler{err}
+		}
+	}
+
 	searchPath := i.LocalPath
 	if searchPath == "" {
 		searchPath = profile.WorkingDirectory
@@ -96,8 +184,12 @@ func (i inputs) resolveRemoteApp(ui terminal.UI, client realm.Client) (appRemote
 	return r, nil
 }
 
+func (i inputs) deployOptions() realm.DeployDraftOptions {
+	return realm.DeployDraftOptions{Comment: i.Comment, Labels: i.labels}
+}
+
 func (i inputs) args(omitDryRun bool) []flags.Arg {
-	args := make([]flags.Arg, 0, 7)
+	args := make([]flags.Arg, 0, 10)
 	if i.Project != "" {
 		args = append(args, flags.Arg{flagProject, i.Project})
 	}
@@ -113,9 +205,42 @@ func (i inputs) args(omitDryRun bool) []flags.Arg {
 	if i.IncludeHosting {
 		args = append(args, flags.Arg{Name: flagIncludeHosting})
 	}
+	if i.AllowSecrets {
+		args = append(args, flags.Arg{Name: local.FlagAllowSecretsInHosting})
+	}
 	if i.ResetCDNCache {
 		args = append(args, flags.Arg{Name: flagResetCDNCache})
 	}
+	if i.NoWait {
+		args = append(args, flags.Arg{Name: flagNoWait})
+	}
+	if i.PlanOut != "" {
+		args = append(args, flags.Arg{flagPlanOut, i.PlanOut})
+	}
+	if i.Plan != "" {
+		args = append(args, flags.Arg{flagPlan, i.Plan})
+	}
+	if i.RetryFailedHosting != "" {
+		args = append(args, flags.Arg{flagRetryFailedHosting, i.RetryFailedHosting})
+	}
+	if i.Comment != "" {
+		args = append(args, flags.Arg{flagComment, i.Comment})
+	}
+	for _, label := range i.Labels {
+		args = append(args, flags.Arg{flagLabel, label})
+	}
+	if i.Merge {
+		args = append(args, flags.Arg{Name: flagMerge})
+	}
+	if i.Draft {
+		args = append(args, flags.Arg{Name: flagDraft})
+	}
+	if i.Team != "" {
+		args = append(args, flags.Arg{flagTeam, i.Team})
+	}
+	if i.AckOwners {
+		args = append(args, flags.Arg{Name: flagAckOwners})
+	}
 	if i.DryRun && !omitDryRun {
 		args = append(args, flags.Arg{Name: flagDryRun})
 	}
@@ -1,6 +1,7 @@
 package push
 
 import (
+	"errors"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
@@ -37,6 +38,95 @@ func TestPushInputsResolve(t *testing.T) {
 		assert.Equal(t, profile.WorkingDirectory, i.LocalPath)
 		assert.Equal(t, "eggcorn-abcde", i.RemoteApp)
 	})
+
+	t.Run("Should not require a project directory when applying a plan", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_init_input_test")
+		defer teardown()
+
+		i := inputs{Plan: "plan.json"}
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, "", i.LocalPath)
+	})
+
+	t.Run("Should return an error when including dependencies without the transpiler installed", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_init_input_test")
+		defer teardown()
+
+		original := checkTranspiler
+		defer func() { checkTranspiler = original }()
+		checkTranspiler = func() error { return errors.New("transpiler not found") }
+
+		i := inputs{LocalPath: "testdata/project", IncludeDependencies: true}
+		err := i.Resolve(profile, nil)
+		assert.Equal(t, "cannot use --include-dependencies, transpiler not found, or omit --include-dependencies to push without your app's dependencies", err.Error())
+		assert.Equal(t, errors.New("transpiler not found"), errors.Unwrap(err))
+	})
+
+	t.Run("Should parse the deployment labels", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_init_input_test")
+		defer teardown()
+
+		i := inputs{Plan: "plan.json", Comment: "hotfix", Labels: []string{"release=1.4.0", "ticket=REALM-1=2"}}
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, realm.DeployDraftOptions{
+			Comment: "hotfix",
+			Labels:  map[string]string{"release": "1.4.0", "ticket": "REALM-1=2"},
+		}, i.deployOptions())
+	})
+
+	for _, tc := range []struct {
+		description string
+		inputs      inputs
+		expectedErr error
+	}{
+		{
+			description: "Should return an error when both plan flags are set",
+			inputs:      inputs{Plan: "plan.json", PlanOut: "plan.json"},
+			expectedErr: errPlanConflict,
+		},
+		{
+			description: "Should return an error when writing a plan that includes dependencies",
+			inputs:      inputs{PlanOut: "plan.json", IncludeDependencies: true},
+			expectedErr: errPlanUnsupported,
+		},
+		{
+			description: "Should return an error when applying a plan as a dry run",
+			inputs:      inputs{Plan: "plan.json", DryRun: true},
+			expectedErr: errPlanUnsupported,
+		},
+		{
+			description: "Should return an error when retrying failed hosting assets as a dry run",
+			inputs:      inputs{RetryFailedHosting: "hosting-failures.json", DryRun: true},
+			expectedErr: errRetryFailedHostingConflict,
+		},
+		{
+			description: "Should return an error when merging remote changes into a plan",
+			inputs:      inputs{Plan: "plan.json", Merge: true},
+			expectedErr: errMergeConflict,
+		},
+		{
+			description: "Should return an error when pushing hosting changes to a draft",
+			inputs:      inputs{IncludeHosting: true, Draft: true},
+			expectedErr: errDraftUnsupported,
+		},
+		{
+			description: "Should return an error when writing a plan for a draft",
+			inputs:      inputs{PlanOut: "plan.json", Draft: true},
+			expectedErr: errDraftUnsupported,
+		},
+		{
+			description: "Should return an error when a label is not a key=value pair",
+			inputs:      inputs{Plan: "plan.json", Labels: []string{"release"}},
+			expectedErr: errors.New("invalid label 'release', labels must be of the form key=value"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile, teardown := mock.NewProfileFromTmpDir(t, "app_init_input_test")
+			defer teardown()
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, nil))
+		})
+	}
 }
 
 func TestPushInputsResolveTo(t *testing.T) {
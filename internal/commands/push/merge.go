@@ -0,0 +1,124 @@
+package push
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+const (
+	mergeOptionKeepLocal  = "Keep local"
+	mergeOptionTakeRemote = "Take remote"
+	mergeOptionViewDiff   = "View diff"
+)
+
+var (
+	errMergeNoAppID = errors.New("cannot merge remote changes without an app id in the app config")
+)
+
+// exportRemoteApp exports and loads the remote app, using the provided config version
+func exportRemoteApp(realmClient realm.Client, remote appRemote, configVersion realm.AppConfigVersion) (local.App, error) {
+	remoteDir, err := ioutil.TempDir("", "realm-cli-remote-")
+	if err != nil {
+		return local.App{}, err
+	}
+	defer os.RemoveAll(remoteDir) //nolint:errcheck
+
+	_, zipPkg, err := realmClient.Export(remote.GroupID, remote.AppID, realm.ExportRequest{ConfigVersion: configVersion})
+	if err != nil {
+		return local.App{}, err
+	}
+	if err := local.WriteZip(remoteDir, zipPkg); err != nil {
+		return local.App{}, err
+	}
+
+	return local.LoadApp(remoteDir)
+}
+
+// mergeRemoteChanges merges the changes made to the remote app since the local app was last pulled or pushed
+// into the local app, prompting to resolve each file changed by both, and returns the directory of the merged app
+func mergeRemoteChanges(profile *user.Profile, ui terminal.UI, realmClient realm.Client, remote appRemote, localPath string) (string, error) {
+	localApp, err := local.LoadApp(localPath)
+	if err != nil {
+		return "", err
+	}
+	if localApp.ID() == "" {
+		return "", errMergeNoAppID
+	}
+
+	remoteApp, err := exportRemoteApp(realmClient, remote, localApp.ConfigVersion())
+	if err != nil {
+		return "", err
+	}
+
+	merge, err := local.MergeApp(localApp, remoteApp, profile.ExportCachePath(localApp.ID()))
+	if err != nil {
+		return "", err
+	}
+
+	if len(merge.RemoteChanges) == 0 && len(merge.Conflicts) == 0 {
+		ui.Print(terminal.NewTextLog("No remote changes to merge"))
+		return "", nil
+	}
+
+	if len(merge.Conflicts) > 0 && ui.AutoConfirm() {
+		paths := make([]string, 0, len(merge.Conflicts))
+		for _, conflict := range merge.Conflicts {
+			paths = append(paths, conflict.Path)
+		}
+		return "", errMergeConflicts{paths}
+	}
+
+	for _, conflict := range merge.Conflicts {
+		takeRemote, err := resolveConflict(ui, conflict)
+		if err != nil {
+			return "", err
+		}
+		merge.Resolve(conflict, takeRemote)
+	}
+
+	mergedDir, err := ioutil.TempDir("", "realm-cli-merged-")
+	if err != nil {
+		return "", err
+	}
+	if err := merge.Write(mergedDir); err != nil {
+		os.RemoveAll(mergedDir) //nolint:errcheck
+		return "", err
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"Merged %d remote change(s) and resolved %d conflict(s)",
+		len(merge.RemoteChanges),
+		len(merge.Conflicts),
+	))
+	return mergedDir, nil
+}
+
+func resolveConflict(ui terminal.UI, conflict local.AppFileConflict) (bool, error) {
+	for {
+		var selection string
+		if err := ui.AskOne(&selection, &survey.Select{
+			Message: "Both the local and remote app changed " + conflict.Path,
+			Options: []string{mergeOptionKeepLocal, mergeOptionTakeRemote, mergeOptionViewDiff},
+		}); err != nil {
+			return false, err
+		}
+
+		switch selection {
+		case mergeOptionKeepLocal:
+			return false, nil
+		case mergeOptionTakeRemote:
+			return true, nil
+		}
+
+		ui.Print(terminal.NewTextLog(strings.Join(conflict.Diff(), "\n")))
+	}
+}
@@ -0,0 +1,120 @@
+package push
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+func TestMergeRemoteChanges(t *testing.T) {
+	profile, teardown := mock.NewProfileFromTmpDir(t, "push_merge_test")
+	defer teardown()
+
+	customUserDataPath := filepath.Join(local.NameAuth, local.FileCustomUserData.String())
+
+	writeApp := func(name string) string {
+		dir := filepath.Join(profile.WorkingDirectory, name)
+		assert.Nil(t, local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.DefaultAppConfigVersion).Write())
+		return dir
+	}
+
+	localDir := writeApp("local")
+	assert.Nil(t, cli.CacheAppExport(profile, localDir))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(localDir, customUserDataPath), []byte(`{"enabled":true,"mongo_service_name":"local"}`), 0666))
+
+	remoteDir := writeApp("remote")
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(remoteDir, customUserDataPath), []byte(`{"enabled":true,"mongo_service_name":"remote"}`), 0666))
+	assert.Nil(t, os.Remove(filepath.Join(remoteDir, local.NameEnvironments, "qa.json")))
+
+	realmClient := mock.RealmClient{}
+	realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+		return "eggcorn_20210101", zipDir(t, remoteDir), nil
+	}
+
+	remote := appRemote{"groupID", "appID"}
+
+	t.Run("should fail with conflicts when auto confirming", func(t *testing.T) {
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		_, err := mergeRemoteChanges(profile, ui, realmClient, remote, localDir)
+		assert.Equal(t, errMergeConflicts{[]string{"auth/custom_user_data.json"}}, err)
+	})
+
+	t.Run("should merge the remote changes and the conflicts resolved with the remote version", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		doneCh := make(chan (struct{}))
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Both the local and remote app changed auth/custom_user_data.json")
+			console.Send(string(terminal.KeyArrowDown))
+			console.Send(string(terminal.KeyArrowDown))
+			console.SendLine("") // view diff
+			console.ExpectString(`+     "mongo_service_name": "remote"`)
+			console.ExpectString("Both the local and remote app changed auth/custom_user_data.json")
+			console.Send(string(terminal.KeyArrowDown))
+			console.SendLine("") // take remote
+			console.ExpectString("Merged 1 remote change(s) and resolved 1 conflict(s)")
+			console.ExpectEOF()
+		}()
+
+		mergedDir, err := mergeRemoteChanges(profile, ui, realmClient, remote, localDir)
+		assert.Nil(t, err)
+		defer os.RemoveAll(mergedDir)
+
+		console.Tty().Close() // flush the writers
+		<-doneCh              // wait for procedure to complete
+
+		customUserData, err := ioutil.ReadFile(filepath.Join(mergedDir, customUserDataPath))
+		assert.Nil(t, err)
+		assert.True(t, bytes.Contains(customUserData, []byte(`"remote"`)), "expected the remote version of the file, but got: %s", customUserData)
+
+		_, err = os.Stat(filepath.Join(mergedDir, local.NameEnvironments, "qa.json"))
+		assert.True(t, os.IsNotExist(err), "expected the remote deletion to be merged")
+	})
+}
+
+func zipDir(t *testing.T, dir string) *zip.Reader {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	assert.Nil(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		f, err := w.Create(filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	}))
+	assert.Nil(t, w.Close())
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Nil(t, err)
+	return r
+}
@@ -0,0 +1,94 @@
+package push
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+const (
+	headerFile   = "File"
+	headerOwners = "Owners"
+)
+
+// checkOwners warns about changes to app files owned by teams other than the one pushing them,
+// as listed in the app's .realmowners file, and blocks them when auto confirming unless acknowledged
+func (cmd *Command) checkOwners(ui terminal.UI, realmClient realm.Client, remote appRemote, appPath string) error {
+	owners, err := local.LoadOwners(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+	if owners.Len() == 0 {
+		return nil
+	}
+
+	// the app is reloaded to compare it without its resolved secrets and inlined shared sources
+	app, err := local.LoadApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	remoteApp, err := exportRemoteApp(realmClient, remote, app.ConfigVersion())
+	if err != nil {
+		return err
+	}
+
+	diffs, err := local.DiffApps(app, remoteApp)
+	if err != nil {
+		return err
+	}
+
+	var rows []map[string]interface{}
+	for _, paths := range [][]string{diffs.Added, diffs.Deleted, diffs.Modified} {
+		for _, path := range paths {
+			fileOwners := owners.Find(path)
+			if len(fileOwners) == 0 || isOwner(fileOwners, cmd.inputs.Team) {
+				continue
+			}
+			rows = append(rows, map[string]interface{}{
+				headerFile:   path,
+				headerOwners: strings.Join(fileOwners, ", "),
+			})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i][headerFile].(string) < rows[j][headerFile].(string)
+	})
+
+	table := terminal.NewTableLog(
+		fmt.Sprintf("Found %d change(s) to app files owned by other teams", len(rows)),
+		[]string{headerFile, headerOwners},
+		rows...,
+	)
+
+	if cmd.inputs.AckOwners {
+		ui.Print(table, terminal.NewWarningLog("Pushing these changes anyway, as --%s is set", flagAckOwners))
+		return nil
+	}
+
+	ui.Print(table, terminal.NewWarningLog("Make sure the owners of these files are aware of the changes"))
+	if ui.AutoConfirm() && !cmd.inputs.DryRun {
+		return errOwnersNotAcknowledged{len(rows)}
+	}
+	return nil
+}
+
+func isOwner(owners []string, team string) bool {
+	if team == "" {
+		return false
+	}
+	for _, owner := range owners {
+		if owner == team {
+			return true
+		}
+	}
+	return false
+}
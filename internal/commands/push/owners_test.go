@@ -0,0 +1,85 @@
+package push
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestPushCheckOwners(t *testing.T) {
+	profile, teardown := mock.NewProfileFromTmpDir(t, "push_owners_test")
+	defer teardown()
+
+	customUserDataPath := filepath.Join(local.NameAuth, local.FileCustomUserData.String())
+
+	writeApp := func(name string) string {
+		dir := filepath.Join(profile.WorkingDirectory, name)
+		assert.Nil(t, local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.DefaultAppConfigVersion).Write())
+		return dir
+	}
+
+	localDir := writeApp("local")
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(localDir, customUserDataPath), []byte(`{"enabled":true,"mongo_service_name":"local"}`), 0666))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(localDir, local.FileRealmOwners.String()), []byte("auth/ @identity\nfunctions/ @backend\n"), 0666))
+
+	remoteDir := writeApp("remote")
+
+	realmClient := mock.RealmClient{}
+	realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+		return "eggcorn_20210101", zipDir(t, remoteDir), nil
+	}
+
+	remote := appRemote{"groupID", "appID"}
+
+	t.Run("should block changes to files owned by other teams when auto confirming", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs: inputs{LocalPath: localDir, Team: "@backend"}}
+
+		assert.Equal(t, errOwnersNotAcknowledged{1}, cmd.checkOwners(ui, realmClient, remote, localDir))
+		assert.Equal(t, `Found 1 change(s) to app files owned by other teams
+  File                        Owners   
+  --------------------------  ---------
+  auth/custom_user_data.json  @identity
+Warning: Make sure the owners of these files are aware of the changes
+`, out.String())
+	})
+
+	t.Run("should push changes to files owned by other teams once acknowledged", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs: inputs{LocalPath: localDir, AckOwners: true}}
+
+		assert.Nil(t, cmd.checkOwners(ui, realmClient, remote, localDir))
+		assert.True(t, strings.HasSuffix(out.String(), "Warning: Pushing these changes anyway, as --ack-owners is set\n"), "expected a warning, but got: %s", out.String())
+	})
+
+	t.Run("should not warn about changes to files owned by the pushing team", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs: inputs{LocalPath: localDir, Team: "@identity"}}
+
+		assert.Nil(t, cmd.checkOwners(ui, realmClient, remote, localDir))
+		assert.Equal(t, "", out.String())
+	})
+
+	t.Run("should only warn about changes to files owned by other teams when not auto confirming", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &Command{inputs: inputs{LocalPath: localDir}}
+
+		assert.Nil(t, cmd.checkOwners(ui, realmClient, remote, localDir))
+		assert.True(t, strings.Contains(out.String(), "Warning: Make sure the owners of these files are aware of the changes"), "expected a warning, but got: %s", out.String())
+	})
+}
@@ -0,0 +1,197 @@
+package push
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/secrets"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+const (
+	planVersion = 1
+
+	fieldSecrets = "secrets"
+)
+
+// pushPlan is the reviewable change set written by --plan-out and applied by --plan
+type pushPlan struct {
+	Version  int             `json:"version"`
+	GroupID  string          `json:"group_id"`
+	AppID    string          `json:"app_id"`
+	AppDiffs []string        `json:"app_diffs"`
+	AppData  json.RawMessage `json:"app_data"`
+}
+
+// newPushPlan creates the plan to push the app data, whose secret values are replaced
+// with the unresolved ones so the plan never contains the values of referenced secrets
+func newPushPlan(remote appRemote, appDiffs []string, appData interface{}, unresolvedSecrets local.SecretsStructure) (pushPlan, error) {
+	data, err := json.Marshal(appData)
+	if err != nil {
+		return pushPlan{}, err
+	}
+
+	data, err = replacePlanSecrets(data, unresolvedSecrets)
+	if err != nil {
+		return pushPlan{}, err
+	}
+	return pushPlan{planVersion, remote.GroupID, remote.AppID, appDiffs, data}, nil
+}
+
+// resolvePlanSecrets returns the app data with its secret values resolved
+func resolvePlanSecrets(appData json.RawMessage, resolve func(value string) (string, error)) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(appData, &fields); err != nil {
+		return nil, err
+	}
+
+	data, ok := fields[fieldSecrets]
+	if !ok {
+		return appData, nil
+	}
+
+	var planSecrets local.SecretsStructure
+	if err := json.Unmarshal(data, &planSecrets); err != nil {
+		return nil, err
+	}
+	if err := planSecrets.Resolve(resolve); err != nil {
+		return nil, err
+	}
+	return replacePlanSecrets(appData, planSecrets)
+}
+
+func replacePlanSecrets(appData json.RawMessage, planSecrets local.SecretsStructure) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(appData, &fields); err != nil {
+		return nil, err
+	}
+	if _, ok := fields[fieldSecrets]; !ok {
+		return appData, nil
+	}
+
+	data, err := json.Marshal(planSecrets)
+	if err != nil {
+		return nil, err
+	}
+	fields[fieldSecrets] = data
+	return json.Marshal(fields)
+}
+
+func readPushPlan(path string) (pushPlan, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return pushPlan{}, err
+	}
+
+	var plan pushPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return pushPlan{}, fmt.Errorf("failed to parse plan at %s: %w", path, err)
+	}
+
+	if plan.Version != planVersion {
+		return pushPlan{}, fmt.Errorf("plan at %s has unsupported version %d", path, plan.Version)
+	}
+	if plan.GroupID == "" || plan.AppID == "" || len(plan.AppData) == 0 {
+		return pushPlan{}, fmt.Errorf("plan at %s is missing the app to push to", path)
+	}
+
+	return plan, nil
+}
+
+func (p pushPlan) write(path string) error {
+	data, err := json.MarshalIndent(p, "", "    ")
+	if err != nil {
+		return err
+	}
+	// plans may still contain secret values which are not references, so keep them private to the current user
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+func (p pushPlan) remote() appRemote {
+	return appRemote{p.GroupID, p.AppID}
+}
+
+// applyPlan pushes the exact change set recorded in the plan file,
+// failing if the remote app no longer produces the same changes
+func (cmd *Command) applyPlan(ui terminal.UI, realmClient realm.Client) error {
+	plan, err := readPushPlan(cmd.inputs.Plan)
+	if err != nil {
+		return err
+	}
+	remote := plan.remote()
+
+	appData, err := resolvePlanSecrets(plan.AppData, secrets.NewDefaultResolver().Resolve)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Determining changes"))
+	appDiffs, err := realmClient.Diff(remote.GroupID, remote.AppID, appData)
+	if err != nil {
+		return err
+	}
+
+	if !equalDiffs(plan.AppDiffs, appDiffs) {
+		return errPlanDrifted{cmd.inputs.Plan}
+	}
+
+	if len(appDiffs) == 0 {
+		ui.Print(terminal.NewTextLog("Deployed app is identical to proposed version, nothing to do"))
+		return nil
+	}
+
+	if !ui.AutoConfirm() {
+		ui.Print(terminal.NewTextLog(
+			"The following reflects the planned changes to your Realm app\n%s",
+			strings.Join(appDiffs, "\n"),
+		))
+	}
+
+	proceed, err := ui.Confirm("Please confirm the changes shown above")
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	ui.Print(terminal.NewTextLog("Creating draft"))
+	draft, proceed, err := createNewDraft(ui, realmClient, remote)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	ui.Print(terminal.NewTextLog("Pushing changes"))
+	if err := realmClient.Import(remote.GroupID, remote.AppID, appData); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Deploying draft"))
+	if err := deployDraftAndWait(ui, realmClient, remote, draft.ID, cmd.inputs.deployOptions(), !cmd.inputs.NoWait); err != nil {
+		return err
+	}
+
+	cmd.pushed = len(appDiffs)
+
+	ui.Print(terminal.NewTextLog("Successfully applied plan: %s", cmd.inputs.Plan))
+	return nil
+}
+
+func equalDiffs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,204 @@
+package push
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestPushPlanHandler(t *testing.T) {
+	tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer cleanupTmpDir()
+
+	planPath := filepath.Join(tmpDir, "plan.json")
+
+	newRealmClient := func(diffs []string) (mock.RealmClient, *[]string) {
+		var imported []string
+
+		var realmClient mock.RealmClient
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID"}}, nil
+		}
+		realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+			return diffs, nil
+		}
+		realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{ID: "draftID"}, nil
+		}
+		realmClient.ImportFn = func(groupID, appID string, appData interface{}) error {
+			data, err := json.Marshal(appData)
+			if err != nil {
+				return err
+			}
+			imported = append(imported, groupID+"/"+appID+": "+string(data))
+			return nil
+		}
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
+			return realm.AppDeployment{Status: realm.DeploymentStatusSuccessful}, nil
+		}
+		return realmClient, &imported
+	}
+
+	t.Run("should write the computed changes to the plan file without pushing", func(t *testing.T) {
+		realmClient, imported := newRealmClient([]string{"diff1", "diff2"})
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID", PlanOut: planPath}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Determining changes
+Wrote plan with 2 change(s) to `+planPath+`
+To push these changes, run: realm-cli push --plan `+planPath+`
+`, out.String())
+		assert.Equal(t, 0, len(*imported))
+
+		plan, err := readPushPlan(planPath)
+		assert.Nil(t, err)
+		assert.Equal(t, "groupID", plan.GroupID)
+		assert.Equal(t, "appID", plan.AppID)
+		assert.Equal(t, []string{"diff1", "diff2"}, plan.AppDiffs)
+		assert.True(t, len(plan.AppData) > 0, "expected plan to include the app data")
+	})
+
+	t.Run("should push exactly the changes recorded in the plan file", func(t *testing.T) {
+		realmClient, imported := newRealmClient([]string{"diff1", "diff2"})
+
+		plan, err := readPushPlan(planPath)
+		assert.Nil(t, err)
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs: inputs{Plan: planPath}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Determining changes
+Creating draft
+Pushing changes
+Deploying draft
+Deployment complete
+Successfully applied plan: `+planPath+`
+`, out.String())
+		var appData bytes.Buffer
+		assert.Nil(t, json.Compact(&appData, plan.AppData))
+		assert.Equal(t, []string{"groupID/appID: " + appData.String()}, *imported)
+	})
+
+	t.Run("should return an error when the remote app has drifted", func(t *testing.T) {
+		realmClient, imported := newRealmClient([]string{"diff1", "diff3"})
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs: inputs{Plan: planPath}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errPlanDrifted{planPath}, err)
+		assert.Equal(t, 0, len(*imported))
+	})
+
+	t.Run("should not write a plan for a new app", func(t *testing.T) {
+		var realmClient mock.RealmClient
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return nil, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs: inputs{LocalPath: "testdata/project", RemoteApp: "appID", Project: "groupID", PlanOut: planPath}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errPlanOutNewApp, err)
+	})
+}
+
+func TestReadPushPlan(t *testing.T) {
+	tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer cleanupTmpDir()
+
+	for _, tc := range []struct {
+		description string
+		contents    string
+		expectedErr string
+	}{
+		{
+			description: "should return an error when the plan is not valid json",
+			contents:    `{"version":`,
+			expectedErr: "failed to parse plan at %s: unexpected end of JSON input",
+		},
+		{
+			description: "should return an error when the plan version is not supported",
+			contents:    `{"version":2,"group_id":"groupID","app_id":"appID","app_data":{}}`,
+			expectedErr: "plan at %s has unsupported version 2",
+		},
+		{
+			description: "should return an error when the plan does not record the app",
+			contents:    `{"version":1,"app_data":{}}`,
+			expectedErr: "plan at %s is missing the app to push to",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			path := filepath.Join(tmpDir, "plan.json")
+			assert.Nil(t, ioutil.WriteFile(path, []byte(tc.contents), 0600))
+
+			_, err := readPushPlan(path)
+			assert.Equal(t, fmt.Sprintf(tc.expectedErr, path), err.Error())
+		})
+	}
+}
+
+func TestPushPlanSecrets(t *testing.T) {
+	appData := local.AppDataV2{local.AppStructureV2{
+		Secrets: local.SecretsStructure{
+			Services: map[string]map[string]string{"mongodb": {"password": "resolved-password"}},
+		},
+	}}
+	unresolvedSecrets := local.SecretsStructure{
+		Services: map[string]map[string]string{"mongodb": {"password": "vault:kv/realm#password"}},
+	}
+
+	plan, err := newPushPlan(appRemote{"groupID", "appID"}, []string{"diff1"}, appData, unresolvedSecrets)
+	assert.Nil(t, err)
+
+	t.Run("should write the unresolved secret values to the plan", func(t *testing.T) {
+		assert.True(t, strings.Contains(string(plan.AppData), `"vault:kv/realm#password"`), "expected plan to contain the secret reference")
+		assert.False(t, strings.Contains(string(plan.AppData), "resolved-password"), "expected plan not to contain the resolved secret value")
+	})
+
+	t.Run("should resolve the secret values of the plan", func(t *testing.T) {
+		resolved, err := resolvePlanSecrets(plan.AppData, func(value string) (string, error) {
+			return strings.TrimPrefix(value, "vault:kv/realm#") + "-resolved", nil
+		})
+		assert.Nil(t, err)
+
+		var data struct {
+			Secrets local.SecretsStructure `json:"secrets"`
+		}
+		assert.Nil(t, json.Unmarshal(resolved, &data))
+		assert.Equal(t, map[string]map[string]string{"mongodb": {"password": "password-resolved"}}, data.Secrets.Services)
+	})
+
+	t.Run("should return the error of a secret value which fails to resolve", func(t *testing.T) {
+		_, err := resolvePlanSecrets(plan.AppData, func(value string) (string, error) {
+			return "", errors.New("something bad happened")
+		})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaCreate is the command meta for the `rules create` command
+var CommandMetaCreate = cli.CommandMeta{
+	Use:         "create",
+	Display:     "rules create",
+	Description: "Create a collection Rule on a data source of your Realm app",
+	HelpText: `Creates the collection Rule defined in the JSON file specified with "--file",
+including its Roles, Filters and Schema, on the data source of your remote Realm
+app. The file must specify the "database" and "collection" the Rule applies to,
+for example:
+
+  {
+    "database": "store",
+    "collection": "orders",
+    "roles": [{ "name": "owner", "apply_when": { "owner_id": "%user.id" }, "read": true, "write": true }]
+  }
+
+The Rule takes effect immediately, without pushing your app. Run "pull"
+afterwards to keep your local app directory up to date.`,
+}
+
+// CommandCreate is the `rules create` command
+type CommandCreate struct {
+	inputs  createInputs
+	created int
+}
+
+type createInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+	File string
+}
+
+// Flags is the command flags
+func (cmd *CommandCreate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+	fs.StringVar(&cmd.inputs.File, flagFile, "", flagFileUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandCreate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	rule, err := readRule(cmd.inputs.File)
+	if err != nil {
+		return err
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	service, err := resolveService(clients.Realm, app.GroupID, app.ID, cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	created, err := clients.Realm.CreateRule(app.GroupID, app.ID, service.ID, rule)
+	if err != nil {
+		return err
+	}
+	cmd.created = 1
+
+	ui.Print(terminal.NewTextLog("Successfully created rule for collection '%s', id: %s", created.Namespace(), created.ID))
+	return nil
+}
+
+// ResourcesAffected is the number of rules created
+func (cmd *CommandCreate) ResourcesAffected() int {
+	return cmd.created
+}
+
+func readRule(path string) (realm.Rule, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return realm.Rule{}, err
+	}
+
+	var rule realm.Rule
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return realm.Rule{}, fmt.Errorf("failed to parse rule file %s: %s", path, err)
+	}
+	if rule.Database == "" || rule.Collection == "" {
+		return realm.Rule{}, fmt.Errorf("rule file %s must specify a database and a collection", path)
+	}
+	return rule, nil
+}
+
+func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	if i.File == "" {
+		return errors.New(`must specify a rule file with "--file"`)
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
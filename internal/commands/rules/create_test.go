@@ -0,0 +1,152 @@
+package rules
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestRulesCreateInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      createInputs
+		err         error
+	}{
+		{
+			description: "should error without a data source",
+			inputs:      createInputs{File: "rule.json"},
+			err:         errors.New(`must specify a data source with "--data-source"`),
+		},
+		{
+			description: "should error without a rule file",
+			inputs:      createInputs{dataSourceInputs: dataSourceInputs{defaultDataSource}},
+			err:         errors.New(`must specify a rule file with "--file"`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.err, tc.inputs.Resolve(profile, ui))
+		})
+	}
+}
+
+func TestRulesCreateHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	setup := func(t *testing.T, data string) (string, func()) {
+		t.Helper()
+
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+
+		path := filepath.Join(dir, "rule.json")
+		assert.Nil(t, local.WriteFile(path, 0666, strings.NewReader(data)))
+		return path, teardown
+	}
+
+	newRealmClient := func(createFn func(groupID, appID, serviceID string, rule realm.Rule) (realm.Rule, error)) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.ServicesFn = func(groupID, appID string) ([]realm.Service, error) {
+			return []realm.Service{{ID: "service1", Name: defaultDataSource, Type: "mongodb-atlas"}}, nil
+		}
+		realmClient.CreateRuleFn = createFn
+		return realmClient
+	}
+
+	t.Run("should create the rule of the file on the data source", func(t *testing.T) {
+		path, teardown := setup(t, `{
+  "database": "store",
+  "collection": "orders",
+  "roles": [{"name": "owner", "apply_when": {"owner_id": "%user.id"}, "read": true, "write": true}],
+  "filters": [{"name": "active", "query": {"active": true}}]
+}`)
+		defer teardown()
+
+		var capturedGroupID, capturedAppID, capturedServiceID string
+		var capturedRule realm.Rule
+		realmClient := newRealmClient(func(groupID, appID, serviceID string, rule realm.Rule) (realm.Rule, error) {
+			capturedGroupID, capturedAppID, capturedServiceID, capturedRule = groupID, appID, serviceID, rule
+			rule.ID = "rule1"
+			return rule, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandCreate{inputs: createInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			File:             path,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully created rule for collection 'store.orders', id: rule1\n", out.String())
+
+		assert.Equal(t, "projectID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+		assert.Equal(t, "service1", capturedServiceID)
+		assert.Equal(t, realm.Rule{
+			Database:   "store",
+			Collection: "orders",
+			Roles: []map[string]interface{}{{
+				"name":       "owner",
+				"apply_when": map[string]interface{}{"owner_id": "%user.id"},
+				"read":       true,
+				"write":      true,
+			}},
+			Filters: []map[string]interface{}{{
+				"name":  "active",
+				"query": map[string]interface{}{"active": true},
+			}},
+		}, capturedRule)
+	})
+
+	t.Run("should return an error when the rule file has no namespace", func(t *testing.T) {
+		path, teardown := setup(t, `{"database": "store"}`)
+		defer teardown()
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandCreate{inputs: createInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			File:             path,
+		}}
+
+		assert.Equal(t,
+			errors.New("rule file "+path+" must specify a database and a collection"),
+			cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient(nil)}),
+		)
+	})
+
+	t.Run("should return an error when the rule fails to create", func(t *testing.T) {
+		path, teardown := setup(t, `{"database": "store", "collection": "orders"}`)
+		defer teardown()
+
+		realmClient := newRealmClient(func(groupID, appID, serviceID string, rule realm.Rule) (realm.Rule, error) {
+			return realm.Rule{}, errors.New("something bad happened")
+		})
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandCreate{inputs: createInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			File:             path,
+		}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
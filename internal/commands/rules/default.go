@@ -0,0 +1,191 @@
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaDefaultGet is the command meta for the `rules default get` command
+var CommandMetaDefaultGet = cli.CommandMeta{
+	Use:         "get",
+	Display:     "rules default get",
+	Description: "Show the default Rule of a data source in your Realm app",
+	HelpText: `The default Rule of a data source defines the Roles and Filters which apply to
+any of its collections without a collection Rule of their own.`,
+}
+
+// CommandDefaultGet is the `rules default get` command
+type CommandDefaultGet struct {
+	inputs defaultGetInputs
+}
+
+type defaultGetInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandDefaultGet) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDefaultGet) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDefaultGet) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	service, err := resolveService(clients.Realm, app.GroupID, app.ID, cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	defaultRule, ok, err := clients.Realm.DefaultRule(app.GroupID, app.ID, service.ID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		ui.Print(terminal.NewTextLog("No default rule is set for data source '%s'", service.Name))
+		return nil
+	}
+
+	defaultRule.ID = "" // the local default_rule.json file does not track the id
+	ui.Print(terminal.NewJSONLog(fmt.Sprintf("The default rule of data source '%s'", service.Name), defaultRule))
+	return nil
+}
+
+func (i *defaultGetInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// CommandMetaDefaultSet is the command meta for the `rules default set` command
+var CommandMetaDefaultSet = cli.CommandMeta{
+	Use:         "set",
+	Display:     "rules default set",
+	Description: "Set the default Rule of a data source in your Realm app",
+	HelpText: `Sets the default Rule of the data source to the Roles and Filters defined in the
+JSON file specified with "--file", which follows the format of the
+"data_sources/<name>/default_rule.json" file of your local app directory, for
+example:
+
+  {
+    "roles": [{ "name": "readAll", "apply_when": {}, "read": true, "write": false }]
+  }
+
+Any existing default Rule is replaced, which takes effect immediately without
+pushing your app.`,
+}
+
+// CommandDefaultSet is the `rules default set` command
+type CommandDefaultSet struct {
+	inputs defaultSetInputs
+	set    int
+}
+
+type defaultSetInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+	File string
+}
+
+// Flags is the command flags
+func (cmd *CommandDefaultSet) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+	fs.StringVar(&cmd.inputs.File, flagFile, "", flagFileDefaultUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDefaultSet) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDefaultSet) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	defaultRule, err := readDefaultRule(cmd.inputs.File)
+	if err != nil {
+		return err
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	service, err := resolveService(clients.Realm, app.GroupID, app.ID, cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	existing, ok, err := clients.Realm.DefaultRule(app.GroupID, app.ID, service.ID)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		defaultRule.ID = existing.ID
+		err = clients.Realm.UpdateDefaultRule(app.GroupID, app.ID, service.ID, defaultRule)
+	} else {
+		_, err = clients.Realm.CreateDefaultRule(app.GroupID, app.ID, service.ID, defaultRule)
+	}
+	if err != nil {
+		return err
+	}
+	cmd.set = 1
+
+	ui.Print(terminal.NewTextLog("Successfully set the default rule of data source '%s'", service.Name))
+	return nil
+}
+
+// ResourcesAffected is the number of default rules created or updated
+func (cmd *CommandDefaultSet) ResourcesAffected() int {
+	return cmd.set
+}
+
+func readDefaultRule(path string) (realm.DefaultRule, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return realm.DefaultRule{}, err
+	}
+
+	var defaultRule realm.DefaultRule
+	if err := json.Unmarshal(data, &defaultRule); err != nil {
+		return realm.DefaultRule{}, fmt.Errorf("failed to parse default rule file %s: %s", path, err)
+	}
+	if len(defaultRule.Roles) == 0 && len(defaultRule.Filters) == 0 {
+		return realm.DefaultRule{}, fmt.Errorf("default rule file %s must specify roles or filters", path)
+	}
+	defaultRule.ID = ""
+	return defaultRule, nil
+}
+
+func (i *defaultSetInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	if i.File == "" {
+		return errors.New(`must specify a default rule file with "--file"`)
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
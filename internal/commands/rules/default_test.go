@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestRulesDefaultGetHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	for _, tc := range []struct {
+		description    string
+		defaultRule    realm.DefaultRule
+		found          bool
+		expectedOutput string
+	}{
+		{
+			description:    "should print a message when the data source has no default rule",
+			expectedOutput: "No default rule is set for data source 'mongodb-atlas'\n",
+		},
+		{
+			description: "should print the default rule of the data source",
+			defaultRule: realm.DefaultRule{
+				ID:    "rule1",
+				Roles: []map[string]interface{}{{"name": "readAll", "read": true}},
+			},
+			found: true,
+			expectedOutput: `The default rule of data source 'mongodb-atlas'
+{
+  "roles": [
+    {
+      "name": "readAll",
+      "read": true
+    }
+  ]
+}
+`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			var capturedServiceID string
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{app}, nil
+			}
+			realmClient.ServicesFn = func(groupID, appID string) ([]realm.Service, error) {
+				return []realm.Service{{ID: "service1", Name: defaultDataSource, Type: "mongodb-atlas"}}, nil
+			}
+			realmClient.DefaultRuleFn = func(groupID, appID, serviceID string) (realm.DefaultRule, bool, error) {
+				capturedServiceID = serviceID
+				return tc.defaultRule, tc.found, nil
+			}
+
+			cmd := &CommandDefaultGet{defaultGetInputs{
+				ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+				dataSourceInputs: dataSourceInputs{defaultDataSource},
+			}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+			assert.Equal(t, "service1", capturedServiceID)
+		})
+	}
+}
+
+func TestRulesDefaultSetInputs(t *testing.T) {
+	t.Run("should error without a default rule file", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		inputs := defaultSetInputs{dataSourceInputs: dataSourceInputs{defaultDataSource}}
+
+		assert.Equal(t, errors.New(`must specify a default rule file with "--file"`), inputs.Resolve(profile, ui))
+	})
+}
+
+func TestRulesDefaultSetHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	dir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	path := filepath.Join(dir, "default_rule.json")
+	assert.Nil(t, local.WriteFile(path, 0666, strings.NewReader(`{"_id": "ignored", "roles": [{"name": "readAll", "read": true}]}`)))
+
+	expectedRule := realm.DefaultRule{Roles: []map[string]interface{}{{"name": "readAll", "read": true}}}
+
+	newRealmClient := func(existing realm.DefaultRule, found bool) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.ServicesFn = func(groupID, appID string) ([]realm.Service, error) {
+			return []realm.Service{{ID: "service1", Name: defaultDataSource, Type: "mongodb-atlas"}}, nil
+		}
+		realmClient.DefaultRuleFn = func(groupID, appID, serviceID string) (realm.DefaultRule, bool, error) {
+			return existing, found, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should create the default rule when the data source has none", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedServiceID string
+		var capturedRule realm.DefaultRule
+		realmClient := newRealmClient(realm.DefaultRule{}, false)
+		realmClient.CreateDefaultRuleFn = func(groupID, appID, serviceID string, defaultRule realm.DefaultRule) (realm.DefaultRule, error) {
+			capturedServiceID, capturedRule = serviceID, defaultRule
+			return defaultRule, nil
+		}
+
+		cmd := &CommandDefaultSet{inputs: defaultSetInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			File:             path,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully set the default rule of data source 'mongodb-atlas'\n", out.String())
+		assert.Equal(t, "service1", capturedServiceID)
+		assert.Equal(t, expectedRule, capturedRule)
+	})
+
+	t.Run("should replace the existing default rule of the data source", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedRule realm.DefaultRule
+		realmClient := newRealmClient(realm.DefaultRule{ID: "rule1"}, true)
+		realmClient.UpdateDefaultRuleFn = func(groupID, appID, serviceID string, defaultRule realm.DefaultRule) error {
+			capturedRule = defaultRule
+			return nil
+		}
+
+		cmd := &CommandDefaultSet{inputs: defaultSetInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			File:             path,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully set the default rule of data source 'mongodb-atlas'\n", out.String())
+
+		expectedRule.ID = "rule1"
+		assert.Equal(t, expectedRule, capturedRule)
+	})
+
+	t.Run("should return an error when the default rule file has no roles or filters", func(t *testing.T) {
+		emptyPath := filepath.Join(dir, "empty.json")
+		assert.Nil(t, local.WriteFile(emptyPath, 0666, strings.NewReader(`{}`)))
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandDefaultSet{inputs: defaultSetInputs{
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			File:             emptyPath,
+		}}
+
+		assert.Equal(t,
+			errors.New("default rule file "+emptyPath+" must specify roles or filters"),
+			cmd.Handler(nil, ui, cli.Clients{}),
+		)
+	})
+}
@@ -0,0 +1,168 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaDelete is the command meta for the `rules delete` command
+var CommandMetaDelete = cli.CommandMeta{
+	Use:         "delete",
+	Display:     "rules delete",
+	Description: "Delete collection Rules from a data source of your Realm app",
+	HelpText: `With this command, you can:
+  - Remove multiple Rules at once with "--collection" flags. You can specify
+    these Rules using their ID or Namespace (database.collection) values`,
+}
+
+// CommandDelete is the `rules delete` command
+type CommandDelete struct {
+	inputs  deleteInputs
+	deleted int
+}
+
+type deleteInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+	Collections []string
+}
+
+// Flags is the command flags
+func (cmd *CommandDelete) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+	fs.StringSliceVarP(&cmd.inputs.Collections, flagCollection, flagCollectionShort, []string{}, flagCollectionUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDelete) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	service, err := resolveService(clients.Realm, app.GroupID, app.ID, cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	rules, err := clients.Realm.Rules(app.GroupID, app.ID, service.ID)
+	if err != nil {
+		return err
+	}
+
+	selected, err := cmd.inputs.resolveRules(ui, rules)
+	if err != nil {
+		return err
+	}
+
+	if len(selected) == 0 {
+		ui.Print(terminal.NewTextLog("No rules to delete"))
+		return nil
+	}
+
+	outputs := make(ruleOutputs, 0, len(selected))
+	for _, rule := range selected {
+		err := clients.Realm.DeleteRule(app.GroupID, app.ID, service.ID, rule.ID)
+		if err == nil {
+			cmd.deleted++
+		}
+		outputs = append(outputs, ruleOutput{rule, err})
+	}
+
+	sort.SliceStable(outputs, func(i, j int) bool {
+		return outputs[i].err != nil && outputs[j].err == nil
+	})
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Deleted %d rule(s)", len(outputs)),
+		tableHeaders(headerDeleted, headerDetails),
+		tableRows(outputs, tableRowDelete)...,
+	))
+	return nil
+}
+
+// ResourcesAffected is the number of rules deleted
+func (cmd *CommandDelete) ResourcesAffected() int {
+	return cmd.deleted
+}
+
+func tableRowDelete(output ruleOutput, row map[string]interface{}) {
+	deleted := false
+	if output.err != nil {
+		row[headerDetails] = output.err.Error()
+	} else {
+		deleted = true
+	}
+	row[headerDeleted] = deleted
+}
+
+func (i *deleteInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+func (i *deleteInputs) resolveRules(ui terminal.UI, serviceRules []realm.Rule) ([]realm.Rule, error) {
+	if len(serviceRules) == 0 {
+		return nil, nil
+	}
+
+	if len(i.Collections) > 0 {
+		rules := make([]realm.Rule, 0, len(i.Collections))
+		for _, identifier := range i.Collections {
+			for _, rule := range serviceRules {
+				if rule.Namespace() == identifier || rule.ID == identifier {
+					rules = append(rules, rule)
+					break
+				}
+			}
+		}
+
+		if len(rules) == 0 {
+			return nil, errors.New("unable to find rules")
+		}
+		return rules, nil
+	}
+
+	options := make([]string, 0, len(serviceRules))
+	rulesByOption := make(map[string]realm.Rule, len(serviceRules))
+	for _, rule := range serviceRules {
+		option := displayRuleOption(rule)
+		options = append(options, option)
+		rulesByOption[option] = rule
+	}
+
+	var selections []string
+	if err := ui.AskOne(
+		&selections,
+		&survey.MultiSelect{
+			Message: "Which rule(s) would you like to delete?",
+			Options: options,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	rules := make([]realm.Rule, 0, len(selections))
+	for _, selection := range selections {
+		rules = append(rules, rulesByOption[selection])
+	}
+	return rules, nil
+}
@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestRulesDeleteHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+	testRules := []realm.Rule{
+		{ID: "rule1", Database: "store", Collection: "orders"},
+		{ID: "rule2", Database: "store", Collection: "customers"},
+		{ID: "rule3", Database: "store", Collection: "products"},
+	}
+
+	newRealmClient := func(rules []realm.Rule) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.ServicesFn = func(groupID, appID string) ([]realm.Service, error) {
+			return []realm.Service{{ID: "service1", Name: defaultDataSource, Type: "mongodb-atlas"}}, nil
+		}
+		realmClient.RulesFn = func(groupID, appID, serviceID string) ([]realm.Rule, error) {
+			return rules, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should delete the rules and show the failures first", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newRealmClient(testRules)
+
+		var deletedIDs []string
+		realmClient.DeleteRuleFn = func(groupID, appID, serviceID, ruleID string) error {
+			if ruleID == "rule3" {
+				return errors.New("something bad happened")
+			}
+			deletedIDs = append(deletedIDs, serviceID+"/"+ruleID)
+			return nil
+		}
+
+		cmd := &CommandDelete{inputs: deleteInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			Collections:      []string{"store.orders", "rule3", "store.missing"},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"service1/rule1"}, deletedIDs)
+		assert.Equal(t, strings.Join([]string{
+			"Deleted 2 rule(s)",
+			"  ID     Namespace       Deleted  Details               ",
+			"  -----  --------------  -------  ----------------------",
+			"  rule3  store.products  false    something bad happened",
+			"  rule1  store.orders    true                           ",
+			"",
+		}, "\n"), out.String())
+	})
+
+	t.Run("should show a message when the data source has no rules", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDelete{inputs: deleteInputs{
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			Collections:      []string{"store.orders"},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient(nil)}))
+		assert.Equal(t, "No rules to delete\n", out.String())
+	})
+
+	t.Run("should return an error when none of the rules can be found", func(t *testing.T) {
+		cmd := &CommandDelete{inputs: deleteInputs{
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			Collections:      []string{"store.missing"},
+		}}
+
+		err := cmd.Handler(nil, nil, cli.Clients{Realm: newRealmClient(testRules)})
+		assert.Equal(t, errors.New("unable to find rules"), err)
+	})
+}
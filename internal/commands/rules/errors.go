@@ -0,0 +1,15 @@
+package rules
+
+import "fmt"
+
+type errRuleTestFailed struct {
+	operation string
+	expected  string
+	actual    string
+}
+
+func (err errRuleTestFailed) Error() string {
+	return fmt.Sprintf("expected the %s operation to be %s, but it was %s", err.operation, err.expected, err.actual)
+}
+
+func (err errRuleTestFailed) DisableUsage() struct{} { return struct{}{} }
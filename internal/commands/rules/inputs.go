@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+// Flag names and usages across the rules commands
+const (
+	flagDataSource      = "data-source"
+	flagDataSourceUsage = "specify the name of the data source whose rules to manage"
+
+	flagFile      = "file"
+	flagFileUsage = "specify the path to a JSON file of the rule to create"
+
+	flagFileDefaultUsage = "specify the path to a JSON file of the default rule to set"
+
+	flagCollection      = "collection"
+	flagCollectionShort = "c"
+	flagCollectionUsage = "specify the namespace (database.collection) or id of the rule to delete"
+
+	defaultDataSource = "mongodb-atlas"
+)
+
+type dataSourceInputs struct {
+	DataSource string
+}
+
+func (i dataSourceInputs) validate() error {
+	if i.DataSource == "" {
+		return fmt.Errorf(`must specify a data source with "--%s"`, flagDataSource)
+	}
+	return nil
+}
+
+// resolveService finds the app's service linked as the data source
+func resolveService(client realm.Client, groupID, appID, dataSource string) (realm.Service, error) {
+	services, err := client.Services(groupID, appID)
+	if err != nil {
+		return realm.Service{}, err
+	}
+
+	for _, service := range services {
+		if service.Name == dataSource {
+			return service, nil
+		}
+	}
+	return realm.Service{}, fmt.Errorf("data source '%s' does not exist", dataSource)
+}
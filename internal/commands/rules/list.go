@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaList is the command meta for the `rules list` command
+var CommandMetaList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "rules list",
+	Description: "List the collection Rules of a data source in your Realm app",
+	HelpText: `This will display the IDs and Namespaces of the collection Rules of the data
+source, along with the names of each Rule's Roles and Filters.`,
+}
+
+// CommandList is the `rules list` command
+type CommandList struct {
+	inputs listInputs
+}
+
+type listInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	service, err := resolveService(clients.Realm, app.GroupID, app.ID, cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	rules, err := clients.Realm.Rules(app.GroupID, app.ID, service.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(rules) == 0 {
+		ui.Print(terminal.NewTextLog("No available rules to show in data source '%s'", service.Name))
+		return nil
+	}
+
+	outputs := make(ruleOutputs, 0, len(rules))
+	for _, rule := range rules {
+		outputs = append(outputs, ruleOutput{rule: rule})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d rule(s) in data source '%s'", len(rules), service.Name),
+		tableHeaders(headerRoles, headerFilters),
+		tableRows(outputs, tableRowList)...,
+	))
+	return nil
+}
+
+func tableRowList(output ruleOutput, row map[string]interface{}) {
+	row[headerRoles] = displayNames(output.rule.Roles)
+	row[headerFilters] = displayNames(output.rule.Filters)
+}
+
+func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
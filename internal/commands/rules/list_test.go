@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestRulesListInputs(t *testing.T) {
+	t.Run("should error without a data source", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		inputs := listInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}}
+
+		assert.Equal(t, errors.New(`must specify a data source with "--data-source"`), inputs.Resolve(profile, ui))
+	})
+}
+
+func TestRulesListHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	for _, tc := range []struct {
+		description    string
+		rules          []realm.Rule
+		expectedOutput string
+	}{
+		{
+			description:    "should list no rules with no data source rules found",
+			expectedOutput: "No available rules to show in data source 'mongodb-atlas'\n",
+		},
+		{
+			description: "should list the rules found for the data source",
+			rules: []realm.Rule{
+				{
+					ID:         "rule1",
+					Database:   "store",
+					Collection: "orders",
+					Roles:      []map[string]interface{}{{"name": "owner"}, {"name": "admin"}},
+					Filters:    []map[string]interface{}{{"name": "active"}},
+				},
+				{ID: "rule2", Database: "store", Collection: "customers"},
+			},
+			expectedOutput: strings.Join([]string{
+				"Found 2 rule(s) in data source 'mongodb-atlas'",
+				"  ID     Namespace        Roles         Filters",
+				"  -----  ---------------  ------------  -------",
+				"  rule1  store.orders     owner, admin  active ",
+				"  rule2  store.customers                       ",
+				"",
+			}, "\n"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			var capturedServiceID string
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{app}, nil
+			}
+			realmClient.ServicesFn = func(groupID, appID string) ([]realm.Service, error) {
+				return []realm.Service{
+					{ID: "service1", Name: "other-atlas", Type: "mongodb-atlas"},
+					{ID: "service2", Name: "mongodb-atlas", Type: "mongodb-atlas"},
+				}, nil
+			}
+			realmClient.RulesFn = func(groupID, appID, serviceID string) ([]realm.Rule, error) {
+				capturedServiceID = serviceID
+				return tc.rules, nil
+			}
+
+			cmd := &CommandList{listInputs{
+				ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+				dataSourceInputs: dataSourceInputs{defaultDataSource},
+			}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+			assert.Equal(t, "service2", capturedServiceID)
+		})
+	}
+
+	t.Run("should return an error when the data source does not exist", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.ServicesFn = func(groupID, appID string) ([]realm.Service, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandList{listInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{"other-atlas"},
+		}}
+
+		assert.Equal(t, errors.New("data source 'other-atlas' does not exist"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+const (
+	headerID        = "ID"
+	headerNamespace = "Namespace"
+	headerRoles     = "Roles"
+	headerFilters   = "Filters"
+	headerDeleted   = "Deleted"
+	headerDetails   = "Details"
+)
+
+type ruleOutputs []ruleOutput
+
+type ruleOutput struct {
+	rule realm.Rule
+	err  error
+}
+
+type tableRowModifier func(ruleOutput, map[string]interface{})
+
+func tableHeaders(additionalHeaders ...string) []string {
+	return append([]string{headerID, headerNamespace}, additionalHeaders...)
+}
+
+func tableRows(outputs ruleOutputs, modifier tableRowModifier) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(outputs))
+	for _, output := range outputs {
+		rows = append(rows, tableRow(output, modifier))
+	}
+	return rows
+}
+
+func tableRow(output ruleOutput, modifier tableRowModifier) map[string]interface{} {
+	row := map[string]interface{}{
+		headerID:        output.rule.ID,
+		headerNamespace: output.rule.Namespace(),
+	}
+	modifier(output, row)
+	return row
+}
+
+// displayNames returns the names of the rule's roles or filters
+func displayNames(entries []map[string]interface{}) string {
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if name, ok := entry["name"].(string); ok {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func displayRuleOption(rule realm.Rule) string {
+	return rule.ID + terminal.DelimiterInline + rule.Namespace()
+}
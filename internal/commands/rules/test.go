@@ -0,0 +1,213 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagCollectionTestUsage = "specify the namespace (database.collection) of the document"
+
+	flagAsUser      = "as-user"
+	flagAsUserUsage = "specify the id of the user to evaluate the rules for"
+
+	flagOperation      = "operation"
+	flagOperationUsage = `select the operation to evaluate, available options: ["read", "write", "insert", "delete"]`
+
+	flagDocument      = "doc"
+	flagDocumentUsage = "specify the path to a JSON file of the document to evaluate the operation on"
+
+	flagLocal      = "local"
+	flagLocalUsage = "specify the local path to a Realm app to evaluate its rule for the collection instead of the deployed one"
+
+	flagExpect      = "expect"
+	flagExpectUsage = `select the expected result, which fails the command when not met, available options: ["allowed", "denied"]`
+
+	expectAllowed = "allowed"
+	expectDenied  = "denied"
+)
+
+var ruleOperations = []string{"read", "write", "insert", "delete"}
+
+// CommandMetaTest is the command meta for the `rules test` command
+var CommandMetaTest = cli.CommandMeta{
+	Use:         "test",
+	Display:     "rules test",
+	Description: "Evaluate whether a user may perform an operation on a document",
+	HelpText: `Evaluates the Rules of the collection to determine whether the user specified
+with "--as-user" may perform the operation on the document of the JSON file
+specified with "--doc", and which Role applies to the user.
+
+By default, the Rules deployed to your Realm app are evaluated. Specify
+"--local" to evaluate the collection Rule of your local Realm app instead, so
+permission changes can be tested before they are pushed. Specify "--expect" to
+fail the command when the result is not the expected one, for example:
+
+  realm-cli rules test -c store.orders --as-user <id> --operation write \
+    --doc order.json --local . --expect denied`,
+}
+
+// CommandTest is the `rules test` command
+type CommandTest struct {
+	inputs testInputs
+}
+
+type testInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+	Collection string
+	User       string
+	Operation  string
+	Document   string
+	LocalPath  string
+	Expect     string
+}
+
+// Flags is the command flags
+func (cmd *CommandTest) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+	fs.StringVarP(&cmd.inputs.Collection, flagCollection, flagCollectionShort, "", flagCollectionTestUsage)
+	fs.StringVar(&cmd.inputs.User, flagAsUser, "", flagAsUserUsage)
+	fs.StringVar(&cmd.inputs.Operation, flagOperation, "", flagOperationUsage)
+	fs.StringVar(&cmd.inputs.Document, flagDocument, "", flagDocumentUsage)
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocal, "", flagLocalUsage)
+	fs.StringVar(&cmd.inputs.Expect, flagExpect, "", flagExpectUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandTest) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandTest) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	parts := strings.SplitN(cmd.inputs.Collection, ".", 2)
+	evaluation := realm.RuleEvaluation{
+		UserID:     cmd.inputs.User,
+		Operation:  cmd.inputs.Operation,
+		Database:   parts[0],
+		Collection: parts[1],
+	}
+
+	data, err := ioutil.ReadFile(cmd.inputs.Document)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &evaluation.Document); err != nil {
+		return fmt.Errorf("failed to parse document file %s: %s", cmd.inputs.Document, err)
+	}
+
+	if cmd.inputs.LocalPath != "" {
+		if evaluation.Rule, err = cmd.inputs.localRule(evaluation.Database, evaluation.Collection); err != nil {
+			return err
+		}
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	service, err := resolveService(clients.Realm, app.GroupID, app.ID, cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	result, err := clients.Realm.EvaluateRule(app.GroupID, app.ID, service.ID, evaluation)
+	if err != nil {
+		return err
+	}
+
+	actual := expectDenied
+	if result.Allowed {
+		actual = expectAllowed
+		role := "no role"
+		if result.Role != "" {
+			role = "role '" + result.Role + "'"
+		}
+		ui.Print(terminal.NewTextLog(
+			"User %s is allowed to %s the document in collection '%s' with %s",
+			cmd.inputs.User,
+			cmd.inputs.Operation,
+			cmd.inputs.Collection,
+			role,
+		))
+	} else {
+		message := fmt.Sprintf("User %s is not allowed to %s the document in collection '%s'", cmd.inputs.User, cmd.inputs.Operation, cmd.inputs.Collection)
+		if result.Reason != "" {
+			message += ": " + result.Reason
+		}
+		ui.Print(terminal.NewTextLog(message))
+	}
+
+	if cmd.inputs.Expect != "" && cmd.inputs.Expect != actual {
+		return errRuleTestFailed{cmd.inputs.Operation, cmd.inputs.Expect, actual}
+	}
+	return nil
+}
+
+// localRule finds the collection rule of the local app
+func (i testInputs) localRule(database, collection string) (map[string]interface{}, error) {
+	app, err := local.LoadApp(i.LocalPath)
+	if err != nil {
+		return nil, err
+	}
+	if app.RootDir == "" {
+		return nil, fmt.Errorf("no local app found at %s", i.LocalPath)
+	}
+
+	rule, ok, err := local.FindRule(app.AppData, i.DataSource, database, collection)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("no rule found for collection '%s' in %s", i.Collection, app.RootDir)
+	}
+	return rule, nil
+}
+
+func (i *testInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	if i.Collection == "" {
+		return fmt.Errorf(`must specify a collection with "--%s"`, flagCollection)
+	}
+	if parts := strings.SplitN(i.Collection, ".", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid collection '%s', collections must be of the form database.collection", i.Collection)
+	}
+	if i.User == "" {
+		return fmt.Errorf(`must specify a user with "--%s"`, flagAsUser)
+	}
+	if !isRuleOperation(i.Operation) {
+		return fmt.Errorf(`must specify an operation with "--%s", one of: %s`, flagOperation, strings.Join(ruleOperations, ", "))
+	}
+	if i.Document == "" {
+		return fmt.Errorf(`must specify a document file with "--%s"`, flagDocument)
+	}
+	if i.Expect != "" && i.Expect != expectAllowed && i.Expect != expectDenied {
+		return fmt.Errorf("unsupported expected result '%s', use one of: %s, %s", i.Expect, expectAllowed, expectDenied)
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+func isRuleOperation(operation string) bool {
+	for _, o := range ruleOperations {
+		if o == operation {
+			return true
+		}
+	}
+	return false
+}
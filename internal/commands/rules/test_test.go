@@ -0,0 +1,207 @@
+package rules
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestRulesTestInputs(t *testing.T) {
+	valid := testInputs{
+		ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+		dataSourceInputs: dataSourceInputs{defaultDataSource},
+		Collection:       "store.orders",
+		User:             "userID",
+		Operation:        "read",
+		Document:         "order.json",
+	}
+
+	for _, tc := range []struct {
+		description string
+		modify      func(i *testInputs)
+		err         error
+	}{
+		{
+			description: "should error without a collection",
+			modify:      func(i *testInputs) { i.Collection = "" },
+			err:         errors.New(`must specify a collection with "--collection"`),
+		},
+		{
+			description: "should error with an invalid collection",
+			modify:      func(i *testInputs) { i.Collection = "orders" },
+			err:         errors.New("invalid collection 'orders', collections must be of the form database.collection"),
+		},
+		{
+			description: "should error without a user",
+			modify:      func(i *testInputs) { i.User = "" },
+			err:         errors.New(`must specify a user with "--as-user"`),
+		},
+		{
+			description: "should error with an unsupported operation",
+			modify:      func(i *testInputs) { i.Operation = "update" },
+			err:         errors.New(`must specify an operation with "--operation", one of: read, write, insert, delete`),
+		},
+		{
+			description: "should error without a document file",
+			modify:      func(i *testInputs) { i.Document = "" },
+			err:         errors.New(`must specify a document file with "--doc"`),
+		},
+		{
+			description: "should error with an unsupported expected result",
+			modify:      func(i *testInputs) { i.Expect = "maybe" },
+			err:         errors.New("unsupported expected result 'maybe', use one of: allowed, denied"),
+		},
+		{
+			description: "should resolve with valid inputs",
+			modify:      func(i *testInputs) { i.Expect = expectDenied },
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			inputs := valid
+			tc.modify(&inputs)
+
+			assert.Equal(t, tc.err, inputs.Resolve(profile, ui))
+		})
+	}
+}
+
+func TestRulesTestHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+	rule := map[string]interface{}{"database": "store", "collection": "orders", "roles": []interface{}{}}
+
+	setup := func(t *testing.T) (string, func()) {
+		t.Helper()
+
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+
+		assert.Nil(t, local.WriteFile(filepath.Join(dir, "order.json"), 0666, strings.NewReader(`{"total": 9.5}`)))
+		return dir, teardown
+	}
+
+	newRealmClient := func(evaluateFn func(groupID, appID, serviceID string, evaluation realm.RuleEvaluation) (realm.RuleEvaluationResult, error)) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.ServicesFn = func(groupID, appID string) ([]realm.Service, error) {
+			return []realm.Service{{ID: "service1", Name: defaultDataSource, Type: "mongodb-atlas"}}, nil
+		}
+		realmClient.EvaluateRuleFn = evaluateFn
+		return realmClient
+	}
+
+	newInputs := func(dir string) testInputs {
+		return testInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			Collection:       "store.orders",
+			User:             "userID",
+			Operation:        "write",
+			Document:         filepath.Join(dir, "order.json"),
+		}
+	}
+
+	t.Run("should evaluate the deployed rules for the operation on the document", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		var capturedGroupID, capturedAppID, capturedServiceID string
+		var capturedEvaluation realm.RuleEvaluation
+		realmClient := newRealmClient(func(groupID, appID, serviceID string, evaluation realm.RuleEvaluation) (realm.RuleEvaluationResult, error) {
+			capturedGroupID, capturedAppID, capturedServiceID = groupID, appID, serviceID
+			capturedEvaluation = evaluation
+			return realm.RuleEvaluationResult{Allowed: true, Role: "owner"}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandTest{newInputs(dir)}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "User userID is allowed to write the document in collection 'store.orders' with role 'owner'\n", out.String())
+
+		assert.Equal(t, "projectID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+		assert.Equal(t, "service1", capturedServiceID)
+		assert.Equal(t, realm.RuleEvaluation{
+			UserID:     "userID",
+			Operation:  "write",
+			Database:   "store",
+			Collection: "orders",
+			Document:   map[string]interface{}{"total": 9.5},
+		}, capturedEvaluation)
+	})
+
+	t.Run("should evaluate the rule of the local app and fail when the result is not the expected one", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		localApp := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+		local.AddDataSource(localApp.AppData, map[string]interface{}{"name": defaultDataSource, "type": "mongodb-atlas"})
+		localApp.AppData.(*local.AppRealmConfigJSON).DataSources[0].Rules = []map[string]interface{}{rule}
+		assert.Nil(t, localApp.Write())
+
+		var capturedRule map[string]interface{}
+		realmClient := newRealmClient(func(groupID, appID, serviceID string, evaluation realm.RuleEvaluation) (realm.RuleEvaluationResult, error) {
+			capturedRule = evaluation.Rule
+			return realm.RuleEvaluationResult{Reason: "no role applies to the user"}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		inputs := newInputs(dir)
+		inputs.LocalPath = dir
+		inputs.Expect = expectAllowed
+		cmd := &CommandTest{inputs}
+
+		assert.Equal(t, errRuleTestFailed{"write", expectAllowed, expectDenied}, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "User userID is not allowed to write the document in collection 'store.orders': no role applies to the user\n", out.String())
+		assert.Equal(t, "store", capturedRule["database"])
+		assert.Equal(t, "orders", capturedRule["collection"])
+		assert.Equal(t, []interface{}{}, capturedRule["roles"])
+	})
+
+	t.Run("should return an error when the local app has no rule for the collection", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		localApp := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+		local.AddDataSource(localApp.AppData, map[string]interface{}{"name": defaultDataSource, "type": "mongodb-atlas"})
+		assert.Nil(t, localApp.Write())
+
+		_, ui := mock.NewUI()
+
+		inputs := newInputs(dir)
+		inputs.LocalPath = dir
+		cmd := &CommandTest{inputs}
+
+		assert.Equal(t, errors.New("no rule found for collection 'store.orders' in "+dir), cmd.Handler(nil, ui, cli.Clients{}))
+	})
+
+	t.Run("should return an error when the rules fail to be evaluated", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		realmClient := newRealmClient(func(groupID, appID, serviceID string, evaluation realm.RuleEvaluation) (realm.RuleEvaluationResult, error) {
+			return realm.RuleEvaluationResult{}, errors.New("something bad happened")
+		})
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandTest{newInputs(dir)}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
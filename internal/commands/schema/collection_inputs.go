@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+const (
+	flagCollection      = "collection"
+	flagCollectionShort = "c"
+	flagCollectionUsage = "specify the namespace of the collection whose schema to use, e.g. db.coll"
+
+	flagDataSource      = "data-source"
+	flagDataSourceUsage = "specify the name of the data source the collection belongs to"
+)
+
+type collectionInputs struct {
+	Collection string
+	DataSource string
+}
+
+func (i collectionInputs) validate() error {
+	if i.Collection == "" {
+		return fmt.Errorf(`must specify a collection with "--%s"`, flagCollection)
+	}
+	if parts := strings.SplitN(i.Collection, ".", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid collection '%s', collections must be of the form db.coll", i.Collection)
+	}
+	return nil
+}
+
+// find returns the schema matching the collection inputs, if any
+func (i collectionInputs) find(schemas []realm.Schema) (realm.Schema, bool, error) {
+	var matches []realm.Schema
+	for _, schema := range schemas {
+		if schema.Namespace() != i.Collection {
+			continue
+		}
+		if i.DataSource != "" && schema.Metadata.DataSource != i.DataSource {
+			continue
+		}
+		matches = append(matches, schema)
+	}
+
+	switch len(matches) {
+	case 0:
+		return realm.Schema{}, false, nil
+	case 1:
+		return matches[0], true, nil
+	}
+	return realm.Schema{}, false, fmt.Errorf(
+		`found a schema for '%s' in more than one data source, specify which one with "--%s"`,
+		i.Collection,
+		flagDataSource,
+	)
+}
@@ -0,0 +1,13 @@
+package schema
+
+import "fmt"
+
+type errDataValidationFailed struct {
+	documents int
+}
+
+func (err errDataValidationFailed) Error() string {
+	return fmt.Sprintf("schema validation failed for %d document(s)", err.documents)
+}
+
+func (err errDataValidationFailed) DisableUsage() struct{} { return struct{}{} }
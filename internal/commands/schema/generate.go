@@ -0,0 +1,154 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagLocalPathGenerate      = "local"
+	flagLocalPathGenerateUsage = "the local path to the Realm app to write the generated schema to"
+
+	flagSampleSizeGenerateUsage = "specify the number of documents to sample to generate the schema"
+
+	defaultDataSource = "mongodb-atlas"
+)
+
+// CommandMetaGenerate is the command meta for the `schema generate` command
+var CommandMetaGenerate = cli.CommandMeta{
+	Use:         "generate",
+	Display:     "schema generate",
+	Description: "Generate a collection's Schema from its existing documents",
+	HelpText: `Samples the existing documents of the specified collection and generates a
+Schema which matches them, the same as "Generate Schema" in the Realm UI. The
+generated Schema is written to the collection's rule in your local Realm app
+directory, replacing any Schema it already has.
+
+The Schema is deployed to your remote Realm app with "push".`,
+}
+
+// CommandGenerate is the `schema generate` command
+type CommandGenerate struct {
+	inputs generateInputs
+}
+
+type generateInputs struct {
+	cli.ProjectInputs
+	collectionInputs
+	LocalPath  string
+	SampleSize int
+}
+
+// Flags is the command flags
+func (cmd *CommandGenerate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Collection, flagCollection, flagCollectionShort, "", flagCollectionUsage)
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathGenerate, "", flagLocalPathGenerateUsage)
+	fs.IntVar(&cmd.inputs.SampleSize, flagSampleSize, defaultSampleSize, flagSampleSizeGenerateUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandGenerate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandGenerate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	localApp, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(cmd.inputs.Collection, ".", 2)
+	metadata := realm.SchemaMetadata{
+		DataSource: cmd.inputs.DataSource,
+		Database:   parts[0],
+		Collection: parts[1],
+	}
+
+	s := ui.Spinner(fmt.Sprintf("Sampling documents of collection '%s'...", cmd.inputs.Collection))
+
+	generateSchema := func() (map[string]interface{}, error) {
+		s.Start()
+		defer s.Stop()
+
+		return clients.Realm.GenerateSchema(app.GroupID, app.ID, metadata, realm.SchemaGenerationOptions{
+			SampleSize: cmd.inputs.SampleSize,
+		})
+	}
+
+	schema, err := generateSchema()
+	if err != nil {
+		return err
+	}
+
+	if err := local.SetSchema(localApp.AppData, metadata.DataSource, metadata.Database, metadata.Collection, schema); err != nil {
+		return err
+	}
+
+	if err := localApp.Write(); err != nil {
+		return err
+	}
+
+	ui.Print(
+		terminal.NewTextLog("Successfully generated the schema of collection '%s' in %s", cmd.inputs.Collection, localApp.RootDir),
+		terminal.NewFollowupLog("To deploy the schema run", fmt.Sprintf("%s push --local %s", cli.Name, localApp.RootDir)),
+	)
+	return nil
+}
+
+func (i *generateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.collectionInputs.validate(); err != nil {
+		return err
+	}
+	if i.DataSource == "" {
+		return fmt.Errorf(`must specify a data source with "--%s"`, flagDataSource)
+	}
+	if i.SampleSize <= 0 {
+		return errors.New("sample size must be a positive number")
+	}
+
+	searchPath := i.LocalPath
+	if searchPath == "" {
+		searchPath = profile.WorkingDirectory
+	}
+
+	app, err := local.LoadAppConfig(searchPath)
+	if err != nil {
+		return err
+	}
+
+	if i.LocalPath == "" && app.RootDir == "" {
+		if err := ui.AskOne(&i.LocalPath, &survey.Input{Message: "App filepath (local)"}); err != nil {
+			return err
+		}
+
+		app, err = local.LoadAppConfig(i.LocalPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if app.RootDir != "" {
+		i.LocalPath = app.RootDir
+	}
+
+	return i.ProjectInputs.Resolve(ui, i.LocalPath, false)
+}
@@ -0,0 +1,174 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSchemaGenerateInputs(t *testing.T) {
+	dir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	app := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+	assert.Nil(t, app.Write())
+
+	for _, tc := range []struct {
+		description string
+		inputs      generateInputs
+		err         error
+	}{
+		{
+			description: "should error without a collection",
+			inputs:      generateInputs{collectionInputs: collectionInputs{DataSource: defaultDataSource}, SampleSize: 1},
+			err:         errors.New(`must specify a collection with "--collection"`),
+		},
+		{
+			description: "should error without a data source",
+			inputs:      generateInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, SampleSize: 1},
+			err:         errors.New(`must specify a data source with "--data-source"`),
+		},
+		{
+			description: "should error without a positive sample size",
+			inputs:      generateInputs{collectionInputs: collectionInputs{Collection: "db.coll", DataSource: defaultDataSource}},
+			err:         errors.New("sample size must be a positive number"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.err, tc.inputs.Resolve(profile, ui))
+		})
+	}
+
+	t.Run("should resolve the local app and the remote app from the working directory", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		profile.WorkingDirectory = filepath.Join(dir, local.NameDataSources)
+		_, ui := mock.NewUI()
+
+		inputs := generateInputs{collectionInputs: collectionInputs{Collection: "db.coll", DataSource: defaultDataSource}, SampleSize: 1}
+
+		assert.Nil(t, inputs.Resolve(profile, ui))
+		assert.Equal(t, dir, inputs.LocalPath)
+		assert.Equal(t, "eggcorn-abcde", inputs.App)
+	})
+}
+
+func TestSchemaGenerateHandler(t *testing.T) {
+	schema := map[string]interface{}{
+		"title":    "orders",
+		"bsonType": "object",
+		"properties": map[string]interface{}{
+			"_id":   map[string]interface{}{"bsonType": "objectId"},
+			"total": map[string]interface{}{"bsonType": "double"},
+		},
+	}
+
+	setup := func(t *testing.T) (string, func()) {
+		t.Helper()
+
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+
+		app := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+		local.AddDataSource(app.AppData, map[string]interface{}{"name": defaultDataSource, "type": "mongodb-atlas"})
+		assert.Nil(t, app.Write())
+		return dir, teardown
+	}
+
+	newRealmClient := func(generateFn func(groupID, appID string, metadata realm.SchemaMetadata, opts realm.SchemaGenerationOptions) (map[string]interface{}, error)) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.GenerateSchemaFn = generateFn
+		return realmClient
+	}
+
+	t.Run("should write the generated schema to the collection in the local app", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		var capturedGroupID, capturedAppID string
+		var capturedMetadata realm.SchemaMetadata
+		var capturedOpts realm.SchemaGenerationOptions
+		realmClient := newRealmClient(func(groupID, appID string, metadata realm.SchemaMetadata, opts realm.SchemaGenerationOptions) (map[string]interface{}, error) {
+			capturedGroupID, capturedAppID = groupID, appID
+			capturedMetadata, capturedOpts = metadata, opts
+			return schema, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandGenerate{generateInputs{
+			collectionInputs: collectionInputs{Collection: "store.orders", DataSource: defaultDataSource},
+			LocalPath:        dir,
+			SampleSize:       1000,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully generated the schema of collection 'store.orders' in "+dir+"\n"+
+			"To deploy the schema run: realm-cli push --local "+dir+"\n", out.String())
+
+		assert.Equal(t, "groupID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+		assert.Equal(t, realm.SchemaMetadata{DataSource: defaultDataSource, Database: "store", Collection: "orders"}, capturedMetadata)
+		assert.Equal(t, realm.SchemaGenerationOptions{SampleSize: 1000}, capturedOpts)
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, local.NameDataSources, defaultDataSource, "store", "orders", local.FileSchema.String()))
+		assert.Nil(t, err)
+
+		var written map[string]interface{}
+		assert.Nil(t, json.Unmarshal(data, &written))
+		assert.Equal(t, schema, written)
+	})
+
+	t.Run("should return an error when the data source does not exist locally", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		realmClient := newRealmClient(func(groupID, appID string, metadata realm.SchemaMetadata, opts realm.SchemaGenerationOptions) (map[string]interface{}, error) {
+			return schema, nil
+		})
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandGenerate{generateInputs{
+			collectionInputs: collectionInputs{Collection: "store.orders", DataSource: "other-atlas"},
+			LocalPath:        dir,
+			SampleSize:       1000,
+		}}
+
+		assert.Equal(t, errors.New("data source 'other-atlas' does not exist"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+
+	t.Run("should return an error when the schema fails to generate", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		realmClient := newRealmClient(func(groupID, appID string, metadata realm.SchemaMetadata, opts realm.SchemaGenerationOptions) (map[string]interface{}, error) {
+			return nil, errors.New("something bad happened")
+		})
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandGenerate{generateInputs{
+			collectionInputs: collectionInputs{Collection: "store.orders", DataSource: defaultDataSource},
+			LocalPath:        dir,
+			SampleSize:       1000,
+		}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
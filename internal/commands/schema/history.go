@@ -0,0 +1,152 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagHistoryLimit      = "limit"
+	flagHistoryLimitUsage = "specify the maximum number of schema versions to inspect"
+
+	defaultHistoryLimit = 10
+)
+
+// CommandMetaHistory is the command meta for the `schema history` command
+var CommandMetaHistory = cli.CommandMeta{
+	Use:         "history",
+	Display:     "schema history",
+	Description: "List the prior versions of a collection's Schema",
+	HelpText: `Lists the versions of your Realm app's Schemas which include the specified
+collection, starting with the most recent. Each version displays the number of
+top-level properties the collection's Schema defined and whether the Schema
+changed from the version before it.
+
+Use "schema rollback" to restore the collection's Schema from one of these
+versions.`,
+}
+
+// CommandHistory is the `schema history` command
+type CommandHistory struct {
+	inputs historyInputs
+}
+
+type historyInputs struct {
+	cli.ProjectInputs
+	collectionInputs
+	Limit int
+}
+
+// Flags is the command flags
+func (cmd *CommandHistory) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Collection, flagCollection, flagCollectionShort, "", flagCollectionUsage)
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, "", flagDataSourceUsage)
+	fs.IntVar(&cmd.inputs.Limit, flagHistoryLimit, defaultHistoryLimit, flagHistoryLimitUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandHistory) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandHistory) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	versions, err := clients.Realm.SchemaVersions(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version > versions[j].Version })
+	if len(versions) > cmd.inputs.Limit {
+		versions = versions[:cmd.inputs.Limit]
+	}
+
+	var outputs []historyOutput
+	for _, version := range versions {
+		schemas, err := clients.Realm.SchemaVersion(app.GroupID, app.ID, version.Version)
+		if err != nil {
+			return err
+		}
+
+		schema, ok, err := cmd.inputs.find(schemas)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		outputs = append(outputs, historyOutput{
+			version:   version.Version,
+			createdAt: version.CreatedAt,
+			schema:    schema.Schema,
+		})
+	}
+
+	if len(outputs) == 0 {
+		ui.Print(terminal.NewTextLog("No schema versions found for collection '%s'", cmd.inputs.Collection))
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(outputs))
+	for i, output := range outputs {
+		changed := true
+		if i+1 < len(outputs) {
+			changed = !reflect.DeepEqual(output.schema, outputs[i+1].schema)
+		}
+
+		properties, _ := output.schema["properties"].(map[string]interface{})
+		rows = append(rows, map[string]interface{}{
+			headerVersion:    output.version,
+			headerCreated:    time.Unix(output.createdAt, 0).UTC().String(),
+			headerProperties: len(properties),
+			headerChanged:    changed,
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d schema version(s) for collection '%s'", len(outputs), cmd.inputs.Collection),
+		[]string{headerVersion, headerCreated, headerProperties, headerChanged},
+		rows...,
+	))
+	return nil
+}
+
+func (i *historyInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+	if i.Limit <= 0 {
+		return errors.New("limit must be a positive number")
+	}
+	return i.collectionInputs.validate()
+}
+
+const (
+	headerVersion    = "Version"
+	headerCreated    = "Created"
+	headerProperties = "Properties"
+	headerChanged    = "Changed"
+)
+
+type historyOutput struct {
+	version   int
+	createdAt int64
+	schema    map[string]interface{}
+}
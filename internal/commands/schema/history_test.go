@@ -0,0 +1,156 @@
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSchemaHistoryInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      historyInputs
+		err         error
+	}{
+		{
+			description: "should error without a collection",
+			inputs:      historyInputs{Limit: 1},
+			err:         errors.New(`must specify a collection with "--collection"`),
+		},
+		{
+			description: "should error with an invalid collection",
+			inputs:      historyInputs{collectionInputs: collectionInputs{Collection: "coll"}, Limit: 1},
+			err:         errors.New("invalid collection 'coll', collections must be of the form db.coll"),
+		},
+		{
+			description: "should error with a non-positive limit",
+			inputs:      historyInputs{collectionInputs: collectionInputs{Collection: "db.coll"}},
+			err:         errors.New("limit must be a positive number"),
+		},
+		{
+			description: "should resolve with a valid collection",
+			inputs:      historyInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, Limit: 1},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			inputs := tc.inputs
+			inputs.Project = "project"
+			inputs.App = "app"
+
+			assert.Equal(t, tc.err, inputs.Resolve(&user.Profile{}, ui))
+		})
+	}
+}
+
+func TestSchemaHistoryHandler(t *testing.T) {
+	schemaAt := func(dataSource string, properties ...string) realm.Schema {
+		props := map[string]interface{}{}
+		for _, p := range properties {
+			props[p] = map[string]interface{}{"bsonType": "string"}
+		}
+		return realm.Schema{
+			Metadata: realm.SchemaMetadata{DataSource: dataSource, Database: "db", Collection: "coll"},
+			Schema:   map[string]interface{}{"properties": props},
+		}
+	}
+
+	newRealmClient := func(schemasByVersion map[int][]realm.Schema) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.SchemaVersionsFn = func(groupID, appID string) ([]realm.SchemaVersion, error) {
+			return []realm.SchemaVersion{
+				{Version: 1, CreatedAt: 1600000000},
+				{Version: 3, CreatedAt: 1600000200},
+				{Version: 2, CreatedAt: 1600000100},
+			}, nil
+		}
+		realmClient.SchemaVersionFn = func(groupID, appID string, version int) ([]realm.Schema, error) {
+			return schemasByVersion[version], nil
+		}
+		return realmClient
+	}
+
+	t.Run("should list the schema versions which include the collection", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newRealmClient(map[int][]realm.Schema{
+			1: {schemaAt("mongodb-atlas", "name")},
+			2: {schemaAt("mongodb-atlas", "name", "age")},
+			3: {schemaAt("mongodb-atlas", "name", "age")},
+		})
+
+		cmd := &CommandHistory{historyInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, Limit: 10}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Found 3 schema version(s) for collection 'db.coll'
+  Version  Created                        Properties  Changed
+  -------  -----------------------------  ----------  -------
+  3        2020-09-13 12:30:00 +0000 UTC  2           false  
+  2        2020-09-13 12:28:20 +0000 UTC  2           true   
+  1        2020-09-13 12:26:40 +0000 UTC  1           true   
+`, out.String())
+	})
+
+	t.Run("should only inspect up to the limit of versions", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var inspected []int
+		realmClient := newRealmClient(nil)
+		realmClient.SchemaVersionFn = func(groupID, appID string, version int) ([]realm.Schema, error) {
+			inspected = append(inspected, version)
+			return []realm.Schema{schemaAt("mongodb-atlas", "name")}, nil
+		}
+
+		cmd := &CommandHistory{historyInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, Limit: 2}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []int{3, 2}, inspected)
+		assert.True(t, len(out.String()) > 0, "expected output to be printed")
+	})
+
+	t.Run("should print a message when the collection has no schema versions", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newRealmClient(map[int][]realm.Schema{})
+
+		cmd := &CommandHistory{historyInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, Limit: 10}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No schema versions found for collection 'db.coll'\n", out.String())
+	})
+
+	t.Run("should error when the collection exists in more than one data source", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newRealmClient(map[int][]realm.Schema{
+			3: {schemaAt("mongodb-atlas", "name"), schemaAt("other-cluster", "name")},
+		})
+
+		cmd := &CommandHistory{historyInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, Limit: 10}}
+
+		assert.Equal(t,
+			errors.New(`found a schema for 'db.coll' in more than one data source, specify which one with "--data-source"`),
+			cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}),
+		)
+	})
+
+	t.Run("should return an error when the client fails to get schema versions", func(t *testing.T) {
+		realmClient := newRealmClient(nil)
+		realmClient.SchemaVersionsFn = func(groupID, appID string) ([]realm.SchemaVersion, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandHistory{historyInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, Limit: 10}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, nil, cli.Clients{Realm: realmClient}))
+	})
+}
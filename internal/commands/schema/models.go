@@ -2,12 +2,14 @@ package schema
 
 import (
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
 	"github.com/10gen/realm-cli/internal/terminal"
 
 	"github.com/spf13/pflag"
@@ -29,7 +31,8 @@ With this command, you can:
   - Specify the language with a "--language" flag
   - Filter which Schema objects you’d like to include in your output with "--name" flags
   - Combine your Schema objects into a single output with a "--flat" flag
-  - Omit import groups from your model with a "--no-imports" flag`,
+  - Omit import groups from your model with a "--no-imports" flag
+  - Write your data models as source files to a directory with an "--output-dir" flag`,
 }
 
 // CommandDatamodels is the `schema datamodels` command
@@ -45,6 +48,7 @@ func (cmd *CommandDatamodels) Flags(fs *pflag.FlagSet) {
 	fs.BoolVar(&cmd.inputs.Flat, flagModelsFlat, false, flagModelsFlatUsage)
 	fs.BoolVar(&cmd.inputs.NoImports, flagModelsNoImports, false, flagModelsNoImportsUsage)
 	fs.StringSliceVar(&cmd.inputs.Names, flagModelsName, []string{}, flagModelsNameUsage)
+	fs.StringVar(&cmd.inputs.OutputDir, flagModelsOutputDir, "", flagModelsOutputDirUsage)
 }
 
 // Inputs is the command inputs
@@ -108,6 +112,7 @@ func (cmd *CommandDatamodels) Handler(profile *user.Profile, ui terminal.UI, cli
 	}
 
 	logs := make([]terminal.Log, 0, len(models))
+	files := make([]modelFile, 0, len(models))
 
 	if cmd.inputs.Flat {
 		var allImports []string
@@ -128,26 +133,49 @@ func (cmd *CommandDatamodels) Handler(profile *user.Profile, ui terminal.UI, cli
 			allCodes = append(allCodes, model.Code)
 		}
 
+		code := codeSnippet(allImports, strings.Join(allCodes, "\n"))
+
+		files = append(files, modelFile{flatModelsFileName, code})
 		logs = append(logs, terminal.NewTextLog(
 			"The following %s data models were generated from your schema\n\n%s",
 			languageDisplay(cmd.inputs.Language),
-			codeSnippet(allImports, strings.Join(allCodes, "\n")),
+			code,
 		))
 	} else {
 		for _, model := range models {
 			inspectModelAlerts(model)
 
+			code := codeSnippet(model.Imports, model.Code)
+
+			files = append(files, modelFile{model.Name, code})
 			logs = append(logs, terminal.NewTextLog(
 				"The following %s data model was generated from your schema: %s\n\n%s",
 				languageDisplay(cmd.inputs.Language),
 				strings.ToUpper(model.Name),
-				codeSnippet(model.Imports, model.Code),
+				code,
 			))
 		}
 	}
 
-	// print data models
-	ui.Print(logs...)
+	if cmd.inputs.OutputDir == "" {
+		// print data models
+		ui.Print(logs...)
+	} else {
+		// write data models
+		paths := make([]interface{}, 0, len(files))
+		for _, file := range files {
+			path := filepath.Join(cmd.inputs.OutputDir, file.name+languageExtension(cmd.inputs.Language))
+			if err := local.WriteFile(path, 0666, strings.NewReader(file.code)); err != nil {
+				return err
+			}
+			paths = append(paths, path)
+		}
+
+		ui.Print(terminal.NewListLog(
+			fmt.Sprintf("Wrote %d %s data model file(s) to %s", len(paths), languageDisplay(cmd.inputs.Language), cmd.inputs.OutputDir),
+			paths...,
+		))
+	}
 
 	// report any errors
 	if len(modelsWithError) > 0 {
@@ -182,6 +210,15 @@ func (cmd *CommandDatamodels) Handler(profile *user.Profile, ui terminal.UI, cli
 	return nil
 }
 
+// flatModelsFileName is the name of the file the data models are written to when grouped together
+const flatModelsFileName = "models"
+
+// modelFile is a data model source file to write
+type modelFile struct {
+	name string
+	code string
+}
+
 const (
 	modelWarningsTableHeaderCollection = "Collection"
 	modelWarningsTableHeaderModelName  = "Model Name"
@@ -262,3 +299,23 @@ func languageDisplay(l language) string {
 	}
 	return ""
 }
+
+func languageExtension(l language) string {
+	switch l {
+	case languageCSharp:
+		return ".cs"
+	case languageJava:
+		return ".java"
+	case languageJavascript:
+		return ".js"
+	case languageKotlin:
+		return ".kt"
+	case languageObjectiveC:
+		return ".m"
+	case languageSwift:
+		return ".swift"
+	case languageTypescript:
+		return ".ts"
+	}
+	return ""
+}
@@ -25,6 +25,9 @@ const (
 
 	flagModelsName      = "name"
 	flagModelsNameUsage = "use (as an array or csv flag) to filter for matched schema object names"
+
+	flagModelsOutputDir      = "output-dir"
+	flagModelsOutputDirUsage = "specify a directory to write the generated data models to as source files, instead of printing them"
 )
 
 type datamodelsInputs struct {
@@ -33,6 +36,7 @@ type datamodelsInputs struct {
 	Language  language
 	NoImports bool
 	Names     []string
+	OutputDir string
 	nameSet   map[string]struct{}
 }
 
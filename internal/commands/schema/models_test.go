@@ -3,11 +3,14 @@ package schema
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
 )
@@ -413,6 +416,75 @@ export const schemaThree = {}
 		}
 
 	})
+
+	t.Run("should write the data models to the output directory", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.SchemaModelsFn = func(groupID, appID, language string) ([]realm.SchemaModel, error) {
+			return []realm.SchemaModel{
+				{Name: "Order", Imports: []string{"import RealmSwift\n"}, Code: "class Order: Object {}\n"},
+				{Name: "Item", Imports: []string{"import RealmSwift\n"}, Code: "class Item: Object {}\n"},
+			}, nil
+		}
+
+		for _, tc := range []struct {
+			description string
+			flat        bool
+			files       map[string]string
+		}{
+			{
+				description: "with a file for each data model",
+				files: map[string]string{
+					"Order.swift": "import RealmSwift\n\nclass Order: Object {}\n",
+					"Item.swift":  "import RealmSwift\n\nclass Item: Object {}\n",
+				},
+			},
+			{
+				description: "with a single file when grouped together",
+				flat:        true,
+				files: map[string]string{
+					"models.swift": "import RealmSwift\n\nclass Order: Object {}\n\nclass Item: Object {}\n",
+				},
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				dir, teardown, err := u.NewTempDir("")
+				assert.Nil(t, err)
+				defer teardown()
+
+				profile := mock.NewProfile(t)
+
+				out, ui := mock.NewUI()
+
+				outputDir := filepath.Join(dir, "models")
+
+				cmd := &CommandDatamodels{datamodelsInputs{
+					Flat:          tc.flat,
+					Language:      languageSwift,
+					OutputDir:     outputDir,
+					ProjectInputs: cli.ProjectInputs{Project: "project", App: "test-app"},
+				}}
+
+				assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+
+				var paths []string
+				if tc.flat {
+					paths = []string{filepath.Join(outputDir, "models.swift")}
+				} else {
+					paths = []string{filepath.Join(outputDir, "Order.swift"), filepath.Join(outputDir, "Item.swift")}
+				}
+				assert.Equal(t, fmt.Sprintf("Wrote %d Swift data model file(s) to %s\n  %s\n", len(paths), outputDir, strings.Join(paths, "\n  ")), out.String())
+
+				for name, code := range tc.files {
+					data, err := ioutil.ReadFile(filepath.Join(outputDir, name))
+					assert.Nil(t, err)
+					assert.Equal(t, code, string(data))
+				}
+			})
+		}
+	})
 }
 
 func TestLanguageType(t *testing.T) {
@@ -454,3 +526,23 @@ func TestLanguageDisplay(t *testing.T) {
 		})
 	}
 }
+
+func TestLanguageExtension(t *testing.T) {
+	for _, tc := range []struct {
+		l        language
+		expected string
+	}{
+		{l: language("eggcorn")},
+		{languageCSharp, ".cs"},
+		{languageJava, ".java"},
+		{languageJavascript, ".js"},
+		{languageKotlin, ".kt"},
+		{languageObjectiveC, ".m"},
+		{languageSwift, ".swift"},
+		{languageTypescript, ".ts"},
+	} {
+		t.Run(fmt.Sprintf("should map %s language to the correct file extension", tc.l), func(t *testing.T) {
+			assert.Equal(t, tc.expected, languageExtension(tc.l))
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagRollbackTo      = "to"
+	flagRollbackToUsage = `specify the schema version to roll back to, see "schema history" for the available versions`
+
+	rollbackVersionUnset = -1
+)
+
+// CommandMetaRollback is the command meta for the `schema rollback` command
+var CommandMetaRollback = cli.CommandMeta{
+	Use:         "rollback",
+	Display:     "schema rollback",
+	Description: "Restore a collection's Schema from a prior version",
+	HelpText: `Stages the collection's Schema from the specified version in a draft of your
+Realm app, which you may then choose to deploy. If your app already has a draft,
+the rollback is added to it alongside its other changes.
+
+Rolling back a Schema can be used to recover from a bad Schema push on a Sync
+app. Use "schema history" to find the version to roll back to.`,
+}
+
+// CommandRollback is the `schema rollback` command
+type CommandRollback struct {
+	inputs rollbackInputs
+}
+
+type rollbackInputs struct {
+	cli.ProjectInputs
+	collectionInputs
+	To int
+}
+
+// Flags is the command flags
+func (cmd *CommandRollback) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Collection, flagCollection, flagCollectionShort, "", flagCollectionUsage)
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, "", flagDataSourceUsage)
+	fs.IntVar(&cmd.inputs.To, flagRollbackTo, rollbackVersionUnset, flagRollbackToUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandRollback) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandRollback) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	schemas, err := clients.Realm.Schemas(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	current, ok, err := cmd.inputs.find(schemas)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("no schema found for collection '%s'", cmd.inputs.Collection)
+	}
+
+	versionSchemas, err := clients.Realm.SchemaVersion(app.GroupID, app.ID, cmd.inputs.To)
+	if err != nil {
+		return err
+	}
+
+	target, ok, err := cmd.inputs.find(versionSchemas)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("no schema found for collection '%s' at version %d", cmd.inputs.Collection, cmd.inputs.To)
+	}
+
+	draft, err := clients.Realm.CreateDraft(app.GroupID, app.ID)
+	if err != nil {
+		if e, ok := err.(realm.ServerError); !ok || e.Code != realm.ErrCodeDraftAlreadyExists {
+			return err
+		}
+
+		if draft, err = clients.Realm.Draft(app.GroupID, app.ID); err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("Adding the rollback to the existing draft %s", draft.ID))
+	}
+
+	if err := clients.Realm.UpdateSchema(app.GroupID, app.ID, realm.Schema{
+		ID:       current.ID,
+		Metadata: current.Metadata,
+		Schema:   target.Schema,
+	}); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"Staged the schema for collection '%s' from version %d in draft %s",
+		cmd.inputs.Collection,
+		cmd.inputs.To,
+		draft.ID,
+	))
+
+	proceed, err := ui.Confirm("Would you like to deploy the draft now?")
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		ui.Print(terminal.NewTextLog("The draft was left for you to review and deploy"))
+		return nil
+	}
+
+	deployment, err := clients.Realm.DeployDraft(app.GroupID, app.ID, draft.ID, realm.DeployDraftOptions{
+		Comment: fmt.Sprintf("Roll back schema for collection '%s' to version %d", cmd.inputs.Collection, cmd.inputs.To),
+	})
+	if err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Deployment %s has started, check its status with \"apps deployments list\"", deployment.ID))
+	return nil
+}
+
+func (i *rollbackInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+	if i.To == rollbackVersionUnset {
+		return fmt.Errorf(`must specify the schema version to roll back to with "--%s"`, flagRollbackTo)
+	}
+	if i.To < 0 {
+		return errors.New("schema version must not be negative")
+	}
+	return i.collectionInputs.validate()
+}
@@ -0,0 +1,185 @@
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSchemaRollbackInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      rollbackInputs
+		err         error
+	}{
+		{
+			description: "should error without a version",
+			inputs:      rollbackInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, To: rollbackVersionUnset},
+			err:         errors.New(`must specify the schema version to roll back to with "--to"`),
+		},
+		{
+			description: "should error with a negative version",
+			inputs:      rollbackInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, To: -2},
+			err:         errors.New("schema version must not be negative"),
+		},
+		{
+			description: "should error without a collection",
+			inputs:      rollbackInputs{To: 1},
+			err:         errors.New(`must specify a collection with "--collection"`),
+		},
+		{
+			description: "should resolve with a collection and version",
+			inputs:      rollbackInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, To: 1},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			inputs := tc.inputs
+			inputs.Project = "project"
+			inputs.App = "app"
+
+			assert.Equal(t, tc.err, inputs.Resolve(&user.Profile{}, ui))
+		})
+	}
+}
+
+func TestSchemaRollbackHandler(t *testing.T) {
+	metadata := realm.SchemaMetadata{DataSource: "mongodb-atlas", Database: "db", Collection: "coll"}
+	current := realm.Schema{ID: "schemaID", Metadata: metadata, Schema: map[string]interface{}{"title": "bad"}}
+	previous := realm.Schema{ID: "oldSchemaID", Metadata: metadata, Schema: map[string]interface{}{"title": "good"}}
+
+	newRealmClient := func() mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.SchemasFn = func(groupID, appID string) ([]realm.Schema, error) {
+			return []realm.Schema{current}, nil
+		}
+		realmClient.SchemaVersionFn = func(groupID, appID string, version int) ([]realm.Schema, error) {
+			return []realm.Schema{previous}, nil
+		}
+		realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{ID: "draftID"}, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should stage the prior schema in a draft and deploy it", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		var updated realm.Schema
+		var deployOpts realm.DeployDraftOptions
+
+		realmClient := newRealmClient()
+		realmClient.UpdateSchemaFn = func(groupID, appID string, schema realm.Schema) error {
+			updated = schema
+			return nil
+		}
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
+			deployOpts = opts
+			return realm.AppDeployment{ID: "deploymentID"}, nil
+		}
+
+		cmd := &CommandRollback{rollbackInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, To: 2}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, realm.Schema{ID: "schemaID", Metadata: metadata, Schema: previous.Schema}, updated)
+		assert.Equal(t, realm.DeployDraftOptions{Comment: "Roll back schema for collection 'db.coll' to version 2"}, deployOpts)
+		assert.Equal(t, `Staged the schema for collection 'db.coll' from version 2 in draft draftID
+Deployment deploymentID has started, check its status with "apps deployments list"
+`, out.String())
+	})
+
+	t.Run("should add the rollback to an existing draft and leave it undeployed", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		realmClient := newRealmClient()
+		realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{}, realm.ServerError{Code: realm.ErrCodeDraftAlreadyExists}
+		}
+		realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{ID: "existingDraftID"}, nil
+		}
+		realmClient.UpdateSchemaFn = func(groupID, appID string, schema realm.Schema) error {
+			return nil
+		}
+		var deployed bool
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
+			deployed = true
+			return realm.AppDeployment{}, nil
+		}
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Would you like to deploy the draft now?")
+			console.SendLine("n")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandRollback{rollbackInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, To: 2}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.False(t, deployed, "expected draft to not be deployed")
+	})
+
+	for _, tc := range []struct {
+		description string
+		setup       func(realmClient *mock.RealmClient)
+		err         error
+	}{
+		{
+			description: "should error when the collection has no current schema",
+			setup: func(realmClient *mock.RealmClient) {
+				realmClient.SchemasFn = func(groupID, appID string) ([]realm.Schema, error) {
+					return nil, nil
+				}
+			},
+			err: errors.New("no schema found for collection 'db.coll'"),
+		},
+		{
+			description: "should error when the version has no schema for the collection",
+			setup: func(realmClient *mock.RealmClient) {
+				realmClient.SchemaVersionFn = func(groupID, appID string, version int) ([]realm.Schema, error) {
+					return nil, nil
+				}
+			},
+			err: errors.New("no schema found for collection 'db.coll' at version 2"),
+		},
+		{
+			description: "should error when the draft fails to be created",
+			setup: func(realmClient *mock.RealmClient) {
+				realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+					return realm.AppDraft{}, errors.New("something bad happened")
+				}
+			},
+			err: errors.New("something bad happened"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			realmClient := newRealmClient()
+			tc.setup(&realmClient)
+
+			cmd := &CommandRollback{rollbackInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, To: 2}}
+
+			assert.Equal(t, tc.err, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		})
+	}
+}
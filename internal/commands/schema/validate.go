@@ -0,0 +1,213 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	flagLocalPathValidate      = "local"
+	flagLocalPathValidateUsage = "the local path to the Realm app whose schema to validate against"
+
+	flagFile      = "file"
+	flagFileUsage = "specify a JSON file of documents to validate instead of sampling the collection"
+
+	flagSample      = "sample"
+	flagSampleUsage = "specify the number of documents to sample from the collection"
+
+	// sampleDocumentsSource is the function source which samples the documents of a collection
+	sampleDocumentsSource = `exports = function(dataSource, database, collection, size) {
+  return context.services.get(dataSource).db(database).collection(collection).aggregate([{ $sample: { size: size } }]).toArray();
+};`
+)
+
+// CommandMetaValidate is the command meta for the `schema validate` command
+var CommandMetaValidate = cli.CommandMeta{
+	Use:         "validate",
+	Display:     "schema validate",
+	Description: "Validate documents against a collection's Schema in your local Realm app",
+	HelpText: `Validates documents against the Schema of the specified collection in your
+local Realm app directory, without pushing your app. By default, a random
+sample of the collection's existing documents is fetched from your remote Realm
+app; specify "--file" to validate the documents of a local JSON file instead,
+either an array of documents or one document per line, in Extended JSON.
+
+Each field which fails to match the Schema is reported with the number of
+mismatched documents and a sample of their IDs. Fix any reported documents, or
+the Schema, before enabling Sync for the collection.`,
+}
+
+// CommandValidate is the `schema validate` command
+type CommandValidate struct {
+	inputs validateInputs
+}
+
+type validateInputs struct {
+	cli.ProjectInputs
+	collectionInputs
+	LocalPath string
+	File      string
+	Sample    int
+}
+
+// Flags is the command flags
+func (cmd *CommandValidate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Collection, flagCollection, flagCollectionShort, "", flagCollectionUsage)
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathValidate, "", flagLocalPathValidateUsage)
+	fs.StringVar(&cmd.inputs.File, flagFile, "", flagFileUsage)
+	fs.IntVar(&cmd.inputs.Sample, flagSample, defaultSampleSize, flagSampleUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandValidate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandValidate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	localApp, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(cmd.inputs.Collection, ".", 2)
+	metadata := realm.SchemaMetadata{
+		DataSource: cmd.inputs.DataSource,
+		Database:   parts[0],
+		Collection: parts[1],
+	}
+
+	schema, ok, err := local.FindSchema(localApp.AppData, metadata.DataSource, metadata.Database, metadata.Collection)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("no schema found for collection '%s' in %s", cmd.inputs.Collection, localApp.RootDir)
+	}
+
+	var documents []bson.D
+	if cmd.inputs.File != "" {
+		documents, err = readDocuments(cmd.inputs.File)
+	} else {
+		documents, err = cmd.sampleDocuments(ui, clients.Realm, metadata)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(documents) == 0 {
+		ui.Print(terminal.NewTextLog("No documents found to validate in collection '%s'", cmd.inputs.Collection))
+		return nil
+	}
+
+	return printValidationResult(ui, cmd.inputs.Collection, validateDocuments(schema, documents))
+}
+
+func (cmd *CommandValidate) sampleDocuments(ui terminal.UI, client realm.Client, metadata realm.SchemaMetadata) ([]bson.D, error) {
+	app, err := cli.ResolveApp(ui, client, cmd.inputs.Filter())
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := json.Marshal([]interface{}{metadata.DataSource, metadata.Database, metadata.Collection, cmd.inputs.Sample})
+	if err != nil {
+		return nil, err
+	}
+	evalSource := fmt.Sprintf("exports(%s)", bytes.Trim(args, "[]"))
+
+	s := ui.Spinner(fmt.Sprintf("Sampling documents of collection '%s'...", cmd.inputs.Collection))
+
+	execute := func() (realm.ExecutionResults, error) {
+		s.Start()
+		defer s.Stop()
+
+		return client.AppDebugExecuteFunctionSource(app.GroupID, app.ID, "", sampleDocumentsSource, evalSource)
+	}
+
+	res, err := execute()
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		return nil, fmt.Errorf("failed to sample the documents of collection '%s': %s", cmd.inputs.Collection, res.ErrorMessage())
+	}
+	if res.Result == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(res.Result)
+	if err != nil {
+		return nil, err
+	}
+	return parseDocuments(bytes.NewReader(data))
+}
+
+func readDocuments(path string) ([]bson.D, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return parseDocuments(file)
+}
+
+func (i *validateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.collectionInputs.validate(); err != nil {
+		return err
+	}
+	if i.DataSource == "" {
+		return fmt.Errorf(`must specify a data source with "--%s"`, flagDataSource)
+	}
+	if i.File == "" && i.Sample <= 0 {
+		return errors.New("sample size must be a positive number")
+	}
+
+	searchPath := i.LocalPath
+	if searchPath == "" {
+		searchPath = profile.WorkingDirectory
+	}
+
+	app, err := local.LoadAppConfig(searchPath)
+	if err != nil {
+		return err
+	}
+
+	if i.LocalPath == "" && app.RootDir == "" {
+		if err := ui.AskOne(&i.LocalPath, &survey.Input{Message: "App filepath (local)"}); err != nil {
+			return err
+		}
+
+		app, err = local.LoadAppConfig(i.LocalPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if app.RootDir != "" {
+		i.LocalPath = app.RootDir
+	}
+
+	if i.File != "" {
+		// the documents are read locally, so the remote app is not needed
+		return nil
+	}
+	return i.ProjectInputs.Resolve(ui, i.LocalPath, false)
+}
@@ -0,0 +1,193 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagSampleSize      = "sample-size"
+	flagSampleSizeUsage = "specify the maximum number of documents to validate, 0 validates every document"
+
+	defaultSampleSize = 1000
+
+	// maxSampleDocumentIDs is the number of document ids reported per mismatch
+	maxSampleDocumentIDs = 3
+)
+
+// CommandMetaValidateData is the command meta for the `schema validate-data` command
+var CommandMetaValidateData = cli.CommandMeta{
+	Use:         "validate-data",
+	Display:     "schema validate-data",
+	Description: "Validate a collection's existing documents against its Schema",
+	HelpText: `Runs the server-side Schema validation of your Realm app against the existing
+documents of the specified collection. Each field which fails to match the
+Schema, such as a null value for a required field or a value of the wrong BSON
+type, is reported with the number of mismatched documents and a sample of their
+IDs.
+
+Fix any reported documents before enabling Sync or GraphQL for the collection.`,
+}
+
+// CommandValidateData is the `schema validate-data` command
+type CommandValidateData struct {
+	inputs validateDataInputs
+}
+
+type validateDataInputs struct {
+	cli.ProjectInputs
+	collectionInputs
+	SampleSize int
+}
+
+// Flags is the command flags
+func (cmd *CommandValidateData) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Collection, flagCollection, flagCollectionShort, "", flagCollectionUsage)
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, "", flagDataSourceUsage)
+	fs.IntVar(&cmd.inputs.SampleSize, flagSampleSize, defaultSampleSize, flagSampleSizeUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandValidateData) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandValidateData) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	schemas, err := clients.Realm.Schemas(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	schema, ok, err := cmd.inputs.find(schemas)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("no schema found for collection '%s'", cmd.inputs.Collection)
+	}
+
+	result, err := clients.Realm.ValidateSchemaData(app.GroupID, app.ID, schema, realm.SchemaValidationOptions{
+		Limit: cmd.inputs.SampleSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printValidationResult(ui, cmd.inputs.Collection, result)
+}
+
+func (i *validateDataInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+	if i.SampleSize < 0 {
+		return errors.New("sample size must not be negative")
+	}
+	return i.collectionInputs.validate()
+}
+
+const (
+	headerField     = "Field"
+	headerError     = "Error"
+	headerDocuments = "Documents"
+	headerSampleIDs = "Sample Document IDs"
+)
+
+// printValidationResult prints the documents of the collection which do not match its schema,
+// returning an error when there are any
+func printValidationResult(ui terminal.UI, collection string, result realm.SchemaValidationResult) error {
+	if len(result.Errors) == 0 {
+		ui.Print(terminal.NewTextLog(
+			"All %d document(s) checked in collection '%s' match the schema",
+			result.DocumentsChecked,
+			collection,
+		))
+		return nil
+	}
+
+	mismatches, documents := newFieldMismatches(result.Errors)
+
+	rows := make([]map[string]interface{}, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		rows = append(rows, map[string]interface{}{
+			headerField:     mismatch.field,
+			headerError:     mismatch.message,
+			headerDocuments: len(mismatch.documentIDs),
+			headerSampleIDs: strings.Join(mismatch.sampleIDs(), ", "),
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf(
+			"Found %d of %d document(s) checked in collection '%s' which do not match the schema",
+			documents,
+			result.DocumentsChecked,
+			collection,
+		),
+		[]string{headerField, headerError, headerDocuments, headerSampleIDs},
+		rows...,
+	))
+
+	return errDataValidationFailed{documents}
+}
+
+type fieldMismatch struct {
+	field       string
+	message     string
+	documentIDs []string
+}
+
+func (m fieldMismatch) sampleIDs() []string {
+	if len(m.documentIDs) > maxSampleDocumentIDs {
+		return m.documentIDs[:maxSampleDocumentIDs]
+	}
+	return m.documentIDs
+}
+
+// newFieldMismatches groups the validation errors by field and message,
+// returning the groups with the most documents first along with the
+// total number of distinct documents which failed validation
+func newFieldMismatches(validationErrors []realm.SchemaValidationError) ([]fieldMismatch, int) {
+	var mismatches []fieldMismatch
+
+	indexes := map[[2]string]int{}
+	documents := map[string]struct{}{}
+	for _, e := range validationErrors {
+		documents[e.DocumentID] = struct{}{}
+
+		key := [2]string{e.Field, e.Message}
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(mismatches)
+			indexes[key] = idx
+			mismatches = append(mismatches, fieldMismatch{field: e.Field, message: e.Message})
+		}
+		mismatches[idx].documentIDs = append(mismatches[idx].documentIDs, e.DocumentID)
+	}
+
+	sort.SliceStable(mismatches, func(i, j int) bool {
+		if len(mismatches[i].documentIDs) != len(mismatches[j].documentIDs) {
+			return len(mismatches[i].documentIDs) > len(mismatches[j].documentIDs)
+		}
+		return mismatches[i].field < mismatches[j].field
+	})
+
+	return mismatches, len(documents)
+}
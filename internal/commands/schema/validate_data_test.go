@@ -0,0 +1,135 @@
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSchemaValidateDataInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      validateDataInputs
+		err         error
+	}{
+		{
+			description: "should error with a negative sample size",
+			inputs:      validateDataInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, SampleSize: -1},
+			err:         errors.New("sample size must not be negative"),
+		},
+		{
+			description: "should error without a collection",
+			err:         errors.New(`must specify a collection with "--collection"`),
+		},
+		{
+			description: "should resolve with a collection",
+			inputs:      validateDataInputs{collectionInputs: collectionInputs{Collection: "db.coll"}},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			inputs := tc.inputs
+			inputs.Project = "project"
+			inputs.App = "app"
+
+			assert.Equal(t, tc.err, inputs.Resolve(&user.Profile{}, ui))
+		})
+	}
+}
+
+func TestSchemaValidateDataHandler(t *testing.T) {
+	schema := realm.Schema{
+		ID:       "schemaID",
+		Metadata: realm.SchemaMetadata{DataSource: "mongodb-atlas", Database: "db", Collection: "coll"},
+		Schema:   map[string]interface{}{"title": "coll"},
+	}
+
+	newRealmClient := func(result realm.SchemaValidationResult) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.SchemasFn = func(groupID, appID string) ([]realm.Schema, error) {
+			return []realm.Schema{schema}, nil
+		}
+		realmClient.ValidateSchemaDataFn = func(groupID, appID string, schema realm.Schema, opts realm.SchemaValidationOptions) (realm.SchemaValidationResult, error) {
+			return result, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should report the field mismatches found", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var validated realm.Schema
+		var validatedOpts realm.SchemaValidationOptions
+
+		realmClient := newRealmClient(realm.SchemaValidationResult{})
+		realmClient.ValidateSchemaDataFn = func(groupID, appID string, schema realm.Schema, opts realm.SchemaValidationOptions) (realm.SchemaValidationResult, error) {
+			validated, validatedOpts = schema, opts
+			return realm.SchemaValidationResult{
+				DocumentsChecked: 10,
+				Errors: []realm.SchemaValidationError{
+					{DocumentID: "doc1", Field: "age", Message: "expected type int but found string"},
+					{DocumentID: "doc2", Field: "name", Message: "required field is null"},
+					{DocumentID: "doc3", Field: "name", Message: "required field is null"},
+					{DocumentID: "doc4", Field: "name", Message: "required field is null"},
+					{DocumentID: "doc5", Field: "name", Message: "required field is null"},
+					{DocumentID: "doc1", Field: "name", Message: "required field is null"},
+				},
+			}, nil
+		}
+
+		cmd := &CommandValidateData{validateDataInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, SampleSize: 10}}
+
+		assert.Equal(t, errDataValidationFailed{5}, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, schema, validated)
+		assert.Equal(t, realm.SchemaValidationOptions{Limit: 10}, validatedOpts)
+		assert.Equal(t, `Found 5 of 10 document(s) checked in collection 'db.coll' which do not match the schema
+  Field  Error                               Documents  Sample Document IDs
+  -----  ----------------------------------  ---------  -------------------
+  name   required field is null              5          doc2, doc3, doc4   
+  age    expected type int but found string  1          doc1               
+`, out.String())
+	})
+
+	t.Run("should print a message when every document matches the schema", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newRealmClient(realm.SchemaValidationResult{DocumentsChecked: 10})
+
+		cmd := &CommandValidateData{validateDataInputs{collectionInputs: collectionInputs{Collection: "db.coll"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "All 10 document(s) checked in collection 'db.coll' match the schema\n", out.String())
+	})
+
+	t.Run("should error when the collection has no schema", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newRealmClient(realm.SchemaValidationResult{})
+
+		cmd := &CommandValidateData{validateDataInputs{collectionInputs: collectionInputs{Collection: "db.other"}}}
+
+		assert.Equal(t, errors.New("no schema found for collection 'db.other'"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+
+	t.Run("should return an error when the client fails to validate the schema", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newRealmClient(realm.SchemaValidationResult{})
+		realmClient.ValidateSchemaDataFn = func(groupID, appID string, schema realm.Schema, opts realm.SchemaValidationOptions) (realm.SchemaValidationResult, error) {
+			return realm.SchemaValidationResult{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandValidateData{validateDataInputs{collectionInputs: collectionInputs{Collection: "db.coll"}}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
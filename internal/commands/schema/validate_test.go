@@ -0,0 +1,235 @@
+package schema
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSchemaValidateInputs(t *testing.T) {
+	dir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	app := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+	assert.Nil(t, app.Write())
+
+	for _, tc := range []struct {
+		description string
+		inputs      validateInputs
+		err         error
+	}{
+		{
+			description: "should error without a collection",
+			inputs:      validateInputs{collectionInputs: collectionInputs{DataSource: defaultDataSource}, Sample: 1},
+			err:         errors.New(`must specify a collection with "--collection"`),
+		},
+		{
+			description: "should error without a data source",
+			inputs:      validateInputs{collectionInputs: collectionInputs{Collection: "db.coll"}, Sample: 1},
+			err:         errors.New(`must specify a data source with "--data-source"`),
+		},
+		{
+			description: "should error without a positive sample size",
+			inputs:      validateInputs{collectionInputs: collectionInputs{Collection: "db.coll", DataSource: defaultDataSource}},
+			err:         errors.New("sample size must be a positive number"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.err, tc.inputs.Resolve(profile, ui))
+		})
+	}
+
+	t.Run("should resolve the local app and the remote app from the working directory", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		profile.WorkingDirectory = filepath.Join(dir, local.NameDataSources)
+		_, ui := mock.NewUI()
+
+		inputs := validateInputs{collectionInputs: collectionInputs{Collection: "db.coll", DataSource: defaultDataSource}, Sample: 1}
+
+		assert.Nil(t, inputs.Resolve(profile, ui))
+		assert.Equal(t, dir, inputs.LocalPath)
+		assert.Equal(t, "eggcorn-abcde", inputs.App)
+	})
+
+	t.Run("should not resolve the remote app when validating the documents of a file", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		inputs := validateInputs{collectionInputs: collectionInputs{Collection: "db.coll", DataSource: defaultDataSource}, LocalPath: dir, File: "orders.json"}
+
+		assert.Nil(t, inputs.Resolve(profile, ui))
+		assert.Equal(t, dir, inputs.LocalPath)
+		assert.Equal(t, "", inputs.App)
+	})
+}
+
+func TestSchemaValidateHandler(t *testing.T) {
+	schema := map[string]interface{}{
+		"title":    "orders",
+		"bsonType": "object",
+		"required": []interface{}{"_id", "total"},
+		"properties": map[string]interface{}{
+			"_id":   map[string]interface{}{"bsonType": "objectId"},
+			"total": map[string]interface{}{"bsonType": "double"},
+		},
+	}
+
+	setup := func(t *testing.T) (string, func()) {
+		t.Helper()
+
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+
+		app := local.NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+		local.AddDataSource(app.AppData, map[string]interface{}{"name": defaultDataSource, "type": "mongodb-atlas"})
+		assert.Nil(t, local.SetSchema(app.AppData, defaultDataSource, "store", "orders", schema))
+		assert.Nil(t, app.Write())
+		return dir, teardown
+	}
+
+	newRealmClient := func(executeFn func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error)) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.AppDebugExecuteFunctionSourceFn = executeFn
+		return realmClient
+	}
+
+	t.Run("should validate a sample of the collection's documents against the local schema", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		var capturedGroupID, capturedAppID, capturedUserID, capturedSource, capturedEvalSource string
+		realmClient := newRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			capturedGroupID, capturedAppID, capturedUserID = groupID, appID, userID
+			capturedSource, capturedEvalSource = source, evalSource
+			return realm.ExecutionResults{Result: []interface{}{
+				map[string]interface{}{
+					"_id":   map[string]interface{}{"$oid": "5fd45718cface356de9d104d"},
+					"total": map[string]interface{}{"$numberDouble": "9.5"},
+				},
+				map[string]interface{}{
+					"_id":   map[string]interface{}{"$oid": "5fd45718cface356de9d104e"},
+					"total": map[string]interface{}{"$numberInt": "10"},
+				},
+				map[string]interface{}{
+					"_id": map[string]interface{}{"$oid": "5fd45718cface356de9d104f"},
+				},
+			}}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandValidate{validateInputs{
+			collectionInputs: collectionInputs{Collection: "store.orders", DataSource: defaultDataSource},
+			LocalPath:        dir,
+			Sample:           500,
+		}}
+
+		assert.Equal(t, errDataValidationFailed{2}, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Found 2 of 3 document(s) checked in collection 'store.orders' which do not match the schema
+  Field  Error                               Documents  Sample Document IDs     
+  -----  ----------------------------------  ---------  ------------------------
+  total  expected type double but found int  1          5fd45718cface356de9d104e
+  total  required field is missing           1          5fd45718cface356de9d104f
+`, out.String())
+
+		assert.Equal(t, "groupID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+		assert.Equal(t, "", capturedUserID)
+		assert.Equal(t, sampleDocumentsSource, capturedSource)
+		assert.Equal(t, `exports("mongodb-atlas","store","orders",500)`, capturedEvalSource)
+	})
+
+	t.Run("should validate the documents of a local file against the local schema", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		path := filepath.Join(dir, "orders.json")
+		assert.Nil(t, local.WriteFile(path, 0666, strings.NewReader(`[
+  {"_id": {"$oid": "5fd45718cface356de9d104d"}, "total": 9.5},
+  {"_id": {"$oid": "5fd45718cface356de9d104e"}, "total": 1.25}
+]`)))
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandValidate{validateInputs{
+			collectionInputs: collectionInputs{Collection: "store.orders", DataSource: defaultDataSource},
+			LocalPath:        dir,
+			File:             path,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, "All 2 document(s) checked in collection 'store.orders' match the schema\n", out.String())
+	})
+
+	t.Run("should print a message when there are no documents to validate", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		realmClient := newRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			return realm.ExecutionResults{Result: []interface{}{}}, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandValidate{validateInputs{
+			collectionInputs: collectionInputs{Collection: "store.orders", DataSource: defaultDataSource},
+			LocalPath:        dir,
+			Sample:           500,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No documents found to validate in collection 'store.orders'\n", out.String())
+	})
+
+	t.Run("should return an error when the collection has no local schema", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandValidate{validateInputs{
+			collectionInputs: collectionInputs{Collection: "store.customers", DataSource: defaultDataSource},
+			LocalPath:        dir,
+			Sample:           500,
+		}}
+
+		assert.Equal(t, errors.New("no schema found for collection 'store.customers' in "+dir), cmd.Handler(nil, ui, cli.Clients{}))
+	})
+
+	t.Run("should return an error when the documents fail to be sampled", func(t *testing.T) {
+		dir, teardown := setup(t)
+		defer teardown()
+
+		realmClient := newRealmClient(func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			return realm.ExecutionResults{Error: map[string]interface{}{"message": "ns not found"}}, nil
+		})
+
+		_, ui := mock.NewUI()
+
+		cmd := &CommandValidate{validateInputs{
+			collectionInputs: collectionInputs{Collection: "store.orders", DataSource: defaultDataSource},
+			LocalPath:        dir,
+			Sample:           500,
+		}}
+
+		assert.Equal(t,
+			errors.New("failed to sample the documents of collection 'store.orders': ns not found"),
+			cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}),
+		)
+	})
+}
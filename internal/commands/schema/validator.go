@@ -0,0 +1,234 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// set of bson types which match more than one type of value
+const (
+	bsonTypeMixed  = "mixed"
+	bsonTypeNumber = "number"
+)
+
+// binary subtype of a uuid
+const binarySubtypeUUID = 0x04
+
+// parseDocuments parses the Extended JSON documents read from r,
+// which are either a single array of documents or a sequence of documents
+func parseDocuments(r io.Reader) ([]bson.D, error) {
+	dec := json.NewDecoder(r)
+
+	var raws []json.RawMessage
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+			var elems []json.RawMessage
+			if err := json.Unmarshal(trimmed, &elems); err != nil {
+				return nil, err
+			}
+			raws = append(raws, elems...)
+			continue
+		}
+		raws = append(raws, raw)
+	}
+
+	documents := make([]bson.D, 0, len(raws))
+	for i, raw := range raws {
+		var document bson.D
+		if err := bson.UnmarshalExtJSON(raw, false, &document); err != nil {
+			return nil, fmt.Errorf("failed to parse document %d: %s", i+1, err)
+		}
+		documents = append(documents, document)
+	}
+	return documents, nil
+}
+
+// validateDocuments validates the documents against the schema the same way the server
+// validates a collection's existing documents, reporting each field of a document
+// which fails to match the schema once
+func validateDocuments(schema map[string]interface{}, documents []bson.D) realm.SchemaValidationResult {
+	result := realm.SchemaValidationResult{DocumentsChecked: len(documents)}
+
+	for i, document := range documents {
+		documentID := documentID(document, i)
+
+		reported := map[[2]string]struct{}{}
+		validateValue(schema, "", document, func(field, message string) {
+			key := [2]string{field, message}
+			if _, ok := reported[key]; ok {
+				return
+			}
+			reported[key] = struct{}{}
+
+			result.Errors = append(result.Errors, realm.SchemaValidationError{
+				DocumentID: documentID,
+				Field:      field,
+				Message:    message,
+			})
+		})
+	}
+
+	return result
+}
+
+// validateValue validates the value found at the field path against the schema,
+// where the elements of an array share the path of the array itself
+func validateValue(schema map[string]interface{}, path string, value interface{}, report func(field, message string)) {
+	if expected := schemaBSONTypes(schema); len(expected) > 0 {
+		actual := bsonTypeOf(value)
+		if !matchesBSONType(expected, actual) {
+			report(path, fmt.Sprintf("expected type %s but found %s", strings.Join(expected, " or "), actual))
+			return
+		}
+	}
+
+	switch v := value.(type) {
+	case primitive.D:
+		fields := make(map[string]interface{}, len(v))
+		for _, e := range v {
+			fields[e.Key] = e.Value
+		}
+
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, r := range required {
+				name, ok := r.(string)
+				if !ok {
+					continue
+				}
+				if _, ok := fields[name]; !ok {
+					report(fieldPath(path, name), "required field is missing")
+				}
+			}
+		}
+
+		properties, _ := schema["properties"].(map[string]interface{})
+		for _, e := range v {
+			property, ok := properties[e.Key].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			validateValue(property, fieldPath(path, e.Key), e.Value, report)
+		}
+
+	case primitive.A:
+		items, ok := schema["items"].(map[string]interface{})
+		if !ok {
+			return
+		}
+		for _, elem := range v {
+			validateValue(items, path, elem, report)
+		}
+	}
+}
+
+// schemaBSONTypes returns the bson types the schema allows, if it specifies any
+func schemaBSONTypes(schema map[string]interface{}) []string {
+	switch bsonType := schema["bsonType"].(type) {
+	case string:
+		return []string{bsonType}
+	case []interface{}:
+		bsonTypes := make([]string, 0, len(bsonType))
+		for _, t := range bsonType {
+			if s, ok := t.(string); ok {
+				bsonTypes = append(bsonTypes, s)
+			}
+		}
+		return bsonTypes
+	}
+	return nil
+}
+
+func matchesBSONType(expected []string, actual string) bool {
+	for _, t := range expected {
+		switch t {
+		case actual, bsonTypeMixed:
+			return true
+		case bsonTypeNumber:
+			if actual == "int" || actual == "long" || actual == "double" || actual == "decimal" {
+				return true
+			}
+		case "binData":
+			if actual == "uuid" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func bsonTypeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil, primitive.Null:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int32:
+		return "int"
+	case int64:
+		return "long"
+	case float64:
+		return "double"
+	case primitive.Decimal128:
+		return "decimal"
+	case primitive.ObjectID:
+		return "objectId"
+	case primitive.DateTime:
+		return "date"
+	case primitive.Timestamp:
+		return "timestamp"
+	case primitive.Regex:
+		return "regex"
+	case primitive.Binary:
+		if v.Subtype == binarySubtypeUUID {
+			return "uuid"
+		}
+		return "binData"
+	case primitive.D:
+		return "object"
+	case primitive.A:
+		return "array"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// documentID returns the document's _id, or its position when it has none
+func documentID(document bson.D, i int) string {
+	for _, e := range document {
+		if e.Key != "_id" {
+			continue
+		}
+		switch id := e.Value.(type) {
+		case primitive.ObjectID:
+			return id.Hex()
+		case string:
+			return id
+		}
+		return fmt.Sprint(e.Value)
+	}
+	return fmt.Sprintf("document %d", i+1)
+}
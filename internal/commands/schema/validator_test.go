@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestParseDocuments(t *testing.T) {
+	id, err := primitive.ObjectIDFromHex("5fd45718cface356de9d104d")
+	assert.Nil(t, err)
+
+	expected := []bson.D{
+		{{Key: "_id", Value: id}, {Key: "total", Value: int32(10)}},
+		{{Key: "_id", Value: "order1"}, {Key: "total", Value: 2.5}, {Key: "items", Value: primitive.A{primitive.D{{Key: "price", Value: int64(3)}}}}},
+	}
+
+	for _, tc := range []struct {
+		description string
+		data        string
+	}{
+		{
+			description: "an array of documents",
+			data: `[
+  {"_id": {"$oid": "5fd45718cface356de9d104d"}, "total": 10},
+  {"_id": "order1", "total": 2.5, "items": [{"price": {"$numberLong": "3"}}]}
+]`,
+		},
+		{
+			description: "one document per line",
+			data: `{"_id": {"$oid": "5fd45718cface356de9d104d"}, "total": {"$numberInt": "10"}}
+{"_id": "order1", "total": {"$numberDouble": "2.5"}, "items": [{"price": {"$numberLong": "3"}}]}
+`,
+		},
+	} {
+		t.Run("should parse "+tc.description, func(t *testing.T) {
+			documents, err := parseDocuments(strings.NewReader(tc.data))
+			assert.Nil(t, err)
+			assert.Equal(t, expected, documents)
+		})
+	}
+
+	t.Run("should return an error for a document which is not valid extended json", func(t *testing.T) {
+		_, err := parseDocuments(strings.NewReader(`{"_id": 1} {"date": {"$date": "yesterday"}}`))
+		assert.NotNil(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), "failed to parse document 2: "), "unexpected error: %s", err)
+	})
+}
+
+func TestValidateDocuments(t *testing.T) {
+	schema := map[string]interface{}{
+		"title":    "order",
+		"bsonType": "object",
+		"required": []interface{}{"_id", "total"},
+		"properties": map[string]interface{}{
+			"_id":   map[string]interface{}{"bsonType": "objectId"},
+			"total": map[string]interface{}{"bsonType": "number"},
+			"note":  map[string]interface{}{"bsonType": []interface{}{"string", "null"}},
+			"items": map[string]interface{}{
+				"bsonType": "array",
+				"items": map[string]interface{}{
+					"bsonType": "object",
+					"required": []interface{}{"price"},
+					"properties": map[string]interface{}{
+						"price": map[string]interface{}{"bsonType": "double"},
+					},
+				},
+			},
+			"extra": map[string]interface{}{"bsonType": "mixed"},
+		},
+	}
+
+	id0, id1, id2 := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+
+	t.Run("should not report documents which match the schema", func(t *testing.T) {
+		result := validateDocuments(schema, []bson.D{
+			{{Key: "_id", Value: id0}, {Key: "total", Value: int32(1)}, {Key: "note", Value: nil}, {Key: "extra", Value: primitive.A{}}},
+			{{Key: "_id", Value: id1}, {Key: "total", Value: 2.5}, {Key: "note", Value: "hello"}, {Key: "items", Value: primitive.A{primitive.D{{Key: "price", Value: 2.5}}}}},
+			{{Key: "_id", Value: id2}, {Key: "total", Value: int64(3)}, {Key: "unknown", Value: true}},
+		})
+		assert.Equal(t, realm.SchemaValidationResult{DocumentsChecked: 3}, result)
+	})
+
+	t.Run("should report each field of a document which does not match the schema once", func(t *testing.T) {
+		result := validateDocuments(schema, []bson.D{
+			{{Key: "_id", Value: id0}, {Key: "total", Value: "10"}},
+			{{Key: "_id", Value: id1}, {Key: "items", Value: primitive.A{
+				primitive.D{{Key: "price", Value: int32(1)}},
+				primitive.D{{Key: "price", Value: int32(2)}},
+				primitive.D{},
+			}}},
+			{{Key: "total", Value: 1.0}, {Key: "note", Value: int32(4)}},
+		})
+		assert.Equal(t, realm.SchemaValidationResult{
+			DocumentsChecked: 3,
+			Errors: []realm.SchemaValidationError{
+				{DocumentID: id0.Hex(), Field: "total", Message: "expected type number but found string"},
+				{DocumentID: id1.Hex(), Field: "total", Message: "required field is missing"},
+				{DocumentID: id1.Hex(), Field: "items.price", Message: "expected type double but found int"},
+				{DocumentID: id1.Hex(), Field: "items.price", Message: "required field is missing"},
+				{DocumentID: "document 3", Field: "_id", Message: "required field is missing"},
+				{DocumentID: "document 3", Field: "note", Message: "expected type string or null but found int"},
+			},
+		}, result)
+	})
+}
@@ -0,0 +1,116 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	defaultIndexName = "default"
+)
+
+// CommandMetaIndexesCreate is the command meta for the `search indexes create` command
+var CommandMetaIndexesCreate = cli.CommandMeta{
+	Use:         "create",
+	Display:     "search indexes create",
+	Description: "Create an Atlas Search index on a collection",
+	HelpText: `Creates an Atlas Search index on a collection in an Atlas cluster linked to
+your Realm app. By default, the index is named "default" and dynamically maps
+all fields in the collection.
+
+With this command, you can:
+  - Provide the index definition (such as "mappings" and "analyzer") from a JSON
+    file with the "--file" flag
+  - Override the analyzer used when indexing with the "--analyzer" flag`,
+}
+
+// CommandIndexesCreate is the `search indexes create` command
+type CommandIndexesCreate struct {
+	inputs createInputs
+}
+
+type createInputs struct {
+	collectionInputs
+	Name     string
+	File     string
+	Analyzer string
+}
+
+// Flags is the command flags
+func (cmd *CommandIndexesCreate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+	fs.StringVarP(&cmd.inputs.Name, flagName, flagNameShort, defaultIndexName, flagNameUsage)
+	fs.StringVar(&cmd.inputs.File, flagFile, "", flagFileUsage)
+	fs.StringVar(&cmd.inputs.Analyzer, flagAnalyzer, "", flagAnalyzerUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandIndexesCreate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandIndexesCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	index, err := cmd.inputs.searchIndex()
+	if err != nil {
+		return err
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	created, err := clients.Atlas.CreateSearchIndex(app.GroupID, cmd.inputs.Cluster, index)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Successfully created search index on %s.%s", created.Database, created.Collection),
+		tableHeaders(),
+		tableRows([]atlas.SearchIndex{created})...,
+	))
+	return nil
+}
+
+func (i createInputs) searchIndex() (atlas.SearchIndex, error) {
+	var index atlas.SearchIndex
+
+	if i.File != "" {
+		data, err := ioutil.ReadFile(i.File)
+		if err != nil {
+			return atlas.SearchIndex{}, err
+		}
+		if err := json.Unmarshal(data, &index); err != nil {
+			return atlas.SearchIndex{}, fmt.Errorf("failed to parse search index definition at %s: %w", i.File, err)
+		}
+	}
+
+	// the flags always determine where the index lives
+	index.ID = ""
+	index.Status = ""
+	index.Database = i.Database
+	index.Collection = i.Collection
+
+	// a name set in the definition file is kept unless one is provided by flag
+	if index.Name == "" || i.Name != defaultIndexName {
+		index.Name = i.Name
+	}
+	if i.Analyzer != "" {
+		index.Analyzer = i.Analyzer
+	}
+	if index.Mappings == nil {
+		index.Mappings = map[string]interface{}{"dynamic": true}
+	}
+
+	return index, nil
+}
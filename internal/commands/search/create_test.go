@@ -0,0 +1,152 @@
+package search
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSearchIndexesCreateHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{app}, nil
+	}
+
+	t.Run("should create a search index with dynamic mappings by default", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedGroupID, capturedCluster string
+		var capturedIndex atlas.SearchIndex
+		atlasClient := mock.AtlasClient{}
+		atlasClient.CreateSearchIndexFn = func(groupID, clusterName string, index atlas.SearchIndex) (atlas.SearchIndex, error) {
+			capturedGroupID = groupID
+			capturedCluster = clusterName
+			capturedIndex = index
+
+			index.ID = "index1"
+			index.Status = "IN_PROGRESS"
+			return index, nil
+		}
+
+		cmd := &CommandIndexesCreate{createInputs{
+			collectionInputs: collectionInputs{
+				ProjectInputs: cli.ProjectInputs{Project: "groupID", App: "appID"},
+				Cluster:       "Cluster0",
+				Database:      "db",
+				Collection:    "coll",
+			},
+			Name: defaultIndexName,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient}))
+		assert.Equal(t, strings.Join(
+			[]string{
+				"Successfully created search index on db.coll",
+				"  ID      Name     Database  Collection  Status     ",
+				"  ------  -------  --------  ----------  -----------",
+				"  index1  default  db        coll        IN_PROGRESS",
+				"",
+			},
+			"\n",
+		), out.String())
+
+		assert.Equal(t, "groupID", capturedGroupID)
+		assert.Equal(t, "Cluster0", capturedCluster)
+		assert.Equal(t, atlas.SearchIndex{
+			Name:       defaultIndexName,
+			Database:   "db",
+			Collection: "coll",
+			Mappings:   map[string]interface{}{"dynamic": true},
+		}, capturedIndex)
+	})
+
+	t.Run("should return an error when creating the search index fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		atlasClient := mock.AtlasClient{}
+		atlasClient.CreateSearchIndexFn = func(groupID, clusterName string, index atlas.SearchIndex) (atlas.SearchIndex, error) {
+			return atlas.SearchIndex{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandIndexesCreate{createInputs{Name: defaultIndexName}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
+
+func TestSearchIndexesCreateInputsSearchIndex(t *testing.T) {
+	tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer cleanupTmpDir()
+
+	definitionPath := filepath.Join(tmpDir, "index.json")
+	assert.Nil(t, ioutil.WriteFile(definitionPath, []byte(`{
+    "name": "titles",
+    "database": "other",
+    "analyzer": "lucene.english",
+    "mappings": {"dynamic": false, "fields": {"title": {"type": "string"}}}
+}`), 0666))
+
+	invalidPath := filepath.Join(tmpDir, "invalid.json")
+	assert.Nil(t, ioutil.WriteFile(invalidPath, []byte(`{"name":`), 0666))
+
+	collection := collectionInputs{Cluster: "Cluster0", Database: "db", Collection: "coll"}
+
+	for _, tc := range []struct {
+		description   string
+		inputs        createInputs
+		expectedIndex atlas.SearchIndex
+	}{
+		{
+			description: "should use the definition file while keeping the collection from flags",
+			inputs:      createInputs{collectionInputs: collection, Name: defaultIndexName, File: definitionPath},
+			expectedIndex: atlas.SearchIndex{
+				Name:       "titles",
+				Database:   "db",
+				Collection: "coll",
+				Analyzer:   "lucene.english",
+				Mappings: map[string]interface{}{
+					"dynamic": false,
+					"fields":  map[string]interface{}{"title": map[string]interface{}{"type": "string"}},
+				},
+			},
+		},
+		{
+			description: "should prefer the name and analyzer flags over the definition file",
+			inputs:      createInputs{collectionInputs: collection, Name: "plots", File: definitionPath, Analyzer: "lucene.standard"},
+			expectedIndex: atlas.SearchIndex{
+				Name:       "plots",
+				Database:   "db",
+				Collection: "coll",
+				Analyzer:   "lucene.standard",
+				Mappings: map[string]interface{}{
+					"dynamic": false,
+					"fields":  map[string]interface{}{"title": map[string]interface{}{"type": "string"}},
+				},
+			},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			index, err := tc.inputs.searchIndex()
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedIndex, index)
+		})
+	}
+
+	t.Run("should return an error when the definition file is invalid", func(t *testing.T) {
+		_, err := createInputs{collectionInputs: collection, File: invalidPath}.searchIndex()
+		assert.Equal(t, "failed to parse search index definition at "+invalidPath+": unexpected end of JSON input", err.Error())
+	})
+}
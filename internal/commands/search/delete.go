@@ -0,0 +1,107 @@
+package search
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaIndexesDelete is the command meta for the `search indexes delete` command
+var CommandMetaIndexesDelete = cli.CommandMeta{
+	Use:         "delete",
+	Display:     "search indexes delete",
+	Description: "Delete an Atlas Search index from a collection",
+	HelpText: `Deletes an Atlas Search index from a collection in an Atlas cluster linked to
+your Realm app. You can specify the index using its ID or Name value with the
+"--index" flag, otherwise you will be prompted to select one.`,
+}
+
+// CommandIndexesDelete is the `search indexes delete` command
+type CommandIndexesDelete struct {
+	inputs deleteInputs
+}
+
+type deleteInputs struct {
+	collectionInputs
+	Index string
+}
+
+// Flags is the command flags
+func (cmd *CommandIndexesDelete) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+	fs.StringVarP(&cmd.inputs.Index, flagIndex, flagIndexShort, "", flagIndexUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandIndexesDelete) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandIndexesDelete) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	indexes, err := clients.Atlas.SearchIndexes(app.GroupID, cmd.inputs.Cluster, cmd.inputs.Database, cmd.inputs.Collection)
+	if err != nil {
+		return err
+	}
+
+	if len(indexes) == 0 {
+		ui.Print(terminal.NewTextLog("No search indexes found on %s.%s", cmd.inputs.Database, cmd.inputs.Collection))
+		return nil
+	}
+
+	index, err := cmd.inputs.resolveIndex(ui, indexes)
+	if err != nil {
+		return err
+	}
+
+	if err := clients.Atlas.DeleteSearchIndex(app.GroupID, cmd.inputs.Cluster, index.ID); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully deleted search index: %s", index.Name))
+	return nil
+}
+
+func (i deleteInputs) resolveIndex(ui terminal.UI, indexes []atlas.SearchIndex) (atlas.SearchIndex, error) {
+	if i.Index != "" {
+		for _, index := range indexes {
+			if index.ID == i.Index || index.Name == i.Index {
+				return index, nil
+			}
+		}
+		return atlas.SearchIndex{}, fmt.Errorf("failed to find search index '%s'", i.Index)
+	}
+
+	options := make([]string, 0, len(indexes))
+	indexesByOption := make(map[string]atlas.SearchIndex, len(indexes))
+	for _, index := range indexes {
+		option := displayIndexOption(index)
+
+		options = append(options, option)
+		indexesByOption[option] = index
+	}
+
+	var selection string
+	if err := ui.AskOne(
+		&selection,
+		&survey.Select{
+			Message: "Which search index would you like to delete?",
+			Options: options,
+		},
+	); err != nil {
+		return atlas.SearchIndex{}, err
+	}
+
+	return indexesByOption[selection], nil
+}
@@ -0,0 +1,155 @@
+package search
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+func TestSearchIndexesDeleteHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{app}, nil
+	}
+
+	indexes := []atlas.SearchIndex{
+		{ID: "index1", Name: "default", Database: "db", Collection: "coll"},
+		{ID: "index2", Name: "titles", Database: "db", Collection: "coll"},
+	}
+
+	collection := collectionInputs{
+		ProjectInputs: cli.ProjectInputs{Project: "groupID", App: "appID"},
+		Cluster:       "Cluster0",
+		Database:      "db",
+		Collection:    "coll",
+	}
+
+	for _, tc := range []struct {
+		description     string
+		index           string
+		expectedIndexID string
+		expectedOutput  string
+	}{
+		{
+			description:     "should delete the search index by name",
+			index:           "titles",
+			expectedIndexID: "index2",
+			expectedOutput:  "Successfully deleted search index: titles\n",
+		},
+		{
+			description:     "should delete the search index by id",
+			index:           "index1",
+			expectedIndexID: "index1",
+			expectedOutput:  "Successfully deleted search index: default\n",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			var capturedGroupID, capturedCluster, capturedIndexID string
+			atlasClient := mock.AtlasClient{}
+			atlasClient.SearchIndexesFn = func(groupID, clusterName, database, collection string) ([]atlas.SearchIndex, error) {
+				return indexes, nil
+			}
+			atlasClient.DeleteSearchIndexFn = func(groupID, clusterName, indexID string) error {
+				capturedGroupID = groupID
+				capturedCluster = clusterName
+				capturedIndexID = indexID
+				return nil
+			}
+
+			cmd := &CommandIndexesDelete{deleteInputs{collection, tc.index}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+
+			assert.Equal(t, "groupID", capturedGroupID)
+			assert.Equal(t, "Cluster0", capturedCluster)
+			assert.Equal(t, tc.expectedIndexID, capturedIndexID)
+		})
+	}
+
+	t.Run("should print a message when no search indexes are found", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		atlasClient := mock.AtlasClient{}
+		atlasClient.SearchIndexesFn = func(groupID, clusterName, database, collection string) ([]atlas.SearchIndex, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandIndexesDelete{deleteInputs{collection, "titles"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient}))
+		assert.Equal(t, "No search indexes found on db.coll\n", out.String())
+	})
+
+	t.Run("should return an error when the search index cannot be found", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		atlasClient := mock.AtlasClient{}
+		atlasClient.SearchIndexesFn = func(groupID, clusterName, database, collection string) ([]atlas.SearchIndex, error) {
+			return indexes, nil
+		}
+
+		cmd := &CommandIndexesDelete{deleteInputs{collection, "plots"}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient})
+		assert.Equal(t, errors.New("failed to find search index 'plots'"), err)
+	})
+
+	t.Run("should return an error when deleting the search index fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		atlasClient := mock.AtlasClient{}
+		atlasClient.SearchIndexesFn = func(groupID, clusterName, database, collection string) ([]atlas.SearchIndex, error) {
+			return indexes, nil
+		}
+		atlasClient.DeleteSearchIndexFn = func(groupID, clusterName, indexID string) error {
+			return errors.New("something bad happened")
+		}
+
+		cmd := &CommandIndexesDelete{deleteInputs{collection, "titles"}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
+
+func TestSearchIndexesDeleteInputsResolveIndex(t *testing.T) {
+	indexes := []atlas.SearchIndex{
+		{ID: "index1", Name: "default"},
+		{ID: "index2", Name: "titles"},
+	}
+
+	t.Run("should prompt for the search index when none is provided", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		doneCh := make(chan (struct{}))
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Which search index would you like to delete?")
+			console.Send(string(terminal.KeyArrowDown))
+			console.SendLine("")
+			console.ExpectEOF()
+		}()
+
+		index, err := deleteInputs{}.resolveIndex(ui, indexes)
+
+		console.Tty().Close() // flush the writers
+		<-doneCh              // wait for procedure to complete
+
+		assert.Nil(t, err)
+		assert.Equal(t, indexes[1], index)
+	})
+}
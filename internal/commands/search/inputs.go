@@ -0,0 +1,92 @@
+package search
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// Flag names and usages across the search commands
+const (
+	flagCluster      = "cluster"
+	flagClusterShort = "c"
+	flagClusterUsage = "the name of the Atlas cluster"
+
+	flagDatabase      = "database"
+	flagDatabaseShort = "d"
+	flagDatabaseUsage = "the name of the database"
+
+	flagCollection      = "collection"
+	flagCollectionUsage = "the name of the collection"
+
+	flagName      = "name"
+	flagNameShort = "n"
+	flagNameUsage = "the name of the search index"
+
+	flagFile      = "file"
+	flagFileUsage = "the path to a JSON file containing the search index definition"
+
+	flagAnalyzer      = "analyzer"
+	flagAnalyzerUsage = "the analyzer to use when indexing fields"
+
+	flagIndex      = "index"
+	flagIndexShort = "i"
+	flagIndexUsage = "the name or id of the search index to delete"
+)
+
+const (
+	inputFieldCluster    = "cluster"
+	inputFieldDatabase   = "database"
+	inputFieldCollection = "collection"
+)
+
+type collectionInputs struct {
+	cli.ProjectInputs
+	Cluster    string
+	Database   string
+	Collection string
+}
+
+func (i *collectionInputs) Flags(fs *pflag.FlagSet) {
+	i.ProjectInputs.Flags(fs)
+	fs.StringVarP(&i.Cluster, flagCluster, flagClusterShort, "", flagClusterUsage)
+	fs.StringVarP(&i.Database, flagDatabase, flagDatabaseShort, "", flagDatabaseUsage)
+	fs.StringVar(&i.Collection, flagCollection, "", flagCollectionUsage)
+}
+
+func (i *collectionInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	var questions []*survey.Question
+
+	if i.Cluster == "" {
+		questions = append(questions, &survey.Question{
+			Name:   inputFieldCluster,
+			Prompt: &survey.Input{Message: "Atlas Cluster Name"},
+		})
+	}
+
+	if i.Database == "" {
+		questions = append(questions, &survey.Question{
+			Name:   inputFieldDatabase,
+			Prompt: &survey.Input{Message: "Database Name"},
+		})
+	}
+
+	if i.Collection == "" {
+		questions = append(questions, &survey.Question{
+			Name:   inputFieldCollection,
+			Prompt: &survey.Input{Message: "Collection Name"},
+		})
+	}
+
+	if len(questions) > 0 {
+		return ui.Ask(i, questions...)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package search
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaIndexesList is the command meta for the `search indexes list` command
+var CommandMetaIndexesList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "search indexes list",
+	Description: "List the Atlas Search indexes on a collection",
+	HelpText: `Displays the Atlas Search indexes defined on a collection in an Atlas cluster
+linked to your Realm app, along with their current status.`,
+}
+
+// CommandIndexesList is the `search indexes list` command
+type CommandIndexesList struct {
+	inputs collectionInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandIndexesList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandIndexesList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandIndexesList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	indexes, err := clients.Atlas.SearchIndexes(app.GroupID, cmd.inputs.Cluster, cmd.inputs.Database, cmd.inputs.Collection)
+	if err != nil {
+		return err
+	}
+
+	if len(indexes) == 0 {
+		ui.Print(terminal.NewTextLog("No search indexes found on %s.%s", cmd.inputs.Database, cmd.inputs.Collection))
+		return nil
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d search index(es)", len(indexes)),
+		tableHeaders(),
+		tableRows(indexes)...,
+	))
+	return nil
+}
@@ -0,0 +1,96 @@
+package search
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSearchIndexesListHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{app}, nil
+	}
+
+	inputs := collectionInputs{
+		ProjectInputs: cli.ProjectInputs{Project: "groupID", App: "appID"},
+		Cluster:       "Cluster0",
+		Database:      "db",
+		Collection:    "coll",
+	}
+
+	for _, tc := range []struct {
+		description    string
+		indexes        []atlas.SearchIndex
+		expectedOutput string
+	}{
+		{
+			description:    "should print a message when no search indexes are found",
+			expectedOutput: "No search indexes found on db.coll\n",
+		},
+		{
+			description: "should list the search indexes found on the collection",
+			indexes: []atlas.SearchIndex{
+				{ID: "index1", Name: "default", Database: "db", Collection: "coll", Status: "STEADY"},
+				{ID: "index2", Name: "titles", Database: "db", Collection: "coll", Status: "IN_PROGRESS"},
+			},
+			expectedOutput: strings.Join(
+				[]string{
+					"Found 2 search index(es)",
+					"  ID      Name     Database  Collection  Status     ",
+					"  ------  -------  --------  ----------  -----------",
+					"  index1  default  db        coll        STEADY     ",
+					"  index2  titles   db        coll        IN_PROGRESS",
+					"",
+				},
+				"\n",
+			),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			var capturedGroupID, capturedCluster, capturedDatabase, capturedCollection string
+			atlasClient := mock.AtlasClient{}
+			atlasClient.SearchIndexesFn = func(groupID, clusterName, database, collection string) ([]atlas.SearchIndex, error) {
+				capturedGroupID = groupID
+				capturedCluster = clusterName
+				capturedDatabase = database
+				capturedCollection = collection
+				return tc.indexes, nil
+			}
+
+			cmd := &CommandIndexesList{inputs}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+
+			assert.Equal(t, "groupID", capturedGroupID)
+			assert.Equal(t, "Cluster0", capturedCluster)
+			assert.Equal(t, "db", capturedDatabase)
+			assert.Equal(t, "coll", capturedCollection)
+		})
+	}
+
+	t.Run("should return an error when listing search indexes fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		atlasClient := mock.AtlasClient{}
+		atlasClient.SearchIndexesFn = func(groupID, clusterName, database, collection string) ([]atlas.SearchIndex, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandIndexesList{inputs}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
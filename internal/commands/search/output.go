@@ -0,0 +1,36 @@
+package search
+
+import (
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+const (
+	headerID         = "ID"
+	headerName       = "Name"
+	headerDatabase   = "Database"
+	headerCollection = "Collection"
+	headerStatus     = "Status"
+)
+
+func tableHeaders() []string {
+	return []string{headerID, headerName, headerDatabase, headerCollection, headerStatus}
+}
+
+func tableRows(indexes []atlas.SearchIndex) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(indexes))
+	for _, index := range indexes {
+		rows = append(rows, map[string]interface{}{
+			headerID:         index.ID,
+			headerName:       index.Name,
+			headerDatabase:   index.Database,
+			headerCollection: index.Collection,
+			headerStatus:     index.Status,
+		})
+	}
+	return rows
+}
+
+func displayIndexOption(index atlas.SearchIndex) string {
+	return index.ID + terminal.DelimiterInline + index.Name
+}
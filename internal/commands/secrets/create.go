@@ -3,6 +3,7 @@ package secrets
 import (
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
+	secretsources "github.com/10gen/realm-cli/internal/secrets"
 	"github.com/10gen/realm-cli/internal/terminal"
 
 	"github.com/spf13/pflag"
@@ -13,12 +14,22 @@ var CommandMetaCreate = cli.CommandMeta{
 	Use:         "create",
 	Display:     "secrets create",
 	Description: "Create a Secret for your Realm app",
-	HelpText:    `You will be prompted to name your Secret and define the value of your Secret.`,
+	HelpText: `You will be prompted to name your Secret and define the value of your Secret.
+The value is hidden as you type it. To keep the value out of your shell history,
+either leave out "--value" to be prompted for it or pipe it in with
+"--value-stdin".
+
+The value may reference an external secret source instead, which is resolved
+when the Secret is created (e.g. "vault:kv/realm/prod#apiKey" reads the "apiKey"
+field from HashiCorp Vault using VAULT_ADDR and VAULT_TOKEN, and
+"aws-sm:realm/prod#apiKey" reads it from AWS Secrets Manager using the standard
+AWS environment credentials).`,
 }
 
 // CommandCreate is the `secrets create` command
 type CommandCreate struct {
-	inputs createInputs
+	inputs  createInputs
+	created int
 }
 
 // Flags is the command flags
@@ -27,6 +38,7 @@ func (cmd *CommandCreate) Flags(fs *pflag.FlagSet) {
 
 	fs.StringVarP(&cmd.inputs.Name, flagName, flagNameShort, "", flagNameUsageCreate)
 	fs.StringVarP(&cmd.inputs.Value, flagValue, flagValueShort, "", flagValueUsageCreate)
+	fs.BoolVar(&cmd.inputs.ValueStdin, flagValueStdin, false, flagValueStdinUsage)
 }
 
 // Inputs is the command inputs
@@ -41,11 +53,22 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	secret, err := clients.Realm.CreateSecret(app.GroupID, app.ID, cmd.inputs.Name, cmd.inputs.Value)
+	value, err := secretsources.NewDefaultResolver().Resolve(cmd.inputs.Value)
 	if err != nil {
 		return err
 	}
 
+	secret, err := clients.Realm.CreateSecret(app.GroupID, app.ID, cmd.inputs.Name, value)
+	if err != nil {
+		return err
+	}
+	cmd.created = 1
+
 	ui.Print(terminal.NewTextLog("Successfully created secret, id: %s", secret.ID))
 	return nil
 }
+
+// ResourcesAffected is the number of secrets created
+func (cmd *CommandCreate) ResourcesAffected() int {
+	return cmd.created
+}
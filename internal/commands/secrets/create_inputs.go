@@ -15,8 +15,9 @@ const (
 
 type createInputs struct {
 	cli.ProjectInputs
-	Name  string
-	Value string
+	Name       string
+	Value      string
+	ValueStdin bool
 }
 
 func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
@@ -24,6 +25,14 @@ func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 		return err
 	}
 
+	if i.ValueStdin {
+		value, err := readValueStdin(i.Value)
+		if err != nil {
+			return err
+		}
+		i.Value = value
+	}
+
 	var questions []*survey.Question
 
 	if i.Name == "" {
@@ -1,6 +1,9 @@
 package secrets
 
 import (
+	"errors"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
@@ -91,3 +94,29 @@ func TestCreateInputs(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateInputsValueStdin(t *testing.T) {
+	defer func(r io.Reader) { stdin = r }(stdin)
+
+	t.Run("should read the secret value from stdin", func(t *testing.T) {
+		stdin = strings.NewReader("value\n")
+
+		inputs := createInputs{Name: "name", ValueStdin: true}
+		assert.Nil(t, inputs.Resolve(mock.NewProfile(t), nil))
+		assert.Equal(t, "value", inputs.Value)
+	})
+
+	t.Run("should return an error when the value is also set", func(t *testing.T) {
+		stdin = strings.NewReader("value\n")
+
+		inputs := createInputs{Name: "name", Value: "value", ValueStdin: true}
+		assert.Equal(t, errors.New("cannot specify both --value and --value-stdin"), inputs.Resolve(mock.NewProfile(t), nil))
+	})
+
+	t.Run("should return an error when stdin is empty", func(t *testing.T) {
+		stdin = strings.NewReader("")
+
+		inputs := createInputs{Name: "name", ValueStdin: true}
+		assert.Equal(t, errors.New("no secret value was read from stdin"), inputs.Resolve(mock.NewProfile(t), nil))
+	})
+}
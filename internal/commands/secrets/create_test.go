@@ -42,7 +42,7 @@ func TestSecretsCreateHandler(t *testing.T) {
 			return realm.Secret{secretID, secretName}, nil
 		}
 
-		cmd := &CommandCreate{createInputs{
+		cmd := &CommandCreate{inputs: createInputs{
 			ProjectInputs: cli.ProjectInputs{
 				Project: projectID,
 				App:     appID,
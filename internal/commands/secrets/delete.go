@@ -6,7 +6,9 @@ import (
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/parallel"
 
 	"github.com/spf13/pflag"
 )
@@ -18,12 +20,16 @@ var CommandMetaDelete = cli.CommandMeta{
 	Description: "Delete a Secret from your Realm app",
 	HelpText: `With this command, you can:
   - Remove multiple Secrets at once with "--secret" flags. You can specify these
-    Secrets using their ID or Name values`,
+    Secrets using their ID or Name values
+
+Secrets are deleted a few at a time, which can be changed with the global
+"--max-parallel" flag.`,
 }
 
 // CommandDelete for the secrets delete command
 type CommandDelete struct {
-	inputs deleteInputs
+	inputs  deleteInputs
+	deleted int
 }
 
 // Flags function for the secrets delete command
@@ -59,10 +65,16 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 		return nil
 	}
 
-	outputs := make(secretOutputs, len(selected))
-	for i, secret := range selected {
-		err := clients.Realm.DeleteSecret(app.GroupID, app.ID, secret.ID)
-		outputs[i] = secretOutput{secret, err}
+	workers := maxConcurrentSecretDeletes
+	if profile != nil {
+		workers = profile.Parallelism(workers)
+	}
+
+	outputs := deleteSecrets(clients.Realm, app, selected, workers)
+	for _, output := range outputs {
+		if output.err == nil {
+			cmd.deleted++
+		}
 	}
 
 	sort.SliceStable(outputs, func(i, j int) bool {
@@ -77,6 +89,24 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 	return nil
 }
 
+// ResourcesAffected is the number of secrets deleted
+func (cmd *CommandDelete) ResourcesAffected() int {
+	return cmd.deleted
+}
+
+// maxConcurrentSecretDeletes is the default maximum number of secrets deleted at the same time
+const maxConcurrentSecretDeletes = 4
+
+// deleteSecrets deletes the secrets with a bounded number of workers,
+// returning the outputs in the order of the secrets
+func deleteSecrets(realmClient realm.Client, app realm.App, secrets []realm.Secret, workers int) secretOutputs {
+	outputs := make(secretOutputs, len(secrets))
+	parallel.Run(len(secrets), workers, func(i int) {
+		outputs[i] = secretOutput{secrets[i], realmClient.DeleteSecret(app.GroupID, app.ID, secrets[i].ID)}
+	})
+	return outputs
+}
+
 func tableRowDelete(output secretOutput, row map[string]interface{}) {
 	deleted := false
 	if output.err != nil {
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
@@ -43,7 +45,7 @@ func TestSecretsDeleteHandler(t *testing.T) {
 			return nil, nil
 		}
 
-		cmd := &CommandDelete{deleteInputs{
+		cmd := &CommandDelete{inputs: deleteInputs{
 			ProjectInputs: cli.ProjectInputs{
 				Project: projectID,
 				App:     appID,
@@ -56,14 +58,16 @@ func TestSecretsDeleteHandler(t *testing.T) {
 	})
 
 	for _, tc := range []struct {
-		description    string
-		testInput      []string
-		expectedOutput string
-		deleteErr      error
+		description      string
+		testInput        []string
+		expectedOutput   string
+		expectedAffected int
+		deleteErr        error
 	}{
 		{
-			description: "should return successful outputs for proper secret inputs",
-			testInput:   []string{"secret_id_1"},
+			description:      "should return successful outputs for proper secret inputs",
+			testInput:        []string{"secret_id_1"},
+			expectedAffected: 1,
 			expectedOutput: strings.Join([]string{
 				"Deleted 1 secret(s)",
 				"  ID           Name           Deleted  Details",
@@ -112,7 +116,7 @@ func TestSecretsDeleteHandler(t *testing.T) {
 				return tc.deleteErr
 			}
 
-			cmd := &CommandDelete{deleteInputs{
+			cmd := &CommandDelete{inputs: deleteInputs{
 				ProjectInputs: cli.ProjectInputs{
 					Project: projectID,
 					App:     appID,
@@ -123,6 +127,7 @@ func TestSecretsDeleteHandler(t *testing.T) {
 			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, tc.expectedOutput, out.String())
+			assert.Equal(t, tc.expectedAffected, cmd.ResourcesAffected())
 
 			assert.Equal(t, realm.AppFilter{App: appID, GroupID: projectID}, capturedAppFilter)
 			assert.Equal(t, projectID, capturedFindProjectID)
@@ -223,3 +228,55 @@ func TestSecretsDeleteModifiers(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsDeleteConcurrently(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID"}
+
+	secrets := make([]realm.Secret, 20)
+	for i := range secrets {
+		secrets[i] = realm.Secret{ID: fmt.Sprintf("secret_id_%d", i), Name: fmt.Sprintf("secret_name_%d", i)}
+	}
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+
+	realmClient := mock.RealmClient{}
+	realmClient.DeleteSecretFn = func(groupID, appID, secretID string) error {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		if secretID == "secret_id_3" {
+			return errors.New("something bad happened")
+		}
+		return nil
+	}
+
+	outputs := deleteSecrets(realmClient, app, secrets, 3)
+
+	t.Run("should delete a bounded number of secrets at a time", func(t *testing.T) {
+		assert.True(t, maxInFlight <= 3, "expected at most 3 concurrent deletes, but got %d", maxInFlight)
+		assert.True(t, maxInFlight > 1, "expected concurrent deletes, but got %d", maxInFlight)
+	})
+
+	t.Run("should return the outputs in the order of the secrets", func(t *testing.T) {
+		assert.Equal(t, len(secrets), len(outputs))
+		for i, output := range outputs {
+			assert.Equal(t, secrets[i], output.secret)
+			if i == 3 {
+				assert.Equal(t, errors.New("something bad happened"), output.err)
+			} else {
+				assert.Nil(t, output.err)
+			}
+		}
+	})
+}
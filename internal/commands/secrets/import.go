@@ -0,0 +1,339 @@
+package secrets
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	secretsources "github.com/10gen/realm-cli/internal/secrets"
+	"github.com/10gen/realm-cli/internal/secrets/providers"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	flagFile      = "file"
+	flagFileUsage = "the dotenv, JSON or YAML file of secret names and values to import"
+
+	flagFrom      = "from"
+	flagFromUsage = `the location of the secrets to import from an external store instead of a file, ` +
+		`e.g. "vault://kv/realm/prod" or "aws-sm://realm/prod/"`
+
+	flagSkipExisting      = "skip-existing"
+	flagSkipExistingUsage = "include to leave secrets which already exist unchanged instead of updating them"
+
+	headerResult = "Result"
+
+	importResultCreated = "created"
+	importResultUpdated = "updated"
+	importResultSkipped = "skipped"
+	importResultFailed  = "failed"
+)
+
+// CommandMetaImport is the command meta for the `secrets import` command
+var CommandMetaImport = cli.CommandMeta{
+	Use:         "import",
+	Display:     "secrets import",
+	Description: "Import Secrets for your Realm app from a file",
+	HelpText: `Creates a Secret for each name and value in the file, updating the Secrets
+which already exist unless "--skip-existing" is included. The file is read as
+JSON when it ends in ".json", as YAML when it ends in ".yaml" or ".yml", and as
+a dotenv file of NAME=value lines otherwise (e.g. ".env.production").
+
+Values may reference an external secret source, which is resolved when the
+Secret is imported (e.g. "vault:kv/realm/prod#apiKey").
+
+Include "--from" instead of "--file" to import every secret stored at a location
+of an external store, so secret values never need to be written to a local file:
+  - "vault://<path>" imports each key of the HashiCorp Vault secret at the path,
+    using VAULT_ADDR and VAULT_TOKEN
+  - "aws-sm://<prefix>" imports each AWS Secrets Manager secret whose name starts
+    with the prefix, named without the prefix, using AWS_ACCESS_KEY_ID,
+    AWS_SECRET_ACCESS_KEY and AWS_REGION`,
+}
+
+// CommandImport is the `secrets import` command
+type CommandImport struct {
+	inputs   importInputs
+	imported int
+}
+
+type importInputs struct {
+	cli.ProjectInputs
+	File         string
+	From         string
+	SkipExisting bool
+}
+
+// Flags is the command flags
+func (cmd *CommandImport) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.File, flagFile, "", flagFileUsage)
+	fs.StringVar(&cmd.inputs.From, flagFrom, "", flagFromUsage)
+	fs.BoolVar(&cmd.inputs.SkipExisting, flagSkipExisting, false, flagSkipExistingUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandImport) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandImport) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	values, err := cmd.inputs.readSecrets()
+	if err != nil {
+		return err
+	}
+
+	if len(values) == 0 {
+		ui.Print(terminal.NewTextLog("No secrets found in %s", cmd.inputs.source()))
+		return nil
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	secrets, err := clients.Realm.Secrets(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]realm.Secret, len(secrets))
+	for _, secret := range secrets {
+		existing[secret.Name] = secret
+	}
+
+	names := make([]string, 0, len(values))
+	var updates int
+	for name := range values {
+		names = append(names, name)
+		if _, ok := existing[name]; ok {
+			updates++
+		}
+	}
+	sort.Strings(names)
+
+	if updates > 0 && !cmd.inputs.SkipExisting {
+		proceed, err := ui.Confirm("This will update %d existing secret(s), are you sure you want to proceed?", updates)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return nil
+		}
+	}
+
+	resolver := secretsources.NewDefaultResolver()
+
+	outputs := make([]importOutput, 0, len(names))
+	for _, name := range names {
+		output := importOutput{secretOutput: secretOutput{secret: existing[name]}}
+		output.secret.Name = name
+
+		if _, exists := existing[name]; exists && cmd.inputs.SkipExisting {
+			output.result = importResultSkipped
+		} else {
+			output.result, output.secret, output.err = importSecret(clients.Realm, resolver, app, output.secret, values[name], exists)
+			if output.err == nil {
+				cmd.imported++
+			}
+		}
+		outputs = append(outputs, output)
+	}
+
+	sort.SliceStable(outputs, func(i, j int) bool {
+		return outputs[i].err != nil && outputs[j].err == nil
+	})
+
+	rows := make([]map[string]interface{}, 0, len(outputs))
+	for _, output := range outputs {
+		rows = append(rows, tableRow(output.secretOutput, output.tableRow))
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Imported %d secret(s) from %s", len(outputs), cmd.inputs.source()),
+		tableHeaders(headerResult, headerDetails),
+		rows...,
+	))
+	return nil
+}
+
+// ResourcesAffected is the number of secrets created or updated
+func (cmd *CommandImport) ResourcesAffected() int {
+	return cmd.imported
+}
+
+func (i *importInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	if i.File == "" && i.From == "" {
+		return fmt.Errorf(`must specify either "--%s" or "--%s"`, flagFile, flagFrom)
+	}
+	if i.File != "" && i.From != "" {
+		return fmt.Errorf(`cannot specify both "--%s" and "--%s"`, flagFile, flagFrom)
+	}
+	return nil
+}
+
+func (i importInputs) source() string {
+	if i.From != "" {
+		return i.From
+	}
+	return i.File
+}
+
+func (i importInputs) readSecrets() (map[string]string, error) {
+	if i.From != "" {
+		return providers.NewDefaultRegistry().Secrets(i.From)
+	}
+	return readSecretsFile(i.File)
+}
+
+type importOutput struct {
+	secretOutput
+	result string
+}
+
+func (output importOutput) tableRow(_ secretOutput, row map[string]interface{}) {
+	row[headerResult] = output.result
+	if output.err != nil {
+		row[headerDetails] = output.err.Error()
+	}
+}
+
+func importSecret(realmClient realm.Client, resolver secretsources.Resolver, app realm.App, secret realm.Secret, value string, exists bool) (string, realm.Secret, error) {
+	resolved, err := resolver.Resolve(value)
+	if err != nil {
+		return importResultFailed, secret, err
+	}
+
+	if exists {
+		if err := realmClient.UpdateSecret(app.GroupID, app.ID, secret.ID, secret.Name, resolved); err != nil {
+			return importResultFailed, secret, err
+		}
+		return importResultUpdated, secret, nil
+	}
+
+	created, err := realmClient.CreateSecret(app.GroupID, app.ID, secret.Name, resolved)
+	if err != nil {
+		return importResultFailed, secret, err
+	}
+	return importResultCreated, created, nil
+}
+
+// readSecretsFile reads the secret names and values from a JSON, YAML or dotenv file
+func readSecretsFile(path string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		var doc map[string]interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		values, err = secretValues(doc)
+	case ".yaml", ".yml":
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		values, err = secretValues(doc)
+	default:
+		values, err = parseDotenv(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return values, nil
+}
+
+func secretValues(doc map[string]interface{}) (map[string]string, error) {
+	values := make(map[string]string, len(doc))
+	for name, value := range doc {
+		switch v := value.(type) {
+		case string:
+			values[name] = v
+		case bool, int, float64:
+			values[name] = fmt.Sprint(v)
+		default:
+			return nil, fmt.Errorf("secret '%s' must have a string value", name)
+		}
+	}
+	return values, nil
+}
+
+// parseDotenv parses NAME=value lines, ignoring blank lines and comments
+// and unquoting values wrapped in single or double quotes
+func parseDotenv(data []byte) (map[string]string, error) {
+	values := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		idx := strings.Index(line, "=")
+		if idx <= 0 {
+			return nil, fmt.Errorf("line %d must be of the form NAME=value", n)
+		}
+
+		name := strings.TrimSpace(line[:idx])
+		value, err := parseDotenvValue(strings.TrimSpace(line[idx+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d has an invalid value: %w", n, err)
+		}
+		values[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func parseDotenvValue(value string) (string, error) {
+	if len(value) < 2 {
+		return value, nil
+	}
+
+	switch value[0] {
+	case '"':
+		if value[len(value)-1] != '"' {
+			return "", errors.New("missing closing quote")
+		}
+		return strconv.Unquote(value)
+	case '\'':
+		if value[len(value)-1] != '\'' {
+			return "", errors.New("missing closing quote")
+		}
+		return value[1 : len(value)-1], nil
+	}
+
+	if idx := strings.Index(value, " #"); idx != -1 {
+		value = strings.TrimSpace(value[:idx]) // an inline comment
+	}
+	return value, nil
+}
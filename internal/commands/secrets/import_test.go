@@ -0,0 +1,225 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	secretsources "github.com/10gen/realm-cli/internal/secrets"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSecretsImportHandler(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	app := realm.App{
+		ID:          "appID",
+		GroupID:     "projectID",
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+
+	envFile := filepath.Join(tmpDir, ".env.production")
+	assert.Nil(t, ioutil.WriteFile(envFile, []byte(`# production secrets
+apiKey=abc123
+export dbPassword="p@ss word"
+webhookToken='t0ken'
+`), 0666))
+
+	newRealmClient := func() (mock.RealmClient, *[]string) {
+		var calls []string
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.SecretsFn = func(groupID, appID string) ([]realm.Secret, error) {
+			return []realm.Secret{{ID: "secret2", Name: "dbPassword"}}, nil
+		}
+		realmClient.CreateSecretFn = func(groupID, appID, name, value string) (realm.Secret, error) {
+			calls = append(calls, "create "+name+"="+value)
+			if name == "webhookToken" {
+				return realm.Secret{}, errors.New("something bad happened")
+			}
+			return realm.Secret{ID: "secret1", Name: name}, nil
+		}
+		realmClient.UpdateSecretFn = func(groupID, appID, secretID, name, value string) error {
+			calls = append(calls, "update "+secretID+" "+name+"="+value)
+			return nil
+		}
+		return realmClient, &calls
+	}
+
+	t.Run("should create and update the secrets from the file", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, calls := newRealmClient()
+
+		cmd := &CommandImport{inputs: importInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, File: envFile}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{
+			"create apiKey=abc123",
+			"update secret2 dbPassword=p@ss word",
+			"create webhookToken=t0ken",
+		}, *calls)
+		assert.Equal(t, `Imported 3 secret(s) from `+envFile+`
+  ID       Name          Result   Details               
+  -------  ------------  -------  ----------------------
+           webhookToken  failed   something bad happened
+  secret1  apiKey        created                        
+  secret2  dbPassword    updated                        
+`, out.String())
+	})
+
+	t.Run("should skip the secrets which already exist", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, calls := newRealmClient()
+
+		cmd := &CommandImport{inputs: importInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, File: envFile, SkipExisting: true}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"create apiKey=abc123", "create webhookToken=t0ken"}, *calls)
+		assert.Equal(t, `Imported 3 secret(s) from `+envFile+`
+  ID       Name          Result   Details               
+  -------  ------------  -------  ----------------------
+           webhookToken  failed   something bad happened
+  secret1  apiKey        created                        
+  secret2  dbPassword    skipped                        
+`, out.String())
+	})
+
+	t.Run("should create and update the secrets from an external store", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/kv/data/realm/prod" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"data":{"data":{"apiKey":"abc123","dbPassword":"p@ss"}}}`)) //nolint:errcheck
+		}))
+		defer server.Close()
+
+		assert.Nil(t, os.Setenv(secretsources.EnvVaultAddress, server.URL))
+		defer os.Unsetenv(secretsources.EnvVaultAddress)
+		assert.Nil(t, os.Setenv(secretsources.EnvVaultToken, "token"))
+		defer os.Unsetenv(secretsources.EnvVaultToken)
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, calls := newRealmClient()
+
+		cmd := &CommandImport{inputs: importInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, From: "vault://kv/realm/prod"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"create apiKey=abc123", "update secret2 dbPassword=p@ss"}, *calls)
+		assert.Equal(t, `Imported 2 secret(s) from vault://kv/realm/prod
+  ID       Name        Result   Details
+  -------  ----------  -------  -------
+  secret1  apiKey      created         
+  secret2  dbPassword  updated         
+`, out.String())
+	})
+}
+
+func TestSecretsImportInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      importInputs
+		expectedErr error
+	}{
+		{
+			description: "should require a file or an external store",
+			expectedErr: errors.New(`must specify either "--file" or "--from"`),
+		},
+		{
+			description: "should not allow both a file and an external store",
+			inputs:      importInputs{File: ".env", From: "vault://kv/realm/prod"},
+			expectedErr: errors.New(`cannot specify both "--file" and "--from"`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			tc.inputs.ProjectInputs = cli.ProjectInputs{Project: "projectID", App: "appID"}
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(mock.NewProfile(t), nil))
+		})
+	}
+}
+
+func TestReadSecretsFile(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	for _, tc := range []struct {
+		description    string
+		file           string
+		contents       string
+		expectedValues map[string]string
+		expectedErr    string
+	}{
+		{
+			description:    "should read a json file",
+			file:           "secrets.json",
+			contents:       `{"apiKey": "abc123", "retries": 3, "debug": true}`,
+			expectedValues: map[string]string{"apiKey": "abc123", "retries": "3", "debug": "true"},
+		},
+		{
+			description:    "should read a yaml file",
+			file:           "secrets.yml",
+			contents:       "apiKey: abc123\ndbPassword: 'p@ss'\n",
+			expectedValues: map[string]string{"apiKey": "abc123", "dbPassword": "p@ss"},
+		},
+		{
+			description:    "should read a dotenv file",
+			file:           ".env",
+			contents:       "apiKey=abc123 # the api key\n\nmultiline=\"one\\ntwo\"\nempty=\n",
+			expectedValues: map[string]string{"apiKey": "abc123", "multiline": "one\ntwo", "empty": ""},
+		},
+		{
+			description: "should return an error for a json value which is not a string",
+			file:        "nested.json",
+			contents:    `{"apiKey": {"value": "abc123"}}`,
+			expectedErr: "secret 'apiKey' must have a string value",
+		},
+		{
+			description: "should return an error for an invalid dotenv line",
+			file:        ".env.invalid",
+			contents:    "apiKey=abc123\nnotASecret\n",
+			expectedErr: "line 2 must be of the form NAME=value",
+		},
+		{
+			description: "should return an error for an unterminated dotenv quote",
+			file:        ".env.quote",
+			contents:    "apiKey=\"abc123\n",
+			expectedErr: "line 1 has an invalid value: missing closing quote",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			path := filepath.Join(tmpDir, tc.file)
+			assert.Nil(t, ioutil.WriteFile(path, []byte(tc.contents), 0666))
+
+			values, err := readSecretsFile(path)
+			if tc.expectedErr != "" {
+				assert.Equal(t, "failed to parse "+path+": "+tc.expectedErr, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedValues, values)
+		})
+	}
+}
@@ -1,5 +1,13 @@
 package secrets
 
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
 // Flag names and usages across the secrets commands
 const (
 	flagName            = "name"
@@ -12,8 +20,32 @@ const (
 	flagValueUsageCreate = "the value of the secret"
 	flagValueUsageUpdate = "the new value of the secret"
 
+	flagValueStdin      = "value-stdin"
+	flagValueStdinUsage = "include to read the value of the secret from stdin"
+
 	flagSecret            = "secret"
 	flagSecretShort       = "s"
 	flagSecretUsageUpdate = "the name or id of the secret to update"
 	flagSecretUsageDelete = "the name or id of the secret to delete"
 )
+
+var stdin io.Reader = os.Stdin
+
+// readValueStdin reads the secret value from stdin, dropping the trailing newline
+// added when the value is piped from a command such as echo
+func readValueStdin(value string) (string, error) {
+	if value != "" {
+		return "", fmt.Errorf("cannot specify both --%s and --%s", flagValue, flagValueStdin)
+	}
+
+	data, err := ioutil.ReadAll(stdin)
+	if err != nil {
+		return "", err
+	}
+
+	value = strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+	if value == "" {
+		return "", fmt.Errorf("no secret value was read from stdin")
+	}
+	return value, nil
+}
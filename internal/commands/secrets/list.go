@@ -56,7 +56,7 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 		return nil
 	}
 
-	ui.Print(terminal.NewTableLog(
+	ui.Print(terminal.NewUnorderedTableLog(
 		fmt.Sprintf("Found %d secrets", len(secrets)),
 		tableHeaders(),
 		tableRowsList(secrets)...,
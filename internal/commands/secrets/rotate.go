@@ -0,0 +1,178 @@
+package secrets
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagSecretUsageRotate = "the name or id of the secret to rotate"
+
+	flagGenerate      = "generate"
+	flagGenerateUsage = "the length of the randomly generated value for the secret"
+
+	flagOutputFile      = "output-file"
+	flagOutputFileUsage = "the file to store the new value of the secret in, instead of printing it"
+
+	flagPairedValue      = "paired-value"
+	flagPairedValueUsage = "the name of a Value to update so it references the rotated secret"
+
+	defaultGenerateLength = 32
+
+	generateCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// CommandMetaRotate is the command meta for the `secrets rotate` command
+var CommandMetaRotate = cli.CommandMeta{
+	Use:         "rotate",
+	Display:     "secrets rotate",
+	Description: "Rotate a Secret in your Realm app to a newly generated value",
+	HelpText: `Replaces the value of the Secret with a randomly generated one of the length set
+by "--generate". The new value is printed once and cannot be read back from
+your Realm app afterwards, so use "--output-file" to store it in a file instead.
+
+Include "--paired-value" to also update a Value of your Realm app so that it
+references the rotated Secret.`,
+}
+
+// CommandRotate is the `secrets rotate` command
+type CommandRotate struct {
+	inputs rotateInputs
+}
+
+type rotateInputs struct {
+	cli.ProjectInputs
+	Secret      string
+	Generate    int
+	OutputFile  string
+	PairedValue string
+}
+
+// Flags is the command flags
+func (cmd *CommandRotate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Secret, flagSecret, flagSecretShort, "", flagSecretUsageRotate)
+	fs.IntVar(&cmd.inputs.Generate, flagGenerate, defaultGenerateLength, flagGenerateUsage)
+	fs.StringVar(&cmd.inputs.OutputFile, flagOutputFile, "", flagOutputFileUsage)
+	fs.StringVar(&cmd.inputs.PairedValue, flagPairedValue, "", flagPairedValueUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandRotate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandRotate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	secrets, err := clients.Realm.Secrets(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	secret, err := resolveSecret(ui, secrets, cmd.inputs.Secret, "rotate")
+	if err != nil {
+		return err
+	}
+
+	var pairedValue realm.Value
+	if cmd.inputs.PairedValue != "" {
+		values, err := clients.Realm.Values(app.GroupID, app.ID)
+		if err != nil {
+			return err
+		}
+
+		if pairedValue, err = findValue(values, cmd.inputs.PairedValue); err != nil {
+			return err
+		}
+	}
+
+	proceed, err := ui.Confirm("This will replace the value of secret %s, are you sure you want to proceed?", secret.Name)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	value, err := generateValue(cmd.inputs.Generate)
+	if err != nil {
+		return err
+	}
+
+	if err := clients.Realm.UpdateSecret(app.GroupID, app.ID, secret.ID, secret.Name, value); err != nil {
+		return err
+	}
+
+	if cmd.inputs.OutputFile != "" {
+		if err := ioutil.WriteFile(cmd.inputs.OutputFile, []byte(value), 0600); err != nil {
+			return fmt.Errorf("rotated secret %s but failed to store its new value: %w", secret.Name, err)
+		}
+		ui.Print(terminal.NewTextLog("Successfully rotated secret %s and stored its new value in %s", secret.Name, cmd.inputs.OutputFile))
+	} else {
+		ui.Print(terminal.NewTextLog("Successfully rotated secret %s, its new value will not be shown again", secret.Name))
+		ui.Print(terminal.NewTextLog(value))
+	}
+
+	if cmd.inputs.PairedValue == "" {
+		return nil
+	}
+
+	pairedValue.FromSecret = true
+	pairedValue.Value = secret.Name
+	if err := clients.Realm.UpdateValue(app.GroupID, app.ID, pairedValue); err != nil {
+		return fmt.Errorf("rotated secret %s but failed to update value %s: %w", secret.Name, pairedValue.Name, err)
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully updated value %s to reference secret %s", pairedValue.Name, secret.Name))
+	return nil
+}
+
+func (i *rotateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	if i.Generate <= 0 {
+		return fmt.Errorf(`"--%s" must be a positive number`, flagGenerate)
+	}
+	return nil
+}
+
+func findValue(values []realm.Value, nameOrID string) (realm.Value, error) {
+	for _, value := range values {
+		if value.ID == nameOrID || value.Name == nameOrID {
+			return value, nil
+		}
+	}
+	return realm.Value{}, fmt.Errorf("unable to find value: %s", nameOrID)
+}
+
+// generateValue returns a cryptographically random alphanumeric value of the specified length
+func generateValue(length int) (string, error) {
+	max := big.NewInt(int64(len(generateCharset)))
+
+	value := make([]byte, length)
+	for i := range value {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		value[i] = generateCharset[n.Int64()]
+	}
+	return string(value), nil
+}
@@ -0,0 +1,164 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSecretsRotateHandler(t *testing.T) {
+	app := realm.App{
+		ID:          "appID",
+		GroupID:     "projectID",
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+
+	newRealmClient := func() (mock.RealmClient, *string, *[]realm.Value) {
+		var rotated string
+		var updatedValues []realm.Value
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.SecretsFn = func(groupID, appID string) ([]realm.Secret, error) {
+			return []realm.Secret{{ID: "secret1", Name: "apiKey"}}, nil
+		}
+		realmClient.UpdateSecretFn = func(groupID, appID, secretID, name, value string) error {
+			rotated = secretID + " " + name + "=" + value
+			return nil
+		}
+		realmClient.ValuesFn = func(groupID, appID string) ([]realm.Value, error) {
+			return []realm.Value{{ID: "value1", Name: "apiKeyValue", Value: "plaintext"}}, nil
+		}
+		realmClient.UpdateValueFn = func(groupID, appID string, value realm.Value) error {
+			updatedValues = append(updatedValues, value)
+			return nil
+		}
+		return realmClient, &rotated, &updatedValues
+	}
+
+	t.Run("should rotate the secret and print its new value", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, rotated, _ := newRealmClient()
+
+		cmd := &CommandRotate{rotateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, Secret: "apiKey", Generate: 12}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.True(t, regexp.MustCompile(`^secret1 apiKey=[A-Za-z0-9]{12}$`).MatchString(*rotated), "should rotate the secret to a generated value, but got: %s", *rotated)
+		value := strings.TrimPrefix(*rotated, "secret1 apiKey=")
+		assert.Equal(t, "Successfully rotated secret apiKey, its new value will not be shown again\n"+value+"\n", out.String())
+	})
+
+	t.Run("should store the new value in the output file", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, rotated, _ := newRealmClient()
+
+		outputFile := filepath.Join(tmpDir, "apiKey.txt")
+		cmd := &CommandRotate{rotateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, Secret: "secret1", Generate: 32, OutputFile: outputFile}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully rotated secret apiKey and stored its new value in "+outputFile+"\n", out.String())
+
+		data, err := ioutil.ReadFile(outputFile)
+		assert.Nil(t, err)
+		assert.Equal(t, 32, len(data))
+		assert.Equal(t, "secret1 apiKey="+string(data), *rotated)
+
+		info, err := os.Stat(outputFile)
+		assert.Nil(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+
+	t.Run("should update the paired value to reference the rotated secret", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, _, updatedValues := newRealmClient()
+
+		cmd := &CommandRotate{rotateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, Secret: "apiKey", Generate: 8, PairedValue: "apiKeyValue"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []realm.Value{{ID: "value1", Name: "apiKeyValue", FromSecret: true, Value: "apiKey"}}, *updatedValues)
+		assert.True(t, strings.HasSuffix(out.String(), "Successfully updated value apiKeyValue to reference secret apiKey\n"), "should print the paired value update")
+	})
+
+	t.Run("should not rotate the secret when the paired value cannot be found", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient, rotated, _ := newRealmClient()
+
+		cmd := &CommandRotate{rotateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, Secret: "apiKey", Generate: 8, PairedValue: "missing"}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("unable to find value: missing"), err)
+		assert.Equal(t, "", *rotated)
+	})
+
+	t.Run("should return an error when the secret cannot be found", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient, _, _ := newRealmClient()
+
+		cmd := &CommandRotate{rotateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, Secret: "missing", Generate: 8}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("unable to find secret: missing"), err)
+	})
+
+	t.Run("should return an error when the secret fails to rotate", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, _, _ := newRealmClient()
+		realmClient.UpdateSecretFn = func(groupID, appID, secretID, name, value string) error {
+			return errors.New("something bad happened")
+		}
+
+		cmd := &CommandRotate{rotateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, Secret: "apiKey", Generate: 8}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+		assert.Equal(t, "", out.String())
+	})
+}
+
+func TestSecretsRotateInputs(t *testing.T) {
+	t.Run("should return an error when the generated length is not positive", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		inputs := rotateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}}
+
+		err := inputs.Resolve(profile, nil)
+		assert.Equal(t, errors.New(`"--generate" must be a positive number`), err)
+	})
+
+	t.Run("should resolve with a positive generated length", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		inputs := rotateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, Generate: defaultGenerateLength}
+
+		assert.Nil(t, inputs.Resolve(profile, nil))
+	})
+}
@@ -0,0 +1,236 @@
+package secrets
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	secretsources "github.com/10gen/realm-cli/internal/secrets"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagFileSyncUsage = "the dotenv, JSON or YAML file of secret names and values to sync the app's Secrets with"
+
+	flagPrune      = "prune"
+	flagPruneUsage = "include to delete the secrets which are not in the file"
+
+	flagDryRun      = "dry-run"
+	flagDryRunShort = "x"
+	flagDryRunUsage = "include to display the changes which would be made to the secrets without making them"
+
+	syncResultDeleted = "deleted"
+
+	syncActionCreate = "create"
+	syncActionUpdate = "update"
+	syncActionDelete = "delete"
+)
+
+// CommandMetaSync is the command meta for the `secrets sync` command
+var CommandMetaSync = cli.CommandMeta{
+	Use:         "sync",
+	Display:     "secrets sync",
+	Description: "Sync the Secrets of your Realm app with a file",
+	HelpText: `Makes the Secrets of your Realm app match the names and values in the file:
+Secrets missing from the app are created and Secrets which already exist are
+updated, since their values cannot be read back to compare. Include "--prune"
+to also delete the Secrets which are not in the file, and "--dry-run" to display
+the changes without making them.
+
+The file is read the same way as with "secrets import".`,
+}
+
+// CommandSync is the `secrets sync` command
+type CommandSync struct {
+	inputs syncInputs
+	synced int
+}
+
+type syncInputs struct {
+	cli.ProjectInputs
+	File   string
+	Prune  bool
+	DryRun bool
+}
+
+// Flags is the command flags
+func (cmd *CommandSync) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.File, flagFile, "", flagFileSyncUsage)
+	fs.BoolVar(&cmd.inputs.Prune, flagPrune, false, flagPruneUsage)
+	fs.BoolVarP(&cmd.inputs.DryRun, flagDryRun, flagDryRunShort, false, flagDryRunUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandSync) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandSync) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	values, err := readSecretsFile(cmd.inputs.File)
+	if err != nil {
+		return err
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	secrets, err := clients.Realm.Secrets(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	changes := cmd.inputs.changes(values, secrets)
+	if len(changes) == 0 {
+		ui.Print(terminal.NewTextLog("No secrets to sync from %s", cmd.inputs.File))
+		return nil
+	}
+
+	if cmd.inputs.DryRun {
+		rows := make([]map[string]interface{}, 0, len(changes))
+		for _, change := range changes {
+			rows = append(rows, tableRow(secretOutput{secret: change.secret}, func(_ secretOutput, row map[string]interface{}) {
+				row[headerResult] = change.action
+			}))
+		}
+
+		ui.Print(terminal.NewTableLog(
+			fmt.Sprintf("The following %d secret change(s) would be synced from %s", len(changes), cmd.inputs.File),
+			tableHeaders(headerResult),
+			rows...,
+		))
+		return nil
+	}
+
+	var deletes int
+	for _, change := range changes {
+		if change.action == syncActionDelete {
+			deletes++
+		}
+	}
+
+	if deletes > 0 {
+		proceed, err := ui.Confirm("This will delete %d secret(s) which are not in %s, are you sure you want to proceed?", deletes, cmd.inputs.File)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return nil
+		}
+	}
+
+	resolver := secretsources.NewDefaultResolver()
+
+	var failed int
+	outputs := make([]importOutput, 0, len(changes))
+	for _, change := range changes {
+		output := importOutput{secretOutput: secretOutput{secret: change.secret}}
+
+		switch change.action {
+		case syncActionDelete:
+			output.result = syncResultDeleted
+			if output.err = clients.Realm.DeleteSecret(app.GroupID, app.ID, change.secret.ID); output.err != nil {
+				output.result = importResultFailed
+			}
+		default:
+			output.result, output.secret, output.err = importSecret(clients.Realm, resolver, app, change.secret, values[change.secret.Name], change.action == syncActionUpdate)
+		}
+
+		if output.err != nil {
+			failed++
+		}
+		outputs = append(outputs, output)
+	}
+
+	cmd.synced = len(outputs) - failed
+
+	sort.SliceStable(outputs, func(i, j int) bool {
+		return outputs[i].err != nil && outputs[j].err == nil
+	})
+
+	rows := make([]map[string]interface{}, 0, len(outputs))
+	for _, output := range outputs {
+		rows = append(rows, tableRow(output.secretOutput, output.tableRow))
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Synced %d secret(s) from %s", len(outputs), cmd.inputs.File),
+		tableHeaders(headerResult, headerDetails),
+		rows...,
+	))
+
+	if failed > 0 {
+		return fmt.Errorf("failed to sync %d secret(s)", failed)
+	}
+	return nil
+}
+
+// ResourcesAffected is the number of secrets created, updated or deleted
+func (cmd *CommandSync) ResourcesAffected() int {
+	return cmd.synced
+}
+
+func (i *syncInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	if i.File == "" {
+		return fmt.Errorf(`must specify "--%s"`, flagFile)
+	}
+	return nil
+}
+
+type syncChange struct {
+	action string
+	secret realm.Secret
+}
+
+// changes returns the creates and updates needed for the app's secrets to match the values,
+// ordered by secret name, followed by the deletes of the secrets not in the values when pruning
+func (i syncInputs) changes(values map[string]string, secrets []realm.Secret) []syncChange {
+	existing := make(map[string]realm.Secret, len(secrets))
+	for _, secret := range secrets {
+		existing[secret.Name] = secret
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	changes := make([]syncChange, 0, len(names))
+	for _, name := range names {
+		if secret, ok := existing[name]; ok {
+			changes = append(changes, syncChange{syncActionUpdate, secret})
+			continue
+		}
+		changes = append(changes, syncChange{syncActionCreate, realm.Secret{Name: name}})
+	}
+
+	if !i.Prune {
+		return changes
+	}
+
+	extras := make([]realm.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		if _, ok := values[secret.Name]; !ok {
+			extras = append(extras, secret)
+		}
+	}
+	sort.Slice(extras, func(i, j int) bool { return extras[i].Name < extras[j].Name })
+
+	for _, secret := range extras {
+		changes = append(changes, syncChange{syncActionDelete, secret})
+	}
+	return changes
+}
@@ -0,0 +1,133 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSecretsSyncHandler(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	app := realm.App{
+		ID:          "appID",
+		GroupID:     "projectID",
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+
+	file := filepath.Join(tmpDir, "secrets.json")
+	assert.Nil(t, ioutil.WriteFile(file, []byte(`{"apiKey": "abc123", "dbPassword": "p@ss"}`), 0666))
+
+	newRealmClient := func() (mock.RealmClient, *[]string) {
+		var calls []string
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.SecretsFn = func(groupID, appID string) ([]realm.Secret, error) {
+			return []realm.Secret{{ID: "secret2", Name: "dbPassword"}, {ID: "secret3", Name: "oldToken"}}, nil
+		}
+		realmClient.CreateSecretFn = func(groupID, appID, name, value string) (realm.Secret, error) {
+			calls = append(calls, "create "+name+"="+value)
+			return realm.Secret{ID: "secret1", Name: name}, nil
+		}
+		realmClient.UpdateSecretFn = func(groupID, appID, secretID, name, value string) error {
+			calls = append(calls, "update "+secretID+" "+name+"="+value)
+			return nil
+		}
+		realmClient.DeleteSecretFn = func(groupID, appID, secretID string) error {
+			calls = append(calls, "delete "+secretID)
+			return nil
+		}
+		return realmClient, &calls
+	}
+
+	t.Run("should create and update the secrets from the file", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, calls := newRealmClient()
+
+		cmd := &CommandSync{inputs: syncInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, File: file}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"create apiKey=abc123", "update secret2 dbPassword=p@ss"}, *calls)
+		assert.Equal(t, `Synced 2 secret(s) from `+file+`
+  ID       Name        Result   Details
+  -------  ----------  -------  -------
+  secret1  apiKey      created         
+  secret2  dbPassword  updated         
+`, out.String())
+	})
+
+	t.Run("should delete the secrets which are not in the file when pruning", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, calls := newRealmClient()
+
+		cmd := &CommandSync{inputs: syncInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, File: file, Prune: true}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"create apiKey=abc123", "update secret2 dbPassword=p@ss", "delete secret3"}, *calls)
+		assert.Equal(t, `Synced 3 secret(s) from `+file+`
+  ID       Name        Result   Details
+  -------  ----------  -------  -------
+  secret1  apiKey      created         
+  secret2  dbPassword  updated         
+  secret3  oldToken    deleted         
+`, out.String())
+	})
+
+	t.Run("should display the changes without making them with a dry run", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, calls := newRealmClient()
+
+		cmd := &CommandSync{inputs: syncInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, File: file, Prune: true, DryRun: true}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, 0, len(*calls))
+		assert.Equal(t, `The following 3 secret change(s) would be synced from `+file+`
+  ID       Name        Result
+  -------  ----------  ------
+           apiKey      create
+  secret2  dbPassword  update
+  secret3  oldToken    delete
+`, out.String())
+	})
+
+	t.Run("should return an error when secrets fail to sync", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient, _ := newRealmClient()
+		realmClient.DeleteSecretFn = func(groupID, appID, secretID string) error {
+			return errors.New("something bad happened")
+		}
+
+		cmd := &CommandSync{inputs: syncInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, File: file, Prune: true}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("failed to sync 1 secret(s)"), err)
+		assert.Equal(t, `Synced 3 secret(s) from `+file+`
+  ID       Name        Result   Details               
+  -------  ----------  -------  ----------------------
+  secret3  oldToken    failed   something bad happened
+  secret1  apiKey      created                        
+  secret2  dbPassword  updated                        
+`, out.String())
+	})
+}
@@ -3,6 +3,7 @@ package secrets
 import (
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
+	secretsources "github.com/10gen/realm-cli/internal/secrets"
 	"github.com/10gen/realm-cli/internal/terminal"
 
 	"github.com/spf13/pflag"
@@ -14,7 +15,15 @@ var CommandMetaUpdate = cli.CommandMeta{
 	Display:     "secret update",
 	Description: "Update a Secret in your Realm app",
 	HelpText: `NOTE: The Name of the Secret cannot be modified. In order to do so, you will
-need to delete and re-create the Secret.`,
+need to delete and re-create the Secret.
+
+When neither "--name" nor "--value" is set, you will be prompted for the new
+value, which is hidden as you type it. Use "--value-stdin" to pipe the new value
+in instead, keeping it out of your shell history.
+
+The new value may reference an external secret source, which is resolved when
+the Secret is updated (e.g. "vault:kv/realm/prod#apiKey" or
+"aws-sm:realm/prod#apiKey").`,
 }
 
 // CommandUpdate is the `secret update` command
@@ -33,6 +42,7 @@ func (cmd *CommandUpdate) Flags(fs *pflag.FlagSet) {
 	fs.StringVarP(&cmd.inputs.secret, flagSecret, flagSecretShort, "", flagSecretUsageUpdate)
 	fs.StringVarP(&cmd.inputs.name, flagName, flagNameShort, "", flagNameUsageUpdate)
 	fs.StringVarP(&cmd.inputs.value, flagValue, flagValueShort, "", flagValueUsageUpdate)
+	fs.BoolVar(&cmd.inputs.valueStdin, flagValueStdin, false, flagValueStdinUsage)
 }
 
 // Handler function for the secrets update command
@@ -57,12 +67,17 @@ func (cmd *CommandUpdate) Handler(profile *user.Profile, ui terminal.UI, clients
 		name = secret.Name // when admin api _says_ patch, but never means it...
 	}
 
+	value, err := secretsources.NewDefaultResolver().Resolve(cmd.inputs.value)
+	if err != nil {
+		return err
+	}
+
 	if err := clients.Realm.UpdateSecret(
 		app.GroupID,
 		app.ID,
 		secret.ID,
 		name,
-		cmd.inputs.value,
+		value,
 	); err != nil {
 		return err
 	}
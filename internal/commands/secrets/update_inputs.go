@@ -14,9 +14,10 @@ import (
 
 type updateInputs struct {
 	cli.ProjectInputs
-	secret string
-	name   string
-	value  string
+	secret     string
+	name       string
+	value      string
+	valueStdin bool
 }
 
 func (i *updateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
@@ -24,6 +25,20 @@ func (i *updateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 		return err
 	}
 
+	if i.valueStdin {
+		value, err := readValueStdin(i.value)
+		if err != nil {
+			return err
+		}
+		i.value = value
+	}
+
+	if i.name == "" && i.value == "" {
+		if err := ui.AskOne(&i.value, &survey.Password{Message: "New Secret Value"}); err != nil {
+			return err
+		}
+	}
+
 	if i.name == "" && i.value == "" {
 		return errors.New("must set either --name or --value when updating a secret")
 	}
@@ -32,14 +47,19 @@ func (i *updateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 }
 
 func (i *updateInputs) resolveSecret(ui terminal.UI, secrets []realm.Secret) (realm.Secret, error) {
+	return resolveSecret(ui, secrets, i.secret, "update")
+}
 
-	if len(i.secret) > 0 {
+// resolveSecret finds the secret by its name or id, or prompts for the secret to
+// perform the action on when none is specified
+func resolveSecret(ui terminal.UI, secrets []realm.Secret, nameOrID, action string) (realm.Secret, error) {
+	if len(nameOrID) > 0 {
 		for _, secret := range secrets {
-			if secret.ID == i.secret || secret.Name == i.secret {
+			if secret.ID == nameOrID || secret.Name == nameOrID {
 				return secret, nil
 			}
 		}
-		return realm.Secret{}, fmt.Errorf("unable to find secret: %s", i.secret)
+		return realm.Secret{}, fmt.Errorf("unable to find secret: %s", nameOrID)
 	}
 
 	selectableSecrets := map[string]realm.Secret{}
@@ -54,7 +74,7 @@ func (i *updateInputs) resolveSecret(ui terminal.UI, secrets []realm.Secret) (re
 	if err := ui.AskOne(
 		&selected,
 		&survey.Select{
-			Message: "Which secret would you like to update?",
+			Message: fmt.Sprintf("Which secret would you like to %s?", action),
 			Options: selectableOptions,
 		},
 	); err != nil {
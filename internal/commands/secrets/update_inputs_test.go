@@ -3,8 +3,11 @@ package secrets
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 
+	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
@@ -57,7 +60,7 @@ func TestSecretInputResolve(t *testing.T) {
 	})
 
 	t.Run("should show the prompt for a user if the input is empty", func(t *testing.T) {
-		inputs := updateInputs{}
+		inputs := updateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}}
 
 		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
 		assert.Nil(t, consoleErr)
@@ -80,3 +83,37 @@ func TestSecretInputResolve(t *testing.T) {
 		assert.Equal(t, secrets[2], secretsResult)
 	})
 }
+
+func TestUpdateInputsResolve(t *testing.T) {
+	t.Run("should read the new secret value from stdin", func(t *testing.T) {
+		defer func(r io.Reader) { stdin = r }(stdin)
+		stdin = strings.NewReader("value\r\n")
+
+		inputs := updateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, valueStdin: true}
+		assert.Nil(t, inputs.Resolve(mock.NewProfile(t), nil))
+		assert.Equal(t, "value", inputs.value)
+	})
+
+	t.Run("should prompt for the new secret value when neither name nor value are set", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("New Secret Value")
+			console.SendLine("value")
+			console.ExpectEOF()
+		}()
+
+		inputs := updateInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}}
+		err := inputs.Resolve(mock.NewProfile(t), ui)
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, "value", inputs.value)
+	})
+}
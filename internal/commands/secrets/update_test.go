@@ -75,6 +75,7 @@ func TestSecretUpdateHandler(t *testing.T) {
 				tc.testSecret,
 				tc.testName,
 				tc.testValue,
+				false,
 			}}
 
 			out, ui := mock.NewUI()
@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const flagUserClientResetUsage = "specify the id of the user whose sync clients to reset"
+
+// CommandMetaClientReset is the command meta for the `sync client-reset` command
+var CommandMetaClientReset = cli.CommandMeta{
+	Use:         "client-reset",
+	Display:     "sync client-reset",
+	Description: "Trigger a client reset for the sync clients of a user of your Realm app",
+	HelpText: `Forces the sync clients of the user specified with "--user" to perform a client
+reset the next time they connect, which replaces their local Realm with the
+data on the server. Any changes the clients have not synced yet are handled by
+their client reset mode, and may be discarded.`,
+}
+
+// CommandClientReset is the `sync client-reset` command
+type CommandClientReset struct {
+	inputs clientResetInputs
+}
+
+type clientResetInputs struct {
+	cli.ProjectInputs
+	User string
+}
+
+// Flags is the command flags
+func (cmd *CommandClientReset) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.User, flagUser, flagUserShort, "", flagUserClientResetUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandClientReset) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandClientReset) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	proceed, err := ui.Confirm(
+		"This will force the sync clients of user %s to perform a client reset, which may discard their unsynced changes, are you sure you want to proceed?",
+		cmd.inputs.User,
+	)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	if err := clients.Realm.SyncClientReset(app.GroupID, app.ID, cmd.inputs.User); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully triggered a client reset for the sync clients of user %s", cmd.inputs.User))
+	return nil
+}
+
+func (i *clientResetInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.User == "" {
+		return fmt.Errorf(`must specify a user with "--%s"`, flagUser)
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
@@ -0,0 +1,50 @@
+package sync
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSyncClientResetInputs(t *testing.T) {
+	t.Run("should error without a user", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		inputs := clientResetInputs{}
+		assert.Equal(t, errors.New(`must specify a user with "--user"`), inputs.Resolve(profile, ui))
+	})
+}
+
+func TestSyncClientResetHandler(t *testing.T) {
+	t.Run("should trigger a client reset for the user", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		var capturedGroupID, capturedAppID, capturedUserID string
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "projectID"}}, nil
+		}
+		realmClient.SyncClientResetFn = func(groupID, appID, userID string) error {
+			capturedGroupID, capturedAppID, capturedUserID = groupID, appID, userID
+			return nil
+		}
+
+		cmd := &CommandClientReset{clientResetInputs{
+			ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"},
+			User:          "user1",
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully triggered a client reset for the sync clients of user user1\n", out.String())
+		assert.Equal(t, "projectID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+		assert.Equal(t, "user1", capturedUserID)
+	})
+}
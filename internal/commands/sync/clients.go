@@ -0,0 +1,113 @@
+package sync
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagUser      = "user"
+	flagUserShort = "u"
+
+	flagUserClientsUsage = "specify the id of a user to only list the sync clients of"
+
+	headerClientID     = "Client ID"
+	headerUserID       = "User ID"
+	headerDeviceID     = "Device ID"
+	headerPlatform     = "Platform"
+	headerSDKVersion   = "SDK Version"
+	headerConnectedAt  = "Connected At"
+	headerLastActivity = "Last Activity"
+)
+
+// CommandMetaClients is the command meta for the `sync clients` command
+var CommandMetaClients = cli.CommandMeta{
+	Use:         "clients",
+	Display:     "sync clients",
+	Description: "List the sync clients with an active session to your Realm app",
+	HelpText: `Displays the sync clients which have an active session, including each client's
+user, device, platform and SDK version, and when it connected and was last
+active. Specify "--user" to only list the sync clients of that user.`,
+}
+
+// CommandClients is the `sync clients` command
+type CommandClients struct {
+	inputs clientsInputs
+}
+
+type clientsInputs struct {
+	cli.ProjectInputs
+	User string
+}
+
+// Flags is the command flags
+func (cmd *CommandClients) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.User, flagUser, flagUserShort, "", flagUserClientsUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandClients) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandClients) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	syncClients, err := clients.Realm.SyncClients(app.GroupID, app.ID, cmd.inputs.User)
+	if err != nil {
+		return err
+	}
+
+	if len(syncClients) == 0 {
+		ui.Print(terminal.NewTextLog("No sync clients have an active session"))
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(syncClients))
+	for _, syncClient := range syncClients {
+		rows = append(rows, syncClientRow(syncClient))
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d sync client(s) with an active session", len(syncClients)),
+		[]string{headerClientID, headerUserID, headerDeviceID, headerPlatform, headerSDKVersion, headerConnectedAt, headerLastActivity},
+		rows...,
+	))
+	return nil
+}
+
+func (i *clientsInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+func syncClientRow(syncClient realm.SyncClient) map[string]interface{} {
+	return map[string]interface{}{
+		headerClientID:     syncClient.ID,
+		headerUserID:       syncClient.UserID,
+		headerDeviceID:     syncClient.DeviceID,
+		headerPlatform:     syncClient.Platform,
+		headerSDKVersion:   syncClient.SDKVersion,
+		headerConnectedAt:  displayTime(syncClient.ConnectedAt),
+		headerLastActivity: displayTime(syncClient.LastActivity),
+	}
+}
+
+func displayTime(seconds int64) string {
+	if seconds == 0 {
+		return "n/a"
+	}
+	return time.Unix(seconds, 0).UTC().String()
+}
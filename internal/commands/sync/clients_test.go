@@ -0,0 +1,76 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSyncClientsHandler(t *testing.T) {
+	newRealmClient := func(syncClients []realm.SyncClient, capturedUserID *string) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "projectID"}}, nil
+		}
+		realmClient.SyncClientsFn = func(groupID, appID, userID string) ([]realm.SyncClient, error) {
+			*capturedUserID = userID
+			return syncClients, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should list the sync clients of the user", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedUserID string
+		realmClient := newRealmClient([]realm.SyncClient{
+			{
+				ID:           "client1",
+				UserID:       "user1",
+				DeviceID:     "device1",
+				Platform:     "ios",
+				SDKVersion:   "10.7.0",
+				ConnectedAt:  1612345678,
+				LastActivity: 1612349278,
+			},
+			{
+				ID:          "client2",
+				UserID:      "user1",
+				DeviceID:    "device2",
+				Platform:    "android",
+				SDKVersion:  "10.3.1",
+				ConnectedAt: 1612345000,
+			},
+		}, &capturedUserID)
+
+		cmd := &CommandClients{clientsInputs{
+			ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"},
+			User:          "user1",
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Found 2 sync client(s) with an active session
+  Client ID  User ID  Device ID  Platform  SDK Version  Connected At                   Last Activity                
+  ---------  -------  ---------  --------  -----------  -----------------------------  -----------------------------
+  client1    user1    device1    ios       10.7.0       2021-02-03 09:47:58 +0000 UTC  2021-02-03 10:47:58 +0000 UTC
+  client2    user1    device2    android   10.3.1       2021-02-03 09:36:40 +0000 UTC  n/a                          
+`, out.String())
+		assert.Equal(t, "user1", capturedUserID)
+	})
+
+	t.Run("should print a message when no sync clients have an active session", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedUserID string
+		realmClient := newRealmClient(nil, &capturedUserID)
+
+		cmd := &CommandClients{clientsInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No sync clients have an active session\n", out.String())
+		assert.Equal(t, "", capturedUserID)
+	})
+}
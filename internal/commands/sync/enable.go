@@ -0,0 +1,174 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagMode      = "mode"
+	flagModeUsage = `select the mode of sync to enable, available options: ["flexible", "partition"]`
+
+	flagDatabase      = "database"
+	flagDatabaseUsage = "specify the name of the database to sync"
+
+	flagQueryableField      = "queryable-field"
+	flagQueryableFieldUsage = "specify the name of a field which flexible sync subscriptions can query on"
+
+	flagPartitionKey      = "partition-key"
+	flagPartitionKeyUsage = "specify the name of the field to partition the synced data on"
+
+	flagPartitionKeyType      = "partition-key-type"
+	flagPartitionKeyTypeUsage = `select the type of the partition key, available options: ["string", "objectId", "long"]`
+
+	flagPartitionKeyRequired      = "partition-key-required"
+	flagPartitionKeyRequiredUsage = "include to require every synced document to have a partition key"
+)
+
+var partitionKeyTypes = []string{"string", "objectId", "long"}
+
+// CommandMetaEnable is the command meta for the `sync enable` command
+var CommandMetaEnable = cli.CommandMeta{
+	Use:         "enable",
+	Display:     "sync enable",
+	Description: "Enable Device Sync for a data source of your Realm app",
+	HelpText: `Enables flexible sync, or partition-based sync with "--mode partition", on the
+database specified with "--database". Flexible sync subscriptions can query on
+the fields specified with "--queryable-field", while partition-based sync
+partitions the synced data on the field specified with "--partition-key".
+
+Sync which was paused or terminated keeps its configuration, and can be
+resumed with "sync re-enable" instead.`,
+}
+
+// CommandEnable is the `sync enable` command
+type CommandEnable struct {
+	inputs enableInputs
+}
+
+type enableInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+	Mode                 string
+	Database             string
+	QueryableFields      []string
+	PartitionKey         string
+	PartitionKeyType     string
+	PartitionKeyRequired bool
+}
+
+// Flags is the command flags
+func (cmd *CommandEnable) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+	fs.StringVar(&cmd.inputs.Mode, flagMode, modeFlexible, flagModeUsage)
+	fs.StringVar(&cmd.inputs.Database, flagDatabase, "", flagDatabaseUsage)
+	fs.StringSliceVar(&cmd.inputs.QueryableFields, flagQueryableField, []string{}, flagQueryableFieldUsage)
+	fs.StringVar(&cmd.inputs.PartitionKey, flagPartitionKey, "", flagPartitionKeyUsage)
+	fs.StringVar(&cmd.inputs.PartitionKeyType, flagPartitionKeyType, partitionKeyTypes[0], flagPartitionKeyTypeUsage)
+	fs.BoolVar(&cmd.inputs.PartitionKeyRequired, flagPartitionKeyRequired, false, flagPartitionKeyRequiredUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandEnable) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandEnable) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, service, config, err := resolveSync(ui, clients.Realm, cmd.inputs.Filter(), cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	if setup, ok := describeSync(config); ok {
+		switch setup.state {
+		case realm.SyncStateEnabled:
+			return fmt.Errorf("sync is already enabled for data source '%s'", service.Name)
+		case realm.SyncStateDisabled:
+			return fmt.Errorf(`sync is paused for data source '%s', resume it with "sync re-enable" or terminate it first`, service.Name)
+		}
+	}
+
+	if err := clients.Realm.UpdateSyncConfig(app.GroupID, app.ID, service.ID, cmd.inputs.syncConfig()); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"Successfully enabled %s sync for data source '%s' on database '%s'",
+		cmd.inputs.Mode,
+		service.Name,
+		cmd.inputs.Database,
+	))
+	return nil
+}
+
+func (i *enableInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	if i.Database == "" {
+		return fmt.Errorf(`must specify a database with "--%s"`, flagDatabase)
+	}
+
+	switch i.Mode {
+	case modeFlexible:
+		if i.PartitionKey != "" {
+			return fmt.Errorf(`cannot use "--%s" with flexible sync`, flagPartitionKey)
+		}
+	case modePartition:
+		if i.PartitionKey == "" {
+			return fmt.Errorf(`must specify a partition key with "--%s"`, flagPartitionKey)
+		}
+		if len(i.QueryableFields) > 0 {
+			return fmt.Errorf(`cannot use "--%s" with partition-based sync`, flagQueryableField)
+		}
+		if !isPartitionKeyType(i.PartitionKeyType) {
+			return fmt.Errorf("unsupported partition key type '%s'", i.PartitionKeyType)
+		}
+	default:
+		return fmt.Errorf("unsupported sync mode '%s'", i.Mode)
+	}
+
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+func (i enableInputs) syncConfig() realm.SyncConfig {
+	if i.Mode == modePartition {
+		return realm.SyncConfig{Partition: &realm.PartitionSyncConfig{
+			State:        realm.SyncStateEnabled,
+			DatabaseName: i.Database,
+			Partition: realm.SyncPartition{
+				Key:      i.PartitionKey,
+				Type:     i.PartitionKeyType,
+				Required: i.PartitionKeyRequired,
+			},
+		}}
+	}
+
+	queryableFields := i.QueryableFields
+	if queryableFields == nil {
+		queryableFields = []string{}
+	}
+	return realm.SyncConfig{Flexible: &realm.FlexibleSyncConfig{
+		State:                realm.SyncStateEnabled,
+		DatabaseName:         i.Database,
+		QueryableFieldsNames: queryableFields,
+	}}
+}
+
+func isPartitionKeyType(keyType string) bool {
+	for _, t := range partitionKeyTypes {
+		if t == keyType {
+			return true
+		}
+	}
+	return false
+}
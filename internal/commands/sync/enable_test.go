@@ -0,0 +1,130 @@
+package sync
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSyncEnableInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      enableInputs
+		err         error
+	}{
+		{
+			description: "should error without a database",
+			inputs:      enableInputs{dataSourceInputs: dataSourceInputs{defaultDataSource}, Mode: modeFlexible},
+			err:         errors.New(`must specify a database with "--database"`),
+		},
+		{
+			description: "should error with an unsupported mode",
+			inputs:      enableInputs{dataSourceInputs: dataSourceInputs{defaultDataSource}, Mode: "eggcorn", Database: "store"},
+			err:         errors.New("unsupported sync mode 'eggcorn'"),
+		},
+		{
+			description: "should error with a partition key for flexible sync",
+			inputs:      enableInputs{dataSourceInputs: dataSourceInputs{defaultDataSource}, Mode: modeFlexible, Database: "store", PartitionKey: "owner_id"},
+			err:         errors.New(`cannot use "--partition-key" with flexible sync`),
+		},
+		{
+			description: "should error without a partition key for partition-based sync",
+			inputs:      enableInputs{dataSourceInputs: dataSourceInputs{defaultDataSource}, Mode: modePartition, Database: "store", PartitionKeyType: "string"},
+			err:         errors.New(`must specify a partition key with "--partition-key"`),
+		},
+		{
+			description: "should error with an unsupported partition key type",
+			inputs:      enableInputs{dataSourceInputs: dataSourceInputs{defaultDataSource}, Mode: modePartition, Database: "store", PartitionKey: "owner_id", PartitionKeyType: "uuid"},
+			err:         errors.New("unsupported partition key type 'uuid'"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.err, tc.inputs.Resolve(profile, ui))
+		})
+	}
+}
+
+func TestSyncEnableHandler(t *testing.T) {
+	t.Run("should enable flexible sync with the queryable fields", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedConfigs []realm.SyncConfig
+		realmClient := newSyncRealmClient(realm.SyncConfig{}, &capturedConfigs)
+
+		cmd := &CommandEnable{enableInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			Mode:             modeFlexible,
+			Database:         "store",
+			QueryableFields:  []string{"owner_id", "status"},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully enabled flexible sync for data source 'mongodb-atlas' on database 'store'\n", out.String())
+		assert.Equal(t, []realm.SyncConfig{{Flexible: &realm.FlexibleSyncConfig{
+			State:                realm.SyncStateEnabled,
+			DatabaseName:         "store",
+			QueryableFieldsNames: []string{"owner_id", "status"},
+		}}}, capturedConfigs)
+	})
+
+	t.Run("should enable partition-based sync with the partition key", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedConfigs []realm.SyncConfig
+		realmClient := newSyncRealmClient(realm.SyncConfig{
+			Flexible: &realm.FlexibleSyncConfig{State: realm.SyncStateTerminated, DatabaseName: "store"},
+		}, &capturedConfigs)
+
+		cmd := &CommandEnable{enableInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+			Mode:             modePartition,
+			Database:         "store",
+			PartitionKey:     "owner_id",
+			PartitionKeyType: "objectId",
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully enabled partition sync for data source 'mongodb-atlas' on database 'store'\n", out.String())
+		assert.Equal(t, []realm.SyncConfig{{Partition: &realm.PartitionSyncConfig{
+			State:        realm.SyncStateEnabled,
+			DatabaseName: "store",
+			Partition:    realm.SyncPartition{Key: "owner_id", Type: "objectId"},
+		}}}, capturedConfigs)
+	})
+
+	for _, tc := range []struct {
+		state string
+		err   error
+	}{
+		{realm.SyncStateEnabled, errors.New("sync is already enabled for data source 'mongodb-atlas'")},
+		{realm.SyncStateDisabled, errors.New(`sync is paused for data source 'mongodb-atlas', resume it with "sync re-enable" or terminate it first`)},
+	} {
+		t.Run("should return an error when sync is "+displayState(tc.state), func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			var capturedConfigs []realm.SyncConfig
+			realmClient := newSyncRealmClient(realm.SyncConfig{
+				Flexible: &realm.FlexibleSyncConfig{State: tc.state, DatabaseName: "store"},
+			}, &capturedConfigs)
+
+			cmd := &CommandEnable{enableInputs{
+				ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+				dataSourceInputs: dataSourceInputs{defaultDataSource},
+				Mode:             modeFlexible,
+				Database:         "store",
+			}}
+
+			assert.Equal(t, tc.err, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, 0, len(capturedConfigs))
+		})
+	}
+}
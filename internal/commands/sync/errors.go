@@ -0,0 +1,32 @@
+package sync
+
+import (
+	"fmt"
+	"time"
+)
+
+// exitCodeProgressTimeout is the exit code when initial sync does not complete in time
+const exitCodeProgressTimeout = 2
+
+type errProgressTimeout struct {
+	timeout time.Duration
+}
+
+func (err errProgressTimeout) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for initial sync to complete", err.timeout)
+}
+
+func (err errProgressTimeout) DisableUsage() struct{} { return struct{}{} }
+
+func (err errProgressTimeout) ExitCode() int { return exitCodeProgressTimeout }
+
+type errInitialSyncFailed struct {
+	namespace string
+	message   string
+}
+
+func (err errInitialSyncFailed) Error() string {
+	return fmt.Sprintf("initial sync failed for namespace '%s': %s", err.namespace, err.message)
+}
+
+func (err errInitialSyncFailed) DisableUsage() struct{} { return struct{}{} }
@@ -0,0 +1,41 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+// Flag names and usages across the sync commands
+const (
+	flagDataSource      = "data-source"
+	flagDataSourceUsage = "specify the name of the data source to sync"
+
+	defaultDataSource = "mongodb-atlas"
+)
+
+type dataSourceInputs struct {
+	DataSource string
+}
+
+func (i dataSourceInputs) validate() error {
+	if i.DataSource == "" {
+		return fmt.Errorf(`must specify a data source with "--%s"`, flagDataSource)
+	}
+	return nil
+}
+
+// resolveService finds the app's service linked as the data source
+func resolveService(client realm.Client, groupID, appID, dataSource string) (realm.Service, error) {
+	services, err := client.Services(groupID, appID)
+	if err != nil {
+		return realm.Service{}, err
+	}
+
+	for _, service := range services {
+		if service.Name == dataSource {
+			return service, nil
+		}
+	}
+	return realm.Service{}, fmt.Errorf("data source '%s' does not exist", dataSource)
+}
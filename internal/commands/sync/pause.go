@@ -0,0 +1,81 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaPause is the command meta for the `sync pause` command
+var CommandMetaPause = cli.CommandMeta{
+	Use:         "pause",
+	Display:     "sync pause",
+	Description: "Pause Device Sync for a data source of your Realm app",
+	HelpText: `Pausing sync disconnects any synced clients until sync is resumed with
+"sync re-enable". Sync keeps its configuration and history while it is paused.`,
+}
+
+// CommandPause is the `sync pause` command
+type CommandPause struct {
+	inputs pauseInputs
+}
+
+type pauseInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandPause) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandPause) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandPause) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, service, config, err := resolveSync(ui, clients.Realm, cmd.inputs.Filter(), cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	setup, ok := describeSync(config)
+	if !ok {
+		return errSyncNotSetUp(service.Name)
+	}
+	if setup.state != realm.SyncStateEnabled {
+		return fmt.Errorf("sync is %s for data source '%s', only enabled sync can be paused", displayState(setup.state), service.Name)
+	}
+
+	proceed, err := ui.Confirm("This will disconnect any synced clients of data source '%s', are you sure you want to proceed?", service.Name)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	if err := clients.Realm.UpdateSyncConfig(app.GroupID, app.ID, service.ID, withState(config, setup, realm.SyncStateDisabled)); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully paused sync for data source '%s'", service.Name))
+	return nil
+}
+
+func (i *pauseInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
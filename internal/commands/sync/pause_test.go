@@ -0,0 +1,68 @@
+package sync
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSyncPauseHandler(t *testing.T) {
+	t.Run("should pause enabled sync", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		var capturedConfigs []realm.SyncConfig
+		realmClient := newSyncRealmClient(realm.SyncConfig{
+			Flexible: &realm.FlexibleSyncConfig{State: realm.SyncStateEnabled, DatabaseName: "store", QueryableFieldsNames: []string{"owner_id"}},
+		}, &capturedConfigs)
+
+		cmd := &CommandPause{pauseInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully paused sync for data source 'mongodb-atlas'\n", out.String())
+		assert.Equal(t, []realm.SyncConfig{{Flexible: &realm.FlexibleSyncConfig{
+			State:                realm.SyncStateDisabled,
+			DatabaseName:         "store",
+			QueryableFieldsNames: []string{"owner_id"},
+		}}}, capturedConfigs)
+	})
+
+	for _, tc := range []struct {
+		description string
+		config      realm.SyncConfig
+		err         error
+	}{
+		{
+			description: "should return an error when sync is not set up",
+			err:         errors.New(`sync has not been set up for data source 'mongodb-atlas', enable it with "sync enable"`),
+		},
+		{
+			description: "should return an error when sync is already paused",
+			config:      realm.SyncConfig{Partition: &realm.PartitionSyncConfig{State: realm.SyncStateDisabled, DatabaseName: "store"}},
+			err:         errors.New("sync is paused for data source 'mongodb-atlas', only enabled sync can be paused"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			var capturedConfigs []realm.SyncConfig
+			realmClient := newSyncRealmClient(tc.config, &capturedConfigs)
+
+			cmd := &CommandPause{pauseInputs{
+				ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+				dataSourceInputs: dataSourceInputs{defaultDataSource},
+			}}
+
+			assert.Equal(t, tc.err, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, 0, len(capturedConfigs))
+		})
+	}
+}
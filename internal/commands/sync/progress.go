@@ -0,0 +1,247 @@
+package sync
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagWait      = "wait"
+	flagWaitUsage = "include to keep polling the progress until initial sync completes"
+
+	flagTimeout      = "timeout"
+	flagTimeoutUsage = `the maximum amount of time to wait for with "--wait", e.g. 30s or 10m`
+
+	flagInterval      = "interval"
+	flagIntervalUsage = "the amount of time to wait between polling the progress"
+
+	defaultProgressTimeout  = 30 * time.Minute
+	defaultProgressInterval = 5 * time.Second
+
+	// progressBarWidth is the number of characters of a namespace's progress bar
+	progressBarWidth = 20
+
+	headerNamespace = "Namespace"
+	headerStatus    = "Status"
+	headerProgress  = "Progress"
+	headerDetails   = "Details"
+)
+
+// CommandMetaProgress is the command meta for the `sync progress` command
+var CommandMetaProgress = cli.CommandMeta{
+	Use:         "progress",
+	Display:     "sync progress",
+	Description: "Show the initial sync progress of your Realm app",
+	HelpText: `When Sync is enabled, the existing documents of each synced namespace are copied
+into the sync history before clients can sync them. This command shows the
+progress of this initial sync for each namespace, with a progress bar once the
+total number of documents of the namespace is known.
+
+Specify "--wait" to keep polling the progress until initial sync completes,
+which is useful to sequence the steps of a provisioning script. The command then
+exits with code 0 once initial sync completes, 1 if it fails for any namespace
+and 2 if it times out.`,
+}
+
+// CommandProgress is the `sync progress` command
+type CommandProgress struct {
+	inputs progressInputs
+}
+
+type progressInputs struct {
+	cli.ProjectInputs
+	Wait     bool
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+// Flags is the command flags
+func (cmd *CommandProgress) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.BoolVar(&cmd.inputs.Wait, flagWait, false, flagWaitUsage)
+	fs.DurationVar(&cmd.inputs.Timeout, flagTimeout, defaultProgressTimeout, flagTimeoutUsage)
+	fs.DurationVar(&cmd.inputs.Interval, flagInterval, defaultProgressInterval, flagIntervalUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandProgress) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandProgress) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	var progress realm.SyncProgress
+	var timeoutErr error
+	if cmd.inputs.Wait {
+		progress, err = cmd.waitForProgress(ui, clients.Realm, app)
+		if e, ok := err.(errProgressTimeout); ok {
+			timeoutErr, err = e, nil // still print the last progress polled
+		}
+	} else {
+		progress, err = clients.Realm.SyncProgress(app.GroupID, app.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(progress.Progress) == 0 {
+		ui.Print(terminal.NewTextLog("No initial sync is in progress"))
+		return nil
+	}
+
+	namespaces := sortedNamespaces(progress)
+
+	rows := make([]map[string]interface{}, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		rows = append(rows, progressRow(namespace, progress.Progress[namespace]))
+	}
+
+	completed, failed := countProgress(progress)
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Initial sync has completed for %d of %d namespace(s)", completed, len(namespaces)),
+		[]string{headerNamespace, headerStatus, headerProgress, headerDetails},
+		rows...,
+	))
+
+	if timeoutErr != nil {
+		return timeoutErr
+	}
+	if cmd.inputs.Wait && failed != "" {
+		return errInitialSyncFailed{failed, progress.Progress[failed].Error}
+	}
+	return nil
+}
+
+// waitForProgress polls the initial sync progress until it completes or fails for any namespace,
+// returning the last progress polled along with any timeout error
+func (cmd *CommandProgress) waitForProgress(ui terminal.UI, client realm.Client, app realm.App) (realm.SyncProgress, error) {
+	s := ui.Spinner("Waiting for initial sync to complete...")
+
+	s.Start()
+	defer s.Stop()
+
+	deadline := time.Now().Add(cmd.inputs.Timeout)
+	for {
+		progress, err := client.SyncProgress(app.GroupID, app.ID)
+		if err != nil {
+			return realm.SyncProgress{}, err
+		}
+
+		completed, failed := countProgress(progress)
+		if completed == len(progress.Progress) || failed != "" {
+			return progress, nil
+		}
+		s.Suffix = " Waiting for initial sync to complete... " + progressSummary(progress, completed)
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return progress, errProgressTimeout{cmd.inputs.Timeout}
+		}
+		if remaining > cmd.inputs.Interval {
+			remaining = cmd.inputs.Interval
+		}
+		time.Sleep(remaining)
+	}
+}
+
+func (i *progressInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Timeout <= 0 {
+		return fmt.Errorf(`"--%s" must be a positive duration`, flagTimeout)
+	}
+	if i.Interval <= 0 {
+		return fmt.Errorf(`"--%s" must be a positive duration`, flagInterval)
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// countProgress returns the number of namespaces whose initial sync has completed,
+// and the first namespace whose initial sync failed, if any
+func countProgress(progress realm.SyncProgress) (int, string) {
+	var completed int
+	var failed string
+	for _, namespace := range sortedNamespaces(progress) {
+		p := progress.Progress[namespace]
+		if p.Error != "" && failed == "" {
+			failed = namespace
+		}
+		if p.Complete {
+			completed++
+		}
+	}
+	return completed, failed
+}
+
+func sortedNamespaces(progress realm.SyncProgress) []string {
+	namespaces := make([]string, 0, len(progress.Progress))
+	for namespace := range progress.Progress {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// progressSummary summarizes the progress on a single line, for the live-updating display
+func progressSummary(progress realm.SyncProgress, completed int) string {
+	var copied, total int64
+	for _, p := range progress.Progress {
+		copied += p.DocumentsCopied
+		total += p.TotalDocuments
+	}
+
+	summary := fmt.Sprintf("%d/%d namespace(s) complete", completed, len(progress.Progress))
+	if total > 0 {
+		summary += ", " + progressBar(copied, total)
+	}
+	return summary
+}
+
+func progressRow(namespace string, p realm.SyncNamespaceProgress) map[string]interface{} {
+	status := "in progress"
+	if p.Error != "" {
+		status = "failed"
+	} else if p.Complete {
+		status = "complete"
+	}
+
+	row := map[string]interface{}{
+		headerNamespace: namespace,
+		headerStatus:    status,
+		headerProgress:  fmt.Sprintf("%d document(s) copied", p.DocumentsCopied),
+		headerDetails:   p.Error,
+	}
+	if p.TotalDocuments > 0 {
+		row[headerProgress] = progressBar(p.DocumentsCopied, p.TotalDocuments)
+	}
+	return row
+}
+
+// progressBar renders the number of documents copied out of the total, e.g. "[#####---------------] 25%"
+func progressBar(copied, total int64) string {
+	ratio := float64(copied) / float64(total)
+	if ratio > 1 {
+		ratio = 1
+	}
+
+	filled := int(ratio * progressBarWidth)
+	return fmt.Sprintf(
+		"[%s%s] %d%%",
+		strings.Repeat("#", filled),
+		strings.Repeat("-", progressBarWidth-filled),
+		int(ratio*100),
+	)
+}
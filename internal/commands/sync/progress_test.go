@@ -0,0 +1,140 @@
+package sync
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSyncProgressInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      progressInputs
+		err         error
+	}{
+		{
+			description: "should error without a positive timeout",
+			inputs:      progressInputs{Interval: time.Second},
+			err:         errors.New(`"--timeout" must be a positive duration`),
+		},
+		{
+			description: "should error without a positive interval",
+			inputs:      progressInputs{Timeout: time.Second},
+			err:         errors.New(`"--interval" must be a positive duration`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+			_, ui := mock.NewUI()
+
+			assert.Equal(t, tc.err, tc.inputs.Resolve(profile, ui))
+		})
+	}
+}
+
+func TestSyncProgressHandler(t *testing.T) {
+	newRealmClient := func(progresses ...realm.SyncProgress) (mock.RealmClient, *int) {
+		var polls int
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "projectID"}}, nil
+		}
+		realmClient.SyncProgressFn = func(groupID, appID string) (realm.SyncProgress, error) {
+			progress := progresses[polls]
+			if polls < len(progresses)-1 {
+				polls++
+			}
+			return progress, nil
+		}
+		return realmClient, &polls
+	}
+
+	inProgress := realm.SyncProgress{Progress: map[string]realm.SyncNamespaceProgress{
+		"store.orders": {DocumentsCopied: 250, TotalDocuments: 1000},
+		"store.items":  {DocumentsCopied: 40},
+	}}
+
+	t.Run("should print the current progress of each namespace", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, _ := newRealmClient(inProgress)
+
+		cmd := &CommandProgress{progressInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Initial sync has completed for 0 of 2 namespace(s)
+  Namespace     Status       Progress                    Details
+  ------------  -----------  --------------------------  -------
+  store.items   in progress  40 document(s) copied              
+  store.orders  in progress  [#####---------------] 25%         
+`, out.String())
+	})
+
+	t.Run("should print a message when no initial sync is in progress", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient, _ := newRealmClient(realm.SyncProgress{})
+
+		cmd := &CommandProgress{progressInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No initial sync is in progress\n", out.String())
+	})
+
+	t.Run("with wait set", func(t *testing.T) {
+		newCommand := func(timeout time.Duration) *CommandProgress {
+			return &CommandProgress{progressInputs{
+				ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"},
+				Wait:          true,
+				Timeout:       timeout,
+				Interval:      time.Millisecond,
+			}}
+		}
+
+		t.Run("should poll the progress until initial sync completes", func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient, polls := newRealmClient(inProgress, realm.SyncProgress{Progress: map[string]realm.SyncNamespaceProgress{
+				"store.orders": {Complete: true, DocumentsCopied: 1000, TotalDocuments: 1000},
+				"store.items":  {Complete: true, DocumentsCopied: 80},
+			}})
+
+			assert.Nil(t, newCommand(time.Minute).Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, `Initial sync has completed for 2 of 2 namespace(s)
+  Namespace     Status    Progress                     Details
+  ------------  --------  ---------------------------  -------
+  store.items   complete  80 document(s) copied               
+  store.orders  complete  [####################] 100%         
+`, out.String())
+			assert.Equal(t, 1, *polls)
+		})
+
+		t.Run("should return an error when initial sync fails for a namespace", func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			realmClient, _ := newRealmClient(inProgress, realm.SyncProgress{Progress: map[string]realm.SyncNamespaceProgress{
+				"store.orders": {Error: "invalid schema", DocumentsCopied: 300, TotalDocuments: 1000},
+				"store.items":  {Complete: true, DocumentsCopied: 80},
+			}})
+
+			err := newCommand(time.Minute).Handler(nil, ui, cli.Clients{Realm: realmClient})
+			assert.Equal(t, errInitialSyncFailed{"store.orders", "invalid schema"}, err)
+			assert.Equal(t, "initial sync failed for namespace 'store.orders': invalid schema", err.Error())
+		})
+
+		t.Run("should return a timeout error when initial sync does not complete in time", func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			realmClient, _ := newRealmClient(inProgress)
+
+			err := newCommand(5*time.Millisecond).Handler(nil, ui, cli.Clients{Realm: realmClient})
+			assert.Equal(t, errProgressTimeout{5 * time.Millisecond}, err)
+			assert.Equal(t, exitCodeProgressTimeout, err.(errProgressTimeout).ExitCode())
+		})
+	})
+}
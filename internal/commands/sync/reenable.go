@@ -0,0 +1,80 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaReenable is the command meta for the `sync re-enable` command
+var CommandMetaReenable = cli.CommandMeta{
+	Use:         "re-enable",
+	Aliases:     []string{"resume"},
+	Display:     "sync re-enable",
+	Description: "Re-enable paused or terminated Device Sync for a data source of your Realm app",
+	HelpText: `Resumes sync with the configuration it had when it was paused or terminated.
+To sync a different database or change the sync mode, terminate sync and then
+use "sync enable" instead.`,
+}
+
+// CommandReenable is the `sync re-enable` command
+type CommandReenable struct {
+	inputs reenableInputs
+}
+
+type reenableInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandReenable) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandReenable) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandReenable) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, service, config, err := resolveSync(ui, clients.Realm, cmd.inputs.Filter(), cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	setup, ok := describeSync(config)
+	if !ok {
+		return errSyncNotSetUp(service.Name)
+	}
+	if setup.state == realm.SyncStateEnabled {
+		return fmt.Errorf("sync is already enabled for data source '%s'", service.Name)
+	}
+
+	if err := clients.Realm.UpdateSyncConfig(app.GroupID, app.ID, service.ID, withState(config, setup, realm.SyncStateEnabled)); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"Successfully re-enabled %s sync for data source '%s' on database '%s'",
+		setup.mode,
+		service.Name,
+		setup.database,
+	))
+	return nil
+}
+
+func (i *reenableInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
@@ -0,0 +1,54 @@
+package sync
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSyncReenableHandler(t *testing.T) {
+	for _, state := range []string{realm.SyncStateDisabled, realm.SyncStateTerminated} {
+		t.Run("should re-enable sync which is "+displayState(state), func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			var capturedConfigs []realm.SyncConfig
+			realmClient := newSyncRealmClient(realm.SyncConfig{
+				Flexible: &realm.FlexibleSyncConfig{State: state, DatabaseName: "store", QueryableFieldsNames: []string{"owner_id"}},
+			}, &capturedConfigs)
+
+			cmd := &CommandReenable{reenableInputs{
+				ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+				dataSourceInputs: dataSourceInputs{defaultDataSource},
+			}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, "Successfully re-enabled flexible sync for data source 'mongodb-atlas' on database 'store'\n", out.String())
+			assert.Equal(t, []realm.SyncConfig{{Flexible: &realm.FlexibleSyncConfig{
+				State:                realm.SyncStateEnabled,
+				DatabaseName:         "store",
+				QueryableFieldsNames: []string{"owner_id"},
+			}}}, capturedConfigs)
+		})
+	}
+
+	t.Run("should return an error when sync is already enabled", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		var capturedConfigs []realm.SyncConfig
+		realmClient := newSyncRealmClient(realm.SyncConfig{
+			Partition: &realm.PartitionSyncConfig{State: realm.SyncStateEnabled, DatabaseName: "store"},
+		}, &capturedConfigs)
+
+		cmd := &CommandReenable{reenableInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+		}}
+
+		assert.Equal(t, errors.New("sync is already enabled for data source 'mongodb-atlas'"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, 0, len(capturedConfigs))
+	})
+}
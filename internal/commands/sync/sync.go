@@ -0,0 +1,89 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// set of supported sync modes
+const (
+	modeFlexible  = "flexible"
+	modePartition = "partition"
+)
+
+// syncSetup describes the sync set up for a data source
+type syncSetup struct {
+	mode     string
+	state    string
+	database string
+}
+
+// describeSync returns the sync set up for a data source, if any
+func describeSync(config realm.SyncConfig) (syncSetup, bool) {
+	var setups []syncSetup
+	if config.Flexible != nil && config.Flexible.DatabaseName != "" {
+		setups = append(setups, syncSetup{modeFlexible, config.Flexible.State, config.Flexible.DatabaseName})
+	}
+	if config.Partition != nil && config.Partition.DatabaseName != "" {
+		setups = append(setups, syncSetup{modePartition, config.Partition.State, config.Partition.DatabaseName})
+	}
+
+	if len(setups) == 0 {
+		return syncSetup{}, false
+	}
+	for _, setup := range setups {
+		if setup.state != realm.SyncStateTerminated {
+			return setup, true // a data source only runs one mode of sync at a time
+		}
+	}
+	return setups[0], true
+}
+
+// withState returns the sync config of the mode set up with its state changed,
+// leaving out the config of the other mode so it is left as is
+func withState(config realm.SyncConfig, setup syncSetup, state string) realm.SyncConfig {
+	if setup.mode == modeFlexible {
+		flexible := *config.Flexible
+		flexible.State = state
+		return realm.SyncConfig{Flexible: &flexible}
+	}
+	partition := *config.Partition
+	partition.State = state
+	return realm.SyncConfig{Partition: &partition}
+}
+
+func displayState(state string) string {
+	switch state {
+	case realm.SyncStateEnabled:
+		return "enabled"
+	case realm.SyncStateDisabled:
+		return "paused"
+	}
+	return "terminated"
+}
+
+// resolveSync finds the app, its service linked as the data source and the service's sync config
+func resolveSync(ui terminal.UI, client realm.Client, filter realm.AppFilter, dataSource string) (realm.App, realm.Service, realm.SyncConfig, error) {
+	app, err := cli.ResolveApp(ui, client, filter)
+	if err != nil {
+		return realm.App{}, realm.Service{}, realm.SyncConfig{}, err
+	}
+
+	service, err := resolveService(client, app.GroupID, app.ID, dataSource)
+	if err != nil {
+		return realm.App{}, realm.Service{}, realm.SyncConfig{}, err
+	}
+
+	config, err := client.SyncConfig(app.GroupID, app.ID, service.ID)
+	if err != nil {
+		return realm.App{}, realm.Service{}, realm.SyncConfig{}, err
+	}
+	return app, service, config, nil
+}
+
+func errSyncNotSetUp(dataSource string) error {
+	return fmt.Errorf(`sync has not been set up for data source '%s', enable it with "sync enable"`, dataSource)
+}
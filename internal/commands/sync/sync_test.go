@@ -0,0 +1,64 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func newSyncRealmClient(config realm.SyncConfig, capturedConfigs *[]realm.SyncConfig) mock.RealmClient {
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}}, nil
+	}
+	realmClient.ServicesFn = func(groupID, appID string) ([]realm.Service, error) {
+		return []realm.Service{{ID: "service1", Name: defaultDataSource, Type: "mongodb-atlas"}}, nil
+	}
+	realmClient.SyncConfigFn = func(groupID, appID, serviceID string) (realm.SyncConfig, error) {
+		return config, nil
+	}
+	realmClient.UpdateSyncConfigFn = func(groupID, appID, serviceID string, config realm.SyncConfig) error {
+		*capturedConfigs = append(*capturedConfigs, config)
+		return nil
+	}
+	return realmClient
+}
+
+func TestDescribeSync(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		config      realm.SyncConfig
+		setup       syncSetup
+		ok          bool
+	}{
+		{
+			description: "should describe no sync when neither mode has a database",
+			config:      realm.SyncConfig{Flexible: &realm.FlexibleSyncConfig{}},
+		},
+		{
+			description: "should describe flexible sync",
+			config:      realm.SyncConfig{Flexible: &realm.FlexibleSyncConfig{State: realm.SyncStateDisabled, DatabaseName: "store"}},
+			setup:       syncSetup{modeFlexible, realm.SyncStateDisabled, "store"},
+			ok:          true,
+		},
+		{
+			description: "should describe the mode which is not terminated when both modes are set up",
+			config: realm.SyncConfig{
+				Flexible:  &realm.FlexibleSyncConfig{State: realm.SyncStateTerminated, DatabaseName: "store"},
+				Partition: &realm.PartitionSyncConfig{State: realm.SyncStateEnabled, DatabaseName: "orders"},
+			},
+			setup: syncSetup{modePartition, realm.SyncStateEnabled, "orders"},
+			ok:    true,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			setup, ok := describeSync(tc.config)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.setup.mode, setup.mode)
+			assert.Equal(t, tc.setup.state, setup.state)
+			assert.Equal(t, tc.setup.database, setup.database)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaTerminate is the command meta for the `sync terminate` command
+var CommandMetaTerminate = cli.CommandMeta{
+	Use:         "terminate",
+	Display:     "sync terminate",
+	Description: "Terminate Device Sync for a data source of your Realm app",
+	HelpText: `Terminating sync deletes its history, so any synced clients must perform a
+client reset once sync is re-enabled. The data in the synced database is not
+deleted.`,
+}
+
+// CommandTerminate is the `sync terminate` command
+type CommandTerminate struct {
+	inputs terminateInputs
+}
+
+type terminateInputs struct {
+	cli.ProjectInputs
+	dataSourceInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandTerminate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.DataSource, flagDataSource, defaultDataSource, flagDataSourceUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandTerminate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandTerminate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, service, config, err := resolveSync(ui, clients.Realm, cmd.inputs.Filter(), cmd.inputs.DataSource)
+	if err != nil {
+		return err
+	}
+
+	setup, ok := describeSync(config)
+	if !ok {
+		return errSyncNotSetUp(service.Name)
+	}
+	if setup.state == realm.SyncStateTerminated {
+		return fmt.Errorf("sync is already terminated for data source '%s'", service.Name)
+	}
+
+	proceed, err := ui.Confirm(
+		"This will delete the sync history of data source '%s' and require any synced clients to perform a client reset, are you sure you want to proceed?",
+		service.Name,
+	)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	if err := clients.Realm.UpdateSyncConfig(app.GroupID, app.ID, service.ID, withState(config, setup, realm.SyncStateTerminated)); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully terminated sync for data source '%s'", service.Name))
+	return nil
+}
+
+func (i *terminateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.dataSourceInputs.validate(); err != nil {
+		return err
+	}
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
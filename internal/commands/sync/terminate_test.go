@@ -0,0 +1,59 @@
+package sync
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestSyncTerminateHandler(t *testing.T) {
+	t.Run("should terminate paused sync and keep its configuration", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		var capturedConfigs []realm.SyncConfig
+		realmClient := newSyncRealmClient(realm.SyncConfig{
+			Flexible: &realm.FlexibleSyncConfig{State: realm.SyncStateTerminated, DatabaseName: "archive"},
+			Partition: &realm.PartitionSyncConfig{
+				State:        realm.SyncStateDisabled,
+				DatabaseName: "store",
+				Partition:    realm.SyncPartition{Key: "owner_id", Type: "string"},
+			},
+		}, &capturedConfigs)
+
+		cmd := &CommandTerminate{terminateInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully terminated sync for data source 'mongodb-atlas'\n", out.String())
+		assert.Equal(t, []realm.SyncConfig{{Partition: &realm.PartitionSyncConfig{
+			State:        realm.SyncStateTerminated,
+			DatabaseName: "store",
+			Partition:    realm.SyncPartition{Key: "owner_id", Type: "string"},
+		}}}, capturedConfigs)
+	})
+
+	t.Run("should return an error when sync is already terminated", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		var capturedConfigs []realm.SyncConfig
+		realmClient := newSyncRealmClient(realm.SyncConfig{
+			Flexible: &realm.FlexibleSyncConfig{State: realm.SyncStateTerminated, DatabaseName: "store"},
+		}, &capturedConfigs)
+
+		cmd := &CommandTerminate{terminateInputs{
+			ProjectInputs:    cli.ProjectInputs{Project: "projectID", App: "appID"},
+			dataSourceInputs: dataSourceInputs{defaultDataSource},
+		}}
+
+		assert.Equal(t, errors.New("sync is already terminated for data source 'mongodb-atlas'"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, 0, len(capturedConfigs))
+	})
+}
@@ -0,0 +1,51 @@
+package trigger
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaDisable is the command meta for the `triggers disable` command
+var CommandMetaDisable = cli.CommandMeta{
+	Use:         "disable",
+	Display:     "triggers disable",
+	Description: "Disable Triggers of your Realm app",
+	HelpText: `Disables the Triggers specified with "--trigger" flags by their name or ID, or
+the ones selected from the enabled Triggers of your Realm app. The change
+takes effect immediately, without pushing your local app, so a misbehaving
+Trigger stops firing right away.
+
+Note that the next push of a local app which has the Trigger enabled will
+enable it again.`,
+}
+
+// CommandDisable is the `triggers disable` command
+type CommandDisable struct {
+	inputs  triggersInputs
+	updated int
+}
+
+// Flags is the command flags
+func (cmd *CommandDisable) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.flags(fs, flagTriggerUsageDisable)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDisable) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDisable) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	updated, err := setTriggersDisabled(ui, clients, cmd.inputs, true)
+	cmd.updated = updated
+	return err
+}
+
+// ResourcesAffected is the number of triggers disabled
+func (cmd *CommandDisable) ResourcesAffected() int {
+	return cmd.updated
+}
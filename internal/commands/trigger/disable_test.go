@@ -0,0 +1,53 @@
+package trigger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestTriggersDisableHandler(t *testing.T) {
+	t.Run("should disable the triggers and report the ones which are not updated", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient(testTriggers)
+
+		var updated []realm.Trigger
+		realmClient.UpdateTriggerFn = func(groupID, appID string, trigger realm.Trigger) error {
+			if trigger.ID == "trigger3" {
+				return errors.New("something bad happened")
+			}
+			updated = append(updated, trigger)
+			return nil
+		}
+
+		cmd := &CommandDisable{inputs: triggersInputs{Triggers: []string{"onNewOrder", "nightlyReport", "onSignup"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Successfully disabled 1 of 3 trigger(s)
+  ID        Name           State     Details                    
+  --------  -------------  --------  ---------------------------
+  trigger1  onNewOrder     disabled                             
+  trigger2  nightlyReport  disabled  trigger is already disabled
+  trigger3  onSignup       enabled   something bad happened     
+`, out.String())
+
+		expected := testTriggers[0]
+		expected.Disabled = true
+		assert.Equal(t, []realm.Trigger{expected}, updated)
+		assert.Equal(t, 1, cmd.ResourcesAffected())
+	})
+
+	t.Run("should print a message when there are no triggers to disable", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDisable{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: newTriggersRealmClient(nil)}))
+		assert.Equal(t, "No triggers to disable\n", out.String())
+	})
+}
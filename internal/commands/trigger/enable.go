@@ -0,0 +1,47 @@
+package trigger
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaEnable is the command meta for the `triggers enable` command
+var CommandMetaEnable = cli.CommandMeta{
+	Use:         "enable",
+	Display:     "triggers enable",
+	Description: "Enable Triggers of your Realm app",
+	HelpText: `Enables the Triggers specified with "--trigger" flags by their name or ID, or
+the ones selected from the disabled Triggers of your Realm app. The change
+takes effect immediately, without pushing your local app.`,
+}
+
+// CommandEnable is the `triggers enable` command
+type CommandEnable struct {
+	inputs  triggersInputs
+	updated int
+}
+
+// Flags is the command flags
+func (cmd *CommandEnable) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.flags(fs, flagTriggerUsageEnable)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandEnable) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandEnable) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	updated, err := setTriggersDisabled(ui, clients, cmd.inputs, false)
+	cmd.updated = updated
+	return err
+}
+
+// ResourcesAffected is the number of triggers enabled
+func (cmd *CommandEnable) ResourcesAffected() int {
+	return cmd.updated
+}
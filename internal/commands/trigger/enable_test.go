@@ -0,0 +1,42 @@
+package trigger
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestTriggersEnableHandler(t *testing.T) {
+	t.Run("should enable the triggers", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient(testTriggers)
+
+		var capturedGroupID, capturedAppID string
+		var updated []realm.Trigger
+		realmClient.UpdateTriggerFn = func(groupID, appID string, trigger realm.Trigger) error {
+			capturedGroupID, capturedAppID = groupID, appID
+			updated = append(updated, trigger)
+			return nil
+		}
+
+		cmd := &CommandEnable{inputs: triggersInputs{Triggers: []string{"trigger2"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Successfully enabled 1 of 1 trigger(s)
+  ID        Name           State    Details
+  --------  -------------  -------  -------
+  trigger2  nightlyReport  enabled         
+`, out.String())
+
+		expected := testTriggers[1]
+		expected.Disabled = false
+		assert.Equal(t, []realm.Trigger{expected}, updated)
+		assert.Equal(t, 1, cmd.ResourcesAffected())
+		assert.Equal(t, "projectID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+	})
+}
@@ -0,0 +1,122 @@
+package trigger
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// Flag names and usages across the trigger commands
+const (
+	flagTrigger             = "trigger"
+	flagTriggerShort        = "t"
+	flagTriggerUsageEnable  = "the name or id of the trigger to enable"
+	flagTriggerUsageDisable = "the name or id of the trigger to disable"
+)
+
+// set of trigger states
+const (
+	stateEnabled  = "enabled"
+	stateDisabled = "disabled"
+)
+
+type triggersInputs struct {
+	cli.ProjectInputs
+	Triggers []string
+}
+
+func (i *triggersInputs) flags(fs *pflag.FlagSet, usage string) {
+	i.Flags(fs)
+	fs.StringSliceVarP(&i.Triggers, flagTrigger, flagTriggerShort, []string{}, usage)
+}
+
+func (i *triggersInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// resolveTriggers finds the specified triggers by name or id, otherwise prompts
+// for the triggers to select out of the ones which are not in the target state yet
+func (i triggersInputs) resolveTriggers(ui terminal.UI, appTriggers []realm.Trigger, disabled bool) ([]realm.Trigger, error) {
+	if len(i.Triggers) > 0 {
+		triggers := make([]realm.Trigger, 0, len(i.Triggers))
+		for _, identifier := range i.Triggers {
+			trigger, ok := findTrigger(appTriggers, identifier)
+			if !ok {
+				return nil, fmt.Errorf("unable to find trigger '%s'", identifier)
+			}
+			triggers = append(triggers, trigger)
+		}
+		return triggers, nil
+	}
+
+	options := make([]string, 0, len(appTriggers))
+	triggersByOption := map[string]realm.Trigger{}
+	for _, trigger := range appTriggers {
+		if trigger.Disabled == disabled {
+			continue
+		}
+		option := displayTriggerOption(trigger)
+
+		options = append(options, option)
+		triggersByOption[option] = trigger
+	}
+
+	if len(options) == 0 {
+		return nil, nil
+	}
+
+	var selections []string
+	if err := ui.AskOne(
+		&selections,
+		&survey.MultiSelect{
+			Message: fmt.Sprintf("Which trigger(s) would you like to %s?", displayAction(disabled)),
+			Options: options,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	triggers := make([]realm.Trigger, 0, len(selections))
+	for _, selection := range selections {
+		triggers = append(triggers, triggersByOption[selection])
+	}
+	return triggers, nil
+}
+
+func findTrigger(triggers []realm.Trigger, identifier string) (realm.Trigger, bool) {
+	for _, trigger := range triggers {
+		if trigger.Name == identifier {
+			return trigger, true
+		}
+	}
+	for _, trigger := range triggers {
+		if trigger.ID == identifier {
+			return trigger, true
+		}
+	}
+	return realm.Trigger{}, false
+}
+
+func displayTriggerOption(trigger realm.Trigger) string {
+	return trigger.ID + terminal.DelimiterInline + trigger.Name
+}
+
+func displayState(disabled bool) string {
+	if disabled {
+		return stateDisabled
+	}
+	return stateEnabled
+}
+
+func displayAction(disabled bool) string {
+	if disabled {
+		return "disable"
+	}
+	return "enable"
+}
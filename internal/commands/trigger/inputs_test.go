@@ -0,0 +1,63 @@
+package trigger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+var testTriggers = []realm.Trigger{
+	{ID: "trigger1", Name: "onNewOrder", Type: realm.TriggerTypeDatabase, FunctionName: "handleOrder"},
+	{ID: "trigger2", Name: "nightlyReport", Type: realm.TriggerTypeScheduled, FunctionName: "sendReport", Disabled: true},
+	{ID: "trigger3", Name: "onSignup", Type: realm.TriggerTypeAuthentication, FunctionID: "function3"},
+}
+
+func TestTriggersInputsResolveTriggers(t *testing.T) {
+	t.Run("should find the triggers specified by name or id", func(t *testing.T) {
+		inputs := triggersInputs{Triggers: []string{"onSignup", "trigger1"}}
+
+		triggers, err := inputs.resolveTriggers(nil, testTriggers, true)
+		assert.Nil(t, err)
+		assert.Equal(t, []realm.Trigger{testTriggers[2], testTriggers[0]}, triggers)
+	})
+
+	t.Run("should return an error when a specified trigger does not exist", func(t *testing.T) {
+		inputs := triggersInputs{Triggers: []string{"onNewOrder", "onDelete"}}
+
+		_, err := inputs.resolveTriggers(nil, testTriggers, true)
+		assert.Equal(t, errors.New("unable to find trigger 'onDelete'"), err)
+	})
+
+	t.Run("should prompt for the triggers which are not yet in the target state", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Which trigger(s) would you like to enable?")
+			console.Send("nightlyReport")
+			console.Send(" ")
+			console.SendLine("")
+			console.ExpectEOF()
+		}()
+
+		triggers, err := triggersInputs{}.resolveTriggers(ui, testTriggers, false)
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, []realm.Trigger{testTriggers[1]}, triggers)
+	})
+
+	t.Run("should not prompt when all triggers are in the target state", func(t *testing.T) {
+		triggers, err := triggersInputs{}.resolveTriggers(nil, testTriggers[1:2], true)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(triggers))
+	})
+}
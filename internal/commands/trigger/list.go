@@ -0,0 +1,102 @@
+package trigger
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	headerID       = "ID"
+	headerName     = "Name"
+	headerType     = "Type"
+	headerFunction = "Function"
+	headerState    = "State"
+	headerDetails  = "Details"
+)
+
+// CommandMetaList is the command meta for the `triggers list` command
+var CommandMetaList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "triggers list",
+	Description: "List the Triggers of your Realm app",
+	HelpText: `Displays the Triggers of your Realm app along with their type, the Function
+they run and whether they are currently enabled or disabled.`,
+}
+
+// CommandList is the `triggers list` command
+type CommandList struct {
+	inputs listInputs
+}
+
+type listInputs struct {
+	cli.ProjectInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	triggers, err := clients.Realm.Triggers(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(triggers) == 0 {
+		ui.Print(terminal.NewTextLog("No available triggers to show"))
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(triggers))
+	for _, trigger := range triggers {
+		rows = append(rows, map[string]interface{}{
+			headerID:       trigger.ID,
+			headerName:     trigger.Name,
+			headerType:     trigger.Type,
+			headerFunction: displayFunction(trigger),
+			headerState:    displayState(trigger.Disabled),
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d trigger(s)", len(triggers)),
+		[]string{headerID, headerName, headerType, headerFunction, headerState},
+		rows...,
+	))
+	return nil
+}
+
+func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// displayFunction returns the function run by the trigger,
+// where triggers sending their events to AWS EventBridge run none
+func displayFunction(trigger realm.Trigger) string {
+	switch {
+	case trigger.FunctionName != "":
+		return trigger.FunctionName
+	case trigger.FunctionID != "":
+		return trigger.FunctionID
+	}
+	return "n/a"
+}
@@ -0,0 +1,64 @@
+package trigger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func newTriggersRealmClient(triggers []realm.Trigger) mock.RealmClient {
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{{ID: "appID", GroupID: "projectID"}}, nil
+	}
+	realmClient.TriggersFn = func(groupID, appID string) ([]realm.Trigger, error) {
+		return triggers, nil
+	}
+	return realmClient
+}
+
+func TestTriggersListHandler(t *testing.T) {
+	t.Run("should list the triggers with their function and state", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient(append(testTriggers, realm.Trigger{ID: "trigger4", Name: "toEventBridge", Type: realm.TriggerTypeDatabase}))
+
+		cmd := &CommandList{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Found 4 trigger(s)
+  ID        Name           Type            Function     State   
+  --------  -------------  --------------  -----------  --------
+  trigger1  onNewOrder     DATABASE        handleOrder  enabled 
+  trigger2  nightlyReport  SCHEDULED       sendReport   disabled
+  trigger3  onSignup       AUTHENTICATION  function3    enabled 
+  trigger4  toEventBridge  DATABASE        n/a          enabled 
+`, out.String())
+	})
+
+	t.Run("should print a message when the app has no triggers", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandList{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: newTriggersRealmClient(nil)}))
+		assert.Equal(t, "No available triggers to show\n", out.String())
+	})
+
+	t.Run("should return an error when the triggers fail to be listed", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient(nil)
+		realmClient.TriggersFn = func(groupID, appID string) ([]realm.Trigger, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandList{}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
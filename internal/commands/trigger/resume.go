@@ -0,0 +1,129 @@
+package trigger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagTriggerUsageResume = "the name or id of the database trigger to resume"
+
+	flagProcessMissedEvents      = "process-missed-events"
+	flagProcessMissedEventsUsage = "include to process the change events which occurred while the trigger was suspended"
+)
+
+// CommandMetaResume is the command meta for the `triggers resume` command
+var CommandMetaResume = cli.CommandMeta{
+	Use:         "resume",
+	Display:     "triggers resume",
+	Description: "Resume a suspended database Trigger of your Realm app",
+	HelpText: `Resumes a database Trigger which was suspended, for example after its cluster
+was unavailable or its change stream failed.
+
+By default, the Trigger resumes from the current time and the change events
+which occurred while it was suspended are skipped. Use "--process-missed-events"
+to process them as well, which is only possible while they are still in the
+cluster's oplog.`,
+}
+
+// CommandResume is the `triggers resume` command
+type CommandResume struct {
+	inputs resumeInputs
+}
+
+type resumeInputs struct {
+	cli.ProjectInputs
+	Trigger             string
+	ProcessMissedEvents bool
+}
+
+// Flags is the command flags
+func (cmd *CommandResume) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Trigger, flagTrigger, flagTriggerShort, "", flagTriggerUsageResume)
+	fs.BoolVar(&cmd.inputs.ProcessMissedEvents, flagProcessMissedEvents, false, flagProcessMissedEventsUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandResume) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandResume) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	triggers, err := clients.Realm.Triggers(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	trigger, err := cmd.inputs.resolveTrigger(ui, triggers)
+	if err != nil {
+		return err
+	}
+
+	if err := clients.Realm.ResumeTrigger(app.GroupID, app.ID, trigger.ID, !cmd.inputs.ProcessMissedEvents); err != nil {
+		return err
+	}
+
+	if cmd.inputs.ProcessMissedEvents {
+		ui.Print(terminal.NewTextLog("Successfully resumed trigger '%s', which processes the events missed while it was suspended", trigger.Name))
+	} else {
+		ui.Print(terminal.NewTextLog("Successfully resumed trigger '%s' from the current time", trigger.Name))
+	}
+	return nil
+}
+
+func (i *resumeInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// resolveTrigger finds the specified trigger by name or id,
+// otherwise prompts for the database trigger to resume
+func (i resumeInputs) resolveTrigger(ui terminal.UI, triggers []realm.Trigger) (realm.Trigger, error) {
+	if i.Trigger != "" {
+		trigger, ok := findTrigger(triggers, i.Trigger)
+		if !ok {
+			return realm.Trigger{}, fmt.Errorf("unable to find trigger '%s'", i.Trigger)
+		}
+		if trigger.Type != realm.TriggerTypeDatabase {
+			return realm.Trigger{}, fmt.Errorf("trigger '%s' cannot be resumed, as only database triggers are suspended", trigger.Name)
+		}
+		return trigger, nil
+	}
+
+	options := make([]string, 0, len(triggers))
+	triggersByOption := map[string]realm.Trigger{}
+	for _, trigger := range triggers {
+		if trigger.Type != realm.TriggerTypeDatabase {
+			continue
+		}
+		option := displayTriggerOption(trigger)
+
+		options = append(options, option)
+		triggersByOption[option] = trigger
+	}
+
+	if len(options) == 0 {
+		return realm.Trigger{}, errors.New("no database triggers found to resume")
+	}
+
+	var selection string
+	if err := ui.AskOne(&selection, &survey.Select{Message: "Which trigger would you like to resume?", Options: options}); err != nil {
+		return realm.Trigger{}, err
+	}
+	return triggersByOption[selection], nil
+}
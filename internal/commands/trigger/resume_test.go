@@ -0,0 +1,110 @@
+package trigger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestTriggersResumeHandler(t *testing.T) {
+	for _, tc := range []struct {
+		description         string
+		processMissedEvents bool
+		disableToken        bool
+		output              string
+	}{
+		{
+			description:  "should resume the trigger from the current time",
+			disableToken: true,
+			output:       "Successfully resumed trigger 'onNewOrder' from the current time\n",
+		},
+		{
+			description:         "should resume the trigger and process the missed events",
+			processMissedEvents: true,
+			output:              "Successfully resumed trigger 'onNewOrder', which processes the events missed while it was suspended\n",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := newTriggersRealmClient(testTriggers)
+
+			var capturedGroupID, capturedAppID, capturedTriggerID string
+			var capturedDisableToken bool
+			realmClient.ResumeTriggerFn = func(groupID, appID, triggerID string, disableToken bool) error {
+				capturedGroupID, capturedAppID, capturedTriggerID = groupID, appID, triggerID
+				capturedDisableToken = disableToken
+				return nil
+			}
+
+			cmd := &CommandResume{resumeInputs{Trigger: "onNewOrder", ProcessMissedEvents: tc.processMissedEvents}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.output, out.String())
+
+			assert.Equal(t, "projectID", capturedGroupID)
+			assert.Equal(t, "appID", capturedAppID)
+			assert.Equal(t, "trigger1", capturedTriggerID)
+			assert.Equal(t, tc.disableToken, capturedDisableToken)
+		})
+	}
+
+	t.Run("should return an error when the trigger is not a database trigger", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandResume{resumeInputs{Trigger: "trigger2"}}
+
+		assert.Equal(t,
+			errors.New("trigger 'nightlyReport' cannot be resumed, as only database triggers are suspended"),
+			cmd.Handler(nil, ui, cli.Clients{Realm: newTriggersRealmClient(testTriggers)}),
+		)
+	})
+
+	t.Run("should return an error when the trigger fails to resume", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient(testTriggers)
+		realmClient.ResumeTriggerFn = func(groupID, appID, triggerID string, disableToken bool) error {
+			return errors.New("resume token is no longer in the oplog")
+		}
+
+		cmd := &CommandResume{resumeInputs{Trigger: "trigger1", ProcessMissedEvents: true}}
+
+		assert.Equal(t, errors.New("resume token is no longer in the oplog"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
+
+func TestTriggersResumeInputsResolveTrigger(t *testing.T) {
+	t.Run("should prompt for the database trigger to resume", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		triggers := append([]realm.Trigger{{ID: "trigger0", Name: "onUpdate", Type: realm.TriggerTypeDatabase}}, testTriggers...)
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Which trigger would you like to resume?")
+			console.SendLine("onNewOrder")
+			console.ExpectEOF()
+		}()
+
+		trigger, err := resumeInputs{}.resolveTrigger(ui, triggers)
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, testTriggers[0], trigger)
+	})
+
+	t.Run("should return an error when the app has no database triggers", func(t *testing.T) {
+		_, err := resumeInputs{}.resolveTrigger(nil, testTriggers[1:])
+		assert.Equal(t, errors.New("no database triggers found to resume"), err)
+	})
+}
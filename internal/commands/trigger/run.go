@@ -0,0 +1,201 @@
+package trigger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagTriggerUsageRun = "the name or id of the trigger to run"
+
+	flagEventFile      = "event-file"
+	flagEventFileUsage = "the path to a JSON file of the event document to run the trigger's function with"
+)
+
+// CommandMetaRun is the command meta for the `triggers run` command
+var CommandMetaRun = cli.CommandMeta{
+	Use:         "run",
+	Display:     "triggers run",
+	Description: "Run the Function of a Trigger with a sample event",
+	HelpText: `Runs the Function linked to a Trigger of your Realm app as system, passing it the
+event document of the JSON file specified with "--event-file", such as a
+database change event or an authentication event. Once run, the following will
+be displayed:
+  - A list of logs, if present
+  - A list of error logs, if present
+  - The function result as a document, or the error the function failed with
+
+The Trigger itself does not fire, so this can be used to test its logic against
+realistic payloads. Scheduled Triggers receive no event, so "--event-file" is
+optional for them.`,
+}
+
+// CommandRun is the `triggers run` command
+type CommandRun struct {
+	inputs runInputs
+}
+
+type runInputs struct {
+	cli.ProjectInputs
+	Trigger   string
+	EventFile string
+}
+
+// Flags is the command flags
+func (cmd *CommandRun) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Trigger, flagTrigger, flagTriggerShort, "", flagTriggerUsageRun)
+	fs.StringVar(&cmd.inputs.EventFile, flagEventFile, "", flagEventFileUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandRun) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandRun) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	triggers, err := clients.Realm.Triggers(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	trigger, err := cmd.inputs.resolveTrigger(ui, triggers)
+	if err != nil {
+		return err
+	}
+
+	args, err := cmd.inputs.eventArgs(trigger)
+	if err != nil {
+		return err
+	}
+
+	functionName, err := resolveFunctionName(clients.Realm, app, trigger)
+	if err != nil {
+		return err
+	}
+
+	s := ui.Spinner(fmt.Sprintf("Running function %s of trigger %s...", functionName, trigger.Name))
+
+	runFunction := func() (realm.ExecutionResults, error) {
+		s.Start()
+		defer s.Stop()
+
+		return clients.Realm.AppDebugExecuteFunction(app.GroupID, app.ID, "", functionName, args)
+	}
+
+	response, err := runFunction()
+	if err != nil {
+		return err
+	}
+	if response.Logs != nil {
+		logs := make([]interface{}, 0, len(response.Logs))
+		for _, log := range response.Logs {
+			logs = append(logs, log)
+		}
+		ui.Print(terminal.NewListLog("Logs", logs...))
+	}
+	if response.ErrorLogs != nil {
+		ui.Print(terminal.NewJSONLog("Error Logs", response.ErrorLogs))
+	}
+	if response.Error != nil {
+		return fmt.Errorf("function '%s' of trigger '%s' failed: %s", functionName, trigger.Name, response.ErrorMessage())
+	}
+	ui.Print(terminal.NewJSONLog("Result", response.Result))
+
+	return nil
+}
+
+func (i *runInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// resolveTrigger finds the specified trigger by name or id,
+// otherwise prompts for the trigger to run
+func (i runInputs) resolveTrigger(ui terminal.UI, triggers []realm.Trigger) (realm.Trigger, error) {
+	if i.Trigger != "" {
+		trigger, ok := findTrigger(triggers, i.Trigger)
+		if !ok {
+			return realm.Trigger{}, fmt.Errorf("unable to find trigger '%s'", i.Trigger)
+		}
+		return trigger, nil
+	}
+
+	if len(triggers) == 0 {
+		return realm.Trigger{}, errors.New("no triggers found to run")
+	}
+
+	options := make([]string, 0, len(triggers))
+	triggersByOption := map[string]realm.Trigger{}
+	for _, trigger := range triggers {
+		option := displayTriggerOption(trigger)
+
+		options = append(options, option)
+		triggersByOption[option] = trigger
+	}
+
+	var selection string
+	if err := ui.AskOne(&selection, &survey.Select{Message: "Which trigger would you like to run?", Options: options}); err != nil {
+		return realm.Trigger{}, err
+	}
+	return triggersByOption[selection], nil
+}
+
+// eventArgs reads the event document to pass the trigger's function,
+// which is required for all but scheduled triggers
+func (i runInputs) eventArgs(trigger realm.Trigger) ([]interface{}, error) {
+	if i.EventFile == "" {
+		if trigger.Type == realm.TriggerTypeScheduled {
+			return []interface{}{}, nil
+		}
+		return nil, fmt.Errorf(`must specify an event document with "--%s" to run a %s trigger`, flagEventFile, trigger.Type)
+	}
+
+	data, err := ioutil.ReadFile(i.EventFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var event map[string]interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, fmt.Errorf("failed to parse event file %s: %s", i.EventFile, err)
+	}
+	return []interface{}{event}, nil
+}
+
+// resolveFunctionName finds the name of the function linked to the trigger
+func resolveFunctionName(client realm.Client, app realm.App, trigger realm.Trigger) (string, error) {
+	if trigger.FunctionName != "" {
+		return trigger.FunctionName, nil
+	}
+	if trigger.FunctionID == "" {
+		return "", fmt.Errorf("trigger '%s' has no function to run", trigger.Name)
+	}
+
+	functions, err := client.Functions(app.GroupID, app.ID)
+	if err != nil {
+		return "", err
+	}
+	for _, function := range functions {
+		if function.ID == trigger.FunctionID {
+			return function.Name, nil
+		}
+	}
+	return "", fmt.Errorf("unable to find function '%s' of trigger '%s'", trigger.FunctionID, trigger.Name)
+}
@@ -0,0 +1,164 @@
+package trigger
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestTriggersRunHandler(t *testing.T) {
+	tmpDir, teardown, tmpDirErr := u.NewTempDir("")
+	assert.Nil(t, tmpDirErr)
+	defer teardown()
+
+	eventFile := filepath.Join(tmpDir, "event.json")
+	assert.Nil(t, local.WriteFile(eventFile, 0666, strings.NewReader(`{"operationType":"insert","fullDocument":{"_id":1}}`)))
+
+	t.Run("should run the trigger function with the event and print the logs and result", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient(testTriggers)
+
+		var capturedUserID, capturedName string
+		var capturedArgs []interface{}
+		realmClient.AppDebugExecuteFunctionFn = func(groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error) {
+			capturedUserID, capturedName, capturedArgs = userID, name, args
+			return realm.ExecutionResults{Result: "ok", Logs: []string{"handling order 1"}}, nil
+		}
+
+		cmd := &CommandRun{runInputs{Trigger: "onNewOrder", EventFile: eventFile}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Logs\n  handling order 1\nResult\n\"ok\"\n", out.String())
+
+		assert.Equal(t, "", capturedUserID)
+		assert.Equal(t, "handleOrder", capturedName)
+		assert.Equal(t, []interface{}{map[string]interface{}{
+			"operationType": "insert",
+			"fullDocument":  map[string]interface{}{"_id": 1.0},
+		}}, capturedArgs)
+	})
+
+	t.Run("should look up the function name of the trigger by its id", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient(testTriggers)
+		realmClient.FunctionsFn = func(groupID, appID string) ([]realm.Function, error) {
+			return []realm.Function{{ID: "function3", Name: "welcomeUser"}}, nil
+		}
+
+		var capturedName string
+		realmClient.AppDebugExecuteFunctionFn = func(groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error) {
+			capturedName = name
+			return realm.ExecutionResults{}, nil
+		}
+
+		cmd := &CommandRun{runInputs{Trigger: "trigger3", EventFile: eventFile}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "welcomeUser", capturedName)
+	})
+
+	t.Run("should run a scheduled trigger function without an event", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient(testTriggers)
+
+		var capturedArgs []interface{}
+		realmClient.AppDebugExecuteFunctionFn = func(groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error) {
+			capturedArgs = args
+			return realm.ExecutionResults{}, nil
+		}
+
+		cmd := &CommandRun{runInputs{Trigger: "nightlyReport"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []interface{}{}, capturedArgs)
+	})
+
+	t.Run("should return an error when the function fails", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient(testTriggers)
+		realmClient.AppDebugExecuteFunctionFn = func(groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error) {
+			return realm.ExecutionResults{
+				Logs:  []string{"running"},
+				Error: map[string]interface{}{"message": "'order' is not defined", "name": "ReferenceError"},
+			}, nil
+		}
+
+		cmd := &CommandRun{runInputs{Trigger: "onNewOrder", EventFile: eventFile}}
+
+		assert.Equal(t,
+			errors.New("function 'handleOrder' of trigger 'onNewOrder' failed: 'order' is not defined"),
+			cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}),
+		)
+		assert.Equal(t, "Logs\n  running\n", out.String())
+	})
+
+	t.Run("should return an error when the trigger has no function", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newTriggersRealmClient([]realm.Trigger{{ID: "trigger4", Name: "toEventBridge", Type: realm.TriggerTypeDatabase}})
+
+		cmd := &CommandRun{runInputs{Trigger: "toEventBridge", EventFile: eventFile}}
+
+		assert.Equal(t, errors.New("trigger 'toEventBridge' has no function to run"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
+
+func TestTriggersRunInputsEventArgs(t *testing.T) {
+	tmpDir, teardown, tmpDirErr := u.NewTempDir("")
+	assert.Nil(t, tmpDirErr)
+	defer teardown()
+
+	t.Run("should return an error when no event file is specified for a database trigger", func(t *testing.T) {
+		_, err := runInputs{}.eventArgs(testTriggers[0])
+		assert.Equal(t, errors.New(`must specify an event document with "--event-file" to run a DATABASE trigger`), err)
+	})
+
+	t.Run("should return an error when the event file is not a JSON object", func(t *testing.T) {
+		eventFile := filepath.Join(tmpDir, "event.json")
+		assert.Nil(t, local.WriteFile(eventFile, 0666, strings.NewReader(`["insert"]`)))
+
+		_, err := runInputs{EventFile: eventFile}.eventArgs(testTriggers[0])
+		assert.Equal(t, errors.New("failed to parse event file "+eventFile+": json: cannot unmarshal array into Go value of type map[string]interface {}"), err)
+	})
+}
+
+func TestTriggersRunInputsResolveTrigger(t *testing.T) {
+	t.Run("should prompt for the trigger to run", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Which trigger would you like to run?")
+			console.SendLine("onSignup")
+			console.ExpectEOF()
+		}()
+
+		trigger, err := runInputs{}.resolveTrigger(ui, testTriggers)
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, testTriggers[2], trigger)
+	})
+
+	t.Run("should return an error when the app has no triggers", func(t *testing.T) {
+		_, err := runInputs{}.resolveTrigger(nil, nil)
+		assert.Equal(t, errors.New("no triggers found to run"), err)
+	})
+}
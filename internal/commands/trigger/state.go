@@ -0,0 +1,72 @@
+package trigger
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// setTriggersDisabled enables or disables the selected triggers of the app and returns the number updated,
+// reporting the triggers which fail to be updated rather than stopping at the first one
+func setTriggersDisabled(ui terminal.UI, clients cli.Clients, inputs triggersInputs, disabled bool) (int, error) {
+	app, err := cli.ResolveApp(ui, clients.Realm, inputs.Filter())
+	if err != nil {
+		return 0, err
+	}
+
+	appTriggers, err := clients.Realm.Triggers(app.GroupID, app.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	selected, err := inputs.resolveTriggers(ui, appTriggers, disabled)
+	if err != nil {
+		return 0, err
+	}
+
+	state := displayState(disabled)
+
+	if len(selected) == 0 {
+		ui.Print(terminal.NewTextLog("No triggers to %s", displayAction(disabled)))
+		return 0, nil
+	}
+
+	var updated int
+	rows := make([]map[string]interface{}, 0, len(selected))
+	for _, trigger := range selected {
+		row := map[string]interface{}{
+			headerID:   trigger.ID,
+			headerName: trigger.Name,
+		}
+		rows = append(rows, row)
+
+		if trigger.Disabled == disabled {
+			row[headerState] = displayState(trigger.Disabled)
+			row[headerDetails] = fmt.Sprintf("trigger is already %s", state)
+			continue
+		}
+
+		if err := updateTriggerDisabled(clients.Realm, app, trigger, disabled); err != nil {
+			row[headerState] = displayState(trigger.Disabled)
+			row[headerDetails] = err.Error()
+			continue
+		}
+
+		updated++
+		row[headerState] = state
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Successfully %s %d of %d trigger(s)", state, updated, len(selected)),
+		[]string{headerID, headerName, headerState, headerDetails},
+		rows...,
+	))
+	return updated, nil
+}
+
+func updateTriggerDisabled(client realm.Client, app realm.App, trigger realm.Trigger, disabled bool) error {
+	trigger.Disabled = disabled
+	return client.UpdateTrigger(app.GroupID, app.ID, trigger)
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaConfirm is the command meta for the `user confirm` command
+var CommandMetaConfirm = cli.CommandMeta{
+	Use:         "confirm",
+	Display:     "user confirm",
+	Description: "Confirm pending application Users of your Realm app",
+	HelpText: `Confirms Email/Password Users of your Realm app which are pending email
+confirmation, so they can log in. You can confirm multiple pending Users at once
+with the "--user" flag, or select them from a list of every pending User. Use
+"user list --pending" to display the pending Users of your Realm app.`,
+}
+
+// CommandConfirm is the `user confirm` command
+type CommandConfirm struct {
+	inputs pendingInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandConfirm) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+	fs.StringSliceVarP(&cmd.inputs.Users, flagUser, flagUserShort, []string{}, flagUserConfirmUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandConfirm) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandConfirm) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	users, err := cmd.inputs.resolveUsers(ui, clients.Realm, app.GroupID, app.ID, "confirm")
+	if err != nil {
+		return err
+	}
+
+	if len(users) == 0 {
+		ui.Print(terminal.NewTextLog("No pending users to confirm"))
+		return nil
+	}
+
+	outputs := processPendingUsers(users, func(email string) error {
+		return clients.Realm.ConfirmPendingUser(app.GroupID, app.ID, email)
+	})
+
+	ui.Print(pendingUsersTable(headerConfirmed, outputs))
+	return nil
+}
@@ -2,9 +2,11 @@ package user
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/terminal"
 
 	"github.com/spf13/pflag"
@@ -16,7 +18,12 @@ var CommandMetaCreate = cli.CommandMeta{
 	Display:     "user create",
 	Description: "Create an application user for your Realm app",
 	HelpText: `Adds a new User to your Realm app. You can create a User for the following
-enabled Auth Providers: "Email/Password", or "API Key".`,
+enabled Auth Providers: "Email/Password", or "API Key".
+
+With "--if-not-exists", creating an Email/Password User whose email is already
+registered returns the existing User instead of failing, so scripts which set up
+test fixtures can be safely rerun. The "Existing" column of the output shows
+whether the User was found rather than created.`,
 }
 
 // CommandCreate is the `user create` command
@@ -32,6 +39,7 @@ func (cmd *CommandCreate) Flags(fs *pflag.FlagSet) {
 	fs.StringVar(&cmd.inputs.APIKeyName, flagAPIKeyName, "", flagAPIKeyNameUsage)
 	fs.StringVar(&cmd.inputs.Email, flagEmail, "", flagEmailUsage)
 	fs.StringVar(&cmd.inputs.Password, flagPassword, "", flagPasswordUsage)
+	fs.BoolVar(&cmd.inputs.IfNotExists, flagIfNotExists, false, flagIfNotExistsUsage)
 }
 
 // Inputs is the command inputs
@@ -64,22 +72,72 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 			},
 		))
 	case userTypeEmailPassword:
-		user, err := clients.Realm.CreateUser(app.GroupID, app.ID, cmd.inputs.Email, cmd.inputs.Password)
+		user, existing, err := cmd.createUser(clients.Realm, app)
 		if err != nil {
-			return fmt.Errorf("failed to create user: %s", err)
+			return err
 		}
 
-		ui.Print(terminal.NewTableLog(
-			"Successfully created user",
-			[]string{headerID, headerEnabled, headerEmail, headerType},
-			map[string]interface{}{
-				headerID:      user.ID,
-				headerEnabled: !user.Disabled,
-				headerEmail:   user.Data["email"],
-				headerType:    user.Type,
-			},
-		))
+		message := "Successfully created user"
+		if existing {
+			message = "Found existing user"
+		}
+
+		headers := []string{headerID, headerEnabled, headerEmail, headerType}
+		row := map[string]interface{}{
+			headerID:      user.ID,
+			headerEnabled: !user.Disabled,
+			headerEmail:   user.Data["email"],
+			headerType:    user.Type,
+		}
+		if cmd.inputs.IfNotExists {
+			headers = append(headers, headerExisting)
+			row[headerExisting] = existing
+		}
+
+		ui.Print(terminal.NewTableLog(message, headers, row))
 	}
 
 	return nil
 }
+
+// createUser creates the email/password user, or with "--if-not-exists" returns the existing
+// user registered with the email along with whether it existed. The existing user is also looked
+// up when creating it fails, in case the same email was registered by a concurrent run
+func (cmd *CommandCreate) createUser(client realm.Client, app realm.App) (realm.User, bool, error) {
+	if cmd.inputs.IfNotExists {
+		user, ok, err := findUserByEmail(client, app, cmd.inputs.Email)
+		if err != nil {
+			return realm.User{}, false, fmt.Errorf("failed to find existing user: %s", err)
+		}
+		if ok {
+			return user, true, nil
+		}
+	}
+
+	user, createErr := client.CreateUser(app.GroupID, app.ID, cmd.inputs.Email, cmd.inputs.Password)
+	if createErr == nil {
+		return user, false, nil
+	}
+
+	if cmd.inputs.IfNotExists {
+		if user, ok, err := findUserByEmail(client, app, cmd.inputs.Email); err == nil && ok {
+			return user, true, nil
+		}
+	}
+	return realm.User{}, false, fmt.Errorf("failed to create user: %s", createErr)
+}
+
+func findUserByEmail(client realm.Client, app realm.App, email string) (realm.User, bool, error) {
+	users, err := client.FindUsers(app.GroupID, app.ID, realm.UserFilter{
+		Providers: []realm.AuthProviderType{realm.AuthProviderTypeUserPassword},
+	})
+	if err != nil {
+		return realm.User{}, false, err
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Email(), email) {
+			return user, true, nil
+		}
+	}
+	return realm.User{}, false, nil
+}
@@ -25,6 +25,9 @@ const (
 
 	flagAPIKeyName      = "name"
 	flagAPIKeyNameUsage = "sets the name of the api key to be created"
+
+	flagIfNotExists      = "if-not-exists"
+	flagIfNotExistsUsage = "include to return the existing email/password user instead of failing when the email is already registered"
 )
 
 // input field names, per survey
@@ -36,10 +39,11 @@ const (
 
 type createInputs struct {
 	cli.ProjectInputs
-	UserType   userType
-	Email      string
-	Password   string
-	APIKeyName string
+	UserType    userType
+	Email       string
+	Password    string
+	APIKeyName  string
+	IfNotExists bool
 }
 
 func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
@@ -64,6 +68,10 @@ func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 		i.UserType = userTypeEmailPassword
 	}
 
+	if i.IfNotExists && i.UserType == userTypeAPIKey {
+		return fmt.Errorf(`"--%s" is only supported for email/password users`, flagIfNotExists)
+	}
+
 	var questions []*survey.Question
 
 	switch i.UserType {
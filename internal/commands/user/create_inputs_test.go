@@ -1,6 +1,7 @@
 package user
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -155,4 +156,15 @@ func TestCreateInputs(t *testing.T) {
 			tc.test(t, tc.inputs)
 		})
 	}
+
+	t.Run("should return an error when if not exists is set for an api key", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		_, ui := mock.NewUI()
+
+		inputs := createInputs{UserType: userTypeAPIKey, APIKeyName: "publickey", IfNotExists: true}
+		inputs.App = "some-app" // avoid app resolution
+
+		err := inputs.Resolve(profile, ui)
+		assert.Equal(t, errors.New(`"--if-not-exists" is only supported for email/password users`), err)
+	})
 }
@@ -53,6 +53,117 @@ func TestUserCreateHandler(t *testing.T) {
 		}, "\n"), out.String())
 	})
 
+	t.Run("with if not exists set", func(t *testing.T) {
+		id := primitive.NewObjectID().Hex()
+		existingUser := realm.User{ID: id, Type: "normal", Data: map[string]interface{}{"email": "user@domain.com"}}
+
+		t.Run("should return the existing user when one is registered with the email", func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			var filters []realm.UserFilter
+			realmClient := newMockClient()
+			realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+				filters = append(filters, filter)
+				return []realm.User{
+					{ID: "other", Data: map[string]interface{}{"email": "other@domain.com"}},
+					existingUser,
+				}, nil
+			}
+			realmClient.CreateUserFn = func(groupID, appID, email, password string) (realm.User, error) {
+				return realm.User{}, errors.New("should not be called")
+			}
+
+			cmd := &CommandCreate{createInputs{UserType: userTypeEmailPassword, Email: "User@Domain.com", IfNotExists: true}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, []realm.UserFilter{{Providers: []realm.AuthProviderType{realm.AuthProviderTypeUserPassword}}}, filters)
+			assert.Equal(t, strings.Join([]string{
+				"Found existing user",
+				"  ID                        Enabled  Email            Type    Existing",
+				"  ------------------------  -------  ---------------  ------  --------",
+				fmt.Sprintf("  %s  true     user@domain.com  normal  true    ", id),
+				"",
+			}, "\n"), out.String())
+		})
+
+		t.Run("should create the user when none is registered with the email", func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := newMockClient()
+			realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+				return nil, nil
+			}
+			realmClient.CreateUserFn = func(groupID, appID, email, password string) (realm.User, error) {
+				return existingUser, nil
+			}
+
+			cmd := &CommandCreate{createInputs{UserType: userTypeEmailPassword, Email: "user@domain.com", IfNotExists: true}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, strings.Join([]string{
+				"Successfully created user",
+				"  ID                        Enabled  Email            Type    Existing",
+				"  ------------------------  -------  ---------------  ------  --------",
+				fmt.Sprintf("  %s  true     user@domain.com  normal  false   ", id),
+				"",
+			}, "\n"), out.String())
+		})
+
+		t.Run("should return the existing user when it is registered while creating it", func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			var findCalls int
+			realmClient := newMockClient()
+			realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+				findCalls++
+				if findCalls == 1 {
+					return nil, nil
+				}
+				return []realm.User{existingUser}, nil
+			}
+			realmClient.CreateUserFn = func(groupID, appID, email, password string) (realm.User, error) {
+				return realm.User{}, errors.New("name already in use")
+			}
+
+			cmd := &CommandCreate{createInputs{UserType: userTypeEmailPassword, Email: "user@domain.com", IfNotExists: true}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, 2, findCalls)
+			assert.True(t, strings.HasPrefix(out.String(), "Found existing user\n"), "expected the existing user to be found, but got: %s", out.String())
+		})
+
+		t.Run("should return the create error when the user still cannot be found", func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			realmClient := newMockClient()
+			realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+				return nil, nil
+			}
+			realmClient.CreateUserFn = func(groupID, appID, email, password string) (realm.User, error) {
+				return realm.User{}, errors.New("something bad happened")
+			}
+
+			cmd := &CommandCreate{createInputs{UserType: userTypeEmailPassword, Email: "user@domain.com", IfNotExists: true}}
+
+			err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+			assert.Equal(t, errors.New("failed to create user: something bad happened"), err)
+		})
+
+		t.Run("should return an error when finding the existing user fails", func(t *testing.T) {
+			_, ui := mock.NewUI()
+
+			realmClient := newMockClient()
+			realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+				return nil, errors.New("something bad happened")
+			}
+
+			cmd := &CommandCreate{createInputs{UserType: userTypeEmailPassword, Email: "user@domain.com", IfNotExists: true}}
+
+			err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+			assert.Equal(t, errors.New("failed to find existing user: something bad happened"), err)
+		})
+	})
+
 	t.Run("should create an api key when apiKey type is set", func(t *testing.T) {
 		id := primitive.NewObjectID().Hex()
 		testAPIKey := realm.APIKey{ID: id, Name: "name", Key: "key"}
@@ -19,12 +19,19 @@ var CommandMetaDelete = cli.CommandMeta{
 	Display:     "user delete",
 	Description: "Delete an application user from your Realm app",
 	HelpText: `You can remove multiple Users at once with the "--user" flag. You can only
-specify these Users using their ID values.`,
+specify these Users using their ID values.
+
+Alternatively, you can select the Users to delete from those matching a filter
+by omitting "--user" and specifying any of "--state", "--provider", "--pending"
+or "--created-before". To delete every User matching the filter instead, include
+"--all"; you will be asked to confirm the number of Users to delete before any
+are deleted. Use "--dry-run" to only display how many Users match the filter.`,
 }
 
 // CommandDelete is the `user delete` command
 type CommandDelete struct {
-	inputs deleteInputs
+	inputs  deleteInputs
+	deleted int
 }
 
 // Flags is the command flags
@@ -38,6 +45,9 @@ func (cmd *CommandDelete) Flags(fs *pflag.FlagSet) {
 		flagProvider,
 		flagProviderUsage,
 	)
+	fs.Var(&cmd.inputs.CreatedBefore, flagCreatedBefore, flagCreatedBeforeUsage)
+	fs.BoolVar(&cmd.inputs.All, flagAll, false, flagAllDeleteUsage)
+	fs.BoolVarP(&cmd.inputs.DryRun, flagDryRun, flagDryRunShort, false, flagDryRunUsage)
 }
 
 // Inputs is the command inputs
@@ -57,14 +67,38 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	users, err := cmd.inputs.selectUsers(ui, found, "delete")
-	if err != nil {
-		return err
+	users := found
+	if !cmd.inputs.All {
+		users, err = cmd.inputs.selectUsers(ui, found, "delete")
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(users) > 0 && cmd.inputs.DryRun {
+		ui.Print(
+			deletePreviewLog(users),
+			terminal.NewTextLog("To delete these users, you must omit the 'dry-run' flag to proceed"),
+		)
+		return nil
+	}
+
+	if len(users) > 0 && cmd.inputs.All {
+		proceed, err := ui.Confirm("Are you sure you want to delete %d user(s)?", len(users))
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return nil
+		}
 	}
 
 	outputs := make(userOutputs, 0, len(users))
 	for _, user := range users {
 		err := clients.Realm.DeleteUser(app.GroupID, app.ID, user.ID)
+		if err == nil {
+			cmd.deleted++
+		}
 		outputs = append(outputs, userOutput{user, err})
 	}
 
@@ -95,18 +129,57 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 	return nil
 }
 
+// ResourcesAffected is the number of users deleted
+func (cmd *CommandDelete) ResourcesAffected() int {
+	return cmd.deleted
+}
+
 type deleteInputs struct {
 	cli.ProjectInputs
 	multiUserInputs
+	All    bool
+	DryRun bool
 }
 
 func (i *deleteInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.All && len(i.Users) > 0 {
+		return fmt.Errorf(`cannot specify both "--%s" and "--%s"`, flagAll, flagUser)
+	}
+	if i.All && !i.isFiltered() {
+		return fmt.Errorf(`must specify any of "--%s", "--%s", "--%s" or "--%s" with "--%s"`, flagState, flagProvider, flagPending, flagCreatedBefore, flagAll)
+	}
+
 	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
 		return err
 	}
 	return nil
 }
 
+func deletePreviewLog(users []realm.User) terminal.Log {
+	counts := map[realm.AuthProviderType]int{}
+	for _, user := range users {
+		var apt realm.AuthProviderType
+		if len(user.Identities) > 0 {
+			apt = user.Identities[0].ProviderType
+		}
+		counts[apt]++
+	}
+
+	rows := make([]map[string]interface{}, 0, len(counts))
+	for _, apt := range realm.ValidAuthProviderTypes {
+		if counts[apt] == 0 {
+			continue
+		}
+		rows = append(rows, map[string]interface{}{headerProviderType: apt.Display(), headerUsers: counts[apt]})
+	}
+
+	return terminal.NewTableLog(
+		fmt.Sprintf("Found %d user(s) to delete", len(users)),
+		[]string{headerProviderType, headerUsers},
+		rows...,
+	)
+}
+
 func tableRowDelete(output userOutput, row map[string]interface{}) {
 	var deleted bool
 	var details string
@@ -1,12 +1,15 @@
 package user
 
 import (
+	"bytes"
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/flags"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
 )
@@ -59,7 +62,7 @@ func TestUserDeleteHandler(t *testing.T) {
 			return nil
 		}
 
-		cmd := &CommandDelete{deleteInputs{ProjectInputs: cli.ProjectInputs{
+		cmd := &CommandDelete{inputs: deleteInputs{ProjectInputs: cli.ProjectInputs{
 			Project: projectID,
 			App:     appID,
 		}}}
@@ -82,7 +85,7 @@ func TestUserDeleteHandler(t *testing.T) {
 			return nil
 		}
 
-		cmd := &CommandDelete{deleteInputs{
+		cmd := &CommandDelete{inputs: deleteInputs{
 			ProjectInputs: cli.ProjectInputs{
 				Project: projectID,
 				App:     appID,
@@ -114,6 +117,137 @@ func TestUserDeleteHandler(t *testing.T) {
 		}, "\n"), out.String())
 	})
 
+	t.Run("should delete every user matching the filter once confirmed", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		createdBefore := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+
+		var capturedFilter realm.UserFilter
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			capturedFilter = filter
+			return testUsers[:1], nil
+		}
+
+		var deleted []string
+		realmClient.DeleteUserFn = func(groupID, appID, userID string) error {
+			deleted = append(deleted, userID)
+			return nil
+		}
+
+		cmd := &CommandDelete{inputs: deleteInputs{
+			ProjectInputs: cli.ProjectInputs{Project: projectID, App: appID},
+			multiUserInputs: multiUserInputs{
+				State:         realm.UserStateDisabled,
+				ProviderTypes: []string{string(realm.AuthProviderTypeAnonymous)},
+				CreatedBefore: flags.Date{Time: createdBefore},
+			},
+			All: true,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, realm.UserFilter{
+			State:         realm.UserStateDisabled,
+			Providers:     []realm.AuthProviderType{realm.AuthProviderTypeAnonymous},
+			CreatedBefore: createdBefore,
+		}, capturedFilter)
+		assert.Equal(t, []string{"user-1"}, deleted)
+		assert.Equal(t, strings.Join([]string{
+			"Provider type: Anonymous",
+			"  ID      Type    Deleted  Details",
+			"  ------  ------  -------  -------",
+			"  user-1  type-1  true            ",
+			"",
+		}, "\n"), out.String())
+	})
+
+	t.Run("should prompt to select the users matching the filter without all", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return testUsers[:2], nil
+		}
+
+		var deleted []string
+		realmClient.DeleteUserFn = func(groupID, appID, userID string) error {
+			deleted = append(deleted, userID)
+			return nil
+		}
+
+		doneCh := make(chan (struct{}))
+		go func() {
+			defer close(doneCh)
+
+			console.ExpectString("Which user(s) would you like to delete?")
+			console.Send("user-1")
+			console.SendLine(" ")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandDelete{inputs: deleteInputs{
+			ProjectInputs:   cli.ProjectInputs{Project: projectID, App: appID},
+			multiUserInputs: multiUserInputs{State: realm.UserStateDisabled},
+		}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+
+		console.Tty().Close() // flush the writers
+		<-doneCh              // wait for procedure to complete
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"user-1"}, deleted)
+	})
+
+	t.Run("should display the count of users matching the filter in a dry run", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return append(testUsers, testUsers[0]), nil
+		}
+
+		var deleteCalled bool
+		realmClient.DeleteUserFn = func(groupID, appID, userID string) error {
+			deleteCalled = true
+			return nil
+		}
+
+		cmd := &CommandDelete{inputs: deleteInputs{
+			ProjectInputs:   cli.ProjectInputs{Project: projectID, App: appID},
+			multiUserInputs: multiUserInputs{State: realm.UserStateDisabled},
+			All:             true,
+			DryRun:          true,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.False(t, deleteCalled, "expected no users to be deleted")
+		assert.Equal(t, strings.Join([]string{
+			"Found 5 user(s) to delete",
+			"  Provider Type  Users",
+			"  -------------  -----",
+			"  User/Password  1    ",
+			"  ApiKey         1    ",
+			"  Anonymous      2    ",
+			"  Custom JWT     1    ",
+			"To delete these users, you must omit the 'dry-run' flag to proceed",
+			"",
+		}, "\n"), out.String())
+	})
+
 	for _, tc := range []struct {
 		description    string
 		deleteErr      error
@@ -166,7 +300,7 @@ func TestUserDeleteHandler(t *testing.T) {
 				return tc.deleteErr
 			}
 
-			cmd := &CommandDelete{deleteInputs{
+			cmd := &CommandDelete{inputs: deleteInputs{
 				ProjectInputs: cli.ProjectInputs{
 					Project: projectID,
 					App:     appID,
@@ -264,3 +398,32 @@ func TestTableRowDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestUserDeleteInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      deleteInputs
+		expectedErr error
+	}{
+		{
+			description: "should return an error when all is set without a filter",
+			inputs:      deleteInputs{All: true},
+			expectedErr: errors.New(`must specify any of "--state", "--provider", "--pending" or "--created-before" with "--all"`),
+		},
+		{
+			description: "should return an error when all is set with users",
+			inputs: deleteInputs{
+				multiUserInputs: multiUserInputs{Users: []string{"user-1"}, State: realm.UserStateDisabled},
+				All:             true,
+			},
+			expectedErr: errors.New(`cannot specify both "--all" and "--user"`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+
+			err := tc.inputs.Resolve(profile, nil)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
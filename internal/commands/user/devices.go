@@ -0,0 +1,123 @@
+package user
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagRevokeDevice      = "revoke"
+	flagRevokeDeviceUsage = "set the device id to revoke from the user"
+)
+
+// CommandMetaDevices is the command meta for the `user devices` command
+var CommandMetaDevices = cli.CommandMeta{
+	Use:         "devices",
+	Display:     "user devices",
+	Description: "List or revoke the devices of an application User of your Realm app",
+	HelpText: `Displays the devices an application User of your Realm app has logged in from,
+including each device's platform, SDK version and when it was last seen. Use
+"--revoke" with a device id to revoke that device, which logs the User out of it.`,
+}
+
+// CommandDevices is the `user devices` command
+type CommandDevices struct {
+	inputs devicesInputs
+}
+
+type devicesInputs struct {
+	cli.ProjectInputs
+	User   string
+	Revoke string
+}
+
+// Flags is the command flags
+func (cmd *CommandDevices) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.User, flagUser, flagUserShort, "", flagUserDevicesUsage)
+	fs.StringVar(&cmd.inputs.Revoke, flagRevokeDevice, "", flagRevokeDeviceUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDevices) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDevices) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	if cmd.inputs.Revoke != "" {
+		proceed, err := ui.Confirm("Are you sure you want to revoke device %s of user %s?", cmd.inputs.Revoke, cmd.inputs.User)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return nil
+		}
+
+		if err := clients.Realm.RevokeUserDevice(app.GroupID, app.ID, cmd.inputs.User, cmd.inputs.Revoke); err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("Successfully revoked device %s of user %s", cmd.inputs.Revoke, cmd.inputs.User))
+		return nil
+	}
+
+	devices, err := clients.Realm.UserDevices(app.GroupID, app.ID, cmd.inputs.User)
+	if err != nil {
+		return err
+	}
+
+	if len(devices) == 0 {
+		ui.Print(terminal.NewTextLog("No devices found for user %s", cmd.inputs.User))
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(devices))
+	for _, device := range devices {
+		rows = append(rows, userDeviceRow(device))
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Devices of user %s", cmd.inputs.User),
+		[]string{headerDeviceID, headerPlatform, headerPlatformVersion, headerSDKVersion, headerLastSeen},
+		rows...,
+	))
+	return nil
+}
+
+func (i *devicesInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	if i.User == "" {
+		return fmt.Errorf(`must specify "--%s"`, flagUser)
+	}
+	return nil
+}
+
+func userDeviceRow(device realm.UserDevice) map[string]interface{} {
+	lastSeen := "n/a"
+	if device.LastSeen != 0 {
+		lastSeen = time.Unix(device.LastSeen, 0).UTC().String()
+	}
+	return map[string]interface{}{
+		headerDeviceID:        device.ID,
+		headerPlatform:        device.Platform,
+		headerPlatformVersion: device.PlatformVersion,
+		headerSDKVersion:      device.SDKVersion,
+		headerLastSeen:        lastSeen,
+	}
+}
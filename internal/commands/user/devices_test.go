@@ -0,0 +1,111 @@
+package user
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestUserDevicesHandler(t *testing.T) {
+	app := realm.App{
+		ID:          "appID",
+		GroupID:     "projectID",
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+
+	newRealmClient := func() mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should display the devices of the user", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var userIDs []string
+
+		realmClient := newRealmClient()
+		realmClient.UserDevicesFn = func(groupID, appID, userID string) ([]realm.UserDevice, error) {
+			userIDs = append(userIDs, userID)
+			return []realm.UserDevice{
+				{ID: "device-1", Platform: "ios", PlatformVersion: "14.4", SDKVersion: "10.5.0", LastSeen: 1614291853},
+				{ID: "device-2", Platform: "android", PlatformVersion: "11", SDKVersion: "10.3.1"},
+			}, nil
+		}
+
+		cmd := &CommandDevices{devicesInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, User: "user-1"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"user-1"}, userIDs)
+		assert.Equal(t, `Devices of user user-1
+  Device ID  Platform  Platform Version  SDK Version  Last Seen                    
+  ---------  --------  ----------------  -----------  -----------------------------
+  device-1   ios       14.4              10.5.0       2021-02-25 22:24:13 +0000 UTC
+  device-2   android   11                10.3.1       n/a                          
+`, out.String())
+	})
+
+	t.Run("should display an empty state message when the user has no devices", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newRealmClient()
+		realmClient.UserDevicesFn = func(groupID, appID, userID string) ([]realm.UserDevice, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandDevices{devicesInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, User: "user-1"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No devices found for user user-1\n", out.String())
+	})
+
+	t.Run("should revoke the device of the user", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		var revoked []string
+
+		realmClient := newRealmClient()
+		realmClient.RevokeUserDeviceFn = func(groupID, appID, userID, deviceID string) error {
+			revoked = append(revoked, groupID+"/"+appID+"/"+userID+"/"+deviceID)
+			return nil
+		}
+
+		cmd := &CommandDevices{devicesInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, User: "user-1", Revoke: "device-1"}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"projectID/appID/user-1/device-1"}, revoked)
+		assert.Equal(t, "Successfully revoked device device-1 of user user-1\n", out.String())
+	})
+
+	t.Run("should return an error when revoking the device fails", func(t *testing.T) {
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		realmClient := newRealmClient()
+		realmClient.RevokeUserDeviceFn = func(groupID, appID, userID, deviceID string) error {
+			return errors.New("something bad happened")
+		}
+
+		cmd := &CommandDevices{devicesInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}, User: "user-1", Revoke: "device-1"}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
+
+func TestUserDevicesInputs(t *testing.T) {
+	t.Run("should return an error when the user is not specified", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		inputs := devicesInputs{ProjectInputs: cli.ProjectInputs{Project: "projectID", App: "appID"}}
+
+		assert.Equal(t, errors.New(`must specify "--user"`), inputs.Resolve(profile, nil))
+	})
+}
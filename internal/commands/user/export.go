@@ -0,0 +1,199 @@
+package user
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagExportOutput      = "output"
+	flagExportOutputUsage = `the file to write the exported users to, a ".csv" extension writes CSV and otherwise JSON is written`
+
+	extCSV = ".csv"
+)
+
+// CommandMetaExport is the command meta for the `user export` command
+var CommandMetaExport = cli.CommandMeta{
+	Use:         "export",
+	Display:     "user export",
+	Description: "Export the application users of your Realm app to a file",
+	HelpText: `Writes the ID, type, state, creation and last authentication dates and
+identities of each of your Realm app's Users to the specified file, which is
+useful for auditing and answering data access requests. A file with a ".csv"
+extension is written as CSV, otherwise the Users are written as a JSON array.
+
+Users may be filtered by state and Auth Provider type.`,
+}
+
+// CommandExport is the `user export` command
+type CommandExport struct {
+	inputs exportInputs
+}
+
+type exportInputs struct {
+	cli.ProjectInputs
+	multiUserInputs
+	Output string
+}
+
+type exportUser struct {
+	ID                     string           `json:"id"`
+	Type                   string           `json:"type"`
+	State                  realm.UserState  `json:"state"`
+	CreationDate           string           `json:"creation_date"`
+	LastAuthenticationDate string           `json:"last_authentication_date,omitempty"`
+	Identities             []exportIdentity `json:"identities"`
+}
+
+type exportIdentity struct {
+	ID           string                 `json:"id"`
+	ProviderType realm.AuthProviderType `json:"provider_type"`
+}
+
+// Flags is the command flags
+func (cmd *CommandExport) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.Output, flagExportOutput, "", flagExportOutputUsage)
+	fs.Var(&cmd.inputs.State, flagState, flagStateUsage)
+	fs.Var(
+		flags.NewEnumSet(&cmd.inputs.ProviderTypes, validAuthProviderTypes()),
+		flagProvider,
+		flagProviderUsage,
+	)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandExport) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandExport) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	users, err := clients.Realm.FindUsers(app.GroupID, app.ID, cmd.inputs.filter())
+	if err != nil {
+		return err
+	}
+
+	exported := make([]exportUser, 0, len(users))
+	for _, user := range users {
+		exported = append(exported, newExportUser(user))
+	}
+
+	var data []byte
+	if strings.EqualFold(filepath.Ext(cmd.inputs.Output), extCSV) {
+		data, err = marshalExportCSV(exported)
+	} else {
+		data, err = local.MarshalJSON(exported)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := local.WriteFile(cmd.inputs.Output, 0600, bytes.NewReader(data)); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Exported %d user(s) to %s", len(exported), cmd.inputs.Output))
+	return nil
+}
+
+func (i *exportInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+	if i.Output == "" {
+		return fmt.Errorf(`must specify the file to export users to with "--%s"`, flagExportOutput)
+	}
+	return nil
+}
+
+func newExportUser(user realm.User) exportUser {
+	state := realm.UserStateEnabled
+	if user.Disabled {
+		state = realm.UserStateDisabled
+	}
+
+	identities := make([]exportIdentity, 0, len(user.Identities))
+	for _, identity := range user.Identities {
+		identities = append(identities, exportIdentity{identity.UID, identity.ProviderType})
+	}
+
+	return exportUser{
+		ID:                     user.ID,
+		Type:                   user.Type,
+		State:                  state,
+		CreationDate:           exportDate(user.CreationDate),
+		LastAuthenticationDate: exportDate(user.LastAuthenticationDate),
+		Identities:             identities,
+	}
+}
+
+func exportDate(seconds int64) string {
+	if seconds == 0 {
+		return ""
+	}
+	return time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+}
+
+// set of exported csv columns
+var exportCSVHeader = []string{
+	"id",
+	"type",
+	"state",
+	"creation_date",
+	"last_authentication_date",
+	"provider_types",
+	"identities",
+}
+
+func marshalExportCSV(users []exportUser) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	if err := w.Write(exportCSVHeader); err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		providerTypes := make([]string, 0, len(user.Identities))
+		identities := make([]string, 0, len(user.Identities))
+		for _, identity := range user.Identities {
+			providerTypes = append(providerTypes, string(identity.ProviderType))
+			identities = append(identities, string(identity.ProviderType)+":"+identity.ID)
+		}
+
+		if err := w.Write([]string{
+			user.ID,
+			user.Type,
+			user.State.String(),
+			user.CreationDate,
+			user.LastAuthenticationDate,
+			strings.Join(providerTypes, ";"),
+			strings.Join(identities, ";"),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,171 @@
+package user
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestUserExportHandler(t *testing.T) {
+	users := []realm.User{
+		{
+			ID:                     "user1",
+			Type:                   "normal",
+			CreationDate:           1600000000,
+			LastAuthenticationDate: 1600000100,
+			Identities: []realm.UserIdentity{
+				{UID: "one@domain.com", ProviderType: realm.AuthProviderTypeUserPassword, ProviderID: primitive.NewObjectID()},
+			},
+		},
+		{
+			ID:           "user2",
+			Type:         "server",
+			Disabled:     true,
+			CreationDate: 1600000200,
+			Identities: []realm.UserIdentity{
+				{UID: "key", ProviderType: realm.AuthProviderTypeAPIKey},
+				{UID: "anon", ProviderType: realm.AuthProviderTypeAnonymous},
+			},
+		},
+	}
+
+	newRealmClient := func() mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return users, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should export the users as json", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		path := filepath.Join(tmpDir, "users.json")
+		cmd := &CommandExport{exportInputs{Output: path}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient()}))
+		assert.Equal(t, "Exported 2 user(s) to "+path+"\n", out.String())
+
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		assert.Equal(t, `[
+    {
+        "id": "user1",
+        "type": "normal",
+        "state": "enabled",
+        "creation_date": "2020-09-13T12:26:40Z",
+        "last_authentication_date": "2020-09-13T12:28:20Z",
+        "identities": [
+            {
+                "id": "one@domain.com",
+                "provider_type": "local-userpass"
+            }
+        ]
+    },
+    {
+        "id": "user2",
+        "type": "server",
+        "state": "disabled",
+        "creation_date": "2020-09-13T12:30:00Z",
+        "identities": [
+            {
+                "id": "key",
+                "provider_type": "api-key"
+            },
+            {
+                "id": "anon",
+                "provider_type": "anon-user"
+            }
+        ]
+    }
+]
+`, string(data))
+	})
+
+	t.Run("should export the users as csv", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		_, ui := mock.NewUI()
+
+		path := filepath.Join(tmpDir, "users.csv")
+		cmd := &CommandExport{exportInputs{Output: path}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: newRealmClient()}))
+
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		assert.Equal(t, `id,type,state,creation_date,last_authentication_date,provider_types,identities
+user1,normal,enabled,2020-09-13T12:26:40Z,2020-09-13T12:28:20Z,local-userpass,local-userpass:one@domain.com
+user2,server,disabled,2020-09-13T12:30:00Z,,api-key;anon-user,api-key:key;anon-user:anon
+`, string(data))
+	})
+
+	t.Run("should find users with the provided filter", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		_, ui := mock.NewUI()
+
+		var capturedFilter realm.UserFilter
+		realmClient := newRealmClient()
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			capturedFilter = filter
+			return nil, nil
+		}
+
+		cmd := &CommandExport{exportInputs{
+			Output: filepath.Join(tmpDir, "users.json"),
+			multiUserInputs: multiUserInputs{
+				State:         realm.UserStateDisabled,
+				ProviderTypes: []string{string(realm.AuthProviderTypeAPIKey)},
+			},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, realm.UserFilter{
+			State:     realm.UserStateDisabled,
+			Providers: []realm.AuthProviderType{realm.AuthProviderTypeAPIKey},
+		}, capturedFilter)
+	})
+
+	t.Run("should return an error when the client fails to find users", func(t *testing.T) {
+		realmClient := newRealmClient()
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandExport{exportInputs{Output: "users.json"}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, nil, cli.Clients{Realm: realmClient}))
+	})
+}
+
+func TestUserExportInputs(t *testing.T) {
+	t.Run("should error without an output file", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		inputs := exportInputs{ProjectInputs: cli.ProjectInputs{Project: "project", App: "app"}}
+
+		assert.Equal(t, errors.New(`must specify the file to export users to with "--output"`), inputs.Resolve(&user.Profile{}, ui))
+	})
+}
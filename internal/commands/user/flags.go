@@ -12,6 +12,17 @@ const (
 		`["local-userpass", "api-key", "oauth2-facebook", "oauth2-google", "oauth2-apple", ` +
 		`"anon-user", "custom-token", "custom-function"]`
 
+	flagCreatedBefore      = "created-before"
+	flagCreatedBeforeUsage = `set the date before which users were created to filter the users with, e.g. "2022-01-01"`
+
+	flagDryRun      = "dry-run"
+	flagDryRunShort = "x"
+	flagDryRunUsage = "include to display the users which would be deleted without deleting them"
+
+	flagAll            = "all"
+	flagAllRevokeUsage = "include to revoke the sessions of every user, or every user matching the filters"
+	flagAllDeleteUsage = "include to delete every user matching the filters, instead of selecting the users to delete"
+
 	flagUser             = "user"
 	flagUserShort        = "u"
 	flagUserListUsage    = `set the user ids for which to filter the list of app users with`
@@ -19,4 +30,8 @@ const (
 	flagUserDisableUsage = `set the user ids for which to disable in the app`
 	flagUserEnableUsage  = `set the user ids for which to enable in the app`
 	flagUserRevokeUsage  = `set the user ids for which to revoke sessions from`
+	flagUserUpdateUsage  = `set the user id of the email/password user to update`
+	flagUserConfirmUsage = `set the pending user ids for which to confirm in the app`
+	flagUserRejectUsage  = `set the pending user ids for which to reject in the app`
+	flagUserDevicesUsage = `set the user id for which to list or revoke devices`
 )
@@ -0,0 +1,311 @@
+package user
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagImportFile      = "file"
+	flagImportFileUsage = `the CSV or JSON file of users to import, use "-" to read from stdin`
+
+	flagContinueOnError      = "continue-on-error"
+	flagContinueOnErrorUsage = "include to keep importing users after one fails to be created"
+
+	importFileStdin = "-"
+)
+
+// set of supported import columns and fields
+const (
+	importFieldType     = "type"
+	importFieldEmail    = "email"
+	importFieldPassword = "password"
+	importFieldName     = "name"
+)
+
+// stdin is the reader users are imported from when the file is "-"
+var stdin io.Reader = os.Stdin
+
+// CommandMetaImport is the command meta for the `user import` command
+var CommandMetaImport = cli.CommandMeta{
+	Use:         "import",
+	Display:     "user import",
+	Description: "Create application users in bulk for your Realm app from a file",
+	HelpText: `Creates a User for each record of a CSV or JSON file. A CSV file must start
+with a header row naming its columns; a JSON file must contain an array of
+objects. The supported columns and fields are "type" ("email" or "api-key"),
+"email", "password" and "name". When "type" is omitted, records with an "email"
+create Email/Password Users and records with a "name" create API Keys.
+
+Every record is validated before any User is created. By default the import
+stops at the first User that fails to be created; use "--continue-on-error" to
+attempt every record. Use "--file -" to read the records from stdin.`,
+}
+
+// CommandImport is the `user import` command
+type CommandImport struct {
+	inputs importInputs
+}
+
+type importInputs struct {
+	cli.ProjectInputs
+	File            string
+	ContinueOnError bool
+
+	records []importRecord
+}
+
+type importRecord struct {
+	Type     userType `json:"type"`
+	Email    string   `json:"email"`
+	Password string   `json:"password"`
+	Name     string   `json:"name"`
+}
+
+type importOutput struct {
+	row    int
+	record importRecord
+	id     string
+	apiKey string
+	err    error
+}
+
+// Flags is the command flags
+func (cmd *CommandImport) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+	fs.StringVar(&cmd.inputs.File, flagImportFile, "", flagImportFileUsage)
+	fs.BoolVar(&cmd.inputs.ContinueOnError, flagContinueOnError, false, flagContinueOnErrorUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandImport) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandImport) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	outputs := make([]importOutput, 0, len(cmd.inputs.records))
+
+	var failed int
+	for i, record := range cmd.inputs.records {
+		output := importOutput{row: i + 1, record: record}
+
+		switch record.Type {
+		case userTypeAPIKey:
+			apiKey, err := clients.Realm.CreateAPIKey(app.GroupID, app.ID, record.Name)
+			output.id, output.apiKey, output.err = apiKey.ID, apiKey.Key, err
+		case userTypeEmailPassword:
+			user, err := clients.Realm.CreateUser(app.GroupID, app.ID, record.Email, record.Password)
+			output.id, output.err = user.ID, err
+		}
+
+		outputs = append(outputs, output)
+
+		if output.err != nil {
+			failed++
+			if !cmd.inputs.ContinueOnError {
+				break
+			}
+		}
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Imported %d of %d user(s)", len(outputs)-failed, len(cmd.inputs.records)),
+		importTableHeaders(cmd.inputs.records),
+		importTableRows(outputs)...,
+	))
+
+	if failed > 0 {
+		if !cmd.inputs.ContinueOnError && len(outputs) < len(cmd.inputs.records) {
+			return fmt.Errorf(
+				"failed to import user on row %d, %d record(s) were not attempted",
+				outputs[len(outputs)-1].row,
+				len(cmd.inputs.records)-len(outputs),
+			)
+		}
+		return fmt.Errorf("failed to import %d user(s)", failed)
+	}
+	return nil
+}
+
+func (i *importInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	if i.File == "" {
+		return fmt.Errorf(`must specify the file of users to import with "--%s"`, flagImportFile)
+	}
+
+	var r io.Reader
+	if i.File == importFileStdin {
+		r = stdin
+	} else {
+		f, err := os.Open(i.File)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	records, err := parseImportRecords(r)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return errors.New("no users found to import")
+	}
+	i.records = records
+
+	return nil
+}
+
+func parseImportRecords(r io.Reader) ([]importRecord, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimSpace(data)
+
+	var records []importRecord
+	if bytes.HasPrefix(data, []byte("[")) {
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("failed to parse users: %s", err)
+		}
+	} else {
+		records, err = parseImportCSV(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range records {
+		if err := records[i].resolve(); err != nil {
+			return nil, fmt.Errorf("row %d: %s", i+1, err)
+		}
+	}
+	return records, nil
+}
+
+func parseImportCSV(data []byte) ([]importRecord, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse users: %s", err)
+	}
+
+	header := rows[0]
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		switch column {
+		case importFieldType, importFieldEmail, importFieldPassword, importFieldName:
+		default:
+			return nil, fmt.Errorf("unsupported column '%s', use any of [%s] instead", column, strings.Join(
+				[]string{importFieldType, importFieldEmail, importFieldPassword, importFieldName},
+				", ",
+			))
+		}
+		header[i] = column
+	}
+
+	records := make([]importRecord, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		var record importRecord
+		for i, value := range row {
+			switch header[i] {
+			case importFieldType:
+				record.Type = userType(strings.TrimSpace(value))
+			case importFieldEmail:
+				record.Email = strings.TrimSpace(value)
+			case importFieldPassword:
+				record.Password = value
+			case importFieldName:
+				record.Name = strings.TrimSpace(value)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (r *importRecord) resolve() error {
+	if !isValidUserType(r.Type) {
+		return errInvalidUserType
+	}
+
+	if r.Type == userTypeNil {
+		switch {
+		case r.Email != "":
+			r.Type = userTypeEmailPassword
+		case r.Name != "":
+			r.Type = userTypeAPIKey
+		default:
+			return errors.New("must specify either an email or a name")
+		}
+	}
+
+	switch r.Type {
+	case userTypeAPIKey:
+		if r.Name == "" {
+			return errors.New("must specify a name for an api key")
+		}
+	case userTypeEmailPassword:
+		if r.Email == "" || r.Password == "" {
+			return errors.New("must specify an email and password for an email user")
+		}
+	}
+	return nil
+}
+
+func importTableHeaders(records []importRecord) []string {
+	headers := []string{headerRow, headerType, headerEmail, headerName, headerID}
+	for _, record := range records {
+		if record.Type == userTypeAPIKey {
+			headers = append(headers, headerAPIKey)
+			break
+		}
+	}
+	return append(headers, headerCreated, headerDetails)
+}
+
+func importTableRows(outputs []importOutput) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(outputs))
+	for _, output := range outputs {
+		row := map[string]interface{}{
+			headerRow:     output.row,
+			headerType:    output.record.Type,
+			headerEmail:   output.record.Email,
+			headerName:    output.record.Name,
+			headerID:      output.id,
+			headerAPIKey:  output.apiKey,
+			headerCreated: output.err == nil,
+		}
+		if output.err != nil {
+			row[headerDetails] = output.err.Error()
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,197 @@
+package user
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestUserImportHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	records := []importRecord{
+		{Type: userTypeEmailPassword, Email: "one@domain.com", Password: "password1"},
+		{Type: userTypeEmailPassword, Email: "two@domain.com", Password: "password2"},
+		{Type: userTypeAPIKey, Name: "server"},
+	}
+
+	newMockClient := func() mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.CreateUserFn = func(groupID, appID, email, password string) (realm.User, error) {
+			if email == "two@domain.com" {
+				return realm.User{}, errors.New("name already in use")
+			}
+			return realm.User{ID: "user-" + strings.Split(email, "@")[0]}, nil
+		}
+		realmClient.CreateAPIKeyFn = func(groupID, appID, apiKeyName string) (realm.APIKey, error) {
+			return realm.APIKey{ID: "key-" + apiKeyName, Name: apiKeyName, Key: "secret"}, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should create each of the users", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var createdUsers []string
+		realmClient := newMockClient()
+		realmClient.CreateUserFn = func(groupID, appID, email, password string) (realm.User, error) {
+			createdUsers = append(createdUsers, email+":"+password)
+			return realm.User{ID: "user-" + strings.Split(email, "@")[0]}, nil
+		}
+
+		cmd := &CommandImport{importInputs{records: records}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"one@domain.com:password1", "two@domain.com:password2"}, createdUsers)
+		assert.Equal(t, strings.Join([]string{
+			"Imported 3 of 3 user(s)",
+			"  Row  Type     Email           Name    ID          API Key  Created  Details",
+			"  ---  -------  --------------  ------  ----------  -------  -------  -------",
+			"  1    email    one@domain.com          user-one             true            ",
+			"  2    email    two@domain.com          user-two             true            ",
+			"  3    api-key                  server  key-server  secret   true            ",
+			"",
+		}, "\n"), out.String())
+	})
+
+	t.Run("should stop at the first user that fails to be created", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandImport{importInputs{records: records}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: newMockClient()})
+		assert.Equal(t, errors.New("failed to import user on row 2, 1 record(s) were not attempted"), err)
+		assert.Equal(t, strings.Join([]string{
+			"Imported 1 of 3 user(s)",
+			"  Row  Type   Email           Name  ID        API Key  Created  Details            ",
+			"  ---  -----  --------------  ----  --------  -------  -------  -------------------",
+			"  1    email  one@domain.com        user-one           true                        ",
+			"  2    email  two@domain.com                           false    name already in use",
+			"",
+		}, "\n"), out.String())
+	})
+
+	t.Run("should attempt every user when continuing on error", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandImport{importInputs{ContinueOnError: true, records: records}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: newMockClient()})
+		assert.Equal(t, errors.New("failed to import 1 user(s)"), err)
+		assert.Equal(t, strings.Join([]string{
+			"Imported 2 of 3 user(s)",
+			"  Row  Type     Email           Name    ID          API Key  Created  Details            ",
+			"  ---  -------  --------------  ------  ----------  -------  -------  -------------------",
+			"  1    email    one@domain.com          user-one             true                        ",
+			"  2    email    two@domain.com                               false    name already in use",
+			"  3    api-key                  server  key-server  secret   true                        ",
+			"",
+		}, "\n"), out.String())
+	})
+}
+
+func TestUserImportInputs(t *testing.T) {
+	projectInputs := cli.ProjectInputs{Project: "groupID", App: "appID"}
+
+	t.Run("should parse the users from a csv file", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		path := filepath.Join(tmpDir, "users.csv")
+		assert.Nil(t, ioutil.WriteFile(path, []byte(`Email,Password,Name,Type
+one@domain.com, pass word ,,
+,,server,api-key
+`), 0666))
+
+		i := importInputs{ProjectInputs: projectInputs, File: path}
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, []importRecord{
+			{Type: userTypeEmailPassword, Email: "one@domain.com", Password: " pass word "},
+			{Type: userTypeAPIKey, Name: "server"},
+		}, i.records)
+	})
+
+	t.Run("should parse the users from json read from stdin", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		defer func(r io.Reader) { stdin = r }(stdin)
+		stdin = strings.NewReader(`[
+  {"email": "one@domain.com", "password": "password1"},
+  {"type": "api-key", "name": "server"}
+]`)
+
+		i := importInputs{ProjectInputs: projectInputs, File: "-"}
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, []importRecord{
+			{Type: userTypeEmailPassword, Email: "one@domain.com", Password: "password1"},
+			{Type: userTypeAPIKey, Name: "server"},
+		}, i.records)
+	})
+
+	for _, tc := range []struct {
+		description string
+		file        string
+		data        string
+		expectedErr error
+	}{
+		{
+			description: "should return an error when no file is specified",
+			expectedErr: errors.New(`must specify the file of users to import with "--file"`),
+		},
+		{
+			description: "should return an error when no users are found",
+			file:        "-",
+			data:        "email,password\n",
+			expectedErr: errors.New("no users found to import"),
+		},
+		{
+			description: "should return an error for an unsupported column",
+			file:        "-",
+			data:        "email,phone\none@domain.com,555-0100\n",
+			expectedErr: errors.New("unsupported column 'phone', use any of [type, email, password, name] instead"),
+		},
+		{
+			description: "should return an error for an unsupported user type",
+			file:        "-",
+			data:        `[{"type": "anon-user"}]`,
+			expectedErr: errors.New("row 1: unsupported value, use one of [api-key, email] instead"),
+		},
+		{
+			description: "should return an error for an email user without a password",
+			file:        "-",
+			data:        "email,password\none@domain.com,password1\ntwo@domain.com,\n",
+			expectedErr: errors.New("row 2: must specify an email and password for an email user"),
+		},
+		{
+			description: "should return an error for a user without an email or a name",
+			file:        "-",
+			data:        `[{"password": "password1"}]`,
+			expectedErr: errors.New("row 1: must specify either an email or a name"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+
+			defer func(r io.Reader) { stdin = r }(stdin)
+			stdin = strings.NewReader(tc.data)
+
+			i := importInputs{ProjectInputs: projectInputs, File: tc.file}
+			assert.Equal(t, tc.expectedErr, i.Resolve(profile, nil))
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
 
 	"github.com/AlecAivazis/survey/v2"
 )
@@ -21,6 +22,7 @@ type multiUserInputs struct {
 	ProviderTypes []string
 	Pending       bool
 	Users         []string
+	CreatedBefore flags.Date
 }
 
 func validAuthProviderTypes() []interface{} {
@@ -60,13 +62,22 @@ func displayUserData(apt realm.AuthProviderType, user realm.User) string {
 
 func (i multiUserInputs) filter() realm.UserFilter {
 	return realm.UserFilter{
-		IDs:       i.Users,
-		State:     i.State,
-		Pending:   i.Pending,
-		Providers: realm.NewAuthProviderTypes(i.ProviderTypes...),
+		IDs:           i.Users,
+		State:         i.State,
+		Pending:       i.Pending,
+		Providers:     realm.NewAuthProviderTypes(i.ProviderTypes...),
+		CreatedBefore: i.CreatedBefore.Time,
 	}
 }
 
+// isFiltered returns whether users are found by a filter rather than by their ids
+func (i multiUserInputs) isFiltered() bool {
+	if len(i.Users) > 0 {
+		return false
+	}
+	return i.State != realm.UserStateNil || len(i.ProviderTypes) > 0 || i.Pending || !i.CreatedBefore.Time.IsZero()
+}
+
 func (i multiUserInputs) findUsers(realmClient realm.Client, groupID, appID string) ([]realm.User, error) {
 	foundUsers, err := realmClient.FindUsers(groupID, appID, i.filter())
 	if err != nil {
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -14,13 +15,23 @@ import (
 	"github.com/spf13/pflag"
 )
 
+const (
+	flagListLimit      = "limit"
+	flagListLimitUsage = "specify the maximum number of users to list, 0 lists every user"
+
+	// listPageSize is the number of users found and displayed at a time
+	listPageSize = 1000
+)
+
 // CommandMetaList is the command meta for the `user list` command
 var CommandMetaList = cli.CommandMeta{
 	Use:         "list",
 	Aliases:     []string{"ls"},
 	Description: "List the application users of your Realm app",
-	HelpText: `Displays a list of your Realm app's Users' details. The list is grouped by Auth
-Provider type and sorted by Last Authentication Date.`,
+	HelpText: `Displays a list of your Realm app's Users' details. Users are displayed a page
+at a time as they are found, with each page grouped by Auth Provider type and
+sorted by Last Authentication Date. Use "--limit" to stop listing after a number
+of Users.`,
 }
 
 // CommandList is the `user list` command
@@ -31,6 +42,7 @@ type CommandList struct {
 type listInputs struct {
 	cli.ProjectInputs
 	multiUserInputs
+	Limit int
 }
 
 // Flags is the command flags
@@ -45,6 +57,7 @@ func (cmd *CommandList) Flags(fs *pflag.FlagSet) {
 		flagProvider,
 		flagProviderUsage,
 	)
+	fs.IntVar(&cmd.inputs.Limit, flagListLimit, 0, flagListLimitUsage)
 }
 
 // Inputs is the command inputs
@@ -59,19 +72,62 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 		return err
 	}
 
-	users, err := cmd.inputs.findUsers(clients.Realm, app.GroupID, app.ID)
-	if err != nil {
-		return err
+	filter := cmd.inputs.filter()
+
+	if !cmd.inputs.isPaged() {
+		users, err := clients.Realm.FindUsers(app.GroupID, app.ID, filter)
+		if err != nil {
+			return err
+		}
+		if cmd.inputs.Limit > 0 && len(users) > cmd.inputs.Limit {
+			users = users[:cmd.inputs.Limit]
+		}
+		if len(users) == 0 {
+			ui.Print(terminal.NewTextLog("No available users to show"))
+			return nil
+		}
+
+		for start := 0; start < len(users); start += listPageSize {
+			end := start + listPageSize
+			if end > len(users) {
+				end = len(users)
+			}
+			ui.Print(userListLogs(users[start:end])...)
+		}
+		return nil
 	}
 
-	outputs := make(userOutputs, 0, len(users))
-	for _, user := range users {
-		outputs = append(outputs, userOutput{user, err})
+	var found int
+	for {
+		filter.Limit = listPageSize
+		if cmd.inputs.Limit > 0 && cmd.inputs.Limit-found < listPageSize {
+			filter.Limit = cmd.inputs.Limit - found
+		}
+
+		users, err := clients.Realm.FindUsers(app.GroupID, app.ID, filter)
+		if err != nil {
+			return err
+		}
+
+		if found == 0 && len(users) == 0 {
+			ui.Print(terminal.NewTextLog("No available users to show"))
+			return nil
+		}
+		found += len(users)
+
+		ui.Print(userListLogs(users)...)
+
+		if len(users) < filter.Limit || found == cmd.inputs.Limit {
+			return nil
+		}
+		filter.After = users[len(users)-1].ID
 	}
+}
 
-	if len(outputs) == 0 {
-		ui.Print(terminal.NewTextLog("No available users to show"))
-		return nil
+func userListLogs(users []realm.User) []terminal.Log {
+	outputs := make(userOutputs, 0, len(users))
+	for _, user := range users {
+		outputs = append(outputs, userOutput{user, nil})
 	}
 
 	outputsByProviderType := outputs.byProviderType()
@@ -91,9 +147,7 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 			tableRows(providerType, o, tableRowList)...,
 		))
 	}
-
-	ui.Print(logs...)
-	return nil
+	return logs
 }
 
 func getUserComparerByLastAuthentication(outputs []userOutput) func(i, j int) bool {
@@ -103,9 +157,19 @@ func getUserComparerByLastAuthentication(outputs []userOutput) func(i, j int) bo
 }
 
 func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Limit < 0 {
+		return errors.New("limit must not be negative")
+	}
 	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
 }
 
+// isPaged returns whether the server can find the users a page at a time, which it cannot
+// for pending users or users found by their ids; those users are found at once and
+// listed a page at a time instead
+func (i listInputs) isPaged() bool {
+	return len(i.Users) == 0 && !i.Pending
+}
+
 func tableRowList(output userOutput, row map[string]interface{}) {
 	timeString := "n/a"
 	if output.user.LastAuthenticationDate != 0 {
@@ -2,11 +2,14 @@ package user
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/flags"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
 )
@@ -44,6 +47,26 @@ func TestUserListHandler(t *testing.T) {
 		assert.Equal(t, "No available users to show\n", out.String())
 	})
 
+	t.Run("should display empty state message when no users are found by their ids", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandList{listInputs{
+			ProjectInputs:   cli.ProjectInputs{Project: projectID, App: appID},
+			multiUserInputs: multiUserInputs{Users: []string{"user-1"}},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No available users to show\n", out.String())
+	})
+
 	t.Run("should display users by auth provider type", func(t *testing.T) {
 		out, ui := mock.NewUI()
 
@@ -167,6 +190,109 @@ func TestUserListHandler(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("should find users a page at a time up to the limit", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		var pages [][2]interface{}
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			pages = append(pages, [2]interface{}{filter.After, filter.Limit})
+
+			users := make([]realm.User, 0, filter.Limit)
+			for i := 0; i < filter.Limit; i++ {
+				users = append(users, realm.User{ID: fmt.Sprintf("%s-%d", filter.After, i)})
+			}
+			return users, nil
+		}
+
+		cmd := &CommandList{listInputs{Limit: listPageSize + 1}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, [][2]interface{}{
+			{"", listPageSize},
+			{fmt.Sprintf("-%d", listPageSize-1), 1},
+		}, pages)
+	})
+
+	t.Run("should find users created before a time a page at a time", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		createdBefore := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		var filters []realm.UserFilter
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			filters = append(filters, filter)
+			return testUsers, nil
+		}
+
+		cmd := &CommandList{listInputs{multiUserInputs: multiUserInputs{CreatedBefore: flags.Date{Time: createdBefore}}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []realm.UserFilter{{Providers: realm.AuthProviderTypes{}, Limit: listPageSize, CreatedBefore: createdBefore}}, filters)
+	})
+
+	t.Run("should find pending users once and list them a page at a time up to the limit", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var filters []realm.UserFilter
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			filters = append(filters, filter)
+
+			users := make([]realm.User, 0, listPageSize+2)
+			for i := 0; i < listPageSize+2; i++ {
+				users = append(users, realm.User{
+					ID:         fmt.Sprintf("user-%d", i),
+					Identities: []realm.UserIdentity{{ProviderType: realm.AuthProviderTypeUserPassword}},
+				})
+			}
+			return users, nil
+		}
+
+		cmd := &CommandList{listInputs{multiUserInputs: multiUserInputs{Pending: true}, Limit: listPageSize + 1}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []realm.UserFilter{{Pending: true, Providers: realm.AuthProviderTypes{}}}, filters)
+
+		output := out.String()
+		assert.Equal(t, 2, strings.Count(output, "Provider type: User/Password"))
+		assert.True(t, strings.Contains(output, fmt.Sprintf("user-%d ", listPageSize)), "expected the last user within the limit to be listed")
+		assert.True(t, !strings.Contains(output, fmt.Sprintf("user-%d ", listPageSize+1)), "expected the users past the limit not to be listed")
+	})
+
+	t.Run("should stop finding users once a page is not full", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		var calls int
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			calls++
+			return testUsers, nil
+		}
+
+		cmd := &CommandList{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, 1, calls)
+	})
 }
 
 func TestTableRowList(t *testing.T) {
@@ -15,6 +15,21 @@ const (
 	headerDeleted                = "Deleted"
 	headerDetails                = "Details"
 	headerRevoked                = "Session Revoked"
+	headerRow                    = "Row"
+	headerCreated                = "Created"
+	headerExisting               = "Existing"
+	headerProviderType           = "Provider Type"
+	headerUsers                  = "Users"
+	headerConfirmed              = "Confirmed"
+	headerRejected               = "Rejected"
+	headerDisabled               = "Disabled"
+	headerPending                = "Pending"
+	headerTotal                  = "Total"
+	headerDeviceID               = "Device ID"
+	headerPlatform               = "Platform"
+	headerPlatformVersion        = "Platform Version"
+	headerSDKVersion             = "SDK Version"
+	headerLastSeen               = "Last Seen"
 )
 
 type userOutputs []userOutput
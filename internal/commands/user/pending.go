@@ -0,0 +1,97 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+var errPendingUserNoEmail = errors.New("pending user has no email")
+
+type pendingInputs struct {
+	cli.ProjectInputs
+	Users []string
+}
+
+func (i *pendingInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+func (i pendingInputs) resolveUsers(ui terminal.UI, realmClient realm.Client, groupID, appID, action string) ([]realm.User, error) {
+	found, err := multiUserInputs{Users: i.Users, Pending: true}.findUsers(realmClient, groupID, appID)
+	if err != nil {
+		return nil, err
+	}
+	if len(i.Users) > 0 || len(found) == 0 {
+		return found, nil
+	}
+
+	options := make([]string, 0, len(found))
+	usersByOption := make(map[string]realm.User, len(found))
+	for _, user := range found {
+		option := user.ID
+		if email := user.Email(); email != "" {
+			option = email + terminal.DelimiterInline + user.ID
+		}
+		options = append(options, option)
+		usersByOption[option] = user
+	}
+
+	var selected []string
+	if err := ui.AskOne(&selected, &survey.MultiSelect{
+		Message: fmt.Sprintf("Which pending user(s) would you like to %s?", action),
+		Options: options,
+	}); err != nil {
+		return nil, err
+	}
+
+	users := make([]realm.User, 0, len(selected))
+	for _, option := range selected {
+		users = append(users, usersByOption[option])
+	}
+	return users, nil
+}
+
+// processPendingUsers calls the process function with the email of each pending user
+func processPendingUsers(users []realm.User, process func(email string) error) userOutputs {
+	outputs := make(userOutputs, 0, len(users))
+	for _, user := range users {
+		err := errPendingUserNoEmail
+		if email := user.Email(); email != "" {
+			err = process(email)
+		}
+		outputs = append(outputs, userOutput{user, err})
+	}
+	return outputs
+}
+
+func pendingUsersTable(header string, outputs userOutputs) terminal.Log {
+	sort.SliceStable(outputs, getUserOutputComparerBySuccess(outputs))
+
+	rows := make([]map[string]interface{}, 0, len(outputs))
+	for _, output := range outputs {
+		row := map[string]interface{}{
+			headerEmail:   output.user.Email(),
+			headerID:      output.user.ID,
+			header:        output.err == nil,
+			headerDetails: "",
+		}
+		if output.err != nil {
+			row[headerDetails] = output.err.Error()
+		}
+		rows = append(rows, row)
+	}
+
+	return terminal.NewTableLog(
+		"Pending users",
+		[]string{headerEmail, headerID, header, headerDetails},
+		rows...,
+	)
+}
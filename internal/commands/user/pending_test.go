@@ -0,0 +1,127 @@
+package user
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+var testPendingUsers = []realm.User{
+	{ID: "pending-1", LoginIDs: []realm.UserLoginID{{IDType: "email", ID: "pending-1@test.com"}}},
+	{ID: "pending-2", LoginIDs: []realm.UserLoginID{{IDType: "email", ID: "pending-2@test.com"}}},
+	{ID: "pending-3"},
+}
+
+func TestUserConfirmHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	t.Run("should display empty state message when no pending users are found", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandConfirm{pendingInputs{ProjectInputs: cli.ProjectInputs{Project: app.GroupID, App: app.ID}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No pending users to confirm\n", out.String())
+	})
+
+	t.Run("should confirm the pending users by email", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+
+		var capturedFilter realm.UserFilter
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			capturedFilter = filter
+			return testPendingUsers, nil
+		}
+
+		var confirmed []string
+		realmClient.ConfirmPendingUserFn = func(groupID, appID, email string) error {
+			if email == "pending-2@test.com" {
+				return errors.New("client error")
+			}
+			confirmed = append(confirmed, email)
+			return nil
+		}
+
+		cmd := &CommandConfirm{pendingInputs{
+			ProjectInputs: cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			Users:         []string{"pending-1", "pending-2", "pending-3"},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"pending-1", "pending-2", "pending-3"}, capturedFilter.IDs)
+		assert.True(t, capturedFilter.Pending, "expected to find pending users")
+		assert.Equal(t, []string{"pending-1@test.com"}, confirmed)
+		assert.Equal(t, strings.Join([]string{
+			"Pending users",
+			"  Email               ID         Confirmed  Details                  ",
+			"  ------------------  ---------  ---------  -------------------------",
+			"  pending-2@test.com  pending-2  false      client error             ",
+			"                      pending-3  false      pending user has no email",
+			"  pending-1@test.com  pending-1  true                                ",
+			"",
+		}, "\n"), out.String())
+	})
+}
+
+func TestUserRejectHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	t.Run("should reject the selected pending users", func(t *testing.T) {
+		_, console, out, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return testPendingUsers, nil
+		}
+
+		var rejected []string
+		realmClient.DeletePendingUserFn = func(groupID, appID, email string) error {
+			rejected = append(rejected, email)
+			return nil
+		}
+
+		doneCh := make(chan (struct{}))
+		go func() {
+			defer close(doneCh)
+
+			console.ExpectString("Which pending user(s) would you like to reject?")
+			console.Send("pending-2")
+			console.SendLine(" ")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandReject{pendingInputs{ProjectInputs: cli.ProjectInputs{Project: app.GroupID, App: app.ID}}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+
+		console.Tty().Close() // flush the writers
+		<-doneCh              // wait for procedure to complete
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"pending-2@test.com"}, rejected)
+		assert.True(t, strings.Contains(out.String(), "pending-2@test.com  pending-2  true"), "expected output to show the rejected user")
+	})
+}
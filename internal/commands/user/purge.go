@@ -0,0 +1,334 @@
+package user
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagReport      = "report"
+	flagReportUsage = `specify the file to write the erasure report to (default: "erasure-<id>.json" in the working directory)`
+
+	flagSigningKeyFile      = "signing-key-file"
+	flagSigningKeyFileUsage = "specify a file containing the key to sign the erasure report with using HMAC-SHA256, ignoring any leading or trailing whitespace such as a trailing newline"
+
+	// deleteCustomUserDataSource is the function source which deletes the custom user data of a user
+	deleteCustomUserDataSource = `exports = function(dataSource, database, collection, userIDField, userID) {
+  return context.services.get(dataSource).db(database).collection(collection)
+    .deleteMany({ [userIDField]: userID })
+    .then(result => String(result.deletedCount));
+};`
+)
+
+// set of erasure steps
+const (
+	erasureStepRevokeSessions       = "revoke_sessions"
+	erasureStepDeleteCustomUserData = "delete_custom_user_data"
+	erasureStepDeleteUser           = "delete_user"
+)
+
+// set of erasure step statuses
+const (
+	erasureStatusCompleted = "completed"
+	erasureStatusSkipped   = "skipped"
+	erasureStatusFailed    = "failed"
+)
+
+// CommandMetaPurge is the command meta for the `user purge` command
+var CommandMetaPurge = cli.CommandMeta{
+	Use:         "purge <id>",
+	Display:     "user purge",
+	Description: "Erase an application User and their data from your Realm app",
+	HelpText: `Erases an application User of your Realm app in a single step, as required by
+data erasure requests. The User's sessions are revoked, their custom user data
+document is deleted from the linked data source configured for custom user
+data, and finally the User is deleted.
+
+An erasure report recording the outcome of each step is written to the file
+specified with "--report", along with its SHA-256 digest. Use "--signing-key-file"
+to also sign the report with HMAC-SHA256, with any leading or trailing
+whitespace of the key file, such as a trailing newline, trimmed from the key.
+If a step fails, the report is still written and the remaining steps are not
+run, so the command can be run again.`,
+}
+
+// CommandPurge is the `user purge` command
+type CommandPurge struct {
+	inputs purgeInputs
+	purged int
+}
+
+type purgeInputs struct {
+	cli.ProjectInputs
+	UserID         string
+	Report         string
+	SigningKeyFile string
+}
+
+// erasureReport is the record of the steps taken to erase a user
+type erasureReport struct {
+	AppID       string        `json:"app_id"`
+	UserID      string        `json:"user_id"`
+	Steps       []erasureStep `json:"steps"`
+	CompletedAt time.Time     `json:"completed_at"`
+}
+
+type erasureStep struct {
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	Details     string    `json:"details,omitempty"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// signedErasureReport is the erasure report along with its digest and, when signed, its signature
+type signedErasureReport struct {
+	Report    json.RawMessage `json:"report"`
+	SHA256    string          `json:"sha256"`
+	Signature string          `json:"hmac_sha256,omitempty"`
+}
+
+// Args is the command args
+func (cmd *CommandPurge) Args(args []string) error {
+	if len(args) != 1 {
+		return errors.New("must specify the id of the user to purge")
+	}
+	cmd.inputs.UserID = args[0]
+	return nil
+}
+
+// Flags is the command flags
+func (cmd *CommandPurge) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.Report, flagReport, "", flagReportUsage)
+	fs.StringVar(&cmd.inputs.SigningKeyFile, flagSigningKeyFile, "", flagSigningKeyFileUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandPurge) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandPurge) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	var signingKey []byte
+	if cmd.inputs.SigningKeyFile != "" {
+		key, err := ioutil.ReadFile(cmd.inputs.SigningKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read signing key: %w", err)
+		}
+		signingKey = bytes.TrimSpace(key)
+		if len(signingKey) == 0 {
+			return fmt.Errorf("signing key file %s is empty", cmd.inputs.SigningKeyFile)
+		}
+	}
+
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	users, err := clients.Realm.FindUsers(app.GroupID, app.ID, realm.UserFilter{IDs: []string{cmd.inputs.UserID}})
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return fmt.Errorf("user %s not found in app %s", cmd.inputs.UserID, app.ClientAppID)
+	}
+
+	proceed, err := ui.Confirm("Are you sure you want to permanently erase user %s and their custom user data?", cmd.inputs.UserID)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	report := erasureReport{AppID: app.ClientAppID, UserID: cmd.inputs.UserID}
+
+	stepErr := cmd.purge(clients.Realm, app, &report)
+	report.CompletedAt = time.Now()
+
+	if err := writeErasureReport(cmd.inputs.Report, report, signingKey); err != nil {
+		return err
+	}
+
+	if stepErr != nil {
+		ui.Print(terminal.NewTextLog("Wrote the erasure report to %s", cmd.inputs.Report))
+		return stepErr
+	}
+	cmd.purged = 1
+
+	ui.Print(
+		terminal.NewTextLog("Successfully purged user %s from app %s", cmd.inputs.UserID, app.ClientAppID),
+		terminal.NewTextLog("Wrote the erasure report to %s", cmd.inputs.Report),
+	)
+	return nil
+}
+
+// ResourcesAffected is the number of users purged
+func (cmd *CommandPurge) ResourcesAffected() int {
+	return cmd.purged
+}
+
+// purge runs each erasure step in order, recording its outcome in the report,
+// and stops at the first step which fails
+func (cmd *CommandPurge) purge(realmClient realm.Client, app realm.App, report *erasureReport) error {
+	record := func(name string, run func() (string, bool, error)) error {
+		details, skipped, err := run()
+
+		step := erasureStep{Name: name, Status: erasureStatusCompleted, Details: details, CompletedAt: time.Now()}
+		if skipped {
+			step.Status = erasureStatusSkipped
+		}
+		if err != nil {
+			step.Status = erasureStatusFailed
+			step.Details = err.Error()
+		}
+		report.Steps = append(report.Steps, step)
+
+		if err != nil {
+			return fmt.Errorf("failed to %s: %w", erasureStepDisplay(name), err)
+		}
+		return nil
+	}
+
+	if err := record(erasureStepRevokeSessions, func() (string, bool, error) {
+		return "", false, realmClient.RevokeUserSessions(app.GroupID, app.ID, cmd.inputs.UserID)
+	}); err != nil {
+		return err
+	}
+
+	if err := record(erasureStepDeleteCustomUserData, func() (string, bool, error) {
+		return deleteCustomUserData(realmClient, app, cmd.inputs.UserID)
+	}); err != nil {
+		return err
+	}
+
+	return record(erasureStepDeleteUser, func() (string, bool, error) {
+		return "", false, realmClient.DeleteUser(app.GroupID, app.ID, cmd.inputs.UserID)
+	})
+}
+
+// deleteCustomUserData deletes the custom user data of the user through the linked data source,
+// which is skipped when custom user data is not enabled
+func deleteCustomUserData(realmClient realm.Client, app realm.App, userID string) (string, bool, error) {
+	description, err := realmClient.AppDescription(app.GroupID, app.ID)
+	if err != nil {
+		return "", false, err
+	}
+
+	customUserData := description.CustomUserData
+	if !customUserData.Enabled {
+		return "custom user data is not enabled", true, nil
+	}
+
+	args, err := json.Marshal([]interface{}{
+		customUserData.DataSource,
+		customUserData.Database,
+		customUserData.Collection,
+		customUserData.UserIDField,
+		userID,
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	res, err := realmClient.AppDebugExecuteFunctionSource(
+		app.GroupID,
+		app.ID,
+		"",
+		deleteCustomUserDataSource,
+		fmt.Sprintf("exports(%s)", bytes.Trim(args, "[]")),
+	)
+	if err != nil {
+		return "", false, err
+	}
+	if res.Error != nil {
+		return "", false, errors.New(res.ErrorMessage())
+	}
+
+	deleted, _ := res.Result.(string)
+	if _, err := strconv.Atoi(deleted); err != nil {
+		return "", false, fmt.Errorf("unexpected result: %v", res.Result)
+	}
+
+	return fmt.Sprintf(
+		"deleted %s document(s) from collection '%s.%s' of data source '%s'",
+		deleted,
+		customUserData.Database,
+		customUserData.Collection,
+		customUserData.DataSource,
+	), false, nil
+}
+
+func erasureStepDisplay(name string) string {
+	switch name {
+	case erasureStepRevokeSessions:
+		return "revoke user sessions"
+	case erasureStepDeleteCustomUserData:
+		return "delete custom user data"
+	case erasureStepDeleteUser:
+		return "delete user"
+	}
+	return name
+}
+
+func writeErasureReport(path string, report erasureReport, signingKey []byte) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256(data)
+	signed := signedErasureReport{Report: data, SHA256: hex.EncodeToString(digest[:])}
+
+	if len(signingKey) > 0 {
+		mac := hmac.New(sha256.New, signingKey)
+		mac.Write(data)
+		signed.Signature = hex.EncodeToString(mac.Sum(nil))
+	}
+
+	// the report is written compactly, so its digest and signature match its bytes as written
+	out, err := json.Marshal(signed)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, out, 0600)
+}
+
+func (i *purgeInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Report == "" {
+		i.Report = filepath.Join(profile.WorkingDirectory, fmt.Sprintf("erasure-%s.json", i.UserID))
+	} else {
+		report, err := homedir.Expand(i.Report)
+		if err != nil {
+			return err
+		}
+		i.Report = report
+	}
+
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
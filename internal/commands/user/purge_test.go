@@ -0,0 +1,231 @@
+package user
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestUserPurgeHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "app-abcde"}
+
+	customUserData := realm.CustomUserDataSummary{
+		Enabled:     true,
+		DataSource:  "mongodb-atlas",
+		Database:    "app",
+		Collection:  "users",
+		UserIDField: "user_id",
+	}
+
+	newRealmClient := func(calls *[]string) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return []realm.User{{ID: filter.IDs[0]}}, nil
+		}
+		realmClient.RevokeUserSessionFn = func(groupID, appID, userID string) error {
+			*calls = append(*calls, "revoke "+userID)
+			return nil
+		}
+		realmClient.AppDescriptionFn = func(groupID, appID string) (realm.AppDescription, error) {
+			return realm.AppDescription{CustomUserData: customUserData}, nil
+		}
+		realmClient.AppDebugExecuteFunctionSourceFn = func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			*calls = append(*calls, evalSource)
+			return realm.ExecutionResults{Result: "1"}, nil
+		}
+		realmClient.DeleteUserFn = func(groupID, appID, userID string) error {
+			*calls = append(*calls, "delete "+userID)
+			return nil
+		}
+		return realmClient
+	}
+
+	readReport := func(t *testing.T, path string) (signedErasureReport, erasureReport) {
+		t.Helper()
+
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+
+		var signed signedErasureReport
+		assert.Nil(t, json.Unmarshal(data, &signed))
+
+		var report erasureReport
+		assert.Nil(t, json.Unmarshal(signed.Report, &report))
+		return signed, report
+	}
+
+	t.Run("should purge the user and write a signed erasure report", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		keyFile := filepath.Join(tmpDir, "key")
+		assert.Nil(t, ioutil.WriteFile(keyFile, []byte("secret\n"), 0600))
+
+		reportPath := filepath.Join(tmpDir, "reports", "erasure.json")
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		var calls []string
+		realmClient := newRealmClient(&calls)
+
+		cmd := &CommandPurge{inputs: purgeInputs{UserID: "user1", Report: reportPath, SigningKeyFile: keyFile}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, fmt.Sprintf(`Successfully purged user user1 from app app-abcde
+Wrote the erasure report to %s
+`, reportPath), out.String())
+		assert.Equal(t, []string{
+			"revoke user1",
+			`exports("mongodb-atlas","app","users","user_id","user1")`,
+			"delete user1",
+		}, calls)
+
+		signed, report := readReport(t, reportPath)
+		assert.Equal(t, "app-abcde", report.AppID)
+		assert.Equal(t, "user1", report.UserID)
+		assert.Equal(t, 3, len(report.Steps))
+		for i, expected := range []erasureStep{
+			{Name: erasureStepRevokeSessions, Status: erasureStatusCompleted},
+			{Name: erasureStepDeleteCustomUserData, Status: erasureStatusCompleted, Details: "deleted 1 document(s) from collection 'app.users' of data source 'mongodb-atlas'"},
+			{Name: erasureStepDeleteUser, Status: erasureStatusCompleted},
+		} {
+			expected.CompletedAt = report.Steps[i].CompletedAt
+			assert.Equal(t, expected, report.Steps[i])
+		}
+
+		digest := sha256.Sum256(signed.Report)
+		assert.Equal(t, hex.EncodeToString(digest[:]), signed.SHA256)
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write(signed.Report)
+		assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), signed.Signature)
+	})
+
+	t.Run("should skip deleting custom user data when it is not enabled", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		reportPath := filepath.Join(tmpDir, "erasure.json")
+
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		var calls []string
+		realmClient := newRealmClient(&calls)
+		realmClient.AppDescriptionFn = func(groupID, appID string) (realm.AppDescription, error) {
+			return realm.AppDescription{}, nil
+		}
+
+		cmd := &CommandPurge{inputs: purgeInputs{UserID: "user1", Report: reportPath}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"revoke user1", "delete user1"}, calls)
+
+		signed, report := readReport(t, reportPath)
+		assert.Equal(t, "", signed.Signature)
+		assert.Equal(t, erasureStatusSkipped, report.Steps[1].Status)
+		assert.Equal(t, "custom user data is not enabled", report.Steps[1].Details)
+	})
+
+	t.Run("should stop at a failed step and still write the erasure report", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		reportPath := filepath.Join(tmpDir, "erasure.json")
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		var calls []string
+		realmClient := newRealmClient(&calls)
+		realmClient.AppDebugExecuteFunctionSourceFn = func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+			return realm.ExecutionResults{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandPurge{inputs: purgeInputs{UserID: "user1", Report: reportPath}}
+
+		err = cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, "failed to delete custom user data: something bad happened", err.Error())
+		assert.Equal(t, "Wrote the erasure report to "+reportPath+"\n", out.String())
+		assert.Equal(t, []string{"revoke user1"}, calls)
+
+		_, report := readReport(t, reportPath)
+		assert.Equal(t, 2, len(report.Steps))
+		assert.Equal(t, erasureStatusFailed, report.Steps[1].Status)
+		assert.Equal(t, "something bad happened", report.Steps[1].Details)
+	})
+
+	t.Run("should return an error when the user is not found", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		var calls []string
+		realmClient := newRealmClient(&calls)
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandPurge{inputs: purgeInputs{UserID: "user1"}}
+
+		assert.Equal(t, errors.New("user user1 not found in app app-abcde"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, 0, len(calls))
+	})
+
+	t.Run("should return an error when the signing key file only contains whitespace", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		keyFile := filepath.Join(tmpDir, "key")
+		assert.Nil(t, ioutil.WriteFile(keyFile, []byte(" \n"), 0600))
+
+		_, ui := mock.NewUI()
+
+		var calls []string
+		realmClient := newRealmClient(&calls)
+
+		cmd := &CommandPurge{inputs: purgeInputs{UserID: "user1", SigningKeyFile: keyFile}}
+
+		assert.Equal(t, fmt.Errorf("signing key file %s is empty", keyFile), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, 0, len(calls))
+	})
+}
+
+func TestUserPurgeInputs(t *testing.T) {
+	t.Run("should default the report to a file named after the user", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		profile.WorkingDirectory = "/path/to/wd"
+
+		i := purgeInputs{ProjectInputs: cli.ProjectInputs{App: "app-abcde"}, UserID: "user1"}
+
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, filepath.Join("/path/to/wd", "erasure-user1.json"), i.Report)
+	})
+}
+
+func TestUserPurgeArgs(t *testing.T) {
+	t.Run("should require the id of the user", func(t *testing.T) {
+		cmd := &CommandPurge{}
+		assert.Equal(t, errors.New("must specify the id of the user to purge"), cmd.Args(nil))
+		assert.Nil(t, cmd.Args([]string{"user1"}))
+		assert.Equal(t, "user1", cmd.inputs.UserID)
+	})
+}
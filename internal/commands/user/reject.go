@@ -0,0 +1,62 @@
+package user
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaReject is the command meta for the `user reject` command
+var CommandMetaReject = cli.CommandMeta{
+	Use:         "reject",
+	Display:     "user reject",
+	Description: "Reject pending application Users of your Realm app",
+	HelpText: `Rejects Email/Password Users of your Realm app which are pending email
+confirmation by removing their registration, so they must register again to log
+in. You can reject multiple pending Users at once with the "--user" flag, or
+select them from a list of every pending User. Use "user list --pending" to
+display the pending Users of your Realm app.`,
+}
+
+// CommandReject is the `user reject` command
+type CommandReject struct {
+	inputs pendingInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandReject) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+	fs.StringSliceVarP(&cmd.inputs.Users, flagUser, flagUserShort, []string{}, flagUserRejectUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandReject) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandReject) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	users, err := cmd.inputs.resolveUsers(ui, clients.Realm, app.GroupID, app.ID, "reject")
+	if err != nil {
+		return err
+	}
+
+	if len(users) == 0 {
+		ui.Print(terminal.NewTextLog("No pending users to reject"))
+		return nil
+	}
+
+	outputs := processPendingUsers(users, func(email string) error {
+		return clients.Realm.DeletePendingUser(app.GroupID, app.ID, email)
+	})
+
+	ui.Print(pendingUsersTable(headerRejected, outputs))
+	return nil
+}
@@ -19,12 +19,17 @@ var CommandMetaRevoke = cli.CommandMeta{
 	Display:     "user revoke",
 	Description: "Revoke an application User’s sessions from your Realm app",
 	HelpText: `Logs a User out of your Realm app. A revoked User can log in again if they
-provide valid credentials.`,
+provide valid credentials.
+
+Use "--all" to revoke the sessions of every User of your Realm app, e.g. after
+credentials have leaked. Combine "--all" with "--state", "--provider" or
+"--pending" to only revoke the sessions of the Users matching those filters.`,
 }
 
 // CommandRevoke is the `user revoke` command
 type CommandRevoke struct {
-	inputs revokeInputs
+	inputs  revokeInputs
+	revoked int
 }
 
 // Flags is the command flags
@@ -38,6 +43,7 @@ func (cmd *CommandRevoke) Flags(fs *pflag.FlagSet) {
 		flagProvider,
 		flagProviderUsage,
 	)
+	fs.BoolVar(&cmd.inputs.All, flagAll, false, flagAllRevokeUsage)
 }
 
 // Inputs is the command inputs
@@ -52,6 +58,10 @@ func (cmd *CommandRevoke) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
+	if cmd.inputs.All {
+		return cmd.revokeAll(ui, clients.Realm, app)
+	}
+
 	found, err := cmd.inputs.findUsers(clients.Realm, app.GroupID, app.ID)
 	if err != nil {
 		return err
@@ -65,6 +75,9 @@ func (cmd *CommandRevoke) Handler(profile *user.Profile, ui terminal.UI, clients
 	outputs := make(userOutputs, 0, len(users))
 	for _, user := range users {
 		err := clients.Realm.RevokeUserSessions(app.GroupID, app.ID, user.ID)
+		if err == nil {
+			cmd.revoked++
+		}
 		outputs = append(outputs, userOutput{user, err})
 	}
 
@@ -95,15 +108,80 @@ func (cmd *CommandRevoke) Handler(profile *user.Profile, ui terminal.UI, clients
 	return nil
 }
 
+// ResourcesAffected is the number of users whose sessions were revoked
+func (cmd *CommandRevoke) ResourcesAffected() int {
+	return cmd.revoked
+}
+
+func (cmd *CommandRevoke) revokeAll(ui terminal.UI, realmClient realm.Client, app realm.App) error {
+	message := "Are you sure you want to revoke the sessions of every user?"
+	if cmd.inputs.isFiltered() {
+		message = "Are you sure you want to revoke the sessions of every user matching the filters?"
+	}
+	proceed, err := ui.Confirm(message)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	s := ui.Spinner("Revoking user sessions...")
+
+	revokeAll := func() ([]realm.UserSessionsRevocation, error) {
+		s.Start()
+		defer s.Stop()
+
+		return realmClient.RevokeAllUserSessions(app.GroupID, app.ID, cmd.inputs.filter(), func(processed, total int) {
+			s.Lock()
+			s.Suffix = fmt.Sprintf(" Revoking user sessions... (%d/%d)", processed, total)
+			s.Unlock()
+		})
+	}
+
+	revocations, err := revokeAll()
+	if err != nil {
+		return err
+	}
+
+	if len(revocations) == 0 {
+		ui.Print(terminal.NewTextLog("No users to revoke sessions for"))
+		return nil
+	}
+
+	var failed []map[string]interface{}
+	for _, revocation := range revocations {
+		if revocation.Err == nil {
+			cmd.revoked++
+			continue
+		}
+		failed = append(failed, map[string]interface{}{
+			headerID:      revocation.User.ID,
+			headerDetails: revocation.Err.Error(),
+		})
+	}
+
+	ui.Print(terminal.NewTextLog("Revoked the sessions of %d of %d user(s)", len(revocations)-len(failed), len(revocations)))
+	if len(failed) > 0 {
+		ui.Print(terminal.NewTableLog("Failed to revoke the sessions of these users", []string{headerID, headerDetails}, failed...))
+		return fmt.Errorf("failed to revoke the sessions of %d user(s)", len(failed))
+	}
+	return nil
+}
+
 type revokeInputs struct {
 	cli.ProjectInputs
 	multiUserInputs
+	All bool
 }
 
 func (i *revokeInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
 		return err
 	}
+	if i.All && len(i.Users) > 0 {
+		return fmt.Errorf(`cannot specify both "--%s" and "--%s"`, flagAll, flagUser)
+	}
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"bytes"
 	"errors"
 	"strings"
 	"testing"
@@ -35,7 +36,7 @@ func TestUserRevokeHandler(t *testing.T) {
 			return nil
 		}
 
-		cmd := &CommandRevoke{revokeInputs{ProjectInputs: cli.ProjectInputs{
+		cmd := &CommandRevoke{inputs: revokeInputs{ProjectInputs: cli.ProjectInputs{
 			Project: projectID,
 			App:     appID,
 		}}}
@@ -58,7 +59,7 @@ func TestUserRevokeHandler(t *testing.T) {
 			return nil
 		}
 
-		cmd := &CommandRevoke{revokeInputs{
+		cmd := &CommandRevoke{inputs: revokeInputs{
 			ProjectInputs: cli.ProjectInputs{
 				Project: projectID,
 				App:     appID,
@@ -142,7 +143,7 @@ func TestUserRevokeHandler(t *testing.T) {
 				return tc.revokeErr
 			}
 
-			cmd := &CommandRevoke{revokeInputs{
+			cmd := &CommandRevoke{inputs: revokeInputs{
 				ProjectInputs: cli.ProjectInputs{
 					Project: projectID,
 					App:     appID,
@@ -236,3 +237,81 @@ func TestTableRowRevoke(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRevokeAll(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "projectID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+	t.Run("should revoke the sessions of every user matching the filters", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+
+		var capturedFilter realm.UserFilter
+		realmClient.RevokeAllUserSessionsFn = func(groupID, appID string, filter realm.UserFilter, onProgress func(processed, total int)) ([]realm.UserSessionsRevocation, error) {
+			capturedFilter = filter
+			revocations := make([]realm.UserSessionsRevocation, 0, len(testUsers))
+			for i, user := range testUsers {
+				revocations = append(revocations, realm.UserSessionsRevocation{User: user})
+				onProgress(i+1, len(testUsers))
+			}
+			return revocations, nil
+		}
+
+		cmd := &CommandRevoke{inputs: revokeInputs{
+			ProjectInputs:   cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			multiUserInputs: multiUserInputs{State: realm.UserStateEnabled},
+			All:             true,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, realm.UserStateEnabled, capturedFilter.State)
+		assert.Equal(t, "Revoked the sessions of 4 of 4 user(s)\n", out.String())
+	})
+
+	t.Run("should report the users whose sessions failed to be revoked", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.RevokeAllUserSessionsFn = func(groupID, appID string, filter realm.UserFilter, onProgress func(processed, total int)) ([]realm.UserSessionsRevocation, error) {
+			return []realm.UserSessionsRevocation{
+				{User: testUsers[0]},
+				{User: testUsers[1], Err: errors.New("client error")},
+			}, nil
+		}
+
+		cmd := &CommandRevoke{inputs: revokeInputs{
+			ProjectInputs: cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			All:           true,
+		}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("failed to revoke the sessions of 1 user(s)"), err)
+		assert.Equal(t, strings.Join([]string{
+			"Revoked the sessions of 1 of 2 user(s)",
+			"Failed to revoke the sessions of these users",
+			"  ID      Details     ",
+			"  ------  ------------",
+			"  user-2  client error",
+			"",
+		}, "\n"), out.String())
+	})
+
+	t.Run("should not allow all with user ids", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		inputs := revokeInputs{
+			ProjectInputs:   cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			multiUserInputs: multiUserInputs{Users: []string{"user-1"}},
+			All:             true,
+		}
+		assert.Equal(t, errors.New(`cannot specify both "--all" and "--user"`), inputs.Resolve(profile, nil))
+	})
+}
@@ -0,0 +1,161 @@
+package user
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaStats is the command meta for the `user stats` command
+var CommandMetaStats = cli.CommandMeta{
+	Use:         "stats",
+	Aliases:     []string{"count"},
+	Description: "Summarize the application users of your Realm app",
+	HelpText: `Displays the number of your Realm app's Users, broken down by Auth Provider
+type and by whether they are enabled, disabled or pending confirmation, without
+listing each User. A User with identities from several Auth Providers is counted
+once for each of them, and once in the total. Use "--output-format json" to
+report the statistics as JSON.`,
+}
+
+// CommandStats is the `user stats` command
+type CommandStats struct {
+	inputs statsInputs
+}
+
+type statsInputs struct {
+	cli.ProjectInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandStats) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandStats) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandStats) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	var stats userStats
+
+	filter := realm.UserFilter{Limit: listPageSize}
+	for {
+		users, err := clients.Realm.FindUsers(app.GroupID, app.ID, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			stats.add(user)
+		}
+
+		if len(users) < filter.Limit {
+			break
+		}
+		filter.After = users[len(users)-1].ID
+	}
+
+	pendingUsers, err := clients.Realm.FindUsers(app.GroupID, app.ID, realm.UserFilter{Pending: true})
+	if err != nil {
+		return err
+	}
+	stats.addPending(len(pendingUsers))
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("User statistics for %s", app.Name),
+		[]string{headerProviderType, headerEnabled, headerDisabled, headerPending, headerTotal},
+		stats.rows()...,
+	))
+	return nil
+}
+
+func (i *statsInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// userStatsCount is the number of users in each state
+type userStatsCount struct {
+	enabled  int
+	disabled int
+	pending  int
+}
+
+func (c *userStatsCount) add(user realm.User) {
+	if user.Disabled {
+		c.disabled++
+	} else {
+		c.enabled++
+	}
+}
+
+func (c userStatsCount) row(providerType string) map[string]interface{} {
+	return map[string]interface{}{
+		headerProviderType: providerType,
+		headerEnabled:      c.enabled,
+		headerDisabled:     c.disabled,
+		headerPending:      c.pending,
+		headerTotal:        c.enabled + c.disabled + c.pending,
+	}
+}
+
+type userStats struct {
+	total      userStatsCount
+	byProvider map[realm.AuthProviderType]*userStatsCount
+}
+
+func (s *userStats) provider(providerType realm.AuthProviderType) *userStatsCount {
+	if s.byProvider == nil {
+		s.byProvider = map[realm.AuthProviderType]*userStatsCount{}
+	}
+	count, ok := s.byProvider[providerType]
+	if !ok {
+		count = &userStatsCount{}
+		s.byProvider[providerType] = count
+	}
+	return count
+}
+
+func (s *userStats) add(user realm.User) {
+	s.total.add(user)
+
+	providerTypes := map[realm.AuthProviderType]struct{}{}
+	for _, identity := range user.Identities {
+		if _, ok := providerTypes[identity.ProviderType]; ok {
+			continue
+		}
+		providerTypes[identity.ProviderType] = struct{}{}
+		s.provider(identity.ProviderType).add(user)
+	}
+}
+
+// addPending counts pending users, which are only registered with email and password
+func (s *userStats) addPending(n int) {
+	if n == 0 {
+		return
+	}
+	s.total.pending += n
+	s.provider(realm.AuthProviderTypeUserPassword).pending += n
+}
+
+func (s userStats) rows() []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(s.byProvider)+1)
+	for _, providerType := range realm.ValidAuthProviderTypes {
+		if count, ok := s.byProvider[providerType]; ok {
+			rows = append(rows, count.row(providerType.Display()))
+		}
+	}
+	return append(rows, s.total.row(headerTotal))
+}
@@ -0,0 +1,110 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestUserStatsHandler(t *testing.T) {
+	app := realm.App{
+		ID:          "appID",
+		GroupID:     "projectID",
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+
+	t.Run("should display the user totals by provider type and state", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var filters []realm.UserFilter
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			filters = append(filters, filter)
+			if filter.Pending {
+				return []realm.User{{ID: "pending-1"}, {ID: "pending-2"}}, nil
+			}
+			return append(testUsers,
+				realm.User{
+					ID:       "user-5",
+					Disabled: true,
+					Identities: []realm.UserIdentity{
+						{ProviderType: realm.AuthProviderTypeUserPassword},
+						{ProviderType: realm.AuthProviderTypeAnonymous},
+					},
+				},
+			), nil
+		}
+
+		cmd := &CommandStats{statsInputs{cli.ProjectInputs{Project: "projectID", App: "appID"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `User statistics for eggcorn
+  Provider Type  Enabled  Disabled  Pending  Total
+  -------------  -------  --------  -------  -----
+  User/Password  1        1         2        4    
+  ApiKey         1        0         0        1    
+  Anonymous      1        1         0        2    
+  Custom JWT     1        0         0        1    
+  Total          4        1         2        7    
+`, out.String())
+
+		assert.Equal(t, 2, len(filters))
+		assert.Equal(t, listPageSize, filters[0].Limit)
+		assert.True(t, filters[1].Pending, "expected pending users to be found")
+	})
+
+	t.Run("should page through every user", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		var afters []string
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			if filter.Pending {
+				return nil, nil
+			}
+			afters = append(afters, filter.After)
+			if filter.After != "" {
+				return nil, nil
+			}
+			users := make([]realm.User, filter.Limit)
+			for i := range users {
+				users[i] = realm.User{ID: "user"}
+			}
+			return users, nil
+		}
+
+		cmd := &CommandStats{statsInputs{cli.ProjectInputs{Project: "projectID", App: "appID"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"", "user"}, afters)
+	})
+
+	t.Run("should return an error when finding users fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandStats{statsInputs{cli.ProjectInputs{Project: "projectID", App: "appID"}}}
+
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
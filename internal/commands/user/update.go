@@ -0,0 +1,166 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagNewEmail      = "new-email"
+	flagNewEmailUsage = "set the new email of the user"
+
+	flagNewPassword      = "new-password"
+	flagNewPasswordUsage = "set the new password of the user"
+
+	flagSendResetEmail      = "send-reset-email"
+	flagSendResetEmailUsage = "include to send the user an email to reset their password"
+)
+
+// CommandMetaUpdate is the command meta for the `user update` command
+var CommandMetaUpdate = cli.CommandMeta{
+	Use:         "update",
+	Display:     "user update",
+	Description: "Update the email or password of an application User of your Realm app",
+	HelpText: `Updates the credentials of an Email/Password User of your Realm app. You can
+change the User's email with "--new-email" and either set their password with
+"--new-password" or send them an email to reset it themselves with
+"--send-reset-email". When the email is also changed, the reset email is sent to
+the new email.`,
+}
+
+// CommandUpdate is the `user update` command
+type CommandUpdate struct {
+	inputs updateInputs
+}
+
+type updateInputs struct {
+	cli.ProjectInputs
+	User           string
+	NewEmail       string
+	NewPassword    string
+	SendResetEmail bool
+}
+
+// Flags is the command flags
+func (cmd *CommandUpdate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.User, flagUser, flagUserShort, "", flagUserUpdateUsage)
+	fs.StringVar(&cmd.inputs.NewEmail, flagNewEmail, "", flagNewEmailUsage)
+	fs.StringVar(&cmd.inputs.NewPassword, flagNewPassword, "", flagNewPasswordUsage)
+	fs.BoolVar(&cmd.inputs.SendResetEmail, flagSendResetEmail, false, flagSendResetEmailUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandUpdate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandUpdate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	found, err := cmd.inputs.resolveUser(ui, clients.Realm, app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	email, _ := found.Data[userDataEmail].(string)
+
+	if cmd.inputs.NewEmail != "" {
+		if err := clients.Realm.UpdateUserEmail(app.GroupID, app.ID, found.ID, cmd.inputs.NewEmail); err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("Successfully updated the email of user %s to %s", found.ID, cmd.inputs.NewEmail))
+		email = cmd.inputs.NewEmail
+	}
+
+	if cmd.inputs.NewPassword != "" {
+		if err := clients.Realm.UpdateUserPassword(app.GroupID, app.ID, found.ID, cmd.inputs.NewPassword); err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("Successfully updated the password of user %s", found.ID))
+	}
+
+	if cmd.inputs.SendResetEmail {
+		if email == "" {
+			return fmt.Errorf("user %s has no email to send a password reset to", found.ID)
+		}
+		if err := clients.Realm.SendUserPasswordReset(app.GroupID, app.ID, email); err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("Successfully sent a password reset email to %s", email))
+	}
+
+	return nil
+}
+
+func (i *updateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false); err != nil {
+		return err
+	}
+
+	if i.NewEmail == "" && i.NewPassword == "" && !i.SendResetEmail {
+		return fmt.Errorf(
+			`must specify at least one of "--%s", "--%s" or "--%s"`,
+			flagNewEmail,
+			flagNewPassword,
+			flagSendResetEmail,
+		)
+	}
+	if i.NewPassword != "" && i.SendResetEmail {
+		return fmt.Errorf(`cannot specify both "--%s" and "--%s"`, flagNewPassword, flagSendResetEmail)
+	}
+	return nil
+}
+
+func (i updateInputs) resolveUser(ui terminal.UI, realmClient realm.Client, groupID, appID string) (realm.User, error) {
+	filter := realm.UserFilter{Providers: []realm.AuthProviderType{realm.AuthProviderTypeUserPassword}}
+	if i.User != "" {
+		filter.IDs = []string{i.User}
+	}
+
+	users, err := realmClient.FindUsers(groupID, appID, filter)
+	if err != nil {
+		return realm.User{}, err
+	}
+
+	if i.User != "" {
+		if len(users) == 0 {
+			return realm.User{}, fmt.Errorf("no email/password user found with id %s", i.User)
+		}
+		return users[0], nil
+	}
+
+	if len(users) == 0 {
+		return realm.User{}, errors.New("no email/password users found")
+	}
+
+	options := make([]string, 0, len(users))
+	usersByOption := make(map[string]realm.User, len(users))
+	for _, user := range users {
+		option := displayUser(realm.AuthProviderTypeUserPassword, user)
+		options = append(options, option)
+		usersByOption[option] = user
+	}
+
+	var selection string
+	if err := ui.AskOne(&selection, &survey.Select{
+		Message: "Which user would you like to update?",
+		Options: options,
+	}); err != nil {
+		return realm.User{}, err
+	}
+	return usersByOption[selection], nil
+}
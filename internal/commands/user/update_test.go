@@ -0,0 +1,152 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestUserUpdateHandler(t *testing.T) {
+	app := realm.App{
+		ID:          "appID",
+		GroupID:     "projectID",
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+	testUser := realm.User{
+		ID:         "user-1",
+		Identities: []realm.UserIdentity{{ProviderType: realm.AuthProviderTypeUserPassword}},
+		Data:       map[string]interface{}{"email": "user-1@test.com"},
+	}
+
+	newRealmClient := func() mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return []realm.User{testUser}, nil
+		}
+		return realmClient
+	}
+
+	t.Run("should update the email and send a password reset to the new email", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newRealmClient()
+
+		var updatedEmail, resetEmail string
+		realmClient.UpdateUserEmailFn = func(groupID, appID, userID, email string) error {
+			updatedEmail = email
+			return nil
+		}
+		realmClient.SendUserPasswordResetFn = func(groupID, appID, email string) error {
+			resetEmail = email
+			return nil
+		}
+
+		cmd := &CommandUpdate{updateInputs{
+			ProjectInputs:  cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			User:           testUser.ID,
+			NewEmail:       "new@test.com",
+			SendResetEmail: true,
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "new@test.com", updatedEmail)
+		assert.Equal(t, "new@test.com", resetEmail)
+		assert.Equal(t, `Successfully updated the email of user user-1 to new@test.com
+Successfully sent a password reset email to new@test.com
+`, out.String())
+	})
+
+	t.Run("should update the password", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newRealmClient()
+
+		var updatedPassword string
+		realmClient.UpdateUserPasswordFn = func(groupID, appID, userID, password string) error {
+			updatedPassword = password
+			return nil
+		}
+
+		cmd := &CommandUpdate{updateInputs{
+			ProjectInputs: cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			User:          testUser.ID,
+			NewPassword:   "password",
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "password", updatedPassword)
+		assert.Equal(t, "Successfully updated the password of user user-1\n", out.String())
+	})
+
+	t.Run("should return an error when the user is not found", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newRealmClient()
+		realmClient.FindUsersFn = func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandUpdate{updateInputs{
+			ProjectInputs: cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			User:          "user-2",
+			NewPassword:   "password",
+		}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("no email/password user found with id user-2"), err)
+	})
+
+	t.Run("should return an error when the update fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newRealmClient()
+		realmClient.UpdateUserPasswordFn = func(groupID, appID, userID, password string) error {
+			return errors.New("something bad happened")
+		}
+
+		cmd := &CommandUpdate{updateInputs{
+			ProjectInputs: cli.ProjectInputs{Project: app.GroupID, App: app.ID},
+			User:          testUser.ID,
+			NewPassword:   "password",
+		}}
+
+		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
+
+func TestUserUpdateInputs(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		inputs      updateInputs
+		expectedErr error
+	}{
+		{
+			description: "should require an action",
+			inputs:      updateInputs{User: "user-1"},
+			expectedErr: errors.New(`must specify at least one of "--new-email", "--new-password" or "--send-reset-email"`),
+		},
+		{
+			description: "should not allow a new password with a reset email",
+			inputs:      updateInputs{User: "user-1", NewPassword: "password", SendResetEmail: true},
+			expectedErr: errors.New(`cannot specify both "--new-password" and "--send-reset-email"`),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			profile := mock.NewProfile(t)
+
+			tc.inputs.Project = "projectID"
+			tc.inputs.App = "appID"
+
+			assert.Equal(t, tc.expectedErr, tc.inputs.Resolve(profile, nil))
+		})
+	}
+}
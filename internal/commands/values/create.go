@@ -0,0 +1,122 @@
+package values
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaCreate is the command meta for the `values create` command
+var CommandMetaCreate = cli.CommandMeta{
+	Use:         "create",
+	Display:     "values create",
+	Description: "Create a Value for your Realm app",
+	HelpText: `You will be prompted to name your Value and define its contents. The contents
+are parsed as JSON when valid, so "--value 42" creates a number and
+"--value '{"region":"us"}'" creates a document, and are stored as a string
+otherwise.
+
+Include "--from-secret" to set the contents to the name of a Secret which the
+Value resolves to, and "--private" to keep the Value from client applications.`,
+}
+
+// CommandCreate is the `values create` command
+type CommandCreate struct {
+	inputs  createInputs
+	created int
+}
+
+const (
+	createInputFieldName  = "name"
+	createInputFieldValue = "value"
+)
+
+type createInputs struct {
+	cli.ProjectInputs
+	Name       string
+	Value      string
+	Private    bool
+	FromSecret bool
+}
+
+// Flags is the command flags
+func (cmd *CommandCreate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Name, flagName, flagNameShort, "", flagNameUsageCreate)
+	fs.StringVarP(&cmd.inputs.Value, flagValue, flagValueShort, "", flagValueUsageCreate)
+	fs.BoolVar(&cmd.inputs.Private, flagPrivate, false, flagPrivateUsage)
+	fs.BoolVar(&cmd.inputs.FromSecret, flagFromSecret, false, flagFromSecretUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandCreate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	var contents interface{} = cmd.inputs.Value
+	if !cmd.inputs.FromSecret {
+		contents = parseValue(cmd.inputs.Value)
+	}
+
+	value, err := clients.Realm.CreateValue(app.GroupID, app.ID, realm.Value{
+		Name:       cmd.inputs.Name,
+		Value:      contents,
+		Private:    cmd.inputs.Private,
+		FromSecret: cmd.inputs.FromSecret,
+	})
+	if err != nil {
+		return err
+	}
+	cmd.created = 1
+
+	ui.Print(terminal.NewTextLog("Successfully created value, id: %s", value.ID))
+	return nil
+}
+
+// ResourcesAffected is the number of values created
+func (cmd *CommandCreate) ResourcesAffected() int {
+	return cmd.created
+}
+
+func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true); err != nil {
+		return err
+	}
+
+	var questions []*survey.Question
+
+	if i.Name == "" {
+		questions = append(questions, &survey.Question{
+			Name:   createInputFieldName,
+			Prompt: &survey.Input{Message: "Value Name"},
+		})
+	}
+
+	if i.Value == "" {
+		message := "Value"
+		if i.FromSecret {
+			message = "Secret Name"
+		}
+		questions = append(questions, &survey.Question{
+			Name:   createInputFieldValue,
+			Prompt: &survey.Input{Message: message},
+		})
+	}
+
+	if len(questions) > 0 {
+		return ui.Ask(i, questions...)
+	}
+	return nil
+}
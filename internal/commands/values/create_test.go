@@ -0,0 +1,89 @@
+package values
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestValuesCreateHandler(t *testing.T) {
+	projectID := "projectID"
+	appID := "appID"
+	app := realm.App{
+		ID:          appID,
+		GroupID:     projectID,
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+
+	for _, tc := range []struct {
+		description   string
+		inputs        createInputs
+		expectedValue realm.Value
+	}{
+		{
+			description:   "should create a value parsed as json",
+			inputs:        createInputs{Name: "config", Value: `{"region":"us"}`},
+			expectedValue: realm.Value{Name: "config", Value: map[string]interface{}{"region": "us"}},
+		},
+		{
+			description:   "should create a private string value",
+			inputs:        createInputs{Name: "greeting", Value: "hello", Private: true},
+			expectedValue: realm.Value{Name: "greeting", Value: "hello", Private: true},
+		},
+		{
+			description:   "should create a value from a secret without parsing it",
+			inputs:        createInputs{Name: "apiKey", Value: "123", FromSecret: true},
+			expectedValue: realm.Value{Name: "apiKey", Value: "123", FromSecret: true},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{app}, nil
+			}
+
+			var capturedGroupID, capturedAppID string
+			var capturedValue realm.Value
+			realmClient.CreateValueFn = func(groupID, appID string, value realm.Value) (realm.Value, error) {
+				capturedGroupID = groupID
+				capturedAppID = appID
+				capturedValue = value
+				value.ID = "valueID"
+				return value, nil
+			}
+
+			tc.inputs.ProjectInputs = cli.ProjectInputs{Project: projectID, App: appID}
+			cmd := &CommandCreate{inputs: tc.inputs}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, "Successfully created value, id: valueID\n", out.String())
+
+			t.Log("and should properly pass through the expected inputs")
+			assert.Equal(t, projectID, capturedGroupID)
+			assert.Equal(t, appID, capturedAppID)
+			assert.Equal(t, tc.expectedValue, capturedValue)
+		})
+	}
+
+	t.Run("should return an error when creating the value fails", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.CreateValueFn = func(groupID, appID string, value realm.Value) (realm.Value, error) {
+			return realm.Value{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandCreate{inputs: createInputs{Name: "name", Value: "value"}}
+
+		err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
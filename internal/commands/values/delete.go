@@ -0,0 +1,158 @@
+package values
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaDelete is the command meta for the `values delete` command
+var CommandMetaDelete = cli.CommandMeta{
+	Use:         "delete",
+	Display:     "values delete",
+	Description: "Delete a Value from your Realm app",
+	HelpText: `With this command, you can:
+  - Remove multiple Values at once with "--name" flags. You can specify these
+    Values using their ID or Name values`,
+}
+
+// CommandDelete is the `values delete` command
+type CommandDelete struct {
+	inputs  deleteInputs
+	deleted int
+}
+
+type deleteInputs struct {
+	cli.ProjectInputs
+	Names []string
+}
+
+// Flags is the command flags
+func (cmd *CommandDelete) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringSliceVarP(&cmd.inputs.Names, flagName, flagNameShort, []string{}, flagNameUsageDelete)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDelete) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	values, err := clients.Realm.Values(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	selected, err := cmd.inputs.resolveValues(ui, values)
+	if err != nil {
+		return err
+	}
+
+	if len(selected) == 0 {
+		ui.Print(terminal.NewTextLog("No values to delete"))
+		return nil
+	}
+
+	outputs := make(valueOutputs, 0, len(selected))
+	for _, value := range selected {
+		err := clients.Realm.DeleteValue(app.GroupID, app.ID, value.ID)
+		if err == nil {
+			cmd.deleted++
+		}
+		outputs = append(outputs, valueOutput{value, err})
+	}
+
+	sort.SliceStable(outputs, func(i, j int) bool {
+		return outputs[i].err != nil && outputs[j].err == nil
+	})
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Deleted %d value(s)", len(outputs)),
+		tableHeaders(headerDeleted, headerDetails),
+		tableRows(outputs, tableRowDelete)...,
+	))
+	return nil
+}
+
+// ResourcesAffected is the number of values deleted
+func (cmd *CommandDelete) ResourcesAffected() int {
+	return cmd.deleted
+}
+
+func tableRowDelete(output valueOutput, row map[string]interface{}) {
+	deleted := false
+	if output.err != nil {
+		row[headerDetails] = output.err.Error()
+	} else {
+		deleted = true
+	}
+	row[headerDeleted] = deleted
+}
+
+func (i *deleteInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+func (i *deleteInputs) resolveValues(ui terminal.UI, appValues []realm.Value) ([]realm.Value, error) {
+	if len(appValues) == 0 {
+		return nil, nil
+	}
+
+	if len(i.Names) > 0 {
+		values := make([]realm.Value, 0, len(i.Names))
+		for _, identifier := range i.Names {
+			for _, value := range appValues {
+				if value.Name == identifier || value.ID == identifier {
+					values = append(values, value)
+					break
+				}
+			}
+		}
+
+		if len(values) == 0 {
+			return nil, errors.New("unable to find values")
+		}
+		return values, nil
+	}
+
+	options := make([]string, 0, len(appValues))
+	valuesByOption := make(map[string]realm.Value, len(appValues))
+	for _, value := range appValues {
+		option := displayValueOption(value)
+		options = append(options, option)
+		valuesByOption[option] = value
+	}
+
+	var selections []string
+	if err := ui.AskOne(
+		&selections,
+		&survey.MultiSelect{
+			Message: "Which value(s) would you like to delete?",
+			Options: options,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	values := make([]realm.Value, 0, len(selections))
+	for _, selection := range selections {
+		values = append(values, valuesByOption[selection])
+	}
+	return values, nil
+}
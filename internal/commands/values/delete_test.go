@@ -0,0 +1,97 @@
+package values
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestValuesDeleteHandler(t *testing.T) {
+	projectID := "projectID"
+	appID := "appID"
+	app := realm.App{
+		ID:          appID,
+		GroupID:     projectID,
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+	testValues := []realm.Value{
+		{ID: "value1", Name: "region"},
+		{ID: "value2", Name: "apiKey"},
+		{ID: "value3", Name: "debug"},
+	}
+
+	t.Run("should delete the values and show the failures first", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.ValuesFn = func(groupID, appID string) ([]realm.Value, error) {
+			return testValues, nil
+		}
+
+		var deletedIDs []string
+		realmClient.DeleteValueFn = func(groupID, appID, valueID string) error {
+			if valueID == "value3" {
+				return errors.New("something bad happened")
+			}
+			deletedIDs = append(deletedIDs, valueID)
+			return nil
+		}
+
+		cmd := &CommandDelete{inputs: deleteInputs{
+			ProjectInputs: cli.ProjectInputs{Project: projectID, App: appID},
+			Names:         []string{"region", "value3", "missing"},
+		}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, []string{"value1"}, deletedIDs)
+		assert.Equal(t, strings.Join([]string{
+			"Deleted 2 value(s)",
+			"  ID      Name    Deleted  Details               ",
+			"  ------  ------  -------  ----------------------",
+			"  value3  debug   false    something bad happened",
+			"  value1  region  true                           ",
+			"",
+		}, "\n"), out.String())
+	})
+
+	t.Run("should show a message when the app has no values", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.ValuesFn = func(groupID, appID string) ([]realm.Value, error) {
+			return nil, nil
+		}
+
+		cmd := &CommandDelete{inputs: deleteInputs{Names: []string{"region"}}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No values to delete\n", out.String())
+	})
+
+	t.Run("should return an error when none of the values can be found", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.ValuesFn = func(groupID, appID string) ([]realm.Value, error) {
+			return testValues, nil
+		}
+
+		cmd := &CommandDelete{inputs: deleteInputs{Names: []string{"missing"}}}
+
+		err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("unable to find values"), err)
+	})
+}
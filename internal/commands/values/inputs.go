@@ -0,0 +1,79 @@
+package values
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// Flag names and usages across the values commands
+const (
+	flagName            = "name"
+	flagNameShort       = "n"
+	flagNameUsageCreate = "the name of the value"
+	flagNameUsageUpdate = "the name or id of the value to update"
+	flagNameUsageDelete = "the name or id of the value to delete"
+
+	flagValue            = "value"
+	flagValueShort       = "v"
+	flagValueUsageCreate = "the value, parsed as JSON when valid and as a string otherwise"
+	flagValueUsageUpdate = "the new value, parsed as JSON when valid and as a string otherwise"
+
+	flagPrivate      = "private"
+	flagPrivateUsage = "include to make the value inaccessible from client applications and function contexts"
+
+	flagFromSecret      = "from-secret"
+	flagFromSecretUsage = "include to set the value as the name of a Secret, which the value then resolves to"
+)
+
+// parseValue returns the value parsed as JSON, or the value itself when it is not valid JSON,
+// so that both `--value 42` and `--value hello` behave as expected
+func parseValue(value string) interface{} {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return value
+	}
+	return parsed
+}
+
+// resolveValue finds the value by its name or id, or prompts for the value to
+// perform the action on when none is specified
+func resolveValue(ui terminal.UI, values []realm.Value, nameOrID, action string) (realm.Value, error) {
+	if nameOrID != "" {
+		for _, value := range values {
+			if value.ID == nameOrID || value.Name == nameOrID {
+				return value, nil
+			}
+		}
+		return realm.Value{}, fmt.Errorf("unable to find value: %s", nameOrID)
+	}
+
+	if len(values) == 0 {
+		return realm.Value{}, fmt.Errorf("no values to %s", action)
+	}
+
+	options := make([]string, 0, len(values))
+	valuesByOption := make(map[string]realm.Value, len(values))
+	for _, value := range values {
+		option := displayValueOption(value)
+		options = append(options, option)
+		valuesByOption[option] = value
+	}
+
+	var selected string
+	if err := ui.AskOne(
+		&selected,
+		&survey.Select{
+			Message: fmt.Sprintf("Which value would you like to %s?", action),
+			Options: options,
+		},
+	); err != nil {
+		return realm.Value{}, err
+	}
+
+	return valuesByOption[selected], nil
+}
@@ -0,0 +1,27 @@
+package values
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestParseValue(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		value       string
+		expected    interface{}
+	}{
+		{"should parse a number", "42", 42.0},
+		{"should parse a boolean", "true", true},
+		{"should parse a quoted string", `"hello"`, "hello"},
+		{"should parse a document", `{"region":"us"}`, map[string]interface{}{"region": "us"}},
+		{"should parse an array", `[1,"two"]`, []interface{}{1.0, "two"}},
+		{"should keep an unquoted string as is", "hello", "hello"},
+		{"should keep an empty string as is", "", ""},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parseValue(tc.value))
+		})
+	}
+}
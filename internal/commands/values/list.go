@@ -0,0 +1,79 @@
+package values
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaList is the command meta for the `values list` command
+var CommandMetaList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "values list",
+	Description: "List the Values in your Realm app",
+	HelpText: `This will display the IDs and Names of the Values in your Realm app, along with
+whether each Value is private or resolves from a Secret.`,
+}
+
+// CommandList is the `values list` command
+type CommandList struct {
+	inputs listInputs
+}
+
+type listInputs struct {
+	cli.ProjectInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandList) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	values, err := clients.Realm.Values(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(values) == 0 {
+		ui.Print(terminal.NewTextLog("No available values to show"))
+		return nil
+	}
+
+	outputs := make(valueOutputs, 0, len(values))
+	for _, value := range values {
+		outputs = append(outputs, valueOutput{value: value})
+	}
+
+	ui.Print(terminal.NewUnorderedTableLog(
+		fmt.Sprintf("Found %d values", len(values)),
+		tableHeaders(headerPrivate, headerFromSecret),
+		tableRows(outputs, tableRowList)...,
+	))
+	return nil
+}
+
+func tableRowList(output valueOutput, row map[string]interface{}) {
+	row[headerPrivate] = output.value.Private
+	row[headerFromSecret] = output.value.FromSecret
+}
+
+func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
@@ -0,0 +1,113 @@
+package values
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestValuesListHandler(t *testing.T) {
+	projectID := "projectID"
+	appID := "appID"
+	app := realm.App{
+		ID:          appID,
+		GroupID:     projectID,
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+
+	for _, tc := range []struct {
+		description    string
+		values         []realm.Value
+		expectedOutput string
+	}{
+		{
+			description:    "should list no values with no app values found",
+			expectedOutput: "No available values to show\n",
+		},
+		{
+			description: "should list the values found for the app",
+			values: []realm.Value{
+				{ID: "value1", Name: "region"},
+				{ID: "value2", Name: "apiKey", Private: true, FromSecret: true},
+			},
+			expectedOutput: strings.Join(
+				[]string{
+					"Found 2 values",
+					"  ID      Name    Private  From Secret",
+					"  ------  ------  -------  -----------",
+					"  value1  region  false    false      ",
+					"  value2  apiKey  true     true       ",
+					"",
+				},
+				"\n",
+			),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{app}, nil
+			}
+			realmClient.ValuesFn = func(groupID, appID string) ([]realm.Value, error) {
+				return tc.values, nil
+			}
+
+			cmd := &CommandList{listInputs{cli.ProjectInputs{
+				Project: projectID,
+				App:     appID,
+			}}}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+		})
+	}
+
+	t.Run("should return an error", func(t *testing.T) {
+		for _, tc := range []struct {
+			description string
+			setupClient func() realm.Client
+			expectedErr error
+		}{
+			{
+				description: "when resolving the app fails",
+				setupClient: func() realm.Client {
+					realmClient := mock.RealmClient{}
+					realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+						return nil, errors.New("something bad happened")
+					}
+					return realmClient
+				},
+				expectedErr: errors.New("something bad happened"),
+			},
+			{
+				description: "when finding the values fails",
+				setupClient: func() realm.Client {
+					realmClient := mock.RealmClient{}
+					realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+						return []realm.App{app}, nil
+					}
+					realmClient.ValuesFn = func(groupID, appID string) ([]realm.Value, error) {
+						return nil, errors.New("something bad happened")
+					}
+					return realmClient
+				},
+				expectedErr: errors.New("something bad happened"),
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				cmd := &CommandList{}
+
+				err := cmd.Handler(nil, nil, cli.Clients{Realm: tc.setupClient()})
+				assert.Equal(t, tc.expectedErr, err)
+			})
+		}
+	})
+}
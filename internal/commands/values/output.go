@@ -0,0 +1,49 @@
+package values
+
+import (
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+const (
+	headerID         = "ID"
+	headerName       = "Name"
+	headerPrivate    = "Private"
+	headerFromSecret = "From Secret"
+	headerDeleted    = "Deleted"
+	headerDetails    = "Details"
+)
+
+type valueOutputs []valueOutput
+
+type valueOutput struct {
+	value realm.Value
+	err   error
+}
+
+type tableRowModifier func(valueOutput, map[string]interface{})
+
+func tableHeaders(additionalHeaders ...string) []string {
+	return append([]string{headerID, headerName}, additionalHeaders...)
+}
+
+func tableRows(outputs valueOutputs, modifier tableRowModifier) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(outputs))
+	for _, output := range outputs {
+		rows = append(rows, tableRow(output, modifier))
+	}
+	return rows
+}
+
+func tableRow(output valueOutput, modifier tableRowModifier) map[string]interface{} {
+	row := map[string]interface{}{
+		headerID:   output.value.ID,
+		headerName: output.value.Name,
+	}
+	modifier(output, row)
+	return row
+}
+
+func displayValueOption(value realm.Value) string {
+	return value.ID + terminal.DelimiterInline + value.Name
+}
@@ -0,0 +1,96 @@
+package values
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaUpdate is the command meta for the `values update` command
+var CommandMetaUpdate = cli.CommandMeta{
+	Use:         "update",
+	Display:     "values update",
+	Description: "Update a Value in your Realm app",
+	HelpText: `Replaces the contents of the Value, which are parsed as JSON when valid and
+stored as a string otherwise. A Value which resolves from a Secret is updated
+to resolve from the Secret named by the new contents.
+
+NOTE: The Name of the Value cannot be modified. In order to do so, you will
+need to delete and re-create the Value.`,
+}
+
+// CommandUpdate is the `values update` command
+type CommandUpdate struct {
+	inputs  updateInputs
+	updated int
+}
+
+type updateInputs struct {
+	cli.ProjectInputs
+	Name  string
+	Value string
+}
+
+// Flags is the command flags
+func (cmd *CommandUpdate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVarP(&cmd.inputs.Name, flagName, flagNameShort, "", flagNameUsageUpdate)
+	fs.StringVarP(&cmd.inputs.Value, flagValue, flagValueShort, "", flagValueUsageUpdate)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandUpdate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandUpdate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ui, clients.Realm, cmd.inputs.Filter())
+	if err != nil {
+		return err
+	}
+
+	values, err := clients.Realm.Values(app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	value, err := resolveValue(ui, values, cmd.inputs.Name, "update")
+	if err != nil {
+		return err
+	}
+
+	contents := cmd.inputs.Value
+	if contents == "" {
+		if err := ui.AskOne(&contents, &survey.Input{Message: "New Value"}); err != nil {
+			return err
+		}
+	}
+
+	if value.FromSecret {
+		value.Value = contents
+	} else {
+		value.Value = parseValue(contents)
+	}
+
+	if err := clients.Realm.UpdateValue(app.GroupID, app.ID, value); err != nil {
+		return err
+	}
+	cmd.updated = 1
+
+	ui.Print(terminal.NewTextLog("Successfully updated value"))
+	return nil
+}
+
+// ResourcesAffected is the number of values updated
+func (cmd *CommandUpdate) ResourcesAffected() int {
+	return cmd.updated
+}
+
+func (i *updateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
@@ -0,0 +1,107 @@
+package values
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestValuesUpdateHandler(t *testing.T) {
+	projectID := "projectID"
+	appID := "appID"
+	app := realm.App{
+		ID:          appID,
+		GroupID:     projectID,
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+	testValues := []realm.Value{
+		{ID: "value1", Name: "region", Value: "us", Private: true},
+		{ID: "value2", Name: "apiKey", Value: "key", FromSecret: true},
+	}
+
+	for _, tc := range []struct {
+		description   string
+		inputs        updateInputs
+		expectedValue realm.Value
+	}{
+		{
+			description:   "should update the value found by name and keep its settings",
+			inputs:        updateInputs{Name: "region", Value: `["us","eu"]`},
+			expectedValue: realm.Value{ID: "value1", Name: "region", Value: []interface{}{"us", "eu"}, Private: true},
+		},
+		{
+			description:   "should update a value from a secret found by id without parsing it",
+			inputs:        updateInputs{Name: "value2", Value: "42"},
+			expectedValue: realm.Value{ID: "value2", Name: "apiKey", Value: "42", FromSecret: true},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{app}, nil
+			}
+			realmClient.ValuesFn = func(groupID, appID string) ([]realm.Value, error) {
+				return testValues, nil
+			}
+
+			var capturedValue realm.Value
+			realmClient.UpdateValueFn = func(groupID, appID string, value realm.Value) error {
+				capturedValue = value
+				return nil
+			}
+
+			tc.inputs.ProjectInputs = cli.ProjectInputs{Project: projectID, App: appID}
+			cmd := &CommandUpdate{inputs: tc.inputs}
+
+			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, "Successfully updated value\n", out.String())
+			assert.Equal(t, tc.expectedValue, capturedValue)
+		})
+	}
+
+	t.Run("should return an error", func(t *testing.T) {
+		for _, tc := range []struct {
+			description string
+			inputs      updateInputs
+			updateErr   error
+			expectedErr error
+		}{
+			{
+				description: "when the value cannot be found",
+				inputs:      updateInputs{Name: "missing", Value: "value"},
+				expectedErr: errors.New("unable to find value: missing"),
+			},
+			{
+				description: "when updating the value fails",
+				inputs:      updateInputs{Name: "region", Value: "value"},
+				updateErr:   errors.New("something bad happened"),
+				expectedErr: errors.New("something bad happened"),
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				realmClient := mock.RealmClient{}
+				realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+					return []realm.App{app}, nil
+				}
+				realmClient.ValuesFn = func(groupID, appID string) ([]realm.Value, error) {
+					return testValues, nil
+				}
+				realmClient.UpdateValueFn = func(groupID, appID string, value realm.Value) error {
+					return tc.updateErr
+				}
+
+				cmd := &CommandUpdate{inputs: tc.inputs}
+
+				err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+				assert.Equal(t, tc.expectedErr, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package version
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/blang/semver"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagCheckLatest      = "check-latest"
+	flagCheckLatestUsage = "include to check for the latest released version of the CLI"
+
+	commitUnknown = "unknown"
+)
+
+// manifestClient is the client used to check for the latest released version
+var manifestClient cli.VersionManifestClient = http.DefaultClient
+
+// CommandMeta is the command meta for the `version` command
+var CommandMeta = cli.CommandMeta{
+	Use:         "version",
+	Description: "Display the version and build information of the CLI",
+	HelpText: `Displays the CLI version along with the commit and Go version it was built
+with, the platform it runs on and the app config versions it supports. Use
+"--check-latest" to also check whether a newer version of the CLI has been
+released and where to download it from.
+
+Use "--output-format json" to print the information as JSON for use by package
+managers and other tooling.`,
+}
+
+// Command is the `version` command
+type Command struct {
+	inputs inputs
+}
+
+type inputs struct {
+	CheckLatest bool
+}
+
+// Flags is the command flags
+func (cmd *Command) Flags(fs *pflag.FlagSet) {
+	fs.BoolVar(&cmd.inputs.CheckLatest, flagCheckLatest, false, flagCheckLatestUsage)
+}
+
+// Handler is the command handler
+func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	info := versionInfo{
+		Version:        cli.Version,
+		Commit:         cli.Commit,
+		GoVersion:      runtime.Version(),
+		Platform:       runtime.GOOS + "/" + runtime.GOARCH,
+		ConfigVersions: realm.SupportedAppConfigVersions,
+	}
+	if info.Commit == "" {
+		info.Commit = commitUnknown
+	}
+
+	if cmd.inputs.CheckLatest {
+		latest, url, err := cli.LatestVersion(manifestClient)
+		if err != nil {
+			ui.Print(terminal.NewWarningLog("Failed to check for the latest version: %s", err))
+		} else {
+			info.checked = true
+			info.LatestVersion = latest
+			info.UpdateAvailable = isNewer(latest, cli.Version)
+			if info.UpdateAvailable {
+				info.UpdateURL = url
+			}
+		}
+	}
+
+	ui.Print(terminal.Log{Level: terminal.LogLevelInfo, Time: time.Now(), Data: info})
+	return nil
+}
+
+func isNewer(version, current string) bool {
+	v, err := semver.Make(version)
+	if err != nil {
+		return false
+	}
+	c, err := semver.Make(current)
+	if err != nil {
+		return false
+	}
+	return v.GT(c)
+}
+
+// set of version info fields
+const (
+	fieldVersion         = "version"
+	fieldCommit          = "commit"
+	fieldGoVersion       = "go_version"
+	fieldPlatform        = "platform"
+	fieldConfigVersions  = "config_versions"
+	fieldLatestVersion   = "latest_version"
+	fieldUpdateAvailable = "update_available"
+	fieldUpdateURL       = "update_url"
+)
+
+type versionInfo struct {
+	Version         string
+	Commit          string
+	GoVersion       string
+	Platform        string
+	ConfigVersions  []realm.AppConfigVersion
+	LatestVersion   string
+	UpdateAvailable bool
+	UpdateURL       string
+
+	checked bool
+}
+
+func (info versionInfo) Message() (string, error) {
+	configVersions := make([]string, 0, len(info.ConfigVersions))
+	for _, configVersion := range info.ConfigVersions {
+		configVersions = append(configVersions, configVersion.String())
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s version %s\n", cli.Name, info.Version)
+	fmt.Fprintf(&sb, "  Commit: %s\n", info.Commit)
+	fmt.Fprintf(&sb, "  Go version: %s\n", info.GoVersion)
+	fmt.Fprintf(&sb, "  Platform: %s\n", info.Platform)
+	fmt.Fprintf(&sb, "  Supported config versions: %s", strings.Join(configVersions, ", "))
+
+	if info.checked {
+		fmt.Fprintf(&sb, "\n  Latest version: %s", info.LatestVersion)
+		switch {
+		case !info.UpdateAvailable:
+			sb.WriteString(" (up to date)")
+		case info.UpdateURL != "":
+			fmt.Fprintf(&sb, " (update available: %s)", info.UpdateURL)
+		default:
+			sb.WriteString(" (update available)")
+		}
+	}
+	return sb.String(), nil
+}
+
+func (info versionInfo) Payload() ([]string, map[string]interface{}, error) {
+	fields := []string{fieldVersion, fieldCommit, fieldGoVersion, fieldPlatform, fieldConfigVersions}
+	payload := map[string]interface{}{
+		fieldVersion:        info.Version,
+		fieldCommit:         info.Commit,
+		fieldGoVersion:      info.GoVersion,
+		fieldPlatform:       info.Platform,
+		fieldConfigVersions: info.ConfigVersions,
+	}
+
+	if info.checked {
+		fields = append(fields, fieldLatestVersion, fieldUpdateAvailable, fieldUpdateURL)
+		payload[fieldLatestVersion] = info.LatestVersion
+		payload[fieldUpdateAvailable] = info.UpdateAvailable
+		payload[fieldUpdateURL] = info.UpdateURL
+	}
+	return fields, payload, nil
+}
@@ -0,0 +1,130 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestVersionHandler(t *testing.T) {
+	origCommit := cli.Commit
+	cli.Commit = "abcdef"
+	defer func() { cli.Commit = origCommit }()
+
+	origManifestClient := manifestClient
+	defer func() { manifestClient = origManifestClient }()
+
+	header := fmt.Sprintf(`realm-cli version %s
+  Commit: abcdef
+  Go version: %s
+  Platform: %s/%s
+  Supported config versions: 20210101, 20200603, 20180301`, cli.Version, runtime.Version(), runtime.GOOS, runtime.GOARCH)
+
+	t.Run("should display the version and build information", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &Command{}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, header+"\n", out.String())
+	})
+
+	t.Run("should display the latest version when checked", func(t *testing.T) {
+		for _, tc := range []struct {
+			description string
+			latest      string
+			expected    string
+		}{
+			{
+				description: "with an update available",
+				latest:      "999.0.0",
+				expected:    "  Latest version: 999.0.0 (update available: http://whatever.com/download)",
+			},
+			{
+				description: "without an update available",
+				latest:      cli.Version,
+				expected:    "  Latest version: " + cli.Version + " (up to date)",
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				out, ui := mock.NewUI()
+
+				manifestClient = manifestClientFunc(func(url string) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body: ioutil.NopCloser(strings.NewReader(fmt.Sprintf(
+							`{"version": %q, "info": {"": {"url": "http://whatever.com/download"}}}`,
+							tc.latest,
+						))),
+					}, nil
+				})
+
+				cmd := &Command{inputs{CheckLatest: true}}
+
+				assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+				assert.Equal(t, header+"\n"+tc.expected+"\n", out.String())
+			})
+		}
+	})
+
+	t.Run("should warn when the latest version check fails", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		manifestClient = manifestClientFunc(func(url string) (*http.Response, error) {
+			return nil, errors.New("something bad happened")
+		})
+
+		cmd := &Command{inputs{CheckLatest: true}}
+
+		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, "Warning: Failed to check for the latest version: something bad happened\n"+header+"\n", out.String())
+	})
+}
+
+func TestVersionInfoPayload(t *testing.T) {
+	info := versionInfo{
+		Version:        "1.0.0",
+		Commit:         "abcdef",
+		GoVersion:      "go1.14",
+		Platform:       "linux/amd64",
+		ConfigVersions: realm.SupportedAppConfigVersions,
+	}
+
+	t.Run("should only include the build information when not checked", func(t *testing.T) {
+		output, err := terminal.Log{Data: info}.Print(terminal.OutputFormatJSON)
+		assert.Nil(t, err)
+		assert.Equal(t,
+			`{"time":"0001-01-01T00:00:00Z","level":"","version":"1.0.0","commit":"abcdef","go_version":"go1.14","platform":"linux/amd64","config_versions":[20210101,20200603,20180301]}`,
+			output,
+		)
+	})
+
+	t.Run("should include the latest version when checked", func(t *testing.T) {
+		info := info
+		info.checked = true
+		info.LatestVersion = "1.1.0"
+		info.UpdateAvailable = true
+		info.UpdateURL = "http://whatever.com/download"
+
+		output, err := terminal.Log{Data: info}.Print(terminal.OutputFormatJSON)
+		assert.Nil(t, err)
+		assert.True(t,
+			strings.HasSuffix(output, `"latest_version":"1.1.0","update_available":true,"update_url":"http://whatever.com/download"}`),
+			"unexpected output: %s", output,
+		)
+	})
+}
+
+type manifestClientFunc func(url string) (*http.Response, error)
+
+func (f manifestClientFunc) Get(url string) (*http.Response, error) { return f(url) }
@@ -15,7 +15,8 @@ var CommandMeta = cli.CommandMeta{
 	// (since this HelptText creates a "whoami depends on login" package cycle)
 	HelpText: `Displays a table that includes your Public and redacted Private Atlas
 programmatic API Key (e.g. ********-****-****-****-3ba985aa367a). No session
-data will be surfaced if you are not logged in.
+data will be surfaced if you are not logged in, and no API Key is displayed if
+you logged in through the browser.
 
 NOTE: To log in and authenticate your session, use "realm-cli login"`,
 }
@@ -28,6 +29,11 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 	user := profile.Credentials()
 	session := profile.Session()
 
+	if user.PublicAPIKey == "" && session.AccessToken != "" {
+		ui.Print(terminal.NewTextLog("Currently logged in through the browser"))
+		return nil
+	}
+
 	if user.PrivateAPIKey == "" {
 		ui.Print(terminal.NewTextLog("No user is currently logged in"))
 		return nil
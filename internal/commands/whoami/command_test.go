@@ -41,6 +41,15 @@ func TestWhoamiFeedback(t *testing.T) {
 					assert.Equal(t, "Currently logged in user: username (**-*****-******-key)\n", output)
 				},
 			},
+			{
+				description: "with a user logged in through the browser",
+				setup: func(t *testing.T, profile *user.Profile) {
+					profile.SetSession(user.Session{"accessToken", "refreshToken"})
+				},
+				test: func(t *testing.T, output string) {
+					assert.Equal(t, "Currently logged in through the browser\n", output)
+				},
+			},
 		} {
 			t.Run(tc.description, func(t *testing.T) {
 				profile := mock.NewProfile(t)
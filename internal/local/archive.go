@@ -162,7 +162,10 @@ func newDirReader(dir string) (ArchiveReader, error) {
 
 	r := dirReader{currFileIdx: -1}
 
-	if err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+	if err := walkExtendedLength(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
 		if info.IsDir() {
 			return nil
 		}
@@ -196,7 +199,7 @@ func (r *dirReader) Read(b []byte) (int, error) {
 	}
 
 	if r.currOpenFile == nil {
-		f, err := os.Open(r.files[r.currFileIdx].Path)
+		f, err := os.Open(extendedLengthPath(r.files[r.currFileIdx].Path))
 		if err != nil {
 			return 0, err
 		}
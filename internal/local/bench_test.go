@@ -0,0 +1,96 @@
+package local
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+)
+
+func BenchmarkLoadApp(b *testing.B) {
+	for _, size := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("functions and rules=%d", size), func(b *testing.B) {
+			rootDir, teardown := newBenchAppFixture(b, AppFixtureOptions{Functions: size, Rules: size})
+			defer teardown()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := LoadApp(rootDir); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkHostingDiffs(b *testing.B) {
+	rootDir, teardown := newBenchAppFixture(b, AppFixtureOptions{HostingFiles: 1000, HostingFileSize: 4096})
+	defer teardown()
+
+	hosting := Hosting{RootDir: filepath.Join(rootDir, NameHosting)}
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cachePath := filepath.Join(rootDir, fmt.Sprintf("cache-uncached-%d.json", i))
+			if _, err := hosting.Diffs(cachePath, fixtureAppID, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		cachePath := filepath.Join(rootDir, "cache-cached.json")
+		if _, err := hosting.Diffs(cachePath, fixtureAppID, nil); err != nil {
+			b.Fatal(err)
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := hosting.Diffs(cachePath, fixtureAppID, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkDependenciesPrepareUpload(b *testing.B) {
+	wd, err := os.Getwd()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	rootDir := filepath.Join(wd, "testdata/dependencies/zip/functions")
+
+	dependencies := Dependencies{
+		RootDir:     rootDir,
+		ArchivePath: filepath.Join(rootDir, "node_modules.zip"),
+	}
+
+	for i := 0; i < b.N; i++ {
+		uploadPath, err := dependencies.PrepareUpload()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.StopTimer()
+		os.RemoveAll(filepath.Dir(uploadPath))
+		b.StartTimer()
+	}
+}
+
+func newBenchAppFixture(b *testing.B, opts AppFixtureOptions) (string, func()) {
+	b.Helper()
+
+	rootDir, teardown, err := u.NewTempDir("")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	if _, err := GenerateAppFixture(rootDir, opts); err != nil {
+		teardown()
+		b.Fatal(err)
+	}
+	return rootDir, teardown
+}
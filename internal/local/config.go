@@ -98,7 +98,7 @@ func WriteZip(wd string, zipPkg *zip.Reader) error {
 }
 
 func mkdir(path string) error {
-	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+	if err := os.MkdirAll(extendedLengthPath(path), os.ModePerm); err != nil {
 		return fmt.Errorf("failed to create directory at %s: %w", path, err)
 	}
 	return nil
@@ -110,7 +110,7 @@ func WriteFile(path string, perm os.FileMode, r io.Reader) error {
 		return err
 	}
 
-	f, openErr := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	f, openErr := os.OpenFile(extendedLengthPath(path), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
 	if openErr != nil {
 		return fmt.Errorf("failed to open file at %s: %s", path, openErr)
 	}
@@ -0,0 +1,251 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+const (
+	serviceTypeHTTP = "http"
+
+	securityAllowedRequestOrigins = "allowed_request_origins"
+)
+
+// ConvertApp converts the app data into the structure of the config version, along with
+// the config file it is written to, so the app is written with the directory layout of
+// that config version. The default rules of data sources are dropped when converting to
+// a v1 structure, since it has no data sources to write them to
+func ConvertApp(app App, configVersion realm.AppConfigVersion) (App, error) {
+	if app.AppData == nil || app.ConfigVersion() == configVersion {
+		return app, nil
+	}
+
+	var v1 *AppStructureV1
+	var v2 *AppStructureV2
+	switch appData := app.AppData.(type) {
+	case *AppStitchJSON:
+		v1 = &appData.AppStructureV1
+	case *AppConfigJSON:
+		v1 = &appData.AppStructureV1
+	case *AppRealmConfigJSON:
+		v2 = &appData.AppStructureV2
+	default:
+		return App{}, fmt.Errorf("cannot convert app with config version %s", app.ConfigVersion())
+	}
+
+	switch configVersion {
+	case realm.AppConfigVersion20180301, realm.AppConfigVersion20200603:
+		var structure AppStructureV1
+		if v1 != nil {
+			structure = *v1
+			structure.Services = make([]ServiceStructure, 0, len(v1.Services))
+			for _, svc := range v1.Services {
+				structure.Services = append(structure.Services, ServiceStructure{svc.Config, flatWebhooks(svc.IncomingWebhooks), svc.Rules})
+			}
+		} else {
+			s, err := appStructureV1(*v2)
+			if err != nil {
+				return App{}, fmt.Errorf("cannot convert app to config version %s: %w", configVersion, err)
+			}
+			structure = s
+		}
+		structure.ConfigVersion = configVersion
+
+		if configVersion == realm.AppConfigVersion20180301 {
+			return App{RootDir: app.RootDir, Config: FileStitch, AppData: &AppStitchJSON{AppDataV1{structure}}}, nil
+		}
+		return App{RootDir: app.RootDir, Config: FileConfig, AppData: &AppConfigJSON{AppDataV1{structure}}}, nil
+	case realm.AppConfigVersion20210101:
+		var structure AppStructureV2
+		if v2 != nil {
+			structure = *v2
+		} else {
+			s, err := appStructureV2(*v1)
+			if err != nil {
+				return App{}, fmt.Errorf("cannot convert app to config version %s: %w", configVersion, err)
+			}
+			structure = s
+		}
+		structure.ConfigVersion = configVersion
+
+		return App{RootDir: app.RootDir, Config: FileRealmConfig, AppData: &AppRealmConfigJSON{AppDataV2{structure}}}, nil
+	}
+	return App{}, fmt.Errorf("cannot convert app to config version %s", configVersion)
+}
+
+func appStructureV1(v2 AppStructureV2) (AppStructureV1, error) {
+	v1 := AppStructureV1{
+		ID:                   v2.ID,
+		Name:                 v2.Name,
+		Location:             v2.Location,
+		DeploymentModel:      v2.DeploymentModel,
+		Environment:          v2.Environment,
+		Environments:         v2.Environments,
+		Hosting:              v2.Hosting,
+		CustomUserDataConfig: v2.Auth.CustomUserData,
+		Sync:                 v2.Sync.Config,
+		Secrets:              v2.Secrets,
+		Triggers:             v2.Triggers,
+		GraphQL:              v2.GraphQL,
+		Values:               v2.Values,
+	}
+
+	if len(v2.AllowedRequestOrigins) > 0 {
+		v1.Security = map[string]interface{}{securityAllowedRequestOrigins: v2.AllowedRequestOrigins}
+	}
+
+	providerNames := make([]string, 0, len(v2.Auth.Providers))
+	for name := range v2.Auth.Providers {
+		providerNames = append(providerNames, name)
+	}
+	sort.Strings(providerNames)
+
+	for _, name := range providerNames {
+		provider, ok := v2.Auth.Providers[name].(map[string]interface{})
+		if !ok {
+			return AppStructureV1{}, fmt.Errorf("invalid auth provider '%s'", name)
+		}
+		v1.AuthProviders = append(v1.AuthProviders, provider)
+	}
+
+	sources := make(map[string]struct{}, len(v2.Functions.Sources))
+	for path := range v2.Functions.Sources {
+		sources[path] = struct{}{}
+	}
+
+	for _, config := range v2.Functions.Configs {
+		name, ok := config["name"].(string)
+		if !ok {
+			return AppStructureV1{}, errors.New("invalid function config without a name")
+		}
+		path := name + extJS
+		source, ok := v2.Functions.Sources[path]
+		if !ok {
+			return AppStructureV1{}, fmt.Errorf("function '%s' has no source", name)
+		}
+		delete(sources, path)
+
+		v1.Functions = append(v1.Functions, map[string]interface{}{NameConfig: config, NameSource: source})
+	}
+
+	if len(sources) > 0 {
+		paths := make([]string, 0, len(sources))
+		for path := range sources {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		return AppStructureV1{}, fmt.Errorf("function source '%s' is not the source of a function", paths[0])
+	}
+
+	for _, svc := range v2.Services {
+		v1.Services = append(v1.Services, ServiceStructure{svc.Config, flatWebhooks(svc.IncomingWebhooks), svc.Rules})
+	}
+	for _, ds := range v2.DataSources {
+		v1.Services = append(v1.Services, ServiceStructure{Config: ds.Config, Rules: ds.Rules})
+	}
+	for _, endpoint := range v2.HTTPEndpoints {
+		v1.Services = append(v1.Services, ServiceStructure{endpoint.Config, flatWebhooks(endpoint.IncomingWebhooks), endpoint.Rules})
+	}
+
+	return v1, nil
+}
+
+func appStructureV2(v1 AppStructureV1) (AppStructureV2, error) {
+	v2 := AppStructureV2{
+		ID:              v1.ID,
+		Name:            v1.Name,
+		Location:        v1.Location,
+		DeploymentModel: v1.DeploymentModel,
+		Environment:     v1.Environment,
+		Environments:    v1.Environments,
+		Hosting:         v1.Hosting,
+		Auth: AuthStructure{
+			CustomUserData: v1.CustomUserDataConfig,
+			Providers:      map[string]interface{}{},
+		},
+		Sync: SyncStructure{Config: v1.Sync},
+		Functions: FunctionsStructure{
+			Configs: []map[string]interface{}{},
+			Sources: map[string]string{},
+		},
+		Secrets:  v1.Secrets,
+		Triggers: v1.Triggers,
+		GraphQL:  v1.GraphQL,
+		Values:   v1.Values,
+	}
+
+	if origins, ok := v1.Security[securityAllowedRequestOrigins].([]interface{}); ok {
+		for _, origin := range origins {
+			if origin, ok := origin.(string); ok {
+				v2.AllowedRequestOrigins = append(v2.AllowedRequestOrigins, origin)
+			}
+		}
+	}
+
+	for _, provider := range v1.AuthProviders {
+		name, ok := provider["name"].(string)
+		if !ok {
+			return AppStructureV2{}, errors.New("invalid auth provider without a name")
+		}
+		v2.Auth.Providers[name] = provider
+	}
+
+	for _, function := range v1.Functions {
+		config, ok := function[NameConfig].(map[string]interface{})
+		if !ok {
+			return AppStructureV2{}, errors.New("invalid function without a config")
+		}
+		name, ok := config["name"].(string)
+		if !ok {
+			return AppStructureV2{}, errors.New("invalid function config without a name")
+		}
+		source, ok := function[NameSource].(string)
+		if !ok {
+			return AppStructureV2{}, fmt.Errorf("function '%s' has no source", name)
+		}
+
+		v2.Functions.Configs = append(v2.Functions.Configs, config)
+		v2.Functions.Sources[name+extJS] = source
+	}
+
+	for _, svc := range v1.Services {
+		svcType, _ := svc.Config["type"].(string)
+		if _, ok := dataSourceTypes[svcType]; ok {
+			v2.DataSources = append(v2.DataSources, DataSourceStructure{Config: svc.Config, Rules: svc.Rules})
+			continue
+		}
+		if svcType == serviceTypeHTTP {
+			v2.HTTPEndpoints = append(v2.HTTPEndpoints, HTTPEndpointStructure{svc.Config, flatWebhooks(svc.IncomingWebhooks), svc.Rules})
+			continue
+		}
+		v2.Services = append(v2.Services, ServiceStructure{svc.Config, flatWebhooks(svc.IncomingWebhooks), svc.Rules})
+	}
+
+	return v2, nil
+}
+
+// flatWebhooks returns the incoming webhooks with their config and source in a single map,
+// which is how they are written, as they are otherwise loaded with the two kept apart
+func flatWebhooks(webhooks []map[string]interface{}) []map[string]interface{} {
+	if webhooks == nil {
+		return nil
+	}
+	flat := make([]map[string]interface{}, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		config, ok := webhook[NameConfig].(map[string]interface{})
+		if !ok {
+			flat = append(flat, webhook)
+			continue
+		}
+		flatWebhook := make(map[string]interface{}, len(config)+1)
+		for k, v := range config {
+			flatWebhook[k] = v
+		}
+		flatWebhook[NameSource] = webhook[NameSource]
+		flat = append(flat, flatWebhook)
+	}
+	return flat
+}
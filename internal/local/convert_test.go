@@ -0,0 +1,200 @@
+package local
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestConvertApp(t *testing.T) {
+	t.Run("should return the app unchanged when it has the config version", func(t *testing.T) {
+		app := App{
+			RootDir: "/path/to/project",
+			Config:  FileConfig,
+			AppData: &AppConfigJSON{AppDataV1{AppStructureV1{ConfigVersion: realm.AppConfigVersion20200603, Name: "test"}}},
+		}
+
+		converted, err := ConvertApp(app, realm.AppConfigVersion20200603)
+		assert.Nil(t, err)
+		assert.Equal(t, app, converted)
+	})
+
+	t.Run("should convert a v1 app between the v1 config versions", func(t *testing.T) {
+		app := App{
+			RootDir: "/path/to/project",
+			Config:  FileStitch,
+			AppData: &AppStitchJSON{AppDataV1{AppStructureV1{ConfigVersion: realm.AppConfigVersion20180301, Name: "test"}}},
+		}
+
+		converted, err := ConvertApp(app, realm.AppConfigVersion20200603)
+		assert.Nil(t, err)
+		assert.Equal(t, App{
+			RootDir: "/path/to/project",
+			Config:  FileConfig,
+			AppData: &AppConfigJSON{AppDataV1{AppStructureV1{
+				ConfigVersion: realm.AppConfigVersion20200603,
+				Name:          "test",
+				Services:      []ServiceStructure{},
+			}}},
+		}, converted)
+	})
+
+	t.Run("should convert a v2 app to a v1 app", func(t *testing.T) {
+		app := App{
+			RootDir: "/path/to/project",
+			Config:  FileRealmConfig,
+			AppData: &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+				ConfigVersion:         realm.AppConfigVersion20210101,
+				Name:                  "test",
+				AllowedRequestOrigins: []string{"http://localhost:8080"},
+				Auth: AuthStructure{
+					CustomUserData: map[string]interface{}{"enabled": true},
+					Providers: map[string]interface{}{
+						"local-userpass": map[string]interface{}{"name": "local-userpass"},
+						"api-key":        map[string]interface{}{"name": "api-key"},
+					},
+				},
+				Sync: SyncStructure{Config: map[string]interface{}{"development_mode_enabled": false}},
+				Functions: FunctionsStructure{
+					Configs: []map[string]interface{}{{"name": "test"}},
+					Sources: map[string]string{"test.js": `exports = () => "test"`},
+				},
+				DataSources: []DataSourceStructure{{
+					Config:      map[string]interface{}{"name": "mongodb-atlas", "type": "mongodb-atlas"},
+					DefaultRule: map[string]interface{}{"roles": []interface{}{}},
+					Rules:       []map[string]interface{}{{"database": "db", "collection": "coll"}},
+				}},
+				HTTPEndpoints: []HTTPEndpointStructure{{
+					Config: map[string]interface{}{"name": "http", "type": "http"},
+					IncomingWebhooks: []map[string]interface{}{{
+						"config": map[string]interface{}{"name": "webhook"},
+						"source": `exports = () => "webhook"`,
+					}},
+				}},
+			}}},
+		}
+
+		converted, err := ConvertApp(app, realm.AppConfigVersion20180301)
+		assert.Nil(t, err)
+		assert.Equal(t, App{
+			RootDir: "/path/to/project",
+			Config:  FileStitch,
+			AppData: &AppStitchJSON{AppDataV1{AppStructureV1{
+				ConfigVersion:        realm.AppConfigVersion20180301,
+				Name:                 "test",
+				Security:             map[string]interface{}{"allowed_request_origins": []string{"http://localhost:8080"}},
+				CustomUserDataConfig: map[string]interface{}{"enabled": true},
+				Sync:                 map[string]interface{}{"development_mode_enabled": false},
+				AuthProviders: []map[string]interface{}{
+					{"name": "api-key"},
+					{"name": "local-userpass"},
+				},
+				Functions: []map[string]interface{}{{
+					"config": map[string]interface{}{"name": "test"},
+					"source": `exports = () => "test"`,
+				}},
+				Services: []ServiceStructure{
+					{
+						Config: map[string]interface{}{"name": "mongodb-atlas", "type": "mongodb-atlas"},
+						Rules:  []map[string]interface{}{{"database": "db", "collection": "coll"}},
+					},
+					{
+						Config:           map[string]interface{}{"name": "http", "type": "http"},
+						IncomingWebhooks: []map[string]interface{}{{"name": "webhook", "source": `exports = () => "webhook"`}},
+					},
+				},
+			}}},
+		}, converted)
+	})
+
+	t.Run("should not convert a v2 app with a function source that has no config", func(t *testing.T) {
+		app := App{
+			Config: FileRealmConfig,
+			AppData: &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+				ConfigVersion: realm.AppConfigVersion20210101,
+				Functions: FunctionsStructure{
+					Sources: map[string]string{"utils/helper.js": `exports = () => "helper"`},
+				},
+			}}},
+		}
+
+		_, err := ConvertApp(app, realm.AppConfigVersion20200603)
+		assert.Equal(t, "cannot convert app to config version 20200603: function source 'utils/helper.js' is not the source of a function", err.Error())
+	})
+
+	t.Run("should write a converted v1 app with the v2 structure", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		v1Dir := filepath.Join(tmpDir, "v1")
+		v2Dir := filepath.Join(tmpDir, "v2")
+
+		app := App{
+			RootDir: v1Dir,
+			Config:  FileConfig,
+			AppData: &AppConfigJSON{AppDataV1{AppStructureV1{
+				ConfigVersion: realm.AppConfigVersion20200603,
+				Name:          "test",
+				Location:      realm.LocationVirginia,
+				Security:      map[string]interface{}{"allowed_request_origins": []interface{}{"http://localhost:8080"}},
+				AuthProviders: []map[string]interface{}{{"name": "api-key", "type": "api-key"}},
+				Functions: []map[string]interface{}{{
+					"config": map[string]interface{}{"name": "test"},
+					"source": `exports = () => "test"`,
+				}},
+				Services: []ServiceStructure{
+					{
+						Config: map[string]interface{}{"name": "mongodb-atlas", "type": "mongodb-atlas"},
+						Rules:  []map[string]interface{}{{"database": "db", "collection": "coll"}},
+					},
+					{
+						Config:           map[string]interface{}{"name": "http", "type": "http"},
+						IncomingWebhooks: []map[string]interface{}{{"name": "webhook", "source": `exports = () => "webhook"`}},
+					},
+					{
+						Config: map[string]interface{}{"name": "twilio", "type": "twilio"},
+					},
+				},
+			}}},
+		}
+		assert.Nil(t, app.Write())
+
+		loaded, err := LoadApp(v1Dir)
+		assert.Nil(t, err)
+
+		converted, err := ConvertApp(loaded, realm.AppConfigVersion20210101)
+		assert.Nil(t, err)
+
+		converted.RootDir = v2Dir
+		assert.Nil(t, converted.Write())
+
+		written, err := LoadApp(v2Dir)
+		assert.Nil(t, err)
+		assert.Equal(t, FileRealmConfig, written.Config)
+
+		appData, ok := written.AppData.(*AppRealmConfigJSON)
+		assert.True(t, ok, "expected the app data to be v2")
+		assert.Equal(t, realm.AppConfigVersion20210101, appData.ConfigVersion())
+		assert.Equal(t, []string{"http://localhost:8080"}, appData.AllowedRequestOrigins)
+		assert.Equal(t, map[string]interface{}{"api-key": map[string]interface{}{"name": "api-key", "type": "api-key"}}, appData.Auth.Providers)
+		assert.Equal(t, map[string]string{"test.js": `exports = () => "test"`}, appData.Functions.Sources)
+
+		assert.Equal(t, 1, len(appData.DataSources))
+		assert.Equal(t, "mongodb-atlas", appData.DataSources[0].Config["name"])
+		assert.Equal(t, 1, len(appData.DataSources[0].Rules))
+		assert.Equal(t, "db", appData.DataSources[0].Rules[0]["database"])
+		assert.Equal(t, "coll", appData.DataSources[0].Rules[0]["collection"])
+
+		assert.Equal(t, 1, len(appData.HTTPEndpoints))
+		assert.Equal(t, "http", appData.HTTPEndpoints[0].Config["name"])
+		assert.Equal(t, 1, len(appData.HTTPEndpoints[0].IncomingWebhooks))
+		assert.Equal(t, `exports = () => "webhook"`, appData.HTTPEndpoints[0].IncomingWebhooks[0]["source"])
+
+		assert.Equal(t, 1, len(appData.Services))
+		assert.Equal(t, "twilio", appData.Services[0].Config["name"])
+	})
+}
@@ -3,9 +3,11 @@ package local
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 )
@@ -58,6 +60,7 @@ const (
 
 	// services
 	NameDataSources      = "data_sources"
+	NameDefaultRule      = "default_rule"
 	NameHTTPEndpoints    = "http_endpoints"
 	NameIncomingWebhooks = "incoming_webhooks"
 	NameRules            = "rules"
@@ -74,6 +77,12 @@ const (
 	// values
 	NameSecrets = "secrets"
 	NameValues  = "values"
+
+	// ignore
+	NameRealmIgnore = ".realmignore"
+
+	// owners
+	NameRealmOwners = ".realmowners"
 )
 
 // set of supported local files
@@ -88,6 +97,7 @@ var (
 	FileProviders      = File{NameProviders, extJSON}
 
 	// data sources
+	FileDefaultRule   = File{NameDefaultRule, extJSON}
 	FileRules         = File{NameRules, extJSON}
 	FileSchema        = File{NameSchema, extJSON}
 	FileRelationships = File{NameRelationships, extJSON}
@@ -97,6 +107,12 @@ var (
 
 	// values
 	FileSecrets = File{NameSecrets, extJSON}
+
+	// ignore
+	FileRealmIgnore = File{NameRealmIgnore, ""}
+
+	// owners
+	FileRealmOwners = File{NameRealmOwners, ""}
 )
 
 // File is a local Realm app file
@@ -107,18 +123,18 @@ type File struct {
 
 func (f File) String() string { return f.Name + f.Ext }
 
-func walk(rootDir string, ignorePaths map[string]struct{}, fn func(file os.FileInfo, path string) error) error {
+func walk(rootDir string, ignore IgnoreMatcher, ignorePaths map[string]struct{}, fn func(file os.FileInfo, path string) error) error {
 	if ignorePaths == nil {
 		ignorePaths = map[string]struct{}{}
 	}
 
-	dw := directoryWalker{path: rootDir}
+	dw := directoryWalker{path: rootDir, ignore: ignore}
 	if err := dw.walk(func(f os.FileInfo, p string) error {
 		if _, ok := ignorePaths[f.Name()]; ok {
 			return nil
 		}
 		if f.IsDir() {
-			return walk(p, ignorePaths, fn)
+			return walk(p, ignore, ignorePaths, fn)
 		}
 		return fn(f, p)
 	}); err != nil {
@@ -129,6 +145,7 @@ func walk(rootDir string, ignorePaths map[string]struct{}, fn func(file os.FileI
 
 type directoryWalker struct {
 	path            string
+	ignore          IgnoreMatcher
 	continueOnError bool
 	failOnNotExist  bool
 	onlyDirs        bool
@@ -150,7 +167,11 @@ func (dw directoryWalker) walk(fn func(file os.FileInfo, path string) error) err
 		if dw.onlyDirs && !file.IsDir() || dw.onlyFiles && file.IsDir() {
 			continue
 		}
-		err := fn(file, filepath.Join(dw.path, file.Name()))
+		path := filepath.Join(dw.path, file.Name())
+		if dw.ignore.Ignored(path, file.IsDir()) {
+			continue
+		}
+		err := fn(file, path)
 		if err != nil {
 			if dw.continueOnError {
 				continue
@@ -215,3 +236,214 @@ func AddDataSource(appData AppData, config map[string]interface{}) {
 		ad.DataSources = append(ad.DataSources, DataSourceStructure{Config: config})
 	}
 }
+
+// FunctionSourceStub is the source written for newly created functions
+const FunctionSourceStub = `exports = async function(arg) {
+  // Write your function logic here
+
+  return arg;
+};
+`
+
+// AddFunction adds a function with a stubbed source to the app data,
+// following the functions layout of the app's config version
+func AddFunction(appData AppData, name string, private bool) error {
+	config := map[string]interface{}{"name": name, "private": private}
+
+	switch ad := appData.(type) {
+	case *AppStitchJSON:
+		return addFunctionV1(&ad.AppDataV1, config)
+	case *AppConfigJSON:
+		return addFunctionV1(&ad.AppDataV1, config)
+	case *AppRealmConfigJSON:
+		for _, fn := range ad.Functions.Configs {
+			if stringField(fn, "name") == name {
+				return fmt.Errorf("function '%s' already exists", name)
+			}
+		}
+		source := filepath.FromSlash(name) + extJS
+		if _, ok := ad.Functions.Sources[source]; ok {
+			return fmt.Errorf("function source '%s' already exists", filepath.ToSlash(source))
+		}
+		if ad.Functions.Sources == nil {
+			ad.Functions.Sources = map[string]string{}
+		}
+		ad.Functions.Configs = append(ad.Functions.Configs, config)
+		ad.Functions.Sources[source] = FunctionSourceStub
+	}
+	return nil
+}
+
+func addFunctionV1(ad *AppDataV1, config map[string]interface{}) error {
+	name := stringField(config, "name")
+	if strings.Contains(name, "/") {
+		return fmt.Errorf("function '%s' cannot be nested in a directory with config version %d", name, ad.ConfigVersion())
+	}
+	for _, fn := range ad.Functions {
+		fnConfig, _ := fn[NameConfig].(map[string]interface{})
+		if stringField(fnConfig, "name") == name {
+			return fmt.Errorf("function '%s' already exists", name)
+		}
+	}
+	ad.Functions = append(ad.Functions, map[string]interface{}{
+		NameConfig: config,
+		NameSource: FunctionSourceStub,
+	})
+	return nil
+}
+
+// AddHTTPEndpoint adds an HTTP service with its incoming webhooks to the app data,
+// where each webhook holds its function source under the "source" key
+func AddHTTPEndpoint(appData AppData, config map[string]interface{}, webhooks []map[string]interface{}) error {
+	name := stringField(config, "name")
+
+	var services *[]ServiceStructure
+	switch ad := appData.(type) {
+	case *AppStitchJSON:
+		services = &ad.Services
+	case *AppConfigJSON:
+		services = &ad.Services
+	case *AppRealmConfigJSON:
+		for _, httpEndpoint := range ad.HTTPEndpoints {
+			if stringField(httpEndpoint.Config, "name") == name {
+				return fmt.Errorf("http endpoint '%s' already exists", name)
+			}
+		}
+		ad.HTTPEndpoints = append(ad.HTTPEndpoints, HTTPEndpointStructure{Config: config, IncomingWebhooks: webhooks})
+		return nil
+	default:
+		return nil
+	}
+
+	for _, svc := range *services {
+		if stringField(svc.Config, "name") == name {
+			return fmt.Errorf("service '%s' already exists", name)
+		}
+	}
+	*services = append(*services, ServiceStructure{Config: config, IncomingWebhooks: webhooks})
+	return nil
+}
+
+// AddTrigger adds a trigger to the app data
+func AddTrigger(appData AppData, config map[string]interface{}) error {
+	var triggers *[]map[string]interface{}
+	switch ad := appData.(type) {
+	case *AppStitchJSON:
+		triggers = &ad.Triggers
+	case *AppConfigJSON:
+		triggers = &ad.Triggers
+	case *AppRealmConfigJSON:
+		triggers = &ad.Triggers
+	default:
+		return nil
+	}
+
+	name := stringField(config, "name")
+	for _, trigger := range *triggers {
+		if stringField(trigger, "name") == name {
+			return fmt.Errorf("trigger '%s' already exists", name)
+		}
+	}
+	*triggers = append(*triggers, config)
+	return nil
+}
+
+// SetSchema sets the schema of the collection in the app data's data source,
+// adding a rule for the collection when it has none and the app's config version supports it
+func SetSchema(appData AppData, dataSource, database, collection string, schema map[string]interface{}) error {
+	rules, canAddRule, ok := dataSourceRules(appData, dataSource)
+	if !ok {
+		return nil
+	}
+
+	if rules == nil {
+		return fmt.Errorf("data source '%s' does not exist", dataSource)
+	}
+
+	for _, rule := range *rules {
+		if stringField(rule, "database") == database && stringField(rule, "collection") == collection {
+			rule[NameSchema] = schema
+			return nil
+		}
+	}
+
+	if !canAddRule {
+		return fmt.Errorf("no rule exists for collection '%s.%s' in data source '%s'", database, collection, dataSource)
+	}
+
+	*rules = append(*rules, map[string]interface{}{
+		"database":   database,
+		"collection": collection,
+		NameSchema:   schema,
+	})
+	return nil
+}
+
+// FindSchema finds the schema of the collection in the app data's data source, if it has one
+func FindSchema(appData AppData, dataSource, database, collection string) (map[string]interface{}, bool, error) {
+	rules, _, ok := dataSourceRules(appData, dataSource)
+	if !ok {
+		return nil, false, nil
+	}
+
+	if rules == nil {
+		return nil, false, fmt.Errorf("data source '%s' does not exist", dataSource)
+	}
+
+	for _, rule := range *rules {
+		if stringField(rule, "database") != database || stringField(rule, "collection") != collection {
+			continue
+		}
+		schema, ok := rule[NameSchema].(map[string]interface{})
+		return schema, ok && len(schema) > 0, nil
+	}
+	return nil, false, nil
+}
+
+// FindRule finds the rule of the collection in the app data's data source, if it has one
+func FindRule(appData AppData, dataSource, database, collection string) (map[string]interface{}, bool, error) {
+	rules, _, ok := dataSourceRules(appData, dataSource)
+	if !ok {
+		return nil, false, nil
+	}
+
+	if rules == nil {
+		return nil, false, fmt.Errorf("data source '%s' does not exist", dataSource)
+	}
+
+	for _, rule := range *rules {
+		if stringField(rule, "database") == database && stringField(rule, "collection") == collection {
+			return rule, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// dataSourceRules returns the rules of the app data's data source, which are nil when
+// the data source does not exist, along with whether the app's config version
+// supports adding rules to it and whether the app data's type is supported at all
+func dataSourceRules(appData AppData, dataSource string) (*[]map[string]interface{}, bool, bool) {
+	switch ad := appData.(type) {
+	case *AppStitchJSON:
+		return serviceRulesV1(&ad.AppDataV1, dataSource), false, true
+	case *AppConfigJSON:
+		return serviceRulesV1(&ad.AppDataV1, dataSource), false, true
+	case *AppRealmConfigJSON:
+		for i := range ad.DataSources {
+			if stringField(ad.DataSources[i].Config, "name") == dataSource {
+				return &ad.DataSources[i].Rules, true, true
+			}
+		}
+		return nil, true, true
+	}
+	return nil, false, false
+}
+
+func serviceRulesV1(ad *AppDataV1, name string) *[]map[string]interface{} {
+	for i := range ad.Services {
+		if stringField(ad.Services[i].Config, "name") == name {
+			return &ad.Services[i].Rules
+		}
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package local
 
 import (
+	"errors"
+	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
@@ -204,3 +207,255 @@ func TestAddDataSource(t *testing.T) {
 		})
 	}
 }
+
+func TestAddFunction(t *testing.T) {
+	for _, tc := range []struct {
+		description     string
+		appData         AppData
+		name            string
+		appDataExpected AppData
+	}{
+		{
+			description: "should add a function to app stitch json",
+			appData:     &AppStitchJSON{},
+			name:        "onNewOrder",
+			appDataExpected: &AppStitchJSON{AppDataV1{AppStructureV1{
+				Functions: []map[string]interface{}{{
+					NameConfig: map[string]interface{}{"name": "onNewOrder", "private": true},
+					NameSource: FunctionSourceStub,
+				}},
+			}}},
+		},
+		{
+			description: "should add a function to app config json",
+			appData:     &AppConfigJSON{},
+			name:        "onNewOrder",
+			appDataExpected: &AppConfigJSON{AppDataV1{AppStructureV1{
+				Functions: []map[string]interface{}{{
+					NameConfig: map[string]interface{}{"name": "onNewOrder", "private": true},
+					NameSource: FunctionSourceStub,
+				}},
+			}}},
+		},
+		{
+			description: "should add a nested function to app realm config json",
+			appData:     &AppRealmConfigJSON{},
+			name:        "orders/onNewOrder",
+			appDataExpected: &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+				Functions: FunctionsStructure{
+					Configs: []map[string]interface{}{{"name": "orders/onNewOrder", "private": true}},
+					Sources: map[string]string{filepath.Join("orders", "onNewOrder.js"): FunctionSourceStub},
+				},
+			}}},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Nil(t, AddFunction(tc.appData, tc.name, true))
+			assert.Equal(t, tc.appDataExpected, tc.appData)
+		})
+	}
+
+	t.Run("should return an error", func(t *testing.T) {
+		for _, tc := range []struct {
+			description string
+			appData     AppData
+			name        string
+			expectedErr error
+		}{
+			{
+				description: "when a v1 function already exists",
+				appData: &AppConfigJSON{AppDataV1{AppStructureV1{
+					Functions: []map[string]interface{}{{NameConfig: map[string]interface{}{"name": "fn"}}},
+				}}},
+				name:        "fn",
+				expectedErr: errors.New("function 'fn' already exists"),
+			},
+			{
+				description: "when a v1 function is nested",
+				appData:     &AppConfigJSON{AppDataV1{AppStructureV1{ConfigVersion: 20200603}}},
+				name:        "orders/fn",
+				expectedErr: errors.New("function 'orders/fn' cannot be nested in a directory with config version 20200603"),
+			},
+			{
+				description: "when a v2 function already exists",
+				appData: &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+					Functions: FunctionsStructure{Configs: []map[string]interface{}{{"name": "fn"}}},
+				}}},
+				name:        "fn",
+				expectedErr: errors.New("function 'fn' already exists"),
+			},
+			{
+				description: "when a v2 function source already exists",
+				appData: &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+					Functions: FunctionsStructure{Sources: map[string]string{"fn.js": ""}},
+				}}},
+				name:        "fn",
+				expectedErr: errors.New("function source 'fn.js' already exists"),
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				assert.Equal(t, tc.expectedErr, AddFunction(tc.appData, tc.name, false))
+			})
+		}
+	})
+}
+
+func TestAddTrigger(t *testing.T) {
+	trigger := map[string]interface{}{"name": "onNewOrder", "type": "SCHEDULED", "function_name": "onNewOrder"}
+
+	for _, appData := range []AppData{&AppStitchJSON{}, &AppConfigJSON{}, &AppRealmConfigJSON{}} {
+		t.Run(fmt.Sprintf("should add a trigger to %T and prevent duplicates", appData), func(t *testing.T) {
+			assert.Nil(t, AddTrigger(appData, trigger))
+			assert.Equal(t, errors.New("trigger 'onNewOrder' already exists"), AddTrigger(appData, trigger))
+		})
+	}
+
+	appData := &AppRealmConfigJSON{}
+	assert.Nil(t, AddTrigger(appData, trigger))
+	assert.Equal(t, []map[string]interface{}{trigger}, appData.Triggers)
+}
+
+func TestAddHTTPEndpoint(t *testing.T) {
+	config := map[string]interface{}{"name": "petstore", "type": "http", "config": map[string]interface{}{}, "version": 1}
+	webhooks := []map[string]interface{}{{"name": "listPets", NameSource: "exports = function() {};"}}
+
+	for _, tc := range []struct {
+		appData AppData
+		err     error
+	}{
+		{&AppStitchJSON{}, errors.New("service 'petstore' already exists")},
+		{&AppConfigJSON{}, errors.New("service 'petstore' already exists")},
+		{&AppRealmConfigJSON{}, errors.New("http endpoint 'petstore' already exists")},
+	} {
+		t.Run(fmt.Sprintf("should add an http endpoint to %T and prevent duplicates", tc.appData), func(t *testing.T) {
+			assert.Nil(t, AddHTTPEndpoint(tc.appData, config, webhooks))
+			assert.Equal(t, tc.err, AddHTTPEndpoint(tc.appData, config, webhooks))
+		})
+	}
+
+	v1 := &AppConfigJSON{}
+	assert.Nil(t, AddHTTPEndpoint(v1, config, webhooks))
+	assert.Equal(t, []ServiceStructure{{Config: config, IncomingWebhooks: webhooks}}, v1.Services)
+
+	v2 := &AppRealmConfigJSON{}
+	assert.Nil(t, AddHTTPEndpoint(v2, config, webhooks))
+	assert.Equal(t, []HTTPEndpointStructure{{Config: config, IncomingWebhooks: webhooks}}, v2.HTTPEndpoints)
+}
+
+func TestSetSchema(t *testing.T) {
+	schema := map[string]interface{}{"title": "order", "bsonType": "object"}
+
+	t.Run("should set the schema of an existing rule", func(t *testing.T) {
+		rule := map[string]interface{}{"database": "store", "collection": "orders", NameSchema: map[string]interface{}{}}
+
+		v1 := &AppConfigJSON{AppDataV1{AppStructureV1{Services: []ServiceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}, Rules: []map[string]interface{}{rule}},
+		}}}}
+		assert.Nil(t, SetSchema(v1, "mongodb-atlas", "store", "orders", schema))
+		assert.Equal(t, schema, v1.Services[0].Rules[0][NameSchema])
+
+		v2 := &AppRealmConfigJSON{AppDataV2{AppStructureV2{DataSources: []DataSourceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}, Rules: []map[string]interface{}{rule}},
+		}}}}
+		assert.Nil(t, SetSchema(v2, "mongodb-atlas", "store", "orders", schema))
+		assert.Equal(t, schema, v2.DataSources[0].Rules[0][NameSchema])
+	})
+
+	t.Run("should add a rule with the schema to a v2 data source without one", func(t *testing.T) {
+		appData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{DataSources: []DataSourceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}},
+		}}}}
+
+		assert.Nil(t, SetSchema(appData, "mongodb-atlas", "store", "orders", schema))
+		assert.Equal(t, []map[string]interface{}{
+			{"database": "store", "collection": "orders", NameSchema: schema},
+		}, appData.DataSources[0].Rules)
+	})
+
+	t.Run("should return an error for a v1 service without a rule for the collection", func(t *testing.T) {
+		appData := &AppStitchJSON{AppDataV1{AppStructureV1{Services: []ServiceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}},
+		}}}}
+
+		err := SetSchema(appData, "mongodb-atlas", "store", "orders", schema)
+		assert.Equal(t, errors.New("no rule exists for collection 'store.orders' in data source 'mongodb-atlas'"), err)
+	})
+
+	for _, appData := range []AppData{&AppStitchJSON{}, &AppConfigJSON{}, &AppRealmConfigJSON{}} {
+		t.Run(fmt.Sprintf("should return an error for %T without the data source", appData), func(t *testing.T) {
+			err := SetSchema(appData, "mongodb-atlas", "store", "orders", schema)
+			assert.Equal(t, errors.New("data source 'mongodb-atlas' does not exist"), err)
+		})
+	}
+}
+
+func TestFindRule(t *testing.T) {
+	rule := map[string]interface{}{"database": "store", "collection": "orders", "roles": []interface{}{}}
+
+	appData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{DataSources: []DataSourceStructure{
+		{Config: map[string]interface{}{"name": "mongodb-atlas"}, Rules: []map[string]interface{}{rule}},
+	}}}}
+
+	t.Run("should find the rule of the collection", func(t *testing.T) {
+		found, ok, err := FindRule(appData, "mongodb-atlas", "store", "orders")
+		assert.Nil(t, err)
+		assert.True(t, ok, "expected the rule to be found")
+		assert.Equal(t, rule, found)
+	})
+
+	t.Run("should not find the rule of a collection without one", func(t *testing.T) {
+		_, ok, err := FindRule(appData, "mongodb-atlas", "store", "customers")
+		assert.Nil(t, err)
+		assert.False(t, ok, "expected no rule to be found")
+	})
+
+	t.Run("should return an error when the data source does not exist", func(t *testing.T) {
+		_, _, err := FindRule(appData, "mongodb-atlas-2", "store", "orders")
+		assert.Equal(t, errors.New("data source 'mongodb-atlas-2' does not exist"), err)
+	})
+}
+
+func TestFindSchema(t *testing.T) {
+	schema := map[string]interface{}{"title": "order", "bsonType": "object"}
+
+	t.Run("should find the schema of the collection", func(t *testing.T) {
+		v1 := &AppConfigJSON{AppDataV1{AppStructureV1{Services: []ServiceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}, Rules: []map[string]interface{}{
+				{"database": "store", "collection": "orders", NameSchema: schema},
+			}},
+		}}}}
+		found, ok, err := FindSchema(v1, "mongodb-atlas", "store", "orders")
+		assert.Nil(t, err)
+		assert.True(t, ok, "expected the schema to be found")
+		assert.Equal(t, schema, found)
+
+		v2 := &AppRealmConfigJSON{AppDataV2{AppStructureV2{DataSources: []DataSourceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}, Rules: []map[string]interface{}{
+				{"database": "store", "collection": "orders", NameSchema: schema},
+			}},
+		}}}}
+		found, ok, err = FindSchema(v2, "mongodb-atlas", "store", "orders")
+		assert.Nil(t, err)
+		assert.True(t, ok, "expected the schema to be found")
+		assert.Equal(t, schema, found)
+	})
+
+	t.Run("should not find an empty schema or a collection without a rule", func(t *testing.T) {
+		appData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{DataSources: []DataSourceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}, Rules: []map[string]interface{}{
+				{"database": "store", "collection": "orders", NameSchema: map[string]interface{}{}},
+			}},
+		}}}}
+
+		for _, collection := range []string{"orders", "customers"} {
+			_, ok, err := FindSchema(appData, "mongodb-atlas", "store", collection)
+			assert.Nil(t, err)
+			assert.False(t, ok, "expected no schema to be found")
+		}
+	})
+
+	t.Run("should return an error without the data source", func(t *testing.T) {
+		_, _, err := FindSchema(&AppRealmConfigJSON{}, "mongodb-atlas", "store", "orders")
+		assert.Equal(t, errors.New("data source 'mongodb-atlas' does not exist"), err)
+	})
+}
@@ -8,11 +8,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"time"
 
 	"github.com/10gen/realm-cli/internal/terminal"
-
-	"github.com/briandowns/spinner"
 )
 
 // Dependencies holds the data related to a local Realm app's dependencies
@@ -159,8 +156,7 @@ func PrepareDependencies(app App, ui terminal.UI) (string, error) {
 		return "", err
 	}
 
-	s := spinner.New(terminal.SpinnerCircles, 250*time.Millisecond)
-	s.Suffix = " Transpiling dependency sources..."
+	s := ui.Spinner("Transpiling dependency sources...")
 
 	prepareUpload := func() (string, error) {
 		s.Start()
@@ -0,0 +1,139 @@
+package local
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+const (
+	fixtureAppID          = "fixture-abcde"
+	fixtureAppName        = "fixture"
+	fixtureDataSourceName = "mongodb-atlas"
+
+	// fixtureRulesPerDatabase is the number of generated rules which share a database
+	fixtureRulesPerDatabase = 100
+
+	// DefaultFixtureHostingFileSize is the default size in bytes of each generated hosting file
+	DefaultFixtureHostingFileSize = 1024
+	// DefaultFixtureHostingFilesPerDir is the default number of generated hosting files in each directory
+	DefaultFixtureHostingFilesPerDir = 100
+)
+
+// AppFixtureOptions are the options to generate a synthetic local Realm app with
+type AppFixtureOptions struct {
+	Functions          int
+	Rules              int
+	HostingFiles       int
+	HostingFileSize    int
+	HostingFilesPerDir int
+	Seed               int64
+}
+
+// GenerateAppFixture writes a synthetic local Realm app of the configured size to the directory,
+// for benchmarking and reproducing scale-related bugs. The same options always generate the same app.
+func GenerateAppFixture(rootDir string, opts AppFixtureOptions) (App, error) {
+	app := NewApp(
+		rootDir,
+		fixtureAppID,
+		fixtureAppName,
+		realm.LocationVirginia,
+		realm.DeploymentModelGlobal,
+		realm.EnvironmentNone,
+		realm.DefaultAppConfigVersion,
+	)
+
+	appData, ok := app.AppData.(*AppRealmConfigJSON)
+	if !ok {
+		return App{}, fmt.Errorf("unsupported app config version: %d", realm.DefaultAppConfigVersion)
+	}
+
+	appData.Functions = fixtureFunctions(opts.Functions)
+	if opts.Rules > 0 {
+		appData.DataSources = []DataSourceStructure{fixtureDataSource(opts.Rules)}
+	}
+
+	if err := app.Write(); err != nil {
+		return App{}, err
+	}
+
+	if err := writeFixtureHostingFiles(rootDir, opts); err != nil {
+		return App{}, err
+	}
+	return app, nil
+}
+
+func fixtureFunctions(n int) FunctionsStructure {
+	functions := FunctionsStructure{
+		Configs: make([]map[string]interface{}, 0, n),
+		Sources: make(map[string]string, n),
+	}
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("function%d", i)
+
+		functions.Configs = append(functions.Configs, map[string]interface{}{"name": name, "private": false})
+		functions.Sources[name+extJS] = fmt.Sprintf(`exports = function(arg) {
+  return { function: %q, arg };
+};
+`, name)
+	}
+	return functions
+}
+
+func fixtureDataSource(n int) DataSourceStructure {
+	rules := make([]map[string]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		rules = append(rules, map[string]interface{}{
+			"database":   fmt.Sprintf("db%d", i/fixtureRulesPerDatabase),
+			"collection": fmt.Sprintf("coll%d", i),
+			"roles": []map[string]interface{}{
+				{"name": "readAll", "apply_when": map[string]interface{}{}, "read": true, "write": false},
+			},
+			"filters": []map[string]interface{}{},
+		})
+	}
+
+	return DataSourceStructure{
+		Config: map[string]interface{}{
+			"name":   fixtureDataSourceName,
+			"type":   "mongodb-atlas",
+			"config": map[string]interface{}{"clusterName": "Cluster0"},
+		},
+		Rules: rules,
+	}
+}
+
+func writeFixtureHostingFiles(rootDir string, opts AppFixtureOptions) error {
+	if opts.HostingFiles <= 0 {
+		return nil
+	}
+
+	size := opts.HostingFileSize
+	if size <= 0 {
+		size = DefaultFixtureHostingFileSize
+	}
+	perDir := opts.HostingFilesPerDir
+	if perDir <= 0 {
+		perDir = DefaultFixtureHostingFilesPerDir
+	}
+
+	r := rand.New(rand.NewSource(opts.Seed))
+
+	dir := filepath.Join(rootDir, NameHosting, NameFiles)
+	for i := 0; i < opts.HostingFiles; i++ {
+		data := make([]byte, size)
+		r.Read(data)
+
+		if err := WriteFile(
+			filepath.Join(dir, fmt.Sprintf("dir%d", i/perDir), fmt.Sprintf("file%d.bin", i)),
+			0666,
+			bytes.NewReader(data),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestGenerateAppFixture(t *testing.T) {
+	opts := AppFixtureOptions{
+		Functions:          3,
+		Rules:              2,
+		HostingFiles:       5,
+		HostingFileSize:    16,
+		HostingFilesPerDir: 2,
+		Seed:               7,
+	}
+
+	t.Run("should generate a local app of the configured size", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		_, err = GenerateAppFixture(tmpDir, opts)
+		assert.Nil(t, err)
+
+		app, err := LoadApp(tmpDir)
+		assert.Nil(t, err)
+
+		appData, ok := app.AppData.(*AppRealmConfigJSON)
+		assert.True(t, ok, "expected app data to be a realm config")
+
+		assert.Equal(t, 3, len(appData.Functions.Configs))
+		assert.Equal(t, 3, len(appData.Functions.Sources))
+		assert.Equal(t, 1, len(appData.DataSources))
+		assert.Equal(t, 2, len(appData.DataSources[0].Rules))
+
+		for _, path := range []string{
+			filepath.Join("dir0", "file0.bin"),
+			filepath.Join("dir0", "file1.bin"),
+			filepath.Join("dir1", "file2.bin"),
+			filepath.Join("dir1", "file3.bin"),
+			filepath.Join("dir2", "file4.bin"),
+		} {
+			fileInfo, err := os.Stat(filepath.Join(tmpDir, NameHosting, NameFiles, path))
+			assert.Nil(t, err)
+			assert.Equal(t, int64(16), fileInfo.Size())
+		}
+	})
+
+	t.Run("should generate the same hosting files with the same seed", func(t *testing.T) {
+		contents := make([][]byte, 0, 2)
+		for i := 0; i < 2; i++ {
+			tmpDir, teardown, err := u.NewTempDir("")
+			assert.Nil(t, err)
+			defer teardown()
+
+			_, err = GenerateAppFixture(tmpDir, opts)
+			assert.Nil(t, err)
+
+			data, err := ioutil.ReadFile(filepath.Join(tmpDir, NameHosting, NameFiles, "dir2", "file4.bin"))
+			assert.Nil(t, err)
+			contents = append(contents, data)
+		}
+		assert.Equal(t, contents[0], contents[1])
+	})
+}
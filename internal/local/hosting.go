@@ -12,14 +12,26 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/terminal"
 	"github.com/10gen/realm-cli/internal/utils/api"
+	"github.com/10gen/realm-cli/internal/utils/parallel"
 )
 
 const (
 	numHostingWorkers = 4
+
+	// hostingUploadAttempts is the number of times a hosting asset is attempted to be uploaded
+	hostingUploadAttempts = 3
+)
+
+var (
+	// hostingUploadRetryBackoff is how long to wait before retrying failed hosting assets,
+	// which is multiplied by the number of attempts made so far
+	hostingUploadRetryBackoff = 250 * time.Millisecond
 )
 
 var (
@@ -36,6 +48,9 @@ var (
 // Hosting is the local Realm app hosting
 type Hosting struct {
 	RootDir string
+
+	// FilesDir is the directory of the hosting files, which defaults to the "files" directory of RootDir
+	FilesDir string
 }
 
 // HostingAssetClient is the hosting asset client
@@ -55,7 +70,7 @@ func FindAppHosting(path string) (Hosting, error) {
 
 	rootDir := filepath.Join(app.RootDir, NameHosting)
 
-	return Hosting{rootDir}, nil
+	return Hosting{RootDir: rootDir}, nil
 }
 
 // HostingDiffs are the hosting asset differences between a local and remote Realm app
@@ -122,7 +137,7 @@ func (h Hosting) Diffs(cachePath, appID string, appAssets []realm.HostingAsset)
 	if err != nil {
 		return HostingDiffs{}, err
 	}
-	localAssets, err := walkFiles(h.RootDir, appID, assets, assetCache)
+	localAssets, err := walkFiles(h.RootDir, h.filesDir(), appID, assets, assetCache)
 	if err != nil {
 		return HostingDiffs{}, err
 	}
@@ -168,82 +183,164 @@ func (h Hosting) Diffs(cachePath, appID string, appAssets []realm.HostingAsset)
 	return HostingDiffs{added, deleted, modified}, nil
 }
 
-// UploadHostingAssets uploads the hosting assets based on the diff of that file
-func (h Hosting) UploadHostingAssets(realmClient realm.Client, groupID, appID string, hostingDiffs HostingDiffs, errHandler func(err error)) error {
-	var wg sync.WaitGroup
+// HostingUploadError is the error returned when hosting assets still fail
+// to be uploaded after being retried, along with the diffs which failed
+type HostingUploadError struct {
+	Failed HostingDiffs
+}
+
+func (err HostingUploadError) Error() string {
+	return fmt.Sprintf("%d error(s) occurred while importing hosting assets", err.Failed.Size())
+}
 
-	jobCh := make(chan func())
-	errCh := make(chan error)
-	doneCh := make(chan struct{})
+// UploadHostingAssets uploads the hosting assets based on the diff of that file,
+// with at most maxParallel uploads at a time (or the default number of workers when not positive),
+// retrying the diffs which fail before reporting their errors to the error handler.
+// When provided, onProgress is called after each successful diff with the number of diffs completed so far
+func (h Hosting) UploadHostingAssets(realmClient realm.Client, groupID, appID string, hostingDiffs HostingDiffs, maxParallel int, errHandler func(err error), onProgress func(completed, total int)) error {
+	var completed int32
+	succeed := func() {
+		n := atomic.AddInt32(&completed, 1)
+		if onProgress != nil {
+			onProgress(int(n), hostingDiffs.Size())
+		}
+	}
 
-	var errs []error
-	go func() {
-		for err := range errCh {
-			errHandler(err)
-			errs = append(errs, err)
+	pending := hostingDiffs
+	for attempt := 1; ; attempt++ {
+		failed, errs := h.uploadHostingAssets(realmClient, groupID, appID, pending, hostingWorkers(maxParallel), succeed)
+		if failed.Size() == 0 {
+			return nil
 		}
-		doneCh <- struct{}{}
-	}()
-
-	for n := 0; n < numHostingWorkers; n++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			for job := range jobCh {
-				job()
+
+		if attempt == hostingUploadAttempts {
+			sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+			for _, err := range errs {
+				errHandler(err)
 			}
-		}()
+			return HostingUploadError{failed}
+		}
+
+		pending = failed
+		time.Sleep(time.Duration(attempt) * hostingUploadRetryBackoff)
+	}
+}
+
+func (h Hosting) uploadHostingAssets(realmClient realm.Client, groupID, appID string, hostingDiffs HostingDiffs, workers int, succeed func()) (HostingDiffs, []error) {
+	var mu sync.Mutex
+	var failed HostingDiffs
+	var errs []error
+
+	fail := func(err error, record func()) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		record()
+		errs = append(errs, err)
 	}
 
-	assetsDir := filepath.Join(h.RootDir, NameFiles)
+	assetsDir := h.filesDir()
+
+	jobs := make([]func(), 0, hostingDiffs.Size())
 
 	for _, added := range hostingDiffs.Added {
 		asset := added // the closure otherwise sees the same value for `added` each iteration
-		jobCh <- func() {
+		jobs = append(jobs, func() {
 			if err := realmClient.HostingAssetUpload(groupID, appID, assetsDir, asset); err != nil {
-				errCh <- fmt.Errorf("failed to add %s: %w", asset.FilePath, err)
+				fail(fmt.Errorf("failed to add %s: %w", asset.FilePath, err), func() { failed.Added = append(failed.Added, asset) })
+				return
 			}
-		}
+			succeed()
+		})
 	}
 
 	for _, deleted := range hostingDiffs.Deleted {
-		asset := deleted // the closure otherwise sees the same value for `added` each iteration
-		jobCh <- func() {
+		asset := deleted // the closure otherwise sees the same value for `deleted` each iteration
+		jobs = append(jobs, func() {
 			if err := realmClient.HostingAssetRemove(groupID, appID, asset.FilePath); err != nil {
-				errCh <- fmt.Errorf("failed to remove %s: %w", asset.FilePath, err)
+				fail(fmt.Errorf("failed to remove %s: %w", asset.FilePath, err), func() { failed.Deleted = append(failed.Deleted, asset) })
+				return
 			}
-		}
+			succeed()
+		})
 	}
 
 	for _, modified := range hostingDiffs.Modified {
-		asset := modified // the closure otherwise sees the same value for `added` each iteration
-		jobCh <- func() {
+		asset := modified // the closure otherwise sees the same value for `modified` each iteration
+		jobs = append(jobs, func() {
 			if asset.AttrsModified && !asset.BodyModified {
 				if err := realmClient.HostingAssetAttributesUpdate(groupID, appID, asset.FilePath, asset.Attrs...); err != nil {
-					errCh <- fmt.Errorf("failed to update attributes for %s: %w", asset.FilePath, err)
+					fail(fmt.Errorf("failed to update attributes for %s: %w", asset.FilePath, err), func() { failed.Modified = append(failed.Modified, asset) })
+					return
 				}
 			} else {
 				if err := realmClient.HostingAssetUpload(groupID, appID, assetsDir, asset.HostingAsset); err != nil {
-					errCh <- fmt.Errorf("failed to update %s: %w", asset.FilePath, err)
+					fail(fmt.Errorf("failed to update %s: %w", asset.FilePath, err), func() { failed.Modified = append(failed.Modified, asset) })
+					return
 				}
 			}
-		}
+			succeed()
+		})
 	}
 
-	close(jobCh)
-	wg.Wait()
+	parallel.Run(len(jobs), workers, func(i int) { jobs[i]() })
 
-	close(errCh)
-	<-doneCh
+	return failed, errs
+}
 
-	if len(errs) > 0 {
-		return fmt.Errorf("%d error(s) occurred while importing hosting assets", len(errs))
+// FilterPaths returns the hosting diffs of the provided asset paths
+func (d HostingDiffs) FilterPaths(paths []string) HostingDiffs {
+	pathSet := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		pathSet[path] = struct{}{}
 	}
-	return nil
+
+	var filtered HostingDiffs
+	for _, added := range d.Added {
+		if _, ok := pathSet[added.FilePath]; ok {
+			filtered.Added = append(filtered.Added, added)
+		}
+	}
+	for _, deleted := range d.Deleted {
+		if _, ok := pathSet[deleted.FilePath]; ok {
+			filtered.Deleted = append(filtered.Deleted, deleted)
+		}
+	}
+	for _, modified := range d.Modified {
+		if _, ok := pathSet[modified.FilePath]; ok {
+			filtered.Modified = append(filtered.Modified, modified)
+		}
+	}
+	return filtered
+}
+
+// Paths returns the asset paths of the hosting diffs
+func (d HostingDiffs) Paths() []string {
+	paths := make([]string, 0, d.Size())
+	for _, added := range d.Added {
+		paths = append(paths, added.FilePath)
+	}
+	for _, deleted := range d.Deleted {
+		paths = append(paths, deleted.FilePath)
+	}
+	for _, modified := range d.Modified {
+		paths = append(paths, modified.FilePath)
+	}
+	sort.Strings(paths)
+	return paths
 }
 
 // WriteHostingAssets writes the hosting assets to disk
-func WriteHostingAssets(assetClient HostingAssetClient, rootDir, groupID, appID string, appAssets []realm.HostingAsset) error {
+func WriteHostingAssets(assetClient HostingAssetClient, rootDir, groupID, appID string, appAssets []realm.HostingAsset, maxParallel int) error {
+	if err := WriteHostingMetadata(rootDir, appAssets); err != nil {
+		return err
+	}
+	return DownloadHostingAssets(assetClient, rootDir, appAssets, maxParallel)
+}
+
+// WriteHostingMetadata writes the attributes of the hosting assets to the metadata file,
+// omitting the assets whose only attribute is implied by their file extension
+func WriteHostingMetadata(rootDir string, appAssets []realm.HostingAsset) error {
 	dir := filepath.Join(rootDir, NameHosting)
 
 	assets := make([]hostingAsset, 0, len(appAssets))
@@ -281,74 +378,123 @@ func WriteHostingAssets(assetClient HostingAssetClient, rootDir, groupID, appID
 		return err
 	}
 
-	if err := WriteFile(
+	return WriteFile(
 		filepath.Join(dir, NameMetadata+extJSON),
 		0666,
 		bytes.NewReader(metadata),
-	); err != nil {
-		return err
-	}
-
-	var wg sync.WaitGroup
+	)
+}
 
-	assetCh := make(chan realm.HostingAsset)
-	errCh := make(chan error)
-	doneCh := make(chan struct{})
+// DownloadHostingAssets downloads the files of the hosting assets to disk,
+// with at most maxParallel downloads at a time (or the default number of workers when not positive)
+func DownloadHostingAssets(assetClient HostingAssetClient, rootDir string, appAssets []realm.HostingAsset, maxParallel int) error {
+	dir := filepath.Join(rootDir, NameHosting)
 
+	var mu sync.Mutex
 	var errs []error
 
-	go func() {
-		for err := range errCh {
-			errs = append(errs, err)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		errs = append(errs, err)
+	}
+
+	parallel.Run(len(appAssets), hostingWorkers(maxParallel), func(i int) {
+		asset := appAssets[i]
+		if strings.HasSuffix(asset.FilePath, "/") {
+			return
 		}
-		doneCh <- struct{}{}
-	}()
 
-	for n := 0; n < numHostingWorkers; n++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		res, err := assetClient.Get(asset.URL)
+		if err != nil {
+			fail(err)
+			return
+		}
+		defer res.Body.Close()
 
-			for asset := range assetCh {
-				if strings.HasSuffix(asset.FilePath, "/") {
-					continue
-				}
+		if res.StatusCode != http.StatusOK {
+			fail(api.ErrUnexpectedStatusCode{"get hosting asset", res.StatusCode})
+			return
+		}
 
-				res, err := assetClient.Get(asset.URL)
-				if err != nil {
-					errCh <- err
-					continue
-				}
-				if res.StatusCode != http.StatusOK {
-					errCh <- api.ErrUnexpectedStatusCode{"get hosting asset", res.StatusCode}
-					continue
-				}
+		if err := WriteFile(
+			filepath.Join(dir, NameFiles, asset.FilePath),
+			0666,
+			res.Body,
+		); err != nil {
+			fail(err)
+		}
+	})
 
-				if err := WriteFile(
-					filepath.Join(dir, NameFiles, asset.FilePath),
-					0666,
-					res.Body,
-				); err != nil {
-					errCh <- err
-				}
-			}
-		}()
+	if len(errs) > 0 {
+		return fmt.Errorf("%d error(s) occurred while exporting hosting assets", len(errs))
+	}
+	return nil
+}
+
+func (h Hosting) filesDir() string {
+	if h.FilesDir != "" {
+		return h.FilesDir
 	}
+	return filepath.Join(h.RootDir, NameFiles)
+}
 
+func hostingWorkers(maxParallel int) int {
+	if maxParallel > 0 {
+		return maxParallel
+	}
+	return numHostingWorkers
+}
+
+// HostingAssetsNewerThanLocal returns the hosting assets which were modified after their
+// local file found in the app directory, including the assets which have no local file
+func HostingAssetsNewerThanLocal(rootDir string, appAssets []realm.HostingAsset) ([]realm.HostingAsset, error) {
+	dir := filepath.Join(rootDir, NameHosting, NameFiles)
+
+	newer := make([]realm.HostingAsset, 0, len(appAssets))
 	for _, appAsset := range appAssets {
-		assetCh <- appAsset
+		fileInfo, err := os.Stat(extendedLengthPath(filepath.Join(dir, filepath.FromSlash(appAsset.FilePath))))
+		if err != nil {
+			if os.IsNotExist(err) {
+				newer = append(newer, appAsset)
+				continue
+			}
+			return nil, err
+		}
+		if fileInfo.ModTime().Unix() < appAsset.LastModified {
+			newer = append(newer, appAsset)
+		}
 	}
+	return newer, nil
+}
 
-	close(assetCh)
-	wg.Wait()
+// ValidHostingAttributeName returns the canonical name of the hosting asset attribute
+// along with whether it is an attribute which can be set on hosting assets
+func ValidHostingAttributeName(name string) (string, bool) {
+	canonical := http.CanonicalHeaderKey(strings.TrimSpace(name))
+	_, ok := validAttrNames[canonical]
+	return canonical, ok
+}
 
-	close(errCh)
-	<-doneCh
+// HostingAttributeNames returns the sorted names of the attributes which can be set on hosting assets
+func HostingAttributeNames() []string {
+	names := make([]string, 0, len(validAttrNames))
+	for name := range validAttrNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
 
-	if len(errs) > 0 {
-		return fmt.Errorf("%d error(s) occurred while exporting hosting assets", len(errs))
+// MatchHostingPath returns whether the hosting asset path matches the gitignore-style pattern,
+// where a pattern matching a directory matches every file within it
+func MatchHostingPath(pattern, assetPath string) bool {
+	p, ok := parseIgnorePattern(pattern)
+	if !ok || p.negate {
+		return false
 	}
-	return nil
+	return p.matchFile(strings.Split(strings.TrimPrefix(assetPath, "/"), "/"))
 }
 
 func assetAttrsEquals(appAssetAttrs, localAssetAttrs realm.HostingAssetAttributes) bool {
@@ -399,16 +545,26 @@ func readMetadata(rootDir string) (map[string]hostingAsset, error) {
 	return assetsByPath, nil
 }
 
-func walkFiles(rootDir, appID string, localAssets map[string]hostingAsset, assetCache *hostingAssetCache) ([]realm.HostingAsset, error) {
-	dir := filepath.Join(rootDir, NameFiles)
+func walkFiles(rootDir, dir, appID string, localAssets map[string]hostingAsset, assetCache *hostingAssetCache) ([]realm.HostingAsset, error) {
+	ignore, err := LoadIgnoreMatcher(rootDir)
+	if err != nil {
+		return nil, err
+	}
 
 	var assets []realm.HostingAsset
 
-	if err := filepath.Walk(dir, func(path string, fileInfo os.FileInfo, err error) error {
+	if err := walkExtendedLength(dir, func(path string, fileInfo os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
+		if ignore.Ignored(path, fileInfo.IsDir()) {
+			if fileInfo.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
 		if fileInfo.IsDir() {
 			return nil
 		}
@@ -474,7 +630,7 @@ func walkFiles(rootDir, appID string, localAssets map[string]hostingAsset, asset
 }
 
 func generateHash(path string) (string, error) {
-	file, err := os.Open(path)
+	file, err := os.Open(extendedLengthPath(path))
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,79 @@
+package local
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+const (
+	hostingFailuresVersion = 1
+
+	// NameHostingFailures is the name of the manifest written when hosting assets fail to upload
+	NameHostingFailures = "hosting-failures.json"
+)
+
+// HostingFailures is the manifest of hosting asset paths which failed to upload,
+// written after an upload so that only those hosting assets are retried
+type HostingFailures struct {
+	Version  int      `json:"version"`
+	GroupID  string   `json:"group_id"`
+	AppID    string   `json:"app_id"`
+	Added    []string `json:"added,omitempty"`
+	Deleted  []string `json:"deleted,omitempty"`
+	Modified []string `json:"modified,omitempty"`
+}
+
+// NewHostingFailures creates the manifest of the hosting diffs which failed to upload to the app
+func NewHostingFailures(groupID, appID string, failed HostingDiffs) HostingFailures {
+	manifest := HostingFailures{Version: hostingFailuresVersion, GroupID: groupID, AppID: appID}
+	for _, added := range failed.Added {
+		manifest.Added = append(manifest.Added, added.FilePath)
+	}
+	for _, deleted := range failed.Deleted {
+		manifest.Deleted = append(manifest.Deleted, deleted.FilePath)
+	}
+	for _, modified := range failed.Modified {
+		manifest.Modified = append(manifest.Modified, modified.FilePath)
+	}
+	return manifest
+}
+
+// ReadHostingFailures reads the hosting failures manifest at the path
+func ReadHostingFailures(path string) (HostingFailures, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return HostingFailures{}, err
+	}
+
+	var manifest HostingFailures
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return HostingFailures{}, fmt.Errorf("failed to parse hosting failures at %s: %w", path, err)
+	}
+
+	if manifest.Version != hostingFailuresVersion {
+		return HostingFailures{}, fmt.Errorf("hosting failures at %s have unsupported version %d", path, manifest.Version)
+	}
+	if manifest.GroupID == "" || manifest.AppID == "" {
+		return HostingFailures{}, fmt.Errorf("hosting failures at %s are missing the app to push to", path)
+	}
+
+	return manifest, nil
+}
+
+// Write writes the hosting failures manifest to the path
+func (m HostingFailures) Write(path string) error {
+	data, err := json.MarshalIndent(m, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Paths returns the paths of the hosting assets which failed to upload
+func (m HostingFailures) Paths() []string {
+	paths := make([]string, 0, len(m.Added)+len(m.Deleted)+len(m.Modified))
+	paths = append(paths, m.Added...)
+	paths = append(paths, m.Deleted...)
+	return append(paths, m.Modified...)
+}
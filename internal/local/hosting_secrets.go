@@ -0,0 +1,186 @@
+package local
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"unicode"
+
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+const (
+	// FlagAllowSecretsInHosting is the flag to upload hosting assets which appear to contain secrets
+	FlagAllowSecretsInHosting      = "allow-secrets-in-hosting"
+	FlagAllowSecretsInHostingUsage = "include to upload hosting assets even when they appear to contain secrets, such as API keys or connection strings"
+
+	headerHostingSecretFile = "File"
+	headerHostingSecretLine = "Line"
+	headerHostingSecretKind = "Possible Secret"
+
+	// hostingSecretMinEntropy is the minimum number of bits of entropy per character
+	// a value assigned to a secret-like name must have to be considered a secret
+	hostingSecretMinEntropy = 3.5
+
+	// hostingSecretSniffLen is the number of bytes read to detect a binary file
+	hostingSecretSniffLen = 512
+)
+
+var (
+	hostingSecretPatterns = []struct {
+		kind    string
+		pattern *regexp.Regexp
+	}{
+		{"AWS access key ID", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+		{"private key", regexp.MustCompile(`-----BEGIN ((RSA|EC|DSA|OPENSSH|ENCRYPTED) )?PRIVATE KEY-----`)},
+		{"MongoDB connection string with credentials", regexp.MustCompile("mongodb(\\+srv)?://[^\\s:/@\"'`<>]+:[^\\s/@\"'`<>]+@")},
+		{"Stripe secret key", regexp.MustCompile(`\b[rs]k_live_[0-9A-Za-z]{24,}\b`)},
+		{"GitHub token", regexp.MustCompile(`\bgh[oprsu]_[0-9A-Za-z]{36,}\b`)},
+		{"Slack token", regexp.MustCompile(`\bxox[abposr]-[0-9A-Za-z-]{10,}\b`)},
+	}
+
+	// hostingSecretAssignment matches a quoted value assigned to a secret-like name,
+	// e.g. `apiKey: "..."` or `CLIENT_SECRET = '...'`
+	hostingSecretAssignment = regexp.MustCompile(`(?i)([\w$-]*(?:api[_-]?key|secret|token|passw(?:or)?d|access[_-]?key)[\w$-]*)["']?\s*[:=]\s*["']([^"'\s]{16,})["']`)
+)
+
+// HostingSecret is a possible secret found in a local hosting asset
+type HostingSecret struct {
+	FilePath string
+	Line     int
+	Kind     string
+}
+
+// CheckSecrets blocks uploading hosting assets which appear to contain secrets,
+// unless they are explicitly allowed
+func (h Hosting) CheckSecrets(ui terminal.UI, hostingDiffs HostingDiffs, allowSecrets bool) error {
+	secrets, err := h.FindSecrets(hostingDiffs)
+	if err != nil {
+		return err
+	}
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(secrets))
+	for _, secret := range secrets {
+		rows = append(rows, map[string]interface{}{
+			headerHostingSecretFile: secret.FilePath,
+			headerHostingSecretLine: secret.Line,
+			headerHostingSecretKind: secret.Kind,
+		})
+	}
+
+	table := terminal.NewTableLog(
+		fmt.Sprintf("Found %d possible secret(s) in the hosting assets to upload", len(secrets)),
+		[]string{headerHostingSecretFile, headerHostingSecretLine, headerHostingSecretKind},
+		rows...,
+	)
+
+	if allowSecrets {
+		ui.Print(table, terminal.NewWarningLog("Uploading these hosting assets anyway, as --%s is set", FlagAllowSecretsInHosting))
+		return nil
+	}
+
+	ui.Print(table)
+	return errSecretsInHosting{len(secrets)}
+}
+
+type errSecretsInHosting struct {
+	count int
+}
+
+func (err errSecretsInHosting) Error() string {
+	return fmt.Sprintf(
+		"found %d possible secret(s) in the hosting assets to upload, remove them or use --%s to upload them anyway",
+		err.count,
+		FlagAllowSecretsInHosting,
+	)
+}
+
+func (err errSecretsInHosting) DisableUsage() struct{} { return struct{}{} }
+
+// FindSecrets scans the contents of the hosting assets which are to be uploaded
+// for possible secrets, such as API keys and connection strings
+func (h Hosting) FindSecrets(hostingDiffs HostingDiffs) ([]HostingSecret, error) {
+	paths := make([]string, 0, len(hostingDiffs.Added)+len(hostingDiffs.Modified))
+	for _, added := range hostingDiffs.Added {
+		paths = append(paths, added.FilePath)
+	}
+	for _, modified := range hostingDiffs.Modified {
+		if modified.BodyModified {
+			paths = append(paths, modified.FilePath)
+		}
+	}
+	sort.Strings(paths)
+
+	assetsDir := h.filesDir()
+
+	var secrets []HostingSecret
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(extendedLengthPath(filepath.Join(assetsDir, path)))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, secret := range findSecrets(data) {
+			secret.FilePath = path
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets, nil
+}
+
+func findSecrets(data []byte) []HostingSecret {
+	sniff := data
+	if len(sniff) > hostingSecretSniffLen {
+		sniff = sniff[:hostingSecretSniffLen]
+	}
+	if bytes.IndexByte(sniff, 0) >= 0 {
+		return nil // binary files such as images and fonts are not scanned
+	}
+
+	var secrets []HostingSecret
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		for _, p := range hostingSecretPatterns {
+			if p.pattern.Match(line) {
+				secrets = append(secrets, HostingSecret{Line: i + 1, Kind: p.kind})
+			}
+		}
+
+		for _, match := range hostingSecretAssignment.FindAllSubmatch(line, -1) {
+			if value := string(match[2]); isHighEntropy(value) {
+				secrets = append(secrets, HostingSecret{Line: i + 1, Kind: `high-entropy value of "` + string(match[1]) + `"`})
+			}
+		}
+	}
+	return secrets
+}
+
+// isHighEntropy reports whether the value looks randomly generated, which excludes
+// placeholders such as "YOUR_API_KEY_HERE" by also requiring both letters and digits
+func isHighEntropy(value string) bool {
+	var hasLetter, hasDigit bool
+	var total int
+	counts := map[rune]int{}
+	for _, r := range value {
+		hasLetter = hasLetter || unicode.IsLetter(r)
+		hasDigit = hasDigit || unicode.IsDigit(r)
+		counts[r]++
+		total++
+	}
+	if !hasLetter || !hasDigit {
+		return false
+	}
+
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy >= hostingSecretMinEntropy
+}
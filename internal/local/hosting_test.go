@@ -1,8 +1,12 @@
 package local
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"sync"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -10,6 +14,7 @@ import (
 	"github.com/10gen/realm-cli/internal/utils/api"
 	u "github.com/10gen/realm-cli/internal/utils/test"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
 )
 
 func TestHostingFind(t *testing.T) {
@@ -89,3 +94,129 @@ func TestHostingFind(t *testing.T) {
 		})
 	})
 }
+
+func TestHostingUploadHostingAssets(t *testing.T) {
+	retryBackoff := hostingUploadRetryBackoff
+	hostingUploadRetryBackoff = 0
+	defer func() { hostingUploadRetryBackoff = retryBackoff }()
+
+	hostingDiffs := HostingDiffs{
+		Added: []realm.HostingAsset{
+			{HostingAssetData: realm.HostingAssetData{FilePath: "/index.html"}},
+			{HostingAssetData: realm.HostingAssetData{FilePath: "/404.html"}},
+		},
+		Deleted: []realm.HostingAsset{
+			{HostingAssetData: realm.HostingAssetData{FilePath: "/deleteme.html"}},
+		},
+	}
+
+	t.Run("should retry the hosting assets which fail to upload", func(t *testing.T) {
+		var mu sync.Mutex
+		uploads := map[string]int{}
+
+		realmClient := mock.RealmClient{}
+		realmClient.HostingAssetUploadFn = func(groupID, appID, rootDir string, asset realm.HostingAsset) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			uploads[asset.FilePath]++
+			if asset.FilePath == "/404.html" && uploads[asset.FilePath] < hostingUploadAttempts {
+				return errors.New("something bad happened")
+			}
+			return nil
+		}
+		realmClient.HostingAssetRemoveFn = func(groupID, appID, path string) error {
+			return nil
+		}
+
+		var errs []error
+		var progress []int
+		err := Hosting{}.UploadHostingAssets(realmClient, "groupID", "appID", hostingDiffs, 0, func(err error) {
+			errs = append(errs, err)
+		}, func(completed, total int) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			assert.Equal(t, 3, total)
+			progress = append(progress, completed)
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(errs))
+		assert.Equal(t, map[string]int{"/index.html": 1, "/404.html": hostingUploadAttempts}, uploads)
+
+		sort.Ints(progress)
+		assert.Equal(t, []int{1, 2, 3}, progress)
+	})
+
+	t.Run("should return the hosting assets which still fail after retrying", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.HostingAssetUploadFn = func(groupID, appID, rootDir string, asset realm.HostingAsset) error {
+			return nil
+		}
+		realmClient.HostingAssetRemoveFn = func(groupID, appID, path string) error {
+			return errors.New("something bad happened")
+		}
+
+		var errs []error
+		err := Hosting{}.UploadHostingAssets(realmClient, "groupID", "appID", hostingDiffs, 0, func(err error) {
+			errs = append(errs, err)
+		}, nil)
+		assert.Equal(t, HostingUploadError{HostingDiffs{Deleted: hostingDiffs.Deleted}}, err)
+		assert.Equal(t, "1 error(s) occurred while importing hosting assets", err.Error())
+		assert.Equal(t, 1, len(errs))
+		assert.Equal(t, "failed to remove /deleteme.html: something bad happened", errs[0].Error())
+	})
+}
+
+func TestHostingDiffsFilterPaths(t *testing.T) {
+	hostingDiffs := HostingDiffs{
+		Added: []realm.HostingAsset{
+			{HostingAssetData: realm.HostingAssetData{FilePath: "/index.html"}},
+			{HostingAssetData: realm.HostingAssetData{FilePath: "/404.html"}},
+		},
+		Deleted: []realm.HostingAsset{
+			{HostingAssetData: realm.HostingAssetData{FilePath: "/deleteme.html"}},
+		},
+		Modified: []ModifiedHostingAsset{
+			{HostingAsset: realm.HostingAsset{HostingAssetData: realm.HostingAssetData{FilePath: "/styles.css"}}},
+		},
+	}
+
+	filtered := hostingDiffs.FilterPaths([]string{"/404.html", "/styles.css", "/missing.html"})
+	assert.Equal(t, []string{"/404.html", "/styles.css"}, filtered.Paths())
+	assert.Equal(t, 0, len(filtered.Deleted))
+}
+
+func TestMatchHostingPath(t *testing.T) {
+	for _, tc := range []struct {
+		pattern   string
+		assetPath string
+		expected  bool
+	}{
+		{pattern: "/downloads/*", assetPath: "/downloads/report.pdf", expected: true},
+		{pattern: "/downloads/*", assetPath: "/downloads/2021/report.pdf", expected: true},
+		{pattern: "/downloads/*", assetPath: "/docs/downloads/report.pdf"},
+		{pattern: "downloads", assetPath: "/docs/downloads/report.pdf", expected: true},
+		{pattern: "*.pdf", assetPath: "/docs/report.pdf", expected: true},
+		{pattern: "*.pdf", assetPath: "/index.html"},
+		{pattern: "/index.html", assetPath: "/index.html", expected: true},
+		{pattern: "!/index.html", assetPath: "/index.html"},
+	} {
+		t.Run(fmt.Sprintf("should match %s against %s", tc.assetPath, tc.pattern), func(t *testing.T) {
+			assert.Equal(t, tc.expected, MatchHostingPath(tc.pattern, tc.assetPath))
+		})
+	}
+}
+
+func TestValidHostingAttributeName(t *testing.T) {
+	t.Run("should return the canonical name of a valid attribute", func(t *testing.T) {
+		name, ok := ValidHostingAttributeName(" content-disposition")
+		assert.True(t, ok, "expected the attribute to be valid")
+		assert.Equal(t, api.HeaderContentDisposition, name)
+	})
+
+	t.Run("should not allow an unsupported attribute", func(t *testing.T) {
+		_, ok := ValidHostingAttributeName("X-Frame-Options")
+		assert.False(t, ok, "expected the attribute to be invalid")
+	})
+}
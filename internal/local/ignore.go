@@ -0,0 +1,170 @@
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IgnoreMatcher matches app files against the patterns of a .realmignore file
+// found at the root of a local Realm app. The patterns follow gitignore syntax.
+// The zero value ignores nothing.
+type IgnoreMatcher struct {
+	rootDir  string
+	patterns []ignorePattern
+}
+
+type ignorePattern struct {
+	segments []string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// LoadIgnoreMatcher loads the .realmignore file found at the provided app root directory
+func LoadIgnoreMatcher(rootDir string) (IgnoreMatcher, error) {
+	data, err := readFile(filepath.Join(rootDir, FileRealmIgnore.String()))
+	if err != nil {
+		return IgnoreMatcher{}, err
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return IgnoreMatcher{}, err
+	}
+
+	return NewIgnoreMatcher(rootDir, lines...), nil
+}
+
+// NewIgnoreMatcher returns a matcher for the provided gitignore-style patterns,
+// which are evaluated relative to the provided app root directory
+func NewIgnoreMatcher(rootDir string, patterns ...string) IgnoreMatcher {
+	m := IgnoreMatcher{rootDir: rootDir}
+	for _, line := range patterns {
+		if p, ok := parseIgnorePattern(line); ok {
+			m.patterns = append(m.patterns, p)
+		}
+	}
+	return m
+}
+
+func parseIgnorePattern(line string) (ignorePattern, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignorePattern{}, false
+	}
+
+	var p ignorePattern
+
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\`) {
+		line = line[1:] // allows patterns starting with a literal '#' or '!'
+	}
+
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	if strings.Contains(line, "/") {
+		p.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+
+	if line == "" {
+		return ignorePattern{}, false
+	}
+
+	p.segments = strings.Split(line, "/")
+	return p, true
+}
+
+// Ignored reports whether the file at the provided path should be ignored,
+// either because it matches a pattern itself or because one of its parent directories does
+func (m IgnoreMatcher) Ignored(filePath string, isDir bool) bool {
+	if len(m.patterns) == 0 {
+		return false
+	}
+
+	rel, err := filepath.Rel(m.rootDir, filePath)
+	if err != nil {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return false
+	}
+
+	segments := strings.Split(rel, "/")
+	for i := 1; i < len(segments); i++ {
+		if m.matches(segments[:i], true) {
+			return true
+		}
+	}
+	return m.matches(segments, isDir)
+}
+
+func (m IgnoreMatcher) matches(segments []string, isDir bool) bool {
+	var ignored bool
+	for _, p := range m.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if p.match(segments) {
+			ignored = !p.negate
+		}
+	}
+	return ignored
+}
+
+// matchFile returns whether the pattern matches the file or any of its parent directories
+func (p ignorePattern) matchFile(segments []string) bool {
+	for i := 1; i < len(segments); i++ {
+		if p.match(segments[:i]) {
+			return true
+		}
+	}
+	return !p.dirOnly && p.match(segments)
+}
+
+func (p ignorePattern) match(segments []string) bool {
+	if !p.anchored {
+		ok, _ := path.Match(p.segments[0], segments[len(segments)-1])
+		return ok
+	}
+	return matchIgnoreSegments(p.segments, segments)
+}
+
+func matchIgnoreSegments(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			if len(pattern) == 1 {
+				return len(segments) > 0
+			}
+			for i := 0; i <= len(segments); i++ {
+				if matchIgnoreSegments(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(segments) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+			return false
+		}
+
+		pattern, segments = pattern[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
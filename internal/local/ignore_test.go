@@ -0,0 +1,169 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestIgnoreMatcher(t *testing.T) {
+	rootDir := filepath.Join("/", "app")
+
+	for _, tc := range []struct {
+		description string
+		patterns    []string
+		path        string
+		isDir       bool
+		ignored     bool
+	}{
+		{
+			description: "ignore nothing without patterns",
+			path:        "functions/test.js",
+		},
+		{
+			description: "ignore a file matching a basename pattern at any depth",
+			patterns:    []string{"*.bak"},
+			path:        "functions/nested/test.js.bak",
+			ignored:     true,
+		},
+		{
+			description: "skip comments and blank lines",
+			patterns:    []string{"# *.js", "", "   "},
+			path:        "functions/test.js",
+		},
+		{
+			description: "ignore a file matching an anchored pattern",
+			patterns:    []string{"/scripts/*.sh"},
+			path:        "scripts/seed.sh",
+			ignored:     true,
+		},
+		{
+			description: "not ignore a nested file with an anchored pattern",
+			patterns:    []string{"/scripts/*.sh"},
+			path:        "functions/scripts/seed.sh",
+		},
+		{
+			description: "ignore every file within an ignored directory",
+			patterns:    []string{"fixtures/"},
+			path:        "functions/fixtures/nested/data.json",
+			ignored:     true,
+		},
+		{
+			description: "not ignore a file with a directory only pattern",
+			patterns:    []string{"fixtures/"},
+			path:        "functions/fixtures",
+		},
+		{
+			description: "ignore a directory with a directory only pattern",
+			patterns:    []string{"fixtures/"},
+			path:        "functions/fixtures",
+			isDir:       true,
+			ignored:     true,
+		},
+		{
+			description: "re-include a file with a negated pattern",
+			patterns:    []string{"*.json", "!config.json"},
+			path:        "triggers/config.json",
+		},
+		{
+			description: "let the last matching pattern win",
+			patterns:    []string{"!config.json", "*.json"},
+			path:        "triggers/config.json",
+			ignored:     true,
+		},
+		{
+			description: "not re-include a file within an ignored directory",
+			patterns:    []string{"fixtures/", "!keep.json"},
+			path:        "fixtures/keep.json",
+			ignored:     true,
+		},
+		{
+			description: "match any number of directories with a double star",
+			patterns:    []string{"functions/**/*.test.js"},
+			path:        "functions/a/b/sum.test.js",
+			ignored:     true,
+		},
+		{
+			description: "match zero directories with a double star",
+			patterns:    []string{"functions/**/*.test.js"},
+			path:        "functions/sum.test.js",
+			ignored:     true,
+		},
+		{
+			description: "match a literal leading character with an escape",
+			patterns:    []string{`\#notes.txt`},
+			path:        "#notes.txt",
+			ignored:     true,
+		},
+		{
+			description: "not match paths outside of the root directory",
+			patterns:    []string{"*.js"},
+			path:        "../test.js",
+		},
+	} {
+		t.Run("should "+tc.description, func(t *testing.T) {
+			m := NewIgnoreMatcher(rootDir, tc.patterns...)
+			assert.Equal(t, tc.ignored, m.Ignored(filepath.Join(rootDir, tc.path), tc.isDir))
+		})
+	}
+}
+
+func TestLoadIgnoreMatcher(t *testing.T) {
+	t.Run("should ignore nothing when the ignore file does not exist", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		m, err := LoadIgnoreMatcher(tmpDir)
+		assert.Nil(t, err)
+		assert.False(t, m.Ignored(filepath.Join(tmpDir, "test.js.bak"), false), "expected file to not be ignored")
+	})
+
+	t.Run("should load the patterns from the ignore file", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		assert.Nil(t, ioutil.WriteFile(
+			filepath.Join(tmpDir, FileRealmIgnore.String()),
+			[]byte("# editor backups\n*.bak\r\nscripts/\n"),
+			0666,
+		))
+
+		m, err := LoadIgnoreMatcher(tmpDir)
+		assert.Nil(t, err)
+		assert.True(t, m.Ignored(filepath.Join(tmpDir, "test.js.bak"), false), "expected backup file to be ignored")
+		assert.True(t, m.Ignored(filepath.Join(tmpDir, "scripts", "seed.js"), false), "expected script to be ignored")
+		assert.False(t, m.Ignored(filepath.Join(tmpDir, "test.js"), false), "expected file to not be ignored")
+	})
+}
+
+func TestHostingIgnoredFiles(t *testing.T) {
+	tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer cleanupTmpDir()
+
+	assert.Nil(t, os.MkdirAll(filepath.Join(tmpDir, NameFiles, "drafts"), os.ModePerm))
+	for _, name := range []string{"index.html", "index.html.bak", "drafts/about.html"} {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, NameFiles, name), []byte(name), 0666))
+	}
+	assert.Nil(t, ioutil.WriteFile(
+		filepath.Join(tmpDir, FileRealmIgnore.String()),
+		[]byte("*.bak\n/files/drafts/\n"),
+		0666,
+	))
+
+	t.Run("should skip hosting files matched by the ignore file", func(t *testing.T) {
+		assetCache, err := loadHostingAssetCache(filepath.Join(tmpDir, "cache.json"))
+		assert.Nil(t, err)
+
+		assets, err := walkFiles(tmpDir, filepath.Join(tmpDir, NameFiles), "appID", map[string]hostingAsset{}, assetCache)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(assets))
+		assert.Equal(t, "/index.html", assets[0].FilePath)
+	})
+}
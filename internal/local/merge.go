@@ -0,0 +1,178 @@
+package local
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// AppFileConflict is an app file changed differently by both the local
+// and remote apps since the app snapshot, where a nil version is deleted
+type AppFileConflict struct {
+	Path   string
+	Local  []byte
+	Remote []byte
+}
+
+// Diff returns the line differences between the local and remote versions of the file
+func (c AppFileConflict) Diff() []string {
+	localLines := splitLines(c.Local)
+	remoteLines := splitLines(c.Remote)
+
+	// lcs[i][j] is the length of the longest common subsequence of localLines[i:] and remoteLines[j:]
+	lcs := make([][]int, len(localLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(remoteLines)+1)
+	}
+	for i := len(localLines) - 1; i >= 0; i-- {
+		for j := len(remoteLines) - 1; j >= 0; j-- {
+			if localLines[i] == remoteLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	diff := []string{"--- local/" + c.Path, "+++ remote/" + c.Path}
+
+	var i, j int
+	for i < len(localLines) && j < len(remoteLines) {
+		switch {
+		case localLines[i] == remoteLines[j]:
+			diff = append(diff, "  "+localLines[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, "- "+localLines[i])
+			i++
+		default:
+			diff = append(diff, "+ "+remoteLines[j])
+			j++
+		}
+	}
+	for ; i < len(localLines); i++ {
+		diff = append(diff, "- "+localLines[i])
+	}
+	for ; j < len(remoteLines); j++ {
+		diff = append(diff, "+ "+remoteLines[j])
+	}
+	return diff
+}
+
+func splitLines(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
+
+// AppMerge is the three-way merge of the local and remote versions of an app
+// with the app snapshot which both were changed from
+type AppMerge struct {
+	// RemoteChanges are the files changed only by the remote app, which are taken from it
+	RemoteChanges []string
+	// Conflicts are the files changed differently by both apps, which must be resolved
+	Conflicts []AppFileConflict
+
+	files map[string][]byte
+}
+
+// MergeApp merges the local and remote apps with the app snapshot in the directory,
+// keeping the files changed by only one of the apps and reporting the others as conflicts
+func MergeApp(localApp, remoteApp App, snapshotDir string) (AppMerge, error) {
+	if err := checkAppSnapshot(localApp, snapshotDir); err != nil {
+		return AppMerge{}, err
+	}
+
+	snapshotFiles, err := readSnapshotFiles(snapshotDir)
+	if err != nil {
+		return AppMerge{}, err
+	}
+
+	localFiles, err := appSnapshotFiles(localApp)
+	if err != nil {
+		return AppMerge{}, err
+	}
+
+	remoteFiles, err := appSnapshotFiles(remoteApp)
+	if err != nil {
+		return AppMerge{}, err
+	}
+
+	paths := map[string]struct{}{}
+	for _, files := range []map[string][]byte{snapshotFiles, localFiles, remoteFiles} {
+		for path := range files {
+			paths[path] = struct{}{}
+		}
+	}
+
+	sortedPaths := make([]string, 0, len(paths))
+	for path := range paths {
+		sortedPaths = append(sortedPaths, path)
+	}
+	sort.Strings(sortedPaths)
+
+	merge := AppMerge{files: map[string][]byte{}}
+	for _, path := range sortedPaths {
+		snapshotData, inSnapshot := snapshotFiles[path]
+		localData, inLocal := localFiles[path]
+		remoteData, inRemote := remoteFiles[path]
+
+		localChanged := inLocal != inSnapshot || !bytes.Equal(localData, snapshotData)
+		remoteChanged := inRemote != inSnapshot || !bytes.Equal(remoteData, snapshotData)
+
+		switch {
+		case !remoteChanged, inLocal == inRemote && bytes.Equal(localData, remoteData):
+			merge.set(path, localData, inLocal)
+		case !localChanged:
+			merge.set(path, remoteData, inRemote)
+			merge.RemoteChanges = append(merge.RemoteChanges, path)
+		default:
+			merge.Conflicts = append(merge.Conflicts, AppFileConflict{path, fileVersion(localData, inLocal), fileVersion(remoteData, inRemote)})
+		}
+	}
+	return merge, nil
+}
+
+func fileVersion(data []byte, ok bool) []byte {
+	if !ok {
+		return nil
+	}
+	if data == nil {
+		return []byte{}
+	}
+	return data
+}
+
+func (m *AppMerge) set(path string, data []byte, ok bool) {
+	if !ok {
+		delete(m.files, path)
+		return
+	}
+	m.files[path] = fileVersion(data, ok)
+}
+
+// Resolve resolves the conflict with either its remote or its local version of the file
+func (m *AppMerge) Resolve(conflict AppFileConflict, takeRemote bool) {
+	if takeRemote {
+		m.set(conflict.Path, conflict.Remote, conflict.Remote != nil)
+		return
+	}
+	m.set(conflict.Path, conflict.Local, conflict.Local != nil)
+}
+
+// Write writes the merged app files to the directory, which can then be loaded as the merged app
+func (m AppMerge) Write(dir string) error {
+	for path, data := range m.files {
+		if err := WriteFile(filepath.Join(dir, filepath.FromSlash(path)), 0666, bytes.NewReader(data)); err != nil {
+			return fmt.Errorf("failed to write merged app file %s: %w", path, err)
+		}
+	}
+	return os.MkdirAll(dir, os.ModePerm)
+}
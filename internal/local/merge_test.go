@@ -0,0 +1,101 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestMergeApp(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	writeApp := func(name string, modify func(dir string)) App {
+		dir := filepath.Join(tmpDir, name)
+		assert.Nil(t, NewApp(dir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.DefaultAppConfigVersion).Write())
+		modify(dir)
+
+		app, err := LoadApp(dir)
+		assert.Nil(t, err)
+		return app
+	}
+
+	customUserDataPath := filepath.Join(NameAuth, FileCustomUserData.String())
+
+	snapshot := writeApp("snapshot", func(dir string) {})
+	snapshotDir := filepath.Join(tmpDir, "cache")
+	assert.Nil(t, WriteAppSnapshot(snapshotDir, snapshot))
+
+	localApp := writeApp("local", func(dir string) {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, customUserDataPath), []byte(`{"enabled":true,"mongo_service_name":"local"}`), 0666))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, NameValues, "local.json"), []byte(`{"name":"local","value":"local"}`), 0666))
+	})
+
+	remoteApp := writeApp("remote", func(dir string) {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, customUserDataPath), []byte(`{"enabled":true,"mongo_service_name":"remote"}`), 0666))
+		assert.Nil(t, os.Remove(filepath.Join(dir, NameEnvironments, "qa.json")))
+	})
+
+	t.Run("should return an error without an app snapshot", func(t *testing.T) {
+		_, err := MergeApp(localApp, remoteApp, filepath.Join(tmpDir, "missing"))
+		assert.Equal(t, "no cached export found for app eggcorn-abcde, pull or push the app to cache its export", err.Error())
+	})
+
+	merge, err := MergeApp(localApp, remoteApp, snapshotDir)
+	assert.Nil(t, err)
+
+	t.Run("should take the files changed only by the remote app", func(t *testing.T) {
+		assert.Equal(t, []string{"environments/qa.json"}, merge.RemoteChanges)
+	})
+
+	t.Run("should report the files changed differently by both apps as conflicts", func(t *testing.T) {
+		assert.Equal(t, 1, len(merge.Conflicts))
+		assert.Equal(t, "auth/custom_user_data.json", merge.Conflicts[0].Path)
+	})
+
+	t.Run("should write the merged app with the resolved conflicts", func(t *testing.T) {
+		merge.Resolve(merge.Conflicts[0], true)
+
+		mergedDir := filepath.Join(tmpDir, "merged")
+		assert.Nil(t, merge.Write(mergedDir))
+
+		mergedApp, err := LoadApp(mergedDir)
+		assert.Nil(t, err)
+
+		diffs, err := DiffAppSnapshot(mergedApp, snapshotDir)
+		assert.Nil(t, err)
+		assert.Equal(t, AppFileDiffs{
+			Added:    []string{"values/local.json"},
+			Deleted:  []string{"environments/qa.json"},
+			Modified: []string{"auth/custom_user_data.json"},
+		}, diffs)
+
+		customUserData, err := ioutil.ReadFile(filepath.Join(mergedDir, customUserDataPath))
+		assert.Nil(t, err)
+		assert.Equal(t, string(merge.Conflicts[0].Remote), string(customUserData))
+	})
+}
+
+func TestAppFileConflictDiff(t *testing.T) {
+	conflict := AppFileConflict{
+		Path:   "auth/custom_user_data.json",
+		Local:  []byte("{\n    \"enabled\": true,\n    \"mongo_service_name\": \"local\"\n}\n"),
+		Remote: []byte("{\n    \"enabled\": true,\n    \"mongo_service_name\": \"remote\"\n}\n"),
+	}
+
+	assert.Equal(t, []string{
+		"--- local/auth/custom_user_data.json",
+		"+++ remote/auth/custom_user_data.json",
+		"  {",
+		`      "enabled": true,`,
+		`-     "mongo_service_name": "local"`,
+		`+     "mongo_service_name": "remote"`,
+		"  }",
+	}, conflict.Diff())
+}
@@ -0,0 +1,90 @@
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"path/filepath"
+	"strings"
+)
+
+// Owners maps app files to the teams which own them, following the rules of a
+// .realmowners file found at the root of a local Realm app. Each rule is a
+// gitignore-style pattern followed by its owners, and as with a CODEOWNERS file
+// the last rule matching a file determines its owners. The zero value owns nothing.
+type Owners struct {
+	rules []ownersRule
+}
+
+type ownersRule struct {
+	pattern ignorePattern
+	owners  []string
+}
+
+// LoadOwners loads the .realmowners file found at the provided app root directory
+func LoadOwners(rootDir string) (Owners, error) {
+	data, err := readFile(filepath.Join(rootDir, FileRealmOwners.String()))
+	if err != nil {
+		return Owners{}, err
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return Owners{}, err
+	}
+
+	return NewOwners(lines...), nil
+}
+
+// NewOwners returns the owners for the provided rules, each a pattern followed by its owners
+func NewOwners(rules ...string) Owners {
+	var o Owners
+	for _, line := range rules {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		p, ok := parseIgnorePattern(fields[0])
+		if !ok || p.negate {
+			continue
+		}
+
+		var owners []string
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			owners = append(owners, owner)
+		}
+
+		o.rules = append(o.rules, ownersRule{p, owners})
+	}
+	return o
+}
+
+// Len returns the number of owners rules
+func (o Owners) Len() int {
+	return len(o.rules)
+}
+
+// Find returns the owners of the file at the provided path, relative to the app root directory.
+// A file matched by a rule without owners, or by no rule at all, has no owners.
+func (o Owners) Find(filePath string) []string {
+	segments := strings.Split(filepath.ToSlash(filepath.Clean(filePath)), "/")
+
+	var owners []string
+	for _, rule := range o.rules {
+		if rule.matches(segments) {
+			owners = rule.owners
+		}
+	}
+	return owners
+}
+
+func (r ownersRule) matches(segments []string) bool {
+	return r.pattern.matchFile(segments)
+}
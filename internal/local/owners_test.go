@@ -0,0 +1,91 @@
+package local
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestOwnersFind(t *testing.T) {
+	owners := NewOwners(
+		"# default owners",
+		"*                @platform",
+		"functions/       @backend",
+		"/functions/billing/** @payments @backend  # shared with backend",
+		"*.graphql        @frontend",
+		"auth/providers.json",
+	)
+
+	for _, tc := range []struct {
+		description string
+		path        string
+		owners      []string
+	}{
+		{
+			description: "find the owners of the rule matching any file",
+			path:        "triggers/onInsert.json",
+			owners:      []string{"@platform"},
+		},
+		{
+			description: "find the owners of a directory rule for the files within it",
+			path:        "functions/sendEmail.js",
+			owners:      []string{"@backend"},
+		},
+		{
+			description: "find the owners of the last matching rule",
+			path:        "functions/billing/charge.js",
+			owners:      []string{"@payments", "@backend"},
+		},
+		{
+			description: "find the owners of a rule matching the file name",
+			path:        "graphql/custom_resolvers/query.graphql",
+			owners:      []string{"@frontend"},
+		},
+		{
+			description: "find no owners for a file matched by a rule without owners",
+			path:        "auth/providers.json",
+		},
+	} {
+		t.Run("should "+tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.owners, owners.Find(tc.path))
+		})
+	}
+
+	t.Run("should find no owners without rules", func(t *testing.T) {
+		assert.Equal(t, 0, Owners{}.Len())
+		assert.Nil(t, Owners{}.Find("functions/sendEmail.js"))
+	})
+}
+
+func TestLoadOwners(t *testing.T) {
+	t.Run("should have no rules when the owners file does not exist", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		owners, err := LoadOwners(tmpDir)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, owners.Len())
+	})
+
+	t.Run("should load the rules from the owners file", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		assert.Nil(t, ioutil.WriteFile(
+			filepath.Join(tmpDir, FileRealmOwners.String()),
+			[]byte("# billing\r\nfunctions/billing/ @payments\n\ntriggers/ @platform\n"),
+			0666,
+		))
+
+		owners, err := LoadOwners(tmpDir)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, owners.Len())
+		assert.Equal(t, []string{"@payments"}, owners.Find("functions/billing/charge.js"))
+		assert.Nil(t, owners.Find("functions/sendEmail.js"))
+	})
+}
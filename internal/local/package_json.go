@@ -0,0 +1,89 @@
+package local
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// FilePackageJSON is the npm manifest of a local Realm app's functions dependencies
+var FilePackageJSON = File{"package", extJSON}
+
+// packageJSONDependencies matches the start of a package.json's dependencies
+var packageJSONDependencies = regexp.MustCompile(`"dependencies"\s*:\s*\{`)
+
+// PackageJSON is the package.json of a local Realm app's functions
+type PackageJSON struct {
+	Path         string
+	Dependencies map[string]string
+
+	data []byte
+}
+
+// LoadPackageJSON loads the package.json of the local Realm app's functions
+// and returns whether one exists
+func LoadPackageJSON(rootDir string) (PackageJSON, bool, error) {
+	path := filepath.Join(rootDir, NameFunctions, FilePackageJSON.String())
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return PackageJSON{}, false, nil
+		}
+		return PackageJSON{}, false, err
+	}
+
+	var manifest struct {
+		Dependencies map[string]string `json:"dependencies"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return PackageJSON{}, false, fmt.Errorf("failed to parse %s: %s", path, err)
+	}
+
+	return PackageJSON{path, manifest.Dependencies, data}, true, nil
+}
+
+// SetDependency sets the version range of the dependency, which rewrites
+// only the range itself so the rest of the manifest is left as it was written
+func (p *PackageJSON) SetDependency(name, version string) error {
+	current, ok := p.Dependencies[name]
+	if !ok {
+		return fmt.Errorf("dependency '%s' not found in %s", name, p.Path)
+	}
+
+	start := packageJSONDependencies.FindIndex(p.data)
+	if start == nil {
+		return fmt.Errorf("dependency '%s' not found in %s", name, p.Path)
+	}
+
+	quotedName, _ := json.Marshal(name)
+	quotedCurrent, _ := json.Marshal(current)
+	quotedVersion, _ := json.Marshal(version)
+
+	entry := regexp.MustCompile(regexp.QuoteMeta(string(quotedName)) + `\s*:\s*` + regexp.QuoteMeta(string(quotedCurrent)))
+
+	loc := entry.FindIndex(p.data[start[1]:])
+	if loc == nil {
+		return fmt.Errorf("dependency '%s' not found in %s", name, p.Path)
+	}
+	rangeEnd := start[1] + loc[1]
+	rangeStart := rangeEnd - len(quotedCurrent)
+
+	var buf bytes.Buffer
+	buf.Write(p.data[:rangeStart])
+	buf.Write(quotedVersion)
+	buf.Write(p.data[rangeEnd:])
+
+	p.data = buf.Bytes()
+	p.Dependencies[name] = version
+	return nil
+}
+
+// Write writes the package.json to its path
+func (p PackageJSON) Write() error {
+	return WriteFile(p.Path, 0666, bytes.NewReader(p.data))
+}
@@ -0,0 +1,72 @@
+package local
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestPackageJSON(t *testing.T) {
+	manifest := `{
+  "name": "functions",
+  "devDependencies": {
+    "lodash": "^4.17.15"
+  },
+  "dependencies": {
+    "moment": "~2.29.1",
+    "lodash":   "^4.17.15"
+  }
+}
+`
+
+	t.Run("should report no package.json when the functions have none", func(t *testing.T) {
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		_, ok, err := LoadPackageJSON(dir)
+		assert.Nil(t, err)
+		assert.False(t, ok, "expected no package.json to be found")
+	})
+
+	t.Run("should load the dependencies and rewrite only the ranges which are set", func(t *testing.T) {
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		path := filepath.Join(dir, NameFunctions, FilePackageJSON.String())
+		assert.Nil(t, WriteFile(path, 0666, strings.NewReader(manifest)))
+
+		packageJSON, ok, err := LoadPackageJSON(dir)
+		assert.Nil(t, err)
+		assert.True(t, ok, "expected the package.json to be found")
+		assert.Equal(t, path, packageJSON.Path)
+		assert.Equal(t, map[string]string{"moment": "~2.29.1", "lodash": "^4.17.15"}, packageJSON.Dependencies)
+
+		assert.Nil(t, packageJSON.SetDependency("lodash", "^4.17.21"))
+		assert.Equal(t, errors.New("dependency 'axios' not found in "+path), packageJSON.SetDependency("axios", "^1.0.0"))
+		assert.Nil(t, packageJSON.Write())
+
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		assert.Equal(t, strings.Replace(manifest, `"lodash":   "^4.17.15"`, `"lodash":   "^4.17.21"`, 1), string(data))
+		assert.Equal(t, "^4.17.21", packageJSON.Dependencies["lodash"])
+	})
+
+	t.Run("should return an error when the package.json is invalid", func(t *testing.T) {
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		path := filepath.Join(dir, NameFunctions, FilePackageJSON.String())
+		assert.Nil(t, WriteFile(path, 0666, strings.NewReader(`{"dependencies": []}`)))
+
+		_, _, err = LoadPackageJSON(dir)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,20 @@
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// walkExtendedLength walks the file tree rooted at root the same as filepath.Walk, but through
+// the root's extended-length path so files nested deeper than the platform's path length limit
+// are still visited, while fn still receives paths rooted at root
+func walkExtendedLength(root string, fn filepath.WalkFunc) error {
+	extendedRoot := extendedLengthPath(root)
+	if extendedRoot == root {
+		return filepath.Walk(root, fn)
+	}
+	return filepath.Walk(extendedRoot, func(path string, info os.FileInfo, err error) error {
+		return fn(root+strings.TrimPrefix(path, extendedRoot), info, err)
+	})
+}
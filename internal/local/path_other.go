@@ -0,0 +1,9 @@
+//go:build !windows
+// +build !windows
+
+package local
+
+// extendedLengthPath returns the path unchanged, since only Windows limits its length
+func extendedLengthPath(path string) string {
+	return path
+}
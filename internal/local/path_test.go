@@ -0,0 +1,51 @@
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestWalkExtendedLength(t *testing.T) {
+	t.Run("should visit the files nested beyond the windows path length limit with paths rooted at the root", func(t *testing.T) {
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		nested := filepath.Join(dir, "node_modules")
+		for i := 0; i < 10; i++ {
+			nested = filepath.Join(nested, strings.Repeat("a", 30))
+		}
+		assert.True(t, len(nested) > 260, "expected the nested path to exceed the windows path length limit")
+
+		assert.Nil(t, WriteFile(filepath.Join(nested, "index.js"), 0666, strings.NewReader("module.exports = {};")))
+		assert.Nil(t, WriteFile(filepath.Join(dir, "node_modules", "package.json"), 0666, strings.NewReader("{}")))
+
+		var files []string
+		assert.Nil(t, walkExtendedLength(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				files = append(files, path)
+			}
+			return nil
+		}))
+
+		assert.Equal(t, []string{
+			filepath.Join(nested, "index.js"),
+			filepath.Join(dir, "node_modules", "package.json"),
+		}, files)
+	})
+
+	t.Run("should return the error of a root which does not exist", func(t *testing.T) {
+		err := walkExtendedLength("testdata/missing", func(path string, info os.FileInfo, err error) error {
+			return err
+		})
+		assert.True(t, os.IsNotExist(err), "expected a not exist error but got: %s", err)
+	})
+}
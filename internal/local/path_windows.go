@@ -0,0 +1,33 @@
+//go:build windows
+// +build windows
+
+package local
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+const (
+	extendedLengthPrefix    = `\\?\`
+	extendedLengthUNCPrefix = extendedLengthPrefix + `UNC\`
+)
+
+// extendedLengthPath returns the path with the extended-length prefix, which lifts the
+// 260 character limit the Windows API places on paths, such as those of the files
+// nested deep within a node_modules directory
+func extendedLengthPath(path string) string {
+	if strings.HasPrefix(path, extendedLengthPrefix) {
+		return path
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+
+	if strings.HasPrefix(abs, `\\`) {
+		return extendedLengthUNCPrefix + abs[2:]
+	}
+	return extendedLengthPrefix + abs
+}
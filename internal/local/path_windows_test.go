@@ -0,0 +1,47 @@
+//go:build windows
+// +build windows
+
+package local
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestExtendedLengthPath(t *testing.T) {
+	wd, err := filepath.Abs(".")
+	assert.Nil(t, err)
+
+	for _, tc := range []struct {
+		description string
+		path        string
+		expected    string
+	}{
+		{
+			description: "should prefix an absolute path",
+			path:        `C:\app\hosting\files`,
+			expected:    `\\?\C:\app\hosting\files`,
+		},
+		{
+			description: "should prefix a relative path once made absolute",
+			path:        `testdata\hosting`,
+			expected:    `\\?\` + filepath.Join(wd, `testdata\hosting`),
+		},
+		{
+			description: "should prefix a unc path",
+			path:        `\\server\share\app`,
+			expected:    `\\?\UNC\server\share\app`,
+		},
+		{
+			description: "should not prefix an extended-length path again",
+			path:        `\\?\C:\app`,
+			expected:    `\\?\C:\app`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, extendedLengthPath(tc.path))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	// NameShared is the directory of javascript modules shared by the app's functions
+	NameShared = "shared"
+)
+
+// sharedRequireRegexp matches the requires of shared modules, capturing the module path
+var sharedRequireRegexp = regexp.MustCompile(`require\(\s*["']` + NameShared + `/([^"']+)["']\s*\)`)
+
+type functionSourcesResolver interface {
+	resolveFunctionSources(resolve func(name, source string) (string, error)) error
+}
+
+// InlineSharedSources replaces each require of a module from the app's shared directory,
+// e.g. require("shared/utils"), in the app's function sources in place with the module's source.
+// Each inlined module is evaluated where it is required, with its own module and exports objects
+func InlineSharedSources(app App) error {
+	resolver, ok := app.AppData.(functionSourcesResolver)
+	if !ok {
+		return nil
+	}
+
+	dir := filepath.Join(app.RootDir, NameShared)
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	inliner := sharedInliner{dir: dir, modules: map[string]string{}, resolving: map[string]bool{}}
+	return resolver.resolveFunctionSources(func(name, source string) (string, error) {
+		inlined, err := inliner.inline(source)
+		if err != nil {
+			return "", fmt.Errorf("failed to inline shared sources of function '%s': %w", name, err)
+		}
+		return inlined, nil
+	})
+}
+
+type sharedInliner struct {
+	dir       string
+	modules   map[string]string
+	resolving map[string]bool
+}
+
+func (si sharedInliner) inline(source string) (string, error) {
+	var err error
+	inlined := sharedRequireRegexp.ReplaceAllStringFunc(source, func(require string) string {
+		if err != nil {
+			return require
+		}
+		var module string
+		module, err = si.module(sharedRequireRegexp.FindStringSubmatch(require)[1])
+		return module
+	})
+	if err != nil {
+		return "", err
+	}
+	return inlined, nil
+}
+
+func (si sharedInliner) module(name string) (string, error) {
+	name = path.Clean(name)
+	if path.Ext(name) == "" {
+		name += extJS
+	}
+	if strings.HasPrefix(name, "..") {
+		return "", fmt.Errorf("'%s/%s' is outside of the shared directory", NameShared, name)
+	}
+
+	if module, ok := si.modules[name]; ok {
+		return module, nil
+	}
+	if si.resolving[name] {
+		return "", fmt.Errorf("'%s/%s' is part of a circular require", NameShared, name)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(si.dir, filepath.FromSlash(name)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("'%s/%s' does not exist", NameShared, name)
+		}
+		return "", err
+	}
+
+	si.resolving[name] = true
+	defer delete(si.resolving, name)
+
+	source, err := si.inline(string(data))
+	if err != nil {
+		return "", err
+	}
+
+	module := fmt.Sprintf(`(function() {
+var module = { exports: {} };
+var exports = module.exports;
+%s
+return module.exports;
+})()`, source)
+
+	si.modules[name] = module
+	return module, nil
+}
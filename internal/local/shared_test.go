@@ -0,0 +1,137 @@
+package local
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestInlineSharedSources(t *testing.T) {
+	setup := func(t *testing.T, shared map[string]string) (string, func()) {
+		t.Helper()
+
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+
+		for name, source := range shared {
+			path := filepath.Join(dir, NameShared, filepath.FromSlash(name))
+			assert.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+			assert.Nil(t, ioutil.WriteFile(path, []byte(source), 0666))
+		}
+		return dir, teardown
+	}
+
+	t.Run("should inline shared modules in v2 function sources", func(t *testing.T) {
+		dir, teardown := setup(t, map[string]string{
+			"utils.js":     `const math = require("shared/lib/math"); exports.double = math.double;`,
+			"lib/math.js":  `module.exports = { double: (n) => n * 2 };`,
+			"unrelated.js": `module.exports = {};`,
+		})
+		defer teardown()
+
+		appData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+			Functions: FunctionsStructure{Sources: map[string]string{
+				"double.js": `const utils = require('shared/utils.js');
+exports = (n) => utils.double(n);`,
+				"plain.js": `exports = () => require("lodash");`,
+			}},
+		}}}
+
+		assert.Nil(t, InlineSharedSources(App{RootDir: dir, AppData: appData}))
+		assert.Equal(t, map[string]string{
+			"double.js": `const utils = (function() {
+var module = { exports: {} };
+var exports = module.exports;
+const math = (function() {
+var module = { exports: {} };
+var exports = module.exports;
+module.exports = { double: (n) => n * 2 };
+return module.exports;
+})(); exports.double = math.double;
+return module.exports;
+})();
+exports = (n) => utils.double(n);`,
+			"plain.js": `exports = () => require("lodash");`,
+		}, appData.Functions.Sources)
+	})
+
+	t.Run("should inline shared modules in v1 function sources", func(t *testing.T) {
+		dir, teardown := setup(t, map[string]string{
+			"utils.js": `exports.one = 1;`,
+		})
+		defer teardown()
+
+		fn := map[string]interface{}{
+			NameConfig: map[string]interface{}{"name": "fn"},
+			NameSource: `exports = () => require("shared/utils").one;`,
+		}
+		appData := &AppConfigJSON{AppDataV1{AppStructureV1{Functions: []map[string]interface{}{fn}}}}
+
+		assert.Nil(t, InlineSharedSources(App{RootDir: dir, AppData: appData}))
+		assert.Equal(t, `exports = () => (function() {
+var module = { exports: {} };
+var exports = module.exports;
+exports.one = 1;
+return module.exports;
+})().one;`, fn[NameSource])
+	})
+
+	t.Run("should leave sources unchanged without a shared directory", func(t *testing.T) {
+		dir, teardown := setup(t, nil)
+		defer teardown()
+
+		appData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+			Functions: FunctionsStructure{Sources: map[string]string{"fn.js": `require("shared/utils")`}},
+		}}}
+
+		assert.Nil(t, InlineSharedSources(App{RootDir: dir, AppData: appData}))
+		assert.Equal(t, `require("shared/utils")`, appData.Functions.Sources["fn.js"])
+	})
+
+	for _, tc := range []struct {
+		description string
+		shared      map[string]string
+		source      string
+		expectedErr error
+	}{
+		{
+			description: "should return an error when a shared module does not exist",
+			shared:      map[string]string{"utils.js": ""},
+			source:      `require("shared/missing")`,
+			expectedErr: errors.New("failed to inline shared sources of function 'fn': 'shared/missing.js' does not exist"),
+		},
+		{
+			description: "should return an error when a shared module is outside of the shared directory",
+			shared:      map[string]string{"utils.js": ""},
+			source:      `require("shared/../secrets")`,
+			expectedErr: errors.New("failed to inline shared sources of function 'fn': 'shared/../secrets.js' is outside of the shared directory"),
+		},
+		{
+			description: "should return an error when shared modules require each other",
+			shared: map[string]string{
+				"a.js": `require("shared/b")`,
+				"b.js": `require("shared/a")`,
+			},
+			source:      `require("shared/a")`,
+			expectedErr: errors.New("failed to inline shared sources of function 'fn': 'shared/a.js' is part of a circular require"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			dir, teardown := setup(t, tc.shared)
+			defer teardown()
+
+			appData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+				Functions: FunctionsStructure{Sources: map[string]string{"fn.js": tc.source}},
+			}}}
+
+			err := InlineSharedSources(App{RootDir: dir, AppData: appData})
+			assert.NotNil(t, err)
+			assert.Equal(t, tc.expectedErr.Error(), err.Error())
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package local
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// AppFileDiffs are the file differences between a local app and an app snapshot
+type AppFileDiffs struct {
+	Added    []string
+	Deleted  []string
+	Modified []string
+}
+
+// Size returns the number of app file diffs
+func (d AppFileDiffs) Size() int {
+	return len(d.Added) + len(d.Deleted) + len(d.Modified)
+}
+
+// Strings returns the app file diffs' formatted output
+func (d AppFileDiffs) Strings() []string {
+	diffs := make([]string, 0, d.Size()+3)
+
+	if len(d.Added) > 0 {
+		diffs = append(diffs, "New app files")
+		for _, added := range d.Added {
+			diffs = append(diffs, terminal.Indent+"+ "+added)
+		}
+	}
+
+	if len(d.Deleted) > 0 {
+		diffs = append(diffs, "Removed app files")
+		for _, deleted := range d.Deleted {
+			diffs = append(diffs, terminal.Indent+"- "+deleted)
+		}
+	}
+
+	if len(d.Modified) > 0 {
+		diffs = append(diffs, "Modified app files")
+		for _, modified := range d.Modified {
+			diffs = append(diffs, terminal.Indent+"* "+modified)
+		}
+	}
+
+	return diffs
+}
+
+// WriteAppSnapshot writes the app to the snapshot directory, replacing any previous snapshot
+func WriteAppSnapshot(dir string, app App) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	return App{RootDir: dir, Config: app.Config, AppData: app.AppData}.Write()
+}
+
+// DiffAppSnapshot returns the file differences between the app and the snapshot directory,
+// writing the app as a snapshot first so that formatting differences are not reported
+func DiffAppSnapshot(app App, dir string) (AppFileDiffs, error) {
+	if err := checkAppSnapshot(app, dir); err != nil {
+		return AppFileDiffs{}, err
+	}
+
+	localFiles, err := appSnapshotFiles(app)
+	if err != nil {
+		return AppFileDiffs{}, err
+	}
+
+	snapshotFiles, err := readSnapshotFiles(dir)
+	if err != nil {
+		return AppFileDiffs{}, err
+	}
+
+	return diffAppFiles(localFiles, snapshotFiles), nil
+}
+
+func diffAppFiles(files, baseFiles map[string][]byte) AppFileDiffs {
+	var diffs AppFileDiffs
+	for path, data := range files {
+		baseData, ok := baseFiles[path]
+		if !ok {
+			diffs.Added = append(diffs.Added, path)
+		} else if !bytes.Equal(data, baseData) {
+			diffs.Modified = append(diffs.Modified, path)
+		}
+	}
+	for path := range baseFiles {
+		if _, ok := files[path]; !ok {
+			diffs.Deleted = append(diffs.Deleted, path)
+		}
+	}
+
+	sort.Strings(diffs.Added)
+	sort.Strings(diffs.Deleted)
+	sort.Strings(diffs.Modified)
+
+	return diffs
+}
+
+// DiffApps returns the file differences between the app and the base app it changes
+func DiffApps(app, base App) (AppFileDiffs, error) {
+	files, err := appSnapshotFiles(app)
+	if err != nil {
+		return AppFileDiffs{}, err
+	}
+
+	baseFiles, err := appSnapshotFiles(base)
+	if err != nil {
+		return AppFileDiffs{}, err
+	}
+
+	return diffAppFiles(files, baseFiles), nil
+}
+
+func checkAppSnapshot(app App, dir string) error {
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("no cached export found for app %s, pull or push the app to cache its export", app.Option())
+		}
+		return err
+	}
+	return nil
+}
+
+// appSnapshotFiles returns the files of the app written as a snapshot
+func appSnapshotFiles(app App) (map[string][]byte, error) {
+	tmpDir, err := ioutil.TempDir("", "realm-cli-snapshot-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir) //nolint:errcheck
+
+	if err := WriteAppSnapshot(tmpDir, app); err != nil {
+		return nil, err
+	}
+	return readSnapshotFiles(tmpDir)
+}
+
+func readSnapshotFiles(dir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = data
+		return nil
+	})
+	return files, err
+}
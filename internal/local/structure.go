@@ -23,6 +23,65 @@ type SecretsStructure struct {
 	Services      map[string]map[string]string `json:"services,omitempty"`
 }
 
+type secretser interface {
+	secrets() SecretsStructure
+}
+
+// ResolveSecrets replaces each of the app's secret values in place
+// with the value returned by the provided resolve function
+func ResolveSecrets(appData AppData, resolve func(value string) (string, error)) error {
+	s, ok := appData.(secretser)
+	if !ok {
+		return nil
+	}
+	return s.secrets().Resolve(resolve)
+}
+
+// AppSecrets returns a copy of the app's secret values, as they are before being resolved
+func AppSecrets(appData AppData) SecretsStructure {
+	s, ok := appData.(secretser)
+	if !ok {
+		return SecretsStructure{}
+	}
+	return s.secrets().Copy()
+}
+
+// Resolve replaces each of the secret values in place
+// with the value returned by the provided resolve function
+func (s SecretsStructure) Resolve(resolve func(value string) (string, error)) error {
+	for _, group := range []map[string]map[string]string{s.AuthProviders, s.Services} {
+		for _, values := range group {
+			for name, value := range values {
+				resolved, err := resolve(value)
+				if err != nil {
+					return err
+				}
+				values[name] = resolved
+			}
+		}
+	}
+	return nil
+}
+
+// Copy returns a copy of the secret values
+func (s SecretsStructure) Copy() SecretsStructure {
+	copyGroup := func(group map[string]map[string]string) map[string]map[string]string {
+		if group == nil {
+			return nil
+		}
+		copied := make(map[string]map[string]string, len(group))
+		for name, values := range group {
+			copiedValues := make(map[string]string, len(values))
+			for key, value := range values {
+				copiedValues[key] = value
+			}
+			copied[name] = copiedValues
+		}
+		return copied
+	}
+	return SecretsStructure{copyGroup(s.AuthProviders), copyGroup(s.Services)}
+}
+
 // ServiceStructure represents the Realm app service structure
 type ServiceStructure struct {
 	Config           map[string]interface{}   `json:"config,omitempty"`
@@ -30,11 +89,12 @@ type ServiceStructure struct {
 	Rules            []map[string]interface{} `json:"rules"`
 }
 
-func parseEnvironments(rootDir string) (map[string]map[string]interface{}, error) {
+func parseEnvironments(rootDir string, ignore IgnoreMatcher) (map[string]map[string]interface{}, error) {
 	out := map[string]map[string]interface{}{}
 
 	dw := directoryWalker{
 		path:      filepath.Join(rootDir, NameEnvironments),
+		ignore:    ignore,
 		onlyFiles: true,
 	}
 	if err := dw.walk(func(file os.FileInfo, path string) error {
@@ -54,7 +114,7 @@ func parseEnvironments(rootDir string) (map[string]map[string]interface{}, error
 	return out, nil
 }
 
-func parseFunctions(rootDir string) ([]map[string]interface{}, error) {
+func parseFunctions(rootDir string, ignore IgnoreMatcher) ([]map[string]interface{}, error) {
 	if _, err := os.Stat(rootDir); err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -64,7 +124,7 @@ func parseFunctions(rootDir string) ([]map[string]interface{}, error) {
 
 	var out []map[string]interface{}
 
-	dw := directoryWalker{path: rootDir, onlyDirs: true}
+	dw := directoryWalker{path: rootDir, ignore: ignore, onlyDirs: true}
 	if walkErr := dw.walk(func(file os.FileInfo, path string) error {
 		if strings.Contains(path, nameNodeModules) {
 			return nil // skip node_modules
@@ -92,7 +152,7 @@ func parseFunctions(rootDir string) ([]map[string]interface{}, error) {
 	return out, nil
 }
 
-func parseGraphQL(rootDir string) (GraphQLStructure, bool, error) {
+func parseGraphQL(rootDir string, ignore IgnoreMatcher) (GraphQLStructure, bool, error) {
 	dir := filepath.Join(rootDir, NameGraphQL)
 
 	if _, err := os.Stat(dir); err != nil {
@@ -107,7 +167,7 @@ func parseGraphQL(rootDir string) (GraphQLStructure, bool, error) {
 		return GraphQLStructure{}, false, configErr
 	}
 
-	customResolvers, customResolversErr := parseJSONFiles(filepath.Join(dir, NameCustomResolvers))
+	customResolvers, customResolversErr := parseJSONFiles(filepath.Join(dir, NameCustomResolvers), ignore)
 	if customResolversErr != nil {
 		return GraphQLStructure{}, false, customResolversErr
 	}
@@ -149,7 +209,7 @@ func parseJSONArray(path string) ([]map[string]interface{}, error) {
 	return out, nil
 }
 
-func parseJSONFiles(rootDir string) ([]map[string]interface{}, error) {
+func parseJSONFiles(rootDir string, ignore IgnoreMatcher) ([]map[string]interface{}, error) {
 	if _, err := os.Stat(rootDir); err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -159,7 +219,7 @@ func parseJSONFiles(rootDir string) ([]map[string]interface{}, error) {
 
 	out := make([]map[string]interface{}, 0)
 
-	dw := directoryWalker{path: rootDir, onlyFiles: true}
+	dw := directoryWalker{path: rootDir, ignore: ignore, onlyFiles: true}
 	if walkErr := dw.walk(func(file os.FileInfo, path string) error {
 		o, err := parseJSON(path)
 		if err != nil {
@@ -197,11 +257,12 @@ func parseSecrets(rootDir string) (SecretsStructure, error) {
 	return secrets, nil
 }
 
-func parseServices(rootDir string) ([]ServiceStructure, error) {
+func parseServices(rootDir string, ignore IgnoreMatcher) ([]ServiceStructure, error) {
 	var out []ServiceStructure
 
 	dw := directoryWalker{
 		path:     filepath.Join(rootDir, NameServices),
+		ignore:   ignore,
 		onlyDirs: true,
 	}
 	if walkErr := dw.walk(func(file os.FileInfo, path string) error {
@@ -213,13 +274,13 @@ func parseServices(rootDir string) ([]ServiceStructure, error) {
 		}
 		svc.Config = config
 
-		webhooks, err := parseFunctions(filepath.Join(path, NameIncomingWebhooks))
+		webhooks, err := parseFunctions(filepath.Join(path, NameIncomingWebhooks), ignore)
 		if err != nil {
 			return err
 		}
 		svc.IncomingWebhooks = webhooks
 
-		rules, err := parseJSONFiles(filepath.Join(path, NameRules))
+		rules, err := parseJSONFiles(filepath.Join(path, NameRules), ignore)
 		if err != nil {
 			return err
 		}
@@ -379,7 +440,7 @@ func writeServices(rootDir string, services []ServiceStructure) error {
 				return err
 			}
 			if err := WriteFile(
-				filepath.Join(dirSvc, NameRules, fmt.Sprintf("%s%s", rule["name"], extJSON)),
+				filepath.Join(dirSvc, NameRules, ruleName(rule)+extJSON),
 				0666,
 				bytes.NewReader(data),
 			); err != nil {
@@ -390,6 +451,15 @@ func writeServices(rootDir string, services []ServiceStructure) error {
 	return nil
 }
 
+// ruleName returns the name of the service rule, which the rules of a data source
+// do not have, so they are named after their database and collection instead
+func ruleName(rule map[string]interface{}) string {
+	if name, ok := rule["name"].(string); ok {
+		return name
+	}
+	return fmt.Sprintf("%s.%s", rule["database"], rule["collection"])
+}
+
 func writeTriggers(rootDir string, triggers []map[string]interface{}) error {
 	for _, trigger := range triggers {
 		name, ok := trigger["name"].(string)
@@ -1,6 +1,7 @@
 package local
 
 import (
+	"errors"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
@@ -47,6 +48,50 @@ func TestWriteSecrets(t *testing.T) {
 	})
 }
 
+func TestResolveSecrets(t *testing.T) {
+	resolve := func(value string) (string, error) {
+		if value == "bad-ref" {
+			return "", errors.New("something bad happened")
+		}
+		return "resolved-" + value, nil
+	}
+
+	t.Run("should resolve secret values in place", func(t *testing.T) {
+		appData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+			Secrets: SecretsStructure{
+				AuthProviders: map[string]map[string]string{
+					"provider": {"value": "provider-ref"},
+				},
+				Services: map[string]map[string]string{
+					"svc": {"value": "svc-ref"},
+				},
+			},
+		}}}
+
+		assert.Nil(t, ResolveSecrets(appData, resolve))
+		assert.Equal(t, SecretsStructure{
+			AuthProviders: map[string]map[string]string{
+				"provider": {"value": "resolved-provider-ref"},
+			},
+			Services: map[string]map[string]string{
+				"svc": {"value": "resolved-svc-ref"},
+			},
+		}, appData.Secrets)
+	})
+
+	t.Run("should return an error when a secret fails to resolve", func(t *testing.T) {
+		appData := &AppConfigJSON{AppDataV1{AppStructureV1{
+			Secrets: SecretsStructure{
+				Services: map[string]map[string]string{
+					"svc": {"value": "bad-ref"},
+				},
+			},
+		}}}
+
+		assert.Equal(t, errors.New("something bad happened"), ResolveSecrets(appData, resolve))
+	})
+}
+
 func TestWriteEnvironments(t *testing.T) {
 	tmpDir, cleanupTmpDir, err := u.NewTempDir("")
 	assert.Nil(t, err)
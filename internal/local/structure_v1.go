@@ -67,52 +67,77 @@ func (a AppDataV1) Environment() realm.Environment {
 	return a.AppStructureV1.Environment
 }
 
+func (a AppDataV1) secrets() SecretsStructure {
+	return a.AppStructureV1.Secrets
+}
+
+func (a AppDataV1) resolveFunctionSources(resolve func(name, source string) (string, error)) error {
+	for _, fn := range a.Functions {
+		source, ok := fn[NameSource].(string)
+		if !ok {
+			continue
+		}
+		config, _ := fn[NameConfig].(map[string]interface{})
+		resolved, err := resolve(stringField(config, "name"), source)
+		if err != nil {
+			return err
+		}
+		fn[NameSource] = resolved
+	}
+	return nil
+}
+
 // LoadData will load the local Realm app data
 func (a *AppDataV1) LoadData(rootDir string) error {
+	ignore, err := LoadIgnoreMatcher(rootDir)
+	if err != nil {
+		return err
+	}
+
 	secrets, err := parseSecrets(rootDir)
 	if err != nil {
 		return err
 	}
 	a.Secrets = secrets
 
-	environments, err := parseEnvironments(rootDir)
+	environments, err := parseEnvironments(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.Environments = environments
 
-	values, err := parseJSONFiles(filepath.Join(rootDir, NameValues))
+	values, err := parseJSONFiles(filepath.Join(rootDir, NameValues), ignore)
 	if err != nil {
 		return err
 	}
 	a.Values = values
 
-	authProviders, err := parseJSONFiles(filepath.Join(rootDir, NameAuthProviders))
+	authProviders, err := parseJSONFiles(filepath.Join(rootDir, NameAuthProviders), ignore)
 	if err != nil {
 		return err
 	}
 	a.AuthProviders = authProviders
 
-	functions, err := parseFunctions(filepath.Join(rootDir, NameFunctions))
+	functions, err := parseFunctions(filepath.Join(rootDir, NameFunctions), ignore)
 	if err != nil {
 		return err
 	}
 	a.Functions = functions
 
-	triggers, err := parseJSONFiles(filepath.Join(rootDir, NameTriggers))
+	triggers, err := parseJSONFiles(filepath.Join(rootDir, NameTriggers), ignore)
 	if err != nil {
 		return err
 	}
 	a.Triggers = triggers
 
-	graphql, ok, err := parseGraphQL(rootDir)
+	graphql, ok, err := parseGraphQL(rootDir, ignore)
 	if err != nil {
 		return err
 	} else if ok {
 		a.GraphQL = graphql
 	}
 
-	services, err := parseServices(rootDir)
+	services, err := parseServices(rootDir, ignore)
 	if err != nil {
 		return err
 	}
@@ -141,6 +166,7 @@ func (a AppDataV1) ConfigData() ([]byte, error) {
 		DeploymentModel:      a.DeploymentModel(),
 		Environment:          a.Environment(),
 		Security:             a.Security,
+		Hosting:              a.Hosting,
 		CustomUserDataConfig: a.CustomUserDataConfig,
 		Sync:                 a.Sync,
 	}
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 )
@@ -42,8 +43,9 @@ type AuthStructure struct {
 
 // DataSourceStructure represents the v2 Realm app data source structure
 type DataSourceStructure struct {
-	Config map[string]interface{}   `json:"config,omitempty"`
-	Rules  []map[string]interface{} `json:"rules,omitempty"`
+	Config      map[string]interface{}   `json:"config,omitempty"`
+	DefaultRule map[string]interface{}   `json:"default_rule,omitempty"`
+	Rules       []map[string]interface{} `json:"rules,omitempty"`
 }
 
 // FunctionsStructure represents the v2 Realm app functions structure
@@ -99,21 +101,41 @@ func (a AppDataV2) Environment() realm.Environment {
 	return a.AppStructureV2.Environment
 }
 
+func (a AppDataV2) secrets() SecretsStructure {
+	return a.AppStructureV2.Secrets
+}
+
+func (a AppDataV2) resolveFunctionSources(resolve func(name, source string) (string, error)) error {
+	for path, source := range a.Functions.Sources {
+		resolved, err := resolve(strings.TrimSuffix(filepath.ToSlash(path), extJS), source)
+		if err != nil {
+			return err
+		}
+		a.Functions.Sources[path] = resolved
+	}
+	return nil
+}
+
 // LoadData will load the local Realm app data
 func (a *AppDataV2) LoadData(rootDir string) error {
+	ignore, err := LoadIgnoreMatcher(rootDir)
+	if err != nil {
+		return err
+	}
+
 	secrets, err := parseSecrets(rootDir)
 	if err != nil {
 		return err
 	}
 	a.Secrets = secrets
 
-	environments, err := parseEnvironments(rootDir)
+	environments, err := parseEnvironments(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.Environments = environments
 
-	values, err := parseJSONFiles(filepath.Join(rootDir, NameValues))
+	values, err := parseJSONFiles(filepath.Join(rootDir, NameValues), ignore)
 	if err != nil {
 		return err
 	}
@@ -131,38 +153,38 @@ func (a *AppDataV2) LoadData(rootDir string) error {
 	}
 	a.Sync = sync
 
-	functions, err := parseFunctionsV2(rootDir)
+	functions, err := parseFunctionsV2(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.Functions = functions
 
-	triggers, err := parseJSONFiles(filepath.Join(rootDir, NameTriggers))
+	triggers, err := parseJSONFiles(filepath.Join(rootDir, NameTriggers), ignore)
 	if err != nil {
 		return err
 	}
 	a.Triggers = triggers
 
-	graphql, ok, err := parseGraphQL(rootDir)
+	graphql, ok, err := parseGraphQL(rootDir, ignore)
 	if err != nil {
 		return err
 	} else if ok {
 		a.GraphQL = graphql
 	}
 
-	services, err := parseServices(rootDir)
+	services, err := parseServices(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.Services = services
 
-	dataSources, err := parseDataSources(rootDir)
+	dataSources, err := parseDataSources(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.DataSources = dataSources
 
-	httpEndpoints, err := parseHTTPEndpoints(rootDir)
+	httpEndpoints, err := parseHTTPEndpoints(rootDir, ignore)
 	if err != nil {
 		return err
 	}
@@ -194,7 +216,7 @@ func parseAuth(rootDir string) (AuthStructure, error) {
 	return AuthStructure{customUserData, providers}, nil
 }
 
-func parseFunctionsV2(rootDir string) (FunctionsStructure, error) {
+func parseFunctionsV2(rootDir string, ignore IgnoreMatcher) (FunctionsStructure, error) {
 	dir := filepath.Join(rootDir, NameFunctions)
 
 	if _, err := os.Stat(dir); err != nil {
@@ -210,7 +232,7 @@ func parseFunctionsV2(rootDir string) (FunctionsStructure, error) {
 	}
 
 	sources := map[string]string{}
-	if err := walk(dir, map[string]struct{}{nameNodeModules: {}}, func(file os.FileInfo, path string) error {
+	if err := walk(dir, ignore, map[string]struct{}{nameNodeModules: {}}, func(file os.FileInfo, path string) error {
 		if filepath.Ext(path) != extJS {
 			return nil // looking for javascript files
 		}
@@ -234,11 +256,12 @@ func parseFunctionsV2(rootDir string) (FunctionsStructure, error) {
 	return FunctionsStructure{configs, sources}, nil
 }
 
-func parseDataSources(rootDir string) ([]DataSourceStructure, error) {
+func parseDataSources(rootDir string, ignore IgnoreMatcher) ([]DataSourceStructure, error) {
 	var out []DataSourceStructure
 
 	dw := directoryWalker{
 		path:     filepath.Join(rootDir, NameDataSources),
+		ignore:   ignore,
 		onlyDirs: true,
 	}
 	if err := dw.walk(func(file os.FileInfo, path string) error {
@@ -247,12 +270,17 @@ func parseDataSources(rootDir string) ([]DataSourceStructure, error) {
 			return err
 		}
 
+		defaultRule, err := parseJSON(filepath.Join(path, FileDefaultRule.String()))
+		if err != nil {
+			return err
+		}
+
 		var rules []map[string]interface{}
 
-		dbs := directoryWalker{path: path, onlyDirs: true}
+		dbs := directoryWalker{path: path, ignore: ignore, onlyDirs: true}
 		if err := dbs.walk(func(db os.FileInfo, dbPath string) error {
 
-			colls := directoryWalker{path: dbPath, onlyDirs: true}
+			colls := directoryWalker{path: dbPath, ignore: ignore, onlyDirs: true}
 			if err := colls.walk(func(coll os.FileInfo, collPath string) error {
 
 				rulePath := filepath.Join(collPath, FileRules.String())
@@ -290,7 +318,7 @@ func parseDataSources(rootDir string) ([]DataSourceStructure, error) {
 			return err
 		}
 
-		out = append(out, DataSourceStructure{config, rules})
+		out = append(out, DataSourceStructure{config, defaultRule, rules})
 		return nil
 	}); err != nil {
 		return nil, err
@@ -298,11 +326,12 @@ func parseDataSources(rootDir string) ([]DataSourceStructure, error) {
 	return out, nil
 }
 
-func parseHTTPEndpoints(rootDir string) ([]HTTPEndpointStructure, error) {
+func parseHTTPEndpoints(rootDir string, ignore IgnoreMatcher) ([]HTTPEndpointStructure, error) {
 	var out []HTTPEndpointStructure
 
 	dw := directoryWalker{
 		path:     filepath.Join(rootDir, NameHTTPEndpoints),
+		ignore:   ignore,
 		onlyDirs: true,
 	}
 	if err := dw.walk(func(file os.FileInfo, path string) error {
@@ -311,7 +340,7 @@ func parseHTTPEndpoints(rootDir string) ([]HTTPEndpointStructure, error) {
 			return err
 		}
 
-		webhooks, err := parseFunctions(filepath.Join(path, NameIncomingWebhooks))
+		webhooks, err := parseFunctions(filepath.Join(path, NameIncomingWebhooks), ignore)
 		if err != nil {
 			return err
 		}
@@ -319,7 +348,7 @@ func parseHTTPEndpoints(rootDir string) ([]HTTPEndpointStructure, error) {
 			webhooks = []map[string]interface{}{}
 		}
 
-		rules, err := parseJSONFiles(filepath.Join(path, NameRules))
+		rules, err := parseJSONFiles(filepath.Join(path, NameRules), ignore)
 		if err != nil {
 			return err
 		}
@@ -514,6 +543,11 @@ func writeDataSources(rootDir string, dataSources []DataSourceStructure) error {
 		); err != nil {
 			return err
 		}
+		if len(ds.DefaultRule) > 0 {
+			if err := WriteDefaultRule(rootDir, name, ds.DefaultRule); err != nil {
+				return err
+			}
+		}
 		for _, rule := range ds.Rules {
 			schema := rule[NameSchema]
 			if schema == nil {
@@ -579,6 +613,20 @@ func writeDataSources(rootDir string, dataSources []DataSourceStructure) error {
 	return nil
 }
 
+// WriteDefaultRule writes the default rule of the data source, which applies to
+// any of its collections without a rule of their own
+func WriteDefaultRule(rootDir, dataSource string, defaultRule map[string]interface{}) error {
+	data, err := MarshalJSON(defaultRule)
+	if err != nil {
+		return err
+	}
+	return WriteFile(
+		filepath.Join(rootDir, NameDataSources, dataSource, FileDefaultRule.String()),
+		0666,
+		bytes.NewReader(data),
+	)
+}
+
 func writeHTTPEndpoints(rootDir string, httpEndpoints []HTTPEndpointStructure) error {
 	dir := filepath.Join(rootDir, NameHTTPEndpoints)
 	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
@@ -17,7 +17,7 @@ func TestParseFunctionsV2(t *testing.T) {
 	testRoot := filepath.Join(wd, "testdata/functions")
 
 	t.Run("should return the parsed functions directory with nested javascript files", func(t *testing.T) {
-		functions, err := parseFunctionsV2(testRoot)
+		functions, err := parseFunctionsV2(testRoot, IgnoreMatcher{})
 		assert.Nil(t, err)
 		assert.Equal(t, FunctionsStructure{
 			Configs: []map[string]interface{}{{
@@ -36,6 +36,17 @@ func TestParseFunctionsV2(t *testing.T) {
 			},
 		}, functions)
 	})
+
+	t.Run("should skip the javascript files matched by the ignore matcher", func(t *testing.T) {
+		functions, err := parseFunctionsV2(testRoot, NewIgnoreMatcher(wd, "foo/"))
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"eggcorn.js": `exports = function () {
+  console.log('eggcorn');
+};
+`,
+		}, functions.Sources)
+	})
 }
 
 func TestParseDataSources(t *testing.T) {
@@ -45,7 +56,7 @@ func TestParseDataSources(t *testing.T) {
 	testRoot := filepath.Join(wd, "testdata/data_sources")
 
 	t.Run("should return the parsed data sources directory with nested rules and schema", func(t *testing.T) {
-		dataSources, err := parseDataSources(testRoot)
+		dataSources, err := parseDataSources(testRoot, IgnoreMatcher{})
 		assert.Nil(t, err)
 		assert.Equal(t, []DataSourceStructure{{
 			Config: map[string]interface{}{
@@ -53,6 +64,16 @@ func TestParseDataSources(t *testing.T) {
 				"name":   "mongodb-atlas",
 				"config": map[string]interface{}{},
 			},
+			DefaultRule: map[string]interface{}{
+				"roles": []interface{}{
+					map[string]interface{}{
+						"name":       "readAll",
+						"apply_when": map[string]interface{}{},
+						"read":       true,
+						"write":      false,
+					},
+				},
+			},
 			Rules: []map[string]interface{}{
 				{
 					"database":      "foo",
@@ -183,6 +204,11 @@ func TestWriteDataSources(t *testing.T) {
 					"wireProtocolEnabled": true,
 				},
 			},
+			DefaultRule: map[string]interface{}{
+				"filters": []interface{}{
+					map[string]interface{}{"name": "active", "query": map[string]interface{}{"active": true}},
+				},
+			},
 			Rules: []map[string]interface{}{
 				{
 					"database":   "foo",
@@ -217,6 +243,20 @@ func TestWriteDataSources(t *testing.T) {
 }
 `, string(config))
 
+		defaultRule, err := ioutil.ReadFile(filepath.Join(tmpDir, NameDataSources, "mongodb-atlas", FileDefaultRule.String()))
+		assert.Nil(t, err)
+		assert.Equal(t, `{
+    "filters": [
+        {
+            "name": "active",
+            "query": {
+                "active": true
+            }
+        }
+    ]
+}
+`, string(defaultRule))
+
 		rule, err := ioutil.ReadFile(filepath.Join(tmpDir, NameDataSources, "mongodb-atlas", "foo", "bar", FileRules.String()))
 		assert.Nil(t, err)
 		assert.Equal(t, `{
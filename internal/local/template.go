@@ -0,0 +1,343 @@
+package local
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+// set of app template bundle names
+const (
+	FileAppTemplateManifest = "template.json"
+
+	appTemplateFilesDir = "app"
+
+	appTemplatePlaceholderAppID   = "{{app_id}}"
+	appTemplatePlaceholderAppName = "{{app_name}}"
+)
+
+var (
+	dataSourceTypes = map[string]struct{}{"mongodb-atlas": {}, "datalake": {}}
+
+	servicesGetPattern = regexp.MustCompile(`(services\.get\(\s*["'])([^"']+)(["']\s*\))`)
+)
+
+// AppTemplateManifest describes the parameters of an app template
+type AppTemplateManifest struct {
+	Name          string                 `json:"name"`
+	ConfigVersion realm.AppConfigVersion `json:"config_version"`
+	DataSources   []string               `json:"data_sources,omitempty"`
+	Secrets       []string               `json:"secrets,omitempty"`
+}
+
+// AppTemplate is a reusable app bundle with its app name, data source names
+// and client app id replaced by placeholders
+type AppTemplate struct {
+	Manifest AppTemplateManifest
+	Files    map[string][]byte
+}
+
+// AppTemplateParams are the values used to render an app template
+type AppTemplateParams struct {
+	ClientAppID string
+	Name        string
+	DataSources map[string]string
+}
+
+func appTemplatePlaceholderDataSource(name string) string {
+	return "{{data_source." + name + "}}"
+}
+
+// PackageAppTemplate converts the app into an app template
+func PackageAppTemplate(app App) (AppTemplate, error) {
+	files, err := appSnapshotFiles(app)
+	if err != nil {
+		return AppTemplate{}, err
+	}
+
+	tmpl := AppTemplate{
+		Manifest: AppTemplateManifest{Name: app.Name(), ConfigVersion: app.ConfigVersion()},
+		Files:    map[string][]byte{},
+	}
+
+	configPath := app.Config.String()
+	if _, ok := files[configPath]; !ok {
+		return AppTemplate{}, fmt.Errorf("failed to find app config at %s", configPath)
+	}
+
+	dataSources := map[string]struct{}{}
+	secrets := map[string]struct{}{}
+
+	for filePath, data := range files {
+		parts := strings.Split(filePath, "/")
+		if len(parts) == 3 && (parts[0] == NameDataSources || parts[0] == NameServices) && parts[2] == FileConfig.String() {
+			var config map[string]interface{}
+			if err := json.Unmarshal(data, &config); err != nil {
+				return AppTemplate{}, fmt.Errorf("failed to parse %s: %w", filePath, err)
+			}
+			if dsType, ok := config["type"].(string); ok {
+				if _, ok := dataSourceTypes[dsType]; ok {
+					dataSources[parts[1]] = struct{}{}
+				}
+			}
+		}
+
+		if path.Ext(filePath) != extJSON {
+			continue
+		}
+
+		var config interface{}
+		if err := json.Unmarshal(data, &config); err != nil {
+			continue
+		}
+		collectSecrets(config, strings.HasPrefix(filePath, NameValues+"/"), secrets)
+	}
+
+	for filePath, data := range files {
+		parts := strings.Split(filePath, "/")
+
+		switch {
+		case filePath == configPath:
+			data, err = templateJSON(data, func(config map[string]interface{}) {
+				config["app_id"] = appTemplatePlaceholderAppID
+				config["name"] = appTemplatePlaceholderAppName
+			})
+		case len(parts) == 3 && parts[2] == FileConfig.String() && isDataSource(dataSources, parts[0], parts[1]):
+			data, err = templateJSON(data, func(config map[string]interface{}) {
+				config["name"] = appTemplatePlaceholderDataSource(parts[1])
+			})
+		case len(parts) == 2 && parts[0] == NameTriggers && path.Ext(filePath) == extJSON:
+			data, err = templateJSON(data, func(config map[string]interface{}) {
+				triggerConfig, ok := config["config"].(map[string]interface{})
+				if !ok {
+					return
+				}
+				if serviceName, ok := triggerConfig["service_name"].(string); ok {
+					if _, ok := dataSources[serviceName]; ok {
+						triggerConfig["service_name"] = appTemplatePlaceholderDataSource(serviceName)
+					}
+				}
+			})
+		case path.Ext(filePath) == extJS:
+			data = servicesGetPattern.ReplaceAllFunc(data, func(match []byte) []byte {
+				groups := servicesGetPattern.FindSubmatch(match)
+				if _, ok := dataSources[string(groups[2])]; !ok {
+					return match
+				}
+				return []byte(string(groups[1]) + appTemplatePlaceholderDataSource(string(groups[2])) + string(groups[3]))
+			})
+		}
+		if err != nil {
+			return AppTemplate{}, fmt.Errorf("failed to parse %s: %w", filePath, err)
+		}
+
+		if len(parts) > 2 && isDataSource(dataSources, parts[0], parts[1]) {
+			parts[1] = appTemplatePlaceholderDataSource(parts[1])
+		}
+		tmpl.Files[strings.Join(parts, "/")] = data
+	}
+
+	tmpl.Manifest.DataSources = sortedKeys(dataSources)
+	tmpl.Manifest.Secrets = sortedKeys(secrets)
+
+	return tmpl, nil
+}
+
+func isDataSource(dataSources map[string]struct{}, dir, name string) bool {
+	if dir != NameDataSources && dir != NameServices {
+		return false
+	}
+	_, ok := dataSources[name]
+	return ok
+}
+
+// collectSecrets finds the secret names referenced by values and secret configs
+func collectSecrets(config interface{}, isValue bool, secrets map[string]struct{}) {
+	switch c := config.(type) {
+	case map[string]interface{}:
+		if fromSecret, ok := c["from_secret"].(bool); ok && fromSecret && isValue {
+			if name, ok := c["value"].(string); ok && name != "" {
+				secrets[name] = struct{}{}
+			}
+		}
+		for key, value := range c {
+			if key != "secret_config" {
+				collectSecrets(value, false, secrets)
+				continue
+			}
+			if secretConfig, ok := value.(map[string]interface{}); ok {
+				for _, secret := range secretConfig {
+					if name, ok := secret.(string); ok && name != "" {
+						secrets[name] = struct{}{}
+					}
+				}
+			}
+		}
+	case []interface{}:
+		for _, value := range c {
+			collectSecrets(value, false, secrets)
+		}
+	}
+}
+
+func templateJSON(data []byte, fn func(config map[string]interface{})) ([]byte, error) {
+	var config map[string]interface{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	fn(config)
+	return MarshalJSON(config)
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Render returns the app template files with their placeholders replaced by the params,
+// keeping the template's data source names which are not renamed
+func (t AppTemplate) Render(params AppTemplateParams) map[string][]byte {
+	oldnew := []string{
+		appTemplatePlaceholderAppID, params.ClientAppID,
+		appTemplatePlaceholderAppName, params.Name,
+	}
+	for _, name := range t.Manifest.DataSources {
+		newName := name
+		if renamed, ok := params.DataSources[name]; ok && renamed != "" {
+			newName = renamed
+		}
+		oldnew = append(oldnew, appTemplatePlaceholderDataSource(name), newName)
+	}
+	replacer := strings.NewReplacer(oldnew...)
+
+	files := make(map[string][]byte, len(t.Files))
+	for filePath, data := range t.Files {
+		files[replacer.Replace(filePath)] = []byte(replacer.Replace(string(data)))
+	}
+	return files
+}
+
+// RenderApp writes the rendered app template to the directory and loads it as an app
+func (t AppTemplate) RenderApp(dir string, params AppTemplateParams) (App, error) {
+	for filePath, data := range t.Render(params) {
+		if cleaned := path.Clean(filePath); cleaned == ".." || strings.HasPrefix(cleaned, "../") || path.IsAbs(cleaned) {
+			return App{}, fmt.Errorf("invalid file path in rendered app template: %s", filePath)
+		}
+		if err := WriteFile(filepath.Join(dir, filepath.FromSlash(filePath)), 0666, bytes.NewReader(data)); err != nil {
+			return App{}, err
+		}
+	}
+	return LoadApp(dir)
+}
+
+// WriteAppTemplate writes the app template as a zip bundle to the specified path
+func WriteAppTemplate(filePath string, t AppTemplate) error {
+	manifest, err := MarshalJSON(t.Manifest)
+	if err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	write := func(name string, data []byte) error {
+		f, err := w.Create(name)
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	}
+
+	if err := write(FileAppTemplateManifest, manifest); err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(t.Files))
+	for p := range t.Files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		if err := write(path.Join(appTemplateFilesDir, p), t.Files[p]); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return WriteFile(filePath, 0666, buf)
+}
+
+// ReadAppTemplate reads the app template zip bundle at the specified path
+func ReadAppTemplate(filePath string) (AppTemplate, error) {
+	r, err := zip.OpenReader(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return AppTemplate{}, fmt.Errorf("failed to find app template at %s", filePath)
+		}
+		return AppTemplate{}, fmt.Errorf("failed to open app template at %s: %w", filePath, err)
+	}
+	defer r.Close()
+
+	tmpl := AppTemplate{Files: map[string][]byte{}}
+
+	var hasManifest bool
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return AppTemplate{}, err
+		}
+		data, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return AppTemplate{}, err
+		}
+
+		if f.Name == FileAppTemplateManifest {
+			if err := json.Unmarshal(data, &tmpl.Manifest); err != nil {
+				return AppTemplate{}, fmt.Errorf("failed to parse app template manifest: %w", err)
+			}
+			hasManifest = true
+			continue
+		}
+
+		if !strings.HasPrefix(f.Name, appTemplateFilesDir+"/") {
+			continue
+		}
+
+		name := path.Clean(strings.TrimPrefix(f.Name, appTemplateFilesDir+"/"))
+		if name == ".." || strings.HasPrefix(name, "../") || path.IsAbs(name) {
+			return AppTemplate{}, fmt.Errorf("invalid file path in app template: %s", f.Name)
+		}
+		tmpl.Files[name] = data
+	}
+
+	if !hasManifest {
+		return AppTemplate{}, fmt.Errorf("failed to find %s in app template at %s", FileAppTemplateManifest, filePath)
+	}
+	return tmpl, nil
+}
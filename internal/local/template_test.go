@@ -0,0 +1,116 @@
+package local
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestAppTemplate(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	appDir := filepath.Join(tmpDir, "app")
+
+	app := NewApp(appDir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.DefaultAppConfigVersion)
+	AddDataSource(app.AppData, map[string]interface{}{
+		"name":   "mongodb-atlas",
+		"type":   "mongodb-atlas",
+		"config": map[string]interface{}{"clusterName": "Cluster0"},
+	})
+	assert.Nil(t, app.Write())
+
+	for path, data := range map[string]string{
+		"values/apiKey.json":     `{"name":"apiKey","value":"apiKeySecret","from_secret":true}`,
+		"values/plain.json":      `{"name":"plain","value":"notASecret","from_secret":false}`,
+		"auth/providers.json":    `{"oauth2-google":{"name":"oauth2-google","type":"oauth2-google","config":{"clientId":"id"},"secret_config":{"clientSecret":"googleSecret"},"disabled":false}}`,
+		"triggers/onInsert.json": `{"name":"onInsert","type":"DATABASE","config":{"service_name":"mongodb-atlas","database":"db","collection":"coll","operation_types":["INSERT"]},"function_name":"lookup","disabled":false}`,
+		"functions/config.json":  `[{"name":"lookup","private":false}]`,
+		"functions/lookup.js":    `exports = () => context.services.get("mongodb-atlas").db("db").collection("coll").findOne({}, { other: context.services.get('http') });`,
+	} {
+		assert.Nil(t, WriteFile(filepath.Join(appDir, filepath.FromSlash(path)), 0666, strings.NewReader(data)))
+	}
+
+	app, err = LoadApp(appDir)
+	assert.Nil(t, err)
+
+	tmpl, err := PackageAppTemplate(app)
+	assert.Nil(t, err)
+
+	t.Run("should describe the template parameters in its manifest", func(t *testing.T) {
+		assert.Equal(t, AppTemplateManifest{
+			Name:          "eggcorn",
+			ConfigVersion: realm.DefaultAppConfigVersion,
+			DataSources:   []string{"mongodb-atlas"},
+			Secrets:       []string{"apiKeySecret", "googleSecret"},
+		}, tmpl.Manifest)
+	})
+
+	t.Run("should replace the data source names with placeholders", func(t *testing.T) {
+		_, ok := tmpl.Files["data_sources/{{data_source.mongodb-atlas}}/config.json"]
+		assert.True(t, ok, "should rename the data source directory")
+		_, ok = tmpl.Files["data_sources/mongodb-atlas/config.json"]
+		assert.False(t, ok, "should not keep the data source directory")
+
+		assert.Equal(t, `exports = () => context.services.get("{{data_source.mongodb-atlas}}").db("db").collection("coll").findOne({}, { other: context.services.get('http') });`, string(tmpl.Files["functions/lookup.js"]))
+	})
+
+	bundle := filepath.Join(tmpDir, "eggcorn-template.zip")
+	assert.Nil(t, WriteAppTemplate(bundle, tmpl))
+
+	t.Run("should read the written template", func(t *testing.T) {
+		read, err := ReadAppTemplate(bundle)
+		assert.Nil(t, err)
+		assert.Equal(t, tmpl, read)
+	})
+
+	t.Run("should render the template as a new app", func(t *testing.T) {
+		read, err := ReadAppTemplate(bundle)
+		assert.Nil(t, err)
+
+		rendered, err := read.RenderApp(filepath.Join(tmpDir, "rendered"), AppTemplateParams{
+			ClientAppID: "chestnut-abcde",
+			Name:        "chestnut",
+			DataSources: map[string]string{"mongodb-atlas": "cluster"},
+		})
+		assert.Nil(t, err)
+
+		assert.Equal(t, "chestnut-abcde", rendered.ID())
+		assert.Equal(t, "chestnut", rendered.Name())
+
+		appData, ok := rendered.AppData.(*AppRealmConfigJSON)
+		assert.True(t, ok, "should render a realm_config.json app")
+		assert.Equal(t, 1, len(appData.DataSources))
+		assert.Equal(t, "cluster", appData.DataSources[0].Config["name"])
+		assert.Equal(t, "mongodb-atlas", appData.DataSources[0].Config["type"])
+		assert.Equal(t, "cluster", appData.Triggers[0]["config"].(map[string]interface{})["service_name"])
+		assert.Equal(t, `exports = () => context.services.get("cluster").db("db").collection("coll").findOne({}, { other: context.services.get('http') });`, appData.Functions.Sources["lookup.js"])
+	})
+
+	t.Run("should return an error for a missing template", func(t *testing.T) {
+		_, err := ReadAppTemplate(filepath.Join(tmpDir, "missing.zip"))
+		assert.Equal(t, "failed to find app template at "+filepath.Join(tmpDir, "missing.zip"), err.Error())
+	})
+
+	t.Run("should return an error for a template without a manifest", func(t *testing.T) {
+		path := filepath.Join(tmpDir, "no-manifest.zip")
+
+		f, err := os.Create(path)
+		assert.Nil(t, err)
+		w := zip.NewWriter(f)
+		_, err = w.Create("app/realm_config.json")
+		assert.Nil(t, err)
+		assert.Nil(t, w.Close())
+		assert.Nil(t, f.Close())
+
+		_, err = ReadAppTemplate(path)
+		assert.Equal(t, "failed to find template.json in app template at "+path, err.Error())
+	})
+}
@@ -0,0 +1,484 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/dop251/goja"
+)
+
+const (
+	// NameTests is the directory of the test fixtures for the app's functions
+	NameTests = "tests"
+)
+
+// FunctionTest is a test case which runs a local function with the
+// specified args and mocks, and checks either its result or its error
+type FunctionTest struct {
+	File      string                            `json:"-"`
+	Name      string                            `json:"-"`
+	Function  string                            `json:"function"`
+	Args      []interface{}                     `json:"args"`
+	Values    map[string]interface{}            `json:"values,omitempty"`
+	Services  map[string]map[string]interface{} `json:"services,omitempty"`
+	Functions map[string]interface{}            `json:"functions,omitempty"`
+	Result    json.RawMessage                   `json:"-"`
+	Error     string                            `json:"-"`
+}
+
+// functionTestFixture is a file of function test cases, whose mocks apply to each of its cases
+type functionTestFixture struct {
+	Function  string                            `json:"function"`
+	Values    map[string]interface{}            `json:"values"`
+	Services  map[string]map[string]interface{} `json:"services"`
+	Functions map[string]interface{}            `json:"functions"`
+	Cases     []functionTestCase                `json:"cases"`
+}
+
+type functionTestCase struct {
+	Name      string                            `json:"name"`
+	Args      []interface{}                     `json:"args"`
+	Values    map[string]interface{}            `json:"values"`
+	Services  map[string]map[string]interface{} `json:"services"`
+	Functions map[string]interface{}            `json:"functions"`
+	Result    json.RawMessage                   `json:"result"`
+	Error     string                            `json:"error"`
+}
+
+// LoadFunctionTests loads the function tests from each JSON fixture file in the app's tests directory
+func LoadFunctionTests(app App) ([]FunctionTest, error) {
+	dir := filepath.Join(app.RootDir, NameTests)
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var tests []FunctionTest
+	if err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != extJSON {
+			return nil
+		}
+
+		file, err := filepath.Rel(app.RootDir, path)
+		if err != nil {
+			return err
+		}
+		file = filepath.ToSlash(file)
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var fixture functionTestFixture
+		if err := json.Unmarshal(data, &fixture); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		if fixture.Function == "" {
+			return fmt.Errorf("%s: missing required field 'function'", file)
+		}
+
+		for i, tc := range fixture.Cases {
+			name := tc.Name
+			if name == "" {
+				name = fmt.Sprintf("case %d", i+1)
+			}
+
+			args := tc.Args
+			if args == nil {
+				args = []interface{}{}
+			}
+
+			services := map[string]map[string]interface{}{}
+			for svc, mocks := range fixture.Services {
+				services[svc] = mergeMocks(mocks, nil)
+			}
+			for svc, mocks := range tc.Services {
+				services[svc] = mergeMocks(services[svc], mocks)
+			}
+
+			tests = append(tests, FunctionTest{
+				File:      file,
+				Name:      name,
+				Function:  fixture.Function,
+				Args:      args,
+				Values:    mergeMocks(fixture.Values, tc.Values),
+				Services:  services,
+				Functions: mergeMocks(fixture.Functions, tc.Functions),
+				Result:    tc.Result,
+				Error:     tc.Error,
+			})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return tests, nil
+}
+
+// mergeMocks merges the mocks of a test case into the mocks of its fixture,
+// with the test case's mocks taking precedence
+func mergeMocks(fixture, tc map[string]interface{}) map[string]interface{} {
+	mocks := make(map[string]interface{}, len(fixture)+len(tc))
+	for k, v := range fixture {
+		mocks[k] = v
+	}
+	for k, v := range tc {
+		mocks[k] = v
+	}
+	return mocks
+}
+
+// FunctionSources returns the source of each of the app's functions by name,
+// with the modules required from the app's shared directory inlined
+func FunctionSources(app App) (map[string]string, error) {
+	if err := InlineSharedSources(app); err != nil {
+		return nil, err
+	}
+
+	sources := map[string]string{}
+
+	resolver, ok := app.AppData.(functionSourcesResolver)
+	if !ok {
+		return sources, nil
+	}
+
+	if err := resolver.resolveFunctionSources(func(name, source string) (string, error) {
+		sources[name] = source
+		return source, nil
+	}); err != nil {
+		return nil, err
+	}
+	return sources, nil
+}
+
+// FunctionTestResult is the outcome of running a function test
+type FunctionTestResult struct {
+	Test   FunctionTest
+	Result interface{}
+	Err    string
+	Logs   []string
+}
+
+// Failure returns the reason the function test failed, or an empty string if it passed
+func (r FunctionTestResult) Failure() string {
+	if r.Test.Error != "" {
+		if r.Err == "" {
+			return fmt.Sprintf("expected the error '%s', but the function succeeded", r.Test.Error)
+		}
+		if r.Err != r.Test.Error {
+			return fmt.Sprintf("expected the error '%s', but got '%s'", r.Test.Error, r.Err)
+		}
+		return ""
+	}
+
+	if r.Err != "" {
+		return fmt.Sprintf("failed with the error '%s'", r.Err)
+	}
+
+	if r.Test.Result == nil {
+		return ""
+	}
+
+	var expected interface{}
+	if err := json.Unmarshal(r.Test.Result, &expected); err != nil {
+		return fmt.Sprintf("failed to parse the expected result: %s", err)
+	}
+	if reflect.DeepEqual(expected, r.Result) {
+		return ""
+	}
+
+	expectedJSON, _ := json.Marshal(expected)
+	actualJSON, _ := json.Marshal(r.Result)
+	return fmt.Sprintf("expected the result %s, but got %s", expectedJSON, actualJSON)
+}
+
+// FunctionTestRunner runs function tests against the local function sources
+type FunctionTestRunner interface {
+	Run(ctx context.Context, dir string, sources map[string]string, tests []FunctionTest) ([]FunctionTestResult, error)
+}
+
+// NewFunctionTestRunner creates a new function test runner, which runs
+// the function tests with the JavaScript runtime bundled with the CLI
+func NewFunctionTestRunner() FunctionTestRunner {
+	return functionTestRunner{}
+}
+
+type functionTestRunner struct{}
+
+type functionTestInput struct {
+	Dir       string            `json:"dir"`
+	Functions map[string]string `json:"functions"`
+	Tests     []FunctionTest    `json:"tests"`
+}
+
+type functionTestOutput struct {
+	Result interface{} `json:"result"`
+	Error  *string     `json:"error"`
+	Logs   []string    `json:"logs"`
+}
+
+func (r functionTestRunner) Run(ctx context.Context, dir string, sources map[string]string, tests []FunctionTest) ([]FunctionTestResult, error) {
+	if len(tests) == 0 {
+		return nil, nil
+	}
+
+	in, err := json.Marshal(functionTestInput{dir, sources, tests})
+	if err != nil {
+		return nil, err
+	}
+
+	vm := goja.New()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			vm.Interrupt(ctx.Err())
+		case <-done:
+		}
+	}()
+
+	if _, err := vm.RunString(functionTestHarness); err != nil {
+		return nil, fmt.Errorf("failed to run function tests: %w", err)
+	}
+
+	run, ok := goja.AssertFunction(vm.Get("run"))
+	if !ok {
+		return nil, errors.New("failed to run function tests: the test harness has no run function")
+	}
+
+	v, err := run(goja.Undefined(), vm.ToValue(string(in)), vm.ToValue(functionTestModules{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to run function tests: %w", err)
+	}
+
+	// the promise jobs are all run before the call returns, so the tests
+	// are still pending only when they wait for something which never happens
+	promise, ok := v.Export().(*goja.Promise)
+	if !ok {
+		return nil, errors.New("failed to run function tests: the test harness did not return a promise")
+	}
+	switch promise.State() {
+	case goja.PromiseStatePending:
+		return nil, errors.New("failed to run function tests: the tests did not complete")
+	case goja.PromiseStateRejected:
+		return nil, fmt.Errorf("failed to run function tests: %s", promise.Result())
+	}
+
+	var outputs []functionTestOutput
+	if err := json.Unmarshal([]byte(promise.Result().String()), &outputs); err != nil {
+		return nil, fmt.Errorf("failed to read function test results: %w", err)
+	}
+	if len(outputs) != len(tests) {
+		return nil, fmt.Errorf("failed to read function test results: expected %d result(s), but got %d", len(tests), len(outputs))
+	}
+
+	results := make([]FunctionTestResult, len(tests))
+	for i, output := range outputs {
+		results[i] = FunctionTestResult{Test: tests[i], Result: output.Result, Logs: output.Logs}
+		if output.Error != nil {
+			results[i].Err = *output.Error
+		}
+	}
+	return results, nil
+}
+
+// functionTestModules loads the modules required by the functions, which are
+// resolved the same way Node.js resolves the modules which are not built in
+type functionTestModules struct{}
+
+// Resolve returns the path of the module required from the directory
+func (m functionTestModules) Resolve(dir, name string) (string, error) {
+	if strings.HasPrefix(name, "./") || strings.HasPrefix(name, "../") || filepath.IsAbs(name) {
+		if path, ok := resolveModuleFile(filepath.Join(dir, name)); ok {
+			return path, nil
+		}
+	} else {
+		for d := dir; ; d = filepath.Dir(d) {
+			if path, ok := resolveModuleFile(filepath.Join(d, nameNodeModules, name)); ok {
+				return path, nil
+			}
+			if filepath.Dir(d) == d {
+				break
+			}
+		}
+	}
+	return "", fmt.Errorf("cannot find module '%s'", name)
+}
+
+// Read returns the source of the module at the path
+func (m functionTestModules) Read(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Dir returns the directory of the module at the path
+func (m functionTestModules) Dir(path string) string {
+	return filepath.Dir(path)
+}
+
+func resolveModuleFile(path string) (string, bool) {
+	for _, file := range []string{path, path + extJS, path + extJSON} {
+		if info, err := os.Stat(file); err == nil && !info.IsDir() {
+			return file, true
+		}
+	}
+
+	if data, err := ioutil.ReadFile(filepath.Join(path, FilePackageJSON.String())); err == nil {
+		var pkg struct {
+			Main string `json:"main"`
+		}
+		if err := json.Unmarshal(data, &pkg); err == nil && pkg.Main != "" {
+			if main := filepath.Join(path, pkg.Main); main != path {
+				if file, ok := resolveModuleFile(main); ok {
+					return file, true
+				}
+			}
+		}
+	}
+
+	index := filepath.Join(path, "index"+extJS)
+	if info, err := os.Stat(index); err == nil && !info.IsDir() {
+		return index, true
+	}
+	return "", false
+}
+
+// functionTestHarness runs each of the function tests it is called with and returns their outcomes.
+// A function is evaluated with mocks of its context: context.values.get returns the mocked values,
+// context.services.get returns a service whose calls return the mocked results by their dotted
+// path (e.g. "db.collection.findOne"), and context.functions.execute returns the mocked function
+// results, otherwise running the local function
+const functionTestHarness = `
+const hasOwn = (obj, key) => obj != null && Object.prototype.hasOwnProperty.call(obj, key);
+
+function newService(mocks, calls) {
+  return new Proxy(function() {}, {
+    get(target, prop) {
+      if (typeof prop !== 'string' || prop === 'then') {
+        return undefined;
+      }
+      return newService(mocks, calls.concat(prop));
+    },
+    apply() {
+      const call = calls.join('.');
+      if (hasOwn(mocks, call)) {
+        return mocks[call];
+      }
+      return newService(mocks, calls);
+    },
+  });
+}
+
+function format(...args) {
+  return args.map((arg) => {
+    if (typeof arg === 'string') {
+      return arg;
+    }
+    if (arg instanceof Error) {
+      return arg.stack || String(arg);
+    }
+    if (arg !== null && typeof arg === 'object') {
+      try {
+        return JSON.stringify(arg);
+      } catch (err) {
+        return String(arg);
+      }
+    }
+    return String(arg);
+  }).join(' ');
+}
+
+function newConsole(logs) {
+  const log = (...args) => logs.push(format(...args));
+  return { log, info: log, warn: log, error: log, debug: log };
+}
+
+function newRequire(modules, cache, dir) {
+  return function require(name) {
+    const file = modules.Resolve(dir, name);
+    if (hasOwn(cache, file)) {
+      return cache[file].exports;
+    }
+
+    const module = { exports: {} };
+    cache[file] = module;
+
+    const source = modules.Read(file);
+    if (file.endsWith('.json')) {
+      module.exports = JSON.parse(source);
+      return module.exports;
+    }
+
+    const fileDir = modules.Dir(file);
+    const load = new Function('module', 'exports', 'require', '__filename', '__dirname', source);
+    load(module, module.exports, newRequire(modules, cache, fileDir), file, fileDir);
+    return module.exports;
+  };
+}
+
+function execute(input, require, test, logs, name, args) {
+  if (!hasOwn(input.functions, name)) {
+    throw new Error("function '" + name + "' does not exist");
+  }
+
+  const context = {
+    values: { get: (value) => (hasOwn(test.values, value) ? test.values[value] : undefined) },
+    services: { get: (svc) => newService(hasOwn(test.services, svc) ? test.services[svc] : {}, []) },
+    functions: {
+      execute: (fn, ...fnArgs) => {
+        if (hasOwn(test.functions, fn)) {
+          return test.functions[fn];
+        }
+        return execute(input, require, test, logs, fn, fnArgs);
+      },
+    },
+  };
+
+  const module = { exports: {} };
+  const load = new Function('context', 'console', 'require', 'module', 'exports',
+    input.functions[name] + '\n;return exports;');
+  let fn = load(context, newConsole(logs), require, module, module.exports);
+  if (typeof fn !== 'function') {
+    fn = module.exports;
+  }
+  if (typeof fn !== 'function') {
+    throw new Error("function '" + name + "' does not export a function");
+  }
+  return fn(...args);
+}
+
+async function run(data, modules) {
+  const input = JSON.parse(data);
+  const require = newRequire(modules, {}, input.dir);
+
+  const outputs = [];
+  for (const test of input.tests) {
+    const logs = [];
+    try {
+      const result = await execute(input, require, test, logs, test.function, test.args);
+      outputs.push({ result: result === undefined ? null : result, logs });
+    } catch (err) {
+      outputs.push({ error: err instanceof Error ? err.message : String(err), logs });
+    }
+  }
+  return JSON.stringify(outputs);
+}
+`
@@ -0,0 +1,244 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestLoadFunctionTests(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.Nil(t, err)
+
+	t.Run("should load the function tests of each fixture with the mocks merged", func(t *testing.T) {
+		app, err := LoadApp(filepath.Join(wd, "testdata/function_tests"))
+		assert.Nil(t, err)
+
+		tests, err := LoadFunctionTests(app)
+		assert.Nil(t, err)
+
+		assert.Equal(t, []FunctionTest{
+			{
+				File:      "tests/greet.json",
+				Name:      "greets with the configured greeting",
+				Function:  "greet",
+				Args:      []interface{}{"world"},
+				Values:    map[string]interface{}{"greeting": "Hello"},
+				Services:  map[string]map[string]interface{}{},
+				Functions: map[string]interface{}{},
+				Result:    json.RawMessage(`"Hello, world x3"`),
+			},
+			{
+				File:      "tests/greet.json",
+				Name:      "greets with an overridden greeting",
+				Function:  "greet",
+				Args:      []interface{}{"world"},
+				Values:    map[string]interface{}{"greeting": "Howdy"},
+				Services:  map[string]map[string]interface{}{},
+				Functions: map[string]interface{}{"sum": 5.0},
+				Result:    json.RawMessage(`"Howdy, world x5"`),
+			},
+			{
+				File:     "tests/orders/total.json",
+				Name:     "case 1",
+				Function: "orders/total",
+				Args:     []interface{}{"order0"},
+				Values:   map[string]interface{}{},
+				Services: map[string]map[string]interface{}{"mongodb-atlas": {
+					"db.collection.findOne": map[string]interface{}{
+						"_id":   "order0",
+						"items": []interface{}{map[string]interface{}{"price": 4.0}, map[string]interface{}{"price": 6.0}},
+					},
+				}},
+				Functions: map[string]interface{}{"discount": 1.0},
+				Result:    json.RawMessage(`9`),
+			},
+			{
+				File:     "tests/orders/total.json",
+				Name:     "fails the wrong total",
+				Function: "orders/total",
+				Args:     []interface{}{"order0"},
+				Values:   map[string]interface{}{},
+				Services: map[string]map[string]interface{}{"mongodb-atlas": {
+					"db.collection.findOne": map[string]interface{}{
+						"_id":   "order0",
+						"items": []interface{}{map[string]interface{}{"price": 4.0}, map[string]interface{}{"price": 6.0}},
+					},
+				}},
+				Functions: map[string]interface{}{"discount": 1.0},
+				Result:    json.RawMessage(`10`),
+			},
+			{
+				File:      "tests/sum.json",
+				Name:      "adds two numbers",
+				Function:  "sum",
+				Args:      []interface{}{1.0, 2.0},
+				Values:    map[string]interface{}{},
+				Services:  map[string]map[string]interface{}{},
+				Functions: map[string]interface{}{},
+				Result:    json.RawMessage(`3`),
+			},
+			{
+				File:      "tests/sum.json",
+				Name:      "requires numbers",
+				Function:  "sum",
+				Args:      []interface{}{"1", 2.0},
+				Values:    map[string]interface{}{},
+				Services:  map[string]map[string]interface{}{},
+				Functions: map[string]interface{}{},
+				Error:     "sum requires two numbers",
+			},
+		}, tests)
+	})
+
+	t.Run("should return no tests when the app has no tests directory", func(t *testing.T) {
+		app, err := LoadApp(filepath.Join(wd, "testdata/functions"))
+		assert.Nil(t, err)
+
+		tests, err := LoadFunctionTests(app)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(tests))
+	})
+
+	t.Run("should return an error when a fixture is missing its function", func(t *testing.T) {
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		assert.Nil(t, WriteFile(filepath.Join(dir, NameTests, "invalid.json"), 0666, strings.NewReader(`{"cases":[{}]}`)))
+
+		_, err = LoadFunctionTests(App{RootDir: dir})
+		assert.Equal(t, "tests/invalid.json: missing required field 'function'", err.Error())
+	})
+}
+
+func TestFunctionTestResultFailure(t *testing.T) {
+	for _, tc := range []struct {
+		description     string
+		result          FunctionTestResult
+		expectedFailure string
+	}{
+		{
+			description: "should pass when the result matches",
+			result: FunctionTestResult{
+				Test:   FunctionTest{Result: json.RawMessage(`{"a":[1,"b"]}`)},
+				Result: map[string]interface{}{"a": []interface{}{1.0, "b"}},
+			},
+		},
+		{
+			description: "should pass when no result is expected and the function succeeds",
+			result:      FunctionTestResult{Result: "anything"},
+		},
+		{
+			description: "should pass when the error matches",
+			result:      FunctionTestResult{Test: FunctionTest{Error: "something bad happened"}, Err: "something bad happened"},
+		},
+		{
+			description:     "should fail when the result does not match",
+			result:          FunctionTestResult{Test: FunctionTest{Result: json.RawMessage(`3`)}, Result: 4.0},
+			expectedFailure: "expected the result 3, but got 4",
+		},
+		{
+			description:     "should fail when the function fails unexpectedly",
+			result:          FunctionTestResult{Err: "something bad happened"},
+			expectedFailure: "failed with the error 'something bad happened'",
+		},
+		{
+			description:     "should fail when the function succeeds unexpectedly",
+			result:          FunctionTestResult{Test: FunctionTest{Error: "something bad happened"}},
+			expectedFailure: "expected the error 'something bad happened', but the function succeeded",
+		},
+		{
+			description:     "should fail when the error does not match",
+			result:          FunctionTestResult{Test: FunctionTest{Error: "something bad happened"}, Err: "something else happened"},
+			expectedFailure: "expected the error 'something bad happened', but got 'something else happened'",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expectedFailure, tc.result.Failure())
+		})
+	}
+}
+
+func TestFunctionTestRunner(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.Nil(t, err)
+
+	t.Run("should run the function tests with the mocked context", func(t *testing.T) {
+		app, err := LoadApp(filepath.Join(wd, "testdata/function_tests"))
+		assert.Nil(t, err)
+
+		tests, err := LoadFunctionTests(app)
+		assert.Nil(t, err)
+
+		sources, err := FunctionSources(app)
+		assert.Nil(t, err)
+
+		results, err := NewFunctionTestRunner().Run(context.Background(), filepath.Join(app.RootDir, NameFunctions), sources, tests)
+		assert.Nil(t, err)
+		assert.Equal(t, len(tests), len(results))
+
+		failures := make([]string, len(results))
+		for i, result := range results {
+			failures[i] = result.Failure()
+		}
+		assert.Equal(t, []string{"", "", "", "expected the result 10, but got 9", "", ""}, failures)
+
+		assert.Equal(t, []string{"greeting world"}, results[0].Logs)
+		assert.Equal(t, "sum requires two numbers", results[5].Err)
+	})
+
+	t.Run("should fail the test of a missing function", func(t *testing.T) {
+		results, err := NewFunctionTestRunner().Run(context.Background(), wd, map[string]string{}, []FunctionTest{{Function: "missing", Args: []interface{}{}}})
+		assert.Nil(t, err)
+		assert.Equal(t, "function 'missing' does not exist", results[0].Err)
+	})
+
+	t.Run("should run the functions with the modules installed in node_modules", func(t *testing.T) {
+		dir, teardown, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer teardown()
+
+		for path, data := range map[string]string{
+			"node_modules/pad/package.json": `{"main": "lib/pad.js"}`,
+			"node_modules/pad/lib/pad.js":   `const fill = require('./fill'); module.exports = (s, n) => fill(n - s.length) + s;`,
+			"node_modules/pad/lib/fill.js":  `module.exports = (n) => '0'.repeat(n);`,
+		} {
+			assert.Nil(t, WriteFile(filepath.Join(dir, path), 0666, strings.NewReader(data)))
+		}
+
+		sources := map[string]string{"pad": `const pad = require('pad'); exports = (s) => pad(s, 4);`}
+
+		results, err := NewFunctionTestRunner().Run(context.Background(), dir, sources, []FunctionTest{
+			{Function: "pad", Args: []interface{}{"7"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "", results[0].Err)
+		assert.Equal(t, "0007", results[0].Result)
+	})
+
+	t.Run("should fail the test of a function which requires a missing module", func(t *testing.T) {
+		sources := map[string]string{"fs": `const fs = require('fs'); exports = () => fs;`}
+
+		results, err := NewFunctionTestRunner().Run(context.Background(), wd, sources, []FunctionTest{{Function: "fs", Args: []interface{}{}}})
+		assert.Nil(t, err)
+		assert.Equal(t, "cannot find module 'fs'", results[0].Err)
+	})
+
+	t.Run("should stop running the tests when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		sources := map[string]string{"loop": `exports = () => { while (true) {} };`}
+
+		_, err := NewFunctionTestRunner().Run(ctx, wd, sources, []FunctionTest{{Function: "loop", Args: []interface{}{}}})
+		assert.NotNil(t, err)
+	})
+}
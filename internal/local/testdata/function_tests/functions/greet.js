@@ -0,0 +1,8 @@
+const format = require('shared/format');
+
+exports = async function (name) {
+  const greeting = context.values.get('greeting');
+  const count = await context.functions.execute('sum', 1, 2);
+  console.log('greeting', name);
+  return format.greeting(greeting, name, count);
+};
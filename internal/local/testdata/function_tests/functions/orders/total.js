@@ -0,0 +1,6 @@
+exports = async function (orderId) {
+  const orders = context.services.get('mongodb-atlas').db('store').collection('orders');
+  const order = await orders.findOne({ _id: orderId });
+  const discount = await context.functions.execute('discount', order);
+  return order.items.reduce((total, item) => total + item.price, 0) - discount;
+};
@@ -0,0 +1,6 @@
+exports = function (a, b) {
+  if (typeof a !== 'number' || typeof b !== 'number') {
+    throw new Error('sum requires two numbers');
+  }
+  return a + b;
+};
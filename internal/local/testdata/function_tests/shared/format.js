@@ -0,0 +1,3 @@
+module.exports = {
+  greeting: (greeting, name, count) => greeting + ', ' + name + ' x' + count,
+};
@@ -16,6 +16,13 @@ type Transpiler interface {
 	Transpile(ctx context.Context, sources ...string) ([]string, error)
 }
 
+// CheckTranspiler checks the transpiler used to prepare an app's dependencies
+// can be located, so commands which upload dependencies can fail before they start
+func CheckTranspiler() error {
+	_, err := newDefaultTranspiler()
+	return err
+}
+
 func newDefaultTranspiler() (Transpiler, error) {
 	return newTranspiler(defaultTranspilerCommand)
 }
@@ -0,0 +1,367 @@
+package local
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ValidationFinding is an issue found while validating a local Realm app
+type ValidationFinding struct {
+	Path    string
+	Message string
+}
+
+// set of known rule expression operators and expansions
+var (
+	ruleOperators = map[string]struct{}{
+		"%and":          {},
+		"%exists":       {},
+		"%function":     {},
+		"%in":           {},
+		"%nin":          {},
+		"%not":          {},
+		"%oidToString":  {},
+		"%or":           {},
+		"%stringToOid":  {},
+		"%stringToUuid": {},
+		"%uuidToString": {},
+	}
+
+	ruleExpansions = map[string]struct{}{
+		"%%args":        {},
+		"%%environment": {},
+		"%%false":       {},
+		"%%partition":   {},
+		"%%prev":        {},
+		"%%prevRoot":    {},
+		"%%request":     {},
+		"%%root":        {},
+		"%%this":        {},
+		"%%true":        {},
+		"%%user":        {},
+		"%%values":      {},
+	}
+
+	ruleExpressionFields = []string{"apply_when", "read", "write", "insert", "delete", "search"}
+)
+
+// ValidateApp validates the structure of the local Realm app found at the provided root directory
+// without contacting the Realm server, returning the list of issues found
+func ValidateApp(rootDir string) ([]ValidationFinding, error) {
+	findings, err := validateJSONSyntax(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(findings) > 0 {
+		// the app cannot be loaded until its files can be parsed
+		return findings, nil
+	}
+
+	app, err := LoadApp(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	if app.RootDir == "" {
+		return nil, fmt.Errorf("no app directory found at %s", rootDir)
+	}
+
+	v := appValidator{}
+	if app.Name() == "" {
+		v.add(app.Config.String(), "missing required field 'name'")
+	}
+
+	switch data := app.AppData.(type) {
+	case *AppStitchJSON:
+		v.validateV1(data.AppDataV1)
+	case *AppConfigJSON:
+		v.validateV1(data.AppDataV1)
+	case *AppRealmConfigJSON:
+		v.validateV2(data.AppDataV2)
+	}
+
+	sort.SliceStable(v.findings, func(i, j int) bool {
+		return v.findings[i].Path < v.findings[j].Path
+	})
+	return v.findings, nil
+}
+
+func validateJSONSyntax(rootDir string) ([]ValidationFinding, error) {
+	var findings []ValidationFinding
+
+	ignore, err := LoadIgnoreMatcher(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	ignorePaths := map[string]struct{}{nameNodeModules: {}, NameFiles: {}}
+	if err := walk(rootDir, ignore, ignorePaths, func(file os.FileInfo, path string) error {
+		if filepath.Ext(path) != extJSON {
+			return nil
+		}
+
+		data, err := readFile(path)
+		if err != nil {
+			return err
+		}
+
+		var o interface{}
+		if err := json.Unmarshal(data, &o); err != nil {
+			findings = append(findings, ValidationFinding{relPath(rootDir, path), jsonErrorMessage(data, err)})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return findings, nil
+}
+
+func jsonErrorMessage(data []byte, err error) string {
+	var offset int64
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		offset = e.Offset
+	case *json.UnmarshalTypeError:
+		offset = e.Offset
+	default:
+		return "invalid JSON: " + err.Error()
+	}
+	line := bytes.Count(data[:offset], []byte("\n")) + 1
+	return fmt.Sprintf("invalid JSON at line %d: %s", line, err.Error())
+}
+
+func relPath(rootDir, path string) string {
+	rel, err := filepath.Rel(rootDir, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
+
+type appValidator struct {
+	findings []ValidationFinding
+}
+
+func (v *appValidator) add(path, format string, args ...interface{}) {
+	v.findings = append(v.findings, ValidationFinding{path, fmt.Sprintf(format, args...)})
+}
+
+func (v *appValidator) validateV1(app AppDataV1) {
+	functions := map[string]struct{}{}
+	for i, fn := range app.Functions {
+		name := stringField(fn, "name")
+		if name == "" {
+			v.add(fmt.Sprintf("%s/%d", NameFunctions, i), "missing required field 'name'")
+			continue
+		}
+		functions[name] = struct{}{}
+	}
+
+	v.validateTriggers(app.Triggers, functions)
+	v.validateCustomResolvers(app.GraphQL.CustomResolvers, functions)
+
+	for i, svc := range app.Services {
+		name := stringField(svc.Config, "name")
+		path := fmt.Sprintf("%s/%s", NameServices, name)
+		if name == "" {
+			path = fmt.Sprintf("%s/%d", NameServices, i)
+		}
+		v.requireFields(path+"/"+FileConfig.String(), svc.Config, "name", "type")
+
+		for _, rule := range svc.Rules {
+			ns := stringField(rule, "database") + "." + stringField(rule, "collection")
+			v.validateRule(path+"/"+NameRules+"/"+ns+extJSON, rule)
+		}
+	}
+}
+
+func (v *appValidator) validateV2(app AppDataV2) {
+	functions := map[string]struct{}{}
+	for i, fn := range app.Functions.Configs {
+		name := stringField(fn, "name")
+		if name == "" {
+			v.add(fmt.Sprintf("%s/%s[%d]", NameFunctions, FileConfig, i), "missing required field 'name'")
+			continue
+		}
+		functions[name] = struct{}{}
+	}
+
+	v.validateTriggers(app.Triggers, functions)
+	v.validateCustomResolvers(app.GraphQL.CustomResolvers, functions)
+
+	for i, ds := range app.DataSources {
+		name := stringField(ds.Config, "name")
+		path := fmt.Sprintf("%s/%s", NameDataSources, name)
+		if name == "" {
+			path = fmt.Sprintf("%s/%d", NameDataSources, i)
+		}
+		v.requireFields(path+"/"+FileConfig.String(), ds.Config, "name", "type")
+
+		for _, rule := range ds.Rules {
+			v.validateRule(
+				fmt.Sprintf("%s/%s/%s/%s", path, stringField(rule, "database"), stringField(rule, "collection"), FileRules),
+				rule,
+			)
+		}
+	}
+
+	for i, svc := range app.Services {
+		name := stringField(svc.Config, "name")
+		path := fmt.Sprintf("%s/%s", NameServices, name)
+		if name == "" {
+			path = fmt.Sprintf("%s/%d", NameServices, i)
+		}
+		v.requireFields(path+"/"+FileConfig.String(), svc.Config, "name", "type")
+	}
+}
+
+func (v *appValidator) validateTriggers(triggers []map[string]interface{}, functions map[string]struct{}) {
+	for i, trigger := range triggers {
+		name := stringField(trigger, "name")
+		path := fmt.Sprintf("%s/%s%s", NameTriggers, name, extJSON)
+		if name == "" {
+			path = fmt.Sprintf("%s/%d", NameTriggers, i)
+		}
+
+		v.requireFields(path, trigger, "name", "type")
+
+		if _, ok := trigger["event_processors"]; ok {
+			continue
+		}
+
+		functionName := stringField(trigger, "function_name")
+		if functionName == "" {
+			v.add(path, "missing required field 'function_name'")
+			continue
+		}
+		if _, ok := functions[functionName]; !ok {
+			v.add(path, "references function '%s' which does not exist", functionName)
+		}
+	}
+}
+
+func (v *appValidator) validateCustomResolvers(resolvers []map[string]interface{}, functions map[string]struct{}) {
+	for _, resolver := range resolvers {
+		path := fmt.Sprintf("%s/%s/%s_%s%s",
+			NameGraphQL,
+			NameCustomResolvers,
+			strings.ToLower(stringField(resolver, "on_type")),
+			stringField(resolver, "field_name"),
+			extJSON,
+		)
+
+		functionName := stringField(resolver, "function_name")
+		if functionName == "" {
+			v.add(path, "missing required field 'function_name'")
+			continue
+		}
+		if _, ok := functions[functionName]; !ok {
+			v.add(path, "references function '%s' which does not exist", functionName)
+		}
+	}
+}
+
+func (v *appValidator) validateRule(path string, rule map[string]interface{}) {
+	v.requireFields(path, rule, "database", "collection")
+
+	roles, _ := rule["roles"].([]interface{})
+	for i, r := range roles {
+		role, ok := r.(map[string]interface{})
+		if !ok {
+			v.add(path, "role %d must be an object", i)
+			continue
+		}
+
+		roleName := stringField(role, "name")
+		if roleName == "" {
+			v.add(path, "role %d is missing required field 'name'", i)
+			roleName = fmt.Sprintf("%d", i)
+		}
+
+		for _, field := range ruleExpressionFields {
+			if expr, ok := role[field]; ok {
+				v.validateExpression(path, fmt.Sprintf("role '%s' %s", roleName, field), expr)
+			}
+		}
+	}
+
+	filters, _ := rule["filters"].([]interface{})
+	for i, f := range filters {
+		filter, ok := f.(map[string]interface{})
+		if !ok {
+			v.add(path, "filter %d must be an object", i)
+			continue
+		}
+
+		filterName := stringField(filter, "name")
+		if filterName == "" {
+			filterName = fmt.Sprintf("%d", i)
+		}
+
+		for _, field := range []string{"apply_when", "query"} {
+			if expr, ok := filter[field]; ok {
+				v.validateExpression(path, fmt.Sprintf("filter '%s' %s", filterName, field), expr)
+			}
+		}
+	}
+}
+
+func (v *appValidator) validateExpression(path, context string, expr interface{}) {
+	switch e := expr.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(e))
+		for key := range e {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys) // ensures the findings for the same path are reported in a stable order
+
+		for _, key := range keys {
+			value := e[key]
+			if strings.HasPrefix(key, "%%") {
+				if !isKnownExpansion(key) {
+					v.add(path, "%s has unknown expansion '%s'", context, key)
+				}
+			} else if strings.HasPrefix(key, "%") {
+				if _, ok := ruleOperators[key]; !ok {
+					v.add(path, "%s has unknown operator '%s'", context, key)
+				}
+			}
+			v.validateExpression(path, context, value)
+		}
+	case []interface{}:
+		for _, value := range e {
+			v.validateExpression(path, context, value)
+		}
+	case string:
+		if strings.HasPrefix(e, "%%") && !isKnownExpansion(e) {
+			v.add(path, "%s has unknown expansion '%s'", context, e)
+		}
+	}
+}
+
+func (v *appValidator) requireFields(path string, o map[string]interface{}, fields ...string) {
+	for _, field := range fields {
+		if stringField(o, field) == "" {
+			v.add(path, "missing required field '%s'", field)
+		}
+	}
+}
+
+func isKnownExpansion(s string) bool {
+	root := s
+	if idx := strings.Index(s, "."); idx != -1 {
+		root = s[:idx]
+	}
+	_, ok := ruleExpansions[root]
+	return ok
+}
+
+func stringField(o map[string]interface{}, field string) string {
+	s, _ := o[field].(string)
+	return s
+}
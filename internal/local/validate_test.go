@@ -0,0 +1,117 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestValidateApp(t *testing.T) {
+	t.Run("should report no findings for a valid app", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		app := NewApp(tmpDir, "", "test", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+		data := app.AppData.(*AppRealmConfigJSON)
+		data.Functions = FunctionsStructure{
+			Configs: []map[string]interface{}{{"name": "onInsert", "private": false}},
+			Sources: map[string]string{"onInsert.js": "exports = function() {}"},
+		}
+		data.Triggers = []map[string]interface{}{
+			{"name": "insertTrigger", "type": "DATABASE", "function_name": "onInsert"},
+		}
+		data.DataSources = []DataSourceStructure{{
+			Config: map[string]interface{}{"name": "mongodb-atlas", "type": "mongodb-atlas"},
+			Rules: []map[string]interface{}{{
+				"database":   "db",
+				"collection": "coll",
+				"roles": []interface{}{map[string]interface{}{
+					"name":       "owner",
+					"apply_when": map[string]interface{}{"owner_id": "%%user.id"},
+					"write":      map[string]interface{}{"%or": []interface{}{true, map[string]interface{}{"%%root.public": true}}},
+				}},
+			}},
+		}}
+		assert.Nil(t, app.Write())
+
+		findings, err := ValidateApp(tmpDir)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(findings))
+	})
+
+	t.Run("should report structural issues with the app", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		app := NewApp(tmpDir, "", "test", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+		data := app.AppData.(*AppRealmConfigJSON)
+		data.Triggers = []map[string]interface{}{
+			{"name": "insertTrigger", "type": "DATABASE", "function_name": "onInsert"},
+			{"name": "noType", "function_name": "onInsert"},
+			{"name": "eventBridge", "type": "DATABASE", "event_processors": map[string]interface{}{}},
+		}
+		data.GraphQL.CustomResolvers = []map[string]interface{}{
+			{"on_type": "Query", "field_name": "total"},
+		}
+		data.DataSources = []DataSourceStructure{{
+			Config: map[string]interface{}{"name": "mongodb-atlas"},
+			Rules: []map[string]interface{}{{
+				"database":   "db",
+				"collection": "coll",
+				"roles": []interface{}{map[string]interface{}{
+					"name":       "owner",
+					"apply_when": map[string]interface{}{"owner_id": "%%usr.id"},
+					"write":      map[string]interface{}{"%xor": []interface{}{true, false}, "%nand": []interface{}{true, false}, "%and": []interface{}{true}},
+				}},
+			}},
+		}}
+		assert.Nil(t, app.Write())
+
+		findings, err := ValidateApp(tmpDir)
+		assert.Nil(t, err)
+		assert.Equal(t, []ValidationFinding{
+			{"data_sources/mongodb-atlas/config.json", "missing required field 'type'"},
+			{"data_sources/mongodb-atlas/db/coll/rules.json", "role 'owner' apply_when has unknown expansion '%%usr.id'"},
+			{"data_sources/mongodb-atlas/db/coll/rules.json", "role 'owner' write has unknown operator '%nand'"},
+			{"data_sources/mongodb-atlas/db/coll/rules.json", "role 'owner' write has unknown operator '%xor'"},
+			{"graphql/custom_resolvers/query_total.json", "missing required field 'function_name'"},
+			{"triggers/insertTrigger.json", "references function 'onInsert' which does not exist"},
+			{"triggers/noType.json", "missing required field 'type'"},
+			{"triggers/noType.json", "references function 'onInsert' which does not exist"},
+		}, findings)
+	})
+
+	t.Run("should report invalid json before loading the app", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		app := NewApp(tmpDir, "", "test", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+		assert.Nil(t, app.Write())
+
+		assert.Nil(t, os.MkdirAll(filepath.Join(tmpDir, NameTriggers), os.ModePerm))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, NameTriggers, "broken.json"), []byte("{\n  \"name\": \"broken\",\n  \"type\"\n}"), 0666))
+
+		findings, err := ValidateApp(tmpDir)
+		assert.Nil(t, err)
+		assert.Equal(t, []ValidationFinding{
+			{"triggers/broken.json", "invalid JSON at line 4: invalid character '}' after object key"},
+		}, findings)
+	})
+
+	t.Run("should return an error when no app is found", func(t *testing.T) {
+		tmpDir, cleanupTmpDir, err := u.NewTempDir("")
+		assert.Nil(t, err)
+		defer cleanupTmpDir()
+
+		_, err = ValidateApp(tmpDir)
+		assert.NotNil(t, err)
+	})
+}
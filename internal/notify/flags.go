@@ -0,0 +1,57 @@
+package notify
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// set of supported notify flags
+const (
+	FlagTarget      = "notify"
+	FlagTargetUsage = `send a notification when a command taking longer than "--notify-after" finishes, either "desktop" or a webhook URL to ping`
+
+	FlagAfter      = "notify-after"
+	FlagAfterUsage = `the duration a command must take before "--notify" sends a notification`
+
+	// DefaultAfter is the default duration a command must take before a notification is sent
+	DefaultAfter = time.Minute
+)
+
+// Target is where notifications are sent
+type Target string
+
+// set of supported notification targets
+const (
+	TargetEmpty   Target = "" // zero-valued to be flag's default
+	TargetDesktop Target = "desktop"
+)
+
+// String returns the string representation
+func (t Target) String() string { return string(t) }
+
+// Type returns the Target type
+func (t Target) Type() string { return flags.TypeString }
+
+// Set validates and sets the target value
+func (t *Target) Set(val string) error {
+	target := Target(val)
+
+	if target != TargetEmpty && target != TargetDesktop && !target.IsWebhook() {
+		return errors.New(`unsupported value, use either "desktop" or an http(s) webhook URL instead`)
+	}
+
+	*t = target
+	return nil
+}
+
+// IsWebhook returns true if the target is a webhook URL
+func (t Target) IsWebhook() bool {
+	u, err := url.Parse(string(t))
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestTarget(t *testing.T) {
+	t.Run("Should have the correct type representation", func(t *testing.T) {
+		assert.Equal(t, "string", TargetDesktop.Type())
+	})
+
+	for _, val := range []string{"", "desktop", "http://localhost:8080/hook", "https://hooks.example.com/services/abc"} {
+		t.Run("Should set its value correctly with "+val, func(t *testing.T) {
+			var target Target
+			assert.Nil(t, target.Set(val))
+			assert.Equal(t, Target(val), target)
+		})
+	}
+
+	for _, val := range []string{"slack", "ftp://example.com", "https://", "example.com/hook"} {
+		t.Run("Should return an error when setting its value with "+val, func(t *testing.T) {
+			var target Target
+			assert.Equal(t, errors.New(`unsupported value, use either "desktop" or an http(s) webhook URL instead`), target.Set(val))
+		})
+	}
+}
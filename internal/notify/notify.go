@@ -0,0 +1,133 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	title = "realm-cli"
+
+	statusSucceeded = "succeeded"
+	statusFailed    = "failed"
+
+	webhookTimeout = 10 * time.Second
+)
+
+var (
+	execCommand = exec.Command
+	goos        = runtime.GOOS
+)
+
+// Notification is the outcome of a finished command
+type Notification struct {
+	Command  string
+	Duration time.Duration
+	Err      error
+}
+
+// Status returns whether the command succeeded or failed
+func (n Notification) Status() string {
+	if n.Err != nil {
+		return statusFailed
+	}
+	return statusSucceeded
+}
+
+// Message returns the notification message
+func (n Notification) Message() string {
+	msg := fmt.Sprintf("%s %s after %s", n.Command, n.Status(), n.Duration.Round(time.Second))
+	if n.Err != nil {
+		msg += ": " + n.Err.Error()
+	}
+	return msg
+}
+
+// ShouldSend returns true if a notification should be sent to the target
+// for a command which took the specified duration
+func ShouldSend(target Target, after, duration time.Duration) bool {
+	return target != TargetEmpty && duration >= after
+}
+
+// Send sends the notification to the target
+func Send(target Target, n Notification) error {
+	if target == TargetDesktop {
+		return sendDesktop(n)
+	}
+	return sendWebhook(string(target), n)
+}
+
+func sendDesktop(n Notification) error {
+	var cmd *exec.Cmd
+	switch goos {
+	case "darwin":
+		cmd = execCommand("osascript", "-e", fmt.Sprintf("display notification %s with title %s", appleScriptString(n.Message()), appleScriptString(title)))
+	case "linux":
+		cmd = execCommand("notify-send", title, n.Message())
+	case "windows":
+		cmd = execCommand("powershell", "-NoProfile", "-Command", fmt.Sprintf(
+			"Add-Type -AssemblyName System.Windows.Forms; $n = New-Object System.Windows.Forms.NotifyIcon; $n.Icon = [System.Drawing.SystemIcons]::Information; $n.Visible = $true; $n.ShowBalloonTip(10000, %s, %s, 'Info'); Start-Sleep -Seconds 1; $n.Dispose()",
+			powershellString(title),
+			powershellString(n.Message()),
+		))
+	default:
+		return fmt.Errorf("desktop notifications are not supported on %s", goos)
+	}
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to send desktop notification: %s", strings.TrimSpace(string(out)+" "+err.Error()))
+	}
+	return nil
+}
+
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func powershellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+type webhookPayload struct {
+	Command         string  `json:"command"`
+	Status          string  `json:"status"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Error           string  `json:"error,omitempty"`
+	Message         string  `json:"message"`
+}
+
+func sendWebhook(url string, n Notification) error {
+	payload := webhookPayload{
+		Command:         n.Command,
+		Status:          n.Status(),
+		DurationSeconds: n.Duration.Seconds(),
+		Message:         n.Message(),
+	}
+	if n.Err != nil {
+		payload.Error = n.Err.Error()
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	client := http.Client{Timeout: webhookTimeout}
+
+	res, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to ping webhook: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("failed to ping webhook: received status code %d", res.StatusCode)
+	}
+	return nil
+}
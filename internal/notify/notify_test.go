@@ -0,0 +1,107 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestNotification(t *testing.T) {
+	t.Run("should describe a successful command", func(t *testing.T) {
+		n := Notification{Command: "realm-cli push", Duration: 3*time.Minute + 2*time.Second + 400*time.Millisecond}
+		assert.Equal(t, "succeeded", n.Status())
+		assert.Equal(t, "realm-cli push succeeded after 3m2s", n.Message())
+	})
+
+	t.Run("should describe a failed command", func(t *testing.T) {
+		n := Notification{Command: "realm-cli push", Duration: 90 * time.Second, Err: errors.New("something bad happened")}
+		assert.Equal(t, "failed", n.Status())
+		assert.Equal(t, "realm-cli push failed after 1m30s: something bad happened", n.Message())
+	})
+}
+
+func TestShouldSend(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		target      Target
+		duration    time.Duration
+		expected    bool
+	}{
+		{"should not send without a target", TargetEmpty, time.Hour, false},
+		{"should not send when the command finished quickly", TargetDesktop, 30 * time.Second, false},
+		{"should send when the command took long enough", TargetDesktop, time.Minute, true},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ShouldSend(tc.target, time.Minute, tc.duration))
+		})
+	}
+}
+
+func TestSendWebhook(t *testing.T) {
+	t.Run("should ping the webhook with the command status", func(t *testing.T) {
+		var payload map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		assert.Nil(t, Send(Target(server.URL), Notification{Command: "realm-cli push", Duration: 2 * time.Minute, Err: errors.New("something bad happened")}))
+		assert.Equal(t, map[string]interface{}{
+			"command":          "realm-cli push",
+			"status":           "failed",
+			"duration_seconds": float64(120),
+			"error":            "something bad happened",
+			"message":          "realm-cli push failed after 2m0s: something bad happened",
+		}, payload)
+	})
+
+	t.Run("should return an error when the webhook responds with an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		err := Send(Target(server.URL), Notification{Command: "realm-cli push", Duration: 2 * time.Minute})
+		assert.Equal(t, errors.New("failed to ping webhook: received status code 500"), err)
+	})
+}
+
+func TestSendDesktop(t *testing.T) {
+	origExecCommand, origGOOS := execCommand, goos
+	defer func() { execCommand, goos = origExecCommand, origGOOS }()
+
+	var args []string
+	execCommand = func(name string, arg ...string) *exec.Cmd {
+		args = append([]string{name}, arg...)
+		return exec.Command(os.Args[0], "-test.run=^$") // runs no tests and exits successfully
+	}
+
+	n := Notification{Command: "realm-cli push", Duration: 2 * time.Minute}
+
+	t.Run("should run notify-send on linux", func(t *testing.T) {
+		goos = "linux"
+		assert.Nil(t, Send(TargetDesktop, n))
+		assert.Equal(t, []string{"notify-send", "realm-cli", "realm-cli push succeeded after 2m0s"}, args)
+	})
+
+	t.Run("should run osascript on macOS", func(t *testing.T) {
+		goos = "darwin"
+		assert.Nil(t, Send(TargetDesktop, Notification{Command: `realm-cli "push"`, Duration: 2 * time.Minute}))
+		assert.Equal(t, []string{"osascript", "-e", `display notification "realm-cli \"push\" succeeded after 2m0s" with title "realm-cli"`}, args)
+	})
+
+	t.Run("should return an error on an unsupported platform", func(t *testing.T) {
+		goos = "plan9"
+		assert.Equal(t, errors.New("desktop notifications are not supported on plan9"), Send(TargetDesktop, n))
+	})
+}
@@ -0,0 +1,283 @@
+package secrets
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+// set of AWS environment variables
+const (
+	EnvAWSAccessKeyID     = "AWS_ACCESS_KEY_ID"
+	EnvAWSSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+	EnvAWSSessionToken    = "AWS_SESSION_TOKEN"
+	EnvAWSRegion          = "AWS_REGION"
+	EnvAWSDefaultRegion   = "AWS_DEFAULT_REGION"
+)
+
+const (
+	awsService          = "secretsmanager"
+	awsSigningAlgorithm = "AWS4-HMAC-SHA256"
+	awsTargetGetSecret  = "secretsmanager.GetSecretValue"
+	awsTargetListSecret = "secretsmanager.ListSecrets"
+	awsContentType      = "application/x-amz-json-1.1"
+
+	awsTimeFormat = "20060102T150405Z"
+	awsDateFormat = "20060102"
+
+	headerAWSDate          = "X-Amz-Date"
+	headerAWSSecurityToken = "X-Amz-Security-Token"
+	headerAWSTarget        = "X-Amz-Target"
+)
+
+var (
+	errAWSMissingCredentials = fmt.Errorf("must set %s and %s to read secrets from AWS Secrets Manager", EnvAWSAccessKeyID, EnvAWSSecretAccessKey)
+	errAWSMissingRegion      = fmt.Errorf("must set %s to read secrets from AWS Secrets Manager", EnvAWSRegion)
+)
+
+// AWSSecretsManagerSource is an AWS Secrets Manager source
+type AWSSecretsManagerSource struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+
+	// Endpoint overrides the regional AWS Secrets Manager endpoint
+	Endpoint string
+}
+
+// NewAWSSecretsManagerSource returns a new AWS Secrets Manager source configured from the environment
+func NewAWSSecretsManagerSource() AWSSecretsManagerSource {
+	region := os.Getenv(EnvAWSRegion)
+	if region == "" {
+		region = os.Getenv(EnvAWSDefaultRegion)
+	}
+	return AWSSecretsManagerSource{
+		Region:          region,
+		AccessKeyID:     os.Getenv(EnvAWSAccessKeyID),
+		SecretAccessKey: os.Getenv(EnvAWSSecretAccessKey),
+		SessionToken:    os.Getenv(EnvAWSSessionToken),
+	}
+}
+
+type awsGetSecretValueRequest struct {
+	SecretID string `json:"SecretId"`
+}
+
+type awsGetSecretValueResponse struct {
+	SecretString string `json:"SecretString"`
+}
+
+type awsListSecretsRequest struct {
+	Filters   []awsListSecretsFilter `json:"Filters,omitempty"`
+	NextToken string                 `json:"NextToken,omitempty"`
+}
+
+type awsListSecretsFilter struct {
+	Key    string   `json:"Key"`
+	Values []string `json:"Values"`
+}
+
+type awsListSecretsResponse struct {
+	SecretList []struct {
+		Name string `json:"Name"`
+	} `json:"SecretList"`
+	NextToken string `json:"NextToken"`
+}
+
+type awsErrorResponse struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+// Value reads the secret value from AWS Secrets Manager, treating the secret
+// string as a JSON object of key/value pairs when the reference includes a key
+func (s AWSSecretsManagerSource) Value(ref Reference) (string, error) {
+	secret, err := s.SecretString(ref.Path)
+	if err != nil {
+		return "", err
+	}
+
+	if ref.Key == "" {
+		return secret, nil
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(secret), &data); err != nil {
+		return "", fmt.Errorf("secret must be a JSON object to read key '%s'", ref.Key)
+	}
+	return valueAtKey(data, ref)
+}
+
+// SecretString reads the secret string of the secret from AWS Secrets Manager
+func (s AWSSecretsManagerSource) SecretString(secretID string) (string, error) {
+	var res awsGetSecretValueResponse
+	if err := s.do(awsTargetGetSecret, "read AWS secret", awsGetSecretValueRequest{secretID}, &res); err != nil {
+		return "", err
+	}
+	return res.SecretString, nil
+}
+
+// SecretNames lists the names of the secrets in AWS Secrets Manager
+// which start with the prefix, or every secret name without a prefix
+func (s AWSSecretsManagerSource) SecretNames(prefix string) ([]string, error) {
+	var req awsListSecretsRequest
+	if prefix != "" {
+		req.Filters = []awsListSecretsFilter{{Key: "name", Values: []string{prefix}}}
+	}
+
+	var names []string
+	for {
+		var res awsListSecretsResponse
+		if err := s.do(awsTargetListSecret, "list AWS secrets", req, &res); err != nil {
+			return nil, err
+		}
+
+		for _, secret := range res.SecretList {
+			names = append(names, secret.Name)
+		}
+
+		if res.NextToken == "" {
+			return names, nil
+		}
+		req.NextToken = res.NextToken
+	}
+}
+
+func (s AWSSecretsManagerSource) do(target, action string, payload, out interface{}) error {
+	if s.AccessKeyID == "" || s.SecretAccessKey == "" {
+		return errAWSMissingCredentials
+	}
+	if s.Region == "" && s.Endpoint == "" {
+		return errAWSMissingRegion
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	endpoint := s.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.%s.amazonaws.com", awsService, s.Region)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(api.HeaderContentType, awsContentType)
+	req.Header.Set(headerAWSTarget, target)
+
+	s.sign(req, body, time.Now().UTC())
+
+	client := http.Client{Timeout: 20 * time.Second}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var errRes awsErrorResponse
+		if err := json.NewDecoder(res.Body).Decode(&errRes); err != nil || errRes.Message == "" {
+			return api.ErrUnexpectedStatusCode{action, res.StatusCode}
+		}
+		return errors.New(errRes.Message)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// sign signs the request with AWS Signature Version 4
+func (s AWSSecretsManagerSource) sign(req *http.Request, body []byte, t time.Time) {
+	req.Header.Set(headerAWSDate, t.Format(awsTimeFormat))
+	if s.SessionToken != "" {
+		req.Header.Set(headerAWSSecurityToken, s.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+
+	headerNames := make([]string, 0, len(headers))
+	for k := range headers {
+		headerNames = append(headerNames, k)
+	}
+	sort.Strings(headerNames)
+
+	var canonicalHeaders strings.Builder
+	for _, k := range headerNames {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hashHex(body),
+	}, "\n")
+
+	date := t.Format(awsDateFormat)
+	scope := strings.Join([]string{date, s.scopeRegion(), awsService, "aws4_request"}, "/")
+
+	stringToSign := strings.Join([]string{
+		awsSigningAlgorithm,
+		t.Format(awsTimeFormat),
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.SecretAccessKey), date)
+	key = hmacSHA256(key, s.scopeRegion())
+	key = hmacSHA256(key, awsService)
+	key = hmacSHA256(key, "aws4_request")
+
+	req.Header.Set(api.HeaderAuthorization, fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsSigningAlgorithm,
+		s.AccessKeyID,
+		scope,
+		signedHeaders,
+		hex.EncodeToString(hmacSHA256(key, stringToSign)),
+	))
+}
+
+func (s AWSSecretsManagerSource) scopeRegion() string {
+	if s.Region != "" {
+		return s.Region
+	}
+	return "us-east-1"
+}
+
+func hashHex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data)) //nolint:errcheck
+	return h.Sum(nil)
+}
@@ -0,0 +1,102 @@
+package secrets
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestAWSSecretsManagerSourceValue(t *testing.T) {
+	var lastRequest *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastRequest = r
+
+		var req awsGetSecretValueRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch req.SecretID {
+		case "realm/plain":
+			w.Write([]byte(`{"SecretString":"plain-value"}`)) //nolint:errcheck
+		case "realm/prod":
+			w.Write([]byte(`{"SecretString":"{\"apiKey\":\"json-value\"}"}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ResourceNotFoundException","message":"Secrets Manager can't find the specified secret."}`)) //nolint:errcheck
+		}
+	}))
+	defer server.Close()
+
+	source := AWSSecretsManagerSource{
+		Region:          "us-west-2",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+		SessionToken:    "session",
+		Endpoint:        server.URL,
+	}
+
+	t.Run("should read a plain text secret", func(t *testing.T) {
+		value, err := source.Value(Reference{SchemeAWSSecretsManager, "realm/plain", ""})
+		assert.Nil(t, err)
+		assert.Equal(t, "plain-value", value)
+
+		assert.Equal(t, awsTargetGetSecret, lastRequest.Header.Get(headerAWSTarget))
+		assert.Equal(t, awsContentType, lastRequest.Header.Get("Content-Type"))
+		assert.Equal(t, "session", lastRequest.Header.Get(headerAWSSecurityToken))
+
+		authorization := lastRequest.Header.Get("Authorization")
+		assert.True(t, strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKID/"), "expected authorization to include credential, but got: %s", authorization)
+		assert.True(t, strings.Contains(authorization, "/us-west-2/secretsmanager/aws4_request"), "expected authorization to include scope, but got: %s", authorization)
+		assert.True(t, strings.Contains(authorization, "SignedHeaders=content-type;host;x-amz-date;x-amz-security-token;x-amz-target"), "expected authorization to include signed headers, but got: %s", authorization)
+	})
+
+	t.Run("should read a key from a json secret", func(t *testing.T) {
+		value, err := source.Value(Reference{SchemeAWSSecretsManager, "realm/prod", "apiKey"})
+		assert.Nil(t, err)
+		assert.Equal(t, "json-value", value)
+	})
+
+	t.Run("should return an error when reading a key from a plain text secret", func(t *testing.T) {
+		_, err := source.Value(Reference{SchemeAWSSecretsManager, "realm/plain", "apiKey"})
+		assert.Equal(t, errors.New("secret must be a JSON object to read key 'apiKey'"), err)
+	})
+
+	t.Run("should return the server error message", func(t *testing.T) {
+		_, err := source.Value(Reference{SchemeAWSSecretsManager, "realm/dev", ""})
+		assert.Equal(t, errors.New("Secrets Manager can't find the specified secret."), err)
+	})
+
+	t.Run("should return an error when credentials are missing", func(t *testing.T) {
+		_, err := AWSSecretsManagerSource{Region: "us-west-2"}.Value(Reference{SchemeAWSSecretsManager, "realm/prod", ""})
+		assert.Equal(t, errAWSMissingCredentials, err)
+	})
+
+	t.Run("should return an error when region is missing", func(t *testing.T) {
+		_, err := AWSSecretsManagerSource{AccessKeyID: "AKID", SecretAccessKey: "secret"}.Value(Reference{SchemeAWSSecretsManager, "realm/prod", ""})
+		assert.Equal(t, errAWSMissingRegion, err)
+	})
+}
+
+func TestAWSSecretsManagerSourceSign(t *testing.T) {
+	t.Run("should produce a stable signature for the same request and time", func(t *testing.T) {
+		source := AWSSecretsManagerSource{Region: "us-east-1", AccessKeyID: "AKID", SecretAccessKey: "secret"}
+
+		signature := func() string {
+			req, err := http.NewRequest(http.MethodPost, "https://secretsmanager.us-east-1.amazonaws.com/", strings.NewReader("{}"))
+			assert.Nil(t, err)
+			source.sign(req, []byte("{}"), time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC))
+			return req.Header.Get("Authorization")
+		}
+
+		assert.Equal(t, signature(), signature())
+		assert.True(t, strings.Contains(signature(), "Credential=AKID/20210102/us-east-1/secretsmanager/aws4_request"), "expected scope to match time")
+	})
+}
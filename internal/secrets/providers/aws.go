@@ -0,0 +1,47 @@
+package providers
+
+import (
+	"path"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/secrets"
+)
+
+const (
+	awsNameSeparator = "/"
+	nameSeparator    = "_"
+)
+
+// AWSSecretsManagerProvider is an AWS Secrets Manager provider,
+// which reads each secret whose name starts with the path as a secret
+type AWSSecretsManagerProvider struct {
+	Source secrets.AWSSecretsManagerSource
+}
+
+// Secrets returns the secret strings of the AWS secrets whose names start with the prefix,
+// named without the prefix and with any remaining '/' separators replaced by '_'
+// (e.g. the secret "realm/prod/db/password" is named "db_password" with the prefix "realm/prod/")
+func (p AWSSecretsManagerProvider) Secrets(prefix string) (map[string]string, error) {
+	names, err := p.Source.SecretNames(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		value, err := p.Source.SecretString(name)
+		if err != nil {
+			return nil, err
+		}
+		values[secretName(name, prefix)] = value
+	}
+	return values, nil
+}
+
+func secretName(name, prefix string) string {
+	trimmed := strings.Trim(strings.TrimPrefix(name, prefix), awsNameSeparator)
+	if trimmed == "" {
+		trimmed = path.Base(name)
+	}
+	return strings.ReplaceAll(trimmed, awsNameSeparator, nameSeparator)
+}
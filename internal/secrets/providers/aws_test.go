@@ -0,0 +1,68 @@
+package providers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/secrets"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestAWSSecretsManagerProviderSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "secretsmanager.ListSecrets":
+			if req["NextToken"] == nil {
+				w.Write([]byte(`{"SecretList":[{"Name":"realm/prod/apiKey"}],"NextToken":"page2"}`)) //nolint:errcheck
+				return
+			}
+			w.Write([]byte(`{"SecretList":[{"Name":"realm/prod/db/password"}]}`)) //nolint:errcheck
+		case "secretsmanager.GetSecretValue":
+			name := req["SecretId"].(string)
+			w.Write([]byte(`{"SecretString":"` + name[strings.LastIndex(name, "/")+1:] + `-value"}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	provider := AWSSecretsManagerProvider{secrets.AWSSecretsManagerSource{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+		Endpoint:        server.URL,
+	}}
+
+	values, err := provider.Secrets("realm/prod/")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"apiKey":      "apiKey-value",
+		"db_password": "password-value",
+	}, values)
+}
+
+func TestSecretName(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		prefix   string
+		expected string
+	}{
+		{"realm/prod/apiKey", "realm/prod/", "apiKey"},
+		{"realm/prod/apiKey", "realm/prod", "apiKey"},
+		{"realm/prod/db/password", "realm/prod/", "db_password"},
+		{"realm/prod/apiKey", "realm/prod/apiKey", "apiKey"},
+		{"apiKey", "", "apiKey"},
+	} {
+		t.Run("should name "+tc.name+" with the prefix "+tc.prefix, func(t *testing.T) {
+			assert.Equal(t, tc.expected, secretName(tc.name, tc.prefix))
+		})
+	}
+}
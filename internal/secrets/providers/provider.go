@@ -0,0 +1,76 @@
+package providers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/secrets"
+)
+
+const (
+	locationSeparator = "://"
+)
+
+// Provider is an external store of secrets
+type Provider interface {
+	// Secrets returns the names and values of the secrets stored at the path
+	Secrets(path string) (map[string]string, error)
+}
+
+// Location is the location of a set of secrets in an external store,
+// represented as `<scheme>://<path>` (e.g. `vault://kv/realm/prod`)
+type Location struct {
+	Scheme string
+	Path   string
+}
+
+func (l Location) String() string {
+	return l.Scheme + locationSeparator + l.Path
+}
+
+// ParseLocation parses the value as the location of a set of secrets
+func ParseLocation(value string) (Location, error) {
+	idx := strings.Index(value, locationSeparator)
+	if idx <= 0 || idx+len(locationSeparator) == len(value) {
+		return Location{}, fmt.Errorf("invalid secrets location '%s', must be of the form <scheme>://<path>", value)
+	}
+	return Location{value[:idx], value[idx+len(locationSeparator):]}, nil
+}
+
+// Registry reads secrets from its registered providers
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry returns a new registry with the provided providers registered by scheme
+func NewRegistry(providers map[string]Provider) Registry {
+	return Registry{providers}
+}
+
+// NewDefaultRegistry returns a new registry with the HashiCorp Vault and
+// AWS Secrets Manager providers configured from the environment
+func NewDefaultRegistry() Registry {
+	return NewRegistry(map[string]Provider{
+		secrets.SchemeVault:             VaultProvider{secrets.NewVaultSource()},
+		secrets.SchemeAWSSecretsManager: AWSSecretsManagerProvider{secrets.NewAWSSecretsManagerSource()},
+	})
+}
+
+// Secrets returns the names and values of the secrets at the location
+func (r Registry) Secrets(location string) (map[string]string, error) {
+	loc, err := ParseLocation(location)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, ok := r.providers[loc.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("no secrets provider is configured for '%s'", loc.Scheme)
+	}
+
+	values, err := provider.Secrets(loc.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secrets from '%s': %w", loc, err)
+	}
+	return values, nil
+}
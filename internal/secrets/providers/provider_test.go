@@ -0,0 +1,60 @@
+package providers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+type mockProvider map[string]map[string]string
+
+func (p mockProvider) Secrets(path string) (map[string]string, error) {
+	values, ok := p[path]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return values, nil
+}
+
+func TestParseLocation(t *testing.T) {
+	for _, tc := range []struct {
+		value       string
+		expected    Location
+		expectedErr error
+	}{
+		{value: "vault://kv/realm/prod", expected: Location{"vault", "kv/realm/prod"}},
+		{value: "aws-sm://realm/prod/", expected: Location{"aws-sm", "realm/prod/"}},
+		{value: "kv/realm/prod", expectedErr: errors.New("invalid secrets location 'kv/realm/prod', must be of the form <scheme>://<path>")},
+		{value: "vault://", expectedErr: errors.New("invalid secrets location 'vault://', must be of the form <scheme>://<path>")},
+		{value: "://kv/realm/prod", expectedErr: errors.New("invalid secrets location '://kv/realm/prod', must be of the form <scheme>://<path>")},
+	} {
+		t.Run("should parse "+tc.value, func(t *testing.T) {
+			location, err := ParseLocation(tc.value)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expected, location)
+		})
+	}
+}
+
+func TestRegistrySecrets(t *testing.T) {
+	registry := NewRegistry(map[string]Provider{
+		"mock": mockProvider{"realm/prod": {"apiKey": "abc123"}},
+	})
+
+	t.Run("should read the secrets from the provider of the scheme", func(t *testing.T) {
+		values, err := registry.Secrets("mock://realm/prod")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"apiKey": "abc123"}, values)
+	})
+
+	t.Run("should return an error for a scheme without a provider", func(t *testing.T) {
+		_, err := registry.Secrets("gcp-sm://realm/prod")
+		assert.Equal(t, errors.New("no secrets provider is configured for 'gcp-sm'"), err)
+	})
+
+	t.Run("should return the provider error with the location", func(t *testing.T) {
+		_, err := registry.Secrets("mock://realm/dev")
+		assert.Equal(t, "failed to read secrets from 'mock://realm/dev': not found", err.Error())
+	})
+}
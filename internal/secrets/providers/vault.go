@@ -0,0 +1,34 @@
+package providers
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/secrets"
+)
+
+// VaultProvider is a HashiCorp Vault KV secrets engine provider,
+// which reads each key of the Vault secret at the path as a secret
+type VaultProvider struct {
+	Source secrets.VaultSource
+}
+
+// Secrets returns the keys and values of the Vault secret at the path
+func (p VaultProvider) Secrets(path string) (map[string]string, error) {
+	data, err := p.Source.Data(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(data))
+	for name, value := range data {
+		switch v := value.(type) {
+		case string:
+			values[name] = v
+		case bool, float64:
+			values[name] = fmt.Sprint(v)
+		default:
+			return nil, fmt.Errorf("secret '%s' must have a string value", name)
+		}
+	}
+	return values, nil
+}
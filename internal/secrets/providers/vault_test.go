@@ -0,0 +1,43 @@
+package providers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/secrets"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestVaultProviderSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/kv/data/realm/prod":
+			w.Write([]byte(`{"data":{"data":{"apiKey":"abc123","port":8080,"debug":true}}}`)) //nolint:errcheck
+		case "/v1/kv/data/realm/nested":
+			w.Write([]byte(`{"data":{"data":{"db":{"password":"p@ss"}}}}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := VaultProvider{secrets.VaultSource{Address: server.URL, Token: "token"}}
+
+	t.Run("should read every key of the vault secret", func(t *testing.T) {
+		values, err := provider.Secrets("kv/realm/prod")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"apiKey": "abc123", "port": "8080", "debug": "true"}, values)
+	})
+
+	t.Run("should return an error for a key which is not a string", func(t *testing.T) {
+		_, err := provider.Secrets("kv/realm/nested")
+		assert.Equal(t, errors.New("secret 'db' must have a string value"), err)
+	})
+
+	t.Run("should return an error when the vault secret is not found", func(t *testing.T) {
+		_, err := provider.Secrets("kv/realm/dev")
+		assert.Equal(t, errors.New("no Vault secret found at 'kv/realm/dev'"), err)
+	})
+}
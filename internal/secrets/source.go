@@ -0,0 +1,114 @@
+package secrets
+
+import (
+	"fmt"
+	"strings"
+)
+
+// set of supported secret source schemes
+const (
+	SchemeVault             = "vault"
+	SchemeAWSSecretsManager = "aws-sm"
+)
+
+const (
+	schemeSeparator = ":"
+	keySeparator    = "#"
+)
+
+// Reference is a pointer to a secret value stored in an external secret source,
+// represented as `<scheme>:<path>[#<key>]` (e.g. `vault:kv/realm/prod#apiKey`)
+type Reference struct {
+	Scheme string
+	Path   string
+	Key    string
+}
+
+func (ref Reference) String() string {
+	s := ref.Scheme + schemeSeparator + ref.Path
+	if ref.Key != "" {
+		s += keySeparator + ref.Key
+	}
+	return s
+}
+
+// ParseReference parses the value as a secret reference and reports
+// whether the value refers to a supported secret source
+func ParseReference(value string) (Reference, bool) {
+	idx := strings.Index(value, schemeSeparator)
+	if idx == -1 {
+		return Reference{}, false
+	}
+
+	ref := Reference{Scheme: value[:idx], Path: value[idx+1:]}
+	switch ref.Scheme {
+	case SchemeVault, SchemeAWSSecretsManager:
+	default:
+		return Reference{}, false
+	}
+
+	if idx := strings.LastIndex(ref.Path, keySeparator); idx != -1 {
+		ref.Key = ref.Path[idx+1:]
+		ref.Path = ref.Path[:idx]
+	}
+
+	if ref.Path == "" {
+		return Reference{}, false
+	}
+	return ref, true
+}
+
+// Source is an external store of secret values
+type Source interface {
+	Value(ref Reference) (string, error)
+}
+
+// Resolver resolves secret references using its registered sources
+type Resolver struct {
+	sources map[string]Source
+}
+
+// NewResolver returns a new resolver with the provided sources registered by scheme
+func NewResolver(sources map[string]Source) Resolver {
+	return Resolver{sources}
+}
+
+// NewDefaultResolver returns a new resolver with the HashiCorp Vault and
+// AWS Secrets Manager sources configured from the environment
+func NewDefaultResolver() Resolver {
+	return NewResolver(map[string]Source{
+		SchemeVault:             NewVaultSource(),
+		SchemeAWSSecretsManager: NewAWSSecretsManagerSource(),
+	})
+}
+
+// Resolve returns the secret value referenced by the provided value
+// or returns the value as-is when it is not a secret reference
+func (r Resolver) Resolve(value string) (string, error) {
+	ref, ok := ParseReference(value)
+	if !ok {
+		return value, nil
+	}
+
+	source, ok := r.sources[ref.Scheme]
+	if !ok {
+		return "", fmt.Errorf("no secret source is configured for '%s'", ref.Scheme)
+	}
+
+	secret, err := source.Value(ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve secret '%s': %w", ref, err)
+	}
+	return secret, nil
+}
+
+func valueAtKey(data map[string]interface{}, ref Reference) (string, error) {
+	v, ok := data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key '%s' not found", ref.Key)
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprintf("%v", v), nil
+}
@@ -0,0 +1,82 @@
+package secrets
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestParseReference(t *testing.T) {
+	for _, tc := range []struct {
+		value       string
+		expectedRef Reference
+		expectedOK  bool
+	}{
+		{value: "plain value"},
+		{value: "https://example.com"},
+		{value: "vault:"},
+		{value: "vault:#apiKey"},
+		{
+			value:       "vault:kv/realm/prod#apiKey",
+			expectedRef: Reference{Scheme: SchemeVault, Path: "kv/realm/prod", Key: "apiKey"},
+			expectedOK:  true,
+		},
+		{
+			value:       "aws-sm:realm/prod",
+			expectedRef: Reference{Scheme: SchemeAWSSecretsManager, Path: "realm/prod"},
+			expectedOK:  true,
+		},
+		{
+			value:       "aws-sm:realm#prod#apiKey",
+			expectedRef: Reference{Scheme: SchemeAWSSecretsManager, Path: "realm#prod", Key: "apiKey"},
+			expectedOK:  true,
+		},
+	} {
+		t.Run("should parse "+tc.value, func(t *testing.T) {
+			ref, ok := ParseReference(tc.value)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedRef, ref)
+			if ok {
+				assert.Equal(t, tc.value, ref.String())
+			}
+		})
+	}
+}
+
+type sourceFunc func(ref Reference) (string, error)
+
+func (f sourceFunc) Value(ref Reference) (string, error) { return f(ref) }
+
+func TestResolverResolve(t *testing.T) {
+	resolver := NewResolver(map[string]Source{
+		SchemeVault: sourceFunc(func(ref Reference) (string, error) {
+			if ref.Key == "missing" {
+				return "", errors.New("something bad happened")
+			}
+			return ref.Path + "/" + ref.Key, nil
+		}),
+	})
+
+	t.Run("should return the value when it is not a reference", func(t *testing.T) {
+		value, err := resolver.Resolve("my-secret-value")
+		assert.Nil(t, err)
+		assert.Equal(t, "my-secret-value", value)
+	})
+
+	t.Run("should return the value resolved by the source", func(t *testing.T) {
+		value, err := resolver.Resolve("vault:kv/realm#apiKey")
+		assert.Nil(t, err)
+		assert.Equal(t, "kv/realm/apiKey", value)
+	})
+
+	t.Run("should return an error when the source fails", func(t *testing.T) {
+		_, err := resolver.Resolve("vault:kv/realm#missing")
+		assert.Equal(t, "failed to resolve secret 'vault:kv/realm#missing': something bad happened", err.Error())
+	})
+
+	t.Run("should return an error when no source is configured for the scheme", func(t *testing.T) {
+		_, err := resolver.Resolve("aws-sm:realm/prod")
+		assert.Equal(t, errors.New("no secret source is configured for 'aws-sm'"), err)
+	})
+}
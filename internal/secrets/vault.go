@@ -0,0 +1,148 @@
+package secrets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+// set of HashiCorp Vault environment variables
+const (
+	EnvVaultAddress   = "VAULT_ADDR"
+	EnvVaultToken     = "VAULT_TOKEN"
+	EnvVaultNamespace = "VAULT_NAMESPACE"
+)
+
+const (
+	vaultAPI = "/v1"
+
+	headerVaultToken     = "X-Vault-Token"
+	headerVaultNamespace = "X-Vault-Namespace"
+)
+
+var (
+	errVaultMissingConfig = fmt.Errorf("must set %s and %s to read secrets from Vault", EnvVaultAddress, EnvVaultToken)
+	errVaultMissingKey    = errors.New("must specify the key of the Vault secret to read (e.g. vault:kv/realm/prod#apiKey)")
+)
+
+// VaultSource is a HashiCorp Vault KV secrets engine source
+type VaultSource struct {
+	Address   string
+	Token     string
+	Namespace string
+}
+
+// NewVaultSource returns a new HashiCorp Vault source configured from the environment
+func NewVaultSource() VaultSource {
+	return VaultSource{
+		Address:   os.Getenv(EnvVaultAddress),
+		Token:     os.Getenv(EnvVaultToken),
+		Namespace: os.Getenv(EnvVaultNamespace),
+	}
+}
+
+type vaultResponse struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+type vaultResponseV2 struct {
+	Data struct {
+		Data map[string]interface{} `json:"data"`
+	} `json:"data"`
+}
+
+// Value reads the secret value from the KV secrets engine
+func (s VaultSource) Value(ref Reference) (string, error) {
+	if s.Address == "" || s.Token == "" {
+		return "", errVaultMissingConfig
+	}
+	if ref.Key == "" {
+		return "", errVaultMissingKey
+	}
+
+	data, err := s.Data(ref.Path)
+	if err != nil {
+		return "", err
+	}
+	return valueAtKey(data, ref)
+}
+
+// Data reads every key/value pair of the secret at the path from the KV secrets engine,
+// trying the versioned (v2) API first before falling back to the unversioned (v1) API
+func (s VaultSource) Data(path string) (map[string]interface{}, error) {
+	if s.Address == "" || s.Token == "" {
+		return nil, errVaultMissingConfig
+	}
+
+	trimmed := strings.Trim(path, "/")
+
+	if mountIdx := strings.Index(trimmed, "/"); mountIdx != -1 {
+		var resV2 vaultResponseV2
+		found, err := s.get(trimmed[:mountIdx]+"/data"+trimmed[mountIdx:], &resV2)
+		if err != nil && !vaultV1Fallback(err) {
+			return nil, err
+		}
+		if err == nil && found && resV2.Data.Data != nil {
+			return resV2.Data.Data, nil
+		}
+	}
+
+	var res vaultResponse
+	found, err := s.get(trimmed, &res)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("no Vault secret found at '%s'", path)
+	}
+	return res.Data, nil
+}
+
+// vaultV1Fallback returns true if the error from probing the versioned (v2) API
+// still allows the secret to be read from the unversioned (v1) API, as a token
+// scoped to a v1 mount is denied access to, or sent a bad request for, the v2 paths
+func vaultV1Fallback(err error) bool {
+	var statusErr api.ErrUnexpectedStatusCode
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	return statusErr.Actual == http.StatusForbidden || statusErr.Actual == http.StatusBadRequest
+}
+
+func (s VaultSource) get(path string, out interface{}) (bool, error) {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(s.Address, "/")+vaultAPI+"/"+path, nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set(headerVaultToken, s.Token)
+	if s.Namespace != "" {
+		req.Header.Set(headerVaultNamespace, s.Namespace)
+	}
+
+	client := http.Client{Timeout: 20 * time.Second}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return false, api.ErrUnexpectedStatusCode{"read Vault secret", res.StatusCode}
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return false, err
+	}
+	return true, nil
+}
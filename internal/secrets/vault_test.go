@@ -0,0 +1,124 @@
+package secrets
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestVaultSourceValue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(headerVaultToken) != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/kv/data/realm/prod":
+			w.Write([]byte(`{"data":{"data":{"apiKey":"v2-value","port":8080}}}`)) //nolint:errcheck
+		case "/v1/secret/realm/prod":
+			w.Write([]byte(`{"data":{"apiKey":"v1-value"}}`)) //nolint:errcheck
+		case "/v1/restricted/data/realm/prod":
+			w.WriteHeader(http.StatusForbidden)
+		case "/v1/legacy/data/realm/prod":
+			w.WriteHeader(http.StatusBadRequest)
+		case "/v1/restricted/realm/prod", "/v1/legacy/realm/prod":
+			w.Write([]byte(`{"data":{"apiKey":"v1-restricted-value"}}`)) //nolint:errcheck
+		case "/v1/broken/data/realm/prod":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	source := VaultSource{Address: server.URL, Token: "token"}
+
+	for _, tc := range []struct {
+		description string
+		ref         Reference
+		expected    string
+	}{
+		{
+			description: "should read a secret from a versioned kv engine",
+			ref:         Reference{SchemeVault, "kv/realm/prod", "apiKey"},
+			expected:    "v2-value",
+		},
+		{
+			description: "should read a non-string secret as a string",
+			ref:         Reference{SchemeVault, "kv/realm/prod", "port"},
+			expected:    "8080",
+		},
+		{
+			description: "should fall back to reading a secret from an unversioned kv engine",
+			ref:         Reference{SchemeVault, "secret/realm/prod", "apiKey"},
+			expected:    "v1-value",
+		},
+		{
+			description: "should fall back to an unversioned kv engine when the versioned api is forbidden",
+			ref:         Reference{SchemeVault, "restricted/realm/prod", "apiKey"},
+			expected:    "v1-restricted-value",
+		},
+		{
+			description: "should fall back to an unversioned kv engine when the versioned api is a bad request",
+			ref:         Reference{SchemeVault, "legacy/realm/prod", "apiKey"},
+			expected:    "v1-restricted-value",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			value, err := source.Value(tc.ref)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+
+	for _, tc := range []struct {
+		description string
+		source      VaultSource
+		ref         Reference
+		expectedErr error
+	}{
+		{
+			description: "should return an error when vault is not configured",
+			ref:         Reference{SchemeVault, "kv/realm/prod", "apiKey"},
+			expectedErr: errVaultMissingConfig,
+		},
+		{
+			description: "should return an error when the reference has no key",
+			source:      source,
+			ref:         Reference{SchemeVault, "kv/realm/prod", ""},
+			expectedErr: errVaultMissingKey,
+		},
+		{
+			description: "should return an error when the secret does not exist",
+			source:      source,
+			ref:         Reference{SchemeVault, "kv/realm/dev", "apiKey"},
+			expectedErr: errors.New("no Vault secret found at 'kv/realm/dev'"),
+		},
+		{
+			description: "should return an error when the key does not exist",
+			source:      source,
+			ref:         Reference{SchemeVault, "kv/realm/prod", "password"},
+			expectedErr: errors.New("key 'password' not found"),
+		},
+		{
+			description: "should return an error when the token is not authorized",
+			source:      VaultSource{Address: server.URL, Token: "invalid"},
+			ref:         Reference{SchemeVault, "kv/realm/prod", "apiKey"},
+			expectedErr: errors.New("failed to read Vault secret: unexpected status code 403"),
+		},
+		{
+			description: "should return an error when the versioned api fails otherwise",
+			source:      source,
+			ref:         Reference{SchemeVault, "broken/realm/prod", "apiKey"},
+			expectedErr: errors.New("failed to read Vault secret: unexpected status code 500"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := tc.source.Value(tc.ref)
+			assert.Equal(t, tc.expectedErr.Error(), err.Error())
+		})
+	}
+}
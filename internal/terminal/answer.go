@@ -0,0 +1,205 @@
+package terminal
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
+)
+
+// PromptType is the type of a prompt
+type PromptType string
+
+// set of supported prompt types
+const (
+	PromptTypeInput       PromptType = "input"
+	PromptTypePassword    PromptType = "password"
+	PromptTypeConfirm     PromptType = "confirm"
+	PromptTypeSelect      PromptType = "select"
+	PromptTypeMultiSelect PromptType = "multiselect"
+)
+
+// Prompt is a question asked by a command
+type Prompt struct {
+	Type PromptType
+	// Name is the name of the question, which is empty for a standalone prompt
+	Name    string
+	Message string
+	Default string
+	Options []string
+}
+
+// Answerer provides the answers to the prompts of a command, so it can run non-interactively.
+// An empty answer accepts the prompt's default, a confirm is answered with "y" or "n",
+// a select with one of its options and a multiselect with a comma-separated list of its options
+type Answerer interface {
+	Answer(prompt Prompt) (string, error)
+}
+
+// SelectPrompt is a prompt shown as a select, which is answered as its select prompt
+// when the UI is not interactive
+type SelectPrompt interface {
+	SelectPrompt() *survey.Select
+}
+
+// AnswererFunc is an Answerer implemented by a function
+type AnswererFunc func(prompt Prompt) (string, error)
+
+// Answer returns the answer to the prompt
+func (fn AnswererFunc) Answer(prompt Prompt) (string, error) { return fn(prompt) }
+
+// NewAnswerUI creates a new terminal UI whose prompts are answered by the answerer
+// instead of being read from the terminal input
+func NewAnswerUI(config UIConfig, answerer Answerer, out, err io.Writer) UI {
+	return &answerUI{NewUI(config, nil, out, err).(*ui), answerer}
+}
+
+type answerUI struct {
+	*ui
+	answerer Answerer
+}
+
+func (ui *answerUI) Ask(answer interface{}, questions ...*survey.Question) error {
+	for _, q := range questions {
+		ans, err := ui.answer(q.Name, q.Prompt)
+		if err != nil {
+			return err
+		}
+		if q.Validate != nil {
+			if err := q.Validate(ans); err != nil {
+				return err
+			}
+		}
+		if q.Transform != nil {
+			if transformed := q.Transform(ans); transformed != nil {
+				ans = transformed
+			}
+		}
+		if err := core.WriteAnswer(answer, q.Name, ans); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ui *answerUI) AskOne(answer interface{}, prompt survey.Prompt) error {
+	return ui.Ask(answer, &survey.Question{Prompt: prompt})
+}
+
+func (ui *answerUI) Confirm(format string, args ...interface{}) (bool, error) {
+	if ui.AutoConfirm() {
+		return true, nil
+	}
+
+	var proceed bool
+	return proceed, ui.AskOne(
+		&proceed,
+		&survey.Confirm{Message: fmt.Sprintf(format, args...)},
+	)
+}
+
+// answer gets the answer to the prompt from the answerer, in the form survey would have answered it
+func (ui *answerUI) answer(name string, prompt survey.Prompt) (interface{}, error) {
+	if p, ok := prompt.(SelectPrompt); ok {
+		prompt = p.SelectPrompt()
+	}
+
+	switch p := prompt.(type) {
+	case *survey.Input:
+		return ui.ask(Prompt{Type: PromptTypeInput, Name: name, Message: p.Message, Default: p.Default})
+
+	case *survey.Password:
+		return ui.ask(Prompt{Type: PromptTypePassword, Name: name, Message: p.Message})
+
+	case *survey.Confirm:
+		def := "n"
+		if p.Default {
+			def = "y"
+		}
+		ans, err := ui.ask(Prompt{Type: PromptTypeConfirm, Name: name, Message: p.Message, Default: def})
+		if err != nil {
+			return nil, err
+		}
+		switch strings.ToLower(ans) {
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		if proceed, err := strconv.ParseBool(ans); err == nil {
+			return proceed, nil
+		}
+		return nil, fmt.Errorf("invalid answer '%s' to '%s', use either y or n", ans, p.Message)
+
+	case *survey.Select:
+		var def string
+		switch d := p.Default.(type) {
+		case string:
+			def = d
+		case int:
+			if d >= 0 && d < len(p.Options) {
+				def = p.Options[d]
+			}
+		}
+		ans, err := ui.ask(Prompt{Type: PromptTypeSelect, Name: name, Message: p.Message, Default: def, Options: p.Options})
+		if err != nil {
+			return nil, err
+		}
+		return optionAnswer(p.Message, p.Options, ans)
+
+	case *survey.MultiSelect:
+		var defs []string
+		switch d := p.Default.(type) {
+		case []string:
+			defs = d
+		case []int:
+			for _, idx := range d {
+				if idx >= 0 && idx < len(p.Options) {
+					defs = append(defs, p.Options[idx])
+				}
+			}
+		}
+		ans, err := ui.ask(Prompt{Type: PromptTypeMultiSelect, Name: name, Message: p.Message, Default: strings.Join(defs, ","), Options: p.Options})
+		if err != nil {
+			return nil, err
+		}
+		options := []core.OptionAnswer{}
+		for _, value := range strings.Split(ans, ",") {
+			if value = strings.TrimSpace(value); value == "" {
+				continue
+			}
+			option, err := optionAnswer(p.Message, p.Options, value)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, option)
+		}
+		return options, nil
+	}
+
+	return nil, fmt.Errorf("cannot answer prompt of type %T non-interactively", prompt)
+}
+
+// ask gets the answer to the prompt from the answerer, falling back to the prompt's default
+func (ui *answerUI) ask(prompt Prompt) (string, error) {
+	ans, err := ui.answerer.Answer(prompt)
+	if err != nil {
+		return "", err
+	}
+	if ans == "" {
+		return prompt.Default, nil
+	}
+	return ans, nil
+}
+
+func optionAnswer(message string, options []string, value string) (core.OptionAnswer, error) {
+	for i, option := range options {
+		if option == value {
+			return core.OptionAnswer{Value: option, Index: i}, nil
+		}
+	}
+	return core.OptionAnswer{}, fmt.Errorf("invalid answer '%s' to '%s', use one of: %s", value, message, strings.Join(options, ", "))
+}
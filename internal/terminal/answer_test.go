@@ -0,0 +1,150 @@
+package terminal_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+func TestAnswerUI(t *testing.T) {
+	answers := func(answers map[string]string) (*[]terminal.Prompt, terminal.Answerer) {
+		var prompts []terminal.Prompt
+		return &prompts, terminal.AnswererFunc(func(prompt terminal.Prompt) (string, error) {
+			prompts = append(prompts, prompt)
+			return answers[prompt.Message], nil
+		})
+	}
+
+	t.Run("should answer each type of prompt", func(t *testing.T) {
+		prompts, answerer := answers(map[string]string{
+			"Name":     "eggcorn",
+			"Password": "p4ssw0rd",
+			"Region":   "US-VA",
+			"Types":    "function, trigger",
+		})
+
+		ui := terminal.NewAnswerUI(terminal.UIConfig{}, answerer, new(bytes.Buffer), new(bytes.Buffer))
+
+		var answer struct {
+			Name     string
+			Password string
+			Region   string
+			Types    []string
+			Deploy   bool
+		}
+		assert.Nil(t, ui.Ask(&answer,
+			&survey.Question{Name: "name", Prompt: &survey.Input{Message: "Name"}},
+			&survey.Question{Name: "password", Prompt: &survey.Password{Message: "Password"}},
+			&survey.Question{Name: "region", Prompt: &survey.Select{Message: "Region", Options: []string{"US-OR", "US-VA"}}},
+			&survey.Question{Name: "types", Prompt: &survey.MultiSelect{Message: "Types", Options: []string{"auth", "function", "trigger"}}},
+			&survey.Question{Name: "deploy", Prompt: &survey.Confirm{Message: "Deploy", Default: true}},
+		))
+
+		assert.Equal(t, "eggcorn", answer.Name)
+		assert.Equal(t, "p4ssw0rd", answer.Password)
+		assert.Equal(t, "US-VA", answer.Region)
+		assert.Equal(t, []string{"function", "trigger"}, answer.Types)
+		assert.True(t, answer.Deploy, "expected the confirm to default to true")
+
+		assert.Equal(t, []terminal.Prompt{
+			{Type: terminal.PromptTypeInput, Name: "name", Message: "Name"},
+			{Type: terminal.PromptTypePassword, Name: "password", Message: "Password"},
+			{Type: terminal.PromptTypeSelect, Name: "region", Message: "Region", Options: []string{"US-OR", "US-VA"}},
+			{Type: terminal.PromptTypeMultiSelect, Name: "types", Message: "Types", Options: []string{"auth", "function", "trigger"}},
+			{Type: terminal.PromptTypeConfirm, Name: "deploy", Message: "Deploy", Default: "y"},
+		}, *prompts)
+	})
+
+	t.Run("should use the default of a select when the answer is empty", func(t *testing.T) {
+		_, answerer := answers(nil)
+
+		ui := terminal.NewAnswerUI(terminal.UIConfig{}, answerer, new(bytes.Buffer), new(bytes.Buffer))
+
+		var region string
+		assert.Nil(t, ui.AskOne(&region, &survey.Select{Message: "Region", Options: []string{"US-OR", "US-VA"}, Default: 1}))
+		assert.Equal(t, "US-VA", region)
+	})
+
+	t.Run("should confirm with the answer", func(t *testing.T) {
+		_, answerer := answers(map[string]string{"Are you sure?": "yes"})
+
+		ui := terminal.NewAnswerUI(terminal.UIConfig{}, answerer, new(bytes.Buffer), new(bytes.Buffer))
+
+		proceed, err := ui.Confirm("Are you sure?")
+		assert.Nil(t, err)
+		assert.True(t, proceed, "expected the confirm to proceed")
+	})
+
+	t.Run("should auto confirm without asking the answerer", func(t *testing.T) {
+		prompts, answerer := answers(nil)
+
+		ui := terminal.NewAnswerUI(terminal.UIConfig{AutoConfirm: true}, answerer, new(bytes.Buffer), new(bytes.Buffer))
+
+		proceed, err := ui.Confirm("Are you sure?")
+		assert.Nil(t, err)
+		assert.True(t, proceed, "expected the confirm to proceed")
+		assert.Equal(t, 0, len(*prompts))
+	})
+
+	t.Run("should print to the writers", func(t *testing.T) {
+		_, answerer := answers(nil)
+
+		out := new(bytes.Buffer)
+		ui := terminal.NewAnswerUI(terminal.UIConfig{}, answerer, out, new(bytes.Buffer))
+
+		ui.Print(terminal.NewTextLog("test log"))
+		assert.Equal(t, "test log\n", out.String())
+	})
+
+	for _, tc := range []struct {
+		description string
+		answerer    terminal.Answerer
+		question    *survey.Question
+		expectedErr error
+	}{
+		{
+			description: "the answerer fails",
+			answerer: terminal.AnswererFunc(func(prompt terminal.Prompt) (string, error) {
+				return "", errors.New("something bad happened")
+			}),
+			question:    &survey.Question{Prompt: &survey.Input{Message: "Name"}},
+			expectedErr: errors.New("something bad happened"),
+		},
+		{
+			description: "the answer is not an option",
+			answerer: terminal.AnswererFunc(func(prompt terminal.Prompt) (string, error) {
+				return "EU-IE", nil
+			}),
+			question:    &survey.Question{Prompt: &survey.Select{Message: "Region", Options: []string{"US-OR", "US-VA"}}},
+			expectedErr: errors.New("invalid answer 'EU-IE' to 'Region', use one of: US-OR, US-VA"),
+		},
+		{
+			description: "the answer to a confirm is not a yes or no",
+			answerer: terminal.AnswererFunc(func(prompt terminal.Prompt) (string, error) {
+				return "maybe", nil
+			}),
+			question:    &survey.Question{Prompt: &survey.Confirm{Message: "Deploy"}},
+			expectedErr: errors.New("invalid answer 'maybe' to 'Deploy', use either y or n"),
+		},
+		{
+			description: "the answer fails validation",
+			answerer: terminal.AnswererFunc(func(prompt terminal.Prompt) (string, error) {
+				return "", nil
+			}),
+			question:    &survey.Question{Prompt: &survey.Input{Message: "Name"}, Validate: survey.Required},
+			expectedErr: errors.New("Value is required"),
+		},
+	} {
+		t.Run("should return an error when "+tc.description, func(t *testing.T) {
+			ui := terminal.NewAnswerUI(terminal.UIConfig{}, tc.answerer, new(bytes.Buffer), new(bytes.Buffer))
+
+			var answer interface{}
+			assert.Equal(t, tc.expectedErr, ui.Ask(&answer, tc.question))
+		})
+	}
+}
@@ -20,6 +20,12 @@ const (
 	FlagOutputFormatShort = "f"
 	FlagOutputFormatUsage = "set the output format, available options: [json]"
 
+	FlagFormatTemplate      = "format-template"
+	FlagFormatTemplateUsage = "set the filepath of a Go template to apply to each row of listed output"
+
+	FlagQuery      = "query"
+	FlagQueryUsage = "set a JMESPath query to apply to the JSON output, which implies --output-format json"
+
 	FlagOutputTarget      = "output-target"
 	FlagOutputTargetShort = "o"
 	FlagOutputTargetUsage = "write output to the specified filepath"
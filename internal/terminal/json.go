@@ -1,8 +1,10 @@
 package terminal
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 )
 
 const (
@@ -32,3 +34,33 @@ func (j jsonDocument) Payload() ([]string, map[string]interface{}, error) {
 		logFieldDoc:     j.data,
 	}, nil
 }
+
+// Unordered marks the provided array or slice as having no meaningful order,
+// so its elements are sorted by their JSON encoding to keep the JSON output stable
+func Unordered(values interface{}) interface{} {
+	return unorderedArray{values}
+}
+
+type unorderedArray struct {
+	values interface{}
+}
+
+func (a unorderedArray) MarshalJSON() ([]byte, error) {
+	raw, err := json.Marshal(a.values)
+	if err != nil {
+		return nil, err
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(raw, &elements); err != nil {
+		return nil, err
+	}
+	if elements == nil {
+		return raw, nil // keeps a nil slice as null
+	}
+
+	sort.SliceStable(elements, func(i, j int) bool {
+		return bytes.Compare(elements[i], elements[j]) < 0
+	})
+	return json.Marshal(elements)
+}
@@ -49,6 +49,29 @@ func (l list) Payload() ([]string, map[string]interface{}, error) {
 	}, nil
 }
 
+// unorderedList is a list whose items have no meaningful order,
+// so they are sorted in its payload
+type unorderedList struct {
+	list
+}
+
+func (l unorderedList) Payload() ([]string, map[string]interface{}, error) {
+	fields, payload, err := l.list.Payload()
+	if err != nil {
+		return nil, nil, err
+	}
+	payload[logFieldData] = Unordered(l.data)
+	return fields, payload, nil
+}
+
+func (l list) templateRows() []interface{} {
+	rows := make([]interface{}, 0, len(l.data))
+	for _, item := range l.data {
+		rows = append(rows, item)
+	}
+	return rows
+}
+
 func (l list) dataString() string {
 	data := make([]string, 0, len(l.data))
 	for _, item := range l.data {
@@ -1,6 +1,7 @@
 package terminal
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -70,6 +71,17 @@ func TestList(t *testing.T) {
 		})
 	})
 
+	t.Run("Payload should sort the items of an unordered list", func(*testing.T) {
+		testList := unorderedList{newList("a list message", []interface{}{"c", "a", "b"}, false)}
+
+		_, payloadData, err := testList.Payload()
+		assert.Nil(t, err)
+
+		data, err := json.Marshal(payloadData["data"])
+		assert.Nil(t, err)
+		assert.Equal(t, `["a","b","c"]`, string(data))
+	})
+
 	t.Run("Message should display single item lists the same line as the message with consolidated set to true", func(*testing.T) {
 		testList := newList(MsgReferenceLinks, []interface{}{"https://mongodb.com"}, true)
 
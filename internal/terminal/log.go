@@ -42,7 +42,8 @@ func NewTextLog(format string, args ...interface{}) Log {
 	return newLog(LogLevelInfo, newTextMessage(format, args...))
 }
 
-// NewJSONLog creates a new log with a JSON document
+// NewJSONLog creates a new log with a JSON document, whose arrays keep their order
+// unless they are marked with Unordered
 func NewJSONLog(message string, data interface{}) Log {
 	return newLog(LogLevelInfo, jsonDocument{message, data})
 }
@@ -52,11 +53,23 @@ func NewTableLog(message string, headers []string, data ...map[string]interface{
 	return newLog(LogLevelInfo, newTable(message, headers, data))
 }
 
+// NewUnorderedTableLog creates a new log with a table whose rows have no meaningful order,
+// so they are sorted in the JSON output to keep it stable
+func NewUnorderedTableLog(message string, headers []string, data ...map[string]interface{}) Log {
+	return newLog(LogLevelInfo, unorderedTable{newTable(message, headers, data)})
+}
+
 // NewListLog creates a new log with a list
 func NewListLog(message string, data ...interface{}) Log {
 	return newLog(LogLevelInfo, newList(message, data, false))
 }
 
+// NewUnorderedListLog creates a new log with a list whose items have no meaningful order,
+// so they are sorted in the JSON output to keep it stable
+func NewUnorderedListLog(message string, data ...interface{}) Log {
+	return newLog(LogLevelInfo, unorderedList{newList(message, data, false)})
+}
+
 // NewErrorLog creates a new error log
 func NewErrorLog(err error) Log {
 	return newLog(LogLevelError, errorMessage{err})
@@ -64,7 +77,7 @@ func NewErrorLog(err error) Log {
 
 // NewWarningLog creates a new warning log
 func NewWarningLog(format string, args ...interface{}) Log {
-	return newLog(LogLevelWarn, newTextMessage(format, args...))
+	return newLog(LogLevelWarn, warningMessage{newTextMessage(format, args...)})
 }
 
 // NewFollowupLog creates a new log with a consolidated list of followup items
@@ -76,6 +89,7 @@ func NewFollowupLog(message string, items ...interface{}) Log {
 const (
 	MsgReferenceLinks string = "For more information"
 	MsgSuggestions    string = "Try instead"
+	MsgTraceID        string = "Share this trace ID when reporting the failure"
 )
 
 func newLog(level LogLevel, data LogData) Log {
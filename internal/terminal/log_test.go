@@ -15,6 +15,8 @@ func TestLogConstructor(t *testing.T) {
 	assert.RegisterOpts(reflect.TypeOf(jsonDocument{}), cmp.AllowUnexported(jsonDocument{}))
 	assert.RegisterOpts(reflect.TypeOf(table{}), cmp.AllowUnexported(table{}))
 	assert.RegisterOpts(reflect.TypeOf(list{}), cmp.AllowUnexported(list{}))
+	assert.RegisterOpts(reflect.TypeOf(warningMessage{}), cmp.AllowUnexported(warningMessage{}))
+	assert.RegisterOpts(reflect.TypeOf(warningSummary{}), cmp.AllowUnexported(warningSummary{}))
 
 	for _, tc := range []struct {
 		ctor          string
@@ -52,6 +54,18 @@ func TestLogConstructor(t *testing.T) {
 			expectedLevel: LogLevelError,
 			expectedData:  errorMessage{errors.New("oh noz")},
 		},
+		{
+			ctor:          "NewWarningLog",
+			log:           NewWarningLog("careful %s", "now"),
+			expectedLevel: LogLevelWarn,
+			expectedData:  warningMessage{textMessage("careful now")},
+		},
+		{
+			ctor:          "NewWarningSummaryLog",
+			log:           NewWarningSummaryLog([]string{"careful now"}),
+			expectedLevel: LogLevelWarn,
+			expectedData:  warningSummary{[]string{"careful now"}},
+		},
 	} {
 		t.Run(fmt.Sprintf("%s should create the expected Log", tc.ctor), func(t *testing.T) {
 			time.Sleep(1 * time.Millisecond) // force tick
@@ -89,6 +103,48 @@ func TestLogMessage(t *testing.T) {
 				OutputFormatJSON: `{"time":"1989-06-22T07:54:00Z","level":"info","message":"a json document","doc":{"a":true,"b":1,"c":"sea"}}`,
 			},
 		},
+		{
+			level: LogLevelInfo,
+			data: jsonDocument{"a json document with unordered arrays", struct {
+				Name  string      `json:"name"`
+				Tags  interface{} `json:"tags"`
+				Steps []string    `json:"steps"`
+				Items interface{} `json:"items"`
+				Count int64       `json:"count"`
+			}{
+				"doc",
+				Unordered([]string{"c", "a", "b"}),
+				[]string{"c", "a", "b"},
+				Unordered([]interface{}{map[string]interface{}{"z": 2}, map[string]interface{}{"z": 1}}),
+				9007199254740993,
+			}},
+			expectedOutputs: map[OutputFormat]string{
+				OutputFormatText: `a json document with unordered arrays
+{
+  "name": "doc",
+  "tags": [
+    "a",
+    "b",
+    "c"
+  ],
+  "steps": [
+    "c",
+    "a",
+    "b"
+  ],
+  "items": [
+    {
+      "z": 1
+    },
+    {
+      "z": 2
+    }
+  ],
+  "count": 9007199254740993
+}`,
+				OutputFormatJSON: `{"time":"1989-06-22T07:54:00Z","level":"info","message":"a json document with unordered arrays","doc":{"name":"doc","tags":["a","b","c"],"steps":["c","a","b"],"items":[{"z":1},{"z":2}],"count":9007199254740993}}`,
+			},
+		},
 		{
 			level: LogLevelError,
 			data:  errorMessage{errors.New("something bad happened")},
@@ -97,6 +153,24 @@ func TestLogMessage(t *testing.T) {
 				OutputFormatJSON: `{"time":"1989-06-22T07:54:00Z","level":"error","err":"something bad happened"}`,
 			},
 		},
+		{
+			level: LogLevelWarn,
+			data:  warningMessage{textMessage("field 'foo' is deprecated")},
+			expectedOutputs: map[OutputFormat]string{
+				OutputFormatText: "Warning: field 'foo' is deprecated",
+				OutputFormatJSON: `{"time":"1989-06-22T07:54:00Z","level":"warn","message":"field 'foo' is deprecated"}`,
+			},
+		},
+		{
+			level: LogLevelWarn,
+			data:  warningSummary{[]string{"field 'foo' is deprecated", "skipped file 'bar.txt'"}},
+			expectedOutputs: map[OutputFormat]string{
+				OutputFormatText: `Finished with 2 warning(s)
+  field 'foo' is deprecated
+  skipped file 'bar.txt'`,
+				OutputFormatJSON: `{"time":"1989-06-22T07:54:00Z","level":"warn","message":"Finished with 2 warning(s)","warnings":["field 'foo' is deprecated","skipped file 'bar.txt'"]}`,
+			},
+		},
 	} {
 		for outputFormat, expectedOutput := range tc.expectedOutputs {
 			t.Run(fmt.Sprintf("With %s output format, %T should print the expected output", outputFormat, tc.data), func(t *testing.T) {
@@ -0,0 +1,38 @@
+package terminal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+// CompileQuery compiles the JMESPath query applied to the JSON output of each log
+func CompileQuery(query string) (*jmespath.JMESPath, error) {
+	return jmespath.Compile(query)
+}
+
+// queryLog produces the JSON output of the log with the query applied to it,
+// or an empty output if the query matches nothing in the log
+func (l Log) queryLog(query *jmespath.JMESPath) (string, error) {
+	output, err := l.jsonOutput()
+	if err != nil {
+		return "", err
+	}
+
+	var data interface{}
+	if err := json.Unmarshal([]byte(output), &data); err != nil {
+		return "", err
+	}
+
+	result, err := query.Search(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to apply query: %w", err)
+	}
+	if result == nil {
+		return "", nil
+	}
+
+	queried, err := json.Marshal(result)
+	return string(queried), err
+}
@@ -0,0 +1,64 @@
+package terminal_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestUIPrintQuery(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		query       string
+		log         terminal.Log
+		expectedOut string
+		expectedErr string
+	}{
+		{
+			description: "Should apply the query to the json output of a table",
+			query:       "data[].Name",
+			log: terminal.NewTableLog("Found 2 rows", []string{"Name", "ID"},
+				map[string]interface{}{"Name": "one", "ID": "1"},
+				map[string]interface{}{"Name": "two", "ID": "2"},
+			),
+			expectedOut: `["one","two"]` + "\n",
+		},
+		{
+			description: "Should apply the query to the json output of a text log",
+			query:       "message",
+			log:         terminal.NewTextLog("No available apps to show"),
+			expectedOut: `"No available apps to show"` + "\n",
+		},
+		{
+			description: "Should print nothing when the query matches nothing in the log",
+			query:       "data[].Name",
+			log:         terminal.NewTextLog("No available apps to show"),
+		},
+		{
+			description: "Should not apply the query to error logs",
+			query:       "data[].Name",
+			log:         terminal.NewErrorLog(errors.New("something bad happened")),
+			expectedErr: `"err":"something bad happened"`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			expr, err := terminal.CompileQuery(tc.query)
+			assert.Nil(t, err)
+
+			out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+			ui := terminal.NewUI(terminal.UIConfig{OutputFormat: terminal.OutputFormatJSON, QueryExpr: expr}, nil, out, errOut)
+
+			ui.Print(tc.log)
+			assert.Equal(t, tc.expectedOut, out.String())
+			assert.True(t, bytes.Contains(errOut.Bytes(), []byte(tc.expectedErr)), "expected error output to contain %s but got: %s", tc.expectedErr, errOut.String())
+		})
+	}
+
+	t.Run("Should return an error for an invalid query", func(t *testing.T) {
+		_, err := terminal.CompileQuery("data[")
+		assert.NotNil(t, err)
+	})
+}
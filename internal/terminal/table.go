@@ -84,6 +84,29 @@ func (t table) Payload() ([]string, map[string]interface{}, error) {
 	}, nil
 }
 
+// unorderedTable is a table whose rows have no meaningful order,
+// so they are sorted in its payload
+type unorderedTable struct {
+	table
+}
+
+func (t unorderedTable) Payload() ([]string, map[string]interface{}, error) {
+	fields, payload, err := t.table.Payload()
+	if err != nil {
+		return nil, nil, err
+	}
+	payload[logFieldData] = Unordered(t.data)
+	return fields, payload, nil
+}
+
+func (t table) templateRows() []interface{} {
+	rows := make([]interface{}, 0, len(t.data))
+	for _, row := range t.data {
+		rows = append(rows, row)
+	}
+	return rows
+}
+
 func (t table) validate() error {
 	if len(t.headers) == 0 {
 		return errors.New("cannot create a table without headers")
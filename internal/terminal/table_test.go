@@ -1,6 +1,7 @@
 package terminal
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -259,6 +260,20 @@ func TestTablePayload(t *testing.T) {
 		assert.Equal(t, headers, payloadData[logFieldHeaders])
 		assert.Equal(t, data, payloadData[logFieldData])
 	})
+
+	t.Run("Payload should sort the rows of an unordered table", func(t *testing.T) {
+		table := unorderedTable{newTable("a table message", []string{"test", "this"}, []map[string]interface{}{
+			{"test": 123, "this": "456"},
+			{"test": 12, "this": "789"},
+		})}
+
+		_, payloadData, err := table.Payload()
+		assert.Nil(t, err)
+
+		data, err := json.Marshal(payloadData[logFieldData])
+		assert.Nil(t, err)
+		assert.Equal(t, `[{"test":"12","this":"789"},{"test":"123","this":"456"}]`, string(data))
+	})
 }
 
 func TestParseValue(t *testing.T) {
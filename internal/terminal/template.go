@@ -0,0 +1,68 @@
+package terminal
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// templateRower is implemented by log data which can be rendered a row at a time by a format template
+type templateRower interface {
+	templateRows() []interface{}
+}
+
+// rowsData is log data whose format template rows are provided by the caller
+type rowsData struct {
+	LogData
+	rows []interface{}
+}
+
+func (d rowsData) templateRows() []interface{} {
+	return d.rows
+}
+
+// WithRows sets the values each row of the log is rendered from when a format template is used,
+// in place of the rows displayed by the log itself
+func (l Log) WithRows(rows ...interface{}) Log {
+	if data, ok := l.Data.(rowsData); ok {
+		l.Data = data.LogData
+	}
+	l.Data = rowsData{l.Data, rows}
+	return l
+}
+
+// ParseFormatTemplate parses the Go template at the specified path,
+// which is applied to each row of the logs that display rows
+func ParseFormatTemplate(path string) (*template.Template, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return template.New(filepath.Base(path)).Option("missingkey=zero").Parse(string(data))
+}
+
+// templateLog produces the log output by applying the template to each of its rows,
+// or the text output if the log has no rows
+func (l Log) templateLog(tmpl *template.Template) (string, error) {
+	rower, ok := l.Data.(templateRower)
+	if !ok {
+		return l.textLog()
+	}
+
+	rows := rower.templateRows()
+	if len(rows) == 0 {
+		return l.textLog()
+	}
+
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, row); err != nil {
+			return "", err
+		}
+		lines = append(lines, strings.TrimRight(buf.String(), "\n"))
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,100 @@
+package terminal_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/10gen/realm-cli/internal/terminal"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestUIPrintFormatTemplate(t *testing.T) {
+	type app struct {
+		ID   string
+		Name string
+	}
+
+	for _, tc := range []struct {
+		description string
+		template    string
+		log         terminal.Log
+		expectedOut string
+	}{
+		{
+			description: "Should apply the template to each row of a table",
+			template:    "{{.Name}}={{index . \"Last Seen\"}}",
+			log: terminal.NewTableLog("Found 2 rows", []string{"Name", "Last Seen"},
+				map[string]interface{}{"Name": "one", "Last Seen": 1},
+				map[string]interface{}{"Name": "two", "Last Seen": 2},
+			),
+			expectedOut: "one=1\ntwo=2\n",
+		},
+		{
+			description: "Should apply the template to each item of a list",
+			template:    "- {{.}}\n",
+			log:         terminal.NewListLog("Found 2 items", "one", "two"),
+			expectedOut: "- one\n- two\n",
+		},
+		{
+			description: "Should apply the template to the rows provided with the log",
+			template:    "{{.ID}}\t{{.Name}}",
+			log:         terminal.NewListLog("Found 1 app", "app - appID").WithRows(app{"appID", "app"}),
+			expectedOut: "appID\tapp\n",
+		},
+		{
+			description: "Should print logs without rows as text",
+			template:    "{{.Name}}",
+			log:         terminal.NewTextLog("No available apps to show"),
+			expectedOut: "No available apps to show\n",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			tmpl, err := template.New("test").Parse(tc.template)
+			assert.Nil(t, err)
+
+			out := new(bytes.Buffer)
+			ui := terminal.NewUI(terminal.UIConfig{Template: tmpl}, nil, out, out)
+
+			ui.Print(tc.log)
+			assert.Equal(t, tc.expectedOut, out.String())
+		})
+	}
+
+	t.Run("Should ignore the template with json output", func(t *testing.T) {
+		tmpl, err := template.New("test").Parse("{{.}}")
+		assert.Nil(t, err)
+
+		out := new(bytes.Buffer)
+		ui := terminal.NewUI(terminal.UIConfig{OutputFormat: terminal.OutputFormatJSON, Template: tmpl}, nil, out, out)
+
+		ui.Print(terminal.NewListLog("Found 1 item", "one"))
+		assert.True(t, bytes.Contains(out.Bytes(), []byte(`"data":["one"]`)), "expected json output but got: %s", out.String())
+	})
+}
+
+func TestParseFormatTemplate(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("")
+	assert.Nil(t, err)
+	defer teardown()
+
+	t.Run("Should parse the template file", func(t *testing.T) {
+		path := filepath.Join(tmpDir, "row.tmpl")
+		assert.Nil(t, ioutil.WriteFile(path, []byte("{{.Name}}\n"), 0666))
+
+		tmpl, err := terminal.ParseFormatTemplate(path)
+		assert.Nil(t, err)
+		assert.Equal(t, "row.tmpl", tmpl.Name())
+	})
+
+	t.Run("Should return an error for an invalid template", func(t *testing.T) {
+		path := filepath.Join(tmpDir, "invalid.tmpl")
+		assert.Nil(t, ioutil.WriteFile(path, []byte("{{.Name"), 0666))
+
+		_, err := terminal.ParseFormatTemplate(path)
+		assert.NotNil(t, err)
+	})
+}
@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"text/template"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/briandowns/spinner"
 	"github.com/fatih/color"
+	"github.com/jmespath/go-jmespath"
 )
 
 // UI is a terminal UI
@@ -16,6 +20,8 @@ type UI interface {
 	AskOne(answer interface{}, prompt survey.Prompt) error
 	Confirm(format string, args ...interface{}) (bool, error)
 	Print(logs ...Log)
+	Spinner(message string) *spinner.Spinner
+	Warnings() []string
 }
 
 // NewUI creates a new terminal UI
@@ -31,14 +37,16 @@ func NewUI(config UIConfig, in io.Reader, out, err io.Writer) UI {
 		fdReader{in},
 		fdWriter{out},
 		err,
+		nil,
 	}
 }
 
 type ui struct {
-	config UIConfig
-	in     fdReader
-	out    fdWriter
-	err    io.Writer
+	config   UIConfig
+	in       fdReader
+	out      fdWriter
+	err      io.Writer
+	warnings []string
 }
 
 func (ui *ui) AutoConfirm() bool {
@@ -75,15 +83,22 @@ func (ui *ui) Confirm(format string, args ...interface{}) (bool, error) {
 
 func (ui *ui) Print(logs ...Log) {
 	for _, l := range logs {
-		output, err := l.Print(ui.config.OutputFormat)
+		output, err := ui.print(l)
 		if err != nil {
 			ui.Print(NewErrorLog(err))
 			return
 		}
+		if output == "" && ui.config.QueryExpr != nil {
+			continue // the query matched nothing in the log
+		}
+
+		if warning, ok := l.Data.(warningMessage); ok {
+			ui.warnings = append(ui.warnings, string(warning.textMessage))
+		}
 
 		var writer io.Writer
 		switch l.Level {
-		case LogLevelError:
+		case LogLevelError, LogLevelWarn:
 			writer = ui.err
 		default:
 			writer = ui.out
@@ -95,12 +110,46 @@ func (ui *ui) Print(logs ...Log) {
 	}
 }
 
+// Spinner creates a spinner showing the message, which is written to the error writer
+// so the spinner never mixes with the command output
+func (ui *ui) Spinner(message string) *spinner.Spinner {
+	s := spinner.New(SpinnerCircles, 250*time.Millisecond, spinner.WithWriter(ui.err))
+	if message != "" {
+		s.Suffix = " " + message
+	}
+	return s
+}
+
+// Warnings returns the messages of the warning logs printed so far,
+// which commands use to report non-fatal issues
+func (ui *ui) Warnings() []string {
+	return ui.warnings
+}
+
+func (ui *ui) print(l Log) (string, error) {
+	if ui.config.QueryExpr != nil && ui.config.OutputFormat == OutputFormatJSON && l.Level != LogLevelError && l.Level != LogLevelWarn {
+		return l.queryLog(ui.config.QueryExpr)
+	}
+	if ui.config.Template != nil && ui.config.OutputFormat == OutputFormatText {
+		return l.templateLog(ui.config.Template)
+	}
+	return l.Print(ui.config.OutputFormat)
+}
+
 // UIConfig holds the global config for the CLI ui
 type UIConfig struct {
-	AutoConfirm   bool
-	DisableColors bool
-	OutputFormat  OutputFormat
-	OutputTarget  string
+	AutoConfirm    bool
+	DisableColors  bool
+	OutputFormat   OutputFormat
+	OutputTarget   string
+	FormatTemplate string
+	Query          string
+
+	// Template is the parsed FormatTemplate
+	Template *template.Template
+
+	// QueryExpr is the compiled Query
+	QueryExpr *jmespath.JMESPath
 }
 
 // FileDescriptor is a file descriptor
@@ -28,10 +28,15 @@ func TestUIPrint(t *testing.T) {
 				log:         terminal.NewErrorLog(errors.New("something bad happened")),
 				expectedErr: "something bad happened\n",
 			},
+			{
+				description: "Should use the error writer while printing a WARN log",
+				log:         terminal.NewWarningLog("careful now"),
+				expectedErr: "Warning: careful now\n",
+			},
 		} {
 			t.Run(tc.description, func(t *testing.T) {
 				out, err := new(bytes.Buffer), new(bytes.Buffer)
-				ui := terminal.NewUI(terminal.UIConfig{}, nil, out, err)
+				ui := terminal.NewUI(terminal.UIConfig{DisableColors: true}, nil, out, err)
 
 				tc.log.Time = mock.StaticTime
 				ui.Print(tc.log)
@@ -41,4 +46,33 @@ func TestUIPrint(t *testing.T) {
 			})
 		}
 	})
+	t.Run("Should record the messages of the warning logs", func(t *testing.T) {
+		ui := terminal.NewUI(terminal.UIConfig{}, nil, new(bytes.Buffer), new(bytes.Buffer))
+
+		ui.Print(
+			terminal.NewWarningLog("first warning"),
+			terminal.NewTextLog("not a warning"),
+			terminal.NewWarningLog("second warning"),
+			terminal.NewWarningSummaryLog([]string{"first warning", "second warning"}),
+		)
+
+		assert.Equal(t, []string{"first warning", "second warning"}, ui.Warnings())
+	})
+}
+
+func TestUISpinner(t *testing.T) {
+	t.Run("Should write the spinner to the error writer", func(t *testing.T) {
+		out, err := new(bytes.Buffer), new(bytes.Buffer)
+
+		ui := terminal.NewUI(terminal.UIConfig{}, nil, out, err)
+
+		s := ui.Spinner("Working...")
+		assert.Equal(t, " Working...", s.Suffix)
+
+		s.Start()
+		s.Stop()
+
+		assert.Equal(t, "", out.String())
+		assert.True(t, err.Len() > 0, "expected the spinner to write to the error writer")
+	})
 }
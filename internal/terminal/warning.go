@@ -0,0 +1,61 @@
+package terminal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+const (
+	logFieldWarnings = "warnings"
+
+	warningPrefix = "Warning: "
+)
+
+var (
+	warningSummaryFields = []string{logFieldMessage, logFieldWarnings}
+)
+
+// warningMessage is a text message for a non-fatal issue, displayed distinctly from info logs
+type warningMessage struct {
+	textMessage
+}
+
+func (w warningMessage) Message() (string, error) {
+	return color.YellowString(warningPrefix) + string(w.textMessage), nil
+}
+
+// warningSummary consolidates the warnings printed while a command ran
+type warningSummary struct {
+	warnings []string
+}
+
+// NewWarningSummaryLog creates a new log which summarizes the warnings printed while a command ran
+func NewWarningSummaryLog(warnings []string) Log {
+	return newLog(LogLevelWarn, warningSummary{warnings})
+}
+
+func (s warningSummary) message() string {
+	return fmt.Sprintf("Finished with %d warning(s)", len(s.warnings))
+}
+
+func (s warningSummary) Message() (string, error) {
+	lines := make([]string, 0, len(s.warnings)+1)
+	lines = append(lines, color.YellowString(s.message()))
+	for _, warning := range s.warnings {
+		lines = append(lines, Indent+warning)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func (s warningSummary) Payload() ([]string, map[string]interface{}, error) {
+	warnings := s.warnings
+	if warnings == nil {
+		warnings = []string{}
+	}
+	return warningSummaryFields, map[string]interface{}{
+		logFieldMessage:  s.message(),
+		logFieldWarnings: warnings,
+	}, nil
+}
@@ -25,6 +25,8 @@ const (
 
 // RequestOptions are options to configure an *http.Request
 type RequestOptions struct {
+	// AllowNotFound returns a 404 response to the caller instead of an error
+	AllowNotFound  bool
 	Body           io.Reader
 	ContentType    string
 	NoAuth         bool
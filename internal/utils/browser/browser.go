@@ -0,0 +1,28 @@
+package browser
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// Open opens the url in the default browser of the platform
+func Open(url string) error {
+	name, args, err := openCommand(runtime.GOOS)
+	if err != nil {
+		return err
+	}
+	return exec.Command(name, append(args, url)...).Start()
+}
+
+func openCommand(goos string) (string, []string, error) {
+	switch goos {
+	case "darwin":
+		return "open", nil, nil
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler"}, nil
+	case "linux", "freebsd", "netbsd", "openbsd":
+		return "xdg-open", nil, nil
+	}
+	return "", nil, fmt.Errorf("opening a browser is not supported on %s", goos)
+}
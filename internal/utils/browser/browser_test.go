@@ -0,0 +1,32 @@
+package browser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestOpenCommand(t *testing.T) {
+	for _, tc := range []struct {
+		goos         string
+		expectedName string
+		expectedArgs []string
+	}{
+		{goos: "darwin", expectedName: "open"},
+		{goos: "windows", expectedName: "rundll32", expectedArgs: []string{"url.dll,FileProtocolHandler"}},
+		{goos: "linux", expectedName: "xdg-open"},
+	} {
+		t.Run("should open the browser on "+tc.goos, func(t *testing.T) {
+			name, args, err := openCommand(tc.goos)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedName, name)
+			assert.Equal(t, tc.expectedArgs, args)
+		})
+	}
+
+	t.Run("should return an error for an unsupported platform", func(t *testing.T) {
+		_, _, err := openCommand("plan9")
+		assert.Equal(t, errors.New("opening a browser is not supported on plan9"), err)
+	})
+}
@@ -19,7 +19,11 @@ const (
 	dateFormatDays      = "2006-01-02"
 )
 
-// Date is a date flag
+// now returns the current time, which relative dates are resolved from
+var now = time.Now
+
+// Date is a date flag, set from either a timestamp or a duration
+// relative to the current time, e.g. "2h" for two hours ago
 type Date struct {
 	Time time.Time
 }
@@ -48,6 +52,12 @@ func (d *Date) Set(val string) error {
 }
 
 func parseTime(val string) (time.Time, error) {
+	if d, err := time.ParseDuration(val); err == nil {
+		if d < 0 {
+			return time.Time{}, fmt.Errorf("relative date must be a positive duration: %s", val)
+		}
+		return now().Add(-d), nil
+	}
 	if t, err := time.Parse(dateFormatTZ, val); err == nil {
 		return t, nil
 	}
@@ -1,7 +1,9 @@
 package flags
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 )
@@ -32,3 +34,33 @@ func TestDateSet(t *testing.T) {
 		})
 	}
 }
+
+func TestDateSetRelative(t *testing.T) {
+	originalNow := now
+	defer func() { now = originalNow }()
+
+	now = func() time.Time { return time.Date(2021, time.June, 22, 7, 54, 42, 0, time.UTC) }
+
+	for _, tc := range []struct {
+		input  string
+		output string
+	}{
+		{"2h", "2021-06-22T05:54:42.000+0000"},
+		{"90m", "2021-06-22T06:24:42.000+0000"},
+		{"1h30m15s", "2021-06-22T06:24:27.000+0000"},
+	} {
+		t.Run("should parse the duration "+tc.input+" as a time relative to now", func(t *testing.T) {
+			date := new(Date)
+
+			assert.Nil(t, date.Set(tc.input))
+
+			assert.Equal(t, tc.output, date.String())
+		})
+	}
+
+	t.Run("should return an error with a negative duration", func(t *testing.T) {
+		date := new(Date)
+
+		assert.Equal(t, errors.New("relative date must be a positive duration: -2h"), date.Set("-2h"))
+	})
+}
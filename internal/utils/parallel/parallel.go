@@ -0,0 +1,35 @@
+package parallel
+
+import (
+	"sync"
+)
+
+// Run calls fn with each index from 0 to n, running at most workers calls at the same time,
+// and returns once every call has returned; a workers count below 1 runs one call at a time
+func Run(n, workers int, fn func(i int)) {
+	if workers < 1 {
+		workers = 1
+	}
+	if n < workers {
+		workers = n
+	}
+
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
@@ -0,0 +1,48 @@
+package parallel
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("should call the function once with each index", func(t *testing.T) {
+		calls := make([]int, 10)
+
+		Run(len(calls), 3, func(i int) { calls[i]++ })
+
+		assert.Equal(t, []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, calls)
+	})
+
+	t.Run("should run at most the number of workers at the same time", func(t *testing.T) {
+		var mu sync.Mutex
+		var inFlight, maxInFlight int
+
+		Run(20, 4, func(i int) {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		})
+
+		assert.True(t, maxInFlight > 1, "expected calls to run concurrently")
+		assert.True(t, maxInFlight <= 4, "expected at most 4 concurrent calls, but got %d", maxInFlight)
+	})
+
+	t.Run("should return without calling the function when there is nothing to run", func(t *testing.T) {
+		var called bool
+		Run(0, 4, func(i int) { called = true })
+		assert.True(t, !called, "expected the function not to be called")
+	})
+}
@@ -8,6 +8,16 @@ type AtlasClient struct {
 	GroupsFn    func() ([]atlas.Group, error)
 	ClustersFn  func(groupID string) ([]atlas.Cluster, error)
 	DataLakesFn func(groupID string) ([]atlas.DataLake, error)
+
+	SearchIndexesFn     func(groupID, clusterName, database, collection string) ([]atlas.SearchIndex, error)
+	CreateSearchIndexFn func(groupID, clusterName string, index atlas.SearchIndex) (atlas.SearchIndex, error)
+	DeleteSearchIndexFn func(groupID, clusterName, indexID string) error
+
+	EventsFn func(groupID string, opts atlas.EventsOptions) ([]atlas.Event, error)
+
+	ProjectUsersFn   func(groupID string) ([]atlas.ProjectUser, error)
+	ProjectTeamsFn   func(groupID string) ([]atlas.ProjectTeam, error)
+	ProjectAPIKeysFn func(groupID string) ([]atlas.ProjectAPIKey, error)
 }
 
 // Groups calls the mocked Groups implementation if provided,
@@ -39,3 +49,73 @@ func (ac AtlasClient) DataLakes(groupID string) ([]atlas.DataLake, error) {
 	}
 	return ac.Client.DataLakes(groupID)
 }
+
+// SearchIndexes calls the mocked SearchIndexes implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) SearchIndexes(groupID, clusterName, database, collection string) ([]atlas.SearchIndex, error) {
+	if ac.SearchIndexesFn != nil {
+		return ac.SearchIndexesFn(groupID, clusterName, database, collection)
+	}
+	return ac.Client.SearchIndexes(groupID, clusterName, database, collection)
+}
+
+// CreateSearchIndex calls the mocked CreateSearchIndex implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) CreateSearchIndex(groupID, clusterName string, index atlas.SearchIndex) (atlas.SearchIndex, error) {
+	if ac.CreateSearchIndexFn != nil {
+		return ac.CreateSearchIndexFn(groupID, clusterName, index)
+	}
+	return ac.Client.CreateSearchIndex(groupID, clusterName, index)
+}
+
+// DeleteSearchIndex calls the mocked DeleteSearchIndex implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) DeleteSearchIndex(groupID, clusterName, indexID string) error {
+	if ac.DeleteSearchIndexFn != nil {
+		return ac.DeleteSearchIndexFn(groupID, clusterName, indexID)
+	}
+	return ac.Client.DeleteSearchIndex(groupID, clusterName, indexID)
+}
+
+// Events calls the mocked Events implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) Events(groupID string, opts atlas.EventsOptions) ([]atlas.Event, error) {
+	if ac.EventsFn != nil {
+		return ac.EventsFn(groupID, opts)
+	}
+	return ac.Client.Events(groupID, opts)
+}
+
+// ProjectUsers calls the mocked ProjectUsers implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) ProjectUsers(groupID string) ([]atlas.ProjectUser, error) {
+	if ac.ProjectUsersFn != nil {
+		return ac.ProjectUsersFn(groupID)
+	}
+	return ac.Client.ProjectUsers(groupID)
+}
+
+// ProjectTeams calls the mocked ProjectTeams implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) ProjectTeams(groupID string) ([]atlas.ProjectTeam, error) {
+	if ac.ProjectTeamsFn != nil {
+		return ac.ProjectTeamsFn(groupID)
+	}
+	return ac.Client.ProjectTeams(groupID)
+}
+
+// ProjectAPIKeys calls the mocked ProjectAPIKeys implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) ProjectAPIKeys(groupID string) ([]atlas.ProjectAPIKey, error) {
+	if ac.ProjectAPIKeysFn != nil {
+		return ac.ProjectAPIKeysFn(groupID)
+	}
+	return ac.Client.ProjectAPIKeys(groupID)
+}
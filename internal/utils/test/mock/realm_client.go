@@ -11,8 +11,10 @@ import (
 type RealmClient struct {
 	realm.Client
 
-	AuthenticateFn func(publicAPIKey, privateAPIKey string) (realm.Session, error)
-	AuthProfileFn  func() (realm.AuthProfile, error)
+	AuthenticateFn       func(publicAPIKey, privateAPIKey string) (realm.Session, error)
+	AuthorizeDeviceFn    func() (realm.DeviceAuthorization, error)
+	AuthenticateDeviceFn func(deviceCode string) (realm.Session, error)
+	AuthProfileFn        func() (realm.AuthProfile, error)
 
 	DiffFn   func(groupID, appID string, appData interface{}) ([]string, error)
 	ExportFn func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error)
@@ -21,32 +23,76 @@ type RealmClient struct {
 	ExportDependenciesFn func(groupID, appID string) (string, io.ReadCloser, error)
 	ImportDependenciesFn func(groupID, appID, uploadPath string) error
 	DiffDependenciesFn   func(groupID, appID, uploadPath string) (realm.DependenciesDiff, error)
+	DependenciesStatusFn func(groupID, appID string) (realm.DependenciesStatus, error)
 
 	CreateAppFn      func(groupID, name string, meta realm.AppMeta) (realm.App, error)
 	DeleteAppFn      func(groupID, appID string) error
 	FindAppsFn       func(filter realm.AppFilter) ([]realm.App, error)
+	FindAppsStreamFn func(filter realm.AppFilter, onApps func(apps []realm.App, pending int)) error
 	AppDescriptionFn func(groupID, appID string) (realm.AppDescription, error)
 
+	AppSettingsFn       func(groupID, appID string) (realm.AppSettings, error)
+	UpdateAppSettingsFn func(groupID, appID string, update realm.AppSettingsUpdate) error
+
+	DeployConfigFn        func(groupID, appID string) (realm.DeployConfig, error)
+	UpdateDeployConfigFn  func(groupID, appID string, config realm.DeployConfig) error
+	GitHubInstallationsFn func(groupID, appID string) ([]realm.GitHubInstallation, error)
+
 	CreateDraftFn  func(groupID, appID string) (realm.AppDraft, error)
 	DiffDraftFn    func(groupID, appID, draftID string) (realm.AppDraftDiff, error)
 	DiscardDraftFn func(groupID, appID, draftID string) error
 	DraftFn        func(groupID, appID string) (realm.AppDraft, error)
 
-	DeployDraftFn func(groupID, appID, draftID string) (realm.AppDeployment, error)
+	DeployDraftFn func(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error)
 	DeploymentFn  func(groupID, appID, deploymentID string) (realm.AppDeployment, error)
+	DeploymentsFn func(groupID, appID string) ([]realm.AppDeployment, error)
+
+	DeploymentHistoryFn  func(groupID, appID string, opts realm.DeploymentHistoryOptions) ([]realm.AppDeployment, error)
+	RedeployDeploymentFn func(groupID, appID, deploymentID string) (realm.AppDeployment, error)
 
 	SecretsFn      func(groupID, appID string) ([]realm.Secret, error)
 	CreateSecretFn func(groupID, appID, name, value string) (realm.Secret, error)
 	DeleteSecretFn func(groupID, appID, secretID string) error
 	UpdateSecretFn func(groupID, appID, secretID, name, value string) error
 
-	CreateAPIKeyFn      func(groupID, appID, apiKeyName string) (realm.APIKey, error)
-	CreateUserFn        func(groupID, appID, email, password string) (realm.User, error)
-	DeleteUserFn        func(groupID, appID, userID string) error
-	DisableUserFn       func(groupID, appID, userID string) error
-	EnableUserFn        func(groupID, appID, userID string) error
-	FindUsersFn         func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error)
-	RevokeUserSessionFn func(groupID, appID, userID string) error
+	ValuesFn      func(groupID, appID string) ([]realm.Value, error)
+	CreateValueFn func(groupID, appID string, value realm.Value) (realm.Value, error)
+	DeleteValueFn func(groupID, appID, valueID string) error
+	UpdateValueFn func(groupID, appID string, value realm.Value) error
+
+	ServicesFn          func(groupID, appID string) ([]realm.Service, error)
+	RulesFn             func(groupID, appID, serviceID string) ([]realm.Rule, error)
+	CreateRuleFn        func(groupID, appID, serviceID string, rule realm.Rule) (realm.Rule, error)
+	DeleteRuleFn        func(groupID, appID, serviceID, ruleID string) error
+	DefaultRuleFn       func(groupID, appID, serviceID string) (realm.DefaultRule, bool, error)
+	CreateDefaultRuleFn func(groupID, appID, serviceID string, defaultRule realm.DefaultRule) (realm.DefaultRule, error)
+	UpdateDefaultRuleFn func(groupID, appID, serviceID string, defaultRule realm.DefaultRule) error
+	EvaluateRuleFn      func(groupID, appID, serviceID string, evaluation realm.RuleEvaluation) (realm.RuleEvaluationResult, error)
+	SyncConfigFn        func(groupID, appID, serviceID string) (realm.SyncConfig, error)
+	UpdateSyncConfigFn  func(groupID, appID, serviceID string, config realm.SyncConfig) error
+	SyncProgressFn      func(groupID, appID string) (realm.SyncProgress, error)
+	SyncClientsFn       func(groupID, appID, userID string) ([]realm.SyncClient, error)
+	SyncClientResetFn   func(groupID, appID, userID string) error
+
+	TriggersFn      func(groupID, appID string) ([]realm.Trigger, error)
+	UpdateTriggerFn func(groupID, appID string, trigger realm.Trigger) error
+	ResumeTriggerFn func(groupID, appID, triggerID string, disableToken bool) error
+
+	CreateAPIKeyFn          func(groupID, appID, apiKeyName string) (realm.APIKey, error)
+	ConfirmPendingUserFn    func(groupID, appID, email string) error
+	CreateUserFn            func(groupID, appID, email, password string) (realm.User, error)
+	DeletePendingUserFn     func(groupID, appID, email string) error
+	DeleteUserFn            func(groupID, appID, userID string) error
+	DisableUserFn           func(groupID, appID, userID string) error
+	EnableUserFn            func(groupID, appID, userID string) error
+	FindUsersFn             func(groupID, appID string, filter realm.UserFilter) ([]realm.User, error)
+	RevokeAllUserSessionsFn func(groupID, appID string, filter realm.UserFilter, onProgress func(processed, total int)) ([]realm.UserSessionsRevocation, error)
+	RevokeUserSessionFn     func(groupID, appID, userID string) error
+	RevokeUserDeviceFn      func(groupID, appID, userID, deviceID string) error
+	UserDevicesFn           func(groupID, appID, userID string) ([]realm.UserDevice, error)
+	SendUserPasswordResetFn func(groupID, appID, email string) error
+	UpdateUserEmailFn       func(groupID, appID, userID, email string) error
+	UpdateUserPasswordFn    func(groupID, appID, userID, password string) error
 
 	HostingAssetsFn                func(groupID, appID string) ([]realm.HostingAsset, error)
 	HostingAssetUploadFn           func(groupID, appID, rootDir string, asset realm.HostingAsset) error
@@ -55,11 +101,21 @@ type RealmClient struct {
 	HostingCacheInvalidateFn       func(groupID, appID, path string) error
 
 	FunctionsFn               func(groupID, appID string) ([]realm.Function, error)
+	FunctionFn                func(groupID, appID, functionID string) (realm.FunctionDetails, error)
 	AppDebugExecuteFunctionFn func(groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error)
 
+	AppDebugExecuteFunctionSourceFn func(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error)
+
 	LogsFn func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error)
 
-	SchemaModelsFn func(groupID, appID, language string) ([]realm.SchemaModel, error)
+	SchemaModelsFn   func(groupID, appID, language string) ([]realm.SchemaModel, error)
+	SchemasFn        func(groupID, appID string) ([]realm.Schema, error)
+	UpdateSchemaFn   func(groupID, appID string, schema realm.Schema) error
+	SchemaVersionsFn func(groupID, appID string) ([]realm.SchemaVersion, error)
+	SchemaVersionFn  func(groupID, appID string, version int) ([]realm.Schema, error)
+
+	ValidateSchemaDataFn func(groupID, appID string, schema realm.Schema, opts realm.SchemaValidationOptions) (realm.SchemaValidationResult, error)
+	GenerateSchemaFn     func(groupID, appID string, metadata realm.SchemaMetadata, opts realm.SchemaGenerationOptions) (map[string]interface{}, error)
 
 	StatusFn func() error
 }
@@ -74,6 +130,26 @@ func (rc RealmClient) Authenticate(publicAPIKey, privateAPIKey string) (realm.Se
 	return rc.Client.Authenticate(publicAPIKey, privateAPIKey)
 }
 
+// AuthorizeDevice calls the mocked AuthorizeDevice implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) AuthorizeDevice() (realm.DeviceAuthorization, error) {
+	if rc.AuthorizeDeviceFn != nil {
+		return rc.AuthorizeDeviceFn()
+	}
+	return rc.Client.AuthorizeDevice()
+}
+
+// AuthenticateDevice calls the mocked AuthenticateDevice implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) AuthenticateDevice(deviceCode string) (realm.Session, error) {
+	if rc.AuthenticateDeviceFn != nil {
+		return rc.AuthenticateDeviceFn(deviceCode)
+	}
+	return rc.Client.AuthenticateDevice(deviceCode)
+}
+
 // AuthProfile calls the mocked AuthProfile implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -144,6 +220,25 @@ func (rc RealmClient) FindApps(filter realm.AppFilter) ([]realm.App, error) {
 	return rc.Client.FindApps(filter)
 }
 
+// FindAppsStream calls the mocked FindAppsStream implementation if provided,
+// otherwise the call falls back to the mocked FindApps implementation if provided,
+// which finds every app at once, and finally to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) FindAppsStream(filter realm.AppFilter, onApps func(apps []realm.App, pending int)) error {
+	if rc.FindAppsStreamFn != nil {
+		return rc.FindAppsStreamFn(filter, onApps)
+	}
+	if rc.FindAppsFn != nil {
+		apps, err := rc.FindAppsFn(filter)
+		if err != nil {
+			return err
+		}
+		onApps(apps, 0)
+		return nil
+	}
+	return rc.Client.FindAppsStream(filter, onApps)
+}
+
 // AppDescription calls the mocked AppDescription implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -154,6 +249,56 @@ func (rc RealmClient) AppDescription(groupID, appID string) (realm.AppDescriptio
 	return rc.Client.AppDescription(groupID, appID)
 }
 
+// AppSettings calls the mocked AppSettings implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) AppSettings(groupID, appID string) (realm.AppSettings, error) {
+	if rc.AppSettingsFn != nil {
+		return rc.AppSettingsFn(groupID, appID)
+	}
+	return rc.Client.AppSettings(groupID, appID)
+}
+
+// UpdateAppSettings calls the mocked UpdateAppSettings implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UpdateAppSettings(groupID, appID string, update realm.AppSettingsUpdate) error {
+	if rc.UpdateAppSettingsFn != nil {
+		return rc.UpdateAppSettingsFn(groupID, appID, update)
+	}
+	return rc.Client.UpdateAppSettings(groupID, appID, update)
+}
+
+// DeployConfig calls the mocked DeployConfig implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) DeployConfig(groupID, appID string) (realm.DeployConfig, error) {
+	if rc.DeployConfigFn != nil {
+		return rc.DeployConfigFn(groupID, appID)
+	}
+	return rc.Client.DeployConfig(groupID, appID)
+}
+
+// UpdateDeployConfig calls the mocked UpdateDeployConfig implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UpdateDeployConfig(groupID, appID string, config realm.DeployConfig) error {
+	if rc.UpdateDeployConfigFn != nil {
+		return rc.UpdateDeployConfigFn(groupID, appID, config)
+	}
+	return rc.Client.UpdateDeployConfig(groupID, appID, config)
+}
+
+// GitHubInstallations calls the mocked GitHubInstallations implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) GitHubInstallations(groupID, appID string) ([]realm.GitHubInstallation, error) {
+	if rc.GitHubInstallationsFn != nil {
+		return rc.GitHubInstallationsFn(groupID, appID)
+	}
+	return rc.Client.GitHubInstallations(groupID, appID)
+}
+
 // CreateDraft calls the mocked CreateDraft implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -167,11 +312,11 @@ func (rc RealmClient) CreateDraft(groupID, appID string) (realm.AppDraft, error)
 // DeployDraft calls the mocked DeployDraft implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DeployDraft(groupID, appID, draftID string) (realm.AppDeployment, error) {
+func (rc RealmClient) DeployDraft(groupID, appID, draftID string, opts realm.DeployDraftOptions) (realm.AppDeployment, error) {
 	if rc.DeployDraftFn != nil {
-		return rc.DeployDraftFn(groupID, appID, draftID)
+		return rc.DeployDraftFn(groupID, appID, draftID, opts)
 	}
-	return rc.Client.DeployDraft(groupID, appID, draftID)
+	return rc.Client.DeployDraft(groupID, appID, draftID, opts)
 }
 
 // DiffDraft calls the mocked DiffDraft implementation if provided,
@@ -214,6 +359,36 @@ func (rc RealmClient) Deployment(groupID, appID, deploymentID string) (realm.App
 	return rc.Client.Deployment(groupID, appID, deploymentID)
 }
 
+// Deployments calls the mocked Deployments implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) Deployments(groupID, appID string) ([]realm.AppDeployment, error) {
+	if rc.DeploymentsFn != nil {
+		return rc.DeploymentsFn(groupID, appID)
+	}
+	return rc.Client.Deployments(groupID, appID)
+}
+
+// DeploymentHistory calls the mocked DeploymentHistory implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) DeploymentHistory(groupID, appID string, opts realm.DeploymentHistoryOptions) ([]realm.AppDeployment, error) {
+	if rc.DeploymentHistoryFn != nil {
+		return rc.DeploymentHistoryFn(groupID, appID, opts)
+	}
+	return rc.Client.DeploymentHistory(groupID, appID, opts)
+}
+
+// RedeployDeployment calls the mocked RedeployDeployment implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) RedeployDeployment(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+	if rc.RedeployDeploymentFn != nil {
+		return rc.RedeployDeploymentFn(groupID, appID, deploymentID)
+	}
+	return rc.Client.RedeployDeployment(groupID, appID, deploymentID)
+}
+
 // CreateAPIKey calls the mocked CreateAPIKey implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -264,6 +439,216 @@ func (rc RealmClient) UpdateSecret(groupID, appID, secretID, name, value string)
 	return rc.Client.UpdateSecret(groupID, appID, secretID, name, value)
 }
 
+// Values calls the mocked Values implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) Values(groupID, appID string) ([]realm.Value, error) {
+	if rc.ValuesFn != nil {
+		return rc.ValuesFn(groupID, appID)
+	}
+	return rc.Client.Values(groupID, appID)
+}
+
+// CreateValue calls the mocked CreateValue implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) CreateValue(groupID, appID string, value realm.Value) (realm.Value, error) {
+	if rc.CreateValueFn != nil {
+		return rc.CreateValueFn(groupID, appID, value)
+	}
+	return rc.Client.CreateValue(groupID, appID, value)
+}
+
+// DeleteValue calls the mocked DeleteValue implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) DeleteValue(groupID, appID, valueID string) error {
+	if rc.DeleteValueFn != nil {
+		return rc.DeleteValueFn(groupID, appID, valueID)
+	}
+	return rc.Client.DeleteValue(groupID, appID, valueID)
+}
+
+// UpdateValue calls the mocked UpdateValue implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UpdateValue(groupID, appID string, value realm.Value) error {
+	if rc.UpdateValueFn != nil {
+		return rc.UpdateValueFn(groupID, appID, value)
+	}
+	return rc.Client.UpdateValue(groupID, appID, value)
+}
+
+// Services calls the mocked Services implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) Services(groupID, appID string) ([]realm.Service, error) {
+	if rc.ServicesFn != nil {
+		return rc.ServicesFn(groupID, appID)
+	}
+	return rc.Client.Services(groupID, appID)
+}
+
+// Rules calls the mocked Rules implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) Rules(groupID, appID, serviceID string) ([]realm.Rule, error) {
+	if rc.RulesFn != nil {
+		return rc.RulesFn(groupID, appID, serviceID)
+	}
+	return rc.Client.Rules(groupID, appID, serviceID)
+}
+
+// CreateRule calls the mocked CreateRule implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) CreateRule(groupID, appID, serviceID string, rule realm.Rule) (realm.Rule, error) {
+	if rc.CreateRuleFn != nil {
+		return rc.CreateRuleFn(groupID, appID, serviceID, rule)
+	}
+	return rc.Client.CreateRule(groupID, appID, serviceID, rule)
+}
+
+// DeleteRule calls the mocked DeleteRule implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) DeleteRule(groupID, appID, serviceID, ruleID string) error {
+	if rc.DeleteRuleFn != nil {
+		return rc.DeleteRuleFn(groupID, appID, serviceID, ruleID)
+	}
+	return rc.Client.DeleteRule(groupID, appID, serviceID, ruleID)
+}
+
+// DefaultRule calls the mocked DefaultRule implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) DefaultRule(groupID, appID, serviceID string) (realm.DefaultRule, bool, error) {
+	if rc.DefaultRuleFn != nil {
+		return rc.DefaultRuleFn(groupID, appID, serviceID)
+	}
+	return rc.Client.DefaultRule(groupID, appID, serviceID)
+}
+
+// CreateDefaultRule calls the mocked CreateDefaultRule implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) CreateDefaultRule(groupID, appID, serviceID string, defaultRule realm.DefaultRule) (realm.DefaultRule, error) {
+	if rc.CreateDefaultRuleFn != nil {
+		return rc.CreateDefaultRuleFn(groupID, appID, serviceID, defaultRule)
+	}
+	return rc.Client.CreateDefaultRule(groupID, appID, serviceID, defaultRule)
+}
+
+// UpdateDefaultRule calls the mocked UpdateDefaultRule implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UpdateDefaultRule(groupID, appID, serviceID string, defaultRule realm.DefaultRule) error {
+	if rc.UpdateDefaultRuleFn != nil {
+		return rc.UpdateDefaultRuleFn(groupID, appID, serviceID, defaultRule)
+	}
+	return rc.Client.UpdateDefaultRule(groupID, appID, serviceID, defaultRule)
+}
+
+// EvaluateRule calls the mocked EvaluateRule implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) EvaluateRule(groupID, appID, serviceID string, evaluation realm.RuleEvaluation) (realm.RuleEvaluationResult, error) {
+	if rc.EvaluateRuleFn != nil {
+		return rc.EvaluateRuleFn(groupID, appID, serviceID, evaluation)
+	}
+	return rc.Client.EvaluateRule(groupID, appID, serviceID, evaluation)
+}
+
+// SyncConfig calls the mocked SyncConfig implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) SyncConfig(groupID, appID, serviceID string) (realm.SyncConfig, error) {
+	if rc.SyncConfigFn != nil {
+		return rc.SyncConfigFn(groupID, appID, serviceID)
+	}
+	return rc.Client.SyncConfig(groupID, appID, serviceID)
+}
+
+// UpdateSyncConfig calls the mocked UpdateSyncConfig implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UpdateSyncConfig(groupID, appID, serviceID string, config realm.SyncConfig) error {
+	if rc.UpdateSyncConfigFn != nil {
+		return rc.UpdateSyncConfigFn(groupID, appID, serviceID, config)
+	}
+	return rc.Client.UpdateSyncConfig(groupID, appID, serviceID, config)
+}
+
+// SyncProgress calls the mocked SyncProgress implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) SyncProgress(groupID, appID string) (realm.SyncProgress, error) {
+	if rc.SyncProgressFn != nil {
+		return rc.SyncProgressFn(groupID, appID)
+	}
+	return rc.Client.SyncProgress(groupID, appID)
+}
+
+// SyncClients calls the mocked SyncClients implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) SyncClients(groupID, appID, userID string) ([]realm.SyncClient, error) {
+	if rc.SyncClientsFn != nil {
+		return rc.SyncClientsFn(groupID, appID, userID)
+	}
+	return rc.Client.SyncClients(groupID, appID, userID)
+}
+
+// SyncClientReset calls the mocked SyncClientReset implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) SyncClientReset(groupID, appID, userID string) error {
+	if rc.SyncClientResetFn != nil {
+		return rc.SyncClientResetFn(groupID, appID, userID)
+	}
+	return rc.Client.SyncClientReset(groupID, appID, userID)
+}
+
+// Triggers calls the mocked Triggers implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) Triggers(groupID, appID string) ([]realm.Trigger, error) {
+	if rc.TriggersFn != nil {
+		return rc.TriggersFn(groupID, appID)
+	}
+	return rc.Client.Triggers(groupID, appID)
+}
+
+// UpdateTrigger calls the mocked UpdateTrigger implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UpdateTrigger(groupID, appID string, trigger realm.Trigger) error {
+	if rc.UpdateTriggerFn != nil {
+		return rc.UpdateTriggerFn(groupID, appID, trigger)
+	}
+	return rc.Client.UpdateTrigger(groupID, appID, trigger)
+}
+
+// ResumeTrigger calls the mocked ResumeTrigger implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) ResumeTrigger(groupID, appID, triggerID string, disableToken bool) error {
+	if rc.ResumeTriggerFn != nil {
+		return rc.ResumeTriggerFn(groupID, appID, triggerID, disableToken)
+	}
+	return rc.Client.ResumeTrigger(groupID, appID, triggerID, disableToken)
+}
+
+// ConfirmPendingUser calls the mocked ConfirmPendingUser implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) ConfirmPendingUser(groupID, appID, email string) error {
+	if rc.ConfirmPendingUserFn != nil {
+		return rc.ConfirmPendingUserFn(groupID, appID, email)
+	}
+	return rc.Client.ConfirmPendingUser(groupID, appID, email)
+}
+
 // CreateUser calls the mocked CreateUser implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -274,6 +659,16 @@ func (rc RealmClient) CreateUser(groupID, appID, email, password string) (realm.
 	return rc.Client.CreateUser(groupID, appID, email, password)
 }
 
+// DeletePendingUser calls the mocked DeletePendingUser implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) DeletePendingUser(groupID, appID, email string) error {
+	if rc.DeletePendingUserFn != nil {
+		return rc.DeletePendingUserFn(groupID, appID, email)
+	}
+	return rc.Client.DeletePendingUser(groupID, appID, email)
+}
+
 // DeleteUser calls the mocked DeleteUser implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -314,6 +709,36 @@ func (rc RealmClient) FindUsers(groupID, appID string, filter realm.UserFilter)
 	return rc.Client.FindUsers(groupID, appID, filter)
 }
 
+// RevokeAllUserSessions calls the mocked RevokeAllUserSessions implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) RevokeAllUserSessions(groupID, appID string, filter realm.UserFilter, onProgress func(processed, total int)) ([]realm.UserSessionsRevocation, error) {
+	if rc.RevokeAllUserSessionsFn != nil {
+		return rc.RevokeAllUserSessionsFn(groupID, appID, filter, onProgress)
+	}
+	return rc.Client.RevokeAllUserSessions(groupID, appID, filter, onProgress)
+}
+
+// RevokeUserDevice calls the mocked RevokeUserDevice implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) RevokeUserDevice(groupID, appID, userID, deviceID string) error {
+	if rc.RevokeUserDeviceFn != nil {
+		return rc.RevokeUserDeviceFn(groupID, appID, userID, deviceID)
+	}
+	return rc.Client.RevokeUserDevice(groupID, appID, userID, deviceID)
+}
+
+// UserDevices calls the mocked UserDevices implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UserDevices(groupID, appID, userID string) ([]realm.UserDevice, error) {
+	if rc.UserDevicesFn != nil {
+		return rc.UserDevicesFn(groupID, appID, userID)
+	}
+	return rc.Client.UserDevices(groupID, appID, userID)
+}
+
 // RevokeUserSessions calls the mocked RevokeUserSessions implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -324,6 +749,36 @@ func (rc RealmClient) RevokeUserSessions(groupID, appID, userID string) error {
 	return rc.Client.RevokeUserSessions(groupID, appID, userID)
 }
 
+// SendUserPasswordReset calls the mocked SendUserPasswordReset implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) SendUserPasswordReset(groupID, appID, email string) error {
+	if rc.SendUserPasswordResetFn != nil {
+		return rc.SendUserPasswordResetFn(groupID, appID, email)
+	}
+	return rc.Client.SendUserPasswordReset(groupID, appID, email)
+}
+
+// UpdateUserEmail calls the mocked UpdateUserEmail implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UpdateUserEmail(groupID, appID, userID, email string) error {
+	if rc.UpdateUserEmailFn != nil {
+		return rc.UpdateUserEmailFn(groupID, appID, userID, email)
+	}
+	return rc.Client.UpdateUserEmail(groupID, appID, userID, email)
+}
+
+// UpdateUserPassword calls the mocked UpdateUserPassword implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UpdateUserPassword(groupID, appID, userID, password string) error {
+	if rc.UpdateUserPasswordFn != nil {
+		return rc.UpdateUserPasswordFn(groupID, appID, userID, password)
+	}
+	return rc.Client.UpdateUserPassword(groupID, appID, userID, password)
+}
+
 // ExportDependencies calls the mocked ExportDependencies implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -354,6 +809,16 @@ func (rc RealmClient) DiffDependencies(groupID, appID, uploadPath string) (realm
 	return rc.Client.DiffDependencies(groupID, appID, uploadPath)
 }
 
+// DependenciesStatus calls the mocked DependenciesStatus implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) DependenciesStatus(groupID, appID string) (realm.DependenciesStatus, error) {
+	if rc.DependenciesStatusFn != nil {
+		return rc.DependenciesStatusFn(groupID, appID)
+	}
+	return rc.Client.DependenciesStatus(groupID, appID)
+}
+
 // HostingAssets calls the mocked HostingAssets implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -414,6 +879,16 @@ func (rc RealmClient) Functions(groupID, appID string) ([]realm.Function, error)
 	return rc.Client.Functions(groupID, appID)
 }
 
+// Function calls the mocked Function implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) Function(groupID, appID, functionID string) (realm.FunctionDetails, error) {
+	if rc.FunctionFn != nil {
+		return rc.FunctionFn(groupID, appID, functionID)
+	}
+	return rc.Client.Function(groupID, appID, functionID)
+}
+
 // AppDebugExecuteFunction calls the mocked AppDebugExecuteFunction implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -424,6 +899,16 @@ func (rc RealmClient) AppDebugExecuteFunction(groupID, appID, userID, name strin
 	return rc.Client.AppDebugExecuteFunction(groupID, appID, userID, name, args)
 }
 
+// AppDebugExecuteFunctionSource calls the mocked AppDebugExecuteFunctionSource implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) AppDebugExecuteFunctionSource(groupID, appID, userID, source, evalSource string) (realm.ExecutionResults, error) {
+	if rc.AppDebugExecuteFunctionSourceFn != nil {
+		return rc.AppDebugExecuteFunctionSourceFn(groupID, appID, userID, source, evalSource)
+	}
+	return rc.Client.AppDebugExecuteFunctionSource(groupID, appID, userID, source, evalSource)
+}
+
 // Logs calls the mocked Logs implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
@@ -444,6 +929,66 @@ func (rc RealmClient) SchemaModels(groupID, appID, language string) ([]realm.Sch
 	return rc.Client.SchemaModels(groupID, appID, language)
 }
 
+// Schemas calls the mocked Schemas implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) Schemas(groupID, appID string) ([]realm.Schema, error) {
+	if rc.SchemasFn != nil {
+		return rc.SchemasFn(groupID, appID)
+	}
+	return rc.Client.Schemas(groupID, appID)
+}
+
+// UpdateSchema calls the mocked UpdateSchema implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) UpdateSchema(groupID, appID string, schema realm.Schema) error {
+	if rc.UpdateSchemaFn != nil {
+		return rc.UpdateSchemaFn(groupID, appID, schema)
+	}
+	return rc.Client.UpdateSchema(groupID, appID, schema)
+}
+
+// SchemaVersions calls the mocked SchemaVersions implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) SchemaVersions(groupID, appID string) ([]realm.SchemaVersion, error) {
+	if rc.SchemaVersionsFn != nil {
+		return rc.SchemaVersionsFn(groupID, appID)
+	}
+	return rc.Client.SchemaVersions(groupID, appID)
+}
+
+// SchemaVersion calls the mocked SchemaVersion implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) SchemaVersion(groupID, appID string, version int) ([]realm.Schema, error) {
+	if rc.SchemaVersionFn != nil {
+		return rc.SchemaVersionFn(groupID, appID, version)
+	}
+	return rc.Client.SchemaVersion(groupID, appID, version)
+}
+
+// ValidateSchemaData calls the mocked ValidateSchemaData implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) ValidateSchemaData(groupID, appID string, schema realm.Schema, opts realm.SchemaValidationOptions) (realm.SchemaValidationResult, error) {
+	if rc.ValidateSchemaDataFn != nil {
+		return rc.ValidateSchemaDataFn(groupID, appID, schema, opts)
+	}
+	return rc.Client.ValidateSchemaData(groupID, appID, schema, opts)
+}
+
+// GenerateSchema calls the mocked GenerateSchema implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) GenerateSchema(groupID, appID string, metadata realm.SchemaMetadata, opts realm.SchemaGenerationOptions) (map[string]interface{}, error) {
+	if rc.GenerateSchemaFn != nil {
+		return rc.GenerateSchemaFn(groupID, appID, metadata, opts)
+	}
+	return rc.Client.GenerateSchema(groupID, appID, metadata, opts)
+}
+
 // Status calls the mocked Status implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
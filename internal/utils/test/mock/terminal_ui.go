@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/10gen/realm-cli/internal/terminal"
 
 	"github.com/Netflix/go-expect"
+	"github.com/briandowns/spinner"
 	"github.com/hinshun/vt10x"
 )
 
@@ -51,6 +53,14 @@ func (ui ui) Print(logs ...terminal.Log) {
 	ui.UI.Print(logs...)
 }
 
+// Spinner creates a spinner which discards its output, so that it never
+// interleaves with the output the tests assert on
+func (ui ui) Spinner(message string) *spinner.Spinner {
+	s := ui.UI.Spinner(message)
+	s.Writer = ioutil.Discard
+	return s
+}
+
 // NewUI returns a new *bytes.Buffer and a mock terminal UI that writes to the buffer
 func NewUI() (*bytes.Buffer, terminal.UI) {
 	out := new(bytes.Buffer)